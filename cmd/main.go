@@ -2,57 +2,229 @@ package main
 
 import (
 	"flag"
+	"go/token"
+	"log"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"unicode"
 
 	"github.com/yihuang/go-abi/generator"
 )
 
+// listFlag collects a repeatable flag, each of them can be a comma-separated list
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(s string) error {
+	*f = append(*f, strings.Split(s, ",")...)
+	return nil
+}
+
 func main() {
+	var inputs, vars, buildTags listFlag
+	flag.Var(&inputs, "input", "Input file (JSON ABI, Go source file or Solidity interface), repeat it or use a comma-separated list to merge multiple inputs into one package (default $GOFILE)")
+	flag.Var(&vars, "var", "Variable name containing human-readable ABI (for Go source files), repeat it or use a comma-separated list to merge multiple variables in order")
+	flag.Var(&buildTags, "buildtag", "Build constraint of the generated files (e.g., 'uint256'), repeat it or use a comma-separated list to combine multiple constraints with &&")
 	var (
-		inputFile     = flag.String("input", os.Getenv("GOFILE"), "Input file (JSON ABI or Go source file)")
 		outputFile    = flag.String("output", "", "Output file")
+		stdout        = flag.Bool("stdout", false, "Print the formatted generated code to stdout instead of writing -output")
 		prefix        = flag.String("prefix", "", "Prefix for generated types and functions")
-		packageName   = flag.String("package", os.Getenv("GOPACKAGE"), "Package name for generated code")
-		varName       = flag.String("var", "", "Variable name containing human-readable ABI (for Go source files)")
-		extTuplesFlag = flag.String("external-tuples", "", "External tuple mappings in format 'key1=value1,key2=value2'")
+		packageName   = flag.String("package", os.Getenv("GOPACKAGE"), "Package name for generated code (default $GOPACKAGE, or the name of the output directory)")
+		extTuplesFlag = flag.String("external-tuples", "", "External tuple mappings in format 'key1=value1,key2=import/path.Type,key3=alias=import/path.Type'")
 		imports       = flag.String("imports", "", "Additional import paths, comma-separated")
 		stdlib        = flag.Bool("stdlib", false, "Generate stdlib itself")
 		artifactInput = flag.Bool("artifact-input", false, "Input file is a solc artifact JSON, will extract the abi field from it")
 		useUint256    = flag.Bool("uint256", false, "Use holiman/uint256.Int instead of *big.Int for uint256 types")
-		buildTag      = flag.String("buildtag", "", "Build tag to add to generated file (e.g., 'uint256')")
+		uniformBigInt = flag.Bool("uniform-bigint", false, "Use *big.Int for all integer types instead of the native Go types up to 64 bits")
+		legacyTag     = flag.Bool("legacy-buildtag", false, "Add the legacy // +build lines after the //go:build line")
+		url           = flag.String("url", "", "Fetch JSON ABI over HTTP instead of reading input file, Etherscan-style responses are unwrapped")
+		timeout       = flag.Duration("timeout", generator.DefaultFetchTimeout, "Timeout for fetching ABI with -url")
+		pointerRecv   = flag.Bool("pointer-receivers", false, "Generate pointer receivers for all methods to avoid copying large structs")
+		client        = flag.String("client", "", "Name of the typed client to generate, e.g. 'ERC20'")
+		caller        = flag.String("caller", "", "Name of the contract to generate XxxCaller bindings for, e.g. 'ERC20'")
+		iface         = flag.Bool("iface", false, "Generate XxxInterface of the -caller contract with the expanded signatures of all functions, for mocking, implemented by XxxCaller")
+		report        = flag.String("report", "", "Write calldata size report per function to file (.json or markdown), '-' for stdout")
+		split         = flag.Bool("split", false, "Split generated code into one file per category, -output is treated as a directory")
+		combined      = flag.Bool("combined", false, "Input file is a solc --combined-json output, generates one file per contract and the shared types into the -output directory")
+		nameTuples    = flag.Bool("name-tuples-by-function", false, "Name anonymous tuples after the enclosing function and position instead of Tuple<hash>")
+		testHelpers   = flag.Bool("testhelpers", false, "Generate RandomXxx constructors and a FuzzDecode test next to the output file")
+		decodeInto    = flag.Bool("decode-into", false, "Generate DecodeInto methods reusing the slices of the decoded struct")
+		clone         = flag.Bool("clone", false, "Generate deep-copy Clone methods for structs")
+		jsonTags      = flag.Bool("json-tags", false, "Add json tags with the original ABI field names to struct fields")
+		force         = flag.Bool("force", false, "Regenerate the output even if it's generated from the same inputs")
+		jsonNaming    = flag.String("json-naming", generator.NamingABI, "Naming convention of the json tags: abi (verbatim), camel, snake or pascal, implies -json-tags unless abi")
+		eip712        = flag.Bool("eip712", false, "Generate EIP-712 TypeHash and HashStruct methods for tuple structs")
+		binaryMarshal = flag.Bool("binary-marshaler", false, "Generate MarshalBinary and UnmarshalBinary methods with the ABI encoding")
+		only          = flag.String("only", "", "Generate only these methods and events, comma-separated names or 4-byte selectors")
+		exclude       = flag.String("exclude", "", "Skip these methods and events, comma-separated names or 4-byte selectors")
+		contractTypes = flag.String("contract-types", "", "Contract and interface types of the human-readable ABI or Solidity interface to encode as address, comma-separated, e.g. 'IERC20,IPool'")
+		enums         = flag.Bool("enums", false, "Generate named uint8 types for the enums of the JSON ABI internalType")
+		toMap         = flag.Bool("tomap", false, "Generate ToMap methods returning the fields by their ABI names, with addresses and bytes as hex strings")
+		nilSlices     = flag.Bool("nil-slices", false, "Decode the zero-length dynamic arrays to nil instead of empty slices, bytes and strings are not affected")
+		callSuffix    = flag.String("call-suffix", generator.DefaultCallSuffix, "Suffix of the call struct names, e.g. Args for TransferArgs")
+		returnSuffix  = flag.String("return-suffix", generator.DefaultReturnSuffix, "Suffix of the return struct names")
+		eventSuffix   = flag.String("event-suffix", generator.DefaultEventSuffix, "Suffix of the event struct names")
+		lenientTopics = flag.Bool("lenient-topics", false, "Tolerate extra trailing topics when decoding events, emitted by some proxies")
+		layout        = flag.Bool("layout", false, "Generate EncodeToDetailed methods returning the byte ranges of the encoded fields")
+		bigSetters    = flag.Bool("big-setters", false, "Generate SetXxxFromBig setters for the native integer fields, returning abi.ErrIntegerOutOfRange if the value doesn't fit")
+		stringViews   = flag.Bool("string-views", false, "Decode strings as views of the input bytes without copying, unsafe if the input buffer is modified or reused afterwards")
+		packUnpack    = flag.Bool("pack-unpack", false, "Generate Pack and Unpack functions dispatching by the function name, like go-ethereum's ABI.Pack and ABI.Unpack")
+		decodeCtx     = flag.Bool("decode-ctx", false, "Generate DecodeCtx methods checking the context periodically while decoding the dynamic arrays, for cancellation")
+		ctxInterval   = flag.Int("decode-ctx-interval", generator.DefaultDecodeCtxInterval, "Number of array elements decoded between the context checks of -decode-ctx")
+		stdPrefix     = flag.String("std-prefix", "", "Qualifier of the go-abi runtime helpers in the generated code, e.g. 'myabi.', for a fork of go-abi with -std-import (default \"abi.\")")
+		stdImport     = flag.String("std-import", "", "Import path of the go-abi runtime package, for a fork under another module path (default \"github.com/yihuang/go-abi\")")
+		compact       = flag.Bool("compact", false, "Encode and decode the slices of tuples with the generic runtime helpers instead of inlined loops, for smaller code")
+		diff          = flag.String("diff", "", "Old ABI file to compare -input against, reports the changes of the generated bindings as JSON to -output or stdout, exits with 1 on breaking changes")
 	)
 	flag.Parse()
 
+	if len(inputs) == 0 {
+		inputs = listFlag{os.Getenv("GOFILE")}
+	}
+	inputFile := inputs.String()
+	varName := vars.String()
+
+	if *stdout {
+		if *outputFile != "" {
+			log.Fatal("-stdout and -output are mutually exclusive")
+		}
+		if *split || *combined {
+			log.Fatal("-stdout is not supported with -split or -combined, they write multiple files")
+		}
+	}
+	if *packageName == "" {
+		*packageName = inferPackageName(*outputFile, *split || *combined)
+	}
+
+	if !slices.Contains(generator.Namings, *jsonNaming) {
+		log.Fatalf("Unsupported -json-naming %q, expected one of %s", *jsonNaming, strings.Join(generator.Namings, ", "))
+	}
 
 	opts := []generator.Option{
 		generator.PackageName(*packageName),
 		generator.Prefix(*prefix),
 		generator.Stdlib(*stdlib),
 		generator.UseUint256(*useUint256),
-		generator.BuildTag(*buildTag),
+		generator.UniformBigInt(*uniformBigInt),
+		generator.BuildTags(buildTags...),
+		generator.LegacyBuildTag(*legacyTag),
+		generator.PointerReceivers(*pointerRecv),
+		generator.JSONTags(*jsonTags),
+		generator.JSONNaming(*jsonNaming),
+		generator.GenerateClient(*client),
+		generator.GenerateCaller(*caller),
+		generator.GenerateInterface(*iface),
+		generator.GenerateClone(*clone),
+		generator.GenerateDecodeInto(*decodeInto),
+		generator.NameTuplesByFunction(*nameTuples),
+		generator.GenerateTestHelpers(*testHelpers),
+		generator.Split(*split),
+		generator.Combined(*combined),
+		generator.Report(*report),
+		generator.Force(*force),
+		generator.GenerateEIP712(*eip712),
+		generator.GenerateBinaryMarshaler(*binaryMarshal),
+		generator.GenerateEnums(*enums),
+		generator.Compact(*compact),
+		generator.GenerateToMap(*toMap),
+		generator.NilSlices(*nilSlices),
+		generator.CallSuffix(*callSuffix),
+		generator.ReturnSuffix(*returnSuffix),
+		generator.EventSuffix(*eventSuffix),
+		generator.LenientTopics(*lenientTopics),
+		generator.Layout(*layout),
+		generator.BigSetters(*bigSetters),
+		generator.StringViews(*stringViews),
+		generator.GeneratePackUnpack(*packUnpack),
+		generator.GenerateDecodeCtx(*decodeCtx, *ctxInterval),
+		generator.StdPrefix(*stdPrefix),
+		generator.StdImport(*stdImport),
 	}
 
 	if *imports != "" {
 		paths := strings.Split(*imports, ",")
 		var importSpecs []generator.ImportSpec
 		for _, imp := range paths {
-			importSpecs = append(importSpecs, generator.ParseImport(imp))
+			spec, err := generator.ParseImport(imp)
+			if err != nil {
+				log.Fatalf("Invalid -imports: %v", err)
+			}
+			importSpecs = append(importSpecs, spec)
 		}
 		opts = append(opts, generator.ExtraImports(importSpecs))
 	}
 
+	if *only != "" {
+		opts = append(opts, generator.IncludeMethods(strings.Split(*only, ",")))
+	}
+	if *exclude != "" {
+		opts = append(opts, generator.ExcludeMethods(strings.Split(*exclude, ",")))
+	}
+
+	if *contractTypes != "" {
+		opts = append(opts, generator.ContractTypes(strings.Split(*contractTypes, ",")))
+	}
+
 	// Parse external tuples if provided
 	if *extTuplesFlag != "" {
-		extTuples := generator.ParseExternalTuples(*extTuplesFlag)
+		extTuples, err := generator.ParseExternalTuples(*extTuplesFlag)
+		if err != nil {
+			log.Fatalf("Invalid -external-tuples: %v", err)
+		}
 		opts = append(opts, generator.ExternalTuples(extTuples))
 	}
 
+	if len(inputs) > 1 && (*diff != "" || *url != "") {
+		log.Fatal("multiple inputs are not supported with -diff or -url")
+	}
+
+	if *diff != "" {
+		if *url != "" {
+			log.Fatal("-diff compares against -input, -url is not supported")
+		}
+		generator.DiffCommand(*diff, inputFile, varName, *artifactInput, *outputFile, opts...)
+		return
+	}
+
+	if *url != "" {
+		generator.CommandFromURL(*url, *timeout, *outputFile, opts...)
+		return
+	}
+
 	generator.Command(
-		*inputFile,
-		*varName,
+		inputFile,
+		varName,
 		*artifactInput,
 		*outputFile,
 		opts...,
 	)
 }
+
+// inferPackageName names the package after the directory of the output, or the working directory
+// when printing to stdout, e.g. "token-v2" becomes "token_v2", falls back to "abi" if it's not usable.
+func inferPackageName(output string, isDir bool) string {
+	dir := output
+	if !isDir {
+		dir = filepath.Dir(output)
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		log.Fatalf("Failed to resolve output directory: %v", err)
+	}
+
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, filepath.Base(abs))
+	if !token.IsIdentifier(name) || !unicode.IsLetter(rune(name[0])) {
+		return "abi"
+	}
+	return name
+}
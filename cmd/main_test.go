@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	// run the command instead of the tests when re-executed by runMain
+	if os.Getenv("GO_ABI_RUN_MAIN") == "1" {
+		os.Args = append([]string{os.Args[0]}, strings.Split(os.Getenv("GO_ABI_ARGS"), "\n")...)
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runMain runs the command with args in a subprocess, returns the exit code and the output
+func runMain(t *testing.T, args ...string) (int, string) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), "GO_ABI_RUN_MAIN=1", "GO_ABI_ARGS="+strings.Join(args, "\n"), "GOFILE=", "GOPACKAGE=")
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), string(output)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return 0, string(output)
+}
+
+func TestUnknownFlag(t *testing.T) {
+	code, output := runMain(t, "-input", "../tests/merge/token.abi.json", "-build-tag", "uint256", "-stdout")
+	if code == 0 {
+		t.Fatalf("Expected a non-zero exit code for an unknown flag, got output:\n%s", output)
+	}
+	if !strings.Contains(output, "flag provided but not defined: -build-tag") {
+		t.Errorf("Expected the unknown flag to be reported, got:\n%s", output)
+	}
+}
+
+func TestBuildTagFlags(t *testing.T) {
+	code, output := runMain(t, "-input", "../tests/merge/token.abi.json", "-package", "token", "-stdout",
+		"-buildtag", "uint256", "-buildtag", "!js,!wasm", "-legacy-buildtag")
+	if code != 0 {
+		t.Fatalf("Expected success, got exit code %d:\n%s", code, output)
+	}
+	header := "//go:build uint256 && !js && !wasm\n// +build uint256,!js,!wasm\n"
+	if !strings.HasPrefix(output, header) {
+		t.Errorf("Expected the output to start with %q, got:\n%s", header, output[:min(len(output), 200)])
+	}
+
+	code, output = runMain(t, "-input", "../tests/merge/token.abi.json", "-stdout", "-buildtag", "uint256 &&")
+	if code == 0 || !strings.Contains(output, "invalid build tag") {
+		t.Errorf("Expected the invalid build tag to fail, got exit code %d:\n%s", code, output)
+	}
+}
+
+func TestInvalidImports(t *testing.T) {
+	for _, args := range [][]string{
+		{"-imports", "a=b=c"},
+		{"-external-tuples", "Tupleb53c1574=a=b=c/types.User"},
+	} {
+		code, output := runMain(t, append([]string{"-input", "../tests/merge/token.abi.json", "-stdout"}, args...)...)
+		if code == 0 || !strings.Contains(output, "invalid import format") {
+			t.Errorf("%v: expected the invalid import to fail, got exit code %d:\n%s", args, code, output)
+		}
+		if strings.Contains(output, "panic") {
+			t.Errorf("%v: unexpected panic:\n%s", args, output)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package abi
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Global error instances to avoid dynamic error creation in generated code.
 //
@@ -17,6 +20,14 @@ var (
 	// ErrInvalidEventTopic is returned when an event topic is invalid
 	ErrInvalidEventTopic = errors.New("invalid event topic")
 
+	// ErrTopicCountMismatch is returned by DecodeTopics when the number of topics doesn't match the indexed
+	// fields of the event, see TopicCountMismatch, it wraps ErrInvalidNumberOfTopics which was returned before.
+	ErrTopicCountMismatch = fmt.Errorf("topic count mismatch: %w", ErrInvalidNumberOfTopics)
+
+	// ErrEventSignatureMismatch is returned by DecodeTopics when the first topic is not the event signature,
+	// it wraps ErrInvalidEventTopic which was returned before.
+	ErrEventSignatureMismatch = fmt.Errorf("event signature mismatch: %w", ErrInvalidEventTopic)
+
 	// ErrInvalidOffsetForSliceElement is returned when the offset for a slice element is invalid
 	ErrInvalidOffsetForSliceElement = errors.New("invalid offset for slice element")
 
@@ -29,9 +40,80 @@ var (
 	// ErrDirtyPadding is returned when padding bytes are not expected
 	ErrDirtyPadding = errors.New("dirty padding")
 
+	// ErrNonCanonicalSize is returned when a length or offset word has non-zero upper bytes
+	// or doesn't fit in an int, it wraps ErrDirtyPadding which was returned before.
+	ErrNonCanonicalSize = fmt.Errorf("non-canonical size: %w", ErrDirtyPadding)
+
+	// ErrSizeTooLarge is returned when a length or offset word exceeds MaxDecodeSize
+	ErrSizeTooLarge = errors.New("size too large")
+
 	// ErrNegativeValue is returned when a negative value is provided for an unsigned type
 	ErrNegativeValue = errors.New("negative value for unsigned type")
 
 	// ErrIntegerTooLarge is returned when an integer value exceeds 256 bits
 	ErrIntegerTooLarge = errors.New("integer too large")
+
+	// ErrIntegerOutOfRange is returned when converting a *big.Int to a native integer field it doesn't fit in
+	ErrIntegerOutOfRange = errors.New("integer out of range")
+
+	// ErrNilInteger is returned when encoding a nil *big.Int or *uint256.Int, nil is not treated as zero
+	// so a forgotten amount field is reported instead of silently encoded
+	ErrNilInteger = errors.New("nil integer, set the field to encode zero")
+
+	// ErrSelectorMismatch is returned when the function selector in calldata doesn't match the expected one
+	ErrSelectorMismatch = errors.New("function selector mismatch")
+
+	// ErrUnknownSelector is returned when no function of the contract matches the selector in calldata
+	ErrUnknownSelector = errors.New("unknown function selector")
+
+	// ErrUnknownMethod is returned by the generated Pack and Unpack when no function has the name
+	ErrUnknownMethod = errors.New("unknown method")
+
+	// ErrArgumentCountMismatch is returned by the generated Pack when the number of arguments is wrong
+	ErrArgumentCountMismatch = errors.New("argument count mismatch")
+
+	// ErrArgumentTypeMismatch is returned by the generated Pack when an argument doesn't have the Go type
+	// of the call struct field
+	ErrArgumentTypeMismatch = errors.New("argument type mismatch")
+
+	// ErrDecodeCanceled is returned by the generated DecodeCtx when the context is done, see DecodeCanceled
+	ErrDecodeCanceled = errors.New("decode canceled")
+
+	// ErrDecoderPanic is returned by FuzzDecode when the decoder panics instead of returning an error
+	ErrDecoderPanic = errors.New("decoder panic")
+
+	// ErrTrailingBytes is returned by strict decoding when unexpected bytes follow the encoded value
+	ErrTrailingBytes = errors.New("unexpected trailing bytes")
+
+	// ErrInvalidHex is returned by FromHex when the string has a non-hex character
+	ErrInvalidHex = errors.New("invalid hex string")
+
+	// ErrOddLengthHex is returned by FromHex when the string has an odd number of hex digits,
+	// it wraps ErrInvalidHex.
+	ErrOddLengthHex = fmt.Errorf("odd length: %w", ErrInvalidHex)
 )
+
+// TopicCountMismatch returns ErrTopicCountMismatch with the expected and actual number of topics
+func TopicCountMismatch(expected, actual int) error {
+	return fmt.Errorf("%w, expected %d, got %d", ErrTopicCountMismatch, expected, actual)
+}
+
+// UnknownMethod returns ErrUnknownMethod with the name of the method
+func UnknownMethod(name string) error {
+	return fmt.Errorf("%w: %s", ErrUnknownMethod, name)
+}
+
+// ArgumentCountMismatch returns ErrArgumentCountMismatch with the expected and actual number of arguments
+func ArgumentCountMismatch(expected, actual int) error {
+	return fmt.Errorf("%w, expected %d, got %d", ErrArgumentCountMismatch, expected, actual)
+}
+
+// ArgumentTypeMismatch returns ErrArgumentTypeMismatch with the index, the expected Go type and the actual argument
+func ArgumentTypeMismatch(index int, expected string, actual interface{}) error {
+	return fmt.Errorf("%w, argument %d expected %s, got %T", ErrArgumentTypeMismatch, index, expected, actual)
+}
+
+// DecodeCanceled returns ErrDecodeCanceled wrapping the error of the context, e.g. context.Canceled
+func DecodeCanceled(err error) error {
+	return fmt.Errorf("%w: %w", ErrDecodeCanceled, err)
+}
@@ -1,11 +1,12 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: 651dd57d82f9e7ddabeebefd52c01eb8799556d3687e5f4f217d9356a3ae15d1
 
 package examples
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -48,13 +49,23 @@ const (
 	TransferFromID = 599290589
 )
 
+// Canonical function signatures
+const (
+	AllowanceSignature    = "allowance(address,address)"
+	ApproveSignature      = "approve(address,uint256)"
+	BalanceOfSignature    = "balanceOf(address)"
+	DecimalsSignature     = "decimals()"
+	NameSignature         = "name()"
+	SymbolSignature       = "symbol()"
+	TotalSupplySignature  = "totalSupply()"
+	TransferSignature     = "transfer(address,uint256)"
+	TransferFromSignature = "transferFrom(address,address,uint256)"
+)
+
 var _ abi.Method = (*AllowanceCall)(nil)
 
 const AllowanceCallStaticSize = 64
 
-var _ abi.Tuple = (*AllowanceCall)(nil)
-var _ abi.PackedTuple = (*AllowanceCall)(nil)
-
 // AllowanceCall represents an ABI tuple
 type AllowanceCall struct {
 	Owner   common.Address
@@ -116,6 +127,24 @@ func (t *AllowanceCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes AllowanceCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *AllowanceCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes AllowanceCall from the hex string with an optional 0x prefix
+func (t *AllowanceCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode AllowanceCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of AllowanceCall
 func (t AllowanceCall) PackedEncodedSize() int {
 	return 40
@@ -174,6 +203,10 @@ func (t *AllowanceCall) PackedDecode(data []byte) (int, error) {
 	return 40, nil
 }
 
+var _ abi.Tuple = (*AllowanceCall)(nil)
+var _ abi.Decoder = (*AllowanceCall)(nil)
+var _ abi.PackedTuple = (*AllowanceCall)(nil)
+
 // GetMethodName returns the function name
 func (t AllowanceCall) GetMethodName() string {
 	return "allowance"
@@ -189,9 +222,14 @@ func (t AllowanceCall) GetMethodSelector() [4]byte {
 	return AllowanceSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of allowance arguments including function selector
+func (t AllowanceCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes allowance arguments to ABI bytes including function selector
 func (t AllowanceCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], AllowanceSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -199,6 +237,84 @@ func (t AllowanceCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes allowance arguments to 0x prefixed hex string
+func (t AllowanceCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes allowance arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t AllowanceCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the allowance calldata, returns 0 if encoding fails
+func (t AllowanceCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes allowance arguments from ABI bytes including function selector
+func (t *AllowanceCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != AllowanceSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes allowance arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *AllowanceCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode AllowanceCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes allowance arguments to packed ABI bytes including function selector
+func (t AllowanceCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], AllowanceSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes allowance arguments from packed ABI bytes including function selector
+func (t *AllowanceCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != AllowanceSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewAllowanceCall constructs a new AllowanceCall
 func NewAllowanceCall(
 	owner common.Address,
@@ -212,9 +328,6 @@ func NewAllowanceCall(
 
 const AllowanceReturnStaticSize = 32
 
-var _ abi.Tuple = (*AllowanceReturn)(nil)
-var _ abi.PackedTuple = (*AllowanceReturn)(nil)
-
 // AllowanceReturn represents an ABI tuple
 type AllowanceReturn struct {
 	Field1 *big.Int
@@ -258,13 +371,32 @@ func (t *AllowanceReturn) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes AllowanceReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *AllowanceReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes AllowanceReturn from the hex string with an optional 0x prefix
+func (t *AllowanceReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode AllowanceReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of AllowanceReturn
 func (t AllowanceReturn) PackedEncodedSize() int {
 	return 32
@@ -311,13 +443,45 @@ func (t *AllowanceReturn) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*AllowanceReturn)(nil)
+var _ abi.Decoder = (*AllowanceReturn)(nil)
+var _ abi.PackedTuple = (*AllowanceReturn)(nil)
+
+// DecodeAllowanceReturn decodes the return data of allowance into its values
+func DecodeAllowanceReturn(data []byte) (r1 *big.Int, err error) {
+	var result AllowanceReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeAllowance decodes the single return value of allowance
+func DecodeAllowance(data []byte) (*big.Int, error) {
+	return DecodeAllowanceReturn(data)
+}
+
+// DecodeAllowanceHex decodes the single return value of allowance from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeAllowanceHex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode AllowanceReturn: %w", err)
+	}
+	return DecodeAllowanceReturn(data)
+}
+
+// EncodeAllowanceResult encodes the single return value of allowance, e.g. for the return data of precompiles
+func EncodeAllowanceResult(v *big.Int) ([]byte, error) {
+	result := AllowanceReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*ApproveCall)(nil)
 
 const ApproveCallStaticSize = 64
 
-var _ abi.Tuple = (*ApproveCall)(nil)
-var _ abi.PackedTuple = (*ApproveCall)(nil)
-
 // ApproveCall represents an ABI tuple
 type ApproveCall struct {
 	Spender common.Address
@@ -372,13 +536,31 @@ func (t *ApproveCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes ApproveCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *ApproveCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes ApproveCall from the hex string with an optional 0x prefix
+func (t *ApproveCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ApproveCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of ApproveCall
 func (t ApproveCall) PackedEncodedSize() int {
 	return 52
@@ -437,6 +619,10 @@ func (t *ApproveCall) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*ApproveCall)(nil)
+var _ abi.Decoder = (*ApproveCall)(nil)
+var _ abi.PackedTuple = (*ApproveCall)(nil)
+
 // GetMethodName returns the function name
 func (t ApproveCall) GetMethodName() string {
 	return "approve"
@@ -452,9 +638,14 @@ func (t ApproveCall) GetMethodSelector() [4]byte {
 	return ApproveSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of approve arguments including function selector
+func (t ApproveCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes approve arguments to ABI bytes including function selector
 func (t ApproveCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], ApproveSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -462,6 +653,84 @@ func (t ApproveCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes approve arguments to 0x prefixed hex string
+func (t ApproveCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes approve arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t ApproveCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the approve calldata, returns 0 if encoding fails
+func (t ApproveCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes approve arguments from ABI bytes including function selector
+func (t *ApproveCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != ApproveSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes approve arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *ApproveCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ApproveCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes approve arguments to packed ABI bytes including function selector
+func (t ApproveCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], ApproveSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes approve arguments from packed ABI bytes including function selector
+func (t *ApproveCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != ApproveSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewApproveCall constructs a new ApproveCall
 func NewApproveCall(
 	spender common.Address,
@@ -475,9 +744,6 @@ func NewApproveCall(
 
 const ApproveReturnStaticSize = 32
 
-var _ abi.Tuple = (*ApproveReturn)(nil)
-var _ abi.PackedTuple = (*ApproveReturn)(nil)
-
 // ApproveReturn represents an ABI tuple
 type ApproveReturn struct {
 	Field1 bool
@@ -528,6 +794,25 @@ func (t *ApproveReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes ApproveReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *ApproveReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes ApproveReturn from the hex string with an optional 0x prefix
+func (t *ApproveReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ApproveReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of ApproveReturn
 func (t ApproveReturn) PackedEncodedSize() int {
 	return 1
@@ -574,13 +859,45 @@ func (t *ApproveReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*ApproveReturn)(nil)
+var _ abi.Decoder = (*ApproveReturn)(nil)
+var _ abi.PackedTuple = (*ApproveReturn)(nil)
+
+// DecodeApproveReturn decodes the return data of approve into its values
+func DecodeApproveReturn(data []byte) (r1 bool, err error) {
+	var result ApproveReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeApprove decodes the single return value of approve
+func DecodeApprove(data []byte) (bool, error) {
+	return DecodeApproveReturn(data)
+}
+
+// DecodeApproveHex decodes the single return value of approve from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeApproveHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode ApproveReturn: %w", err)
+	}
+	return DecodeApproveReturn(data)
+}
+
+// EncodeApproveResult encodes the single return value of approve, e.g. for the return data of precompiles
+func EncodeApproveResult(v bool) ([]byte, error) {
+	result := ApproveReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*BalanceOfCall)(nil)
 
 const BalanceOfCallStaticSize = 32
 
-var _ abi.Tuple = (*BalanceOfCall)(nil)
-var _ abi.PackedTuple = (*BalanceOfCall)(nil)
-
 // BalanceOfCall represents an ABI tuple
 type BalanceOfCall struct {
 	Account common.Address
@@ -631,6 +948,24 @@ func (t *BalanceOfCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BalanceOfCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *BalanceOfCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes BalanceOfCall from the hex string with an optional 0x prefix
+func (t *BalanceOfCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of BalanceOfCall
 func (t BalanceOfCall) PackedEncodedSize() int {
 	return 20
@@ -677,6 +1012,10 @@ func (t *BalanceOfCall) PackedDecode(data []byte) (int, error) {
 	return 20, nil
 }
 
+var _ abi.Tuple = (*BalanceOfCall)(nil)
+var _ abi.Decoder = (*BalanceOfCall)(nil)
+var _ abi.PackedTuple = (*BalanceOfCall)(nil)
+
 // GetMethodName returns the function name
 func (t BalanceOfCall) GetMethodName() string {
 	return "balanceOf"
@@ -692,9 +1031,14 @@ func (t BalanceOfCall) GetMethodSelector() [4]byte {
 	return BalanceOfSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of balanceOf arguments including function selector
+func (t BalanceOfCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes balanceOf arguments to ABI bytes including function selector
 func (t BalanceOfCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], BalanceOfSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -702,6 +1046,84 @@ func (t BalanceOfCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes balanceOf arguments to 0x prefixed hex string
+func (t BalanceOfCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes balanceOf arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t BalanceOfCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the balanceOf calldata, returns 0 if encoding fails
+func (t BalanceOfCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes balanceOf arguments from ABI bytes including function selector
+func (t *BalanceOfCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BalanceOfSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes balanceOf arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *BalanceOfCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes balanceOf arguments to packed ABI bytes including function selector
+func (t BalanceOfCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], BalanceOfSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes balanceOf arguments from packed ABI bytes including function selector
+func (t *BalanceOfCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BalanceOfSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewBalanceOfCall constructs a new BalanceOfCall
 func NewBalanceOfCall(
 	account common.Address,
@@ -713,9 +1135,6 @@ func NewBalanceOfCall(
 
 const BalanceOfReturnStaticSize = 32
 
-var _ abi.Tuple = (*BalanceOfReturn)(nil)
-var _ abi.PackedTuple = (*BalanceOfReturn)(nil)
-
 // BalanceOfReturn represents an ABI tuple
 type BalanceOfReturn struct {
 	Field1 *big.Int
@@ -759,13 +1178,32 @@ func (t *BalanceOfReturn) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BalanceOfReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *BalanceOfReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes BalanceOfReturn from the hex string with an optional 0x prefix
+func (t *BalanceOfReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of BalanceOfReturn
 func (t BalanceOfReturn) PackedEncodedSize() int {
 	return 32
@@ -812,6 +1250,41 @@ func (t *BalanceOfReturn) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*BalanceOfReturn)(nil)
+var _ abi.Decoder = (*BalanceOfReturn)(nil)
+var _ abi.PackedTuple = (*BalanceOfReturn)(nil)
+
+// DecodeBalanceOfReturn decodes the return data of balanceOf into its values
+func DecodeBalanceOfReturn(data []byte) (r1 *big.Int, err error) {
+	var result BalanceOfReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeBalanceOf decodes the single return value of balanceOf
+func DecodeBalanceOf(data []byte) (*big.Int, error) {
+	return DecodeBalanceOfReturn(data)
+}
+
+// DecodeBalanceOfHex decodes the single return value of balanceOf from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeBalanceOfHex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode BalanceOfReturn: %w", err)
+	}
+	return DecodeBalanceOfReturn(data)
+}
+
+// EncodeBalanceOfResult encodes the single return value of balanceOf, e.g. for the return data of precompiles
+func EncodeBalanceOfResult(v *big.Int) ([]byte, error) {
+	result := BalanceOfReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*DecimalsCall)(nil)
 
 // DecimalsCall represents the input arguments for decimals function
@@ -834,9 +1307,14 @@ func (t DecimalsCall) GetMethodSelector() [4]byte {
 	return DecimalsSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of decimals arguments including function selector
+func (t DecimalsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes decimals arguments to ABI bytes including function selector
 func (t DecimalsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], DecimalsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -844,6 +1322,59 @@ func (t DecimalsCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes decimals arguments to 0x prefixed hex string
+func (t DecimalsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes decimals arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t DecimalsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the decimals calldata, returns 0 if encoding fails
+func (t DecimalsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes decimals arguments from ABI bytes including function selector
+func (t *DecimalsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != DecimalsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes decimals arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *DecimalsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode DecimalsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewDecimalsCall constructs a new DecimalsCall
 func NewDecimalsCall() *DecimalsCall {
 	return &DecimalsCall{}
@@ -851,9 +1382,6 @@ func NewDecimalsCall() *DecimalsCall {
 
 const DecimalsReturnStaticSize = 32
 
-var _ abi.Tuple = (*DecimalsReturn)(nil)
-var _ abi.PackedTuple = (*DecimalsReturn)(nil)
-
 // DecimalsReturn represents an ABI tuple
 type DecimalsReturn struct {
 	Field1 uint8
@@ -904,6 +1432,25 @@ func (t *DecimalsReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes DecimalsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *DecimalsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes DecimalsReturn from the hex string with an optional 0x prefix
+func (t *DecimalsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode DecimalsReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of DecimalsReturn
 func (t DecimalsReturn) PackedEncodedSize() int {
 	return 1
@@ -950,6 +1497,41 @@ func (t *DecimalsReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*DecimalsReturn)(nil)
+var _ abi.Decoder = (*DecimalsReturn)(nil)
+var _ abi.PackedTuple = (*DecimalsReturn)(nil)
+
+// DecodeDecimalsReturn decodes the return data of decimals into its values
+func DecodeDecimalsReturn(data []byte) (r1 uint8, err error) {
+	var result DecimalsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeDecimals decodes the single return value of decimals
+func DecodeDecimals(data []byte) (uint8, error) {
+	return DecodeDecimalsReturn(data)
+}
+
+// DecodeDecimalsHex decodes the single return value of decimals from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeDecimalsHex(s string) (uint8, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero uint8
+		return zero, fmt.Errorf("decode DecimalsReturn: %w", err)
+	}
+	return DecodeDecimalsReturn(data)
+}
+
+// EncodeDecimalsResult encodes the single return value of decimals, e.g. for the return data of precompiles
+func EncodeDecimalsResult(v uint8) ([]byte, error) {
+	result := DecimalsReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*NameCall)(nil)
 
 // NameCall represents the input arguments for name function
@@ -972,9 +1554,14 @@ func (t NameCall) GetMethodSelector() [4]byte {
 	return NameSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of name arguments including function selector
+func (t NameCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes name arguments to ABI bytes including function selector
 func (t NameCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], NameSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -982,6 +1569,59 @@ func (t NameCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes name arguments to 0x prefixed hex string
+func (t NameCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes name arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t NameCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the name calldata, returns 0 if encoding fails
+func (t NameCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes name arguments from ABI bytes including function selector
+func (t *NameCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != NameSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes name arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *NameCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode NameCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewNameCall constructs a new NameCall
 func NewNameCall() *NameCall {
 	return &NameCall{}
@@ -989,8 +1629,6 @@ func NewNameCall() *NameCall {
 
 const NameReturnStaticSize = 32
 
-var _ abi.Tuple = (*NameReturn)(nil)
-
 // NameReturn represents an ABI tuple
 type NameReturn struct {
 	Field1 string
@@ -1014,6 +1652,7 @@ func (value NameReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Field1, buf[dynamicOffset:])
@@ -1063,6 +1702,59 @@ func (t *NameReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes NameReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *NameReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes NameReturn from the hex string with an optional 0x prefix
+func (t *NameReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode NameReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*NameReturn)(nil)
+var _ abi.Decoder = (*NameReturn)(nil)
+
+// DecodeNameReturn decodes the return data of name into its values
+func DecodeNameReturn(data []byte) (r1 string, err error) {
+	var result NameReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeName decodes the single return value of name
+func DecodeName(data []byte) (string, error) {
+	return DecodeNameReturn(data)
+}
+
+// DecodeNameHex decodes the single return value of name from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeNameHex(s string) (string, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero string
+		return zero, fmt.Errorf("decode NameReturn: %w", err)
+	}
+	return DecodeNameReturn(data)
+}
+
+// EncodeNameResult encodes the single return value of name, e.g. for the return data of precompiles
+func EncodeNameResult(v string) ([]byte, error) {
+	result := NameReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*SymbolCall)(nil)
 
 // SymbolCall represents the input arguments for symbol function
@@ -1085,9 +1777,14 @@ func (t SymbolCall) GetMethodSelector() [4]byte {
 	return SymbolSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of symbol arguments including function selector
+func (t SymbolCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes symbol arguments to ABI bytes including function selector
 func (t SymbolCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], SymbolSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1095,6 +1792,59 @@ func (t SymbolCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes symbol arguments to 0x prefixed hex string
+func (t SymbolCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes symbol arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t SymbolCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the symbol calldata, returns 0 if encoding fails
+func (t SymbolCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes symbol arguments from ABI bytes including function selector
+func (t *SymbolCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SymbolSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes symbol arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *SymbolCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SymbolCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewSymbolCall constructs a new SymbolCall
 func NewSymbolCall() *SymbolCall {
 	return &SymbolCall{}
@@ -1102,8 +1852,6 @@ func NewSymbolCall() *SymbolCall {
 
 const SymbolReturnStaticSize = 32
 
-var _ abi.Tuple = (*SymbolReturn)(nil)
-
 // SymbolReturn represents an ABI tuple
 type SymbolReturn struct {
 	Field1 string
@@ -1127,6 +1875,7 @@ func (value SymbolReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Field1, buf[dynamicOffset:])
@@ -1176,6 +1925,59 @@ func (t *SymbolReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SymbolReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *SymbolReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes SymbolReturn from the hex string with an optional 0x prefix
+func (t *SymbolReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SymbolReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*SymbolReturn)(nil)
+var _ abi.Decoder = (*SymbolReturn)(nil)
+
+// DecodeSymbolReturn decodes the return data of symbol into its values
+func DecodeSymbolReturn(data []byte) (r1 string, err error) {
+	var result SymbolReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeSymbol decodes the single return value of symbol
+func DecodeSymbol(data []byte) (string, error) {
+	return DecodeSymbolReturn(data)
+}
+
+// DecodeSymbolHex decodes the single return value of symbol from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeSymbolHex(s string) (string, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero string
+		return zero, fmt.Errorf("decode SymbolReturn: %w", err)
+	}
+	return DecodeSymbolReturn(data)
+}
+
+// EncodeSymbolResult encodes the single return value of symbol, e.g. for the return data of precompiles
+func EncodeSymbolResult(v string) ([]byte, error) {
+	result := SymbolReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*TotalSupplyCall)(nil)
 
 // TotalSupplyCall represents the input arguments for totalSupply function
@@ -1198,9 +2000,14 @@ func (t TotalSupplyCall) GetMethodSelector() [4]byte {
 	return TotalSupplySelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of totalSupply arguments including function selector
+func (t TotalSupplyCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes totalSupply arguments to ABI bytes including function selector
 func (t TotalSupplyCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], TotalSupplySelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1208,6 +2015,59 @@ func (t TotalSupplyCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes totalSupply arguments to 0x prefixed hex string
+func (t TotalSupplyCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes totalSupply arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TotalSupplyCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the totalSupply calldata, returns 0 if encoding fails
+func (t TotalSupplyCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes totalSupply arguments from ABI bytes including function selector
+func (t *TotalSupplyCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TotalSupplySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes totalSupply arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TotalSupplyCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TotalSupplyCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewTotalSupplyCall constructs a new TotalSupplyCall
 func NewTotalSupplyCall() *TotalSupplyCall {
 	return &TotalSupplyCall{}
@@ -1215,9 +2075,6 @@ func NewTotalSupplyCall() *TotalSupplyCall {
 
 const TotalSupplyReturnStaticSize = 32
 
-var _ abi.Tuple = (*TotalSupplyReturn)(nil)
-var _ abi.PackedTuple = (*TotalSupplyReturn)(nil)
-
 // TotalSupplyReturn represents an ABI tuple
 type TotalSupplyReturn struct {
 	Field1 *big.Int
@@ -1261,13 +2118,32 @@ func (t *TotalSupplyReturn) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TotalSupplyReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TotalSupplyReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TotalSupplyReturn from the hex string with an optional 0x prefix
+func (t *TotalSupplyReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TotalSupplyReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TotalSupplyReturn
 func (t TotalSupplyReturn) PackedEncodedSize() int {
 	return 32
@@ -1314,13 +2190,45 @@ func (t *TotalSupplyReturn) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*TotalSupplyReturn)(nil)
+var _ abi.Decoder = (*TotalSupplyReturn)(nil)
+var _ abi.PackedTuple = (*TotalSupplyReturn)(nil)
+
+// DecodeTotalSupplyReturn decodes the return data of totalSupply into its values
+func DecodeTotalSupplyReturn(data []byte) (r1 *big.Int, err error) {
+	var result TotalSupplyReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTotalSupply decodes the single return value of totalSupply
+func DecodeTotalSupply(data []byte) (*big.Int, error) {
+	return DecodeTotalSupplyReturn(data)
+}
+
+// DecodeTotalSupplyHex decodes the single return value of totalSupply from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTotalSupplyHex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode TotalSupplyReturn: %w", err)
+	}
+	return DecodeTotalSupplyReturn(data)
+}
+
+// EncodeTotalSupplyResult encodes the single return value of totalSupply, e.g. for the return data of precompiles
+func EncodeTotalSupplyResult(v *big.Int) ([]byte, error) {
+	result := TotalSupplyReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*TransferCall)(nil)
 
 const TransferCallStaticSize = 64
 
-var _ abi.Tuple = (*TransferCall)(nil)
-var _ abi.PackedTuple = (*TransferCall)(nil)
-
 // TransferCall represents an ABI tuple
 type TransferCall struct {
 	To     common.Address
@@ -1375,13 +2283,31 @@ func (t *TransferCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferCall from the hex string with an optional 0x prefix
+func (t *TransferCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferCall
 func (t TransferCall) PackedEncodedSize() int {
 	return 52
@@ -1440,6 +2366,10 @@ func (t *TransferCall) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*TransferCall)(nil)
+var _ abi.Decoder = (*TransferCall)(nil)
+var _ abi.PackedTuple = (*TransferCall)(nil)
+
 // GetMethodName returns the function name
 func (t TransferCall) GetMethodName() string {
 	return "transfer"
@@ -1455,9 +2385,14 @@ func (t TransferCall) GetMethodSelector() [4]byte {
 	return TransferSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of transfer arguments including function selector
+func (t TransferCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes transfer arguments to ABI bytes including function selector
 func (t TransferCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], TransferSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1465,6 +2400,84 @@ func (t TransferCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes transfer arguments to 0x prefixed hex string
+func (t TransferCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes transfer arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TransferCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the transfer calldata, returns 0 if encoding fails
+func (t TransferCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes transfer arguments from ABI bytes including function selector
+func (t *TransferCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes transfer arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TransferCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes transfer arguments to packed ABI bytes including function selector
+func (t TransferCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TransferSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes transfer arguments from packed ABI bytes including function selector
+func (t *TransferCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewTransferCall constructs a new TransferCall
 func NewTransferCall(
 	to common.Address,
@@ -1478,9 +2491,6 @@ func NewTransferCall(
 
 const TransferReturnStaticSize = 32
 
-var _ abi.Tuple = (*TransferReturn)(nil)
-var _ abi.PackedTuple = (*TransferReturn)(nil)
-
 // TransferReturn represents an ABI tuple
 type TransferReturn struct {
 	Field1 bool
@@ -1531,6 +2541,25 @@ func (t *TransferReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TransferReturn from the hex string with an optional 0x prefix
+func (t *TransferReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferReturn
 func (t TransferReturn) PackedEncodedSize() int {
 	return 1
@@ -1577,13 +2606,45 @@ func (t *TransferReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*TransferReturn)(nil)
+var _ abi.Decoder = (*TransferReturn)(nil)
+var _ abi.PackedTuple = (*TransferReturn)(nil)
+
+// DecodeTransferReturn decodes the return data of transfer into its values
+func DecodeTransferReturn(data []byte) (r1 bool, err error) {
+	var result TransferReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTransfer decodes the single return value of transfer
+func DecodeTransfer(data []byte) (bool, error) {
+	return DecodeTransferReturn(data)
+}
+
+// DecodeTransferHex decodes the single return value of transfer from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTransferHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TransferReturn: %w", err)
+	}
+	return DecodeTransferReturn(data)
+}
+
+// EncodeTransferResult encodes the single return value of transfer, e.g. for the return data of precompiles
+func EncodeTransferResult(v bool) ([]byte, error) {
+	result := TransferReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*TransferFromCall)(nil)
 
 const TransferFromCallStaticSize = 96
 
-var _ abi.Tuple = (*TransferFromCall)(nil)
-var _ abi.PackedTuple = (*TransferFromCall)(nil)
-
 // TransferFromCall represents an ABI tuple
 type TransferFromCall struct {
 	From   common.Address
@@ -1649,13 +2710,31 @@ func (t *TransferFromCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[64:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[64:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferFromCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferFromCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferFromCall from the hex string with an optional 0x prefix
+func (t *TransferFromCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferFromCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferFromCall
 func (t TransferFromCall) PackedEncodedSize() int {
 	return 72
@@ -1726,6 +2805,10 @@ func (t *TransferFromCall) PackedDecode(data []byte) (int, error) {
 	return 72, nil
 }
 
+var _ abi.Tuple = (*TransferFromCall)(nil)
+var _ abi.Decoder = (*TransferFromCall)(nil)
+var _ abi.PackedTuple = (*TransferFromCall)(nil)
+
 // GetMethodName returns the function name
 func (t TransferFromCall) GetMethodName() string {
 	return "transferFrom"
@@ -1741,9 +2824,14 @@ func (t TransferFromCall) GetMethodSelector() [4]byte {
 	return TransferFromSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of transferFrom arguments including function selector
+func (t TransferFromCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes transferFrom arguments to ABI bytes including function selector
 func (t TransferFromCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], TransferFromSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1751,6 +2839,84 @@ func (t TransferFromCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes transferFrom arguments to 0x prefixed hex string
+func (t TransferFromCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes transferFrom arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TransferFromCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the transferFrom calldata, returns 0 if encoding fails
+func (t TransferFromCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes transferFrom arguments from ABI bytes including function selector
+func (t *TransferFromCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferFromSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes transferFrom arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TransferFromCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferFromCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes transferFrom arguments to packed ABI bytes including function selector
+func (t TransferFromCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TransferFromSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes transferFrom arguments from packed ABI bytes including function selector
+func (t *TransferFromCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferFromSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewTransferFromCall constructs a new TransferFromCall
 func NewTransferFromCall(
 	from common.Address,
@@ -1766,9 +2932,6 @@ func NewTransferFromCall(
 
 const TransferFromReturnStaticSize = 32
 
-var _ abi.Tuple = (*TransferFromReturn)(nil)
-var _ abi.PackedTuple = (*TransferFromReturn)(nil)
-
 // TransferFromReturn represents an ABI tuple
 type TransferFromReturn struct {
 	Field1 bool
@@ -1819,6 +2982,25 @@ func (t *TransferFromReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferFromReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferFromReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TransferFromReturn from the hex string with an optional 0x prefix
+func (t *TransferFromReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferFromReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferFromReturn
 func (t TransferFromReturn) PackedEncodedSize() int {
 	return 1
@@ -1865,6 +3047,76 @@ func (t *TransferFromReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*TransferFromReturn)(nil)
+var _ abi.Decoder = (*TransferFromReturn)(nil)
+var _ abi.PackedTuple = (*TransferFromReturn)(nil)
+
+// DecodeTransferFromReturn decodes the return data of transferFrom into its values
+func DecodeTransferFromReturn(data []byte) (r1 bool, err error) {
+	var result TransferFromReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTransferFrom decodes the single return value of transferFrom
+func DecodeTransferFrom(data []byte) (bool, error) {
+	return DecodeTransferFromReturn(data)
+}
+
+// DecodeTransferFromHex decodes the single return value of transferFrom from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTransferFromHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TransferFromReturn: %w", err)
+	}
+	return DecodeTransferFromReturn(data)
+}
+
+// EncodeTransferFromResult encodes the single return value of transferFrom, e.g. for the return data of precompiles
+func EncodeTransferFromResult(v bool) ([]byte, error) {
+	result := TransferFromReturn{Field1: v}
+	return result.Encode()
+}
+
+// DecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func DecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case AllowanceSelector:
+		call = new(AllowanceCall)
+	case ApproveSelector:
+		call = new(ApproveCall)
+	case BalanceOfSelector:
+		call = new(BalanceOfCall)
+	case DecimalsSelector:
+		call = new(DecimalsCall)
+	case NameSelector:
+		call = new(NameCall)
+	case SymbolSelector:
+		call = new(SymbolCall)
+	case TotalSupplySelector:
+		call = new(TotalSupplyCall)
+	case TransferSelector:
+		call = new(TransferCall)
+	case TransferFromSelector:
+		call = new(TransferFromCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
 // Event signatures
 var (
 	// Approval(address,address,uint256)
@@ -1873,6 +3125,30 @@ var (
 	TransferEventTopic = common.Hash{0xdd, 0xf2, 0x52, 0xad, 0x1b, 0xe2, 0xc8, 0x9b, 0x69, 0xc2, 0xb0, 0x68, 0xfc, 0x37, 0x8d, 0xaa, 0x95, 0x2b, 0xa7, 0xf1, 0x63, 0xc4, 0xa1, 0x16, 0x28, 0xf5, 0x5a, 0x4d, 0xf5, 0x23, 0xb3, 0xef}
 )
 
+// Event IDs, the same hashes as the topics, named like go-ethereum's abi.Event.ID
+var (
+	ApprovalEventID = ApprovalEventTopic
+	TransferEventID = TransferEventTopic
+)
+
+// Canonical event signatures
+const (
+	ApprovalEventSignature = "Approval(address,address,uint256)"
+	TransferEventSignature = "Transfer(address,address,uint256)"
+)
+
+// Events maps event topics to event names
+var Events = map[common.Hash]string{
+	ApprovalEventTopic: "Approval",
+	TransferEventTopic: "Transfer",
+}
+
+// EventTopics maps event topics to the canonical event signatures
+var EventTopics = map[common.Hash]string{
+	ApprovalEventTopic: ApprovalEventSignature,
+	TransferEventTopic: TransferEventSignature,
+}
+
 // ApprovalEvent represents the Approval event
 var _ abi.Event = (*ApprovalEvent)(nil)
 
@@ -1937,13 +3213,13 @@ func (e ApprovalEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of Approval event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of Approval event from topics, hash topics are stored as is
 func (e *ApprovalEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 3 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(3, len(topics))
 	}
 	if topics[0] != ApprovalEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.Owner, _, err = abi.DecodeAddress(topics[1][:])
@@ -1959,9 +3235,6 @@ func (e *ApprovalEventIndexed) DecodeTopics(topics []common.Hash) error {
 
 const ApprovalEventDataStaticSize = 32
 
-var _ abi.Tuple = (*ApprovalEventData)(nil)
-var _ abi.PackedTuple = (*ApprovalEventData)(nil)
-
 // ApprovalEventData represents an ABI tuple
 type ApprovalEventData struct {
 	Value *big.Int
@@ -2005,13 +3278,31 @@ func (t *ApprovalEventData) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Value: uint256
-	t.Value, _, err = abi.DecodeUint256(data[0:])
+	t.Value, _, err = abi.DecodeIntoUint256(t.Value, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes ApprovalEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *ApprovalEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes ApprovalEventData from the hex string with an optional 0x prefix
+func (t *ApprovalEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ApprovalEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of ApprovalEventData
 func (t ApprovalEventData) PackedEncodedSize() int {
 	return 32
@@ -2058,6 +3349,10 @@ func (t *ApprovalEventData) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*ApprovalEventData)(nil)
+var _ abi.Decoder = (*ApprovalEventData)(nil)
+var _ abi.PackedTuple = (*ApprovalEventData)(nil)
+
 // TransferEvent represents the Transfer event
 var _ abi.Event = (*TransferEvent)(nil)
 
@@ -2122,13 +3417,13 @@ func (e TransferEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of Transfer event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of Transfer event from topics, hash topics are stored as is
 func (e *TransferEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 3 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(3, len(topics))
 	}
 	if topics[0] != TransferEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.From, _, err = abi.DecodeAddress(topics[1][:])
@@ -2144,9 +3439,6 @@ func (e *TransferEventIndexed) DecodeTopics(topics []common.Hash) error {
 
 const TransferEventDataStaticSize = 32
 
-var _ abi.Tuple = (*TransferEventData)(nil)
-var _ abi.PackedTuple = (*TransferEventData)(nil)
-
 // TransferEventData represents an ABI tuple
 type TransferEventData struct {
 	Value *big.Int
@@ -2190,13 +3482,31 @@ func (t *TransferEventData) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Value: uint256
-	t.Value, _, err = abi.DecodeUint256(data[0:])
+	t.Value, _, err = abi.DecodeIntoUint256(t.Value, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferEventData from the hex string with an optional 0x prefix
+func (t *TransferEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferEventData
 func (t TransferEventData) PackedEncodedSize() int {
 	return 32
@@ -2242,3 +3552,20 @@ func (t *TransferEventData) PackedDecode(data []byte) (int, error) {
 	}
 	return 32, nil
 }
+
+var _ abi.Tuple = (*TransferEventData)(nil)
+var _ abi.Decoder = (*TransferEventData)(nil)
+var _ abi.PackedTuple = (*TransferEventData)(nil)
+
+// Selectors maps the function and error selectors to the canonical signatures
+var Selectors = map[[4]byte]string{
+	AllowanceSelector:    AllowanceSignature,
+	ApproveSelector:      ApproveSignature,
+	BalanceOfSelector:    BalanceOfSignature,
+	DecimalsSelector:     DecimalsSignature,
+	NameSelector:         NameSignature,
+	SymbolSelector:       SymbolSignature,
+	TotalSupplySelector:  TotalSupplySignature,
+	TransferSelector:     TransferSignature,
+	TransferFromSelector: TransferFromSignature,
+}
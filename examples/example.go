@@ -17,7 +17,7 @@ var ERC20ABI = []string{
 	"event Approval(address indexed owner, address indexed spender, uint256 value)",
 }
 
-//go:generate go run ../cmd -var SimpleABI -output simple.abi.go
+//go:generate go run ../cmd -var SimpleABI -output simple.abi.go -prefix simple
 
 // SimpleABI contains a single function definition
 var SimpleABI = "function send(address to, uint256 amount)"
@@ -1,10 +1,11 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: a71d22d924fbc92ad9dd6d334785cc5b65599220983e64b254e3e88825b5cf8c
 
 package examples
 
 import (
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -23,13 +24,15 @@ const (
 	SendID = 3496451380
 )
 
+// Canonical function signatures
+const (
+	SendSignature = "send(address,uint256)"
+)
+
 var _ abi.Method = (*SendCall)(nil)
 
 const SendCallStaticSize = 64
 
-var _ abi.Tuple = (*SendCall)(nil)
-var _ abi.PackedTuple = (*SendCall)(nil)
-
 // SendCall represents an ABI tuple
 type SendCall struct {
 	To     common.Address
@@ -84,13 +87,31 @@ func (t *SendCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SendCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *SendCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes SendCall from the hex string with an optional 0x prefix
+func (t *SendCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SendCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of SendCall
 func (t SendCall) PackedEncodedSize() int {
 	return 52
@@ -149,6 +170,10 @@ func (t *SendCall) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*SendCall)(nil)
+var _ abi.Decoder = (*SendCall)(nil)
+var _ abi.PackedTuple = (*SendCall)(nil)
+
 // GetMethodName returns the function name
 func (t SendCall) GetMethodName() string {
 	return "send"
@@ -164,9 +189,14 @@ func (t SendCall) GetMethodSelector() [4]byte {
 	return SendSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of send arguments including function selector
+func (t SendCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes send arguments to ABI bytes including function selector
 func (t SendCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], SendSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -174,6 +204,84 @@ func (t SendCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes send arguments to 0x prefixed hex string
+func (t SendCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes send arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t SendCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the send calldata, returns 0 if encoding fails
+func (t SendCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes send arguments from ABI bytes including function selector
+func (t *SendCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SendSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes send arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *SendCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SendCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes send arguments to packed ABI bytes including function selector
+func (t SendCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], SendSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes send arguments from packed ABI bytes including function selector
+func (t *SendCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SendSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewSendCall constructs a new SendCall
 func NewSendCall(
 	to common.Address,
@@ -189,3 +297,27 @@ func NewSendCall(
 type SendReturn struct {
 	abi.EmptyTuple
 }
+
+// SimpleDecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func SimpleDecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case SendSelector:
+		call = new(SendCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// SimpleSelectors maps the function and error selectors to the canonical signatures
+var SimpleSelectors = map[[4]byte]string{
+	SendSelector: SendSignature,
+}
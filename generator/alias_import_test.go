@@ -69,3 +69,51 @@ func TestAliasImports(t *testing.T) {
 		t.Error("Expected regular import '\"time\"' not found")
 	}
 }
+
+func TestStdPrefix(t *testing.T) {
+	abiDef, err := ethabi.JSON(strings.NewReader(`[
+		{"name": "test", "type": "function", "inputs": [{"name": "values", "type": "uint256[]"}, {"name": "memo", "type": "string"}], "outputs": []}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := NewGenerator(StdPrefix("myabi."), StdImport("example.com/fork/abi")).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, `myabi "example.com/fork/abi"`) {
+		t.Error("Expected the fork to be imported as myabi")
+	}
+	if strings.Contains(code, "github.com/yihuang/go-abi") {
+		t.Error("Unexpected import of github.com/yihuang/go-abi")
+	}
+	for _, helper := range []string{"myabi.DecodeSize", "myabi.Method", "myabi.ErrInvalidOffsetForDynamicField"} {
+		if !strings.Contains(code, helper) {
+			t.Errorf("Expected %s in the generated code", helper)
+		}
+	}
+	if strings.Contains(code, " abi.") || strings.Contains(code, "(abi.") || strings.Contains(code, "\tabi.") {
+		t.Error("Unexpected abi. qualifier in the generated code")
+	}
+
+	// the default import is aliased if only the prefix changes
+	code, err = NewGenerator(StdPrefix("goabi.")).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, `goabi "github.com/yihuang/go-abi"`) {
+		t.Error("Expected github.com/yihuang/go-abi to be imported as goabi")
+	}
+
+	for _, opts := range [][]Option{
+		{StdPrefix("myabi")},
+		{StdPrefix("my-abi.")},
+		{StdPrefix("_.")},
+		{StdPrefix("myabi."), Stdlib(true)},
+	} {
+		if _, err := NewGenerator(opts...).GenerateFromABI(abiDef); err == nil {
+			t.Errorf("Expected error for options %+v", NewOptions(opts...))
+		}
+	}
+}
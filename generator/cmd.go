@@ -2,14 +2,21 @@ package generator
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/yihuang/go-abi"
@@ -18,7 +25,55 @@ import (
 
 // Command runs the original generator
 func Command(inputFile, varName string, artifactInput bool, outputFile string, opts ...Option) {
-	var abiDef ethabi.ABI
+	if NewOptions(opts...).Combined {
+		generateContracts(inputFile, outputFile, opts...)
+		return
+	}
+	if inputFiles := strings.Split(inputFile, ","); len(inputFiles) > 1 {
+		generateMerged(inputFiles, varName, artifactInput, outputFile, opts...)
+		return
+	}
+	generate(loadABI(inputFile, varName, artifactInput, NewOptions(opts...).ContractTypes), outputFile, opts...)
+}
+
+// DiffCommand reports the changes of the bindings generated from inputFile against the ones generated
+// from oldFile, the summary is printed to stderr and the JSON report is written to outputFile, or stdout
+// if empty, exits with status 1 if there are breaking changes.
+func DiffCommand(oldFile, inputFile, varName string, artifactInput bool, outputFile string, opts ...Option) {
+	contractTypes := NewOptions(opts...).ContractTypes
+	oldDef, err := ethabi.JSON(bytes.NewReader(loadABI(oldFile, varName, artifactInput, contractTypes)))
+	if err != nil {
+		log.Fatalf("Failed to parse old ABI JSON: %v", err)
+	}
+	newDef, err := ethabi.JSON(bytes.NewReader(loadABI(inputFile, varName, artifactInput, contractTypes)))
+	if err != nil {
+		log.Fatalf("Failed to parse ABI JSON: %v", err)
+	}
+
+	report := Diff(oldDef, newDef, opts...)
+	if err := WriteDiffSummary(os.Stderr, report); err != nil {
+		log.Fatalf("Failed to write diff summary: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDiffJSON(&buf, report); err != nil {
+		log.Fatalf("Failed to write diff report: %v", err)
+	}
+	if outputFile == "" {
+		os.Stdout.Write(buf.Bytes())
+	} else if err := os.WriteFile(outputFile, buf.Bytes(), 0644); err != nil {
+		log.Fatalf("Failed to write output file: %v", err)
+	}
+
+	if report.Breaking() {
+		os.Exit(1)
+	}
+}
+
+// loadABI reads the JSON ABI from inputFile, the human-readable ABI in Go source and the
+// Solidity interfaces are converted to JSON with the contractTypes mapped to address.
+func loadABI(inputFile, varName string, artifactInput bool, contractTypes []string) []byte {
+	var abiJSON []byte
 	var err error
 
 	// Determine input type by file extension
@@ -27,13 +82,13 @@ func Command(inputFile, varName string, artifactInput bool, outputFile string, o
 		if varName == "" {
 			log.Fatal("-var flag is required when input is a Go source file")
 		}
-		abiDef, err = parseHumanReadableABIFromFile(inputFile, varName)
+		abiJSON, err = parseHumanReadableABIFromFile(inputFile, varName, contractTypes...)
 		if err != nil {
 			log.Fatalf("Failed to parse human-readable ABI from variable %s in file %s: %v", varName, inputFile, err)
 		}
 	} else if strings.HasSuffix(inputFile, ".json") {
 		// JSON ABI file
-		abiJSON, err := os.ReadFile(inputFile)
+		abiJSON, err = os.ReadFile(inputFile)
 		if err != nil {
 			log.Fatalf("Failed to read input file: %v", err)
 		}
@@ -54,53 +109,285 @@ func Command(inputFile, varName string, artifactInput bool, outputFile string, o
 			}
 		}
 
-		abiDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+		abiJSON, err = UnwrapExplorerABI(abiJSON)
 		if err != nil {
 			log.Fatalf("Failed to parse ABI JSON: %v", err)
 		}
+	} else if strings.HasSuffix(inputFile, ".sol") {
+		// Solidity interface source
+		src, err := os.ReadFile(inputFile)
+		if err != nil {
+			log.Fatalf("Failed to read input file: %v", err)
+		}
+		abiJSON, err = abi.ParseSolidityInterface(string(src), contractTypes...)
+		if err != nil {
+			log.Fatalf("Failed to parse Solidity interface in file %s: %v", inputFile, err)
+		}
 	} else {
-		log.Fatalf("Unsupported input file type: %s (expected .go or .json)", inputFile)
+		log.Fatalf("Unsupported input file type: %s (expected .go, .json or .sol)", inputFile)
+	}
+
+	return abiJSON
+}
+
+// CommandFromURL runs the generator on the JSON ABI fetched from url
+func CommandFromURL(url string, timeout time.Duration, outputFile string, opts ...Option) {
+	abiJSON, err := FetchABI(url, timeout)
+	if err != nil {
+		log.Fatalf("Failed to fetch ABI from %s: %v", url, err)
+	}
+
+	generate(abiJSON, outputFile, opts...)
+}
+
+// generate generates code from abiJSON and writes it to outputFile, see generateABI
+func generate(abiJSON []byte, outputFile string, opts ...Option) {
+	abiDef, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		log.Fatalf("Failed to parse ABI JSON: %v", err)
 	}
 
 	// Generate code
-	var generatedCode string
 	gen := NewGenerator(opts...)
-	generatedCode, err = gen.GenerateFromABI(abiDef)
+	if gen.Options.Enums {
+		if err := MarkEnums(abiDef, abiJSON); err != nil {
+			log.Fatalf("Failed to parse enums: %v", err)
+		}
+	}
+	generateABI(gen, abiDef, abiJSON, outputFile)
+}
+
+// generateMerged generates a single package from the ABIs of multiple input files, the identical
+// items are deduplicated, see MergeABIs
+func generateMerged(inputFiles []string, varName string, artifactInput bool, outputFile string, opts ...Option) {
+	gen := NewGenerator(opts...)
+	abis := make(map[string]ethabi.ABI, len(inputFiles))
+	var inputs []byte
+	for _, inputFile := range inputFiles {
+		abiJSON := loadABI(inputFile, varName, artifactInput, gen.Options.ContractTypes)
+		abiDef, err := ethabi.JSON(bytes.NewReader(abiJSON))
+		if err != nil {
+			log.Fatalf("Failed to parse ABI JSON of %s: %v", inputFile, err)
+		}
+		if gen.Options.Enums {
+			if err := MarkEnums(abiDef, abiJSON); err != nil {
+				log.Fatalf("Failed to parse enums of %s: %v", inputFile, err)
+			}
+		}
+		abis[inputFile] = abiDef
+		inputs = append(inputs, abiJSON...)
+	}
+
+	abiDef, err := gen.MergeABIs(abis)
 	if err != nil {
-		log.Printf("Raw generated code before formatting:%s\n", generatedCode)
-		log.Fatalf("Failed to generate code: %v", err)
+		log.Fatalf("Failed to merge ABIs: %v", err)
 	}
+	generateABI(gen, abiDef, inputs, outputFile)
+}
 
-	// Write output
-	if outputFile == "" {
-		fmt.Println(generatedCode)
+// generateABI generates code from abiDef parsed from abiJSON and writes it to outputFile, or stdout if empty,
+// the output is left untouched if it's generated from the same input hash, unless forced.
+func generateABI(gen *Generator, abiDef ethabi.ABI, abiJSON []byte, outputFile string) {
+	if gen.Options.Report != "" {
+		if err := WriteReportFile(gen.Options.Report, NewReport(abiDef)); err != nil {
+			log.Fatalf("Failed to write report: %v", err)
+		}
+	}
+
+	if outputFile != "" {
+		gen.InputHash = InputHash(abiJSON, gen.Options)
+		if !gen.Options.Force && !gen.Options.Split && upToDate(gen, outputFile) {
+			fmt.Printf("Generated code in %s is up to date\n", outputFile)
+			return
+		}
+	}
+
+	if gen.Options.Split {
+		generateFiles(gen, abiDef, outputFile)
 		return
 	}
 
+	generatedCode, err := gen.GenerateFromABI(abiDef)
+	if err != nil {
+		log.Printf("Raw generated code before formatting:%s\n", generatedCode)
+		log.Fatalf("Failed to generate code: %v", err)
+	}
+	printWarnings(gen)
+
 	opt := imports.Options{
 		Comments: true,
 	}
 	formatted, err := imports.Process(outputFile, []byte(generatedCode), &opt)
 	if err != nil {
-		log.Printf("Raw generated code before formatting:%s\n", generatedCode)
-		log.Fatalf("failed to format generated code: %v", err)
+		log.Fatalf("failed to format generated code: %v", formatError([]byte(generatedCode), err))
 	}
 
-	if err := os.WriteFile(outputFile, formatted, 0644); err != nil {
+	// Write output
+	if outputFile == "" {
+		if _, err := os.Stdout.Write(formatted); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
+		}
+		return
+	}
+
+	if err := writeFileIfChanged(outputFile, formatted); err != nil {
 		log.Fatalf("Failed to write output file: %v", err)
 	}
 	fmt.Printf("Generated code written to %s\n", outputFile)
+
+	if fuzzTest := gen.GenerateFuzzTest(); fuzzTest != "" {
+		fuzzFile := fuzzTestFile(outputFile)
+		formatted, err := imports.Process(fuzzFile, []byte(fuzzTest), &opt)
+		if err != nil {
+			log.Fatalf("failed to format generated code: %v", formatError([]byte(fuzzTest), err))
+		}
+		if err := writeFileIfChanged(fuzzFile, formatted); err != nil {
+			log.Fatalf("Failed to write output file: %v", err)
+		}
+		fmt.Printf("Generated fuzz test written to %s\n", fuzzFile)
+	}
 }
 
-// parseHumanReadableABIFromFile parses a Go source file and extracts human-readable ABI from a variable
-func parseHumanReadableABIFromFile(filename, varName string) (ethabi.ABI, error) {
+// formatError adds the lines around the first syntax error of the generated src to err
+func formatError(src []byte, err error) error {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) || len(list) == 0 {
+		return err
+	}
+
+	const context = 3
+	lines := strings.Split(string(src), "\n")
+	line := list[0].Pos.Line
+	var b strings.Builder
+	for i := max(line-context, 1); i <= min(line+context, len(lines)); i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&b, "\n%s %5d | %s", marker, i, lines[i-1])
+	}
+	return fmt.Errorf("%w%s", err, b.String())
+}
+
+// fuzzTestFile returns the path of the fuzz test generated next to the output file
+func fuzzTestFile(outputFile string) string {
+	return strings.TrimSuffix(outputFile, ".go") + "_fuzz_test.go"
+}
+
+// InputHash returns the hex sha256 hash of the effective inputs of a generation: the ABI JSON,
+// the options except Force, and the generator version.
+func InputHash(abiJSON []byte, opts Options) string {
+	opts.Force = false
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%+v\n", generatorVersion(), opts)
+	h.Write(abiJSON)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sources are the source files of the generator, hashed into the input hash
+//
+//go:embed *.go
+var sources embed.FS
+
+// generatorVersion returns the hash of the source files of the generator, excluding the tests, so changes
+// to the generator invalidate the input hash while every build of the same sources has the same version.
+func generatorVersion() string {
+	entries, err := sources.ReadDir(".")
+	if err != nil {
+		return "unknown"
+	}
+	h := sha256.New()
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+		data, err := sources.ReadFile(entry.Name())
+		if err != nil {
+			return "unknown"
+		}
+		fmt.Fprintf(h, "%s %d\n", entry.Name(), len(data))
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// upToDate returns if the output file, and the fuzz test with the test helpers enabled,
+// are generated from the input hash of the generator
+func upToDate(gen *Generator, outputFile string) bool {
+	files := []string{outputFile}
+	if gen.Options.TestHelpers {
+		files = append(files, fuzzTestFile(outputFile))
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil || !bytes.Contains(content, []byte(inputHashComment(gen.InputHash))) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeFileIfChanged writes the file unless it already has the same content,
+// to keep its modification time
+func writeFileIfChanged(name string, content []byte) error {
+	if existing, err := os.ReadFile(name); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	return os.WriteFile(name, content, 0644)
+}
+
+// printWarnings logs the warnings of the last generation
+func printWarnings(gen *Generator) {
+	for _, warning := range gen.Warnings {
+		log.Printf("warning: %s", warning)
+	}
+}
+
+// parseHumanReadableABIFromFile parses a Go source file and converts the human-readable ABI
+// in a variable to JSON ABI, varName can be a comma-separated list of variables which are merged
+// in order, so the struct definitions in one of them can be used by the following ones.
+func parseHumanReadableABIFromFile(filename, varName string, contractTypes ...string) ([]byte, error) {
 	// Parse the Go source file
 	fset := token.NewFileSet()
 	node, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
 	if err != nil {
-		return ethabi.ABI{}, fmt.Errorf("failed to parse Go file: %w", err)
+		return nil, fmt.Errorf("failed to parse Go file: %w", err)
 	}
 
+	varNames := strings.Split(varName, ",")
+	if len(varNames) == 1 {
+		abiLines, err := humanReadableLines(node, varName)
+		if err != nil {
+			return nil, err
+		}
+
+		// Parse human-readable ABI
+		abiJSON, err := abi.ParseHumanReadableABI(abiLines, contractTypes...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse human-readable ABI: %w", err)
+		}
+		return abiJSON, nil
+	}
+
+	builder := abi.NewHumanABIBuilder(contractTypes...)
+	for _, name := range varNames {
+		abiLines, err := humanReadableLines(node, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		if err := builder.AddFragments(abiLines); err != nil {
+			return nil, fmt.Errorf("failed to parse human-readable ABI in variable %s: %w", name, err)
+		}
+	}
+	abiJSON, err := builder.BuildJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge variables %s: %w", varName, err)
+	}
+	return abiJSON, nil
+}
+
+// humanReadableLines extracts the string lines of a variable declared in a Go source file
+func humanReadableLines(node *ast.File, varName string) ([]string, error) {
 	// Find the specified variable
 	var abiLines []string
 	ast.Inspect(node, func(n ast.Node) bool {
@@ -133,15 +420,83 @@ func parseHumanReadableABIFromFile(filename, varName string) (ethabi.ABI, error)
 	})
 
 	if len(abiLines) == 0 {
-		return ethabi.ABI{}, fmt.Errorf("variable %s not found or has no string value", varName)
+		return nil, fmt.Errorf("variable %s not found or has no string value", varName)
 	}
+	return abiLines, nil
+}
 
-	// Parse human-readable ABI
-	abiJSON, err := abi.ParseHumanReadableABI(abiLines)
+// generateFiles generates code split into files by category and writes them into outputDir
+func generateFiles(gen *Generator, abiDef ethabi.ABI, outputDir string) {
+	if outputDir == "" {
+		log.Fatal("-output directory is required with -split")
+	}
+
+	files, err := gen.GenerateFiles(abiDef)
 	if err != nil {
-		return ethabi.ABI{}, fmt.Errorf("failed to parse human-readable ABI: %w", err)
+		log.Fatalf("Failed to generate code: %v", err)
 	}
+	printWarnings(gen)
 
-	// Convert to go-ethereum ABI
-	return ethabi.JSON(bytes.NewReader(abiJSON))
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+	for _, name := range SortedMapKeys(files) {
+		outputFile := filepath.Join(outputDir, name)
+		if err := writeFileIfChanged(outputFile, []byte(files[name])); err != nil {
+			log.Fatalf("Failed to write output file: %v", err)
+		}
+		fmt.Printf("Generated code written to %s\n", outputFile)
+	}
+}
+
+// generateContracts generates the code of each contract of the combined ABI in inputFile and the shared
+// items into outputDir, see GenerateContracts
+func generateContracts(inputFile, outputDir string, opts ...Option) {
+	if outputDir == "" {
+		log.Fatal("-output directory is required with -combined")
+	}
+	if strings.Contains(inputFile, ",") {
+		log.Fatal("-combined takes a single input file")
+	}
+
+	data, err := os.ReadFile(inputFile)
+	if err != nil {
+		log.Fatalf("Failed to read input file: %v", err)
+	}
+	abiJSONs, err := ParseCombinedABI(data)
+	if err != nil {
+		log.Fatalf("Failed to parse combined ABI: %v", err)
+	}
+
+	gen := NewGenerator(opts...)
+	contracts := make(map[string]ethabi.ABI, len(abiJSONs))
+	for _, name := range SortedMapKeys(abiJSONs) {
+		abiDef, err := ethabi.JSON(bytes.NewReader(abiJSONs[name]))
+		if err != nil {
+			log.Fatalf("Failed to parse ABI JSON of contract %s: %v", name, err)
+		}
+		if gen.Options.Enums {
+			if err := MarkEnums(abiDef, abiJSONs[name]); err != nil {
+				log.Fatalf("Failed to parse enums of contract %s: %v", name, err)
+			}
+		}
+		contracts[name] = abiDef
+	}
+
+	files, err := gen.GenerateContracts(contracts)
+	if err != nil {
+		log.Fatalf("Failed to generate code: %v", err)
+	}
+	printWarnings(gen)
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+	for _, name := range SortedMapKeys(files) {
+		outputFile := filepath.Join(outputDir, name)
+		if err := writeFileIfChanged(outputFile, []byte(files[name])); err != nil {
+			log.Fatalf("Failed to write output file: %v", err)
+		}
+		fmt.Printf("Generated code written to %s\n", outputFile)
+	}
 }
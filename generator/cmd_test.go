@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/tools/imports"
+)
+
+func TestCommandSkipsUpToDateOutput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "token.abi.json")
+	output := filepath.Join(dir, "token.abi.go")
+
+	writeABI := func(abiJSON string) {
+		if err := os.WriteFile(input, []byte(abiJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	modTime := func() time.Time {
+		info, err := os.Stat(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return info.ModTime()
+	}
+	// backdate the output so a rewrite is detectable regardless of the file system time resolution
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	backdate := func() {
+		if err := os.Chtimes(output, past, past); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeABI(`[{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"}],"outputs":[]}]`)
+	Command(input, "", false, output, PackageName("token"))
+	content, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "// Input hash: ") {
+		t.Fatal("Expected the input hash in the header")
+	}
+
+	backdate()
+	Command(input, "", false, output, PackageName("token"))
+	if !modTime().Equal(past) {
+		t.Error("Expected the up to date output to be left untouched")
+	}
+
+	// different options change the input hash
+	Command(input, "", false, output, PackageName("token"), JSONTags(true))
+	if modTime().Equal(past) {
+		t.Error("Expected the output to be regenerated with different options")
+	}
+
+	// forced regeneration of the same content keeps the file
+	backdate()
+	Command(input, "", false, output, PackageName("token"), JSONTags(true), Force(true))
+	if !modTime().Equal(past) {
+		t.Error("Expected the identical output to be left untouched")
+	}
+
+	writeABI(`[{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"}],"outputs":[]}]`)
+	Command(input, "", false, output, PackageName("token"), JSONTags(true))
+	if modTime().Equal(past) {
+		t.Error("Expected the output to be regenerated after the ABI changed")
+	}
+	content, err = os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "ApproveCall") {
+		t.Error("Expected the regenerated output to contain ApproveCall")
+	}
+}
+
+func TestGeneratorVersionReproducible(t *testing.T) {
+	// the version only depends on the sources, not on the test binary running the generator
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.New()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(h, "%s %d\n", file, len(data))
+		h.Write(data)
+	}
+	if version := generatorVersion(); version != hex.EncodeToString(h.Sum(nil)) {
+		t.Errorf("Expected the hash of the generator sources, got %s", version)
+	}
+}
+
+func TestFormatErrorContext(t *testing.T) {
+	src := "package p\n\nfunc f(\n\ttype uint8,\n) {}\n"
+	_, err := imports.Process("p.go", []byte(src), &imports.Options{Comments: true})
+	if err == nil {
+		t.Fatal("Expected format error")
+	}
+
+	msg := formatError([]byte(src), err).Error()
+	if !strings.Contains(msg, ">     4 | \ttype uint8,") {
+		t.Errorf("Expected the offending line in the error, got:\n%s", msg)
+	}
+	var list scanner.ErrorList
+	if !errors.As(formatError([]byte(src), err), &list) {
+		t.Error("Expected the original error to be wrapped")
+	}
+}
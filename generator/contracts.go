@@ -0,0 +1,332 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"maps"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"golang.org/x/tools/imports"
+)
+
+// SharedFile is the name of the file of the items shared by the contracts of a combined ABI
+const SharedFile = "shared.abi.go"
+
+// sharedItems are the items used by more than one contract of a combined ABI, they are generated
+// once in the shared file and skipped in the contract files
+type sharedItems struct {
+	abi   ethabi.ABI             // methods, events, errors, fallback and receive functions
+	types map[string]ethabi.Type // tuples and enums by their type names
+}
+
+// ownMethods returns the methods generated in the current file, without the ones in the shared file
+func (g *Generator) ownMethods(methods []ethabi.Method) []ethabi.Method {
+	if g.sharedFile || len(g.shared.abi.Methods) == 0 {
+		return methods
+	}
+	var own []ethabi.Method
+	for _, method := range methods {
+		if _, shared := g.shared.abi.Methods[method.Name]; !shared {
+			own = append(own, method)
+		}
+	}
+	return own
+}
+
+// ownEvents returns the events generated in the current file, without the ones in the shared file
+func (g *Generator) ownEvents(events []ethabi.Event) []ethabi.Event {
+	if g.sharedFile || len(g.shared.abi.Events) == 0 {
+		return events
+	}
+	var own []ethabi.Event
+	for _, event := range events {
+		if _, shared := g.shared.abi.Events[event.Name]; !shared {
+			own = append(own, event)
+		}
+	}
+	return own
+}
+
+// isSharedType returns if the tuple or enum type is generated in the shared file instead of the current file
+func (g *Generator) isSharedType(name string) bool {
+	_, shared := g.shared.types[name]
+	return shared && !g.sharedFile
+}
+
+// ParseCombinedABI parses the output of `solc --combined-json abi` into the JSON ABI of each contract,
+// keyed by the contract name without the source file, e.g. Token for "src/Token.sol:Token". The abi
+// field may also be a JSON encoded string, as written by the older solc versions.
+func ParseCombinedABI(data []byte) (map[string][]byte, error) {
+	var combined struct {
+		Contracts map[string]struct {
+			ABI json.RawMessage `json:"abi"`
+		} `json:"contracts"`
+	}
+	if err := json.Unmarshal(data, &combined); err != nil {
+		return nil, fmt.Errorf("failed to parse combined ABI: %w", err)
+	}
+	if len(combined.Contracts) == 0 {
+		return nil, errors.New("no contracts found in the combined ABI")
+	}
+
+	contracts := make(map[string][]byte, len(combined.Contracts))
+	for _, key := range SortedMapKeys(combined.Contracts) {
+		name := key[strings.LastIndex(key, ":")+1:]
+		if !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("invalid contract name %q", key)
+		}
+		if _, ok := contracts[name]; ok {
+			return nil, fmt.Errorf("duplicate contract name %s", name)
+		}
+
+		abiJSON := []byte(combined.Contracts[key].ABI)
+		var encoded string
+		if err := json.Unmarshal(abiJSON, &encoded); err == nil {
+			abiJSON = []byte(encoded)
+		}
+		if len(abiJSON) == 0 {
+			return nil, fmt.Errorf("contract %s has no abi, run solc with --combined-json abi", name)
+		}
+		contracts[name] = abiJSON
+	}
+	return contracts, nil
+}
+
+// GenerateContracts generates Go code for the contracts of a combined ABI in the same package, one file per
+// contract named after the snake case contract name, the tuples, enums, methods, events and errors used by
+// more than one contract are generated once in SharedFile. The items of the same name must have the same
+// definition in all the contracts. The standalone functions of each contract file are prefixed with the
+// contract name after the Prefix option, e.g. DecodeBySelector becomes TokenDecodeBySelector.
+func (g *Generator) GenerateContracts(contracts map[string]ethabi.ABI) (map[string]string, error) {
+	switch {
+	case g.Options.Split:
+		return nil, errors.New("splitting files is not supported with a combined ABI")
+	case g.Options.Client != "" || g.Options.Caller != "":
+		return nil, errors.New("the client and caller of a single contract are not supported with a combined ABI")
+	case len(g.Options.IncludeMethods) > 0:
+		return nil, errors.New("including methods is not supported with a combined ABI, exclude the others instead")
+	}
+
+	opts := g.Options
+	defer func() {
+		g.Options = opts
+		g.shared, g.sharedFile = sharedItems{}, false
+		g.out = nil
+	}()
+
+	prepared := make(map[string]ethabi.ABI, len(contracts))
+	for _, name := range SortedMapKeys(contracts) {
+		g.Options.Prefix = ToCamel(opts.Prefix) + name
+		abiDef := g.prepare(contracts[name])
+		if g.err != nil {
+			return nil, fmt.Errorf("contract %s: %w", name, g.err)
+		}
+		prepared[name] = abiDef
+	}
+
+	shared, err := g.shareItems(prepared)
+	if err != nil {
+		return nil, err
+	}
+	g.shared = shared
+
+	files := make(map[string]string, len(contracts)+1)
+	var warnings []string
+	generate := func(fileName string, abiDef ethabi.ABI) error {
+		var buf bytes.Buffer
+		g.out = &buf
+		g.genHeader()
+		g.genBody(abiDef)
+		if g.err != nil {
+			return fmt.Errorf("%s: %w", fileName, g.err)
+		}
+		for _, warning := range g.Warnings {
+			warnings = append(warnings, fmt.Sprintf("%s: %s", fileName, warning))
+		}
+
+		formatted, err := imports.Process(fileName, buf.Bytes(), &imports.Options{Comments: true})
+		if err != nil {
+			return fmt.Errorf("failed to format %s: %w", fileName, formatError(buf.Bytes(), err))
+		}
+		files[fileName] = string(formatted)
+
+		if fuzzTest := g.GenerateFuzzTest(); fuzzTest != "" {
+			fuzzFile := fuzzTestFile(fileName)
+			formatted, err := imports.Process(fuzzFile, []byte(fuzzTest), &imports.Options{Comments: true})
+			if err != nil {
+				return fmt.Errorf("failed to format %s: %w", fuzzFile, formatError([]byte(fuzzTest), err))
+			}
+			files[fuzzFile] = string(formatted)
+		}
+		return nil
+	}
+
+	if len(shared.types) > 0 || len(shared.abi.Methods) > 0 || len(shared.abi.Events) > 0 ||
+		len(shared.abi.Errors) > 0 || shared.abi.HasFallback() || shared.abi.HasReceive() {
+		g.Options.Prefix = opts.Prefix
+		g.sharedFile = true
+		if err := generate(SharedFile, shared.abi); err != nil {
+			return nil, err
+		}
+		g.sharedFile = false
+	}
+
+	for _, name := range SortedMapKeys(contracts) {
+		fileName := ConvertCase(name, NamingSnake) + ".abi.go"
+		if _, ok := files[fileName]; ok {
+			return nil, fmt.Errorf("contract %s: file %s is already generated", name, fileName)
+		}
+		g.Options.Prefix = ToCamel(opts.Prefix) + name
+		if err := generate(fileName, contracts[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	g.Warnings = warnings
+	return files, nil
+}
+
+// shareItems returns the items used by more than one of the contracts, keyed by the contract or input names,
+// an error is returned if an item is defined differently by two contracts, as their generated names would collide.
+func (g *Generator) shareItems(contracts map[string]ethabi.ABI) (sharedItems, error) {
+	shared := sharedItems{
+		abi: ethabi.ABI{
+			Methods: make(map[string]ethabi.Method),
+			Events:  make(map[string]ethabi.Event),
+			Errors:  make(map[string]ethabi.Error),
+		},
+		types: make(map[string]ethabi.Type),
+	}
+
+	type item struct {
+		key      string // the definition of the item
+		contract string // the first contract using the item
+		last     string // the last contract using the item
+	}
+	seen := make(map[string]*item)
+	var err error
+	// share returns true if the item is used by the second time by another contract
+	share := func(contract, kind, name, key string) bool {
+		id := kind + " " + name
+		it, ok := seen[id]
+		if !ok {
+			seen[id] = &item{key: key, contract: contract, last: contract}
+			return false
+		}
+		if it.key != key {
+			if err == nil {
+				err = fmt.Errorf("%s %s is defined differently in %s and %s, generate them into separate packages",
+					kind, name, it.contract, contract)
+			}
+			return false
+		}
+		if it.last == contract {
+			return false
+		}
+		it.last = contract
+		return true
+	}
+
+	for _, contract := range SortedMapKeys(contracts) {
+		abiDef := contracts[contract]
+		visit := func(t ethabi.Type) {
+			if t.T == ethabi.TupleTy {
+				name := TupleStructName(t)
+				if _, external := g.Options.ExternalTuples[name]; !external && share(contract, "tuple", name, g.tupleKey(t)) {
+					shared.types[name] = t
+				}
+			} else if name := g.enumName(t); name != "" && share(contract, "enum", name, "") {
+				shared.types[name] = t
+			}
+		}
+		visitArgs := func(args ethabi.Arguments) {
+			for _, arg := range args {
+				VisitABIType(arg.Type, visit)
+			}
+		}
+
+		for _, name := range SortedMapKeys(abiDef.Methods) {
+			method := abiDef.Methods[name]
+			visitArgs(method.Inputs)
+			visitArgs(method.Outputs)
+			key := fmt.Sprintf("%s(%s) returns (%s)", method.RawName, g.argumentsKey(method.Inputs), g.argumentsKey(method.Outputs))
+			if share(contract, "function", name, key) {
+				shared.abi.Methods[name] = method
+			}
+		}
+		for _, name := range SortedMapKeys(abiDef.Events) {
+			event := abiDef.Events[name]
+			visitArgs(event.Inputs)
+			key := fmt.Sprintf("%s(%s) anonymous=%t", event.RawName, g.argumentsKey(event.Inputs), event.Anonymous)
+			if share(contract, "event", name, key) {
+				shared.abi.Events[name] = event
+			}
+		}
+		for _, name := range SortedMapKeys(abiDef.Errors) {
+			e := abiDef.Errors[name]
+			if share(contract, "error", name, fmt.Sprintf("%s(%s)", e.Sig, g.argumentsKey(e.Inputs))) {
+				shared.abi.Errors[name] = e
+			}
+		}
+		if abiDef.HasFallback() && share(contract, "fallback", "function", abiDef.Fallback.StateMutability) {
+			shared.abi.Fallback = abiDef.Fallback
+		}
+		if abiDef.HasReceive() && share(contract, "receive", "function", abiDef.Receive.StateMutability) {
+			shared.abi.Receive = abiDef.Receive
+		}
+	}
+	return shared, err
+}
+
+// argumentsKey returns the definition of the arguments which determines the generated code
+func (g *Generator) argumentsKey(args ethabi.Arguments) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = g.typeIdentifier(arg.Type) + " " + arg.Name
+		if arg.Indexed {
+			parts[i] += " indexed"
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// tupleKey returns the definition of the tuple struct, the nested tuples are identified by their names
+func (g *Generator) tupleKey(t ethabi.Type) string {
+	parts := make([]string, len(t.TupleElems))
+	for i, elem := range t.TupleElems {
+		parts[i] = g.typeIdentifier(*elem) + " " + t.TupleRawNames[i]
+	}
+	return strings.Join(parts, ",")
+}
+
+// MergeABIs merges the ABIs of multiple inputs keyed by the input names into one ABI to generate a single
+// package, the tuples, enums, methods, events and errors of the same name are deduplicated, an error is
+// returned if they have different definitions in two inputs.
+func (g *Generator) MergeABIs(abis map[string]ethabi.ABI) (ethabi.ABI, error) {
+	if _, err := g.shareItems(abis); err != nil {
+		return ethabi.ABI{}, err
+	}
+
+	merged := ethabi.ABI{
+		Methods: make(map[string]ethabi.Method),
+		Events:  make(map[string]ethabi.Event),
+		Errors:  make(map[string]ethabi.Error),
+	}
+	for _, name := range SortedMapKeys(abis) {
+		abiDef := abis[name]
+		maps.Copy(merged.Methods, abiDef.Methods)
+		maps.Copy(merged.Events, abiDef.Events)
+		maps.Copy(merged.Errors, abiDef.Errors)
+		if abiDef.HasFallback() {
+			merged.Fallback = abiDef.Fallback
+		}
+		if abiDef.HasReceive() {
+			merged.Receive = abiDef.Receive
+		}
+	}
+	return merged, nil
+}
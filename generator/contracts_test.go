@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestParseCombinedABI(t *testing.T) {
+	contracts, err := ParseCombinedABI([]byte(`{
+		"contracts": {
+			"src/Token.sol:Token": {"abi": [{"type": "function", "name": "owner", "inputs": [], "outputs": []}]},
+			"src/Vault.sol:Vault": {"abi": "[{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[]}]"}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse combined ABI: %v", err)
+	}
+	if names := SortedMapKeys(contracts); !slices.Equal([]string{"Token", "Vault"}, names) {
+		t.Fatalf("Expected contracts Token and Vault, got %v", names)
+	}
+	for name, abiJSON := range contracts {
+		if !strings.HasPrefix(string(abiJSON), "[") {
+			t.Errorf("Expected the JSON ABI of %s, got %s", name, abiJSON)
+		}
+	}
+
+	for _, tc := range []struct {
+		input string
+		err   string
+	}{
+		{`{"contracts": {}}`, "no contracts found"},
+		{`{"contracts": {"a/Token.sol:Token": {"abi": []}, "b/Token.sol:Token": {"abi": []}}}`, "duplicate contract name Token"},
+		{`{"contracts": {"Token.sol:Token": {"bin": "00"}}}`, "contract Token has no abi"},
+	} {
+		if _, err := ParseCombinedABI([]byte(tc.input)); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Expected error %q for %s, got %v", tc.err, tc.input, err)
+		}
+	}
+}
+
+func TestGenerateContracts(t *testing.T) {
+	contracts := map[string]ethabi.ABI{
+		"Token": mustParseABI(t, `[
+			{"type": "function", "name": "owner", "inputs": [], "outputs": [{"name": "", "type": "address"}]},
+			{"type": "function", "name": "send", "inputs": [{"name": "coin", "type": "tuple", "internalType": "struct Coin", "components": [{"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}]}], "outputs": []}
+		]`),
+		"MyVault": mustParseABI(t, `[
+			{"type": "function", "name": "owner", "inputs": [], "outputs": [{"name": "", "type": "address"}]},
+			{"type": "function", "name": "deposit", "inputs": [{"name": "coins", "type": "tuple[]", "internalType": "struct Coin[]", "components": [{"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}]}], "outputs": []}
+		]`),
+	}
+
+	files, err := NewGenerator(PackageName("combined")).GenerateContracts(contracts)
+	if err != nil {
+		t.Fatalf("Failed to generate contracts: %v", err)
+	}
+	expectedFiles := []string{"my_vault.abi.go", SharedFile, "token.abi.go"}
+	if names := SortedMapKeys(files); !slices.Equal(expectedFiles, names) {
+		t.Fatalf("Expected files %v, got %v", expectedFiles, names)
+	}
+
+	declared := make(map[string]string)
+	for _, name := range expectedFiles {
+		for _, decl := range topLevelDecls(t, files[name]) {
+			if other, ok := declared[decl]; ok {
+				t.Errorf("%s is declared in both %s and %s", decl, other, name)
+			}
+			declared[decl] = name
+		}
+	}
+	for decl, file := range map[string]string{
+		"Coin":                   SharedFile,
+		"OwnerCall":              SharedFile,
+		"SendCall":               "token.abi.go",
+		"DepositCall":            "my_vault.abi.go",
+		"TokenDecodeBySelector":  "token.abi.go",
+		"MyVaultEncodeCoinSlice": "my_vault.abi.go",
+	} {
+		if declared[decl] != file {
+			t.Errorf("Expected %s to be declared in %s, got %q", decl, file, declared[decl])
+		}
+	}
+	if _, ok := declared["DecodeBySelector"]; ok {
+		t.Errorf("Expected no DecodeBySelector in the shared file")
+	}
+
+	// the items of the same name must have the same definition
+	contracts["Other"] = mustParseABI(t, `[
+		{"type": "function", "name": "owner", "inputs": [], "outputs": [{"name": "", "type": "uint256"}]}
+	]`)
+	_, err = NewGenerator(PackageName("combined")).GenerateContracts(contracts)
+	if err == nil || !strings.Contains(err.Error(), "function owner is defined differently in MyVault and Other") {
+		t.Errorf("Expected conflicting definition error, got %v", err)
+	}
+
+	_, err = NewGenerator(PackageName("combined"), Split(true)).GenerateContracts(contracts)
+	if err == nil {
+		t.Errorf("Expected error splitting files of a combined ABI")
+	}
+}
+
+func TestMergeABIs(t *testing.T) {
+	abis := map[string]ethabi.ABI{
+		"token.json": mustParseABI(t, `[
+			{"type": "function", "name": "owner", "inputs": [], "outputs": [{"name": "", "type": "address"}]},
+			{"type": "function", "name": "send", "inputs": [{"name": "coin", "type": "tuple", "internalType": "struct Coin", "components": [{"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}]}], "outputs": []}
+		]`),
+		"vault.json": mustParseABI(t, `[
+			{"type": "function", "name": "owner", "inputs": [], "outputs": [{"name": "", "type": "address"}]},
+			{"type": "function", "name": "deposit", "inputs": [{"name": "coins", "type": "tuple[]", "internalType": "struct Coin[]", "components": [{"name": "denom", "type": "string"}, {"name": "amount", "type": "uint256"}]}], "outputs": []}
+		]`),
+	}
+
+	merged, err := NewGenerator().MergeABIs(abis)
+	if err != nil {
+		t.Fatalf("Failed to merge ABIs: %v", err)
+	}
+	if names := SortedMapKeys(merged.Methods); !slices.Equal([]string{"deposit", "owner", "send"}, names) {
+		t.Fatalf("Expected methods deposit, owner and send, got %v", names)
+	}
+
+	code, err := NewGenerator(PackageName("merged")).GenerateFromABI(merged)
+	if err != nil {
+		t.Fatalf("Failed to generate the merged ABI: %v", err)
+	}
+	declared := make(map[string]int)
+	for _, decl := range topLevelDecls(t, code) {
+		declared[decl]++
+	}
+	for _, decl := range []string{"Coin", "OwnerCall", "SendCall", "DepositCall"} {
+		if declared[decl] != 1 {
+			t.Errorf("Expected %s to be declared once, got %d", decl, declared[decl])
+		}
+	}
+
+	// the tuples of the same name must have the same shape
+	abis["other.json"] = mustParseABI(t, `[
+		{"type": "function", "name": "burn", "inputs": [{"name": "coin", "type": "tuple", "internalType": "struct Coin", "components": [{"name": "amount", "type": "uint256"}]}], "outputs": []}
+	]`)
+	if _, err := NewGenerator().MergeABIs(abis); err == nil || !strings.Contains(err.Error(), "tuple Coin is defined differently in other.json and token.json") {
+		t.Errorf("Expected conflicting definition error, got %v", err)
+	}
+}
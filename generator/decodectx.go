@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// decodesSlices returns if decoding t loops over the elements of a dynamic array, these types get the
+// DecodeCtx variants of the decoders, the external tuples are decoded without the context.
+func (g *Generator) decodesSlices(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.SliceTy:
+		return true
+	case ethabi.ArrayTy:
+		return g.decodesSlices(*t.Elem)
+	case ethabi.TupleTy:
+		if _, external := g.Options.ExternalTuples[TupleStructName(t)]; external {
+			return false
+		}
+		for _, elem := range t.TupleElems {
+			if g.decodesSlices(*elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// genDecodeCtxCall returns the call of the DecodeCtx variant of the decoder of t
+func (g *Generator) genDecodeCtxCall(t ethabi.Type, dataRef string) string {
+	return fmt.Sprintf("%s(ctx, %s)", g.genFuncName(t, "DecodeCtx"), dataRef)
+}
+
+// genDecodeCtxFunction generates the DecodeCtx variant of the decoding function of the array types,
+// the plain decoders are not affected. The loops over the elements of the dynamic arrays check the
+// context every DecodeCtxInterval elements, and the nested arrays and tuples are decoded with their
+// DecodeCtx variants.
+func (g *Generator) genDecodeCtxFunction(t ethabi.Type) {
+	if t.T == ethabi.TupleTy || !g.decodesSlices(t) {
+		return
+	}
+	defer g.within("type %s", t.String())()
+
+	g.ctx = true
+	defer func() { g.ctx = false }()
+
+	funcName := g.genFuncName(t, "DecodeCtx")
+	goType := g.abiTypeToGoType(t)
+	g.L("")
+	g.L("// %s decodes %s from ABI bytes like %s, checking ctx every %d elements of the dynamic arrays", funcName, t.String(), g.genFuncName(t, "Decode"), g.Options.DecodeCtxInterval)
+	g.L("func %s(ctx context.Context, data []byte) (%s, int, error) {", funcName, goType)
+	if t.T == ethabi.SliceTy {
+		g.L("\tvar dst %s", goType)
+		g.genSliceDecoding(t)
+	} else {
+		g.genArrayDecoding(t)
+	}
+	g.L("}")
+}
+
+// genDecodeCtxElement generates the decoding of the dynamic element result[i] with the DecodeCtx variant
+func (g *Generator) genDecodeCtxElement(t ethabi.Type) {
+	if t.T == ethabi.TupleTy {
+		g.L("\t\tn, err = result[i].DecodeCtx(ctx, data[dynamicOffset:])")
+	} else {
+		g.L("\t\tresult[i], n, err = %s", g.genDecodeCtxCall(t, "data[dynamicOffset:]"))
+	}
+}
+
+// genCtxCheck generates the periodic check of the context in the loop over the elements of a dynamic array
+func (g *Generator) genCtxCheck() {
+	g.L("\t\tif i%%%d == 0 {", g.Options.DecodeCtxInterval)
+	g.L("\t\t\tif err := ctx.Err(); err != nil {")
+	g.L("\t\t\t\treturn nil, 0, %sDecodeCanceled(err)", g.StdPrefix)
+	g.L("\t\t\t}")
+	g.L("\t\t}")
+}
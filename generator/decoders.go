@@ -9,9 +9,9 @@ import (
 // genIntDecoding generates decoding for integer types
 func (g *Generator) genIntDecoding(t ethabi.Type) {
 	// Optimize small integer types to avoid big.Int overhead
-	if t.Size <= 64 {
+	if !g.isBigIntType(t) {
 		g.genSmallIntDecoding(t)
-	} else if t.T == ethabi.UintTy && g.Options.UseUint256 {
+	} else if g.Options.UseUint256 && isUint256Type(t) {
 		g.genUint256Decoding()
 	} else {
 		g.genBigIntDecoding(t)
@@ -23,15 +23,18 @@ func (g *Generator) genUint256Decoding() {
 	g.L("\tif len(data) < 32 {")
 	g.L("\t\treturn nil, 0, io.ErrUnexpectedEOF")
 	g.L("\t}")
-	g.L("\tresult := new(uint256.Int)")
-	g.L("\tresult.SetBytes32(data[:32])")
-	g.L("\treturn result, 32, nil")
+	g.L("\tif dst == nil {")
+	g.L("\t\tdst = new(uint256.Int)")
+	g.L("\t}")
+	g.L("\tdst.SetBytes32(data[:32])")
+	g.L("\treturn dst, 32, nil")
 }
 
 // genSmallIntDecoding generates optimized decoding for small integer types
 func (g *Generator) genSmallIntDecoding(t ethabi.Type) {
 	if t.Size%8 != 0 {
-		panic(fmt.Sprintf("unsupported size %d for small integer decoding", t.Size))
+		g.errorf("unsupported size %d for small integer decoding", t.Size)
+		return
 	}
 
 	// For small integers, we can use direct binary decoding without big.Int
@@ -62,7 +65,7 @@ func (g *Generator) genBigIntDecoding(t ethabi.Type) {
 		signed = "true"
 	}
 
-	g.L("\tresult, err := %sDecodeBigInt(data[:32], %s)", g.StdPrefix, signed)
+	g.L("\tresult, err := %sDecodeIntoBigInt(dst, data, %s)", g.StdPrefix, signed)
 	g.L("\tif err != nil {")
 	g.L("\t\treturn nil, 0, err")
 	g.L("\t}")
@@ -72,10 +75,8 @@ func (g *Generator) genBigIntDecoding(t ethabi.Type) {
 // genAddressDecoding generates decoding for address types
 func (g *Generator) genAddressDecoding() {
 	g.L("\tvar result common.Address")
-	g.L("\tfor i := 0; i < 12; i++ {")
-	g.L("\t\tif data[i] != 0x00 {")
-	g.L("\t\t\treturn result, 0, %sErrDirtyPadding", g.StdPrefix)
-	g.L("\t\t}")
+	g.L("\tif binary.BigEndian.Uint64(data[0:8]) != 0 || binary.BigEndian.Uint32(data[8:12]) != 0 {")
+	g.L("\t\treturn result, 0, %sErrDirtyPadding", g.StdPrefix)
 	g.L("\t}")
 	g.L("\tcopy(result[:], data[12:32])")
 	g.L("\treturn result, 32, nil")
@@ -84,12 +85,10 @@ func (g *Generator) genAddressDecoding() {
 // genBoolDecoding generates decoding for boolean types
 func (g *Generator) genBoolDecoding() {
 	g.L("\t// Validate boolean encoding - only 0 or 1 are valid")
-	g.L("\tfor _, i := range data[:31] {")
-	g.L("\t\tif i != 0 {")
-	g.L("\t\t\treturn false, 0, %sErrDirtyPadding", g.StdPrefix)
-	g.L("\t\t}")
+	g.L("\tif binary.BigEndian.Uint64(data[0:8])|binary.BigEndian.Uint64(data[8:16])|binary.BigEndian.Uint64(data[16:24]) != 0 {")
+	g.L("\t\treturn false, 0, %sErrDirtyPadding", g.StdPrefix)
 	g.L("\t}")
-	g.L("\tswitch data[31] {")
+	g.L("\tswitch binary.BigEndian.Uint64(data[24:32]) {")
 	g.L("\tcase 0x01:")
 	g.L("\t\treturn true, 32, nil")
 	g.L("\tcase 0x00:")
@@ -113,6 +112,10 @@ func (g *Generator) genStringDecoding() {
 	g.L("\t}")
 	g.L("\tdata = data[32:]")
 
+	g.L("\t// check the length first, Pad32 overflows near MaxInt")
+	g.L("\tif length > len(data) {")
+	g.L("\t\treturn \"\", 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
 	g.L("\tpaddedLength := %sPad32(length)", g.StdPrefix)
 	g.L("\tif len(data) < paddedLength {")
 	g.L("\t\treturn \"\", 0, io.ErrUnexpectedEOF")
@@ -144,6 +147,10 @@ func (g *Generator) genBytesDecoding() {
 	g.L("\t}")
 	g.L("\tdata = data[32:]")
 
+	g.L("\t// check the length first, Pad32 overflows near MaxInt")
+	g.L("\tif length > len(data) {")
+	g.L("\t\treturn nil, 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
 	g.L("\tpaddedLength := %sPad32(length)", g.StdPrefix)
 	g.L("\tif len(data) < paddedLength {")
 	g.L("\t\treturn nil, 0, io.ErrUnexpectedEOF")
@@ -177,6 +184,26 @@ func (g *Generator) genFixedBytesDecoding(t ethabi.Type) {
 
 // genSliceDecoding generates decoding for slice types
 func (g *Generator) genSliceDecoding(t ethabi.Type) {
+	// the helpers decode the elements with Decode, DecodeInto keeps the inlined loops to reuse their allocations
+	if g.Options.Compact && !g.ctx && t.Elem.T == ethabi.TupleTy && g.tupleDecodeMethod(*t.Elem, true) == "Decode" {
+		ret := "return"
+		if g.Options.NilSlices {
+			ret = "result, n, err :="
+		}
+		if IsDynamicType(*t.Elem) {
+			g.L("\t%s %sDecodeDynamicSlice(dst, data)", ret, g.StdPrefix)
+		} else {
+			g.L("\t%s %sDecodeStaticSlice(dst, data, %d)", ret, g.StdPrefix, GetTypeSize(*t.Elem))
+		}
+		if g.Options.NilSlices {
+			g.L("\tif len(result) == 0 {")
+			g.L("\t\tresult = nil")
+			g.L("\t}")
+			g.L("\treturn result, n, err")
+		}
+		return
+	}
+
 	g.L("\t// Decode length")
 
 	g.L("\tif len(data) < 32 {")
@@ -188,24 +215,35 @@ func (g *Generator) genSliceDecoding(t ethabi.Type) {
 	g.L("\t\treturn nil, 0, err")
 	g.L("\t}")
 
+	if g.Options.NilSlices {
+		g.L("\tif length == 0 {")
+		g.L("\t\treturn nil, 32, nil")
+		g.L("\t}")
+	}
+
 	g.L("\tdata = data[32:]")
-	g.L("\t\tif length > len(data) || length * %d > len(data) {", GetTypeSize(*t.Elem))
-	g.L("\t\t\treturn nil, 0, io.ErrUnexpectedEOF")
-	g.L("\t\t}")
+	// the division form doesn't overflow for the lengths near MaxInt
+	g.L("\tif length > len(data)/%d {", GetTypeSize(*t.Elem))
+	g.L("\t\treturn nil, 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
 
 	g.L("\tvar (")
 	g.L("\t\tn int")
 	g.L("\t\toffset int")
 	g.L("\t)")
 
-	goType := g.abiTypeToGoType(*t.Elem)
 	if !IsDynamicType(*t.Elem) {
 		g.L("\t// Decode elements with static types")
-		g.L("\tresult := make([]%s, length)", goType)
+		g.L("\tresult := %sResizeSlice(dst, length)", g.StdPrefix)
 		g.L("\tfor i := 0; i < length; i++ {")
+		if g.ctx {
+			g.genCtxCheck()
+		}
 
 		if t.Elem.T == ethabi.TupleTy {
-			g.L("\t\tn, err = result[i].Decode(data[offset:])")
+			g.L("\t\tn, err = result[i].%s(data[offset:])", g.tupleDecodeMethod(*t.Elem, true))
+		} else if g.isBigIntType(*t.Elem) {
+			g.L("\t\tresult[i], n, err = %s(result[i], data[offset:])", g.genFuncName(*t.Elem, "DecodeInto"))
 		} else {
 			g.L("\t\tresult[i], n, err = %s", g.genDecodeCall(*t.Elem, "data[offset:]"))
 		}
@@ -218,9 +256,12 @@ func (g *Generator) genSliceDecoding(t ethabi.Type) {
 		g.L("\treturn result, offset + 32, nil")
 	} else {
 		g.L("\t// Decode elements with dynamic types")
-		g.L("\tresult := make([]%s, length)", goType)
+		g.L("\tresult := %sResizeSlice(dst, length)", g.StdPrefix)
 		g.L("\tdynamicOffset := length * 32")
 		g.L("\tfor i := 0; i < length; i++ {")
+		if g.ctx {
+			g.genCtxCheck()
+		}
 		g.L("\t\ttmp, err := %sDecodeSize(data[offset:])", g.StdPrefix)
 		g.L("\t\tif err != nil {")
 		g.L("\t\t\treturn nil, 0, err")
@@ -231,8 +272,12 @@ func (g *Generator) genSliceDecoding(t ethabi.Type) {
 		g.L("\t\t\treturn nil, 0, %sErrInvalidOffsetForSliceElement", g.StdPrefix)
 		g.L("\t\t}")
 
-		if t.Elem.T == ethabi.TupleTy {
-			g.L("\t\tn, err = result[i].Decode(data[dynamicOffset:])")
+		if g.ctx && g.decodesSlices(*t.Elem) {
+			g.genDecodeCtxElement(*t.Elem)
+		} else if t.Elem.T == ethabi.TupleTy {
+			g.L("\t\tn, err = result[i].%s(data[dynamicOffset:])", g.tupleDecodeMethod(*t.Elem, true))
+		} else if t.Elem.T == ethabi.SliceTy {
+			g.L("\t\tresult[i], n, err = %s(result[i], data[dynamicOffset:])", g.genFuncName(*t.Elem, "DecodeInto"))
 		} else {
 			g.L("\t\tresult[i], n, err = %s", g.genDecodeCall(*t.Elem, "data[dynamicOffset:]"))
 		}
@@ -264,7 +309,11 @@ func (g *Generator) genArrayDecoding(t ethabi.Type) {
 		var offset int
 		for i := 0; i < t.Size; i++ {
 			g.L("\t// Element %d", i)
-			g.L("\tresult[%d], _, err = %s", i, g.genDecodeCall(*t.Elem, fmt.Sprintf("data[%d:]", offset)))
+			if t.Elem.T == ethabi.TupleTy {
+				g.L("\t_, err = result[%d].Decode(data[%d:])", i, offset)
+			} else {
+				g.L("\tresult[%d], _, err = %s", i, g.genDecodeCall(*t.Elem, fmt.Sprintf("data[%d:]", offset)))
+			}
 			g.L("\tif err != nil {")
 			g.L("\t\treturn result, 0, err")
 			g.L("\t}")
@@ -296,8 +345,12 @@ func (g *Generator) genArrayDecoding(t ethabi.Type) {
 		g.L("\t\tif dynamicOffset != tmp {")
 		g.L("\t\t\treturn result, 0, %sErrInvalidOffsetForArrayElement", g.StdPrefix)
 		g.L("\t\t}")
-		if t.Elem.T == ethabi.TupleTy {
-			g.L("\t\tn, err = result[i].Decode(data[dynamicOffset:])")
+		if g.ctx && g.decodesSlices(*t.Elem) {
+			g.genDecodeCtxElement(*t.Elem)
+		} else if t.Elem.T == ethabi.TupleTy {
+			g.L("\t\tn, err = result[i].%s(data[dynamicOffset:])", g.tupleDecodeMethod(*t.Elem, true))
+		} else if t.Elem.T == ethabi.SliceTy {
+			g.L("\t\tresult[i], n, err = %s(result[i], data[dynamicOffset:])", g.genFuncName(*t.Elem, "DecodeInto"))
 		} else {
 			g.L("\t\tresult[i], n, err = %s", g.genDecodeCall(*t.Elem, "data[dynamicOffset:]"))
 		}
@@ -320,7 +373,7 @@ func (g *Generator) genPackedIntDecoding(t ethabi.Type) {
 
 	// Use appropriate zero value for error returns
 	zeroValue := "0"
-	if byteSize > 8 {
+	if g.isBigIntType(t) {
 		zeroValue = "nil"
 	}
 
@@ -328,7 +381,7 @@ func (g *Generator) genPackedIntDecoding(t ethabi.Type) {
 	g.L("\t\treturn %s, 0, io.ErrUnexpectedEOF", zeroValue)
 	g.L("\t}")
 
-	if byteSize <= 8 {
+	if !g.isBigIntType(t) {
 		// For sizes <= 8 bytes, use native integer types
 		switch byteSize {
 		case 1:
@@ -393,24 +446,27 @@ func (g *Generator) genPackedIntDecoding(t ethabi.Type) {
 			}
 		}
 	} else {
-		// For sizes > 8 bytes
-		if t.T == ethabi.UintTy && g.Options.UseUint256 {
+		// For sizes > 8 bytes or uniform big.Int
+		if g.Options.UseUint256 && isUint256Type(t) {
 			// Use uint256.Int for large unsigned integers when enabled
 			g.genPackedLargeUintDecoding(t)
 			return
 		}
-		// Use big.Int
-		if t.T == ethabi.IntTy {
-			g.L("\tresult, err := %sDecodeBigInt(data[:%d], true)", g.StdPrefix, byteSize)
-			g.L("\tif err != nil {")
-			g.L("\t\treturn nil, 0, err")
-			g.L("\t}")
-			g.L("\treturn result, %d, nil", byteSize)
-		} else {
-			g.L("\tresult := new(big.Int).SetBytes(data[:%d])", byteSize)
-			g.L("\treturn result, %d, nil", byteSize)
-		}
+		g.genPackedBigIntDecoding(t)
+	}
+}
+
+// genPackedBigIntDecoding generates packed decoding for big.Int types
+func (g *Generator) genPackedBigIntDecoding(t ethabi.Type) {
+	byteSize := t.Size / 8
+	g.L("\tresult := new(big.Int).SetBytes(data[:%d])", byteSize)
+	if t.T == ethabi.IntTy {
+		// two's complement of the packed width
+		g.L("\tif data[0]&0x80 != 0 {")
+		g.L("\t\tresult.Sub(result, new(big.Int).Lsh(big.NewInt(1), %d))", t.Size)
+		g.L("\t}")
 	}
+	g.L("\treturn result, %d, nil", byteSize)
 }
 
 // genPackedLargeUintDecoding generates packed decoding for large unsigned integers using uint256.Int
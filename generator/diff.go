@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Kinds of the changes in a DiffReport
+const (
+	ChangeAdded      = "added"
+	ChangeRemoved    = "removed"
+	ChangeCompatible = "compatible"
+	ChangeBreaking   = "breaking"
+)
+
+// Change describes a difference of the generated bindings between two ABI versions
+type Change struct {
+	Kind string `json:"kind"`
+	// The changed item, e.g. "function transfer(address,uint256)", "event Transfer(address,address,uint256)" or "tuple Coin"
+	Item   string `json:"item"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// DiffReport lists the changes of the generated bindings between two ABI versions
+type DiffReport struct {
+	Changes []Change `json:"changes"`
+}
+
+// Breaking returns true if any of the changes breaks the existing users, removals included
+func (r DiffReport) Breaking() bool {
+	for _, c := range r.Changes {
+		if c.Kind == ChangeBreaking || c.Kind == ChangeRemoved {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares the bindings generated with opts from the old and new ABI. The functions are matched by
+// selector and the events by topic, then by name to report the signature changes, the tuples are matched
+// by struct name. The arguments and tuple fields are compared by position on both the ABI and Go types,
+// renames are compatible, while type changes and reordering are breaking.
+func Diff(oldABI, newABI ethabi.ABI, opts ...Option) DiffReport {
+	d := &differ{g: NewGenerator(opts...)}
+	oldABI, newABI = d.prepare(oldABI), d.prepare(newABI)
+
+	matchItems(d, sortedValues(oldABI.Methods), sortedValues(newABI.Methods),
+		func(m ethabi.Method) string { return "0x" + hex.EncodeToString(m.ID) },
+		func(m ethabi.Method) string { return m.Name },
+		func(m ethabi.Method) string { return "function " + m.Sig },
+		d.compareMethods,
+	)
+	matchItems(d, sortedValues(oldABI.Events), sortedValues(newABI.Events),
+		func(e ethabi.Event) string { return e.ID.Hex() },
+		func(e ethabi.Event) string { return e.Name },
+		func(e ethabi.Event) string { return "event " + e.Sig },
+		d.compareEvents,
+	)
+	matchItems(d, d.tuples(oldABI), d.tuples(newABI),
+		func(s Struct) string { return s.Name },
+		func(s Struct) string { return s.Name },
+		func(s Struct) string { return "tuple " + s.Name },
+		func(item string, o, n Struct) {
+			d.compareFields(item, "field", o.Fields, n.Fields)
+		},
+	)
+
+	return DiffReport{Changes: d.changes}
+}
+
+// differ collects the changes between two ABI versions
+type differ struct {
+	g       *Generator
+	changes []Change
+}
+
+func (d *differ) add(kind, item, format string, args ...any) {
+	d.changes = append(d.changes, Change{Kind: kind, Item: item, Detail: fmt.Sprintf(format, args...)})
+}
+
+// prepare filters the methods and names the tuples the same way as the generator
+func (d *differ) prepare(abiDef ethabi.ABI) ethabi.ABI {
+	// the methods missing from one of the versions are reported as added or removed
+	abiDef, _ = filterMethods(abiDef, d.g.Options)
+	if d.g.Options.NameTuplesByFunction {
+		abiDef = nameTuplesByFunction(abiDef)
+	}
+	abiDef, _ = d.g.resolveNameCollisions(abiDef)
+	return abiDef
+}
+
+// tuples returns the structs generated for the tuples of the methods and events, sorted by name
+func (d *differ) tuples(abiDef ethabi.ABI) []Struct {
+	structs := make(map[string]Struct)
+	visit := func(t ethabi.Type) {
+		if t.T != ethabi.TupleTy {
+			return
+		}
+		s := StructFromTuple(t)
+		if _, ok := d.g.Options.ExternalTuples[s.Name]; !ok {
+			structs[s.Name] = s
+		}
+	}
+	for _, method := range sortedValues(abiDef.Methods) {
+		for _, arg := range append(slices.Clone(method.Inputs), method.Outputs...) {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	for _, event := range sortedValues(abiDef.Events) {
+		for _, arg := range event.Inputs {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	return sortedValues(structs)
+}
+
+func (d *differ) compareMethods(item string, o, n ethabi.Method) {
+	if !slices.Equal(o.ID, n.ID) {
+		d.add(ChangeBreaking, item, "selector changed from 0x%x to 0x%x (%s)", o.ID, n.ID, n.Sig)
+	}
+	d.compareFields(item, "argument", StructFromArguments(o.Name, o.Inputs).Fields, StructFromArguments(n.Name, n.Inputs).Fields)
+	d.compareFields(item, "return value", StructFromArguments(o.Name, o.Outputs).Fields, StructFromArguments(n.Name, n.Outputs).Fields)
+}
+
+func (d *differ) compareEvents(item string, o, n ethabi.Event) {
+	if o.ID != n.ID {
+		d.add(ChangeBreaking, item, "topic changed from %s to %s (%s)", o.ID.Hex(), n.ID.Hex(), n.Sig)
+	}
+	for i := range min(len(o.Inputs), len(n.Inputs)) {
+		if o.Inputs[i].Indexed != n.Inputs[i].Indexed {
+			d.add(ChangeBreaking, item, "argument %d indexed changed from %t to %t", i, o.Inputs[i].Indexed, n.Inputs[i].Indexed)
+		}
+	}
+	d.compareFields(item, "argument", StructFromArguments(o.Name, o.Inputs).Fields, StructFromArguments(n.Name, n.Inputs).Fields)
+}
+
+// compareFields compares the fields by position, what names the fields in the details
+func (d *differ) compareFields(item, what string, o, n []StructField) {
+	if len(o) != len(n) {
+		d.add(ChangeBreaking, item, "%s count changed from %d to %d", what, len(o), len(n))
+		return
+	}
+
+	var renames []string
+	oldNames := make([]string, len(o))
+	newNames := make([]string, len(n))
+	for i := range o {
+		oldNames[i], newNames[i] = o[i].Name, n[i].Name
+		oldType, newType := d.g.abiTypeToGoType(*o[i].Type), d.g.abiTypeToGoType(*n[i].Type)
+		if o[i].Type.String() != n[i].Type.String() || oldType != newType {
+			d.add(ChangeBreaking, item, "%s %s type changed from %s (%s) to %s (%s)",
+				what, n[i].Name, o[i].Type.String(), oldType, n[i].Type.String(), newType)
+		} else if o[i].Name != n[i].Name {
+			renames = append(renames, fmt.Sprintf("%s %s renamed to %s", what, o[i].Name, n[i].Name))
+		}
+	}
+	if len(renames) == 0 {
+		return
+	}
+
+	// the same names at different positions still compile but no longer match the encoding
+	if slices.Equal(slices.Sorted(slices.Values(oldNames)), slices.Sorted(slices.Values(newNames))) {
+		d.add(ChangeBreaking, item, "%ss reordered from %s to %s", what, strings.Join(oldNames, ", "), strings.Join(newNames, ", "))
+		return
+	}
+	for _, rename := range renames {
+		d.add(ChangeCompatible, item, "%s", rename)
+	}
+}
+
+// matchItems pairs the old and new items by key, then the remaining ones by name, the pairs are compared
+// with compare and the rest are reported as removed or added.
+func matchItems[T any](d *differ, oldItems, newItems []T, key, name, item func(T) string, compare func(string, T, T)) {
+	matched := make(map[int]bool)
+	pair := func(field func(T) string) {
+		index := make(map[string]int)
+		for i, n := range newItems {
+			if !matched[i] {
+				index[field(n)] = i
+			}
+		}
+		for j := 0; j < len(oldItems); j++ {
+			i, ok := index[field(oldItems[j])]
+			if !ok || matched[i] {
+				continue
+			}
+			matched[i] = true
+			compare(item(newItems[i]), oldItems[j], newItems[i])
+			oldItems = slices.Delete(oldItems, j, j+1)
+			j--
+		}
+	}
+	pair(key)
+	pair(name)
+
+	for _, o := range oldItems {
+		d.add(ChangeRemoved, item(o), "")
+	}
+	for i, n := range newItems {
+		if !matched[i] {
+			d.add(ChangeAdded, item(n), "")
+		}
+	}
+}
+
+// sortedValues returns the values of the map sorted by key
+func sortedValues[V any](m map[string]V) []V {
+	values := make([]V, 0, len(m))
+	for _, k := range SortedMapKeys(m) {
+		values = append(values, m[k])
+	}
+	return values
+}
+
+// WriteDiffJSON writes the diff report as JSON
+func WriteDiffJSON(w io.Writer, r DiffReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteDiffSummary writes a line per change followed by the counts of each kind
+func WriteDiffSummary(w io.Writer, r DiffReport) error {
+	var b strings.Builder
+	counts := make(map[string]int)
+	for _, c := range r.Changes {
+		counts[c.Kind]++
+		fmt.Fprintf(&b, "%s: %s", c.Kind, c.Item)
+		if c.Detail != "" {
+			fmt.Fprintf(&b, ": %s", c.Detail)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%d breaking, %d removed, %d added, %d compatible changes\n",
+		counts[ChangeBreaking], counts[ChangeRemoved], counts[ChangeAdded], counts[ChangeCompatible])
+	_, err := io.WriteString(w, b.String())
+	return err
+}
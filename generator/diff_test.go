@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func mustParseABI(t *testing.T, abiJSON string) abi.ABI {
+	t.Helper()
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	return abiDef
+}
+
+func TestDiff(t *testing.T) {
+	oldABI := mustParseABI(t, `[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+		{"type": "function", "name": "mint", "inputs": [{"name": "amount", "type": "uint128"}], "outputs": []},
+		{"type": "function", "name": "burn", "inputs": [{"name": "amount", "type": "uint256"}], "outputs": []},
+		{"type": "function", "name": "getPair", "inputs": [], "outputs": [{"name": "pair", "type": "tuple", "internalType": "struct Pair", "components": [
+			{"name": "base", "type": "uint64"}, {"name": "quote", "type": "uint64"}
+		]}]},
+		{"type": "event", "name": "Transfer", "inputs": [
+			{"name": "from", "type": "address", "indexed": true}, {"name": "value", "type": "uint256", "indexed": false}
+		]}
+	]`)
+	newABI := mustParseABI(t, `[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "recipient", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+		{"type": "function", "name": "mint", "inputs": [{"name": "amount", "type": "uint256"}], "outputs": []},
+		{"type": "function", "name": "getPair", "inputs": [], "outputs": [{"name": "pair", "type": "tuple", "internalType": "struct Pair", "components": [
+			{"name": "quote", "type": "uint64"}, {"name": "base", "type": "uint64"}
+		]}]},
+		{"type": "function", "name": "pause", "inputs": [], "outputs": []},
+		{"type": "event", "name": "Transfer", "inputs": [
+			{"name": "from", "type": "address", "indexed": false}, {"name": "value", "type": "uint256", "indexed": false}
+		]}
+	]`)
+
+	report := Diff(oldABI, newABI)
+	expected := []Change{
+		{Kind: ChangeCompatible, Item: "function transfer(address,uint256)", Detail: "argument To renamed to Recipient"},
+		{Kind: ChangeBreaking, Item: "function mint(uint256)", Detail: "selector changed from 0x69d3e20e to 0xa0712d68 (mint(uint256))"},
+		{Kind: ChangeBreaking, Item: "function mint(uint256)", Detail: "argument Amount type changed from uint128 (*big.Int) to uint256 (*big.Int)"},
+		{Kind: ChangeRemoved, Item: "function burn(uint256)"},
+		{Kind: ChangeAdded, Item: "function pause()"},
+		{Kind: ChangeBreaking, Item: "event Transfer(address,uint256)", Detail: "argument 0 indexed changed from true to false"},
+		{Kind: ChangeBreaking, Item: "tuple Pair", Detail: "fields reordered from Base, Quote to Quote, Base"},
+	}
+	if !reflect.DeepEqual(report.Changes, expected) {
+		t.Errorf("Diff mismatch\nexpected: %+v\nactual:   %+v", expected, report.Changes)
+	}
+	if !report.Breaking() {
+		t.Error("Expected the report to be breaking")
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDiffJSON(&buf, report); err != nil {
+		t.Fatalf("Failed to write JSON: %v", err)
+	}
+	var decoded DiffReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Failed to parse JSON: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, report) {
+		t.Errorf("JSON round trip mismatch: %+v", decoded)
+	}
+
+	buf.Reset()
+	if err := WriteDiffSummary(&buf, report); err != nil {
+		t.Fatalf("Failed to write summary: %v", err)
+	}
+	if !strings.HasSuffix(buf.String(), "4 breaking, 1 removed, 1 added, 1 compatible changes\n") {
+		t.Errorf("Unexpected summary:\n%s", buf.String())
+	}
+}
+
+func TestDiffCompatible(t *testing.T) {
+	oldABI := mustParseABI(t, `[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": []}
+	]`)
+	newABI := mustParseABI(t, `[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "recipient", "type": "address"}, {"name": "value", "type": "uint256"}], "outputs": []}
+	]`)
+
+	report := Diff(oldABI, newABI)
+	if report.Breaking() {
+		t.Errorf("Expected no breaking changes, got %+v", report.Changes)
+	}
+	if len(report.Changes) != 2 {
+		t.Errorf("Expected 2 renames, got %+v", report.Changes)
+	}
+	if changes := Diff(oldABI, oldABI).Changes; len(changes) != 0 {
+		t.Errorf("Expected no changes against itself, got %+v", changes)
+	}
+}
+
+func TestDiffGoTypes(t *testing.T) {
+	oldABI := mustParseABI(t, `[
+		{"type": "function", "name": "total", "inputs": [], "outputs": [{"name": "", "type": "uint64"}]}
+	]`)
+	newABI := mustParseABI(t, `[
+		{"type": "function", "name": "total", "inputs": [], "outputs": [{"name": "", "type": "uint128"}]}
+	]`)
+
+	expected := []Change{
+		{Kind: ChangeBreaking, Item: "function total()", Detail: "return value Field1 type changed from uint64 (uint64) to uint128 (*uint256.Int)"},
+	}
+	if changes := Diff(oldABI, newABI, UseUint256(true)).Changes; !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Diff mismatch\nexpected: %+v\nactual:   %+v", expected, changes)
+	}
+}
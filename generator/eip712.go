@@ -0,0 +1,129 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/yihuang/go-abi"
+)
+
+// eip712MemberType returns the type of the member in the EIP-712 type encoding, structs are referenced by name
+func eip712MemberType(t ethabi.Type) string {
+	switch t.T {
+	case ethabi.TupleTy:
+		return TupleStructName(t)
+	case ethabi.SliceTy:
+		return eip712MemberType(*t.Elem) + "[]"
+	case ethabi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", eip712MemberType(*t.Elem), t.Size)
+	default:
+		return t.String()
+	}
+}
+
+// eip712StructType returns the EIP-712 encoding of a single struct type, e.g. "Person(string name,address wallet)"
+func eip712StructType(s Struct) string {
+	members := make([]string, len(s.Fields))
+	for i, f := range s.Fields {
+		members[i] = eip712MemberType(*f.Type) + " " + f.RawName
+	}
+	return fmt.Sprintf("%s(%s)", s.Name, strings.Join(members, ","))
+}
+
+// EIP712Type returns the EIP-712 encodeType of the tuple, the struct type followed by
+// the types of the structs it references in alphabetical order.
+func EIP712Type(t ethabi.Type) string {
+	s := StructFromTuple(t)
+
+	referenced := make(map[string]Struct)
+	for _, f := range s.Fields {
+		VisitABIType(*f.Type, func(t ethabi.Type) {
+			if t.T == ethabi.TupleTy {
+				ref := StructFromTuple(t)
+				if ref.Name != s.Name {
+					referenced[ref.Name] = ref
+				}
+			}
+		})
+	}
+
+	var b strings.Builder
+	b.WriteString(eip712StructType(s))
+	for _, name := range SortedMapKeys(referenced) {
+		b.WriteString(eip712StructType(referenced[name]))
+	}
+	return b.String()
+}
+
+// genEIP712 generates the EIP-712 type hash and the HashStruct method of the tuple struct
+func (g *Generator) genEIP712(s Struct) {
+	defer g.within("struct %s", s.Name)()
+
+	typeString := EIP712Type(s.T)
+	var parts []string
+	for _, b := range abi.Keccak256([]byte(typeString)) {
+		parts = append(parts, fmt.Sprintf("0x%02x", b))
+	}
+
+	g.L("")
+	g.L("// %sEIP712Type is the EIP-712 type encoding of %s", s.Name, s.Name)
+	g.L("const %sEIP712Type = %q", s.Name, typeString)
+	g.L("")
+	g.L("// %sTypeHash is the EIP-712 type hash of %s", s.Name, s.Name)
+	g.L("var %sTypeHash = [32]byte{%s}", s.Name, strings.Join(parts, ", "))
+
+	g.L("")
+	g.L("// TypeHash returns the EIP-712 type hash of %s", s.Name)
+	g.L("func (t %s) TypeHash() [32]byte {", g.recv(s.Name))
+	g.L("\treturn %sTypeHash", s.Name)
+	g.L("}")
+
+	g.L("")
+	g.L("// HashStruct returns the EIP-712 hashStruct of %s, keccak256(typeHash ‖ encodeData(t))", s.Name)
+	g.L("func (t %s) HashStruct() ([32]byte, error) {", g.recv(s.Name))
+	g.L("\tbuf := make([]byte, %d)", 32*(len(s.Fields)+1))
+	g.L("\tcopy(buf, %sTypeHash[:])", s.Name)
+	for i, f := range s.Fields {
+		g.L("\t// %s", f.Name)
+		g.genEIP712Value(*f.Type, "t."+f.Name, fmt.Sprintf("buf[%d:%d]", 32*(i+1), 32*(i+2)), 0)
+	}
+	g.L("\treturn %sKeccak256Hash(buf), nil", g.StdPrefix)
+	g.L("}")
+}
+
+// genEIP712Value generates the code to write the EIP-712 encodeData word of the value ref to the 32 bytes dst,
+// level names the loop variables of the nested arrays.
+func (g *Generator) genEIP712Value(t ethabi.Type, ref, dst string, level int) {
+	switch t.T {
+	case ethabi.StringTy:
+		g.L("\tcopy(%s, %sKeccak256([]byte(%s)))", dst, g.StdPrefix, ref)
+	case ethabi.BytesTy:
+		g.L("\tcopy(%s, %sKeccak256(%s))", dst, g.StdPrefix, ref)
+	case ethabi.TupleTy:
+		g.L("\t{")
+		g.L("\t\thash, err := %s.HashStruct()", ref)
+		g.L("\t\tif err != nil {")
+		g.L("\t\t\treturn [32]byte{}, err")
+		g.L("\t\t}")
+		g.L("\t\tcopy(%s, hash[:])", dst)
+		g.L("\t}")
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		// arrays are the keccak256 of the concatenated encodeData of the elements
+		idx, elems := fmt.Sprintf("i%d", level), fmt.Sprintf("elems%d", level)
+		g.L("\t{")
+		g.L("\t\t%s := make([]byte, 32*len(%s))", elems, ref)
+		g.L("\t\tfor %s := range %s {", idx, ref)
+		g.genEIP712Value(*t.Elem, fmt.Sprintf("%s[%s]", ref, idx), fmt.Sprintf("%s[32*%s:32*%s+32]", elems, idx, idx), level+1)
+		g.L("\t\t}")
+		g.L("\t\tcopy(%s, %sKeccak256(%s))", dst, g.StdPrefix, elems)
+		g.L("\t}")
+	case ethabi.UintTy, ethabi.IntTy, ethabi.BoolTy, ethabi.AddressTy, ethabi.FixedBytesTy:
+		// atomic types are encoded as the abi word
+		g.L("\tif _, err := %s; err != nil {", g.genEncodeCall(t, ref, dst))
+		g.L("\t\treturn [32]byte{}, err")
+		g.L("\t}")
+	default:
+		g.errorf("unsupported EIP-712 type: %s", t.String())
+	}
+}
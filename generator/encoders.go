@@ -4,15 +4,14 @@ import (
 	"fmt"
 
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/yihuang/go-abi"
 )
 
 // genIntEncoding generates encoding for integer types
 func (g *Generator) genIntEncoding(t ethabi.Type) {
 	// Optimize small integer types to avoid big.Int overhead
-	if t.Size <= 64 {
+	if !g.isBigIntType(t) {
 		g.genSmallIntEncoding(t)
-	} else if t.T == ethabi.UintTy && g.Options.UseUint256 {
+	} else if g.Options.UseUint256 && isUint256Type(t) {
 		g.genUint256Encoding()
 	} else {
 		g.genBigIntEncoding(t)
@@ -21,6 +20,7 @@ func (g *Generator) genIntEncoding(t ethabi.Type) {
 
 // genUint256Encoding generates encoding for holiman/uint256.Int types
 func (g *Generator) genUint256Encoding() {
+	g.genNilIntegerCheck()
 	g.L("\tvalue.WriteToArray32((*[32]byte)(buf[:32]))")
 	g.L("\treturn 32, nil")
 }
@@ -28,13 +28,16 @@ func (g *Generator) genUint256Encoding() {
 // genSmallIntEncoding generates optimized encoding for small integer types
 func (g *Generator) genSmallIntEncoding(t ethabi.Type) {
 	if t.Size%8 != 0 {
-		panic(fmt.Sprintf("unsupported size %d for small integer decoding", t.Size))
+		g.errorf("unsupported size %d for small integer encoding", t.Size)
+		return
 	}
 
 	// For small integers, we can use direct binary decoding without big.Int
 	// Use the closest native integer type that fits
 	size := nativeSize(t.Size)
 	var nativeType string
+
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	if t.T == ethabi.IntTy {
 		nativeType = fmt.Sprintf("int%d", size)
 	} else {
@@ -81,20 +84,30 @@ func (g *Generator) genBigIntEncoding(t ethabi.Type) {
 		signed = "true"
 	}
 
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tif err := %sEncodeBigInt(value, buf[:32], %s); err != nil {", g.StdPrefix, signed)
 	g.L("\t\treturn 0, err")
 	g.L("\t}")
 	g.L("\treturn 32, nil")
 }
 
+// genNilIntegerCheck generates the check of the nil *uint256.Int value, EncodeBigInt checks the *big.Int
+func (g *Generator) genNilIntegerCheck() {
+	g.L("\tif value == nil {")
+	g.L("\t\treturn 0, %sErrNilInteger", g.StdPrefix)
+	g.L("\t}")
+}
+
 // genAddressEncoding generates encoding for address types
 func (g *Generator) genAddressEncoding() {
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tcopy(buf[12:32], value[:])")
 	g.L("\treturn 32, nil")
 }
 
 // genBoolEncoding generates encoding for boolean types
 func (g *Generator) genBoolEncoding() {
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tif value {")
 	g.L("\t\tbuf[31] = 1")
 	g.L("\t}")
@@ -104,10 +117,12 @@ func (g *Generator) genBoolEncoding() {
 // genStringEncoding generates encoding for string types
 func (g *Generator) genStringEncoding() {
 	g.L("\t// Encode length")
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tbinary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))")
 	g.L("\t")
-	g.L("\t// Encode data")
+	g.L("\t// Encode data and zero the padding")
 	g.L("\tcopy(buf[32:], []byte(value))")
+	g.L("\tclear(buf[32+len(value) : 32+%sPad32(len(value))])", g.StdPrefix)
 	g.L("\t")
 	g.L("\treturn 32 + %sPad32(len(value)), nil", g.StdPrefix)
 }
@@ -115,23 +130,36 @@ func (g *Generator) genStringEncoding() {
 // genBytesEncoding generates encoding for bytes types
 func (g *Generator) genBytesEncoding() {
 	g.L("\t// Encode length")
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tbinary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))")
 	g.L("\t")
-	g.L("\t// Encode data")
+	g.L("\t// Encode data and zero the padding")
 	g.L("\tcopy(buf[32:], value)")
+	g.L("\tclear(buf[32+len(value) : 32+%sPad32(len(value))])", g.StdPrefix)
 	g.L("\t")
 	g.L("\treturn 32 + %sPad32(len(value)), nil", g.StdPrefix)
 }
 
 // genFixedBytesEncoding generates encoding for fixed bytes types
 func (g *Generator) genFixedBytesEncoding(t ethabi.Type) {
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tcopy(buf[:%d], value[:])", t.Size)
 	g.L("\treturn %d, nil", t.Size)
 }
 
 // genSliceEncoding generates encoding for slice types
 func (g *Generator) genSliceEncoding(t ethabi.Type) {
+	if g.Options.Compact && t.Elem.T == ethabi.TupleTy {
+		if IsDynamicType(*t.Elem) {
+			g.L("\treturn %sEncodeDynamicSlice(buf, value)", g.StdPrefix)
+		} else {
+			g.L("\treturn %sEncodeStaticSlice(buf, value, %d)", g.StdPrefix, GetTypeSize(*t.Elem))
+		}
+		return
+	}
+
 	g.L("\t// Encode length")
+	g.L("\t%sClearWord(buf)", g.StdPrefix)
 	g.L("\tbinary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))")
 	g.L("\tbuf = buf[32:]")
 	g.L("\t")
@@ -153,6 +181,7 @@ func (g *Generator) genSliceEncoding(t ethabi.Type) {
 		g.L("\tdynamicOffset := len(value)*32")
 		g.L("\tfor _, elem := range value {")
 		g.L("\t\t// Write offset for element")
+		g.L("\t\t%sClearWord(buf[offset:])", g.StdPrefix)
 		g.L("\t\toffset += 32")
 		g.L("\t\tbinary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))")
 		g.L("")
@@ -197,6 +226,7 @@ func (g *Generator) genArrayEncoding(t ethabi.Type) {
 
 		g.L("\tdynamicOffset := 32 * %d", t.Size)
 		for i := 0; i < t.Size; i++ {
+			g.L("\t%sClearWord(buf[%d:])", g.StdPrefix, offset)
 			g.L("\tbinary.BigEndian.PutUint64(buf[%d+24:%d+32], uint64(dynamicOffset))", offset, offset)
 			offset += 32
 
@@ -213,10 +243,19 @@ func (g *Generator) genArrayEncoding(t ethabi.Type) {
 	}
 }
 
-// genTupleEncoding generates encoding for tuple types
-func (g *Generator) genTupleEncoding(t ethabi.Type) {
+// genTupleEncoding generates encoding for tuple types, returns the EncodeLayout instead of the size if layout
+func (g *Generator) genTupleEncoding(t ethabi.Type, layout bool) {
+	// the zero value returned on errors
+	fail := "0"
+	if layout {
+		fail = g.StdPrefix + "EncodeLayout{}"
+	}
+
 	g.L("\t// Encode tuple fields")
-	g.L("\tdynamicOffset := %sStaticSize // Start dynamic data after static section", abi.TupleStructName(t))
+	g.L("\tdynamicOffset := %sStaticSize // Start dynamic data after static section", TupleStructName(t))
+	if layout {
+		g.L("\tvar fields [%d]%sFieldLayout", len(t.TupleElems), g.StdPrefix)
+	}
 
 	// Generate encoding for each tuple element
 	if IsDynamicType(t) {
@@ -239,25 +278,40 @@ func (g *Generator) genTupleEncoding(t ethabi.Type) {
 		if !IsDynamicType(*elem) {
 			// Static field - encode directly
 			g.L("\tif _, err := %s; err != nil {", g.genEncodeCall(*elem, ref, fmt.Sprintf("buf[%d:]", offset)))
-			g.L("\t\treturn 0, err")
+			g.L("\t\treturn %s, err", fail)
 			g.L("\t}")
+			if layout {
+				g.L("\tfields[%d] = %sFieldLayout{Offset: %d, Length: %d}", i, g.StdPrefix, offset, GetTypeSize(*elem))
+			}
 			offset += GetTypeSize(*elem)
 		} else {
 			// Dynamic field - encode offset pointer and data in dynamic section
 			g.L("\t// Encode offset pointer")
+			g.L("\t%sClearWord(buf[%d:])", g.StdPrefix, offset)
 			g.L("\tbinary.BigEndian.PutUint64(buf[%d+24:%d+32], uint64(dynamicOffset))", offset, offset)
 			offset += 32
 
 			g.L("\t// Encode dynamic data")
 			g.L("\tn, err = %s", g.genEncodeCall(*elem, ref, "buf[dynamicOffset:]"))
 			g.L("\tif err != nil {")
-			g.L("\t\treturn 0, err")
+			g.L("\t\treturn %s, err", fail)
 			g.L("\t}")
+			if layout {
+				g.L("\tfields[%d] = %sFieldLayout{Offset: dynamicOffset, Length: n}", i, g.StdPrefix)
+			}
 			g.L("\tdynamicOffset += n")
 		}
 		g.L("")
 	}
 
+	if layout {
+		g.L("\treturn %sEncodeLayout{", g.StdPrefix)
+		g.L("\t\tStaticLen: %sStaticSize,", TupleStructName(t))
+		g.L("\t\tDynamicLen: dynamicOffset - %sStaticSize,", TupleStructName(t))
+		g.L("\t\tFields: fields[:],")
+		g.L("\t}, nil")
+		return
+	}
 	g.L("\treturn dynamicOffset, nil")
 }
 
@@ -274,7 +328,7 @@ func (g *Generator) genPackedIntEncoding(t ethabi.Type) {
 	g.L("\t\treturn 0, io.ErrShortBuffer")
 	g.L("\t}")
 
-	if byteSize <= 8 {
+	if !g.isBigIntType(t) {
 		// For sizes <= 8 bytes, use native integer types
 		switch byteSize {
 		case 1:
@@ -316,28 +370,40 @@ func (g *Generator) genPackedIntEncoding(t ethabi.Type) {
 			g.L("\tbinary.BigEndian.PutUint64(buf[:8], uint64(value))")
 		}
 	} else {
-		// For sizes > 8 bytes
-		if t.T == ethabi.UintTy && g.Options.UseUint256 {
+		// For sizes > 8 bytes or uniform big.Int
+		if g.Options.UseUint256 && isUint256Type(t) {
 			// Use uint256.Int for large unsigned integers when enabled
 			g.genPackedLargeUintEncoding(t)
 			return
 		}
-		// Use big.Int
-		if t.T == ethabi.IntTy {
-			g.L("\tif err := %sEncodeBigInt(value, buf[:%d], true); err != nil {", g.StdPrefix, byteSize)
-		} else {
-			g.L("\tif err := %sEncodeBigInt(value, buf[:%d], false); err != nil {", g.StdPrefix, byteSize)
-		}
-		g.L("\t\treturn 0, err")
-		g.L("\t}")
+		g.genPackedBigIntEncoding(t)
+		return
+	}
+
+	g.L("\treturn %d, nil", byteSize)
+}
+
+// genPackedBigIntEncoding generates packed encoding for big.Int types, the value is encoded
+// to a full word first and the low bytes are copied to the buffer
+func (g *Generator) genPackedBigIntEncoding(t ethabi.Type) {
+	byteSize := t.Size / 8
+	signed := "false"
+	if t.T == ethabi.IntTy {
+		signed = "true"
 	}
 
+	g.L("\tvar tmp [32]byte")
+	g.L("\tif err := %sEncodeBigInt(value, tmp[:], %s); err != nil {", g.StdPrefix, signed)
+	g.L("\t\treturn 0, err")
+	g.L("\t}")
+	g.L("\tcopy(buf[:%d], tmp[%d:])", byteSize, 32-byteSize)
 	g.L("\treturn %d, nil", byteSize)
 }
 
 // genPackedLargeUintEncoding generates packed encoding for large unsigned integers using uint256.Int
 func (g *Generator) genPackedLargeUintEncoding(t ethabi.Type) {
 	byteSize := t.Size / 8
+	g.genNilIntegerCheck()
 	if byteSize == 32 {
 		// Full 32 bytes - use WriteToArray32
 		g.L("\tvalue.WriteToArray32((*[32]byte)(buf[:32]))")
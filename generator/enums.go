@@ -0,0 +1,165 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// MarkEnums marks the uint8 arguments and tuple fields of abiDef whose internalType in abiJSON is an enum,
+// e.g. "enum MyContract.Status", to generate them as the named Go type Status with the Enums option. go-ethereum
+// drops the internalType of the non-tuple types, so the enum name is kept in the unused TupleRawName of the
+// uint8 type. abiDef must be parsed from abiJSON, the methods and events are matched the same way.
+func MarkEnums(abiDef ethabi.ABI, abiJSON []byte) error {
+	var items []struct {
+		Type    string
+		Name    string
+		Inputs  []ethabi.ArgumentMarshaling
+		Outputs []ethabi.ArgumentMarshaling
+	}
+	if err := json.Unmarshal(abiJSON, &items); err != nil {
+		return err
+	}
+
+	methods := make(map[string]bool)
+	events := make(map[string]bool)
+	for _, item := range items {
+		switch item.Type {
+		case "function":
+			name := ethabi.ResolveNameConflict(item.Name, func(s string) bool { return methods[s] })
+			methods[name] = true
+			if method, ok := abiDef.Methods[name]; ok {
+				markEnumArguments(method.Inputs, item.Inputs)
+				markEnumArguments(method.Outputs, item.Outputs)
+			}
+		case "event":
+			name := ethabi.ResolveNameConflict(item.Name, func(s string) bool { return events[s] })
+			events[name] = true
+			if event, ok := abiDef.Events[name]; ok {
+				markEnumArguments(event.Inputs, item.Inputs)
+			}
+		}
+	}
+	return nil
+}
+
+func markEnumArguments(args ethabi.Arguments, marshaling []ethabi.ArgumentMarshaling) {
+	for i := range min(len(args), len(marshaling)) {
+		markEnumType(&args[i].Type, marshaling[i].InternalType, marshaling[i].Components)
+	}
+}
+
+func markEnumType(t *ethabi.Type, internalType string, components []ethabi.ArgumentMarshaling) {
+	switch t.T {
+	case ethabi.UintTy:
+		if name, ok := strings.CutPrefix(internalType, "enum "); ok && t.Size == 8 {
+			// the enums are deduplicated by name without the contract, e.g. MyContract.Status
+			t.TupleRawName = Title.String(name[strings.LastIndex(name, ".")+1:])
+		}
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		// the internalType of the element drops the last dimension, e.g. enum Status[2][] -> enum Status[2]
+		if i := strings.LastIndex(internalType, "["); i >= 0 {
+			internalType = internalType[:i]
+		}
+		markEnumType(t.Elem, internalType, components)
+	case ethabi.TupleTy:
+		for i := range min(len(t.TupleElems), len(components)) {
+			markEnumType(t.TupleElems[i], components[i].InternalType, components[i].Components)
+		}
+	}
+}
+
+// enumName returns the Go type name of the enum marked by MarkEnums, empty if t is not an enum
+// or the Enums option is not set
+func (g *Generator) enumName(t ethabi.Type) string {
+	if !g.Options.Enums || t.T != ethabi.UintTy {
+		return ""
+	}
+	return t.TupleRawName
+}
+
+// typeIdentifier returns the identifier of t in the names of the generated functions,
+// enums are identified by their type name.
+func (g *Generator) typeIdentifier(t ethabi.Type) string {
+	if name := g.enumName(t); name != "" {
+		return name
+	}
+	switch t.T {
+	case ethabi.SliceTy:
+		return g.typeIdentifier(*t.Elem) + "Slice"
+	case ethabi.ArrayTy:
+		return fmt.Sprintf("%sArray%d", g.typeIdentifier(*t.Elem), t.Size)
+	default:
+		return GenTypeIdentifier(t)
+	}
+}
+
+// genEnums generates the named types of the enums used by the methods and events, and their
+// encoding functions delegating to the uint8 ones
+func (g *Generator) genEnums(abiDef ethabi.ABI) {
+	enums := make(map[string]ethabi.Type)
+	visit := func(t ethabi.Type) {
+		if name := g.enumName(t); name != "" {
+			enums[name] = t
+		}
+	}
+	for _, method := range abiDef.Methods {
+		for _, arg := range method.Inputs {
+			VisitABIType(arg.Type, visit)
+		}
+		for _, arg := range method.Outputs {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	for _, event := range abiDef.Events {
+		for _, arg := range event.Inputs {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	if g.sharedFile {
+		for _, t := range g.shared.types {
+			VisitABIType(t, visit)
+		}
+	}
+
+	for _, name := range SortedMapKeys(enums) {
+		t := enums[name]
+
+		// the encoding functions are named with the prefix of the file, only the type is shared
+		if !g.isSharedType(name) {
+			g.L("")
+			g.L("// %s is the Solidity enum %s, encoded as uint8", name, name)
+			g.L("type %s uint8", name)
+			g.L("")
+			g.L("// String returns the numeric value of %s, the names of the enum members are not part of the ABI", name)
+			g.L("func (e %s) String() string {", name)
+			g.L("\treturn fmt.Sprintf(\"%%d\", uint8(e))")
+			g.L("}")
+		}
+
+		g.L("")
+		g.L("// %s encodes %s to ABI bytes", g.genFuncName(t, "Encode"), name)
+		g.L("func %s(value %s, buf []byte) (int, error) {", g.genFuncName(t, "Encode"), name)
+		g.L("\treturn %sEncodeUint8(uint8(value), buf)", g.StdPrefix)
+		g.L("}")
+		g.L("")
+		g.L("// %s decodes %s from ABI bytes", g.genFuncName(t, "Decode"), name)
+		g.L("func %s(data []byte) (%s, int, error) {", g.genFuncName(t, "Decode"), name)
+		g.L("\tvalue, n, err := %sDecodeUint8(data)", g.StdPrefix)
+		g.L("\treturn %s(value), n, err", name)
+		g.L("}")
+		g.L("")
+		g.L("// %s encodes %s to packed ABI bytes (no padding)", g.genFuncName(t, "PackedEncode"), name)
+		g.L("func %s(value %s, buf []byte) (int, error) {", g.genFuncName(t, "PackedEncode"), name)
+		g.L("\treturn %sPackedEncodeUint8(uint8(value), buf)", g.StdPrefix)
+		g.L("}")
+		g.L("")
+		g.L("// %s decodes %s from packed ABI bytes (no padding)", g.genFuncName(t, "PackedDecode"), name)
+		g.L("func %s(data []byte) (%s, int, error) {", g.genFuncName(t, "PackedDecode"), name)
+		g.L("\tvalue, n, err := %sPackedDecodeUint8(data)", g.StdPrefix)
+		g.L("\treturn %s(value), n, err", name)
+		g.L("}")
+	}
+}
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestUnsupportedTypeError(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "register",
+			"inputs": [
+				{"name": "owner", "type": "address"},
+				{"name": "callback", "type": "function"}
+			],
+			"outputs": []
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	_, err = NewGenerator().GenerateFromABI(abiDef)
+	if err == nil {
+		t.Fatal("Expected error for unsupported function type")
+	}
+	for _, expected := range []string{"register(address,function)", "argument callback", "unsupported ABI type function"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error %q to contain %q", err, expected)
+		}
+	}
+
+	_, err = NewGenerator(Split(true)).GenerateFiles(abiDef)
+	if err == nil {
+		t.Fatal("Expected error for unsupported function type when splitting files")
+	}
+}
+
+func TestArraySizeError(t *testing.T) {
+	for _, size := range []string{"0", "65537"} {
+		abiJSON := `[{"type": "function", "name": "set", "inputs": [{"name": "values", "type": "uint256[` + size + `]"}], "outputs": []}]`
+
+		abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+		if err != nil {
+			t.Fatalf("Failed to parse ABI: %v", err)
+		}
+
+		_, err = NewGenerator().GenerateFromABI(abiDef)
+		if err == nil {
+			t.Fatalf("Expected error for array size %s", size)
+		}
+		expected := "argument values: array size of uint256[" + size + "] must be between 1 and 65536"
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error %q to contain %q", err, expected)
+		}
+	}
+}
+
+func TestInterfaceWithoutCallerError(t *testing.T) {
+	abiDef, err := abi.JSON(strings.NewReader(`[{"type": "function", "name": "pause", "inputs": [], "outputs": []}]`))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	_, err = NewGenerator(GenerateInterface(true)).GenerateFromABI(abiDef)
+	if err == nil || !strings.Contains(err.Error(), "Interface requires the Caller name") {
+		t.Errorf("Expected error for interface without caller, got %v", err)
+	}
+}
+
+func TestDecodeCtxIntervalError(t *testing.T) {
+	abiDef, err := abi.JSON(strings.NewReader(`[{"type": "function", "name": "f", "inputs": [{"name": "ids", "type": "uint256[]"}], "outputs": []}]`))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	_, err = NewGenerator(GenerateDecodeCtx(true, 0)).GenerateFromABI(abiDef)
+	if err == nil || !strings.Contains(err.Error(), "must be positive") {
+		t.Errorf("Expected error for zero interval, got %v", err)
+	}
+
+	// the interval is only used by DecodeCtx
+	if _, err = NewGenerator(GenerateDecodeCtx(false, 0)).GenerateFromABI(abiDef); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
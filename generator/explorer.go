@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultFetchTimeout is the default timeout for fetching ABI over HTTP
+const DefaultFetchTimeout = 30 * time.Second
+
+// explorerResponse is the response envelope of Etherscan-style block explorer APIs,
+// the ABI is JSON-encoded as a string in the result field
+type explorerResponse struct {
+	Status  string           `json:"status"`
+	Message string           `json:"message"`
+	Result  *json.RawMessage `json:"result"`
+}
+
+// UnwrapExplorerABI detects the Etherscan-style response envelope
+// `{"status":"1","message":"OK","result":"[...]"}` and returns the ABI JSON inside it,
+// other inputs are returned unchanged.
+func UnwrapExplorerABI(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return data, nil
+	}
+
+	var resp explorerResponse
+	if err := json.Unmarshal(trimmed, &resp); err != nil || resp.Result == nil {
+		// not an explorer response, let the ABI parser report errors
+		return data, nil
+	}
+
+	var result string
+	if err := json.Unmarshal(*resp.Result, &result); err != nil {
+		// result is not a string, not an explorer response
+		return data, nil
+	}
+
+	inner := bytes.TrimSpace([]byte(result))
+	if len(inner) == 0 || inner[0] != '[' {
+		return nil, fmt.Errorf("explorer API error (status %q, message %q): %s", resp.Status, resp.Message, result)
+	}
+	return inner, nil
+}
+
+// FetchABI fetches the ABI JSON from url over HTTP, unwrapping Etherscan-style responses
+func FetchABI(url string, timeout time.Duration) ([]byte, error) {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ABI: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch ABI: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch ABI: unexpected HTTP status %s", resp.Status)
+	}
+
+	return UnwrapExplorerABI(body)
+}
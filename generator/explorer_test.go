@@ -0,0 +1,82 @@
+package generator
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const transferABI = `[{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+func TestUnwrapExplorerABI(t *testing.T) {
+	wrapped := fmt.Sprintf(`{"status":"1","message":"OK","result":%s}`, strconv.Quote(transferABI))
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+		err      string
+	}{
+		{"raw abi", transferABI, transferABI, ""},
+		{"wrapped abi", wrapped, transferABI, ""},
+		{"solc artifact", `{"abi":[]}`, `{"abi":[]}`, ""},
+		{"non-string result", `{"result":[]}`, `{"result":[]}`, ""},
+		{"explorer error", `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`, "", "Contract source code not verified"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := UnwrapExplorerABI([]byte(tc.input))
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(result) != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestFetchABI(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/raw", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, transferABI)
+	})
+	mux.HandleFunc("/wrapped", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"status":"1","message":"OK","result":%s}`, strconv.Quote(transferABI))
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, transferABI)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for _, path := range []string{"/raw", "/wrapped"} {
+		result, err := FetchABI(server.URL+path, time.Second)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", path, err)
+		}
+		if string(result) != transferABI {
+			t.Errorf("%s: expected %s, got %s", path, transferABI, result)
+		}
+	}
+
+	if _, err := FetchABI(server.URL+"/missing", time.Second); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected HTTP status error, got %v", err)
+	}
+
+	if _, err := FetchABI(server.URL+"/slow", 50*time.Millisecond); err == nil || !strings.Contains(err.Error(), "failed to fetch ABI") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
@@ -76,3 +76,124 @@ func TestExternalTuples(t *testing.T) {
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && (s[0:len(substr)] == substr || contains(s[1:], substr)))
 }
+
+func TestExternalTuplesImportPath(t *testing.T) {
+	testCases := []struct {
+		value    string
+		typeName string
+		imp      *ImportSpec
+	}{
+		{"User", "User", nil},
+		{"types.User@github.com/org/shared/types", "types.User", &ImportSpec{Path: "github.com/org/shared/types"}},
+		{"t2.User@github.com/org/shared/types", "t2.User", &ImportSpec{Path: "github.com/org/shared/types", Alias: "t2"}},
+		{"github.com/org/shared/types.User", "types.User", &ImportSpec{Path: "github.com/org/shared/types"}},
+		{"t2=github.com/org/shared/types.User", "t2.User", &ImportSpec{Path: "github.com/org/shared/types", Alias: "t2"}},
+		// the package name doesn't include the major version suffix
+		{"github.com/org/shared/v2.Coin", "shared.Coin", &ImportSpec{Path: "github.com/org/shared/v2"}},
+		{"gopkg.in/yaml.v3.Node", "yaml.Node", &ImportSpec{Path: "gopkg.in/yaml.v3"}},
+		{"shared.Coin@github.com/org/shared/v2", "shared.Coin", &ImportSpec{Path: "github.com/org/shared/v2"}},
+		{"yaml.Node@gopkg.in/yaml.v3", "yaml.Node", &ImportSpec{Path: "gopkg.in/yaml.v3"}},
+		{"eth=github.com/ethereum/go-ethereum.Log", "eth.Log", &ImportSpec{Path: "github.com/ethereum/go-ethereum", Alias: "eth"}},
+		{"eth.Log@github.com/ethereum/go-ethereum", "eth.Log", &ImportSpec{Path: "github.com/ethereum/go-ethereum", Alias: "eth"}},
+	}
+	for _, tc := range testCases {
+		typeName, imp, err := ParseExternalTuple(tc.value)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tc.value, err)
+		}
+		if typeName != tc.typeName {
+			t.Errorf("%s: expected type name %s, got %s", tc.value, tc.typeName, typeName)
+		}
+		if (imp == nil) != (tc.imp == nil) || (imp != nil && *imp != *tc.imp) {
+			t.Errorf("%s: expected import %v, got %v", tc.value, tc.imp, imp)
+		}
+	}
+
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "processUserData",
+			"inputs": [
+				{
+					"name": "data",
+					"type": "tuple",
+					"components": [
+						{"name": "address", "type": "address"},
+						{"name": "name", "type": "string"},
+						{"name": "amount", "type": "uint256"}
+					]
+				}
+			],
+			"outputs": []
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	extTuples, err := ParseExternalTuples("Tupleb53c1574=shared.UserData@github.com/org/shared")
+	if err != nil {
+		t.Fatalf("Failed to parse external tuples: %v", err)
+	}
+	code, err := NewGenerator(ExternalTuples(extTuples)).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code with external tuples: %v", err)
+	}
+
+	if !strings.Contains(code, "\t\"github.com/org/shared\"\n") {
+		t.Error("Expected generated code to import the external tuple package")
+	}
+	if !strings.Contains(code, "Data shared.UserData") {
+		t.Error("Expected function input struct to use qualified external tuple type")
+	}
+	if strings.Contains(code, "@") {
+		t.Error("Expected import path to be stripped from the external tuple type")
+	}
+
+	extTuples, err = ParseExternalTuples("Tupleb53c1574=github.com/org/shared/v2.UserData")
+	if err != nil {
+		t.Fatalf("Failed to parse external tuples: %v", err)
+	}
+	code, err = NewGenerator(ExternalTuples(extTuples)).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code with external tuples: %v", err)
+	}
+	if !strings.Contains(code, "\t\"github.com/org/shared/v2\"\n") || !strings.Contains(code, "Data shared.UserData") {
+		t.Error("Expected the v2 package to be referred to by its package name")
+	}
+}
+
+func TestParseImportErrors(t *testing.T) {
+	spec, err := ParseImport("cmn=github.com/ethereum/go-ethereum/common")
+	if err != nil || spec != (ImportSpec{Path: "github.com/ethereum/go-ethereum/common", Alias: "cmn"}) {
+		t.Errorf("Unexpected import %v, error %v", spec, err)
+	}
+
+	for _, imp := range []string{"a=b=c", "", "cmn=", "my-alias=github.com/org/shared"} {
+		if _, err := ParseImport(imp); err == nil {
+			t.Errorf("%q: expected error", imp)
+		}
+	}
+
+	// the alias is required if the package name is not an identifier
+	for _, value := range []string{"a=b=c/types.User", "my-alias=github.com/org/shared/types.User", "github.com/ethereum/go-ethereum.Log"} {
+		if _, _, err := ParseExternalTuple(value); err == nil {
+			t.Errorf("%q: expected error", value)
+		}
+		if _, err := ParseExternalTuples("Tupleb53c1574=" + value); err == nil {
+			t.Errorf("%q: expected error from ParseExternalTuples", value)
+		}
+	}
+
+	// the options given directly to NewGenerator are reported by the generation
+	abiDef, err := abi.JSON(strings.NewReader(`[{"type": "function", "name": "pause", "inputs": [], "outputs": []}]`))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	_, err = NewGenerator(ExternalTuples(map[string]string{"Tupleb53c1574": "a=b=c/types.User"})).GenerateFromABI(abiDef)
+	if err == nil || !strings.Contains(err.Error(), "invalid import format") {
+		t.Errorf("Expected invalid import error, got %v", err)
+	}
+}
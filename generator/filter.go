@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// filterMethods returns a copy of abiDef with the methods and events selected by the IncludeMethods and
+// ExcludeMethods options, so the tuples only used by the dropped ones are not collected either. The methods
+// are matched by name or 4-byte selector hex, the events by name. An error is returned with the filtered
+// ABI if an included name matches nothing.
+func filterMethods(abiDef ethabi.ABI, opts Options) (ethabi.ABI, error) {
+	if len(opts.IncludeMethods) == 0 && len(opts.ExcludeMethods) == 0 {
+		return abiDef, nil
+	}
+
+	matched := make([]bool, len(opts.IncludeMethods))
+	keep := func(keys ...string) bool {
+		included := len(opts.IncludeMethods) == 0
+		for i, name := range opts.IncludeMethods {
+			if matchName(name, keys) {
+				matched[i] = true
+				included = true
+			}
+		}
+		for _, name := range opts.ExcludeMethods {
+			if matchName(name, keys) {
+				return false
+			}
+		}
+		return included
+	}
+
+	var available []string
+	methods := make(map[string]ethabi.Method, len(abiDef.Methods))
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		available = append(available, method.Name)
+		if keep(name, method.Name, "0x"+hex.EncodeToString(method.ID)) {
+			methods[name] = method
+		}
+	}
+	abiDef.Methods = methods
+
+	events := make(map[string]ethabi.Event, len(abiDef.Events))
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[name]
+		available = append(available, event.Name)
+		if keep(name, event.Name) {
+			events[name] = event
+		}
+	}
+	abiDef.Events = events
+
+	var missing []string
+	for i, name := range opts.IncludeMethods {
+		if !matched[i] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(available)
+		return abiDef, fmt.Errorf("methods or events not found in the ABI: %s, available: %s",
+			strings.Join(missing, ", "), strings.Join(slices.Compact(available), ", "))
+	}
+	return abiDef, nil
+}
+
+// matchName returns true if name is one of the keys, a selector hex is matched case-insensitively
+// with or without the 0x prefix
+func matchName(name string, keys []string) bool {
+	name = strings.TrimSpace(name)
+	selector := "0x" + strings.TrimPrefix(strings.ToLower(name), "0x")
+	for _, key := range keys {
+		if key == name || key == selector {
+			return true
+		}
+	}
+	return false
+}
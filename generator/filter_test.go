@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+const filterTestABI = `[
+	{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": [{"name": "", "type": "bool"}]},
+	{"type": "function", "name": "balanceOf", "inputs": [{"name": "account", "type": "address"}], "outputs": [{"name": "", "type": "uint256"}]},
+	{"type": "function", "name": "initialize", "inputs": [{"name": "config", "type": "tuple", "internalType": "struct Config", "components": [
+		{"name": "owner", "type": "address"}, {"name": "fee", "type": "uint16"}
+	]}], "outputs": []},
+	{"type": "function", "name": "upgradeTo", "inputs": [{"name": "impl", "type": "address"}], "outputs": []},
+	{"type": "event", "name": "Transfer", "inputs": [{"name": "from", "type": "address", "indexed": true}, {"name": "value", "type": "uint256", "indexed": false}]},
+	{"type": "event", "name": "Upgraded", "inputs": [{"name": "impl", "type": "address", "indexed": true}]}
+]`
+
+func TestIncludeMethods(t *testing.T) {
+	abiDef := mustParseABI(t, filterTestABI)
+
+	code, err := NewGenerator(IncludeMethods([]string{"transfer", " balanceOf", "Transfer"})).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	for _, name := range []string{"TransferCall", "BalanceOfCall", "TransferEvent"} {
+		if !strings.Contains(code, "type "+name+" struct") {
+			t.Errorf("Expected %s to be generated", name)
+		}
+	}
+	for _, name := range []string{"InitializeCall", "InitializeSelector", "UpgradeToCall", "UpgradedEvent", "type Config struct"} {
+		if strings.Contains(code, name) {
+			t.Errorf("Expected %s to be filtered out", name)
+		}
+	}
+
+	_, err = NewGenerator(IncludeMethods([]string{"transfer", "mint"})).GenerateFromABI(abiDef)
+	if err == nil {
+		t.Fatal("Expected an error for the unknown method")
+	}
+	if !strings.Contains(err.Error(), "not found in the ABI: mint, available: Transfer, Upgraded, balanceOf, initialize, transfer, upgradeTo") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestExcludeMethods(t *testing.T) {
+	abiDef := mustParseABI(t, filterTestABI)
+
+	// initialize by selector, upgradeTo by name
+	selector := "0x" + strings.ToUpper(hex.EncodeToString(abiDef.Methods["initialize"].ID))
+	code, err := NewGenerator(ExcludeMethods([]string{selector, "upgradeTo", "Upgraded"})).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	for _, name := range []string{"InitializeSelector", "UpgradeToSelector", "UpgradedEvent", "type Config struct"} {
+		if strings.Contains(code, name) {
+			t.Errorf("Expected %s to be filtered out", name)
+		}
+	}
+	for _, name := range []string{"TransferSelector", "BalanceOfSelector", "TransferEventTopic"} {
+		if !strings.Contains(code, name) {
+			t.Errorf("Expected %s to be generated", name)
+		}
+	}
+
+	// exclude takes precedence over include
+	code, err = NewGenerator(
+		IncludeMethods([]string{"transfer", "initialize"}),
+		ExcludeMethods([]string{"initialize"}),
+	).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if !strings.Contains(code, "TransferSelector") || strings.Contains(code, "InitializeSelector") {
+		t.Error("Expected only transfer to be generated")
+	}
+}
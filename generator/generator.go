@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"go/build/constraint"
+	"go/token"
 	"slices"
 	"strings"
 
@@ -17,10 +19,19 @@ var (
 		{Path: "encoding/binary"},
 		{Path: "math/big"},
 		{Path: "github.com/ethereum/go-ethereum/common"},
-		{Path: "github.com/ethereum/go-ethereum/crypto"},
 	}
 )
 
+// modulePath is the import path of the go-abi module, which provides the stdlib of the generated code
+const modulePath = "github.com/yihuang/go-abi"
+
+// Uint256FuncSuffix is appended to the names of the functions operating on holiman/uint256.Int
+const Uint256FuncSuffix = "U256"
+
+// BigIntFuncSuffix is appended to the names of the functions operating on *big.Int for the integer types
+// up to 64 bits, which are native Go types in the stdlib
+const BigIntFuncSuffix = "Big"
+
 type M = map[string]interface{}
 
 // ImportSpec represents a Go import with optional alias
@@ -42,10 +53,40 @@ type SelectorInfo struct {
 type Generator struct {
 	buf bytes.Buffer
 
+	// Output buffers of each file category when splitting files, see GenerateFiles
+	sections map[string]*bytes.Buffer
+	out      *bytes.Buffer
+
+	// Structs with RandomXxx functions, see GenerateFuzzTest
+	randomStructs []string
+
+	// Items of a combined ABI generated in the shared file, skipped in the contract files, see GenerateContracts
+	shared     sharedItems
+	sharedFile bool
+
+	// Generating the DecodeCtx variants of the decoders, see genDecodeCtxFunction
+	ctx bool
+
+	// Go names of the methods, events and errors renamed by kind and ABI name, see resolveNameCollisions
+	itemNames map[string]string
+
 	Options   Options
 	Imports   []ImportSpec
 	Selectors []SelectorInfo
 	StdPrefix string
+
+	// Name collisions resolved by renaming tuples in the last generation
+	Warnings []string
+
+	// Hash of the inputs written in the header, to skip regenerating unchanged outputs, see InputHash
+	InputHash string
+
+	// First error of the current generation and the item being generated, see errorf
+	err   error
+	scope string
+
+	// Error of the options which can't be reported by NewGenerator, see prepare
+	optionsErr error
 }
 
 // NewGenerator creates a new ABI code generator with standalone functions
@@ -55,8 +96,19 @@ func NewGenerator(opts ...Option) *Generator {
 	defaultImports := slices.Clone(DefaultImports)
 	stdPrefix := ""
 	if !opt.Stdlib {
-		defaultImports = append(defaultImports, ImportSpec{Path: "github.com/yihuang/go-abi"})
+		stdImport := ImportSpec{Path: modulePath}
 		stdPrefix = "abi."
+		if opt.StdPrefix != "" || opt.StdImport != "" {
+			// the fork may have another package name, import it as the prefix, validated by prepare
+			if opt.StdPrefix != "" {
+				stdPrefix = opt.StdPrefix
+			}
+			if opt.StdImport != "" {
+				stdImport.Path = opt.StdImport
+			}
+			stdImport.Alias = strings.TrimSuffix(stdPrefix, ".")
+		}
+		defaultImports = append(defaultImports, stdImport)
 	}
 
 	// Add uint256 import if using holiman/uint256
@@ -64,35 +116,242 @@ func NewGenerator(opts ...Option) *Generator {
 		defaultImports = append(defaultImports, ImportSpec{Path: "github.com/holiman/uint256"})
 	}
 
+	if opt.TestHelpers {
+		defaultImports = append(defaultImports, ImportSpec{Path: "math/rand"})
+	}
+
+	// XxxBackend takes ethereum.CallMsg
+	if opt.Caller != "" {
+		defaultImports = append(defaultImports, ImportSpec{Path: "github.com/ethereum/go-ethereum"})
+	}
+
+	if opt.DecodeCtx {
+		defaultImports = append(defaultImports, ImportSpec{Path: "context"})
+	}
+
+	// Resolve the import paths of external tuples, an invalid one is reported by prepare
+	var optionsErr error
+	externalTuples := make(map[string]string, len(opt.ExternalTuples))
+	for _, key := range SortedMapKeys(opt.ExternalTuples) {
+		typeName, imp, err := ParseExternalTuple(opt.ExternalTuples[key])
+		if err != nil {
+			if optionsErr == nil {
+				optionsErr = fmt.Errorf("external tuple %s: %w", key, err)
+			}
+			continue
+		}
+		externalTuples[key] = typeName
+		if imp != nil && !slices.Contains(defaultImports, *imp) && !slices.Contains(opt.ExtraImports, *imp) {
+			defaultImports = append(defaultImports, *imp)
+		}
+	}
+	opt.ExternalTuples = externalTuples
+
 	return &Generator{
 		Options:   *opt,
 		Imports:   append(defaultImports, opt.ExtraImports...),
 		Selectors: []SelectorInfo{},
 		StdPrefix: stdPrefix,
+
+		optionsErr: optionsErr,
 	}
 }
 
 func (g *Generator) L(format string, args ...any) {
-	fmt.Fprintf(&g.buf, format, args...)
-	fmt.Fprint(&g.buf, "\n")
+	out := g.out
+	if out == nil {
+		out = &g.buf
+	}
+	fmt.Fprintf(out, format, args...)
+	fmt.Fprint(out, "\n")
+}
+
+// section switches the output to the buffer of the file category when splitting files
+func (g *Generator) section(name string) {
+	if g.sections == nil {
+		return
+	}
+	buf, ok := g.sections[name]
+	if !ok {
+		buf = new(bytes.Buffer)
+		g.sections[name] = buf
+	}
+	g.out = buf
+}
+
+// fieldTag returns the struct tag for a field with the original ABI name
+func (g *Generator) fieldTag(rawName string) string {
+	naming := g.Options.JSONNaming
+	if (!g.Options.JSONTags && (naming == "" || naming == NamingABI)) || rawName == "" {
+		return ""
+	}
+	return fmt.Sprintf(" `json:\"%s\"`", ConvertCase(rawName, naming))
+}
+
+// structFieldTag returns the struct tag for the i-th field of the struct, the unnamed fields are
+// named field1, field2... verbatim, or after the UnnamedPrefix of the struct in other conventions.
+func (g *Generator) structFieldTag(s Struct, i int) string {
+	name := s.Fields[i].RawName
+	if name == "" {
+		prefix := s.UnnamedPrefix
+		if prefix == "" || g.Options.JSONNaming == "" || g.Options.JSONNaming == NamingABI {
+			prefix = "field"
+		}
+		name = fmt.Sprintf("%s%d", prefix, i+1)
+	}
+	return g.fieldTag(name)
+}
+
+// recv returns the receiver type for the generated methods of the named type
+func (g *Generator) recv(name string) string {
+	if g.Options.PointerReceivers {
+		return "*" + name
+	}
+	return name
+}
+
+// callName returns the name of the call struct of the method, e.g. TransferCall
+func (g *Generator) callName(method ethabi.Method) string {
+	return g.methodName(method) + g.Options.CallSuffix
+}
+
+// returnName returns the name of the return struct of the method, e.g. TransferReturn
+func (g *Generator) returnName(method ethabi.Method) string {
+	return g.methodName(method) + g.Options.ReturnSuffix
+}
+
+// eventName returns the name of the event struct, the indexed and data structs and
+// the topic are named after it, e.g. TransferEvent, TransferEventIndexed
+func (g *Generator) eventName(event ethabi.Event) string {
+	return g.eventBaseName(event) + g.Options.EventSuffix
+}
+
+// methodName returns the Go name of the method, e.g. Transfer, with a numeric suffix if it was renamed
+// by resolveNameCollisions
+func (g *Generator) methodName(method ethabi.Method) string {
+	if name, ok := g.itemNames["function "+method.Name]; ok {
+		return name
+	}
+	return Title.String(method.Name)
+}
+
+// eventBaseName returns the Go name of the event before the suffix, with a numeric suffix if it was renamed
+func (g *Generator) eventBaseName(event ethabi.Event) string {
+	if name, ok := g.itemNames["event "+event.Name]; ok {
+		return name
+	}
+	return event.Name
+}
+
+// errorName returns the Go name of the custom error, with a numeric suffix if it was renamed
+func (g *Generator) errorName(e ethabi.Error) string {
+	if name, ok := g.itemNames["error "+e.Name]; ok {
+		return name
+	}
+	return e.Name
+}
+
+// inputHashComment returns the header comment recording the input hash of the generated file
+func inputHashComment(hash string) string {
+	return "// Input hash: " + hash
+}
+
+// errorf records the first error of the generation, prefixed with the item being generated,
+// the generation carries on with the invalid code which is discarded at the end.
+func (g *Generator) errorf(format string, args ...any) {
+	if g.err != nil {
+		return
+	}
+	err := fmt.Errorf(format, args...)
+	if g.scope != "" {
+		err = fmt.Errorf("%s: %w", g.scope, err)
+	}
+	g.err = err
+}
+
+// within appends the item to the scope of the errors until the returned function is called
+func (g *Generator) within(format string, args ...any) func() {
+	scope := g.scope
+	if g.scope != "" {
+		g.scope += ", "
+	}
+	g.scope += fmt.Sprintf(format, args...)
+	return func() {
+		g.scope = scope
+	}
 }
 
 // GenerateFromABI generates Go code from ABI JSON using standalone functions
 func (g *Generator) GenerateFromABI(abiDef ethabi.ABI) (string, error) {
-	// Write build tag
+	g.genHeader()
+	g.genBody(abiDef)
+	if g.err != nil {
+		return g.buf.String(), g.err
+	}
+
+	// Format the generated code
+	return g.buf.String(), nil
+}
+
+// checkStdPrefix validates the StdPrefix option is a package name followed by a dot
+func checkStdPrefix(opts Options) error {
+	if opts.StdPrefix == "" && opts.StdImport == "" {
+		return nil
+	}
+	if opts.Stdlib {
+		return fmt.Errorf("the stdlib is generated without the prefix, StdPrefix and StdImport are not supported with Stdlib")
+	}
+	if opts.StdPrefix == "" {
+		return nil
+	}
+	name, ok := strings.CutSuffix(opts.StdPrefix, ".")
+	if !ok || !token.IsIdentifier(name) || name == "_" {
+		return fmt.Errorf("invalid StdPrefix %q, expected a package name followed by a dot, e.g. \"myabi.\"", opts.StdPrefix)
+	}
+	return nil
+}
+
+// checkBuildTag validates the build constraint expression of the option, and that it can be written
+// as the legacy +build lines if requested.
+func checkBuildTag(opts Options) error {
+	if opts.BuildTag == "" {
+		return nil
+	}
+	expr, err := constraint.Parse("//go:build " + opts.BuildTag)
+	if err != nil {
+		return fmt.Errorf("invalid build tag %q: %w", opts.BuildTag, err)
+	}
+	if opts.LegacyBuildTag {
+		if _, err := constraint.PlusBuildLines(expr); err != nil {
+			return fmt.Errorf("build tag %q: %w", opts.BuildTag, err)
+		}
+	}
+	return nil
+}
+
+// genHeader generates the build tag, package declaration and imports
+func (g *Generator) genHeader() {
+	// Write build tag, an invalid expression is reported by prepare
 	if g.Options.BuildTag != "" {
-		g.L("//go:build %s", g.Options.BuildTag)
-		g.L("")
-	} else if g.Options.UseUint256 {
-		g.L("//go:build uint256")
-		g.L("")
-	} else {
-		g.L("//go:build !uint256")
+		if expr, err := constraint.Parse("//go:build " + g.Options.BuildTag); err == nil {
+			g.L("//go:build %s", expr)
+			if g.Options.LegacyBuildTag {
+				lines, _ := constraint.PlusBuildLines(expr)
+				for _, line := range lines {
+					g.L("%s", line)
+				}
+			}
+		} else {
+			g.L("//go:build %s", g.Options.BuildTag)
+		}
 		g.L("")
 	}
 
 	// Write do not edit warning
 	g.L("// Code generated by go-abi. DO NOT EDIT.")
+	if g.InputHash != "" {
+		g.L("%s", inputHashComment(g.InputHash))
+	}
 	g.L("")
 
 	// Write package declaration
@@ -112,22 +371,40 @@ func (g *Generator) GenerateFromABI(abiDef ethabi.ABI) (string, error) {
 		g.L(")")
 		g.L("")
 	}
+}
+
+// genBody generates the code for all the items in the ABI
+func (g *Generator) genBody(abiDef ethabi.ABI) {
+	g.randomStructs = nil
+	g.err, g.scope = nil, ""
+	if abiDef = g.prepare(abiDef); g.err != nil {
+		return
+	}
 
 	// First, collect all tuple types needed for this ABI
 	var methods []ethabi.Method
 	for _, name := range SortedMapKeys(abiDef.Methods) {
 		methods = append(methods, abiDef.Methods[name])
 	}
+	// the methods shared with other contracts of a combined ABI are generated in the shared file
+	own := g.ownMethods(methods)
+	var events []ethabi.Event
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		events = append(events, abiDef.Events[name])
+	}
 
 	// Generate all selector constants at the beginning
-	g.genAllSelectors(methods)
+	g.section(SectionCalls)
+	g.genAllSelectors(own)
 
 	// Generate all tuple structs needed for this function FIRST
 	// This ensures tuple types are available for encoding function generation
-	g.genTuples(methods)
+	g.section(SectionTypes)
+	g.genTuples(methods, events)
+	g.genEnums(abiDef)
 
 	// Collect all types needed for encoding functions (excluding tuple types)
-	allTypes := g.collectAllTypes(methods)
+	allTypes := g.collectAllTypes(methods, events)
 
 	// Now generate functions in the order they were collected
 	for _, t := range allTypes {
@@ -147,6 +424,11 @@ func (g *Generator) GenerateFromABI(abiDef ethabi.ABI) (string, error) {
 	for _, t := range allTypes {
 		g.genDecodingFunction(t)
 	}
+	if g.Options.DecodeCtx {
+		for _, t := range allTypes {
+			g.genDecodeCtxFunction(t)
+		}
+	}
 
 	// Generate packed encoding functions (skip non-packable types)
 	for _, t := range allTypes {
@@ -158,35 +440,157 @@ func (g *Generator) GenerateFromABI(abiDef ethabi.ABI) (string, error) {
 		g.genPackedDecodingFunction(t)
 	}
 
+	// Generate top-level encoding functions for slices
+	for _, t := range allTypes {
+		if t.T != ethabi.SliceTy {
+			continue
+		}
+
+		g.genTopLevelSliceFunctions(t)
+	}
+
 	// Generate code for each function
-	for _, method := range methods {
+	for _, method := range own {
 		g.genFunction(method)
 	}
-
-	var events []ethabi.Event
-	for _, name := range SortedMapKeys(abiDef.Events) {
-		events = append(events, abiDef.Events[name])
+	g.section(SectionCalls)
+	g.genDecodeBySelector(methods)
+	if g.Options.PackUnpack {
+		g.genPackUnpack(methods)
 	}
 
+	g.section(SectionEvents)
 	g.genAllEventTopics(events)
 
 	// Generate code for each event
-	for _, name := range SortedMapKeys(abiDef.Events) {
-		event := abiDef.Events[name]
+	for _, event := range g.ownEvents(events) {
 		g.genEvent(event)
 	}
 
-	// Format the generated code
-	return g.buf.String(), nil
+	var errs, allErrs []ethabi.Error
+	for _, name := range SortedMapKeys(abiDef.Errors) {
+		allErrs = append(allErrs, abiDef.Errors[name])
+		if _, shared := g.shared.abi.Errors[name]; shared && !g.sharedFile {
+			continue
+		}
+		errs = append(errs, abiDef.Errors[name])
+	}
+
+	g.section(SectionCalls)
+	g.genAllErrorSelectors(errs)
+	g.genSelectorRegistry(methods, allErrs)
+
+	if abiDef.HasFallback() && (g.sharedFile || !g.shared.abi.HasFallback()) {
+		g.genFallback(abiDef.Fallback)
+	}
+	if abiDef.HasReceive() && (g.sharedFile || !g.shared.abi.HasReceive()) {
+		g.genReceive(abiDef.Receive)
+	}
+
+	if g.Options.Client != "" {
+		g.genClient(methods)
+	}
+	if g.Options.Caller != "" {
+		g.genCaller(methods)
+	}
+}
+
+// prepare validates the options and the types of abiDef, and returns a copy with the methods and events
+// filtered and the tuples named and renamed as configured, the error is kept in g.err.
+func (g *Generator) prepare(abiDef ethabi.ABI) ethabi.ABI {
+	if g.err = g.optionsErr; g.err != nil {
+		return abiDef
+	}
+	if g.err = checkSuffixes(g.Options); g.err != nil {
+		return abiDef
+	}
+	if g.err = checkBuildTag(g.Options); g.err != nil {
+		return abiDef
+	}
+	if g.err = checkStdPrefix(g.Options); g.err != nil {
+		return abiDef
+	}
+	if abiDef, g.err = filterMethods(abiDef, g.Options); g.err != nil {
+		return abiDef
+	}
+	if g.Options.Interface && g.Options.Caller == "" {
+		g.err = fmt.Errorf("the interface is implemented by the caller, Interface requires the Caller name")
+		return abiDef
+	}
+	if g.Options.DecodeCtx && g.Options.DecodeCtxInterval <= 0 {
+		g.err = fmt.Errorf("the interval of the context checks must be positive, got %d", g.Options.DecodeCtxInterval)
+		return abiDef
+	}
+	if g.Options.NameTuplesByFunction {
+		abiDef = nameTuplesByFunction(abiDef)
+	}
+	abiDef, g.Warnings = g.resolveNameCollisions(abiDef)
+	if g.checkTypes(abiDef); g.err != nil {
+		return abiDef
+	}
+	g.err = g.checkNames(abiDef)
+	return abiDef
+}
+
+// supportedType returns if the generator supports the kind of t, e.g. function and fixed point types are not
+func supportedType(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.IntTy, ethabi.UintTy, ethabi.BoolTy, ethabi.StringTy, ethabi.SliceTy, ethabi.ArrayTy,
+		ethabi.TupleTy, ethabi.AddressTy, ethabi.FixedBytesTy, ethabi.BytesTy:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkTypes reports the first argument of the methods, events and errors using a type the generator
+// doesn't support, e.g. function or fixed point types, before any code is generated.
+func (g *Generator) checkTypes(abiDef ethabi.ABI) {
+	check := func(args ethabi.Arguments) {
+		for i, arg := range args {
+			name := arg.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i+1)
+			}
+			VisitABIType(arg.Type, func(t ethabi.Type) {
+				if !supportedType(t) {
+					g.errorf("argument %s: unsupported ABI type %s", name, t.String())
+				}
+				if t.T == ethabi.ArrayTy && (t.Size < 1 || t.Size > abi.MaxArraySize) {
+					g.errorf("argument %s: array size of %s must be between 1 and %d", name, t.String(), abi.MaxArraySize)
+				}
+			})
+		}
+	}
+
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		restore := g.within("function %s", method.Sig)
+		check(method.Inputs)
+		check(method.Outputs)
+		restore()
+	}
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[name]
+		restore := g.within("event %s", event.Sig)
+		check(event.Inputs)
+		restore()
+	}
+	for _, name := range SortedMapKeys(abiDef.Errors) {
+		e := abiDef.Errors[name]
+		restore := g.within("error %s", e.Sig)
+		check(e.Inputs)
+		restore()
+	}
 }
 
 // collectAllTypes collects all unique ABI types needed for encoding functions
-func (g *Generator) collectAllTypes(methods []ethabi.Method) []ethabi.Type {
+func (g *Generator) collectAllTypes(methods []ethabi.Method, events []ethabi.Event) []ethabi.Type {
 	typeSet := make(map[string]ethabi.Type)
 
 	var collectTypes func(t ethabi.Type)
 	collectTypes = func(t ethabi.Type) {
-		typeID := abi.GenTypeIdentifier(t)
+		typeID := g.typeIdentifier(t)
 		if _, exists := typeSet[typeID]; !exists {
 			typeSet[typeID] = t
 		}
@@ -215,13 +619,25 @@ func (g *Generator) collectAllTypes(methods []ethabi.Method) []ethabi.Type {
 			collectTypes(output.Type)
 		}
 	}
+	// Collect from events, including the indexed fields hashed into the topics
+	for _, event := range events {
+		for _, input := range event.Inputs {
+			collectTypes(input.Type)
+		}
+	}
+	if g.sharedFile {
+		// the shared tuples are not necessarily used by the shared methods
+		for _, name := range SortedMapKeys(g.shared.types) {
+			collectTypes(g.shared.types[name])
+		}
+	}
 
 	// Convert map to slice
 	result := make([]ethabi.Type, 0, len(typeSet))
 	for _, name := range SortedMapKeys(typeSet) {
 		t := typeSet[name]
-		if t.T == ethabi.TupleTy {
-			// Skip tuple types since they have their own struct methods
+		if t.T == ethabi.TupleTy || g.enumName(t) != "" {
+			// Skip tuple types since they have their own struct methods, enums are generated with their types
 			continue
 		}
 		result = append(result, t)
@@ -230,16 +646,124 @@ func (g *Generator) collectAllTypes(methods []ethabi.Method) []ethabi.Type {
 }
 
 func (g *Generator) genFuncName(t ethabi.Type, fn string) string {
-	typeID := abi.GenTypeIdentifier(t)
-	if !g.Options.Stdlib && abi.IsStdlibType(typeID) {
+	typeID := g.typeIdentifier(t)
+	suffix := ""
+	if g.Options.UseUint256 && isUint256Type(t) {
+		// distinguish from the *big.Int functions
+		suffix = Uint256FuncSuffix
+	} else if g.Options.UniformBigInt && g.isNativeIntType(t) {
+		// distinguish from the native integer functions
+		suffix = BigIntFuncSuffix
+	}
+	// the stdlib decodes the zero-length slices to empty slices, the NilSlices decoders are generated locally,
+	// so are the DecodeCtx variants
+	local := g.Options.NilSlices && t.T == ethabi.SliceTy && strings.HasPrefix(fn, "Decode") || fn == "DecodeCtx"
+	if g.Options.StringViews && !g.Options.Stdlib && strings.HasPrefix(fn, "Decode") && containsString(t) {
+		if t.T == ethabi.StringTy {
+			return g.StdPrefix + "DecodeStringView"
+		}
+		// the stdlib copies the strings, the arrays of strings are decoded locally
+		local = true
+	}
+	if !g.Options.Stdlib && IsStdlibType(typeID) && suffix != BigIntFuncSuffix && !local {
 		// Use standard library prefix for stdlib types
-		return fmt.Sprintf("%s%s%s", g.StdPrefix, fn, typeID)
+		return fmt.Sprintf("%s%s%s%s", g.StdPrefix, fn, typeID, suffix)
+	}
+	return fmt.Sprintf("%s%s%s%s", ToCamel(g.Options.Prefix), fn, typeID, suffix)
+}
+
+// containsString returns true if t is a string or an array of strings, tuples are not included
+// as they are decoded by their struct methods.
+func containsString(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.StringTy:
+		return true
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		return containsString(*t.Elem)
+	default:
+		return false
+	}
+}
+
+// isBigIntType returns true if the Go type of t is a pointer to big.Int or uint256.Int
+func (g *Generator) isBigIntType(t ethabi.Type) bool {
+	return (t.T == ethabi.UintTy || t.T == ethabi.IntTy) && (t.Size > 64 || g.Options.UniformBigInt) && g.enumName(t) == ""
+}
+
+// isNativeIntType returns true if the Go type of t depends on the uniform big.Int option,
+// tuples are not included as their structs are local to the generated package, nor enums.
+func (g *Generator) isNativeIntType(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.UintTy, ethabi.IntTy:
+		return t.Size <= 64 && g.enumName(t) == ""
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		return g.isNativeIntType(*t.Elem)
+	default:
+		return false
+	}
+}
+
+// isUint256Type returns true if the Go type of t depends on the uint256 option,
+// tuples are not included as their structs are local to the generated package.
+func isUint256Type(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.UintTy:
+		return t.Size > 64
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		return isUint256Type(*t.Elem)
+	default:
+		return false
+	}
+}
+
+// genTopLevelSliceFunctions generates the functions to encode/decode a slice as a single
+// top-level ABI value, which includes the leading offset word.
+func (g *Generator) genTopLevelSliceFunctions(t ethabi.Type) {
+	funcName := g.genFuncName(t, "EncodeTopLevel")
+	if strings.Contains(funcName, ".") {
+		// Skip generating functions for stdlib types
+		return
 	}
-	return fmt.Sprintf("%s%s%s", ToCamel(g.Options.Prefix), fn, typeID)
+
+	goType := g.abiTypeToGoType(t)
+
+	g.L("")
+	g.L("// %s encodes %s to ABI bytes as a single top-level value, including the leading offset word", funcName, t.String())
+	g.L("func %s(value %s) ([]byte, error) {", funcName, goType)
+	g.L("\tbuf := make([]byte, 32+%s(value))", g.genFuncName(t, "Size"))
+	g.L("\tbinary.BigEndian.PutUint64(buf[24:32], 32)")
+	g.L("\tif _, err := %s(value, buf[32:]); err != nil {", g.genFuncName(t, "Encode"))
+	g.L("\t\treturn nil, err")
+	g.L("\t}")
+	g.L("\treturn buf, nil")
+	g.L("}")
+
+	funcName = g.genFuncName(t, "DecodeTopLevel")
+	g.L("")
+	g.L("// %s decodes %s from ABI bytes of a single top-level value, including the leading offset word", funcName, t.String())
+	g.L("func %s(data []byte) (%s, int, error) {", funcName, goType)
+	g.L("\tif len(data) < 32 {")
+	g.L("\t\treturn nil, 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
+	g.L("\toffset, err := %sDecodeSize(data)", g.StdPrefix)
+	g.L("\tif err != nil {")
+	g.L("\t\treturn nil, 0, err")
+	g.L("\t}")
+	g.L("\tif offset != 32 {")
+	g.L("\t\treturn nil, 0, %sErrInvalidOffsetForDynamicField", g.StdPrefix)
+	g.L("\t}")
+	g.L("\tresult, n, err := %s(data[32:])", g.genFuncName(t, "Decode"))
+	g.L("\tif err != nil {")
+	g.L("\t\treturn nil, 0, err")
+	g.L("\t}")
+	g.L("\treturn result, 32 + n, nil")
+	g.L("}")
 }
 
 // genEncodingFunction generates a standalone encoding function for a specific ABI type
 func (g *Generator) genEncodingFunction(t ethabi.Type) {
+	defer g.within("type %s", t.String())()
+
 	funcName := g.genFuncName(t, "Encode")
 	if strings.Contains(funcName, ".") {
 		// Skip generating decoding function for stdlib types
@@ -271,9 +795,9 @@ func (g *Generator) genEncodingFunction(t ethabi.Type) {
 	case ethabi.ArrayTy:
 		g.genArrayEncoding(t)
 	case ethabi.TupleTy:
-		panic("tuple types should use struct methods for encoding")
+		g.errorf("tuple types should use struct methods for encoding")
 	default:
-		panic("unsupported ABI type for encoding function generation: " + t.String())
+		g.errorf("unsupported ABI type for encoding function generation: %s", t.String())
 	}
 
 	g.L("}")
@@ -281,6 +805,8 @@ func (g *Generator) genEncodingFunction(t ethabi.Type) {
 
 // genSizeFunction generates a standalone size calculation function for a specific ABI type
 func (g *Generator) genSizeFunction(t ethabi.Type) {
+	defer g.within("type %s", t.String())()
+
 	funcName := g.genFuncName(t, "Size")
 	if strings.Contains(funcName, ".") {
 		// Skip generating decoding function for stdlib types
@@ -323,7 +849,7 @@ func (g *Generator) genSizeFunction(t ethabi.Type) {
 			// Dynamic tuple, just call tuple struct method
 			g.L("\tsize := value.EncodedSize() // dynamic tuple")
 		default:
-			panic("impossible")
+			g.errorf("unsupported dynamic ABI type for size function generation: %s", t.String())
 		}
 		g.L("\treturn size")
 	}
@@ -333,6 +859,8 @@ func (g *Generator) genSizeFunction(t ethabi.Type) {
 
 // genDecodingFunction generates a standalone decoding function for a specific ABI type
 func (g *Generator) genDecodingFunction(t ethabi.Type) {
+	defer g.within("type %s", t.String())()
+
 	funcName := g.genFuncName(t, "Decode")
 	if strings.Contains(funcName, ".") {
 		// Skip generating decoding function for stdlib types
@@ -341,6 +869,30 @@ func (g *Generator) genDecodingFunction(t ethabi.Type) {
 
 	goType := g.abiTypeToGoType(t)
 
+	if t.T == ethabi.SliceTy || g.isBigIntType(t) {
+		intoName := g.genFuncName(t, "DecodeInto")
+		g.L("")
+		g.L("// %s decodes %s from ABI bytes", funcName, t.String())
+		g.L("func %s(data []byte) (%s, int, error) {", funcName, goType)
+		g.L("\treturn %s(nil, data)", intoName)
+		g.L("}")
+
+		g.L("")
+		if t.T == ethabi.SliceTy {
+			g.L("// %s decodes %s from ABI bytes, reusing the backing array of dst", intoName, t.String())
+		} else {
+			g.L("// %s decodes %s from ABI bytes into dst, allocates if dst is nil", intoName, t.String())
+		}
+		g.L("func %s(dst %s, data []byte) (%s, int, error) {", intoName, goType, goType)
+		if t.T == ethabi.SliceTy {
+			g.genSliceDecoding(t)
+		} else {
+			g.genIntDecoding(t)
+		}
+		g.L("}")
+		return
+	}
+
 	g.L("")
 	g.L("// %s decodes %s from ABI bytes", funcName, t.String())
 	g.L("func %s(data []byte) (%s, int, error) {", funcName, goType)
@@ -359,29 +911,27 @@ func (g *Generator) genDecodingFunction(t ethabi.Type) {
 		g.genBytesDecoding()
 	case ethabi.FixedBytesTy:
 		g.genFixedBytesDecoding(t)
-	case ethabi.SliceTy:
-		g.genSliceDecoding(t)
 	case ethabi.ArrayTy:
 		g.genArrayDecoding(t)
 	case ethabi.TupleTy:
-		panic("tuple types should use struct methods for decoding")
+		g.errorf("tuple types should use struct methods for decoding")
 	default:
-		panic("unsupported ABI type for decoding function generation: " + t.String())
+		g.errorf("unsupported ABI type for decoding function generation: %s", t.String())
 	}
 
 	g.L("}")
 }
 
 // genTuples generates all tuple structs needed for a function
-func (g *Generator) genTuples(methods []ethabi.Method) {
-	// Collect all tuple types from function inputs and outputs
+func (g *Generator) genTuples(methods []ethabi.Method, events []ethabi.Event) {
+	// Collect all tuple types from function inputs and outputs, and event inputs
 	tupleTypes := make(map[string]ethabi.Type)
 
 	var collectTupleVisitor = func(t ethabi.Type) {
 		if t.T != ethabi.TupleTy {
 			return
 		}
-		tupleTypes[abi.TupleStructName(t)] = t
+		tupleTypes[TupleStructName(t)] = t
 	}
 
 	// Collect tuples from all methods
@@ -395,6 +945,17 @@ func (g *Generator) genTuples(methods []ethabi.Method) {
 			VisitABIType(output.Type, collectTupleVisitor)
 		}
 	}
+	// Collect tuples used only by events
+	for _, event := range events {
+		for _, input := range event.Inputs {
+			VisitABIType(input.Type, collectTupleVisitor)
+		}
+	}
+	if g.sharedFile {
+		for _, t := range g.shared.types {
+			VisitABIType(t, collectTupleVisitor)
+		}
+	}
 
 	// Generate struct definitions for collected tuples
 	for _, name := range SortedMapKeys(tupleTypes) {
@@ -403,35 +964,53 @@ func (g *Generator) genTuples(methods []ethabi.Method) {
 			// Skip generating this tuple since it uses an external implementation
 			continue
 		}
+		if g.isSharedType(name) {
+			continue
+		}
 
 		tupleType := tupleTypes[name]
 		s := StructFromTuple(tupleType)
 		g.genStruct(s)
+		if g.Options.EIP712 {
+			g.genEIP712(s)
+		}
 	}
 }
 
 // genStruct generates a struct definition
 func (g *Generator) genStruct(s Struct) {
+	defer g.within("struct %s", s.Name)()
+
 	g.L("")
 	g.L("const %sStaticSize = %d", s.Name, GetTupleSize(s.Types()))
 	g.L("")
-	// assert interface
-	g.L("var _ %sTuple = (*%s)(nil)", g.StdPrefix, s.Name)
-	// assert PackedTuple interface if all fields are packable
-	if g.canPackStruct(s) {
-		g.L("var _ %sPackedTuple = (*%s)(nil)", g.StdPrefix, s.Name)
-	}
 	g.L("// %s represents an ABI tuple", s.Name)
 	g.L("type %s struct {", s.Name)
 
-	for _, f := range s.Fields {
+	for i, f := range s.Fields {
+		restore := g.within("field %s", f.Name)
 		goType := g.abiTypeToGoType(*f.Type)
-		g.L("%s %s", f.Name, goType)
+		restore()
+		g.L("%s %s%s", f.Name, goType, g.structFieldTag(s, i))
 	}
 	g.L("}")
 
 	// Generate encode method for the tuple struct
 	g.genStructMethods(s)
+
+	// assert the interfaces after the methods are generated, a struct missing the encoder or the decoder
+	// fails to compile
+	g.L("")
+	g.L("var _ %sTuple = (*%s)(nil)", g.StdPrefix, s.Name)
+	g.L("var _ %sDecoder = (*%s)(nil)", g.StdPrefix, s.Name)
+	// assert PackedTuple interface if all fields are packable
+	if g.canPackStruct(s) {
+		g.L("var _ %sPackedTuple = (*%s)(nil)", g.StdPrefix, s.Name)
+	}
+
+	if g.Options.TestHelpers {
+		g.genRandomFunc(s)
+	}
 }
 
 // genStructMethods generates Encode/Decode methods for tuple structs
@@ -441,11 +1020,14 @@ func (g *Generator) genStructMethods(s Struct) {
 
 	// Generate EncodeTo method that calls standalone function
 	g.genStructEncodeTo(s)
+	if g.Options.Layout {
+		g.genStructEncodeToDetailed(s)
+	}
 
 	// Generate Encode method
 	g.L("")
 	g.L("// Encode encodes %s to ABI bytes", s.Name)
-	g.L("func (value %s) Encode() ([]byte, error) {", s.Name)
+	g.L("func (value %s) Encode() ([]byte, error) {", g.recv(s.Name))
 	g.L("\tbuf := make([]byte, value.EncodedSize())")
 	g.L("\tif _, err := value.EncodeTo(buf); err != nil {")
 	g.L("\t\treturn nil, err")
@@ -454,7 +1036,32 @@ func (g *Generator) genStructMethods(s Struct) {
 	g.L("}")
 
 	// Generate Decode method
-	g.genStructDecode(s)
+	g.genStructDecode(s, false)
+	g.genStructDecodeStrict(s)
+	g.genStructDecodeHex(s)
+	if g.Options.DecodeInto {
+		g.genStructDecode(s, true)
+		g.genStructReset(s)
+	}
+	if g.Options.DecodeCtx && g.decodesSlices(s.T) {
+		g.genStructDecodeCtx(s)
+	}
+
+	if g.Options.GenerateClone {
+		g.genStructClone(s)
+	}
+
+	if g.Options.BinaryMarshaler {
+		g.genStructBinaryMarshaler(s)
+	}
+
+	if g.Options.ToMap {
+		g.genStructToMap(s)
+	}
+
+	if g.Options.BigSetters {
+		g.genStructBigSetters(s)
+	}
 
 	// Generate packed methods if all fields are packable
 	if g.canPackStruct(s) {
@@ -480,7 +1087,7 @@ func (g *Generator) genPackedEncodedSize(s Struct) {
 	packedSize := GetPackedTupleSize(s.Types())
 	g.L("")
 	g.L("// PackedEncodedSize returns the packed encoded size of %s", s.Name)
-	g.L("func (t %s) PackedEncodedSize() int {", s.Name)
+	g.L("func (t %s) PackedEncodedSize() int {", g.recv(s.Name))
 	g.L("\treturn %d", packedSize)
 	g.L("}")
 }
@@ -489,7 +1096,7 @@ func (g *Generator) genPackedEncodedSize(s Struct) {
 func (g *Generator) genStructPackedEncodeTo(s Struct) {
 	g.L("")
 	g.L("// PackedEncodeTo encodes %s to packed ABI bytes in the provided buffer", s.Name)
-	g.L("func (value %s) PackedEncodeTo(buf []byte) (int, error) {", s.Name)
+	g.L("func (value %s) PackedEncodeTo(buf []byte) (int, error) {", g.recv(s.Name))
 
 	g.genPackedTupleEncoding(s.T)
 
@@ -500,7 +1107,7 @@ func (g *Generator) genStructPackedEncodeTo(s Struct) {
 func (g *Generator) genStructPackedEncode(s Struct) {
 	g.L("")
 	g.L("// PackedEncode encodes %s to packed ABI bytes", s.Name)
-	g.L("func (value %s) PackedEncode() ([]byte, error) {", s.Name)
+	g.L("func (value %s) PackedEncode() ([]byte, error) {", g.recv(s.Name))
 	g.L("\tbuf := make([]byte, value.PackedEncodedSize())")
 	g.L("\tif _, err := value.PackedEncodeTo(buf); err != nil {")
 	g.L("\t\treturn nil, err")
@@ -545,22 +1152,181 @@ func (g *Generator) genStructPackedDecode(s Struct) {
 	g.L("}")
 }
 
+// genStructDecodeHex generates the DecodeHex method decoding from the 0x prefixed hex string,
+// the hex errors are prefixed with the struct name.
+func (g *Generator) genStructDecodeHex(s Struct) {
+	g.L("")
+	g.L("// DecodeHex decodes %s from the hex string with an optional 0x prefix", s.Name)
+	g.L("func (t *%s) DecodeHex(s string) (int, error) {", s.Name)
+	g.L("	data, err := %sFromHex(s)", g.StdPrefix)
+	g.L("	if err != nil {")
+	g.L("		return 0, fmt.Errorf(\"decode %s: %%w\", err)", s.Name)
+	g.L("	}")
+	g.L("	return t.Decode(data)")
+	g.L("}")
+}
+
+// genStructBigSetters generates the SetXxxFromBig setters of the fields with native integer types,
+// converting the *big.Int values held by the callers with the range of the ABI type checked.
+func (g *Generator) genStructBigSetters(s Struct) {
+	for _, f := range s.Fields {
+		t := *f.Type
+		if (t.T != ethabi.UintTy && t.T != ethabi.IntTy) || g.isBigIntType(t) || g.enumName(t) != "" {
+			continue
+		}
+
+		convert := "UintFromBig"
+		if t.T == ethabi.IntTy {
+			convert = "IntFromBig"
+		}
+		goType := g.abiTypeToGoType(t)
+
+		g.L("")
+		g.L("// Set%sFromBig sets %s from v, returns %sErrIntegerOutOfRange if it doesn't fit in %s", f.Name, f.Name, g.StdPrefix, t.String())
+		g.L("func (t *%s) Set%sFromBig(v *big.Int) error {", s.Name, f.Name)
+		g.L("	n, err := %s%s[%s](v, %d)", g.StdPrefix, convert, goType, t.Size)
+		g.L("	if err != nil {")
+		g.L("		return err")
+		g.L("	}")
+		g.L("	t.%s = n", f.Name)
+		g.L("	return nil")
+		g.L("}")
+	}
+}
+
 // genStructEncodeTo generates the EncodeTo method that calls standalone function
 func (g *Generator) genStructEncodeTo(s Struct) {
 	g.L("")
 	g.L("// EncodeTo encodes %s to ABI bytes in the provided buffer", s.Name)
-	g.L("func (value %s) EncodeTo(buf []byte) (int, error) {", s.Name)
+	g.L("func (value %s) EncodeTo(buf []byte) (int, error) {", g.recv(s.Name))
+
+	g.genTupleEncoding(s.T, false)
+
+	g.L("}")
+}
+
+// genStructEncodeToDetailed generates the EncodeToDetailed method returning the layout of the encoded fields
+func (g *Generator) genStructEncodeToDetailed(s Struct) {
+	g.L("")
+	g.L("// EncodeToDetailed encodes %s to ABI bytes in the provided buffer, returns the byte ranges of the fields", s.Name)
+	g.L("func (value %s) EncodeToDetailed(buf []byte) (%sEncodeLayout, error) {", g.recv(s.Name), g.StdPrefix)
 
-	g.genTupleEncoding(s.T)
+	g.genTupleEncoding(s.T, true)
 
 	g.L("}")
 }
 
+// genStructClone generates the Clone method which deep-copies the struct
+func (g *Generator) genStructClone(s Struct) {
+	g.L("")
+	g.L("// Clone returns a deep copy of %s", s.Name)
+	g.L("func (t %s) Clone() %s {", g.recv(s.Name), s.Name)
+	if g.Options.PointerReceivers {
+		g.L("	c := *t")
+	} else {
+		g.L("	c := t")
+	}
+	for _, f := range s.Fields {
+		if !g.needsDeepCopy(*f.Type) {
+			continue
+		}
+		g.genCloneValue(*f.Type, "c."+f.Name, "t."+f.Name, 0)
+	}
+	g.L("	return c")
+	g.L("}")
+}
+
+// genStructReset generates the Reset method which sets every field to the zero value
+func (g *Generator) genStructReset(s Struct) {
+	g.L("")
+	g.L("// Reset sets every field of %s to the zero value, to reuse it from a pool", s.Name)
+	g.L("func (t *%s) Reset() {", s.Name)
+	for _, f := range s.Fields {
+		if f.Type.T == ethabi.TupleTy {
+			if _, external := g.Options.ExternalTuples[TupleStructName(*f.Type)]; !external {
+				g.L("	t.%s.Reset()", f.Name)
+				continue
+			}
+		}
+		g.L("	t.%s = %s", f.Name, g.zeroValue(*f.Type))
+	}
+	g.L("}")
+}
+
+// zeroValue returns the Go zero value literal of the type
+func (g *Generator) zeroValue(t ethabi.Type) string {
+	switch t.T {
+	case ethabi.UintTy, ethabi.IntTy:
+		if g.isBigIntType(t) {
+			return "nil"
+		}
+		return "0"
+	case ethabi.BoolTy:
+		return "false"
+	case ethabi.StringTy:
+		return `""`
+	case ethabi.BytesTy, ethabi.SliceTy:
+		return "nil"
+	default:
+		return g.abiTypeToGoType(t) + "{}"
+	}
+}
+
+// needsDeepCopy returns if the Go value of the type shares memory when copied
+func (g *Generator) needsDeepCopy(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.UintTy, ethabi.IntTy:
+		return g.isBigIntType(t)
+	case ethabi.BytesTy, ethabi.SliceTy:
+		return true
+	case ethabi.ArrayTy:
+		return g.needsDeepCopy(*t.Elem)
+	case ethabi.TupleTy:
+		// external tuples are copied by value
+		_, external := g.Options.ExternalTuples[TupleStructName(t)]
+		return !external
+	default:
+		return false
+	}
+}
+
+// genCloneValue generates code to deep copy src into dst,
+// dst is either zero value or a shallow copy of src.
+func (g *Generator) genCloneValue(t ethabi.Type, dst, src string, level int) {
+	switch t.T {
+	case ethabi.UintTy, ethabi.IntTy:
+		g.L("\tif %s != nil {", src)
+		g.L("\t\t%s = new(%s).Set(%s)", dst, strings.TrimPrefix(g.abiTypeToGoType(t), "*"), src)
+		g.L("\t}")
+	case ethabi.BytesTy:
+		g.L("\t%s = bytes.Clone(%s)", dst, src)
+	case ethabi.TupleTy:
+		g.L("\t%s = %s.Clone()", dst, src)
+	case ethabi.SliceTy:
+		if !g.needsDeepCopy(*t.Elem) {
+			g.L("\t%s = slices.Clone(%s)", dst, src)
+			return
+		}
+		idx := fmt.Sprintf("i%d", level)
+		g.L("\tif %s != nil {", src)
+		g.L("\t\t%s = make(%s, len(%s))", dst, g.abiTypeToGoType(t), src)
+		g.L("\t\tfor %s := range %s {", idx, src)
+		g.genCloneValue(*t.Elem, fmt.Sprintf("%s[%s]", dst, idx), fmt.Sprintf("%s[%s]", src, idx), level+1)
+		g.L("\t\t}")
+		g.L("\t}")
+	case ethabi.ArrayTy:
+		idx := fmt.Sprintf("i%d", level)
+		g.L("\tfor %s := range %s {", idx, src)
+		g.genCloneValue(*t.Elem, fmt.Sprintf("%s[%s]", dst, idx), fmt.Sprintf("%s[%s]", src, idx), level+1)
+		g.L("\t}")
+	}
+}
+
 // genEncodedSize generates the size calculation logic without selector
 func (g *Generator) genEncodedSize(s Struct) {
 	g.L("")
 	g.L("// EncodedSize returns the total encoded size of %s", s.Name)
-	g.L("func (t %s) EncodedSize() int {", s.Name)
+	g.L("func (t %s) EncodedSize() int {", g.recv(s.Name))
 	g.L("\tdynamicSize := 0")
 
 	for _, f := range s.Fields {
@@ -576,12 +1342,23 @@ func (g *Generator) genEncodedSize(s Struct) {
 	g.L("}")
 }
 
-// genStructDecode generates the Decode method (placeholder for now)
-func (g *Generator) genStructDecode(s Struct) {
+// genStructDecode generates the Decode method, or the DecodeInto method
+// reusing the slices and nested tuples of the struct if reuse is set.
+func (g *Generator) genStructDecode(s Struct, reuse bool) {
 	staticSize := GetTupleSize(s.Types())
 	g.L("")
-	g.L("// Decode decodes %s from ABI bytes in the provided buffer", s.Name)
-	g.L("func (t *%s) Decode(data []byte) (int, error) {", s.Name)
+	if g.ctx {
+		g.L("// DecodeCtx decodes %s from ABI bytes in the provided buffer like Decode, checking ctx every %d", s.Name, g.Options.DecodeCtxInterval)
+		g.L("// elements of the dynamic arrays, returns the error of ctx wrapped in %sErrDecodeCanceled if it's done.", g.StdPrefix)
+		g.L("func (t *%s) DecodeCtx(ctx context.Context, data []byte) (int, error) {", s.Name)
+	} else if reuse {
+		g.L("// DecodeInto decodes %s from ABI bytes in the provided buffer,", s.Name)
+		g.L("// reusing the allocations of the slices and nested tuples of t")
+		g.L("func (t *%s) DecodeInto(data []byte) (int, error) {", s.Name)
+	} else {
+		g.L("// Decode decodes %s from ABI bytes in the provided buffer", s.Name)
+		g.L("func (t *%s) Decode(data []byte) (int, error) {", s.Name)
+	}
 	g.L("\tif len(data) < %d {", staticSize)
 	g.L("\t\treturn 0, io.ErrUnexpectedEOF")
 	g.L("\t}")
@@ -602,7 +1379,9 @@ func (g *Generator) genStructDecode(s Struct) {
 			g.L("\t// Decode static field %s: %s", f.Name, f.Type.String())
 
 			if f.Type.T == ethabi.TupleTy {
-				g.L("\t_, err = t.%s.Decode(%s)", f.Name, dataRef)
+				g.L("\t_, err = t.%s.%s(%s)", f.Name, g.tupleDecodeMethod(*f.Type, reuse), dataRef)
+			} else if g.isBigIntType(*f.Type) {
+				g.L("\tt.%s, _, err = %s(t.%s, %s)", f.Name, g.genFuncName(*f.Type, "DecodeInto"), f.Name, dataRef)
 			} else {
 				g.L("\tt.%s, _, err = %s", f.Name, g.genDecodeCall(*f.Type, dataRef))
 			}
@@ -615,68 +1394,506 @@ func (g *Generator) genStructDecode(s Struct) {
 			g.L("\t// Decode dynamic field %s", f.Name)
 			g.L("\t{")
 
-			g.L("\t\toffset, err = %sDecodeSize(data[%d:])", g.StdPrefix, offset)
-			g.L("\t\tif err != nil {")
-			g.L("\t\t\treturn 0, err")
-			g.L("\t\t}")
-			g.L("\t\tif offset != dynamicOffset {")
-			g.L("\t\t\treturn 0, %sErrInvalidOffsetForDynamicField", g.StdPrefix)
-			g.L("\t\t}")
+			g.L("\t\toffset, err = %sDecodeSize(data[%d:])", g.StdPrefix, offset)
+			g.L("\t\tif err != nil {")
+			g.L("\t\t\treturn 0, err")
+			g.L("\t\t}")
+			g.L("\t\tif offset != dynamicOffset {")
+			g.L("\t\t\treturn 0, %sErrInvalidOffsetForDynamicField", g.StdPrefix)
+			g.L("\t\t}")
+
+			if g.ctx && g.decodesSlices(*f.Type) {
+				if f.Type.T == ethabi.TupleTy {
+					g.L("\t\tn, err = t.%s.DecodeCtx(ctx, data[dynamicOffset:])", f.Name)
+				} else {
+					g.L("\t\tt.%s, n, err = %s", f.Name, g.genDecodeCtxCall(*f.Type, "data[dynamicOffset:]"))
+				}
+			} else if f.Type.T == ethabi.TupleTy {
+				g.L("\t\tn, err = t.%s.%s(data[dynamicOffset:])", f.Name, g.tupleDecodeMethod(*f.Type, reuse))
+			} else if reuse && f.Type.T == ethabi.SliceTy {
+				g.L("\t\tt.%s, n, err = %s(t.%s, data[dynamicOffset:])", f.Name, g.genFuncName(*f.Type, "DecodeInto"), f.Name)
+			} else {
+				g.L("\t\tt.%s, n, err = %s", f.Name, g.genDecodeCall(*f.Type, "data[dynamicOffset:]"))
+			}
+			g.L("\t\tif err != nil {")
+			g.L("\t\t\treturn 0, err")
+			g.L("\t\t}")
+			g.L("\t\tdynamicOffset += n")
+
+			g.L("\t}")
+
+			offset += 32
+		}
+	}
+
+	g.L("\treturn dynamicOffset, nil")
+	g.L("}")
+}
+
+// genStructDecodeCtx generates the DecodeCtx method, see genDecodeCtxFunction
+func (g *Generator) genStructDecodeCtx(s Struct) {
+	g.ctx = true
+	defer func() { g.ctx = false }()
+	g.genStructDecode(s, false)
+}
+
+// tupleDecodeMethod returns the method decoding a tuple, DecodeInto if reuse is set
+// and the tuple is generated with it, external tuples may not have it.
+func (g *Generator) tupleDecodeMethod(t ethabi.Type, reuse bool) string {
+	if !reuse || !g.Options.DecodeInto {
+		return "Decode"
+	}
+	if _, external := g.Options.ExternalTuples[TupleStructName(t)]; external {
+		return "Decode"
+	}
+	return "DecodeInto"
+}
+
+// genStructDecodeStrict generates the DecodeStrict method which rejects trailing bytes
+func (g *Generator) genStructDecodeStrict(s Struct) {
+	g.L("")
+	g.L("// DecodeStrict decodes %s from ABI bytes, rejecting unexpected trailing bytes", s.Name)
+	g.L("func (t *%s) DecodeStrict(data []byte) error {", s.Name)
+	g.L("\tn, err := t.Decode(data)")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn err")
+	g.L("\t}")
+	if s.TrailingPadding > 0 {
+		g.L("\t// tolerate zero padding after return data")
+		g.L("\treturn %sCheckTrailingBytes(data[n:], %sMaxReturnPadding)", g.StdPrefix, g.StdPrefix)
+	} else {
+		g.L("\treturn %sCheckTrailingBytes(data[n:], 0)", g.StdPrefix)
+	}
+	g.L("}")
+}
+
+// genStructBinaryMarshaler generates the encoding.BinaryMarshaler and encoding.BinaryUnmarshaler
+// implementations with the ABI encoding
+func (g *Generator) genStructBinaryMarshaler(s Struct) {
+	g.L("")
+	g.L("// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of %s", s.Name)
+	g.L("func (value %s) MarshalBinary() ([]byte, error) {", g.recv(s.Name))
+	g.L("	return value.Encode()")
+	g.L("}")
+
+	g.L("")
+	g.L("// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes")
+	g.L("func (t *%s) UnmarshalBinary(data []byte) error {", s.Name)
+	g.L("	return t.DecodeStrict(data)")
+	g.L("}")
+}
+
+// genPackedWithSelector generates the packed encoding methods prefixed with the function selector
+func (g *Generator) genPackedWithSelector(name string, method ethabi.Method) {
+	g.L("")
+	g.L("// PackedEncodeWithSelector encodes %s arguments to packed ABI bytes including function selector", method.Name)
+	g.L("func (t %s) PackedEncodeWithSelector() ([]byte, error) {", g.recv(name))
+	g.L("\tresult := make([]byte, 4+t.PackedEncodedSize())")
+	g.L("\tcopy(result[:4], %sSelector[:])", g.methodName(method))
+	g.L("\tif _, err := t.PackedEncodeTo(result[4:]); err != nil {")
+	g.L("\t\treturn nil, err")
+	g.L("\t}")
+	g.L("\treturn result, nil")
+	g.L("}")
+
+	g.L("")
+	g.L("// PackedDecodeWithSelector decodes %s arguments from packed ABI bytes including function selector", method.Name)
+	g.L("func (t *%s) PackedDecodeWithSelector(data []byte) (int, error) {", name)
+	g.L("\tif len(data) < 4 {")
+	g.L("\t\treturn 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
+	g.L("\tif [4]byte(data[:4]) != %sSelector {", g.methodName(method))
+	g.L("\t\treturn 0, %sErrSelectorMismatch", g.StdPrefix)
+	g.L("\t}")
+	g.L("\tn, err := t.PackedDecode(data[4:])")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn 0, err")
+	g.L("\t}")
+	g.L("\treturn 4 + n, nil")
+	g.L("}")
+}
+
+func (g *Generator) genCallConstructor(s Struct) {
+	if len(s.Fields) == 0 {
+		g.L("// New%s constructs a new %s", s.Name, s.Name)
+		g.L("func New%s() *%s {", s.Name, s.Name)
+		g.L("\treturn &%s{}", s.Name)
+		g.L("}")
+		return
+	}
+
+	g.L("")
+	g.L("// New%s constructs a new %s", s.Name, s.Name)
+	g.L("func New%s(", s.Name)
+
+	// Generate function parameters
+	for _, f := range s.Fields {
+		goType := g.abiTypeToGoType(*f.Type)
+		g.L("\t%s %s,", ToArgName(f.Name), goType)
+	}
+
+	g.L(") *%s {", s.Name)
+	g.L("return &%s{", s.Name)
+
+	// Generate struct initialization
+	for _, f := range s.Fields {
+		g.L("\t%s: %s,", f.Name, ToArgName(f.Name))
+	}
+
+	g.L("}")
+	g.L("}")
+}
+
+// genFallback generates the raw calldata passthrough for the fallback function
+func (g *Generator) genFallback(fallback ethabi.Method) {
+	g.L("")
+	g.L("// FallbackStateMutability is the state mutability of the fallback function")
+	g.L("const FallbackStateMutability = \"%s\"", fallback.StateMutability)
+	g.L("")
+	g.L("// FallbackCall represents the raw calldata passed to the fallback function")
+	g.L("type FallbackCall struct {")
+	g.L("\tData []byte")
+	g.L("}")
+	g.L("")
+	g.L("// EncodeWithSelector returns the raw calldata, fallback function has no selector")
+	g.L("func (t %s) EncodeWithSelector() ([]byte, error) {", g.recv("FallbackCall"))
+	g.L("\treturn t.Data, nil")
+	g.L("}")
+	g.L("")
+	g.L("// DecodeWithSelector takes the whole calldata as is")
+	g.L("func (t *FallbackCall) DecodeWithSelector(data []byte) (int, error) {")
+	g.L("\tt.Data = data")
+	g.L("\treturn len(data), nil")
+	g.L("}")
+
+	if g.Options.GenerateClone {
+		g.L("")
+		g.L("// Clone returns a deep copy of FallbackCall")
+		g.L("func (t %s) Clone() FallbackCall {", g.recv("FallbackCall"))
+		g.L("\treturn FallbackCall{Data: bytes.Clone(t.Data)}")
+		g.L("}")
+	}
+
+	if g.Options.ToMap {
+		g.L("")
+		g.L("// ToMap returns the calldata of FallbackCall as a hex string")
+		g.L("func (t %s) ToMap() map[string]interface{} {", g.recv("FallbackCall"))
+		g.L("\treturn map[string]interface{}{\"data\": \"0x\" + hex.EncodeToString(t.Data)}")
+		g.L("}")
+	}
+}
+
+// genReceive generates the call struct for the receive function
+func (g *Generator) genReceive(receive ethabi.Method) {
+	g.L("")
+	g.L("// ReceiveStateMutability is the state mutability of the receive function")
+	g.L("const ReceiveStateMutability = \"%s\"", receive.StateMutability)
+	g.L("")
+	g.L("// ReceiveCall represents a plain value transfer to the receive function, which has empty calldata")
+	g.L("type ReceiveCall struct{}")
+	g.L("")
+	g.L("// EncodeWithSelector returns the empty calldata")
+	g.L("func (t %s) EncodeWithSelector() ([]byte, error) {", g.recv("ReceiveCall"))
+	g.L("\treturn []byte{}, nil")
+	g.L("}")
+	g.L("")
+	g.L("// DecodeWithSelector accepts only empty calldata")
+	g.L("func (t *ReceiveCall) DecodeWithSelector(data []byte) (int, error) {")
+	g.L("\tif len(data) != 0 {")
+	g.L("\t\treturn 0, %sErrTrailingBytes", g.StdPrefix)
+	g.L("\t}")
+	g.L("\treturn 0, nil")
+	g.L("}")
+}
+
+// genClient generates a typed client calling the functions through ContractCaller
+func (g *Generator) genClient(methods []ethabi.Method) {
+	name := g.Options.Client
+
+	g.L("")
+	g.L("// %s is a typed client of the contract", name)
+	g.L("type %s struct {", name)
+	g.L("	caller %sContractCaller", g.StdPrefix)
+	g.L("	addr common.Address")
+	g.L("}")
+
+	g.L("")
+	g.L("// New%s constructs a new %s calling the contract at addr", name, name)
+	g.L("func New%s(caller %sContractCaller, addr common.Address) *%s {", name, g.StdPrefix, name)
+	g.L("	return &%s{caller: caller, addr: addr}", name)
+	g.L("}")
+
+	g.L("")
+	g.L("// Address returns the address of the contract")
+	g.L("func (c *%s) Address() common.Address {", name)
+	g.L("	return c.addr")
+	g.L("}")
+
+	for _, method := range methods {
+		g.genClientMethod(method)
+	}
+}
+
+// genCaller generates the XxxCaller bindings calling the view functions through XxxBackend,
+// other functions only get a XxxTxData helper returning the calldata, see genInterface for the
+// expanded methods of all functions.
+func (g *Generator) genCaller(methods []ethabi.Method) {
+	name := g.Options.Caller + "Caller"
+
+	backend := g.Options.Caller + "Backend"
+
+	g.L("")
+	g.L("// %s is the subset of ethclient.Client used by %s, declared in the generated code so the", backend, name)
+	g.L("// runtime doesn't depend on the go-ethereum root package")
+	g.L("type %s interface {", backend)
+	g.L("	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)")
+	g.L("}")
+
+	g.L("")
+	g.L("// %s calls the view functions of the %s contract", name, g.Options.Caller)
+	g.L("type %s struct {", name)
+	g.L("	backend %s", backend)
+	g.L("	addr common.Address")
+	g.L("}")
+
+	g.L("")
+	g.L("// New%s constructs a new %s calling the contract at addr", name, name)
+	g.L("func New%s(backend %s, addr common.Address) *%s {", name, backend, name)
+	g.L("	return &%s{backend: backend, addr: addr}", name)
+	g.L("}")
+
+	g.L("")
+	g.L("// Address returns the address of the contract")
+	g.L("func (c *%s) Address() common.Address {", name)
+	g.L("	return c.addr")
+	g.L("}")
+
+	for _, method := range methods {
+		switch {
+		case g.Options.Interface:
+			g.genCallerExpandedMethod(name, method)
+			if !method.IsConstant() {
+				g.genCallerTxData(name, method)
+			}
+		case method.IsConstant():
+			g.genCallerMethod(name, method)
+		default:
+			g.genCallerTxData(name, method)
+		}
+	}
+
+	if g.Options.Interface {
+		g.genInterface(methods)
+	}
+}
+
+// genInterface generates the XxxInterface listing all the functions of the contract with the call
+// arguments and the return values expanded, implemented by XxxCaller and easy to mock in unit tests.
+func (g *Generator) genInterface(methods []ethabi.Method) {
+	name := g.Options.Caller + "Interface"
+
+	g.L("")
+	g.L("// %s lists the functions of the %s contract, implemented by %sCaller", name, g.Options.Caller, g.Options.Caller)
+	g.L("type %s interface {", name)
+	for _, method := range methods {
+		params, _ := g.callParams(method)
+		params = append([]string{"ctx context.Context"}, params...)
+		g.L("	%s(%s) %s", g.methodName(method), strings.Join(params, ", "), g.expandedResults(method, params, true))
+	}
+	g.L("}")
+
+	g.L("")
+	g.L("var _ %s = (*%sCaller)(nil)", name, g.Options.Caller)
+}
+
+// expandedResults returns the results of the expanded signature of method, the values of the outputs and
+// the error, named after the outputs if named is set and they are all named and distinct from the params.
+func (g *Generator) expandedResults(method ethabi.Method, params []string, named bool) string {
+	if len(method.Outputs) == 0 {
+		return "error"
+	}
+
+	s := StructFromArguments(g.returnName(method), method.Outputs)
+	taken := map[string]bool{"err": true}
+	for _, param := range params {
+		taken[strings.Fields(param)[0]] = true
+	}
+	names := make([]string, len(method.Outputs))
+	for i, output := range method.Outputs {
+		names[i] = ToArgName(GoFieldName(output.Name))
+		if names[i] == "" || taken[names[i]] {
+			named = false
+		}
+		taken[names[i]] = true
+	}
 
-			if f.Type.T == ethabi.TupleTy {
-				g.L("\t\tn, err = t.%s.Decode(data[dynamicOffset:])", f.Name)
-			} else {
-				g.L("\t\tt.%s, n, err = %s", f.Name, g.genDecodeCall(*f.Type, "data[dynamicOffset:]"))
-			}
-			g.L("\t\tif err != nil {")
-			g.L("\t\t\treturn 0, err")
-			g.L("\t\t}")
-			g.L("\t\tdynamicOffset += n")
+	results := make([]string, 0, len(s.Fields)+1)
+	for i, f := range s.Fields {
+		result := g.abiTypeToGoType(*f.Type)
+		if named {
+			result = names[i] + " " + result
+		}
+		results = append(results, result)
+	}
+	if named {
+		results = append(results, "err error")
+	} else {
+		results = append(results, "error")
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
 
-			g.L("\t}")
+// genCallerExpandedMethod generates the caller method with the expanded signature of the interface,
+// the state changing functions are simulated at the latest block.
+func (g *Generator) genCallerExpandedMethod(callerName string, method ethabi.Method) {
+	name := g.methodName(method)
+	params, args := g.callParams(method)
+	params = append([]string{"ctx context.Context"}, params...)
 
-			offset += 32
+	fail := "err"
+	if len(method.Outputs) > 0 {
+		s := StructFromArguments(g.returnName(method), method.Outputs)
+		values := make([]string, 0, len(s.Fields)+1)
+		for _, f := range s.Fields {
+			values = append(values, "zero."+f.Name)
 		}
+		fail = strings.Join(append(values, "err"), ", ")
 	}
 
-	g.L("\treturn dynamicOffset, nil")
+	g.L("")
+	if method.IsConstant() {
+		g.L("// %s calls the %s function of the contract", name, method.Name)
+	} else {
+		g.L("// %s simulates the %s function of the contract with eth_call, the state changes are discarded,", name, method.Name)
+		g.L("// send the calldata of %sTxData in a transaction to apply them", name)
+	}
+	g.L("func (c *%s) %s(%s) %s {", callerName, name, strings.Join(params, ", "), g.expandedResults(method, params, false))
+	if len(method.Outputs) > 0 {
+		g.L("	var zero %s", g.returnName(method))
+	}
+	g.L("	data, err := New%s(%s).EncodeWithSelector()", g.callName(method), strings.Join(args, ", "))
+	g.L("	if err != nil {")
+	g.L("		return %s", fail)
+	g.L("	}")
+	if len(method.Outputs) == 0 {
+		g.L("	_, err = c.backend.CallContract(ctx, ethereum.CallMsg{To: &c.addr, Data: data}, nil)")
+		g.L("	return err")
+		g.L("}")
+		return
+	}
+	g.L("	output, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &c.addr, Data: data}, nil)")
+	g.L("	if err != nil {")
+	g.L("		return %s", fail)
+	g.L("	}")
+	g.L("	return Decode%s(output)", g.returnName(method))
 	g.L("}")
 }
 
-func (g *Generator) genCallConstructor(s Struct) {
-	if len(s.Fields) == 0 {
-		g.L("// New%s constructs a new %s", s.Name, s.Name)
-		g.L("func New%s() *%s {", s.Name, s.Name)
-		g.L("\treturn &%s{}", s.Name)
+// genCallerMethod generates the caller method calling a view function at the latest block
+func (g *Generator) genCallerMethod(callerName string, method ethabi.Method) {
+	name := g.methodName(method)
+	params, args := g.callParams(method)
+	params = append([]string{"ctx context.Context"}, params...)
+
+	results := "error"
+	errReturn := "err"
+	if len(method.Outputs) > 0 {
+		results = fmt.Sprintf("(*%s, error)", g.returnName(method))
+		errReturn = "nil, err"
+	}
+
+	g.L("")
+	g.L("// %s calls the %s function of the contract", name, method.Name)
+	g.L("func (c *%s) %s(%s) %s {", callerName, name, strings.Join(params, ", "), results)
+	g.L("	data, err := New%s(%s).EncodeWithSelector()", g.callName(method), strings.Join(args, ", "))
+	g.L("	if err != nil {")
+	g.L("		return %s", errReturn)
+	g.L("	}")
+	g.L("	output, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &c.addr, Data: data}, nil)")
+	if len(method.Outputs) == 0 {
+		g.L("	return err")
 		g.L("}")
 		return
 	}
+	g.L("	if err != nil {")
+	g.L("		return nil, err")
+	g.L("	}")
+	g.L("	var result %s", g.returnName(method))
+	g.L("	if _, err := result.Decode(output); err != nil {")
+	g.L("		return nil, err")
+	g.L("	}")
+	g.L("	return &result, nil")
+	g.L("}")
+}
+
+// genCallerTxData generates the helper returning the calldata of a state changing function
+func (g *Generator) genCallerTxData(callerName string, method ethabi.Method) {
+	name := g.methodName(method)
+	params, args := g.callParams(method)
 
 	g.L("")
-	g.L("// New%s constructs a new %s", s.Name, s.Name)
-	g.L("func New%s(", s.Name)
+	g.L("// %sTxData returns the calldata of the %s function, to be sent in a transaction", name, method.Name)
+	g.L("func (c *%s) %sTxData(%s) ([]byte, error) {", callerName, name, strings.Join(params, ", "))
+	g.L("	return New%s(%s).EncodeWithSelector()", g.callName(method), strings.Join(args, ", "))
+	g.L("}")
+}
 
-	// Generate function parameters
+// callParams returns the parameter declarations and argument names of the Call constructor
+func (g *Generator) callParams(method ethabi.Method) ([]string, []string) {
+	s := StructFromArguments(g.callName(method), method.Inputs)
+	params := make([]string, 0, len(s.Fields))
+	args := make([]string, 0, len(s.Fields))
 	for _, f := range s.Fields {
-		goType := g.abiTypeToGoType(*f.Type)
-		g.L("\t%s %s,", ToArgName(f.Name), goType)
+		params = append(params, fmt.Sprintf("%s %s", ToArgName(f.Name), g.abiTypeToGoType(*f.Type)))
+		args = append(args, ToArgName(f.Name))
 	}
+	return params, args
+}
 
-	g.L(") *%s {", s.Name)
-	g.L("return &%s{", s.Name)
+// genClientMethod generates the client method calling a contract function
+func (g *Generator) genClientMethod(method ethabi.Method) {
+	name := g.methodName(method)
+	params, args := g.callParams(method)
+	params = append([]string{"ctx context.Context"}, params...)
 
-	// Generate struct initialization
-	for _, f := range s.Fields {
-		g.L("\t%s: %s,", f.Name, ToArgName(f.Name))
+	results := "error"
+	errReturn := "err"
+	if len(method.Outputs) > 0 {
+		results = fmt.Sprintf("(*%s, error)", g.returnName(method))
+		errReturn = "nil, err"
 	}
 
-	g.L("}")
+	g.L("")
+	g.L("// %s calls the %s function of the contract", name, method.Name)
+	g.L("func (c *%s) %s(%s) %s {", g.Options.Client, name, strings.Join(params, ", "), results)
+	g.L("	data, err := New%s(%s).EncodeWithSelector()", g.callName(method), strings.Join(args, ", "))
+	g.L("	if err != nil {")
+	g.L("		return %s", errReturn)
+	g.L("	}")
+	if len(method.Outputs) == 0 {
+		g.L("	_, err = c.caller.CallContract(ctx, c.addr, data)")
+		g.L("	return err")
+		g.L("}")
+		return
+	}
+	g.L("	output, err := c.caller.CallContract(ctx, c.addr, data)")
+	g.L("	if err != nil {")
+	g.L("		return nil, err")
+	g.L("	}")
+	g.L("	var result %s", g.returnName(method))
+	g.L("	if _, err := result.Decode(output); err != nil {")
+	g.L("		return nil, err")
+	g.L("	}")
+	g.L("	return &result, nil")
 	g.L("}")
 }
 
 func (g *Generator) genFunction(method ethabi.Method) {
+	defer g.within("function %s", method.Sig)()
+
 	// Generate struct and methods for functions with inputs
-	name := fmt.Sprintf("%sCall", Title.String(method.Name))
+	g.section(SectionCalls)
+	name := g.callName(method)
 	// assert interface
 	g.L("var _ %sMethod = (*%s)(nil)", g.StdPrefix, name)
 
@@ -689,56 +1906,210 @@ func (g *Generator) genFunction(method ethabi.Method) {
 		g.L("type %s struct {", name)
 		g.L("\t%sEmptyTuple", g.StdPrefix)
 		g.L("}")
+
+		if g.Options.GenerateClone {
+			g.L("")
+			g.L("// Clone returns a copy of %s", name)
+			g.L("func (t %s) Clone() %s {", g.recv(name), name)
+			g.L("\treturn %s{}", name)
+			g.L("}")
+		}
+
+		if g.Options.ToMap {
+			g.genEmptyToMap(name)
+		}
 	}
 
 	// GetMethodName method
 	g.L("")
 	g.L("// GetMethodName returns the function name")
-	g.L("func (t %s) GetMethodName() string {", name)
+	g.L("func (t %s) GetMethodName() string {", g.recv(name))
 	g.L("\treturn \"%s\"", method.Name)
 	g.L("}")
 
 	// GetMethodID method
 	g.L("")
 	g.L("// GetMethodID returns the function id")
-	g.L("func (t %s) GetMethodID() uint32 {", name)
-	g.L("\treturn %sID", Title.String(method.Name))
+	g.L("func (t %s) GetMethodID() uint32 {", g.recv(name))
+	g.L("\treturn %sID", g.methodName(method))
 	g.L("}")
 
 	// GetMethodSelector method
 	g.L("")
 	g.L("// GetMethodSelector returns the function selector")
-	g.L("func (t %s) GetMethodSelector() [4]byte {", name)
-	g.L("\treturn %sSelector", Title.String(method.Name))
+	g.L("func (t %s) GetMethodSelector() [4]byte {", g.recv(name))
+	g.L("\treturn %sSelector", g.methodName(method))
+	g.L("}")
+
+	g.L("")
+	g.L("// EncodedSizeWithSelector returns the encoded size of %s arguments including function selector", method.Name)
+	g.L("func (t %s) EncodedSizeWithSelector() int {", g.recv(name))
+	g.L("\treturn 4 + t.EncodedSize()")
 	g.L("}")
 
 	g.L("")
 	g.L("// EncodeWithSelector encodes %s arguments to ABI bytes including function selector", method.Name)
-	g.L("func (t %s) EncodeWithSelector() ([]byte, error) {", name)
-	g.L("\tresult := make([]byte, 4 + t.EncodedSize())")
-	g.L("\tcopy(result[:4], %sSelector[:])", Title.String(method.Name))
+	g.L("func (t %s) EncodeWithSelector() ([]byte, error) {", g.recv(name))
+	g.L("\tresult := make([]byte, t.EncodedSizeWithSelector())")
+	g.L("\tcopy(result[:4], %sSelector[:])", g.methodName(method))
 	g.L("\tif _, err := t.EncodeTo(result[4:]); err != nil {")
 	g.L("\t\treturn nil, err")
 	g.L("\t}")
 	g.L("\treturn result, nil")
 	g.L("}")
 
+	g.L("")
+	g.L("// EncodeHex encodes %s arguments to 0x prefixed hex string", method.Name)
+	g.L("func (t %s) EncodeHex() (string, error) {", g.recv(name))
+	g.L("\tdata, err := t.Encode()")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn \"\", err")
+	g.L("\t}")
+	g.L("\treturn \"0x\" + hex.EncodeToString(data), nil")
+	g.L("}")
+
+	g.L("")
+	g.L("// EncodeHexWithSelector encodes %s arguments to 0x prefixed hex calldata including function selector,", method.Name)
+	g.L("// ready for the data field of eth_call and eth_sendTransaction")
+	g.L("func (t %s) EncodeHexWithSelector() (string, error) {", g.recv(name))
+	g.L("\tdata, err := t.EncodeWithSelector()")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn \"\", err")
+	g.L("\t}")
+	g.L("\treturn \"0x\" + hex.EncodeToString(data), nil")
+	g.L("}")
+
+	g.L("")
+	g.L("// CalldataCost returns the gas cost of the %s calldata, returns 0 if encoding fails", method.Name)
+	g.L("func (t %s) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {", g.recv(name))
+	g.L("\tdata, err := t.EncodeWithSelector()")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn 0")
+	g.L("\t}")
+	g.L("\treturn %sCalldataCost(data, zeroByteGas, nonZeroByteGas)", g.StdPrefix)
+	g.L("}")
+
+	g.L("")
+	g.L("// DecodeWithSelector decodes %s arguments from ABI bytes including function selector", method.Name)
+	g.L("func (t *%s) DecodeWithSelector(data []byte) (int, error) {", name)
+	g.L("\tif len(data) < 4 {")
+	g.L("\t\treturn 0, io.ErrUnexpectedEOF")
+	g.L("\t}")
+	g.L("\tif [4]byte(data[:4]) != %sSelector {", g.methodName(method))
+	g.L("\t\treturn 0, %sErrSelectorMismatch", g.StdPrefix)
+	g.L("\t}")
+	g.L("\tn, err := t.Decode(data[4:])")
+	g.L("\tif err != nil {")
+	g.L("\t\treturn 0, err")
+	g.L("\t}")
+	g.L("\treturn 4 + n, nil")
+	g.L("}")
+
+	g.L("")
+	g.L("// DecodeHexWithSelector decodes %s arguments from the hex calldata with an optional 0x prefix,", method.Name)
+	g.L("// including function selector")
+	g.L("func (t *%s) DecodeHexWithSelector(s string) (int, error) {", name)
+	g.L("\tdata, err := %sFromHex(s)", g.StdPrefix)
+	g.L("\tif err != nil {")
+	g.L("\t\treturn 0, fmt.Errorf(\"decode %s: %%w\", err)", name)
+	g.L("\t}")
+	g.L("\treturn t.DecodeWithSelector(data)")
+	g.L("}")
+
+	if len(method.Inputs) > 0 && g.canPackStruct(s) {
+		g.genPackedWithSelector(name, method)
+	}
+
 	// Generate constructor for Call struct
 	g.genCallConstructor(s)
 
-	name = fmt.Sprintf("%sReturn", Title.String(method.Name))
+	g.section(SectionReturns)
+	name = g.returnName(method)
 	if len(method.Outputs) > 0 {
 		s := StructFromArguments(name, method.Outputs)
+		s.TrailingPadding = abi.MaxReturnPadding
+		s.UnnamedPrefix = "result"
 		g.genStruct(s)
+		g.genReturnValuesDecoder(s, method)
+		if len(method.Outputs) == 1 {
+			g.genSingleResultFuncs(s, method)
+		}
 	} else {
 		g.L("")
 		g.L("// %s represents the output arguments for %s function", name, method.Name)
 		g.L("type %s struct {", name)
 		g.L("\t%sEmptyTuple", g.StdPrefix)
 		g.L("}")
+
+		if g.Options.GenerateClone {
+			g.L("")
+			g.L("// Clone returns a copy of %s", name)
+			g.L("func (t %s) Clone() %s {", g.recv(name), name)
+			g.L("\treturn %s{}", name)
+			g.L("}")
+		}
+
+		if g.Options.ToMap {
+			g.genEmptyToMap(name)
+		}
 	}
 }
 
+// genReturnValuesDecoder generates a function decoding the return data
+// of a method directly into multiple return values.
+func (g *Generator) genReturnValuesDecoder(s Struct, method ethabi.Method) {
+	results := make([]string, 0, len(s.Fields)+1)
+	values := make([]string, 0, len(s.Fields)+1)
+	for i, f := range s.Fields {
+		results = append(results, fmt.Sprintf("r%d %s", i+1, g.abiTypeToGoType(*f.Type)))
+		values = append(values, "result."+f.Name)
+	}
+	results = append(results, "err error")
+	values = append(values, "nil")
+
+	g.L("")
+	g.L("// Decode%s decodes the return data of %s into its values", s.Name, method.Name)
+	g.L("func Decode%s(data []byte) (%s) {", s.Name, strings.Join(results, ", "))
+	g.L("	var result %s", s.Name)
+	g.L("	if _, err = result.Decode(data); err != nil {")
+	g.L("		return")
+	g.L("	}")
+	g.L("	return %s", strings.Join(values, ", "))
+	g.L("}")
+}
+
+// genSingleResultFuncs generates the functions decoding and encoding the return data of a method
+// with a single output directly from and to the value, sharing the validations of the Return struct.
+func (g *Generator) genSingleResultFuncs(s Struct, method ethabi.Method) {
+	name := g.methodName(method)
+	goType := g.abiTypeToGoType(*s.Fields[0].Type)
+
+	g.L("")
+	g.L("// Decode%s decodes the single return value of %s", name, method.Name)
+	g.L("func Decode%s(data []byte) (%s, error) {", name, goType)
+	g.L("	return Decode%s(data)", s.Name)
+	g.L("}")
+
+	g.L("")
+	g.L("// Decode%sHex decodes the single return value of %s from the hex string with an optional 0x prefix,", name, method.Name)
+	g.L("// e.g. the result of eth_call")
+	g.L("func Decode%sHex(s string) (%s, error) {", name, goType)
+	g.L("	data, err := %sFromHex(s)", g.StdPrefix)
+	g.L("	if err != nil {")
+	g.L("		var zero %s", goType)
+	g.L("		return zero, fmt.Errorf(\"decode %s: %%w\", err)", s.Name)
+	g.L("	}")
+	g.L("	return Decode%s(data)", s.Name)
+	g.L("}")
+
+	g.L("")
+	g.L("// Encode%sResult encodes the single return value of %s, e.g. for the return data of precompiles", name, method.Name)
+	g.L("func Encode%sResult(v %s) ([]byte, error) {", name, goType)
+	g.L("	result := %s{%s: v}", s.Name, s.Fields[0].Name)
+	g.L("	return result.Encode()")
+	g.L("}")
+}
+
 func (g *Generator) genAllSelectors(methods []ethabi.Method) {
 	if len(methods) == 0 {
 		return
@@ -748,7 +2119,7 @@ func (g *Generator) genAllSelectors(methods []ethabi.Method) {
 	g.L("// Function selectors")
 	g.L("var (")
 	for _, method := range methods {
-		name := Title.String(method.Name)
+		name := g.methodName(method)
 		g.L("\t// %s", method.Sig)
 		g.L("\t%sSelector = [4]byte{0x%02x, 0x%02x, 0x%02x, 0x%02x}",
 			name,
@@ -764,11 +2135,49 @@ func (g *Generator) genAllSelectors(methods []ethabi.Method) {
 	g.L("const (")
 	for _, method := range methods {
 		// Generate integer version of selector
-		name := Title.String(method.Name)
+		name := g.methodName(method)
 		selectorInt := binary.BigEndian.Uint32(method.ID)
 		g.L("\t%sID = %d", name, selectorInt)
 	}
 	g.L(")")
+
+	g.L("")
+	g.L("// Canonical function signatures")
+	g.L("const (")
+	for _, method := range methods {
+		g.L("\t%sSignature = \"%s\"", g.methodName(method), method.Sig)
+	}
+	g.L(")")
+}
+
+// genDecodeBySelector generates the function decoding calldata into the call struct matching the selector
+func (g *Generator) genDecodeBySelector(methods []ethabi.Method) {
+	if g.Options.Stdlib || g.sharedFile || len(methods) == 0 {
+		return
+	}
+
+	name := ToCamel(g.Options.Prefix) + "DecodeBySelector"
+	g.L("")
+	g.L("// %s decodes the calldata into the call struct of the function matching the selector,", name)
+	g.L("// returns %sErrUnknownSelector if no function matches.", g.StdPrefix)
+	g.L("func %s(data []byte) (%sMethod, error) {", name, g.StdPrefix)
+	g.L("\tif len(data) < 4 {")
+	g.L("\t\treturn nil, io.ErrUnexpectedEOF")
+	g.L("\t}")
+	g.L("\tvar call %sMethod", g.StdPrefix)
+	g.L("\tswitch [4]byte(data[:4]) {")
+	for _, method := range methods {
+		g.L("\tcase %sSelector:", g.methodName(method))
+		g.L("\t\tcall = new(%s)", g.callName(method))
+	}
+	g.L("\tdefault:")
+	g.L("\t\treturn nil, %sErrUnknownSelector", g.StdPrefix)
+	g.L("\t}")
+	g.L("\tif _, err := call.DecodeWithSelector(data); err != nil {")
+	g.L("\t\treturn nil, err")
+	g.L("\t}")
+	g.L("\treturn call, nil")
+	g.L("}")
 }
 
 // abiTypeToGoType converts ABI type to Go type (reuse existing implementation)
@@ -777,6 +2186,13 @@ func (g *Generator) abiTypeToGoType(abiType ethabi.Type) string {
 	// This is a temporary placeholder - we should refactor this to avoid duplication
 	switch abiType.T {
 	case ethabi.UintTy:
+		if name := g.enumName(abiType); name != "" {
+			return name
+		} else if abiType.Size > 64 && g.Options.UseUint256 {
+			return "*uint256.Int"
+		} else if g.isBigIntType(abiType) {
+			return "*big.Int"
+		}
 		// Use the closest native Go type that fits to avoid big.Int allocations
 		if abiType.Size <= 8 {
 			return "uint8"
@@ -784,14 +2200,13 @@ func (g *Generator) abiTypeToGoType(abiType ethabi.Type) string {
 			return "uint16"
 		} else if abiType.Size <= 32 {
 			return "uint32"
-		} else if abiType.Size <= 64 {
-			return "uint64"
-		} else if g.Options.UseUint256 {
-			return "*uint256.Int"
 		} else {
-			return "*big.Int"
+			return "uint64"
 		}
 	case ethabi.IntTy:
+		if g.isBigIntType(abiType) {
+			return "*big.Int"
+		}
 		// Use the closest native Go type that fits to avoid big.Int allocations
 		if abiType.Size <= 8 {
 			return "int8"
@@ -799,10 +2214,8 @@ func (g *Generator) abiTypeToGoType(abiType ethabi.Type) string {
 			return "int16"
 		} else if abiType.Size <= 32 {
 			return "int32"
-		} else if abiType.Size <= 64 {
-			return "int64"
 		} else {
-			return "*big.Int"
+			return "int64"
 		}
 	case ethabi.AddressTy:
 		return "common.Address"
@@ -824,14 +2237,15 @@ func (g *Generator) abiTypeToGoType(abiType ethabi.Type) string {
 		return fmt.Sprintf("[%d]%s", abiType.Size, elemType)
 	case ethabi.TupleTy:
 		// Handle tuple types - generate struct type name
-		structName := abi.TupleStructName(abiType)
+		structName := TupleStructName(abiType)
 		// Check if this tuple has an external implementation
 		if externalName, exists := g.Options.ExternalTuples[structName]; exists {
 			return externalName
 		}
 		return structName
 	default:
-		panic(fmt.Sprintf("unsupported ABI type: %s", abiType.String()))
+		g.errorf("unsupported ABI type: %s", abiType.String())
+		return ""
 	}
 }
 
@@ -847,7 +2261,7 @@ func (g *Generator) genEncodeCall(t ethabi.Type, value string, dataRef string) s
 
 func (g *Generator) genSizeCall(t ethabi.Type, valueRef string) string {
 	if !IsDynamicType(t) {
-		panic("size call should only be generated for dynamic types")
+		g.errorf("size call should only be generated for dynamic types, got %s", t.String())
 	}
 
 	if t.T == ethabi.TupleTy {
@@ -860,7 +2274,7 @@ func (g *Generator) genSizeCall(t ethabi.Type, valueRef string) string {
 
 func (g *Generator) genDecodeCall(t ethabi.Type, dataRef string) string {
 	if t.T == ethabi.TupleTy {
-		panic("tuple types should use struct methods for decoding")
+		g.errorf("tuple types should use struct methods for decoding, got %s", t.String())
 	}
 
 	return fmt.Sprintf("%s(%s)", g.genFuncName(t, "Decode"), dataRef)
@@ -875,13 +2289,15 @@ func (g *Generator) genPackedEncodeCall(t ethabi.Type, value string, dataRef str
 
 func (g *Generator) genPackedDecodeCall(t ethabi.Type, dataRef string) string {
 	if t.T == ethabi.TupleTy {
-		panic("tuple types should use struct methods for packed decoding")
+		g.errorf("tuple types should use struct methods for packed decoding, got %s", t.String())
 	}
 	return fmt.Sprintf("%s(%s)", g.genFuncName(t, "PackedDecode"), dataRef)
 }
 
 // genPackedEncodingFunction generates a standalone packed encoding function for a specific ABI type
 func (g *Generator) genPackedEncodingFunction(t ethabi.Type) {
+	defer g.within("type %s", t.String())()
+
 	if !CanPackType(t) {
 		return
 	}
@@ -909,9 +2325,9 @@ func (g *Generator) genPackedEncodingFunction(t ethabi.Type) {
 	case ethabi.ArrayTy:
 		g.genPackedArrayEncoding(t)
 	case ethabi.TupleTy:
-		panic("tuple types should use struct methods for packed encoding")
+		g.errorf("tuple types should use struct methods for packed encoding")
 	default:
-		panic("unsupported ABI type for packed encoding: " + t.String())
+		g.errorf("unsupported ABI type for packed encoding: %s", t.String())
 	}
 
 	g.L("}")
@@ -919,6 +2335,8 @@ func (g *Generator) genPackedEncodingFunction(t ethabi.Type) {
 
 // genPackedDecodingFunction generates a standalone packed decoding function for a specific ABI type
 func (g *Generator) genPackedDecodingFunction(t ethabi.Type) {
+	defer g.within("type %s", t.String())()
+
 	if !CanPackType(t) {
 		return
 	}
@@ -946,9 +2364,9 @@ func (g *Generator) genPackedDecodingFunction(t ethabi.Type) {
 	case ethabi.ArrayTy:
 		g.genPackedArrayDecoding(t)
 	case ethabi.TupleTy:
-		panic("tuple types should use struct methods for packed decoding")
+		g.errorf("tuple types should use struct methods for packed decoding")
 	default:
-		panic("unsupported ABI type for packed decoding: " + t.String())
+		g.errorf("unsupported ABI type for packed decoding: %s", t.String())
 	}
 
 	g.L("}")
@@ -956,10 +2374,51 @@ func (g *Generator) genPackedDecodingFunction(t ethabi.Type) {
 
 // genAllEventTopics generates all event constants at the beginning of the file
 func (g *Generator) genAllEventTopics(events []ethabi.Event) {
-	if len(events) == 0 {
+	own := g.ownEvents(events)
+	if len(own) > 0 {
+		g.genEventSignatures(own)
+	}
+	if len(events) == 0 || g.sharedFile {
+		return
+	}
+
+	g.L("")
+	g.L("// %sEvents maps event topics to event names", ToCamel(g.Options.Prefix))
+	g.L("var %sEvents = map[common.Hash]string{", ToCamel(g.Options.Prefix))
+	for _, event := range events {
+		g.L("\t%sTopic: \"%s\",", g.eventName(event), event.Name)
+	}
+	g.L("}")
+
+	g.L("")
+	g.L("// %sEventTopics maps event topics to the canonical event signatures", ToCamel(g.Options.Prefix))
+	g.L("var %sEventTopics = map[common.Hash]string{", ToCamel(g.Options.Prefix))
+	for _, event := range events {
+		g.L("\t%sTopic: %sSignature,", g.eventName(event), g.eventName(event))
+	}
+	g.L("}")
+}
+
+// genSelectorRegistry generates the map of the function and error selectors to their canonical signatures
+func (g *Generator) genSelectorRegistry(methods []ethabi.Method, errs []ethabi.Error) {
+	if g.Options.Stdlib || g.sharedFile || len(methods)+len(errs) == 0 {
 		return
 	}
 
+	g.L("")
+	g.L("// %sSelectors maps the function and error selectors to the canonical signatures", ToCamel(g.Options.Prefix))
+	g.L("var %sSelectors = map[[4]byte]string{", ToCamel(g.Options.Prefix))
+	for _, method := range methods {
+		g.L("\t%sSelector: %sSignature,", g.methodName(method), g.methodName(method))
+	}
+	for _, e := range errs {
+		g.L("\t%sErrorSelector: %q,", g.errorName(e), e.Sig)
+	}
+	g.L("}")
+}
+
+// genEventSignatures generates the topics and canonical signatures of the events
+func (g *Generator) genEventSignatures(events []ethabi.Event) {
 	g.L("")
 	g.L("// Event signatures")
 	g.L("var (")
@@ -970,12 +2429,59 @@ func (g *Generator) genAllEventTopics(events []ethabi.Event) {
 		for _, b := range event.ID {
 			parts = append(parts, fmt.Sprintf("0x%02x", b))
 		}
-		g.L("\t%sEventTopic = common.Hash{%s}", event.Name, strings.Join(parts, ", "))
+		g.L("\t%sTopic = common.Hash{%s}", g.eventName(event), strings.Join(parts, ", "))
+	}
+	g.L(")")
+
+	g.L("")
+	g.L("// Event IDs, the same hashes as the topics, named like go-ethereum's abi.Event.ID")
+	g.L("var (")
+	for _, event := range events {
+		g.L("\t%sID = %sTopic", g.eventName(event), g.eventName(event))
+	}
+	g.L(")")
+
+	g.L("")
+	g.L("// Canonical event signatures")
+	g.L("const (")
+	for _, event := range events {
+		g.L("\t%sSignature = \"%s\"", g.eventName(event), event.Sig)
+	}
+	g.L(")")
+}
+
+// genAllErrorSelectors generates the selectors of custom errors
+func (g *Generator) genAllErrorSelectors(errs []ethabi.Error) {
+	if len(errs) == 0 {
+		return
+	}
+
+	g.L("")
+	g.L("// Error selectors")
+	g.L("var (")
+	for _, e := range errs {
+		g.L("\t// %s", e.Sig)
+		g.L("\t%sErrorSelector = [4]byte{0x%02x, 0x%02x, 0x%02x, 0x%02x}",
+			g.errorName(e),
+			e.ID[0],
+			e.ID[1],
+			e.ID[2],
+			e.ID[3])
+	}
+	g.L(")")
+
+	g.L("")
+	g.L("// Big endian integer versions of error selectors")
+	g.L("const (")
+	for _, e := range errs {
+		g.L("\t%sErrorID = %d", g.errorName(e), binary.BigEndian.Uint32(e.ID[:4]))
 	}
 	g.L(")")
 }
 
 func (g *Generator) genEvent(event ethabi.Event) {
+	defer g.within("event %s", event.Sig)()
+
 	// Fill empty field names
 	for i := range event.Inputs {
 		if event.Inputs[i].Name == "" {
@@ -985,58 +2491,68 @@ func (g *Generator) genEvent(event ethabi.Event) {
 
 	// gen top level struct NameEvent
 	g.genEventTopLevel(event)
+	if g.Options.ToMap {
+		g.genEventToMap(event)
+	}
 
 	// gen struct NameEventIndexed
 	g.genEventIndexed(event)
 
 	// gen struct NameEventData
-	dataStruct := StructFromEventData(event)
+	dataStruct := StructFromArguments(g.eventName(event)+"Data", event.Inputs.NonIndexed())
 	if len(dataStruct.Fields) > 0 {
 		g.genStruct(dataStruct)
 	} else {
-		g.L("type %sEventData struct {", event.Name)
+		g.L("type %s struct {", dataStruct.Name)
 		g.L("\t%sEmptyTuple", g.StdPrefix)
 		g.L("}")
 	}
 }
 
 func (g *Generator) genEventTopLevel(event ethabi.Event) {
-	g.L("// %sEvent represents the %s event", event.Name, event.Name)
+	name := g.eventName(event)
+	g.L("// %s represents the %s event", name, event.Name)
 	// assert interface
-	g.L("var _ %sEvent = (*%sEvent)(nil)", g.StdPrefix, event.Name)
-	g.L("type %sEvent struct {", event.Name)
-	g.L("%sEventIndexed", event.Name)
-	g.L("%sEventData", event.Name)
+	g.L("var _ %sEvent = (*%s)(nil)", g.StdPrefix, name)
+	g.L("type %s struct {", name)
+	g.L("%sIndexed", name)
+	g.L("%sData", name)
 	g.L("}")
 
 	// gen constructor
-	g.L("// New%sEvent constructs a new %s event", event.Name, event.Name)
-	g.L("func New%sEvent(", event.Name)
+	g.L("// New%s constructs a new %s event", name, event.Name)
+	g.L("func New%s(", name)
 
 	for _, input := range event.Inputs {
 		goType := g.abiTypeToGoType(input.Type)
-		g.L("\t%s %s,", input.Name, goType)
+		g.L("\t%s %s,", ToArgName(GoFieldName(input.Name)), goType)
 	}
 
-	g.L(") *%sEvent {", event.Name)
-	g.L("return &%sEvent{", event.Name)
-	g.L("\t%sEventIndexed: %sEventIndexed{", event.Name, event.Name)
+	g.L(") *%s {", name)
+	g.L("return &%s{", name)
+	g.L("\t%sIndexed: %sIndexed{", name, name)
 
 	for _, input := range event.Inputs {
 		if !input.Indexed {
 			continue
 		}
-		g.L("%s: %s,", GoFieldName(input.Name), input.Name)
+		fieldName := GoFieldName(input.Name)
+		if isHashTopic(input.Type) {
+			g.L("%sPreimage: &%s,", fieldName, ToArgName(fieldName))
+			continue
+		}
+		g.L("%s: %s,", fieldName, ToArgName(fieldName))
 	}
 
 	g.L("\t},")
-	g.L("\t%sEventData: %sEventData{", event.Name, event.Name)
+	g.L("\t%sData: %sData{", name, name)
 
 	for _, input := range event.Inputs {
 		if input.Indexed {
 			continue
 		}
-		g.L("%s: %s,", GoFieldName(input.Name), input.Name)
+		fieldName := GoFieldName(input.Name)
+		g.L("%s: %s,", fieldName, ToArgName(fieldName))
 	}
 
 	g.L("\t},")
@@ -1046,20 +2562,20 @@ func (g *Generator) genEventTopLevel(event ethabi.Event) {
 	// GetEventName method
 	g.L("")
 	g.L("// GetEventName returns the event name")
-	g.L("func (e %sEvent) GetEventName() string {", event.Name)
+	g.L("func (e %s) GetEventName() string {", g.recv(name))
 	g.L("\treturn \"%s\"", event.Name)
 	g.L("}")
 
 	// GetEventID method
 	g.L("")
 	g.L("// GetEventID returns the event ID (topic)")
-	g.L("func (e %sEvent) GetEventID() common.Hash {", event.Name)
-	g.L("\treturn %sEventTopic", event.Name)
+	g.L("func (e %s) GetEventID() common.Hash {", g.recv(name))
+	g.L("\treturn %sTopic", name)
 	g.L("}")
 }
 
 func (g *Generator) genEventIndexed(event ethabi.Event) {
-	name := event.Name
+	name := g.eventName(event)
 
 	var fields []ethabi.Argument
 	for _, input := range event.Inputs {
@@ -1069,37 +2585,56 @@ func (g *Generator) genEventIndexed(event ethabi.Event) {
 		fields = append(fields, input)
 	}
 
-	if len(fields) == 0 {
-		g.L("type %sEventIndexed struct {", event.Name)
-		g.L("\t%sEmptyIndexed", g.StdPrefix)
-		g.L("}")
-		return
+	// the first topic is the event signature, except for the anonymous events
+	first := 1
+	if event.Anonymous {
+		first = 0
 	}
 
-	g.L("// %s represents an ABI event", name)
-	g.L("type %sEventIndexed struct {", name)
+	g.L("// %s represents an ABI event", event.Name)
+	if slices.ContainsFunc(fields, func(input ethabi.Argument) bool { return isHashTopic(input.Type) }) {
+		g.L("//")
+		g.L("// Indexed dynamic and non-word fields only appear as keccak hashes in the topics,")
+		g.L("// the original values are unrecoverable, set the XxxPreimage fields to hash them in EncodeTopics.")
+	}
+	g.L("type %sIndexed struct {", name)
 
 	for _, input := range fields {
 		goType := g.abiTypeToGoType(input.Type)
 		fieldName := GoFieldName(input.Name)
-		g.L("%s %s", fieldName, goType)
+		if isHashTopic(input.Type) {
+			g.L("%s common.Hash%s", fieldName, g.fieldTag(input.Name))
+			g.L("%sPreimage *%s%s", fieldName, goType, g.fieldTag(input.Name+"Preimage"))
+			continue
+		}
+		g.L("%s %s%s", fieldName, goType, g.fieldTag(input.Name))
 	}
 	g.L("}")
 
 	// Generate methods for indexed fields
-	g.L("// EncodeTopics encodes indexed fields of %s event to topics", name)
-	g.L("func (e %sEventIndexed) EncodeTopics() ([]common.Hash, error) {", name)
-	g.L("\ttopics := make([]common.Hash, 0, %d)", len(fields)+1)
-	g.L("\ttopics = append(topics, %sEventTopic)", name)
+	g.L("// EncodeTopics encodes indexed fields of %s event to topics", event.Name)
+	g.L("func (e %s) EncodeTopics() ([]common.Hash, error) {", g.recv(name+"Indexed"))
+	g.L("\ttopics := make([]common.Hash, 0, %d)", len(fields)+first)
+	if !event.Anonymous {
+		g.L("\ttopics = append(topics, %sTopic)", name)
+	}
 
 	for _, input := range fields {
 		fieldName := GoFieldName(input.Name)
 
 		g.L("\t{")
 		g.L("\t\t// %s", fieldName)
-		ref := fmt.Sprintf("e.%s", fieldName)
-
-		g.genEncodeToHash(input.Type, ref)
+		if isHashTopic(input.Type) {
+			g.L("hash := e.%s", fieldName)
+			g.L("if e.%sPreimage != nil {", fieldName)
+			g.genHashTopic(input.Type, fmt.Sprintf("*e.%sPreimage", fieldName))
+			g.L("}")
+		} else {
+			g.L("var hash common.Hash")
+			g.L("if _, err := %s; err != nil {", g.genEncodeCall(input.Type, "e."+fieldName, "hash[:]"))
+			g.L("\treturn nil, err")
+			g.L("}")
+		}
 
 		g.L("\t\ttopics = append(topics, hash)")
 		g.L("\t}")
@@ -1108,36 +2643,39 @@ func (g *Generator) genEventIndexed(event ethabi.Event) {
 	g.L("\treturn topics, nil")
 	g.L("}")
 
-	g.L("// DecodeTopics decodes indexed fields of %s event from topics, ignore hash topics", name)
-	g.L("func (e *%sEventIndexed) DecodeTopics(topics []common.Hash) error {", name)
-
-	g.L("\tif len(topics) != %d {", len(fields)+1)
-	g.L("\t\treturn %sErrInvalidNumberOfTopics", g.StdPrefix)
-	g.L("\t}")
+	g.L("// DecodeTopics decodes indexed fields of %s event from topics, hash topics are stored as is", event.Name)
+	g.L("func (e *%sIndexed) DecodeTopics(topics []common.Hash) error {", name)
 
-	g.L("\tif topics[0] != %sEventTopic {", name)
-	g.L("\t\treturn %sErrInvalidEventTopic", g.StdPrefix)
+	if g.Options.LenientTopics {
+		// tolerate the extra trailing topics of non-standard emitters
+		g.L("\tif len(topics) < %d {", len(fields)+first)
+	} else {
+		g.L("\tif len(topics) != %d {", len(fields)+first)
+	}
+	g.L("\t\treturn %sTopicCountMismatch(%d, len(topics))", g.StdPrefix, len(fields)+first)
 	g.L("\t}")
 
-	decodeFields := make(map[int]struct{})
-	for i, input := range fields {
-		if IsDynamicType(input.Type) || GetTypeSize(input.Type) != 32 {
-			// ignore hash fields silently
-			continue
-		}
-		decodeFields[i] = struct{}{}
+	if !event.Anonymous {
+		g.L("\tif topics[0] != %sTopic {", name)
+		g.L("\t\treturn %sErrEventSignatureMismatch", g.StdPrefix)
+		g.L("\t}")
 	}
 
-	if len(decodeFields) > 0 {
-		g.L("\tvar err error")
+	for _, input := range fields {
+		if !isHashTopic(input.Type) {
+			g.L("\tvar err error")
+			break
+		}
 	}
 	for i, input := range fields {
-		if _, ok := decodeFields[i]; !ok {
+		fieldName := GoFieldName(input.Name)
+		if isHashTopic(input.Type) {
+			g.L("\te.%s = topics[%d]", fieldName, i+first)
+			g.L("\te.%sPreimage = nil", fieldName)
 			continue
 		}
 
-		fieldName := GoFieldName(input.Name)
-		dataRef := fmt.Sprintf("topics[%d][:]", i+1)
+		dataRef := fmt.Sprintf("topics[%d][:]", i+first)
 		g.L("\te.%s, _, err = %s", fieldName, g.genDecodeCall(input.Type, dataRef))
 		g.L("\tif err != nil {")
 		g.L("\t\treturn err")
@@ -1148,29 +2686,73 @@ func (g *Generator) genEventIndexed(event ethabi.Event) {
 	g.L("}")
 }
 
-func (g *Generator) genEncodeToHash(t ethabi.Type, ref string) {
-	isDynamic := IsDynamicType(t)
-	typeSize := GetTypeSize(t)
-	if !isDynamic && typeSize == 32 {
-		// fast path
-		g.L("var hash common.Hash")
-		g.L("if _, err := %s; err != nil {", g.genEncodeCall(t, ref, "hash[:]"))
-		g.L("\treturn nil, err")
-		g.L("}")
-	} else if isDynamic {
-		// dynamic type - hash the encoded bytes
-		g.L("encodedSize := %s", g.genSizeCall(t, ref))
-		g.L("buf := make([]byte, encodedSize)")
-		g.L("if _, err := %s; err != nil {", g.genEncodeCall(t, ref, "buf"))
-		g.L("\treturn nil, err")
-		g.L("}")
-		g.L("hash := crypto.Keccak256Hash(buf)")
+// isHashTopic returns if the indexed field of type t is stored as the keccak hash in the topics
+func isHashTopic(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.StringTy, ethabi.BytesTy, ethabi.SliceTy, ethabi.ArrayTy, ethabi.TupleTy:
+		// the reference types are hashed even if they fit in a word, e.g. a tuple of a single uint256
+		return true
+	default:
+		return false
+	}
+}
+
+// genHashTopic generates the code to assign the topic hash of the indexed value ref to hash
+func (g *Generator) genHashTopic(t ethabi.Type, ref string) {
+	switch t.T {
+	case ethabi.StringTy:
+		// strings and bytes are hashed without the abi encoding
+		g.L("hash = %sKeccak256Hash([]byte(%s))", g.StdPrefix, ref)
+		return
+	case ethabi.BytesTy:
+		g.L("hash = %sKeccak256Hash(%s)", g.StdPrefix, ref)
+		return
+	}
+
+	ref = "(" + ref + ")"
+	if IsDynamicType(t) {
+		// the dynamic tuples and arrays are hashed in the in-place encoding, without the offsets and lengths
+		g.L("var buf []byte")
+		g.genTopicEncoding(t, ref, 0)
 	} else {
-		// static type but not 32 bytes
-		g.L("buf := make([]byte, %d)", typeSize)
+		g.L("buf := make([]byte, %d)", GetTypeSize(t))
 		g.L("if _, err := %s; err != nil {", g.genEncodeCall(t, ref, "buf"))
 		g.L("\treturn nil, err")
 		g.L("}")
-		g.L("hash := crypto.Keccak256Hash(buf)")
+	}
+	g.L("hash = %sKeccak256Hash(buf)", g.StdPrefix)
+}
+
+// genTopicEncoding generates the code appending the in-place encoding of ref to buf, as specified for
+// the indexed event parameters: the elements of the arrays and the fields of the tuples are concatenated,
+// the strings and bytes are padded to a multiple of 32 bytes, and the static values are encoded as usual.
+func (g *Generator) genTopicEncoding(t ethabi.Type, ref string, depth int) {
+	switch {
+	case t.T == ethabi.StringTy || t.T == ethabi.BytesTy:
+		g.L("buf = append(buf, %s...)", ref)
+		g.L("buf = append(buf, make([]byte, %sPad32(len(%s))-len(%s))...)", g.StdPrefix, ref, ref)
+	case !IsDynamicType(t):
+		g.L("{")
+		g.L("\tn := len(buf)")
+		g.L("\tbuf = append(buf, make([]byte, %d)...)", GetTypeSize(t))
+		g.L("\tif _, err := %s; err != nil {", g.genEncodeCall(t, ref, "buf[n:]"))
+		g.L("\t\treturn nil, err")
+		g.L("\t}")
+		g.L("}")
+	case t.T == ethabi.SliceTy || t.T == ethabi.ArrayTy:
+		elem := fmt.Sprintf("elem%d", depth)
+		g.L("for _, %s := range %s {", elem, ref)
+		g.genTopicEncoding(*t.Elem, elem, depth+1)
+		g.L("}")
+	case t.T == ethabi.TupleTy:
+		for i, elem := range t.TupleElems {
+			fieldName := GoFieldName(t.TupleRawNames[i])
+			if fieldName == "" {
+				fieldName = fmt.Sprintf("Field%d", i+1)
+			}
+			g.genTopicEncoding(*elem, ref+"."+fieldName, depth)
+		}
+	default:
+		g.errorf("unsupported indexed type %s", t.String())
 	}
 }
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/yihuang/go-abi"
+)
+
+// stdlibTypes are the identifiers of the types with the encoding functions in the runtime
+var stdlibTypes map[string]struct{}
+
+func init() {
+	bz, err := abi.ParseHumanReadableABI(abi.StdlibABI)
+	if err != nil {
+		panic(err)
+	}
+	stdlib, err := ethabi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		panic(err)
+	}
+
+	stdlibTypes = make(map[string]struct{})
+	for _, method := range stdlib.Methods {
+		for _, input := range method.Inputs {
+			stdlibTypes[GenTypeIdentifier(input.Type)] = struct{}{}
+		}
+	}
+}
+
+// IsStdlibType returns if the runtime provides the encoding functions of the type identifier
+func IsStdlibType(ident string) bool {
+	_, ok := stdlibTypes[ident]
+	return ok
+}
+
+// GenTypeIdentifier generates a unique identifier for any ABI type
+// This is used to create unique function names for encoding/decoding
+func GenTypeIdentifier(t ethabi.Type) string {
+	switch t.T {
+	case ethabi.UintTy:
+		return fmt.Sprintf("Uint%d", t.Size)
+	case ethabi.IntTy:
+		return fmt.Sprintf("Int%d", t.Size)
+	case ethabi.AddressTy:
+		return "Address"
+	case ethabi.BoolTy:
+		return "Bool"
+	case ethabi.StringTy:
+		return "String"
+	case ethabi.BytesTy:
+		return "Bytes"
+	case ethabi.FixedBytesTy:
+		return fmt.Sprintf("Bytes%d", t.Size)
+	case ethabi.SliceTy:
+		return fmt.Sprintf("%sSlice", GenTypeIdentifier(*t.Elem))
+	case ethabi.ArrayTy:
+		return fmt.Sprintf("%sArray%d", GenTypeIdentifier(*t.Elem), t.Size)
+	case ethabi.TupleTy:
+		return TupleStructName(t) // Reuse existing tuple identifier logic
+	default:
+		panic("unsupported ABI type for identifier generation: " + t.String())
+	}
+}
+
+// GenTupleIdentifier generates a unique identifier for a tuple type
+func GenTupleIdentifier(t ethabi.Type) string {
+	// Create a signature based on tuple element types
+	types := make([]string, len(t.TupleElems))
+	for i, elem := range t.TupleElems {
+		types[i] = elem.String()
+	}
+
+	sig := fmt.Sprintf("(%v)", strings.Join(types, ","))
+	id := abi.Keccak256([]byte(sig))
+	return "Tuple" + hex.EncodeToString(id)[:8] // Use first 8 chars for readability
+}
+
+// TupleStructName generates a unique struct name for a tuple type
+func TupleStructName(t ethabi.Type) string {
+	if t.TupleRawName != "" {
+		return t.TupleRawName
+	}
+
+	// Use the tuple's string representation as the basis for the struct name
+	// This creates a deterministic name based on the tuple structure
+	return GenTupleIdentifier(t)
+}
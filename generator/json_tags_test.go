@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestJSONTags(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "submit",
+			"inputs": [
+				{
+					"name": "order",
+					"type": "tuple",
+					"components": [
+						{"name": "maker", "type": "address"},
+						{"name": "amount", "type": "uint256"}
+					]
+				},
+				{"name": "", "type": "bytes"}
+			],
+			"outputs": [{"name": "", "type": "bool"}]
+		},
+		{
+			"type": "event",
+			"name": "Submitted",
+			"inputs": [
+				{"name": "maker", "type": "address", "indexed": true},
+				{"name": "amount", "type": "uint256", "indexed": false}
+			]
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	code, err := NewGenerator().GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if strings.Contains(code, "`json:") {
+		t.Error("Expected no json tags without JSONTags option")
+	}
+
+	code, err = NewGenerator(JSONTags(true)).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+
+	for _, expected := range []string{
+		"Maker common.Address `json:\"maker\"`",
+		"`json:\"order\"`",
+		"Field2 []byte `json:\"field2\"`",
+		"Field1 bool `json:\"field1\"`",
+		"Amount *big.Int `json:\"amount\"`",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected generated code to contain %q", expected)
+		}
+	}
+}
+
+func TestJSONNaming(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "swap",
+			"inputs": [
+				{"name": "tokenIn", "type": "address"},
+				{"name": "amount_out", "type": "uint256"},
+				{"name": "", "type": "bytes"}
+			],
+			"outputs": [{"name": "", "type": "bool"}]
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	tests := []struct {
+		naming   string
+		expected []string
+	}{
+		{NamingABI, []string{"`json:\"tokenIn\"`", "`json:\"amount_out\"`", "`json:\"field3\"`", "`json:\"field1\"`"}},
+		{NamingCamel, []string{"`json:\"tokenIn\"`", "`json:\"amountOut\"`", "`json:\"arg3\"`", "`json:\"result1\"`"}},
+		{NamingSnake, []string{"`json:\"token_in\"`", "`json:\"amount_out\"`", "`json:\"arg3\"`", "`json:\"result1\"`"}},
+		{NamingPascal, []string{"`json:\"TokenIn\"`", "`json:\"AmountOut\"`", "`json:\"Arg3\"`", "`json:\"Result1\"`"}},
+	}
+
+	for _, tc := range tests {
+		code, err := NewGenerator(JSONTags(true), JSONNaming(tc.naming)).GenerateFromABI(abiDef)
+		if err != nil {
+			t.Fatalf("Failed to generate code: %v", err)
+		}
+		for _, expected := range tc.expected {
+			if !strings.Contains(code, expected) {
+				t.Errorf("Expected %s naming to generate %s", tc.naming, expected)
+			}
+		}
+	}
+
+	code, err := NewGenerator(JSONNaming(NamingSnake)).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if !strings.Contains(code, "`json:\"token_in\"`") {
+		t.Error("Expected snake naming to imply json tags")
+	}
+}
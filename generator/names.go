@@ -0,0 +1,403 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// tupleRenamer renames the tuples whose struct names collide with other generated names
+type tupleRenamer struct {
+	// names generated for the methods, events, errors and enums, to the items generating them
+	reserved map[string]string
+	// struct name to the identifier of the tuple shape using it
+	shapes map[string]string
+	// names generated for the resolved tuple structs, to the struct names
+	taken map[string]string
+	// original struct name and tuple identifier to the resolved struct name
+	resolved map[[2]string]string
+	external map[string]string
+	opts     Options
+	warnings []string
+}
+
+// generatedNames collects the package-level names generated for the items of the ABI, the first
+// name generated by two different items is reported as error as the code wouldn't compile.
+type generatedNames struct {
+	owners map[string]string
+	err    error
+}
+
+var suffixRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// checkSuffixes validates the suffixes of the struct names, they must be non-empty identifiers
+// and differ from each other so the structs of a method and an event of the same name don't collide
+func checkSuffixes(opts Options) error {
+	suffixes := []string{opts.CallSuffix, opts.ReturnSuffix, opts.EventSuffix}
+	for i, suffix := range suffixes {
+		if !suffixRegex.MatchString(suffix) {
+			return fmt.Errorf("invalid struct name suffix %q, must be a non-empty identifier", suffix)
+		}
+		for _, other := range suffixes[:i] {
+			if suffix == other {
+				return fmt.Errorf("the call, return and event suffixes must differ, got %q twice", suffix)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveNameCollisions returns a copy of abiDef where the colliding names are renamed with a numeric suffix,
+// the renames are returned as warnings. First the methods, events and errors are renamed in order when the
+// names generated for them collide with the names of the package, the enums and the encoding functions, or
+// with the names of the items before them, e.g. the function foo is generated as Foo2 after the function Foo.
+// Then the tuples are renamed when their struct names collide with the names generated for the items, or with
+// another tuple of a different shape.
+func (g *Generator) resolveNameCollisions(abiDef ethabi.ABI) (ethabi.ABI, []string) {
+	warnings := g.resolveItemNames(abiDef)
+	n := &tupleRenamer{
+		reserved: g.reservedNames(abiDef).owners,
+		shapes:   make(map[string]string),
+		taken:    make(map[string]string),
+		resolved: make(map[[2]string]string),
+		external: g.Options.ExternalTuples,
+		opts:     g.Options,
+	}
+
+	methods := make(map[string]ethabi.Method, len(abiDef.Methods))
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		method.Inputs = n.arguments(method.Inputs)
+		method.Outputs = n.arguments(method.Outputs)
+		methods[name] = method
+	}
+	abiDef.Methods = methods
+
+	events := make(map[string]ethabi.Event, len(abiDef.Events))
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[name]
+		event.Inputs = n.arguments(event.Inputs)
+		events[name] = event
+	}
+	abiDef.Events = events
+
+	return abiDef, append(warnings, n.warnings...)
+}
+
+// resolveItemNames resolves the Go names of the methods, events and errors into itemNames, see
+// resolveNameCollisions, and returns the renames as warnings.
+func (g *Generator) resolveItemNames(abiDef ethabi.ABI) []string {
+	g.itemNames = make(map[string]string)
+
+	// the names of the package, the enums and the types are fixed, their collisions are reported by checkNames
+	names := &generatedNames{owners: make(map[string]string)}
+	g.packageNames(abiDef, names)
+	g.typeFuncNames(abiDef, names)
+
+	var warnings []string
+	resolve := func(key, owner, name string, generate func(string) []string) {
+		resolved := name
+		for i := 2; names.taken(owner, generate(resolved)); i++ {
+			resolved = fmt.Sprintf("%s%d", name, i)
+		}
+		if resolved != name {
+			g.itemNames[key] = resolved
+			warnings = append(warnings, fmt.Sprintf("%s collides with another generated name, renamed to %s", owner, resolved))
+		}
+		names.add(owner, generate(resolved)...)
+	}
+
+	for _, key := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[key]
+		resolve("function "+method.Name, "function "+method.Sig, Title.String(method.Name), func(name string) []string {
+			return methodNames(name, method, g.Options)
+		})
+	}
+	for _, key := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[key]
+		resolve("event "+event.Name, "event "+event.Sig, event.Name, func(name string) []string {
+			return eventNames(name, g.Options)
+		})
+	}
+	for _, key := range SortedMapKeys(abiDef.Errors) {
+		e := abiDef.Errors[key]
+		resolve("error "+e.Name, "error "+e.Sig, e.Name, errorNames)
+	}
+	return warnings
+}
+
+// checkNames reports the package-level names generated twice which can't be resolved by renaming,
+// e.g. an enum named like the Selectors registry.
+func (g *Generator) checkNames(abiDef ethabi.ABI) error {
+	names := g.reservedNames(abiDef)
+	g.typeFuncNames(abiDef, names)
+	return names.err
+}
+
+// reservedNames returns the package-level names generated for the resolved methods, events and errors,
+// for the enums and for the package itself
+func (g *Generator) reservedNames(abiDef ethabi.ABI) *generatedNames {
+	names := &generatedNames{owners: make(map[string]string)}
+	g.packageNames(abiDef, names)
+	for _, key := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[key]
+		names.add("function "+method.Sig, methodNames(g.methodName(method), method, g.Options)...)
+	}
+	for _, key := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[key]
+		names.add("event "+event.Sig, eventNames(g.eventBaseName(event), g.Options)...)
+	}
+	for _, key := range SortedMapKeys(abiDef.Errors) {
+		e := abiDef.Errors[key]
+		names.add("error "+e.Sig, errorNames(g.errorName(e))...)
+	}
+	return names
+}
+
+// packageNames adds the names generated once for the package and the enums
+func (g *Generator) packageNames(abiDef ethabi.ABI, names *generatedNames) {
+	opts := g.Options
+	g.enumNames(abiDef, names)
+
+	const pkg = "the generated package"
+	prefix := ToCamel(opts.Prefix)
+	if len(abiDef.Events) > 0 {
+		names.add(pkg, prefix+"Events", prefix+"EventTopics")
+	}
+	if !opts.Stdlib && len(abiDef.Methods)+len(abiDef.Errors) > 0 {
+		names.add(pkg, prefix+"Selectors")
+	}
+	if !opts.Stdlib && len(abiDef.Methods) > 0 {
+		names.add(pkg, prefix+"DecodeBySelector")
+		if opts.PackUnpack {
+			names.add(pkg, prefix+"Pack", prefix+"Unpack")
+		}
+	}
+	if opts.TestHelpers {
+		names.add(pkg, "FuzzDecode"+Title.String(opts.Prefix))
+	}
+	if abiDef.HasFallback() {
+		names.add("the fallback function", structNames("FallbackCall", opts, false)...)
+		names.add("the fallback function", "FallbackStateMutability")
+	}
+	if abiDef.HasReceive() {
+		names.add("the receive function", "ReceiveCall", "ReceiveStateMutability")
+	}
+	if opts.Client != "" {
+		names.add("the client", opts.Client, "New"+opts.Client)
+	}
+	if opts.Caller != "" {
+		names.add("the caller", opts.Caller+"Backend", opts.Caller+"Caller", "New"+opts.Caller+"Caller")
+		if opts.Interface {
+			names.add("the caller", opts.Caller+"Interface")
+		}
+	}
+}
+
+// methodNames returns the package-level names generated for the method with the Go name
+func methodNames(name string, method ethabi.Method, opts Options) []string {
+	names := structNames(name+opts.CallSuffix, opts, false)
+	names = append(names, structNames(name+opts.ReturnSuffix, opts, false)...)
+	names = append(names,
+		name+"Selector", name+"ID", name+"Signature",
+		"New"+name+opts.CallSuffix, "Decode"+name+opts.ReturnSuffix,
+	)
+	if len(method.Outputs) == 1 {
+		names = append(names, "Decode"+name, "Decode"+name+"Hex", "Encode"+name+"Result")
+	}
+	return names
+}
+
+// eventNames returns the package-level names generated for the event with the Go name, before the suffix
+func eventNames(name string, opts Options) []string {
+	name += opts.EventSuffix
+	names := structNames(name+"Data", opts, false)
+	return append(names, name, name+"Indexed", name+"Topic", name+"ID", name+"Signature", "New"+name)
+}
+
+// errorNames returns the package-level names generated for the custom error with the Go name
+func errorNames(name string) []string {
+	return []string{name + "ErrorSelector", name + "ErrorID"}
+}
+
+// enumNames adds the enum types and their encoding functions
+func (g *Generator) enumNames(abiDef ethabi.ABI, names *generatedNames) {
+	enums := make(map[string]ethabi.Type)
+	visit := func(t ethabi.Type) {
+		if name := g.enumName(t); name != "" {
+			enums[name] = t
+		}
+	}
+	for _, method := range abiDef.Methods {
+		for _, arg := range append(slices.Clone(method.Inputs), method.Outputs...) {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	for _, event := range abiDef.Events {
+		for _, arg := range event.Inputs {
+			VisitABIType(arg.Type, visit)
+		}
+	}
+	for _, name := range SortedMapKeys(enums) {
+		t := enums[name]
+		names.add("enum "+name, name)
+		for _, fn := range []string{"Encode", "Decode", "PackedEncode", "PackedDecode"} {
+			names.add("enum "+name, g.genFuncName(t, fn))
+		}
+	}
+}
+
+// typeFuncNames adds the encoding functions generated for the types of the methods and events, the items
+// with unsupported types are skipped, they are reported by checkTypes
+func (g *Generator) typeFuncNames(abiDef ethabi.ABI, names *generatedNames) {
+	var methods []ethabi.Method
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		if supportedArguments(method.Inputs) && supportedArguments(method.Outputs) {
+			methods = append(methods, method)
+		}
+	}
+	var events []ethabi.Event
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[name]
+		if supportedArguments(event.Inputs) {
+			events = append(events, event)
+		}
+	}
+
+	for _, t := range g.collectAllTypes(methods, events) {
+		fns := []string{"Encode", "Size", "Decode", "PackedEncode", "PackedDecode"}
+		if t.T == ethabi.SliceTy || g.isBigIntType(t) {
+			fns = append(fns, "DecodeInto")
+		}
+		if t.T == ethabi.SliceTy {
+			fns = append(fns, "EncodeTopLevel", "DecodeTopLevel")
+		}
+		if g.Options.DecodeCtx && g.decodesSlices(t) {
+			fns = append(fns, "DecodeCtx")
+		}
+		for _, fn := range fns {
+			if name := g.genFuncName(t, fn); !strings.Contains(name, ".") {
+				names.add("type "+t.String(), name)
+			}
+		}
+	}
+}
+
+// structNames returns the package-level names generated for the struct, the tuple structs have
+// the EIP-712 type and hash in addition
+func structNames(name string, opts Options, tuple bool) []string {
+	names := []string{name, name + "StaticSize"}
+	if opts.TestHelpers {
+		names = append(names, "Random"+name)
+	}
+	if tuple && opts.EIP712 {
+		names = append(names, name+"EIP712Type", name+"TypeHash")
+	}
+	return names
+}
+
+// supportedArguments returns if the generator supports the types of all the arguments
+func supportedArguments(args ethabi.Arguments) bool {
+	supported := true
+	for _, arg := range args {
+		VisitABIType(arg.Type, func(t ethabi.Type) {
+			supported = supported && supportedType(t)
+		})
+	}
+	return supported
+}
+
+// taken returns if any of the names is generated by another owner
+func (n *generatedNames) taken(owner string, names []string) bool {
+	for _, name := range names {
+		if other, ok := n.owners[name]; ok && other != owner {
+			return true
+		}
+	}
+	return false
+}
+
+// add records the names generated by the owner, the names generated by another owner are reported
+func (n *generatedNames) add(owner string, names ...string) {
+	for _, name := range names {
+		other, ok := n.owners[name]
+		if !ok {
+			n.owners[name] = owner
+		} else if other != owner && n.err == nil {
+			n.err = fmt.Errorf("%s and %s both generate %s", other, owner, name)
+		}
+	}
+}
+
+func (n *tupleRenamer) arguments(args ethabi.Arguments) ethabi.Arguments {
+	result := make(ethabi.Arguments, len(args))
+	for i, arg := range args {
+		arg.Type = n.rename(arg.Type)
+		result[i] = arg
+	}
+	return result
+}
+
+// rename returns a copy of t with the colliding tuples renamed
+func (n *tupleRenamer) rename(t ethabi.Type) ethabi.Type {
+	switch t.T {
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		elem := n.rename(*t.Elem)
+		t.Elem = &elem
+	case ethabi.TupleTy:
+		elems := make([]*ethabi.Type, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			renamed := n.rename(*elem)
+			elems[i] = &renamed
+		}
+		t.TupleElems = elems
+
+		name := TupleStructName(t)
+		if _, ok := n.external[name]; ok {
+			break
+		}
+
+		id := GenTupleIdentifier(t)
+		key := [2]string{name, id}
+		resolved, ok := n.resolved[key]
+		if !ok {
+			resolved = name
+			for i := 2; n.collides(resolved, id); i++ {
+				resolved = fmt.Sprintf("%s%d", name, i)
+			}
+			if resolved != name {
+				n.warnings = append(n.warnings, fmt.Sprintf("tuple %s %s collides with another generated name, renamed to %s", name, t.String(), resolved))
+			}
+			n.shapes[resolved] = id
+			for _, generated := range structNames(resolved, n.opts, true) {
+				n.taken[generated] = resolved
+			}
+			n.resolved[key] = resolved
+		}
+		if resolved != name {
+			t.TupleRawName = resolved
+		}
+	}
+	return t
+}
+
+// collides returns if the struct name or the names generated for it are taken by a generated name,
+// or by a tuple of a different shape
+func (n *tupleRenamer) collides(name, id string) bool {
+	if shape, ok := n.shapes[name]; ok && shape != id {
+		return true
+	}
+	for _, generated := range structNames(name, n.opts, true) {
+		if _, ok := n.reserved[generated]; ok {
+			return true
+		}
+		if other, ok := n.taken[generated]; ok && other != name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+package generator
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"golang.org/x/tools/imports"
+)
+
+func TestNameCollisions(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "transfer",
+			"inputs": [
+				{"name": "call", "type": "tuple", "internalType": "struct TransferCall", "components": [
+					{"name": "to", "type": "address"},
+					{"name": "amount", "type": "uint256"}
+				]}
+			],
+			"outputs": []
+		},
+		{
+			"type": "function",
+			"name": "getInfo",
+			"inputs": [
+				{"name": "info", "type": "tuple", "internalType": "struct Info", "components": [
+					{"name": "id", "type": "uint256"}
+				]}
+			],
+			"outputs": [
+				{"name": "", "type": "tuple", "internalType": "struct Info", "components": [
+					{"name": "name", "type": "string"}
+				]}
+			]
+		},
+		{
+			"type": "function",
+			"name": "setInfo",
+			"inputs": [
+				{"name": "info", "type": "tuple", "internalType": "struct Info", "components": [
+					{"name": "id", "type": "uint256"}
+				]}
+			],
+			"outputs": []
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	gen := NewGenerator()
+	code, err := gen.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, match := range regexp.MustCompile(`(?m)^type (\w+) struct`).FindAllStringSubmatch(code, -1) {
+		if seen[match[1]] {
+			t.Errorf("Duplicate type declaration %s", match[1])
+		}
+		seen[match[1]] = true
+	}
+
+	for _, expected := range []string{
+		"Call TransferCall2",
+		"Info Info\n",
+		"Field1 Info2",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected generated code to contain %q", expected)
+		}
+	}
+
+	if len(gen.Warnings) != 2 {
+		t.Fatalf("Expected 2 warnings, got %v", gen.Warnings)
+	}
+	if !strings.Contains(gen.Warnings[0], "renamed to Info2") || !strings.Contains(gen.Warnings[1], "renamed to TransferCall2") {
+		t.Errorf("Unexpected warnings %v", gen.Warnings)
+	}
+
+	// the renames are deterministic
+	again := NewGenerator()
+	code2, err := again.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if code != code2 {
+		t.Error("Expected deterministic output")
+	}
+}
+
+func TestStructSuffixes(t *testing.T) {
+	abiDef := mustParseABI(t, `[
+		{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}], "outputs": [{"name": "", "type": "bool"}]},
+		{"type": "event", "name": "Transfer", "inputs": [{"name": "to", "type": "address", "indexed": true}]}
+	]`)
+
+	code, err := NewGenerator(CallSuffix("Args"), ReturnSuffix("Results"), EventSuffix("Log")).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	for _, expected := range []string{
+		"type TransferArgs struct", "func NewTransferArgs(", "type TransferResults struct", "func DecodeTransferResults(",
+		"type TransferLog struct", "type TransferLogIndexed struct", "type TransferLogData struct", "TransferLogTopic = ",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected generated code to contain %q", expected)
+		}
+	}
+	if strings.Contains(code, "TransferCall") || strings.Contains(code, "TransferEvent") {
+		t.Error("Expected no default suffixes in the generated code")
+	}
+
+	for _, opts := range [][]Option{
+		{CallSuffix("")},
+		{ReturnSuffix("Call")},
+		{EventSuffix("Re-turn")},
+	} {
+		if _, err := NewGenerator(opts...).GenerateFromABI(abiDef); err == nil {
+			t.Errorf("Expected error for invalid suffixes %+v", NewOptions(opts...))
+		}
+	}
+}
+
+func TestGeneratedNameCollisions(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "get",
+			"inputs": [
+				{"name": "s", "type": "tuple", "internalType": "struct Selectors", "components": [{"name": "a", "type": "uint256"}]},
+				{"name": "p", "type": "tuple", "internalType": "struct Pack", "components": [{"name": "a", "type": "bool"}]},
+				{"name": "status", "type": "uint8", "internalType": "enum Status"},
+				{"name": "st", "type": "tuple", "internalType": "struct Status", "components": [{"name": "a", "type": "string"}]}
+			],
+			"outputs": [
+				{"name": "", "type": "tuple", "internalType": "struct Events", "components": [{"name": "b", "type": "address"}]}
+			],
+			"stateMutability": "view"
+		},
+		{
+			"type": "function",
+			"name": "info",
+			"inputs": [
+				{"name": "i", "type": "tuple[]", "internalType": "struct Info[]", "components": [{"name": "a", "type": "uint64"}]},
+				{"name": "r", "type": "tuple", "internalType": "struct RandomInfo", "components": [{"name": "a", "type": "bytes"}]},
+				{"name": "v", "type": "tuple", "internalType": "struct TokenInterface", "components": [{"name": "a", "type": "uint8"}]}
+			],
+			"outputs": [
+				{"name": "", "type": "tuple", "internalType": "struct Unpack", "components": [{"name": "a", "type": "int32"}]}
+			],
+			"stateMutability": "view"
+		},
+		{
+			"type": "event",
+			"name": "Transfer",
+			"inputs": [
+				{"name": "t", "type": "tuple", "internalType": "struct EventTopics", "components": [{"name": "a", "type": "uint256"}], "indexed": false}
+			]
+		}
+	]`
+
+	abiDef := mustParseABI(t, abiJSON)
+	if err := MarkEnums(abiDef, []byte(abiJSON)); err != nil {
+		t.Fatalf("Failed to mark enums: %v", err)
+	}
+
+	gen := NewGenerator(
+		GenerateTestHelpers(true), GeneratePackUnpack(true), GenerateEnums(true), GenerateEIP712(true),
+		GenerateCaller("Token"), GenerateInterface(true),
+	)
+	code, err := gen.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if len(gen.Warnings) != 8 {
+		t.Errorf("Expected 8 renames, got %v", gen.Warnings)
+	}
+	buildGenerated(t, code)
+}
+
+// buildGenerated checks that the generated code builds in the module
+func buildGenerated(t *testing.T, code string) {
+	t.Helper()
+	dir, err := os.MkdirTemp(".", "_names")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "names.abi.go")
+	formatted, err := imports.Process(file, []byte(code), &imports.Options{Comments: true})
+	if err != nil {
+		t.Fatalf("Failed to format the generated code: %v", err)
+	}
+	if err := os.WriteFile(file, formatted, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("go", "build", "./"+dir).CombinedOutput(); err != nil {
+		t.Fatalf("Failed to build the generated code: %v\n%s", err, out)
+	}
+}
+
+func TestDuplicateGoNames(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	for _, tc := range []struct {
+		abiJSON  string
+		opts     []Option
+		expected string
+	}{
+		{
+			`[
+				{"type": "function", "name": "foo", "inputs": [], "outputs": []},
+				{"type": "function", "name": "Foo", "inputs": [], "outputs": []}
+			]`,
+			nil,
+			"function foo() collides with another generated name, renamed to Foo2",
+		},
+		{
+			`[
+				{"type": "function", "name": "transferEvent", "inputs": [], "outputs": []},
+				{"type": "event", "name": "Transfer", "inputs": []}
+			]`,
+			nil,
+			"event Transfer() collides with another generated name, renamed to Transfer2",
+		},
+		{
+			`[
+				{"type": "function", "name": "fooError", "inputs": [], "outputs": []},
+				{"type": "error", "name": "Foo", "inputs": []}
+			]`,
+			nil,
+			"error Foo() collides with another generated name, renamed to Foo2",
+		},
+		{
+			`[
+				{"type": "function", "name": "foo", "inputs": [], "outputs": [{"name": "", "type": "uint256[]"}]},
+				{"type": "function", "name": "uint256Slice", "inputs": [], "outputs": [{"name": "", "type": "bool"}]}
+			]`,
+			[]Option{Stdlib(true)},
+			"function uint256Slice() collides with another generated name, renamed to Uint256Slice2",
+		},
+	} {
+		gen := NewGenerator(tc.opts...)
+		code, err := gen.GenerateFromABI(mustParseABI(t, tc.abiJSON))
+		if err != nil {
+			t.Fatalf("Failed to generate code: %v", err)
+		}
+		if len(gen.Warnings) != 1 || gen.Warnings[0] != tc.expected {
+			t.Errorf("Expected warning %q, got %v", tc.expected, gen.Warnings)
+		}
+		if len(tc.opts) == 0 {
+			buildGenerated(t, code)
+		}
+	}
+
+	// the names generated once for the package are not renamed
+	abiJSON := `[
+		{"type": "function", "name": "get", "inputs": [{"name": "s", "type": "uint8", "internalType": "enum Selectors"}], "outputs": []}
+	]`
+	abiDef := mustParseABI(t, abiJSON)
+	if err := MarkEnums(abiDef, []byte(abiJSON)); err != nil {
+		t.Fatalf("Failed to mark enums: %v", err)
+	}
+	_, err := NewGenerator(GenerateEnums(true)).GenerateFromABI(abiDef)
+	if err == nil || !strings.Contains(err.Error(), "both generate Selectors") {
+		t.Errorf("Expected error %q, got %v", "both generate Selectors", err)
+	}
+}
@@ -1,5 +1,17 @@
 package generator
 
+import "strings"
+
+// The default suffixes of the generated struct names
+const (
+	DefaultCallSuffix   = "Call"
+	DefaultReturnSuffix = "Return"
+	DefaultEventSuffix  = "Event"
+)
+
+// DefaultDecodeCtxInterval is the default number of elements decoded between the context checks of DecodeCtx
+const DefaultDecodeCtxInterval = 1024
+
 // Options allows to customize the code generation process.
 type Options struct {
 	PackageName  string
@@ -10,14 +22,62 @@ type Options struct {
 	Prefix         string
 	Stdlib         bool
 	UseUint256     bool   // Use holiman/uint256 for uint256 types instead of *big.Int
-	BuildTag       string // Build tag to add to generated file (e.g., "uint256")
+	UniformBigInt  bool   // Use *big.Int for all integer types instead of the native Go types up to 64 bits
+	BuildTag       string // Build constraint expression of the generated files (e.g., "uint256"), see BuildTags
+	LegacyBuildTag bool   // Add the legacy // +build lines after the //go:build line
+	// Generate pointer receivers for all methods instead of value receivers,
+	// avoids copying large structs on each call
+	PointerReceivers bool
+	JSONTags         bool   // Add json tags with the original ABI field names to struct fields
+	JSONNaming       string // Naming convention of the json tags, one of Namings, other than abi implies JSONTags
+	Client           string // Name of the typed client to generate, empty to skip
+	Caller           string // Name of the contract to generate XxxCaller bindings for, empty to skip
+	Interface        bool   // Generate XxxInterface with the expanded signatures of all functions, implemented by XxxCaller
+	GenerateClone    bool   // Generate deep-copy Clone methods for structs
+	DecodeInto       bool   // Generate DecodeInto methods reusing the allocations of the struct
+	// Name anonymous tuples after the enclosing function and position instead of Tuple<hash>
+	NameTuplesByFunction bool
+	TestHelpers          bool   // Generate RandomXxx constructors and the FuzzDecode test, see GenerateFuzzTest
+	Split                bool   // Split the generated code into one file per category, see GenerateFiles
+	Combined             bool   // Input is the solc --combined-json output, generates one file per contract, see GenerateContracts
+	Report               string // Write the calldata size report to this file, "-" for stdout
+	Force                bool   // Regenerate the output even if it has the same input hash
+	EIP712               bool   // Generate the EIP-712 TypeHash and HashStruct methods for tuple structs
+	BinaryMarshaler      bool   // Generate MarshalBinary and UnmarshalBinary methods with the ABI encoding
+	// Names or 4-byte selectors of the methods and events to generate, empty to generate all
+	IncludeMethods []string
+	ExcludeMethods []string // Names or 4-byte selectors of the methods and events to skip
+	ContractTypes  []string // Contract and interface types of the human-readable ABI, mapped to address
+	Enums          bool     // Generate named types for the uint8 enums of the JSON ABI internalType, see MarkEnums
+	Compact        bool     // Encode and decode the slices of tuples with the generic runtime helpers instead of inlined loops
+	ToMap          bool     // Generate ToMap methods returning the fields by their ABI names
+	NilSlices      bool     // Decode the zero-length dynamic arrays to nil instead of empty slices
+	CallSuffix     string   // Suffix of the call struct names, e.g. TransferCall
+	ReturnSuffix   string   // Suffix of the return struct names, e.g. TransferReturn
+	EventSuffix    string   // Suffix of the event struct names, e.g. TransferEvent
+	LenientTopics  bool     // Tolerate extra trailing topics when decoding events, the exact count is required otherwise
+	Layout         bool     // Generate EncodeToDetailed methods returning the byte ranges of the encoded fields
+	BigSetters     bool     // Generate SetXxxFromBig setters for the native integer fields, checking the range
+	StringViews    bool     // Decode strings as views of the input with abi.DecodeStringView, unsafe if the input is reused
+	PackUnpack     bool     // Generate the Pack and Unpack functions dispatching by the function name like go-ethereum's ABI
+	DecodeCtx      bool     // Generate DecodeCtx methods checking the context in the loops over the dynamic arrays
+	// Number of elements of the dynamic arrays decoded between the context checks of DecodeCtx
+	DecodeCtxInterval int
+	// Qualifier of the runtime helpers in the generated code, e.g. "myabi.", "abi." if empty, with StdImport
+	// it points the generated code at a fork of go-abi under another import path
+	StdPrefix string
+	StdImport string // Import path of the runtime package, github.com/yihuang/go-abi if empty
 }
 
 func NewOptions(opts ...Option) *Options {
 	options := &Options{
-		PackageName:    "abi",
-		ExtraImports:   []ImportSpec{},
-		ExternalTuples: make(map[string]string),
+		PackageName:       "abi",
+		ExtraImports:      []ImportSpec{},
+		ExternalTuples:    make(map[string]string),
+		CallSuffix:        DefaultCallSuffix,
+		ReturnSuffix:      DefaultReturnSuffix,
+		EventSuffix:       DefaultEventSuffix,
+		DecodeCtxInterval: DefaultDecodeCtxInterval,
 	}
 	for _, opt := range opts {
 		opt(options)
@@ -63,8 +123,243 @@ func UseUint256(use bool) Option {
 	}
 }
 
+func UniformBigInt(use bool) Option {
+	return func(o *Options) {
+		o.UniformBigInt = use
+	}
+}
+
 func BuildTag(tag string) Option {
 	return func(o *Options) {
 		o.BuildTag = tag
 	}
 }
+
+// BuildTags combines the build constraint expressions with &&, e.g. "uint256" and "!js" to "uint256 && !js"
+func BuildTags(tags ...string) Option {
+	return func(o *Options) {
+		var exprs []string
+		for _, tag := range tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				exprs = append(exprs, tag)
+			}
+		}
+		if len(exprs) > 1 {
+			for i, expr := range exprs {
+				exprs[i] = "(" + expr + ")"
+			}
+		}
+		o.BuildTag = strings.Join(exprs, " && ")
+	}
+}
+
+func LegacyBuildTag(legacy bool) Option {
+	return func(o *Options) {
+		o.LegacyBuildTag = legacy
+	}
+}
+
+func PointerReceivers(use bool) Option {
+	return func(o *Options) {
+		o.PointerReceivers = use
+	}
+}
+
+func JSONTags(use bool) Option {
+	return func(o *Options) {
+		o.JSONTags = use
+	}
+}
+
+func JSONNaming(naming string) Option {
+	return func(o *Options) {
+		o.JSONNaming = naming
+	}
+}
+
+func GenerateClient(name string) Option {
+	return func(o *Options) {
+		o.Client = name
+	}
+}
+
+func GenerateCaller(name string) Option {
+	return func(o *Options) {
+		o.Caller = name
+	}
+}
+
+func NameTuplesByFunction(enable bool) Option {
+	return func(o *Options) {
+		o.NameTuplesByFunction = enable
+	}
+}
+
+func GenerateTestHelpers(enable bool) Option {
+	return func(o *Options) {
+		o.TestHelpers = enable
+	}
+}
+
+func GenerateDecodeInto(decodeInto bool) Option {
+	return func(o *Options) {
+		o.DecodeInto = decodeInto
+	}
+}
+
+func GenerateClone(use bool) Option {
+	return func(o *Options) {
+		o.GenerateClone = use
+	}
+}
+
+func Split(split bool) Option {
+	return func(o *Options) {
+		o.Split = split
+	}
+}
+
+func Combined(combined bool) Option {
+	return func(o *Options) {
+		o.Combined = combined
+	}
+}
+
+func Report(path string) Option {
+	return func(o *Options) {
+		o.Report = path
+	}
+}
+
+func Force(force bool) Option {
+	return func(o *Options) {
+		o.Force = force
+	}
+}
+
+func GenerateEIP712(enable bool) Option {
+	return func(o *Options) {
+		o.EIP712 = enable
+	}
+}
+
+func GenerateBinaryMarshaler(enable bool) Option {
+	return func(o *Options) {
+		o.BinaryMarshaler = enable
+	}
+}
+
+func IncludeMethods(names []string) Option {
+	return func(o *Options) {
+		o.IncludeMethods = names
+	}
+}
+
+func ExcludeMethods(names []string) Option {
+	return func(o *Options) {
+		o.ExcludeMethods = names
+	}
+}
+
+func ContractTypes(names []string) Option {
+	return func(o *Options) {
+		o.ContractTypes = names
+	}
+}
+
+func GenerateEnums(enable bool) Option {
+	return func(o *Options) {
+		o.Enums = enable
+	}
+}
+
+func Compact(compact bool) Option {
+	return func(o *Options) {
+		o.Compact = compact
+	}
+}
+
+func GenerateToMap(enable bool) Option {
+	return func(o *Options) {
+		o.ToMap = enable
+	}
+}
+
+func NilSlices(enable bool) Option {
+	return func(o *Options) {
+		o.NilSlices = enable
+	}
+}
+
+func CallSuffix(suffix string) Option {
+	return func(o *Options) {
+		o.CallSuffix = suffix
+	}
+}
+
+func ReturnSuffix(suffix string) Option {
+	return func(o *Options) {
+		o.ReturnSuffix = suffix
+	}
+}
+
+func EventSuffix(suffix string) Option {
+	return func(o *Options) {
+		o.EventSuffix = suffix
+	}
+}
+
+func LenientTopics(lenient bool) Option {
+	return func(o *Options) {
+		o.LenientTopics = lenient
+	}
+}
+
+func Layout(layout bool) Option {
+	return func(o *Options) {
+		o.Layout = layout
+	}
+}
+
+func GenerateInterface(iface bool) Option {
+	return func(o *Options) {
+		o.Interface = iface
+	}
+}
+
+func BigSetters(setters bool) Option {
+	return func(o *Options) {
+		o.BigSetters = setters
+	}
+}
+
+func StringViews(views bool) Option {
+	return func(o *Options) {
+		o.StringViews = views
+	}
+}
+
+func GeneratePackUnpack(packUnpack bool) Option {
+	return func(o *Options) {
+		o.PackUnpack = packUnpack
+	}
+}
+
+func GenerateDecodeCtx(decodeCtx bool, interval int) Option {
+	return func(o *Options) {
+		o.DecodeCtx = decodeCtx
+		o.DecodeCtxInterval = interval
+	}
+}
+
+func StdPrefix(prefix string) Option {
+	return func(o *Options) {
+		o.StdPrefix = prefix
+	}
+}
+
+func StdImport(path string) Option {
+	return func(o *Options) {
+		o.StdImport = path
+	}
+}
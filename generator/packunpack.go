@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// genPackUnpack generates the Pack and Unpack functions dispatching by the function name to the
+// call and return structs, with the same shape as go-ethereum's ABI.Pack and ABI.Unpack.
+func (g *Generator) genPackUnpack(methods []ethabi.Method) {
+	if g.Options.Stdlib || g.sharedFile || len(methods) == 0 {
+		return
+	}
+
+	g.genPack(methods)
+	g.genUnpack(methods)
+}
+
+// genPack generates the Pack function, the arguments are checked against the Go types of the
+// NewXxxCall parameters, there is no conversion between the types like go-ethereum's reflection.
+func (g *Generator) genPack(methods []ethabi.Method) {
+	name := ToCamel(g.Options.Prefix) + "Pack"
+	g.L("")
+	g.L("// %s encodes the calldata of the function by name with the selector, like go-ethereum's ABI.Pack,", name)
+	g.L("// the overloaded functions are named like go-ethereum, e.g. transfer0. The arguments must have")
+	g.L("// the exact Go types of the call struct fields, otherwise %sErrArgumentTypeMismatch is returned.", g.StdPrefix)
+	g.L("func %s(name string, args ...interface{}) ([]byte, error) {", name)
+	g.L("\tswitch name {")
+	for _, method := range methods {
+		s := StructFromArguments(g.callName(method), method.Inputs)
+		g.L("\tcase %q:", method.Name)
+		g.L("\t\tif len(args) != %d {", len(s.Fields))
+		g.L("\t\t\treturn nil, %sArgumentCountMismatch(%d, len(args))", g.StdPrefix, len(s.Fields))
+		g.L("\t\t}")
+		vars := make([]string, len(s.Fields))
+		for i, f := range s.Fields {
+			goType := g.abiTypeToGoType(*f.Type)
+			vars[i] = fmt.Sprintf("a%d", i)
+			g.L("\t\t%s, ok := args[%d].(%s)", vars[i], i, goType)
+			g.L("\t\tif !ok {")
+			g.L("\t\t\treturn nil, %sArgumentTypeMismatch(%d, %q, args[%d])", g.StdPrefix, i, goType, i)
+			g.L("\t\t}")
+		}
+		g.L("\t\treturn New%s(%s).EncodeWithSelector()", g.callName(method), strings.Join(vars, ", "))
+	}
+	g.L("\tdefault:")
+	g.L("\t\treturn nil, %sUnknownMethod(name)", g.StdPrefix)
+	g.L("\t}")
+	g.L("}")
+}
+
+// genUnpack generates the Unpack function returning the decoded return values in order
+func (g *Generator) genUnpack(methods []ethabi.Method) {
+	name := ToCamel(g.Options.Prefix) + "Unpack"
+	g.L("")
+	g.L("// %s decodes the return values of the function by name, like go-ethereum's ABI.Unpack,", name)
+	g.L("// the values have the Go types of the return struct fields.")
+	g.L("func %s(name string, data []byte) ([]interface{}, error) {", name)
+	g.L("\tswitch name {")
+	for _, method := range methods {
+		g.L("\tcase %q:", method.Name)
+		if len(method.Outputs) == 0 {
+			g.L("\t\treturn []interface{}{}, nil")
+			continue
+		}
+		s := StructFromArguments(g.returnName(method), method.Outputs)
+		values := make([]string, len(s.Fields))
+		for i, f := range s.Fields {
+			values[i] = "ret." + f.Name
+		}
+		g.L("\t\tvar ret %s", s.Name)
+		g.L("\t\tif _, err := ret.Decode(data); err != nil {")
+		g.L("\t\t\treturn nil, err")
+		g.L("\t\t}")
+		g.L("\t\treturn []interface{}{%s}, nil", strings.Join(values, ", "))
+	}
+	g.L("\tdefault:")
+	g.L("\t\treturn nil, %sUnknownMethod(name)", g.StdPrefix)
+	g.L("\t}")
+	g.L("}")
+}
@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// FieldReport describes the calldata layout of a function argument
+type FieldReport struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Size in the static head, dynamic fields contribute the 32 bytes offset
+	StaticSize int  `json:"staticSize"`
+	Dynamic    bool `json:"dynamic"`
+}
+
+// FunctionReport describes the calldata layout of a function
+type FunctionReport struct {
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+	Selector  string `json:"selector"`
+	// Size of the static head, excluding the selector
+	StaticSize int `json:"staticSize"`
+	// Size of the packed encoding, -1 if the arguments can't be packed
+	PackedSize int           `json:"packedSize"`
+	Fields     []FieldReport `json:"fields"`
+}
+
+// NewReport builds the calldata size report of all functions in the ABI
+func NewReport(abiDef ethabi.ABI) []FunctionReport {
+	reports := make([]FunctionReport, 0, len(abiDef.Methods))
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		s := StructFromArguments(name, method.Inputs)
+
+		fields := make([]FieldReport, 0, len(s.Fields))
+		for i, f := range s.Fields {
+			fields = append(fields, FieldReport{
+				Name:       method.Inputs[i].Name,
+				Type:       f.Type.String(),
+				StaticSize: GetTypeSize(*f.Type),
+				Dynamic:    IsDynamicType(*f.Type),
+			})
+		}
+
+		reports = append(reports, FunctionReport{
+			Name:       name,
+			Signature:  method.Sig,
+			Selector:   "0x" + hex.EncodeToString(method.ID),
+			StaticSize: GetTupleSize(s.Types()),
+			PackedSize: GetPackedTupleSize(s.Types()),
+			Fields:     fields,
+		})
+	}
+	return reports
+}
+
+// WriteReportJSON writes the report as JSON
+func WriteReportJSON(w io.Writer, reports []FunctionReport) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(reports)
+}
+
+// WriteReportMarkdown writes the report as markdown tables
+func WriteReportMarkdown(w io.Writer, reports []FunctionReport) error {
+	var b strings.Builder
+	b.WriteString("# Calldata Size Report\n")
+	for _, r := range reports {
+		fmt.Fprintf(&b, "\n## %s\n\n", r.Name)
+		fmt.Fprintf(&b, "`%s` selector `%s`, static size %d bytes", r.Signature, r.Selector, r.StaticSize)
+		if r.PackedSize >= 0 {
+			fmt.Fprintf(&b, ", packed size %d bytes", r.PackedSize)
+		}
+		b.WriteString("\n")
+
+		if len(r.Fields) == 0 {
+			continue
+		}
+		b.WriteString("\n| Field | Type | Static Size | Dynamic |\n")
+		b.WriteString("|-------|------|-------------|---------|\n")
+		for _, f := range r.Fields {
+			dynamic := "no"
+			if f.Dynamic {
+				dynamic = "yes"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %d | %s |\n", f.Name, f.Type, f.StaticSize, dynamic)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteReportFile writes the report to path, as JSON if it has .json extension,
+// otherwise as markdown, "-" writes markdown to stdout.
+func WriteReportFile(path string, reports []FunctionReport) error {
+	if path == "-" {
+		return WriteReportMarkdown(os.Stdout, reports)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if strings.HasSuffix(path, ".json") {
+		err = WriteReportJSON(f, reports)
+	} else {
+		err = WriteReportMarkdown(f, reports)
+	}
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestReport(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "transfer",
+			"inputs": [
+				{"name": "to", "type": "address"},
+				{"name": "amount", "type": "uint256"}
+			],
+			"outputs": [{"name": "", "type": "bool"}]
+		},
+		{
+			"type": "function",
+			"name": "setMessage",
+			"inputs": [
+				{"name": "id", "type": "uint64"},
+				{"name": "message", "type": "string"}
+			],
+			"outputs": []
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	expected := []FunctionReport{
+		{
+			Name:       "setMessage",
+			Signature:  "setMessage(uint64,string)",
+			Selector:   "0x" + hexSelector(abiDef, "setMessage"),
+			StaticSize: 64,
+			PackedSize: -1,
+			Fields: []FieldReport{
+				{Name: "id", Type: "uint64", StaticSize: 32},
+				{Name: "message", Type: "string", StaticSize: 32, Dynamic: true},
+			},
+		},
+		{
+			Name:       "transfer",
+			Signature:  "transfer(address,uint256)",
+			Selector:   "0xa9059cbb",
+			StaticSize: 64,
+			PackedSize: 52,
+			Fields: []FieldReport{
+				{Name: "to", Type: "address", StaticSize: 32},
+				{Name: "amount", Type: "uint256", StaticSize: 32},
+			},
+		},
+	}
+
+	reports := NewReport(abiDef)
+	if !reflect.DeepEqual(expected, reports) {
+		t.Fatalf("Unexpected report:\n%+v\nexpected:\n%+v", reports, expected)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteReportJSON(&buf, reports); err != nil {
+		t.Fatalf("Failed to write json report: %v", err)
+	}
+	var decoded []FunctionReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Failed to decode json report: %v", err)
+	}
+	if !reflect.DeepEqual(reports, decoded) {
+		t.Errorf("JSON report doesn't roundtrip:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteReportMarkdown(&buf, reports); err != nil {
+		t.Fatalf("Failed to write markdown report: %v", err)
+	}
+	for _, s := range []string{
+		"## transfer",
+		"`transfer(address,uint256)` selector `0xa9059cbb`, static size 64 bytes, packed size 52 bytes",
+		"| to | address | 32 | no |",
+		"| message | string | 32 | yes |",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("Expected markdown report to contain %q:\n%s", s, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "setMessage(uint64,string)` selector `0x"+hexSelector(abiDef, "setMessage")+"`, static size 64 bytes, packed") {
+		t.Error("Expected no packed size for dynamic arguments")
+	}
+}
+
+func hexSelector(abiDef abi.ABI, name string) string {
+	return hex.EncodeToString(abiDef.Methods[name].ID)
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"golang.org/x/tools/imports"
+)
+
+// File categories of the generated code when splitting files
+const (
+	SectionTypes   = "types"   // tuple structs and the standalone encoding/decoding functions
+	SectionCalls   = "calls"   // selectors, call structs and error selectors
+	SectionReturns = "returns" // return structs
+	SectionEvents  = "events"  // event topics and event structs
+)
+
+var sectionOrder = []string{SectionTypes, SectionCalls, SectionReturns, SectionEvents}
+
+// GenerateFiles generates Go code from ABI JSON split into one file per category,
+// returns the formatted content keyed by file name "<prefix>_<category>.abi.go",
+// the prefix defaults to the package name. Categories without content are omitted.
+func (g *Generator) GenerateFiles(abiDef ethabi.ABI) (map[string]string, error) {
+	g.sections = make(map[string]*bytes.Buffer)
+	defer func() {
+		g.sections = nil
+		g.out = nil
+	}()
+
+	g.genBody(abiDef)
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	prefix := g.Options.Prefix
+	if prefix == "" {
+		prefix = g.Options.PackageName
+	}
+
+	files := make(map[string]string, len(g.sections))
+	for _, name := range sectionOrder {
+		body, ok := g.sections[name]
+		if !ok || body.Len() == 0 {
+			continue
+		}
+
+		var buf bytes.Buffer
+		g.out = &buf
+		g.genHeader()
+		buf.Write(body.Bytes())
+
+		fileName := fmt.Sprintf("%s_%s.abi.go", prefix, name)
+		formatted, err := imports.Process(fileName, buf.Bytes(), &imports.Options{Comments: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", fileName, formatError(buf.Bytes(), err))
+		}
+		files[fileName] = string(formatted)
+	}
+
+	if fuzzTest := g.GenerateFuzzTest(); fuzzTest != "" {
+		fileName := fmt.Sprintf("%s_fuzz_test.go", prefix)
+		formatted, err := imports.Process(fileName, []byte(fuzzTest), &imports.Options{Comments: true})
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", fileName, formatError([]byte(fuzzTest), err))
+		}
+		files[fileName] = string(formatted)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,168 @@
+package generator
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/yihuang/go-abi"
+	"golang.org/x/tools/imports"
+)
+
+var splitTestABI = []string{
+	"struct Coin { string denom; uint256 amount }",
+	"function send(address to, Coin[] amount) returns (bool)",
+	"function balances(address owner) view returns (Coin[] balances, uint64 height)",
+	"function pause()",
+	"event Sent(address indexed from, Coin[] amount)",
+	"error InsufficientFunds(Coin[] required)",
+}
+
+// topLevelDecls returns the names of the top level declarations in the source
+func topLevelDecls(t *testing.T, src string) []string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse generated code: %v", err)
+	}
+
+	var names []string
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil {
+				name = recvTypeName(d.Recv.List[0].Type) + "." + name
+			}
+			names = append(names, name)
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, s.Name.Name)
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						if n.Name != "_" {
+							names = append(names, n.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// recvTypeName returns the type name of a method receiver
+func recvTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + recvTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+	return ""
+}
+
+func TestGenerateFiles(t *testing.T) {
+	abiJSON, err := abi.ParseHumanReadableABI(splitTestABI)
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	abiDef, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	code, err := NewGenerator(PackageName("split")).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	formatted, err := imports.Process("split.abi.go", []byte(code), &imports.Options{Comments: true})
+	if err != nil {
+		t.Fatalf("Failed to format generated code: %v", err)
+	}
+	expected := topLevelDecls(t, string(formatted))
+
+	files, err := NewGenerator(PackageName("split")).GenerateFiles(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate files: %v", err)
+	}
+
+	expectedFiles := []string{"split_calls.abi.go", "split_events.abi.go", "split_returns.abi.go", "split_types.abi.go"}
+	if names := SortedMapKeys(files); !slices.Equal(expectedFiles, names) {
+		t.Fatalf("Expected files %v, got %v", expectedFiles, names)
+	}
+
+	var actual []string
+	for _, name := range expectedFiles {
+		actual = append(actual, topLevelDecls(t, files[name])...)
+	}
+
+	slices.Sort(expected)
+	slices.Sort(actual)
+	if !slices.Equal(expected, actual) {
+		t.Errorf("Split files declare %v, expected %v", actual, expected)
+	}
+	if len(slices.Compact(slices.Clone(actual))) != len(actual) {
+		t.Errorf("Split files have duplicated declarations: %v", actual)
+	}
+
+	// the programmatic API can be called again with the same result
+	again, err := NewGenerator(PackageName("split")).GenerateFiles(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate files: %v", err)
+	}
+	for name, content := range files {
+		if again[name] != content {
+			t.Errorf("Generated %s is not deterministic", name)
+		}
+	}
+}
+
+func TestBuildTagsHeader(t *testing.T) {
+	abiJSON, err := abi.ParseHumanReadableABI(splitTestABI)
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+	abiDef, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	opts := []Option{PackageName("split"), BuildTags("uint256", "!js || wasm"), LegacyBuildTag(true), GenerateTestHelpers(true)}
+	header := "//go:build uint256 && (!js || wasm)\n// +build uint256\n// +build !js wasm\n\n// Code generated by go-abi. DO NOT EDIT."
+
+	gen := NewGenerator(opts...)
+	code, err := gen.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	generated := map[string]string{"split.abi.go": code, "split.abi_fuzz_test.go": gen.GenerateFuzzTest()}
+
+	files, err := NewGenerator(append(opts, Split(true))...).GenerateFiles(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate files: %v", err)
+	}
+	for name, src := range files {
+		generated[name] = src
+	}
+
+	for name, src := range generated {
+		if !strings.HasPrefix(src, header) {
+			t.Errorf("Expected %s to start with the build constraints, got:\n%s", name, src[:min(len(src), 200)])
+		}
+	}
+
+	for _, tag := range []string{"uint256 &&", "(uint256", "uint256 !js"} {
+		_, err := NewGenerator(BuildTag(tag)).GenerateFromABI(abiDef)
+		if err == nil || !strings.Contains(err.Error(), "invalid build tag") {
+			t.Errorf("Expected invalid build tag error for %q, got %v", tag, err)
+		}
+	}
+}
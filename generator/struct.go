@@ -4,29 +4,33 @@ import (
 	"fmt"
 
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/yihuang/go-abi"
 )
 
 type StructField struct {
 	Type *ethabi.Type
 	Name string
+
+	// The original field name in ABI, used for JSON tags
+	RawName string
 }
 
 func StructFieldFromArgument(arg ethabi.Argument) StructField {
 	return StructField{
-		Type: &arg.Type,
-		Name: GoFieldName(arg.Name),
+		Type:    &arg.Type,
+		Name:    GoFieldName(arg.Name),
+		RawName: arg.Name,
 	}
 }
 
 func StructFieldFromTupleElement(t ethabi.Type, index int) StructField {
 	fieldName := t.TupleRawNames[index]
 	if fieldName == "" {
-		fieldName = fmt.Sprintf("Field%d", index+1)
+		fieldName = fmt.Sprintf("field%d", index+1)
 	}
 	return StructField{
-		Type: t.TupleElems[index],
-		Name: GoFieldName(fieldName),
+		Type:    t.TupleElems[index],
+		Name:    GoFieldName(fieldName),
+		RawName: fieldName,
 	}
 }
 
@@ -36,6 +40,12 @@ type Struct struct {
 
 	// The tuple type
 	T ethabi.Type
+
+	// Number of trailing zero bytes tolerated by DecodeStrict
+	TrailingPadding int
+
+	// Prefix naming the unnamed fields in the json tags, e.g. arg or result
+	UnnamedPrefix string
 }
 
 func StructFromArguments(name string, args []ethabi.Argument) Struct {
@@ -52,9 +62,10 @@ func StructFromArguments(name string, args []ethabi.Argument) Struct {
 		names = append(names, field.Name)
 	}
 	return Struct{
-		Name:   name,
-		Fields: fields,
-		T:      ethabi.Type{T: ethabi.TupleTy, TupleElems: types, TupleRawNames: names, TupleRawName: name},
+		Name:          name,
+		Fields:        fields,
+		T:             ethabi.Type{T: ethabi.TupleTy, TupleElems: types, TupleRawNames: names, TupleRawName: name},
+		UnnamedPrefix: "arg",
 	}
 }
 
@@ -64,14 +75,15 @@ func StructFromTuple(t ethabi.Type) Struct {
 		fields = append(fields, StructFieldFromTupleElement(t, i))
 	}
 	return Struct{
-		Name:   abi.TupleStructName(t),
+		Name:   TupleStructName(t),
 		Fields: fields,
 		T:      t,
 	}
 }
 
+// StructFromEventData returns the struct of the non-indexed event arguments, named with the default event suffix
 func StructFromEventData(event ethabi.Event) Struct {
-	name := fmt.Sprintf("%sEventData", event.Name)
+	name := event.Name + DefaultEventSuffix + "Data"
 	arguments := make([]ethabi.Argument, 0)
 	for _, input := range event.Inputs {
 		if input.Indexed {
@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// genRandomFunc generates the RandomXxx function which fills the struct with valid random values,
+// slices are at most maxLen long and don't nest deeper than maxDepth.
+func (g *Generator) genRandomFunc(s Struct) {
+	g.randomStructs = append(g.randomStructs, s.Name)
+
+	g.L("")
+	g.L("// Random%s returns a %s filled with random values, for property based tests", s.Name, s.Name)
+	g.L("func Random%s(r *rand.Rand, maxDepth, maxLen int) %s {", s.Name, s.Name)
+	g.L("\tvar t %s", s.Name)
+	for _, f := range s.Fields {
+		g.genRandomValue(*f.Type, "t."+f.Name, 0, 0)
+	}
+	g.L("\treturn t")
+	g.L("}")
+}
+
+// genRandomValue generates code to assign a random value of type t to ref,
+// depth is the number of enclosing slices, level names the loop variables.
+func (g *Generator) genRandomValue(t ethabi.Type, ref string, level, depth int) {
+	maxDepth := "maxDepth"
+	if depth > 0 {
+		maxDepth = fmt.Sprintf("maxDepth-%d", depth)
+	}
+
+	switch t.T {
+	case ethabi.UintTy:
+		switch {
+		case !g.isBigIntType(t):
+			g.L("\t%s = %s(r.Uint64() >> %d)", ref, g.abiTypeToGoType(t), 64-t.Size)
+		case g.Options.UseUint256 && isUint256Type(t):
+			g.L("\t%s = %sRandomUint256(r, %d)", ref, g.StdPrefix, t.Size)
+		default:
+			g.L("\t%s = %sRandomBigInt(r, %d, false)", ref, g.StdPrefix, t.Size)
+		}
+	case ethabi.IntTy:
+		if !g.isBigIntType(t) {
+			g.L("\t%s = %s(int64(r.Uint64()) >> %d)", ref, g.abiTypeToGoType(t), 64-t.Size)
+		} else {
+			g.L("\t%s = %sRandomBigInt(r, %d, true)", ref, g.StdPrefix, t.Size)
+		}
+	case ethabi.BoolTy:
+		g.L("\t%s = r.Intn(2) == 1", ref)
+	case ethabi.AddressTy, ethabi.FixedBytesTy:
+		g.L("\tr.Read(%s[:])", ref)
+	case ethabi.StringTy:
+		g.L("\t%s = %sRandomString(r, maxLen)", ref, g.StdPrefix)
+	case ethabi.BytesTy:
+		g.L("\t%s = %sRandomBytes(r, maxLen)", ref, g.StdPrefix)
+	case ethabi.TupleTy:
+		g.L("\t%s = %s(r, maxDepth-%d, maxLen)", ref, g.randomFuncName(t), depth+1)
+	case ethabi.SliceTy:
+		idx := fmt.Sprintf("i%d", level)
+		g.L("\t%s = make(%s, %sRandomLen(r, %s, maxLen))", ref, g.abiTypeToGoType(t), g.StdPrefix, maxDepth)
+		g.L("\tfor %s := range %s {", idx, ref)
+		g.genRandomValue(*t.Elem, fmt.Sprintf("%s[%s]", ref, idx), level+1, depth+1)
+		g.L("\t}")
+	case ethabi.ArrayTy:
+		idx := fmt.Sprintf("i%d", level)
+		g.L("\tfor %s := range %s {", idx, ref)
+		g.genRandomValue(*t.Elem, fmt.Sprintf("%s[%s]", ref, idx), level+1, depth)
+		g.L("\t}")
+	default:
+		g.errorf("unsupported ABI type: %s", t.String())
+	}
+}
+
+// randomFuncName returns the name of the RandomXxx function of the tuple type,
+// external tuples are expected to provide it next to the type, e.g. types.RandomCoin for types.Coin.
+func (g *Generator) randomFuncName(t ethabi.Type) string {
+	goType := g.abiTypeToGoType(t)
+	if pkg, name, ok := strings.Cut(goType, "."); ok {
+		return pkg + ".Random" + name
+	}
+	return "Random" + goType
+}
+
+// GenerateFuzzTest generates the FuzzDecode<Prefix> test of the structs generated by the last
+// GenerateFromABI or GenerateFiles call with the test helpers enabled, returns empty string if there are none.
+func (g *Generator) GenerateFuzzTest() string {
+	if len(g.randomStructs) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	out, imports := g.out, g.Imports
+	g.out = &buf
+	g.Imports = append(slices.Clone(imports), ImportSpec{Path: "testing"})
+	defer func() {
+		g.out, g.Imports = out, imports
+	}()
+
+	g.genHeader()
+
+	name := "FuzzDecode" + Title.String(g.Options.Prefix)
+	g.L("// %s decodes arbitrary data into the generated structs, seeded with random values,", name)
+	g.L("// the decoded values must survive the encoding round trip.")
+	g.L("func %s(f *testing.F) {", name)
+	g.L("\tseed := func(kind uint16, v %sTuple) {", g.StdPrefix)
+	g.L("\t\tdata, err := v.Encode()")
+	g.L("\t\tif err != nil {")
+	g.L("\t\t\tf.Fatal(err)")
+	g.L("\t\t}")
+	g.L("\t\tf.Add(kind, data)")
+	g.L("\t}")
+	g.L("")
+	g.L("\tr := rand.New(rand.NewSource(0))")
+	g.L("\tfor i := 0; i < 4; i++ {")
+	for i, s := range g.randomStructs {
+		g.L("\t\t{")
+		g.L("\t\t\tv := Random%s(r, 3, 4)", s)
+		g.L("\t\t\tseed(%d, &v)", i)
+		g.L("\t\t}")
+	}
+	g.L("\t}")
+	g.L("")
+	g.L("\tf.Fuzz(func(t *testing.T, kind uint16, data []byte) {")
+	g.L("\t\tvar v %sTuple", g.StdPrefix)
+	g.L("\t\tswitch kind %% %d {", len(g.randomStructs))
+	for i, s := range g.randomStructs {
+		g.L("\t\tcase %d:", i)
+		g.L("\t\t\tv = new(%s)", s)
+	}
+	g.L("\t\t}")
+	g.L("\t\tif err := %sCheckRoundTrip(v, data); err != nil {", g.StdPrefix)
+	g.L("\t\t\tt.Fatal(err)")
+	g.L("\t\t}")
+	g.L("\t})")
+	g.L("}")
+
+	return buf.String()
+}
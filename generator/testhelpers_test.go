@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestTestHelpers(t *testing.T) {
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "submit",
+			"inputs": [
+				{"name": "amounts", "type": "uint24[][]"},
+				{"name": "value", "type": "int128"},
+				{"name": "coin", "type": "tuple", "internalType": "struct Coin", "components": [
+					{"name": "denom", "type": "string"}
+				]}
+			],
+			"outputs": []
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	gen := NewGenerator()
+	code, err := gen.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if strings.Contains(code, "func Random") || gen.GenerateFuzzTest() != "" {
+		t.Error("Expected no test helpers without TestHelpers option")
+	}
+
+	gen = NewGenerator(GenerateTestHelpers(true), ExternalTuples(map[string]string{"Coin": "github.com/org/shared/types.Coin"}), Prefix("token"))
+	code, err = gen.GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+
+	for _, expected := range []string{
+		"func RandomSubmitCall(r *rand.Rand, maxDepth, maxLen int) SubmitCall {",
+		"t.Amounts = make([][]uint32, abi.RandomLen(r, maxDepth, maxLen))",
+		"t.Amounts[i0] = make([]uint32, abi.RandomLen(r, maxDepth-1, maxLen))",
+		"t.Amounts[i0][i1] = uint32(r.Uint64() >> 40)",
+		"t.Value = abi.RandomBigInt(r, 128, true)",
+		"t.Coin = types.RandomCoin(r, maxDepth-1, maxLen)",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected generated code to contain %q", expected)
+		}
+	}
+
+	fuzzTest := gen.GenerateFuzzTest()
+	for _, expected := range []string{
+		"func FuzzDecodeToken(f *testing.F) {",
+		"v := RandomSubmitCall(r, 3, 4)",
+		"v = new(SubmitCall)",
+	} {
+		if !strings.Contains(fuzzTest, expected) {
+			t.Errorf("Expected fuzz test to contain %q", expected)
+		}
+	}
+}
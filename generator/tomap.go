@@ -0,0 +1,117 @@
+package generator
+
+import (
+	"fmt"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// toMapConverts returns if the value of the type is converted in the ToMap result,
+// addresses and bytes are rendered as hex strings, tuples as nested maps.
+func (g *Generator) toMapConverts(t ethabi.Type) bool {
+	switch t.T {
+	case ethabi.AddressTy, ethabi.FixedBytesTy, ethabi.BytesTy:
+		return true
+	case ethabi.TupleTy:
+		// external tuples may not have the ToMap method, they are kept as is
+		_, external := g.Options.ExternalTuples[TupleStructName(t)]
+		return !external
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		return g.toMapConverts(*t.Elem)
+	default:
+		return false
+	}
+}
+
+// toMapExpr returns the expression of the ToMap value of the non-array value src
+func (g *Generator) toMapExpr(t ethabi.Type, src string) string {
+	if !g.toMapConverts(t) {
+		return src
+	}
+	switch t.T {
+	case ethabi.AddressTy:
+		return src + ".Hex()"
+	case ethabi.FixedBytesTy:
+		return fmt.Sprintf("\"0x\" + hex.EncodeToString(%s[:])", src)
+	case ethabi.BytesTy:
+		return fmt.Sprintf("\"0x\" + hex.EncodeToString(%s)", src)
+	default:
+		return src + ".ToMap()"
+	}
+}
+
+// genToMapValue generates code to assign the ToMap value of src to dst, the slices and arrays with
+// converted elements become []interface{}, level names the loop variables of the nested arrays.
+func (g *Generator) genToMapValue(t ethabi.Type, dst, src string, level int) {
+	if (t.T != ethabi.SliceTy && t.T != ethabi.ArrayTy) || !g.toMapConverts(t) {
+		g.L("\t%s = %s", dst, g.toMapExpr(t, src))
+		return
+	}
+
+	idx, elems := fmt.Sprintf("i%d", level), fmt.Sprintf("elems%d", level)
+	g.L("\t{")
+	g.L("\t\t%s := make([]interface{}, len(%s))", elems, src)
+	g.L("\t\tfor %s := range %s {", idx, src)
+	g.genToMapValue(*t.Elem, fmt.Sprintf("%s[%s]", elems, idx), fmt.Sprintf("%s[%s]", src, idx), level+1)
+	g.L("\t\t}")
+	g.L("\t\t%s = %s", dst, elems)
+	g.L("\t}")
+}
+
+// toMapKey returns the key of the field in the ToMap result, the original ABI name if any
+func toMapKey(f StructField, i int) string {
+	if f.RawName == "" {
+		return fmt.Sprintf("field%d", i+1)
+	}
+	return f.RawName
+}
+
+// genStructToMap generates the ToMap method returning the fields by their ABI names
+func (g *Generator) genStructToMap(s Struct) {
+	g.L("")
+	g.L("// ToMap returns the fields of %s by their ABI names, for inspection without reflection", s.Name)
+	g.L("func (t %s) ToMap() map[string]interface{} {", g.recv(s.Name))
+	g.L("\tm := make(map[string]interface{}, %d)", len(s.Fields))
+	for i, f := range s.Fields {
+		g.genToMapValue(*f.Type, fmt.Sprintf("m[%q]", toMapKey(f, i)), "t."+f.Name, 0)
+	}
+	g.L("\treturn m")
+	g.L("}")
+}
+
+// genEmptyToMap generates the ToMap method of the struct without fields
+func (g *Generator) genEmptyToMap(name string) {
+	g.L("")
+	g.L("// ToMap returns the fields of %s, which has none", name)
+	g.L("func (t %s) ToMap() map[string]interface{} {", g.recv(name))
+	g.L("\treturn map[string]interface{}{}")
+	g.L("}")
+}
+
+// genEventToMap generates the ToMap method of the top level event struct, merging the indexed fields
+// into the fields of the data, the hash topics are rendered as hex strings.
+func (g *Generator) genEventToMap(event ethabi.Event) {
+	name := g.eventName(event)
+
+	g.L("")
+	g.L("// ToMap returns the indexed and data fields of %s by their ABI names, for inspection without reflection", name)
+	g.L("func (e %s) ToMap() map[string]interface{} {", g.recv(name))
+	if len(event.Inputs.NonIndexed()) > 0 {
+		g.L("\tm := e.%sData.ToMap()", name)
+	} else {
+		g.L("\tm := make(map[string]interface{}, %d)", len(event.Inputs))
+	}
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			continue
+		}
+		fieldName := GoFieldName(input.Name)
+		if isHashTopic(input.Type) {
+			g.L("\tm[%q] = e.%s.Hex()", input.Name, fieldName)
+			continue
+		}
+		g.genToMapValue(input.Type, fmt.Sprintf("m[%q]", input.Name), "e."+fieldName, 0)
+	}
+	g.L("\treturn m")
+	g.L("}")
+}
@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"fmt"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// tupleNamer names anonymous tuples after the position they first appear at
+type tupleNamer struct {
+	// tuple identifier to the assigned name, so tuples of the same shape share a struct
+	names map[string]string
+}
+
+// nameTuplesByFunction returns a copy of abiDef where the anonymous tuples of the functions and events
+// are named after the enclosing function and argument position, e.g. GetPairReturn0 for the first output
+// of getPair, instead of the Tuple<hash> fallback. Tuples of the same shape share the first name.
+func nameTuplesByFunction(abiDef ethabi.ABI) ethabi.ABI {
+	n := &tupleNamer{names: make(map[string]string)}
+
+	methods := make(map[string]ethabi.Method, len(abiDef.Methods))
+	for _, name := range SortedMapKeys(abiDef.Methods) {
+		method := abiDef.Methods[name]
+		method.Inputs = n.arguments(method.Inputs, Title.String(method.Name)+"Param")
+		method.Outputs = n.arguments(method.Outputs, Title.String(method.Name)+"Return")
+		methods[name] = method
+	}
+	abiDef.Methods = methods
+
+	events := make(map[string]ethabi.Event, len(abiDef.Events))
+	for _, name := range SortedMapKeys(abiDef.Events) {
+		event := abiDef.Events[name]
+		event.Inputs = n.arguments(event.Inputs, event.Name+"Param")
+		events[name] = event
+	}
+	abiDef.Events = events
+
+	return abiDef
+}
+
+func (n *tupleNamer) arguments(args ethabi.Arguments, prefix string) ethabi.Arguments {
+	result := make(ethabi.Arguments, len(args))
+	for i, arg := range args {
+		arg.Type = n.name(arg.Type, fmt.Sprintf("%s%d", prefix, i))
+		result[i] = arg
+	}
+	return result
+}
+
+// name returns a copy of t with the anonymous tuples named, name is used if t itself is an anonymous tuple
+func (n *tupleNamer) name(t ethabi.Type, name string) ethabi.Type {
+	switch t.T {
+	case ethabi.SliceTy, ethabi.ArrayTy:
+		elem := n.name(*t.Elem, name)
+		t.Elem = &elem
+	case ethabi.TupleTy:
+		if t.TupleRawName == "" {
+			id := GenTupleIdentifier(t)
+			if _, ok := n.names[id]; !ok {
+				n.names[id] = name
+			}
+			t.TupleRawName = n.names[id]
+		}
+
+		elems := make([]*ethabi.Type, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fieldName := GoFieldName(t.TupleRawNames[i])
+			if fieldName == "" {
+				fieldName = fmt.Sprintf("Field%d", i+1)
+			}
+			named := n.name(*elem, t.TupleRawName+fieldName)
+			elems[i] = &named
+		}
+		t.TupleElems = elems
+	}
+	return t
+}
@@ -0,0 +1,93 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestNameTuplesByFunction(t *testing.T) {
+	pair := `{
+		"name": "",
+		"type": "tuple",
+		"components": [
+			{"name": "a", "type": "uint256"},
+			{"name": "b", "type": "uint256"}
+		]
+	}`
+	abiJSON := `[
+		{
+			"type": "function",
+			"name": "getSimplePair",
+			"inputs": [],
+			"outputs": [` + pair + `]
+		},
+		{
+			"type": "function",
+			"name": "setPair",
+			"inputs": [` + pair + `],
+			"outputs": []
+		},
+		{
+			"type": "function",
+			"name": "getNested",
+			"inputs": [],
+			"outputs": [{
+				"name": "",
+				"type": "tuple[]",
+				"components": [
+					{"name": "flag", "type": "bool"},
+					{"name": "inner", "type": "tuple", "components": [{"name": "x", "type": "address"}]}
+				]
+			}]
+		}
+	]`
+
+	abiDef, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse ABI: %v", err)
+	}
+
+	code, err := NewGenerator().GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+	if strings.Contains(code, "GetSimplePairReturn0") {
+		t.Error("Expected hash based tuple names without NameTuplesByFunction option")
+	}
+
+	code, err = NewGenerator(NameTuplesByFunction(true)).GenerateFromABI(abiDef)
+	if err != nil {
+		t.Fatalf("Failed to generate code: %v", err)
+	}
+
+	for _, expected := range []string{
+		"type GetSimplePairReturn0 struct",
+		"type GetNestedReturn0 struct",
+		"type GetNestedReturn0Inner struct",
+		"Field1 []GetNestedReturn0",
+		"Inner GetNestedReturn0Inner",
+		"Field1 GetSimplePairReturn0",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Expected generated code to contain %q", expected)
+		}
+	}
+
+	// structurally identical tuples share one struct
+	if strings.Contains(code, "SetPairParam0") {
+		t.Error("Expected setPair input to reuse GetSimplePairReturn0")
+	}
+	if strings.Count(code, "type GetSimplePairReturn0 struct") != 1 {
+		t.Error("Expected a single GetSimplePairReturn0 struct")
+	}
+	if strings.Contains(code, "type Tuple") {
+		t.Error("Expected no hash based tuple names")
+	}
+
+	// the input ABI is left untouched
+	if abiDef.Methods["getSimplePair"].Outputs[0].Type.TupleRawName != "" {
+		t.Error("Expected the input ABI not to be mutated")
+	}
+}
@@ -2,8 +2,13 @@ package generator
 
 import (
 	"cmp"
+	"fmt"
+	"go/token"
+	"path"
+	"regexp"
 	"slices"
 	"strings"
+	"unicode"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -21,11 +26,86 @@ func ToCamel(s string) string {
 	return strings.Join(parts, "")
 }
 
+// ToArgName converts the Go field name to the argument name, Go keywords are suffixed with underscore
 func ToArgName(s string) string {
 	if s == "" {
 		return s
 	}
-	return strings.ToLower(s[:1]) + s[1:]
+	s = strings.ToLower(s[:1]) + s[1:]
+	if token.IsKeyword(s) {
+		s += "_"
+	}
+	return s
+}
+
+// Naming conventions of the json tags, see ConvertCase
+const (
+	NamingABI    = "abi"    // verbatim ABI name
+	NamingCamel  = "camel"  // tokenIn
+	NamingSnake  = "snake"  // token_in
+	NamingPascal = "pascal" // TokenIn
+)
+
+// Namings lists the supported naming conventions
+var Namings = []string{NamingABI, NamingCamel, NamingSnake, NamingPascal}
+
+// SplitWords splits an identifier into lower case words at underscores and case changes,
+// digits stay with the preceding word and consecutive capitals form one word,
+// the leading underscores are returned separately, e.g. "_newURI" -> "_", ["new", "uri"].
+func SplitWords(name string) (string, []string) {
+	trimmed := strings.TrimLeft(name, "_")
+	lead := name[:len(name)-len(trimmed)]
+
+	var (
+		words []string
+		word  []rune
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	runes := []rune(trimmed)
+	for i, r := range runes {
+		if r == '_' {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return lead, words
+}
+
+// ConvertCase converts an ABI name to the naming convention, leading underscores are kept,
+// unknown conventions and NamingABI return the name verbatim.
+func ConvertCase(name, naming string) string {
+	lead, words := SplitWords(name)
+	switch naming {
+	case NamingCamel:
+		for i := 1; i < len(words); i++ {
+			words[i] = Title.String(words[i])
+		}
+		return lead + strings.Join(words, "")
+	case NamingPascal:
+		for i := range words {
+			words[i] = Title.String(words[i])
+		}
+		return lead + strings.Join(words, "")
+	case NamingSnake:
+		return lead + strings.Join(words, "_")
+	default:
+		return name
+	}
 }
 
 func SortedMapKeys[K cmp.Ordered, V any](m map[K]V) []K {
@@ -114,11 +194,12 @@ func GoFieldName(name string) string {
 }
 
 // ParseExternalTuples parses external tuple mappings from string format
-// Format: "key1=value1,key2=value2"
-func ParseExternalTuples(s string) map[string]string {
+// Format: "key1=value1,key2=value2", value can be "pkg.Type@import/path", see ParseExternalTuple
+// for the errors of the invalid values.
+func ParseExternalTuples(s string) (map[string]string, error) {
 	result := make(map[string]string)
 	if s == "" {
-		return result
+		return result, nil
 	}
 
 	pairs := strings.Split(s, ",")
@@ -128,11 +209,84 @@ func ParseExternalTuples(s string) map[string]string {
 			key := strings.TrimSpace(parts[0])
 			value := strings.TrimSpace(parts[1])
 			if key != "" && value != "" {
+				if _, _, err := ParseExternalTuple(value); err != nil {
+					return nil, fmt.Errorf("external tuple %s: %w", key, err)
+				}
 				result[key] = value
 			}
 		}
 	}
-	return result
+	return result, nil
+}
+
+// ParseExternalTuple parses an external tuple type which may specify the import path
+// of the package containing the type, either after "@" or as the qualifier of the type name.
+// Examples:
+//
+//	"User" -> "User", nil
+//	"types.User@github.com/org/shared/types" -> "types.User", &ImportSpec{Path: "github.com/org/shared/types"}
+//	"t2.User@github.com/org/shared/types" -> "t2.User", &ImportSpec{Path: "github.com/org/shared/types", Alias: "t2"}
+//	"github.com/org/shared/types.User" -> "types.User", &ImportSpec{Path: "github.com/org/shared/types"}
+//	"t2=github.com/org/shared/types.User" -> "t2.User", &ImportSpec{Path: "github.com/org/shared/types", Alias: "t2"}
+//	"github.com/org/shared/v2.Coin" -> "shared.Coin", &ImportSpec{Path: "github.com/org/shared/v2"}
+//
+// The import path with an invalid alias, e.g. "a=b=c/types.User", is an error.
+func ParseExternalTuple(value string) (string, *ImportSpec, error) {
+	typeName, importPath, found := strings.Cut(value, "@")
+	if !found {
+		return parseQualifiedTuple(value)
+	}
+
+	spec := &ImportSpec{Path: importPath}
+	if pkg, _, ok := strings.Cut(typeName, "."); ok {
+		if name, err := importName(importPath); err != nil || pkg != name {
+			spec.Alias = pkg
+		}
+	}
+	return typeName, spec, nil
+}
+
+// parseQualifiedTuple parses the "[alias=]import/path.Type" form of external tuple types
+func parseQualifiedTuple(value string) (string, *ImportSpec, error) {
+	slash := strings.LastIndex(value, "/")
+	dot := strings.LastIndex(value, ".")
+	if slash < 0 || dot < slash {
+		return value, nil, nil
+	}
+
+	spec, err := ParseImport(value[:dot])
+	if err != nil {
+		return "", nil, err
+	}
+	pkg := spec.Alias
+	if pkg == "" {
+		if pkg, err = importName(spec.Path); err != nil {
+			return "", nil, err
+		}
+	}
+	return pkg + value[dot:], &spec, nil
+}
+
+var (
+	// majorVersionRegex matches the major version element of the module paths, e.g. v2
+	majorVersionRegex = regexp.MustCompile(`^v[0-9]+$`)
+	// gopkgVersionRegex matches the version suffix of the gopkg.in paths, e.g. .v3 of yaml.v3
+	gopkgVersionRegex = regexp.MustCompile(`\.v[0-9]+$`)
+)
+
+// importName returns the conventional package name of the import path, the last element without the
+// major version suffix, e.g. "shared" for "github.com/org/shared/v2" and "yaml" for "gopkg.in/yaml.v3",
+// it's an error if it's not an identifier, e.g. "go-ethereum", the alias must be given explicitly.
+func importName(importPath string) (string, error) {
+	name := path.Base(importPath)
+	if majorVersionRegex.MatchString(name) && path.Dir(importPath) != "." {
+		name = path.Base(path.Dir(importPath))
+	}
+	name = gopkgVersionRegex.ReplaceAllString(name, "")
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("package name of %q is not an identifier, give the alias explicitly, e.g. alias=%s.Type", importPath, importPath)
+	}
+	return name, nil
 }
 
 // ParseImport parses an import string that may contain an alias
@@ -140,7 +294,9 @@ func ParseExternalTuples(s string) map[string]string {
 //
 //	"github.com/ethereum/go-ethereum/common" -> ImportSpec{Path: "github.com/ethereum/go-ethereum/common", Alias: ""}
 //	"cmn=github.com/ethereum/go-ethereum/common" -> ImportSpec{Path: "github.com/ethereum/go-ethereum/common", Alias: "cmn"}
-func ParseImport(imp string) ImportSpec {
+//
+// More than one "=", an empty path or an alias which is not an identifier is an error.
+func ParseImport(imp string) (ImportSpec, error) {
 	parts := strings.Split(imp, "=")
 	var spec ImportSpec
 
@@ -150,14 +306,20 @@ func ParseImport(imp string) ImportSpec {
 			Alias: parts[0],
 			Path:  parts[1],
 		}
+		if !token.IsIdentifier(spec.Alias) && spec.Alias != "." {
+			return ImportSpec{}, fmt.Errorf("invalid import alias %q in %q", spec.Alias, imp)
+		}
 	case 1:
 		spec = ImportSpec{
 			Path: parts[0],
 		}
 	default:
-		panic("invalid import format " + imp)
+		return ImportSpec{}, fmt.Errorf("invalid import format %q, expected [alias=]path", imp)
+	}
+	if spec.Path == "" {
+		return ImportSpec{}, fmt.Errorf("empty import path in %q", imp)
 	}
-	return spec
+	return spec, nil
 }
 
 // nativeSize returns the closest native size for a given int size s
@@ -0,0 +1,57 @@
+package generator
+
+import "testing"
+
+func TestConvertCase(t *testing.T) {
+	tests := []struct {
+		name   string
+		camel  string
+		snake  string
+		pascal string
+	}{
+		{"to", "to", "to", "To"},
+		{"tokenIn", "tokenIn", "token_in", "TokenIn"},
+		{"token_in", "tokenIn", "token_in", "TokenIn"},
+		{"TokenIn", "tokenIn", "token_in", "TokenIn"},
+		{"tokenID", "tokenId", "token_id", "TokenId"},
+		{"_newURI", "_newUri", "_new_uri", "_NewUri"},
+		{"__owner", "__owner", "__owner", "__Owner"},
+		{"URIPrefix", "uriPrefix", "uri_prefix", "UriPrefix"},
+		{"amount0In", "amount0In", "amount0_in", "Amount0In"},
+		{"ERC20Token", "erc20Token", "erc20_token", "Erc20Token"},
+		{"v2", "v2", "v2", "V2"},
+		{"arg1", "arg1", "arg1", "Arg1"},
+	}
+
+	for _, tc := range tests {
+		if got := ConvertCase(tc.name, NamingABI); got != tc.name {
+			t.Errorf("ConvertCase(%q, abi) = %q, want %q", tc.name, got, tc.name)
+		}
+		if got := ConvertCase(tc.name, NamingCamel); got != tc.camel {
+			t.Errorf("ConvertCase(%q, camel) = %q, want %q", tc.name, got, tc.camel)
+		}
+		if got := ConvertCase(tc.name, NamingSnake); got != tc.snake {
+			t.Errorf("ConvertCase(%q, snake) = %q, want %q", tc.name, got, tc.snake)
+		}
+		if got := ConvertCase(tc.name, NamingPascal); got != tc.pascal {
+			t.Errorf("ConvertCase(%q, pascal) = %q, want %q", tc.name, got, tc.pascal)
+		}
+	}
+}
+
+func TestToArgName(t *testing.T) {
+	tests := map[string]string{
+		"":       "",
+		"Amount": "amount",
+		"Field3": "field3",
+		"Type":   "type_",
+		"Func":   "func_",
+		"Range":  "range_",
+		"String": "string",
+	}
+	for name, expected := range tests {
+		if got := ToArgName(name); got != expected {
+			t.Errorf("ToArgName(%q) = %q, want %q", name, got, expected)
+		}
+	}
+}
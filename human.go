@@ -10,12 +10,17 @@ import (
 
 // Regular expressions compiled once at package level
 var (
-	// Function: function name(type1,type2) [payable|view|pure] [returns(type3,type4)]
-	// Match basic function structure, handle parameters and returns manually
-	functionRegex = regexp.MustCompile(`^function\s+(\w+)\s*\(.*\)\s*(payable|view|pure)?(?:\s+returns\s*\(.*\))?$`)
+	// Function: function name(type1,type2) [modifiers] [returns(type3,type4)]
+	// Match basic function structure, handle parameters and returns manually,
+	// the modifiers are payable|view|pure among visibility and other keywords copied from Solidity source
+	functionRegex = regexp.MustCompile(`^function\s+(\w+)\s*\(.*\)(?:\s*\w+)*(?:\s+returns\s*\(.*\))?$`)
 
-	// Event: event name(type1 indexed name1, type2 name2)
-	eventRegex = regexp.MustCompile(`^event\s+(\w+)\s*\(([^)]*)\)$`)
+	// Event: event name(type1 indexed name1, type2 name2) [anonymous]
+	// the parameters may contain the parentheses of inline tuples
+	eventRegex = regexp.MustCompile(`^event\s+(\w+)\s*\((.*)\)(\s+anonymous)?$`)
+
+	// Error: error name(type1 name1, type2 name2)
+	errorRegex = regexp.MustCompile(`^error\s+(\w+)\s*\((.*)\)$`)
 
 	// Constructor: constructor(type1,type2) [payable]
 	constructorRegex = regexp.MustCompile(`^constructor\s*\(([^)]*)\)\s*(payable)?$`)
@@ -23,18 +28,54 @@ var (
 	// Fallback/Receive: fallback() [payable] or receive() [payable]
 	fallbackRegex = regexp.MustCompile(`^(fallback|receive)\s*\(\s*\)\s*(payable)?$`)
 
+	// Interface wrapper: interface Name [is Base1, Base2] {
+	interfaceRegex = regexp.MustCompile(`\binterface\s+\w+(?:\s+is\s+[\w\s,]+)?\s*\{`)
+
 	// Struct: struct Name { type1 name1; type2 name2; }
 	structRegex = regexp.MustCompile(`^struct\s+(\w+)\s*\{\s*([^}]*)\s*\}$`)
 
-	// Parameter with optional indexed and name: type [indexed] [name]
-	paramRegex = regexp.MustCompile(`^(\S+)(?:\s+(indexed))?(?:\s+(\w+))?$`)
+	// Identifier of a contract or interface type
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_]\w*$`)
+
+	// Struct without a name: struct { ... }
+	unnamedStructRegex = regexp.MustCompile(`^struct\s*\{`)
+
+	// Parameter with optional data location, indexed and name: type [memory|calldata|storage] [indexed] [name]
+	paramRegex = regexp.MustCompile(`^(\S+)(?:\s+(?:memory|calldata|storage)\b)?(?:\s+(indexed))?(?:\s+(\w+))?$`)
 
 	// Type without tuple: matches types like uint256, address[], bytes32[4], etc.
 	typeWithoutTupleRegex = regexp.MustCompile(`^(\w+)((\[\d*\])+)?$`)
+
+	// Suffix after an inline tuple: [array dimensions] [memory|calldata|storage] [indexed] [name]
+	tupleSuffixRegex = regexp.MustCompile(`^((?:\[\d*\])*)\s*(?:(?:memory|calldata|storage)\b\s*)?(?:(indexed)\b\s*)?(\w*)$`)
+
+	// Array brackets with whitespace inside or before them, e.g. uint256 [ 2 ]
+	arrayBracketsRegex = regexp.MustCompile(`\s*\[\s*(\d*)\s*\]`)
+
+	// Size of a fixed array dimension, e.g. the 2 of uint256[2]
+	arraySizeRegex = regexp.MustCompile(`\[(\d+)\]`)
+
+	// The returns keyword after the parameters of a function, not part of a longer identifier
+	returnsRegex = regexp.MustCompile(`\breturns\b`)
 )
 
-// ParseHumanReadableABI parses human-readable ABI definitions and converts them to JSON ABI format
-func ParseHumanReadableABI(humanABI []string) ([]byte, error) {
+// ParseHumanReadableABI parses human-readable ABI definitions and converts them to JSON ABI format,
+// contractTypes are the names of the contract and interface types used as parameter types, e.g. IERC20,
+// which are encoded as address. Other types that are neither Solidity types nor structs are rejected.
+func ParseHumanReadableABI(humanABI []string, contractTypes ...string) ([]byte, error) {
+	humanABI, err := stripBlockComments(humanABI)
+	if err != nil {
+		return nil, err
+	}
+	humanABI, err = joinStructLines(humanABI)
+	if err != nil {
+		return nil, err
+	}
+	humanABI, err = mapContractTypes(humanABI, contractTypes)
+	if err != nil {
+		return nil, err
+	}
+
 	// First pass: extract and parse all struct definitions
 	structs, err := parseStructs(humanABI)
 	if err != nil {
@@ -75,6 +116,183 @@ func ParseHumanReadableABI(humanABI []string) ([]byte, error) {
 	return jsonBytes, nil
 }
 
+// ParseSolidityInterface parses a Solidity interface pasted verbatim, e.g.
+// `interface IERC20 { function transfer(address to, uint256 amount) external returns (bool); }`,
+// the comments and the interface wrapper are stripped, and each statement is parsed as a line of
+// human-readable ABI, the source without the wrapper is parsed as the interface body.
+// contractTypes are mapped to address as in ParseHumanReadableABI.
+func ParseSolidityInterface(src string, contractTypes ...string) ([]byte, error) {
+	body := stripComments(src)
+	if loc := interfaceRegex.FindStringIndex(body); loc != nil {
+		end := strings.LastIndex(body, "}")
+		if end < loc[1] {
+			return nil, fmt.Errorf("unterminated interface block")
+		}
+		body = body[loc[1]:end]
+	}
+
+	return ParseHumanReadableABI(splitStatements(body), contractTypes...)
+}
+
+// mapContractTypes replaces the contract and interface type names with address, the ABI encodes
+// them as address and the selectors are computed from the canonical address type.
+func mapContractTypes(lines []string, contractTypes []string) ([]string, error) {
+	if len(contractTypes) == 0 {
+		return lines, nil
+	}
+
+	names := make([]string, len(contractTypes))
+	for i, name := range contractTypes {
+		name = strings.TrimSpace(name)
+		if !identifierRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid contract type name: %q", name)
+		}
+		names[i] = regexp.QuoteMeta(name)
+	}
+	re := regexp.MustCompile(`\b(?:` + strings.Join(names, "|") + `)\b`)
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = re.ReplaceAllString(line, "address")
+	}
+	return result, nil
+}
+
+// stripComments removes the line and block comments from Solidity source
+func stripComments(src string) string {
+	var b strings.Builder
+	for len(src) > 0 {
+		switch {
+		case strings.HasPrefix(src, "//"):
+			end := strings.IndexByte(src, '\n')
+			if end == -1 {
+				return b.String()
+			}
+			src = src[end:]
+		case strings.HasPrefix(src, "/*"):
+			end := strings.Index(src[2:], "*/")
+			if end == -1 {
+				return b.String()
+			}
+			b.WriteByte(' ')
+			src = src[2+end+2:]
+		default:
+			b.WriteByte(src[0])
+			src = src[1:]
+		}
+	}
+	return b.String()
+}
+
+// stripBlockComments removes the `/* ... */` comments which may span multiple lines, the lines covered by a
+// comment are kept as empty lines, the `//` comment lines are left to the parser. There are no string
+// literals in the signatures which could contain the comment markers.
+func stripBlockComments(lines []string) ([]string, error) {
+	result := make([]string, len(lines))
+	inComment := false
+	for i, line := range lines {
+		if !inComment && strings.HasPrefix(strings.TrimSpace(line), "//") {
+			result[i] = line
+			continue
+		}
+
+		var b strings.Builder
+		for len(line) > 0 {
+			if inComment {
+				end := strings.Index(line, "*/")
+				if end == -1 {
+					break
+				}
+				line = line[end+2:]
+				inComment = false
+				b.WriteByte(' ')
+				continue
+			}
+
+			start := strings.Index(line, "/*")
+			if start == -1 {
+				b.WriteString(line)
+				break
+			}
+			b.WriteString(line[:start])
+			line = line[start+2:]
+			inComment = true
+		}
+		result[i] = b.String()
+	}
+	if inComment {
+		return nil, fmt.Errorf("unterminated block comment")
+	}
+	return result, nil
+}
+
+// joinStructLines joins the struct definitions written across multiple lines, from the `struct Name {`
+// line to the closing brace, into single lines, the `//` comment lines inside are dropped.
+func joinStructLines(lines []string) ([]string, error) {
+	var (
+		result []string
+		parts  []string
+	)
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if parts == nil {
+			if strings.HasPrefix(line, "struct") && strings.Contains(line, "{") && !strings.Contains(line, "}") {
+				parts = []string{line}
+				continue
+			}
+			result = append(result, line)
+			continue
+		}
+
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		parts = append(parts, line)
+		if strings.Contains(line, "}") {
+			result = append(result, strings.Join(parts, " "))
+			parts = nil
+		}
+	}
+	if parts != nil {
+		return nil, fmt.Errorf("unterminated struct definition: %s", parts[0])
+	}
+	return result, nil
+}
+
+// splitStatements splits the Solidity source into statements terminated by `;`, or by the closing brace
+// of a block like struct definitions, the whitespaces in each statement are collapsed into single spaces.
+func splitStatements(src string) []string {
+	var (
+		statements []string
+		start      int
+		depth      int
+	)
+	add := func(statement string) {
+		if statement = strings.Join(strings.Fields(statement), " "); statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	for i, ch := range src {
+		switch ch {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				add(src[start : i+1])
+				start = i + 1
+			}
+		case ';':
+			if depth == 0 {
+				add(src[start:i])
+				start = i + 1
+			}
+		}
+	}
+	add(src[start:])
+	return statements
+}
+
 // isStructSignature checks if a line is a struct definition
 func isStructSignature(line string) bool {
 	return structRegex.MatchString(line)
@@ -100,6 +318,15 @@ func parseLineWithStructs(line string, structs map[string][]map[string]interface
 		return item, nil
 	}
 
+	// Try to match error
+	item, err = parseErrorWithStructs(line, structs)
+	if err != nil {
+		return nil, err
+	}
+	if item != nil {
+		return item, nil
+	}
+
 	// Try to match constructor
 	item, err = parseConstructorWithStructs(line, structs)
 	if err != nil {
@@ -130,6 +357,7 @@ func parseFunctionWithStructs(line string, structs map[string][]map[string]inter
 
 	// Manually extract parameters section
 	openParen := strings.Index(line, "(")
+	closeParen := len(line)
 	if openParen != -1 {
 		// Find the matching closing parenthesis for parameters
 		parenCount := 1
@@ -140,68 +368,47 @@ func parseFunctionWithStructs(line string, structs map[string][]map[string]inter
 				parenCount--
 				if parenCount == 0 {
 					inputsStr = line[openParen+1 : i]
+					closeParen = i
 					break
 				}
 			}
 		}
 	}
 
-	// Manually extract returns section if it exists
-	returnsIndex := -1
-	if strings.Contains(line, "returns") {
-		returnsIndex = strings.Index(line, "returns")
-		if returnsIndex != -1 {
-			// Find the opening parenthesis after "returns"
-			openParen := strings.Index(line[returnsIndex:], "(")
-			if openParen != -1 {
-				start := returnsIndex + openParen + 1
-				// Find the matching closing parenthesis
-				parenCount := 1
-				for i := start; i < len(line); i++ {
-					if line[i] == '(' {
-						parenCount++
-					} else if line[i] == ')' {
-						parenCount--
-						if parenCount == 0 {
-							outputsStr = line[start:i]
-							break
-						}
+	// Manually extract returns section if it exists, the keyword is only searched after the parameters
+	// so the names containing returns, e.g. returnsCount, are not mistaken for it
+	rest := ""
+	if closeParen < len(line) {
+		rest = line[closeParen+1:]
+	}
+	modifiers := rest
+	if loc := returnsRegex.FindStringIndex(rest); loc != nil {
+		// the modifiers are between the parameters and returns
+		modifiers = rest[:loc[0]]
+		returnsStr := rest[loc[1]:]
+		// Find the opening parenthesis after "returns"
+		if openParen := strings.Index(returnsStr, "("); openParen != -1 {
+			start := openParen + 1
+			// Find the matching closing parenthesis
+			parenCount := 1
+			for i := start; i < len(returnsStr); i++ {
+				if returnsStr[i] == '(' {
+					parenCount++
+				} else if returnsStr[i] == ')' {
+					parenCount--
+					if parenCount == 0 {
+						outputsStr = returnsStr[start:i]
+						break
 					}
 				}
 			}
 		}
 	}
 
-	// Extract state mutability manually - look for payable/view/pure between parameters and returns
-	stateMutability := "nonpayable"
-	if returnsIndex != -1 {
-		// Look for state mutability between the end of parameters and "returns"
-		endOfParams := openParen + len(inputsStr) + 2 // position after closing parenthesis of parameters
-		if endOfParams < returnsIndex {
-			between := strings.TrimSpace(line[endOfParams:returnsIndex])
-			switch between {
-			case "payable":
-				stateMutability = "payable"
-			case "view":
-				stateMutability = "view"
-			case "pure":
-				stateMutability = "pure"
-			}
-		}
-	} else {
-		// No returns clause, look for state mutability after parameters
-		endOfParams := openParen + len(inputsStr) + 2 // position after closing parenthesis of parameters
-		if endOfParams < len(line) {
-			remaining := strings.TrimSpace(line[endOfParams:])
-			switch remaining {
-			case "payable":
-				stateMutability = "payable"
-			case "view":
-				stateMutability = "view"
-			case "pure":
-				stateMutability = "pure"
-			}
-		}
+	// Extract state mutability from the modifiers between parameters and returns
+	stateMutability, err := parseStateMutability(modifiers)
+	if err != nil {
+		return nil, err
 	}
 
 	inputs, err := parseParametersWithStructs(inputsStr, false, structs)
@@ -226,6 +433,31 @@ func parseFunctionWithStructs(line string, structs map[string][]map[string]inter
 	}, nil
 }
 
+// parseStateMutability tokenizes the modifiers of a function and returns its state mutability,
+// nonpayable if there's none, the legacy constant means view. Visibility (external, public...),
+// virtual, override and custom modifiers are ignored, conflicting mutabilities are rejected.
+func parseStateMutability(modifiers string) (string, error) {
+	stateMutability := ""
+	for _, modifier := range strings.Fields(modifiers) {
+		mutability := modifier
+		switch modifier {
+		case "payable", "nonpayable", "view", "pure":
+		case "constant":
+			mutability = "view"
+		default:
+			continue
+		}
+		if stateMutability != "" && stateMutability != mutability {
+			return "", fmt.Errorf("conflicting state mutability %s and %s", stateMutability, mutability)
+		}
+		stateMutability = mutability
+	}
+	if stateMutability == "" {
+		stateMutability = "nonpayable"
+	}
+	return stateMutability, nil
+}
+
 // parseEventWithStructs parses an event definition with struct context
 func parseEventWithStructs(line string, structs map[string][]map[string]interface{}) (map[string]interface{}, error) {
 	matches := eventRegex.FindStringSubmatch(line)
@@ -245,7 +477,29 @@ func parseEventWithStructs(line string, structs map[string][]map[string]interfac
 		"type":      "event",
 		"name":      name,
 		"inputs":    inputs,
-		"anonymous": false,
+		"anonymous": matches[3] != "",
+	}, nil
+}
+
+// parseErrorWithStructs parses a custom error definition with struct context
+func parseErrorWithStructs(line string, structs map[string][]map[string]interface{}) (map[string]interface{}, error) {
+	matches := errorRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, nil
+	}
+
+	name := matches[1]
+	inputsStr := matches[2]
+
+	inputs, err := parseParametersWithStructs(inputsStr, false, structs)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"type":   "error",
+		"name":   name,
+		"inputs": inputs,
 	}, nil
 }
 
@@ -329,6 +583,8 @@ func parseParametersWithStructs(paramsStr string, isEvent bool, structs map[stri
 
 // parseParameterWithStructs parses a single parameter string with struct context
 func parseParameterWithStructs(paramStr string, isEvent bool, structs map[string][]map[string]interface{}) (map[string]interface{}, error) {
+	paramStr = normalizeBrackets(paramStr)
+
 	// For tuple types, we need special handling
 	// Look for opening parenthesis and find matching closing parenthesis
 	if strings.HasPrefix(paramStr, "(") {
@@ -357,6 +613,9 @@ func parseParameterWithStructs(paramStr string, isEvent bool, structs map[string
 	typeStr := matches[1]
 	indexed := matches[2] == "indexed"
 	name := matches[3]
+	if indexed && !isEvent {
+		return nil, fmt.Errorf("indexed is only allowed for event parameters: %s", paramStr)
+	}
 
 	matches = typeWithoutTupleRegex.FindStringSubmatch(typeStr)
 	if matches == nil {
@@ -364,6 +623,9 @@ func parseParameterWithStructs(paramStr string, isEvent bool, structs map[string
 	}
 	baseType := matches[1]
 	arrayPart := matches[2]
+	if err := checkArraySizes(arrayPart); err != nil {
+		return nil, err
+	}
 
 	// Check if this is a struct reference
 	if structs != nil {
@@ -401,7 +663,9 @@ func parseParameterWithStructs(paramStr string, isEvent bool, structs map[string
 	return paramMap, nil
 }
 
-// parseTupleParameterWithStructs parses a tuple parameter with struct context
+// parseTupleParameterWithStructs parses an inline tuple parameter with struct context,
+// the tuple components are parsed recursively so inline tuples can be nested arbitrarily:
+// (type1 name1, (type2 name2)[] name3)[2][] [indexed] [name]
 func parseTupleParameterWithStructs(paramStr string, isEvent bool, structs map[string][]map[string]interface{}) (map[string]interface{}, error) {
 	// Find the matching closing parenthesis for the tuple content
 	parenCount := 0
@@ -425,52 +689,39 @@ func parseTupleParameterWithStructs(paramStr string, isEvent bool, structs map[s
 	// Extract the content inside the tuple parentheses
 	content := strings.TrimSpace(paramStr[1:tupleEnd])
 
-	// Parse the tuple components
+	// Parse the tuple components, nested tuples are handled by the recursion
 	components, err := parseParametersWithStructs(content, false, structs)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extract name and array info from the part after the tuple
-	name := ""
-	isArray := false
-	isFixedArray := false
-	arraySize := ""
-
-	if tupleEnd+1 < len(paramStr) {
-		remaining := strings.TrimSpace(paramStr[tupleEnd+1:])
-		if remaining != "" {
-			// Check for array types
-			if strings.HasPrefix(remaining, "[]") {
-				isArray = true
-				// Update the name to remove the array brackets
-				name = strings.TrimSpace(strings.TrimPrefix(remaining, "[]"))
-			} else if bracketIdx := strings.Index(remaining, "["); bracketIdx != -1 && strings.HasSuffix(remaining, "]") {
-				isFixedArray = true
-				arraySize = remaining[bracketIdx+1 : len(remaining)-1]
-				// Update the name to remove the fixed array brackets
-				name = strings.TrimSpace(remaining[:bracketIdx])
-			} else {
-				// No array, just a name
-				name = remaining
-			}
-		}
+	// Extract array suffix, indexed and name from the part after the tuple
+	remaining := strings.TrimSpace(paramStr[tupleEnd+1:])
+	matches := tupleSuffixRegex.FindStringSubmatch(remaining)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid tuple parameter format: %s", paramStr)
+	}
+	arrayPart := matches[1]
+	if err := checkArraySizes(arrayPart); err != nil {
+		return nil, err
+	}
+	indexed := matches[2] == "indexed"
+	name := matches[3]
+	if indexed && !isEvent {
+		return nil, fmt.Errorf("indexed is only allowed for event parameters: %s", paramStr)
 	}
 
 	paramMap := map[string]interface{}{
 		"name":       name,
-		"type":       "tuple",
+		"type":       "tuple" + arrayPart,
 		"components": components,
 	}
 
-	if isArray {
-		paramMap["type"] = "tuple[]"
-	} else if isFixedArray {
-		paramMap["type"] = "tuple[" + arraySize + "]"
-	}
-
 	// Only add indexed field for events
 	// For functions, don't include the indexed field at all
+	if isEvent {
+		paramMap["indexed"] = indexed
+	}
 
 	return paramMap, nil
 }
@@ -514,6 +765,38 @@ func splitByCommaOutsideParentheses(s string) ([]string, error) {
 	return parts, nil
 }
 
+// normalizeBrackets removes the whitespace inside and before the array brackets, a common artifact of
+// reformatted signatures, e.g. "uint256 [ 2 ] keys" -> "uint256[2] keys", unbalanced brackets are kept
+// to be rejected by the type parsing.
+func normalizeBrackets(s string) string {
+	return arrayBracketsRegex.ReplaceAllString(s, "[$1]")
+}
+
+// checkArraySize validates the size of a fixed array dimension, it must be between 1 and MaxArraySize
+func checkArraySize(sizeStr string) error {
+	size, err := strconv.Atoi(sizeStr)
+	if err != nil {
+		return fmt.Errorf("invalid array size '%s'", sizeStr)
+	}
+	if size < 1 {
+		return fmt.Errorf("invalid array size '%s': fixed arrays must have at least one element", sizeStr)
+	}
+	if size > MaxArraySize {
+		return fmt.Errorf("invalid array size '%s': exceeds the maximum of %d", sizeStr, MaxArraySize)
+	}
+	return nil
+}
+
+// checkArraySizes validates the fixed dimensions of an array suffix, e.g. "[2][][3]"
+func checkArraySizes(arrayPart string) error {
+	for _, m := range arraySizeRegex.FindAllStringSubmatch(arrayPart, -1) {
+		if err := checkArraySize(m[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // normalizeType validates and normalizes Solidity type names
 func normalizeType(typeStr string) (string, error) {
 	// Handle arrays first
@@ -526,8 +809,8 @@ func normalizeType(typeStr string) (string, error) {
 		return normalizedElem + "[]", nil
 	}
 
-	// Handle fixed arrays
-	if idx := strings.Index(typeStr, "["); idx != -1 && strings.HasSuffix(typeStr, "]") {
+	// Handle fixed arrays, the last dimension is the outermost one
+	if idx := strings.LastIndex(typeStr, "["); idx != -1 && strings.HasSuffix(typeStr, "]") {
 		elemType := typeStr[:idx]
 		sizeStr := typeStr[idx+1 : len(typeStr)-1]
 
@@ -536,8 +819,8 @@ func normalizeType(typeStr string) (string, error) {
 			return "", err
 		}
 
-		if _, err := strconv.Atoi(sizeStr); err != nil {
-			return "", fmt.Errorf("invalid array size '%s'", sizeStr)
+		if err := checkArraySize(sizeStr); err != nil {
+			return "", err
 		}
 
 		return normalizedElem + "[" + sizeStr + "]", nil
@@ -595,9 +878,9 @@ func normalizeType(typeStr string) (string, error) {
 		return typeStr, nil
 	}
 
-	// For now, treat any unrecognized type as a potential struct reference
-	// This allows the parsing to continue and the struct resolution can happen later
-	return typeStr, nil
+	// Struct references are resolved before normalizing, contract and interface types must be
+	// mapped to address, otherwise the selector would be computed from a bogus signature
+	return "", fmt.Errorf("unknown type %s, not a Solidity type nor a struct, contract types must be mapped to address", typeStr)
 }
 
 // parseStructs parses struct definitions from a list of lines
@@ -614,11 +897,17 @@ func parseStructs(lines []string) (map[string][]map[string]interface{}, error) {
 
 		matches := structRegex.FindStringSubmatch(line)
 		if matches == nil {
+			if unnamedStructRegex.MatchString(line) {
+				return nil, fmt.Errorf("invalid struct signature (no name): %s", line)
+			}
 			continue
 		}
 
 		name := matches[1]
 		properties := matches[2]
+		if _, ok := shallowStructs[name]; ok {
+			return nil, fmt.Errorf("duplicate struct %s: %s", name, line)
+		}
 
 		// Parse properties (split by semicolon)
 		propList := strings.Split(properties, ";")
@@ -631,7 +920,7 @@ func parseStructs(lines []string) (map[string][]map[string]interface{}, error) {
 			}
 
 			// Parse each property as a parameter
-			parts := strings.Fields(prop)
+			parts := strings.Fields(normalizeBrackets(prop))
 			if len(parts) < 1 {
 				continue
 			}
@@ -640,15 +929,12 @@ func parseStructs(lines []string) (map[string][]map[string]interface{}, error) {
 			paramName := ""
 			if len(parts) > 1 {
 				paramName = parts[1]
+				if !identifierRegex.MatchString(paramName) {
+					return nil, fmt.Errorf("invalid property %q in struct %s", prop, name)
+				}
 			}
 
-			var err error
-			paramType, err = normalizeType(paramType)
-			if err != nil {
-				return nil, fmt.Errorf("invalid type in struct %s: %s", name, paramType)
-			}
-
-			// For struct parsing, we don't validate types yet
+			// For struct parsing, we don't validate types yet, the structs may be defined later
 			component := map[string]interface{}{
 				"name": paramName,
 				"type": paramType,
@@ -667,7 +953,7 @@ func parseStructs(lines []string) (map[string][]map[string]interface{}, error) {
 	for name, parameters := range shallowStructs {
 		resolved, err := resolveStructComponents(parameters, shallowStructs, make(map[string]bool))
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid type in struct %s: %w", name, err)
 		}
 		structs[name] = resolved
 	}
@@ -695,6 +981,9 @@ func resolveStructComponents(parameters []map[string]interface{}, structs map[st
 
 		baseType := matches[1]
 		arrayPart := matches[2]
+		if err := checkArraySizes(arrayPart); err != nil {
+			return nil, err
+		}
 
 		// Check if this is a struct reference
 		if nestedStruct, exists := structs[baseType]; exists {
@@ -725,10 +1014,14 @@ func resolveStructComponents(parameters []map[string]interface{}, structs map[st
 			components = append(components, tupleParam)
 		} else {
 			// Not a struct, validate it's a valid Solidity type
-			if _, err := normalizeType(paramType); err != nil {
-				return nil, fmt.Errorf("unknown type: %s", paramType)
+			normalized, err := normalizeType(paramType)
+			if err != nil {
+				return nil, err
 			}
-			components = append(components, param)
+			components = append(components, map[string]interface{}{
+				"name": param["name"],
+				"type": normalized,
+			})
 		}
 	}
 
@@ -0,0 +1,203 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// HumanABIBuilder composes an ABI incrementally from human-readable fragments and JSON ABI
+// documents. The struct definitions accumulate across the calls, so the structs shared by several
+// modules can be added once and referenced by the fragments added later.
+type HumanABIBuilder struct {
+	contractTypes []string
+	structLines   []string
+	structs       map[string][]map[string]interface{}
+	items         []builderItem
+	sources       int
+}
+
+// builderItem is an ABI item with the source it was added from, for the duplicate errors
+type builderItem struct {
+	item   map[string]interface{}
+	source string
+}
+
+// NewHumanABIBuilder constructs an empty builder, the contract types are encoded as address
+func NewHumanABIBuilder(contractTypes ...string) *HumanABIBuilder {
+	return &HumanABIBuilder{
+		contractTypes: contractTypes,
+		structs:       make(map[string][]map[string]interface{}),
+	}
+}
+
+// AddStructs adds struct definitions, they can reference the structs added before.
+func (b *HumanABIBuilder) AddStructs(lines []string) error {
+	source := b.nextSource("structs")
+	lines, err := b.preprocess(lines)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if !isStructSignature(line) {
+			return fmt.Errorf("%s: not a struct definition: %s", source, line)
+		}
+	}
+	return b.addStructs(lines, source)
+}
+
+// AddFragments parses human-readable ABI lines, the struct definitions among them are added first
+// and the other lines are resolved against all the structs added so far.
+func (b *HumanABIBuilder) AddFragments(lines []string) error {
+	source := b.nextSource("fragments")
+	lines, err := b.preprocess(lines)
+	if err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	if err := b.addStructs(lines, source); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") || isStructSignature(line) {
+			continue
+		}
+
+		item, err := parseLineWithStructs(line, b.structs)
+		if err != nil {
+			return fmt.Errorf("%s: failed to parse line '%s': %w", source, line, err)
+		}
+		if item != nil {
+			b.items = append(b.items, builderItem{item: item, source: source})
+		}
+	}
+	return nil
+}
+
+// AddJSON adds the items of a JSON ABI document, the types of their parameters are validated.
+func (b *HumanABIBuilder) AddJSON(data []byte) error {
+	source := b.nextSource("JSON")
+	var items []map[string]interface{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	for _, item := range items {
+		if _, err := itemKey(item); err != nil {
+			return fmt.Errorf("%s: %w", source, err)
+		}
+		b.items = append(b.items, builderItem{item: item, source: source})
+	}
+	return nil
+}
+
+// BuildJSON returns the JSON ABI of all the items added, an item declared by more than one source
+// is an error naming both of them.
+func (b *HumanABIBuilder) BuildJSON() ([]byte, error) {
+	seen := make(map[string]string, len(b.items))
+	items := make([]map[string]interface{}, 0, len(b.items))
+	for _, it := range b.items {
+		key, err := itemKey(it.item)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", it.source, err)
+		}
+		if first, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicate %s in %s and %s", key, first, it.source)
+		}
+		seen[key] = it.source
+		items = append(items, it.item)
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no valid ABI items found")
+	}
+
+	jsonBytes, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	return jsonBytes, nil
+}
+
+// nextSource names the next source added, e.g. "fragments #2", in the order of the calls
+func (b *HumanABIBuilder) nextSource(kind string) string {
+	b.sources++
+	return fmt.Sprintf("%s #%d", kind, b.sources)
+}
+
+// preprocess applies the same normalization as ParseHumanReadableABI
+func (b *HumanABIBuilder) preprocess(lines []string) ([]string, error) {
+	lines, err := stripBlockComments(lines)
+	if err != nil {
+		return nil, err
+	}
+	lines, err = joinStructLines(lines)
+	if err != nil {
+		return nil, err
+	}
+	return mapContractTypes(lines, b.contractTypes)
+}
+
+// addStructs re-parses the accumulated struct definitions with the ones in lines, so they can
+// reference each other regardless of the call they were added in.
+func (b *HumanABIBuilder) addStructs(lines []string, source string) error {
+	structLines := b.structLines
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if isStructSignature(line) {
+			structLines = append(structLines, line)
+		}
+	}
+	if len(structLines) == len(b.structLines) {
+		return nil
+	}
+
+	structs, err := parseStructs(structLines)
+	if err != nil {
+		return fmt.Errorf("%s: failed to parse structs: %w", source, err)
+	}
+	b.structLines = structLines
+	b.structs = structs
+	return nil
+}
+
+// itemKey identifies an ABI item by its kind and canonical signature, e.g. "function transfer(address,uint256)",
+// the types of the inputs and outputs are validated.
+func itemKey(item map[string]interface{}) (string, error) {
+	typ, _ := item["type"].(string)
+	if typ == "" {
+		typ = "function"
+	}
+	switch typ {
+	case "constructor", "fallback", "receive":
+		return typ, nil
+	case "function", "event", "error":
+	default:
+		return "", fmt.Errorf("unknown ABI item type %q", typ)
+	}
+
+	name, _ := item["name"].(string)
+	if name == "" {
+		return "", fmt.Errorf("%s has no name", typ)
+	}
+	inputs, err := itemParameters(item["inputs"])
+	if err != nil {
+		return "", fmt.Errorf("%s %s: %w", typ, name, err)
+	}
+	params, err := canonicalParameters(inputs)
+	if err != nil {
+		return "", fmt.Errorf("%s %s: %w", typ, name, err)
+	}
+	outputs, err := itemParameters(item["outputs"])
+	if err != nil {
+		return "", fmt.Errorf("%s %s: %w", typ, name, err)
+	}
+	if _, err := canonicalParameters(outputs); err != nil {
+		return "", fmt.Errorf("%s %s: %w", typ, name, err)
+	}
+	return typ + " " + name + params, nil
+}
@@ -0,0 +1,93 @@
+package abi
+
+import (
+	"bytes"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+// build parses the JSON ABI of the builder with go-ethereum
+func build(b *HumanABIBuilder) (ethabi.ABI, error) {
+	data, err := b.BuildJSON()
+	if err != nil {
+		return ethabi.ABI{}, err
+	}
+	return ethabi.JSON(bytes.NewReader(data))
+}
+
+func TestHumanABIBuilder(t *testing.T) {
+	b := NewHumanABIBuilder()
+	require.NoError(t, b.AddStructs([]string{
+		"struct Coin { string denom; uint256 amount; }",
+	}))
+	require.NoError(t, b.AddFragments([]string{
+		"struct Balance { address owner; Coin[] coins; }",
+		"function balance(address owner) view returns (Balance)",
+	}))
+	require.NoError(t, b.AddFragments([]string{
+		"function send(address to, Coin[] coins) returns (bool)",
+		"event Sent(address indexed to, Coin coin)",
+	}))
+	require.NoError(t, b.AddJSON([]byte(`[{"type":"function","name":"owner","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"}]`)))
+
+	parsed, err := build(b)
+	require.NoError(t, err)
+	require.Equal(t, "balance(address)", parsed.Methods["balance"].Sig)
+	require.Equal(t, "send(address,(string,uint256)[])", parsed.Methods["send"].Sig)
+	require.Equal(t, "Sent(address,(string,uint256))", parsed.Events["Sent"].Sig)
+	require.Equal(t, "owner()", parsed.Methods["owner"].Sig)
+	require.Equal(t, "(address,(string,uint256)[])", parsed.Methods["balance"].Outputs[0].Type.String())
+}
+
+func TestHumanABIBuilder_Errors(t *testing.T) {
+	t.Run("duplicate across sources", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		require.NoError(t, b.AddFragments([]string{"function transfer(address to, uint256 amount) returns (bool)"}))
+		require.NoError(t, b.AddJSON([]byte(`[{"type":"function","name":"transfer","inputs":[{"name":"","type":"address"},{"name":"","type":"uint256"}],"outputs":[]}]`)))
+		_, err := build(b)
+		require.EqualError(t, err, "duplicate function transfer(address,uint256) in fragments #1 and JSON #2")
+	})
+
+	t.Run("overloads are not duplicates", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		require.NoError(t, b.AddFragments([]string{"function transfer(address to, uint256 amount)"}))
+		require.NoError(t, b.AddFragments([]string{"function transfer(address to)", "event transfer(address to)"}))
+		_, err := build(b)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown struct", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		require.NoError(t, b.AddStructs([]string{"struct Coin { string denom; uint256 amount; }"}))
+		err := b.AddFragments([]string{"function send(Token token)"})
+		require.ErrorContains(t, err, "fragments #2")
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		err := b.AddStructs([]string{"function send(address to)"})
+		require.ErrorContains(t, err, "not a struct definition")
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		require.Error(t, b.AddJSON([]byte(`{`)))
+	})
+
+	t.Run("invalid JSON types", func(t *testing.T) {
+		b := NewHumanABIBuilder()
+		err := b.AddJSON([]byte(`[{"type":"function","name":"f","inputs":[{"name":"","type":"uint7"}],"outputs":[]}]`))
+		require.ErrorContains(t, err, "JSON #1: function f")
+		err = b.AddJSON([]byte(`[{"type":"function","name":"f","inputs":[],"outputs":[{"name":"","type":"bytes33"}]}]`))
+		require.ErrorContains(t, err, "JSON #2: function f")
+		err = b.AddJSON([]byte(`[{"type":"modifier","name":"onlyOwner"}]`))
+		require.ErrorContains(t, err, "unknown ABI item type")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := NewHumanABIBuilder().BuildJSON()
+		require.ErrorContains(t, err, "no valid ABI items found")
+	})
+}
@@ -1,9 +1,12 @@
 package abi
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,6 +50,174 @@ func TestParseHumanReadableABI(t *testing.T) {
 				}
 			]`,
 		},
+		{
+			name:  "function with visibility from solidity source",
+			input: []string{"function transfer(address to, uint256 amount) external returns (bool)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "transfer",
+					"inputs": [
+						{"name": "to", "type": "address"},
+						{"name": "amount", "type": "uint256"}
+					],
+					"outputs": [
+						{"name": "", "type": "bool"}
+					],
+					"stateMutability": "nonpayable"
+				}
+			]`,
+		},
+		{
+			name:  "function with custom modifier",
+			input: []string{"function mint(address to) public onlyOwner"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "mint",
+					"inputs": [
+						{"name": "to", "type": "address"}
+					],
+					"outputs": [],
+					"stateMutability": "nonpayable"
+				}
+			]`,
+		},
+		{
+			name:  "function with combined modifiers",
+			input: []string{"function balanceOf(address account) external view virtual override returns (uint256)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "balanceOf",
+					"inputs": [
+						{"name": "account", "type": "address"}
+					],
+					"outputs": [
+						{"name": "", "type": "uint256"}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name:  "function with data locations",
+			input: []string{"function setName(string memory name, bytes calldata data, (address owner, uint256[] ids) memory info) public payable whenNotPaused returns (string memory)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "setName",
+					"inputs": [
+						{"name": "name", "type": "string"},
+						{"name": "data", "type": "bytes"},
+						{
+							"name": "info",
+							"type": "tuple",
+							"components": [
+								{"name": "owner", "type": "address"},
+								{"name": "ids", "type": "uint256[]"}
+							]
+						}
+					],
+					"outputs": [
+						{"name": "", "type": "string"}
+					],
+					"stateMutability": "payable"
+				}
+			]`,
+		},
+		{
+			name:  "function with storage location and private visibility",
+			input: []string{"function _update(uint256[] storage values) private pure"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "_update",
+					"inputs": [
+						{"name": "values", "type": "uint256[]"}
+					],
+					"outputs": [],
+					"stateMutability": "pure"
+				}
+			]`,
+		},
+		{
+			name:  "function with external view",
+			input: []string{"function f() external view returns (uint256)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "f",
+					"inputs": [],
+					"outputs": [
+						{"name": "", "type": "uint256"}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name:  "function with public payable",
+			input: []string{"function g() public payable"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "g",
+					"inputs": [],
+					"outputs": [],
+					"stateMutability": "payable"
+				}
+			]`,
+		},
+		{
+			name:  "function with a parameter name containing returns",
+			input: []string{"function f(uint256 returnsCount) external view returns (uint256)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "f",
+					"inputs": [
+						{"name": "returnsCount", "type": "uint256"}
+					],
+					"outputs": [
+						{"name": "", "type": "uint256"}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name:  "function with a name containing returns",
+			input: []string{"function returnsX() view returns (uint256)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "returnsX",
+					"inputs": [],
+					"outputs": [
+						{"name": "", "type": "uint256"}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name:  "function with an output name containing returns",
+			input: []string{"function g(address returnsTo) public pure returns (bool returnsOk)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "g",
+					"inputs": [
+						{"name": "returnsTo", "type": "address"}
+					],
+					"outputs": [
+						{"name": "returnsOk", "type": "bool"}
+					],
+					"stateMutability": "pure"
+				}
+			]`,
+		},
 		{
 			name:  "function with payable",
 			input: []string{"function deposit() payable"},
@@ -76,6 +247,138 @@ func TestParseHumanReadableABI(t *testing.T) {
 				}
 			]`,
 		},
+		{
+			name:  "event with indexed inline tuple",
+			input: []string{"event Settled((uint256 id, uint256 amount) indexed fill)"},
+			expected: `[
+				{
+					"type": "event",
+					"name": "Settled",
+					"inputs": [
+						{
+							"name": "fill",
+							"type": "tuple",
+							"indexed": true,
+							"components": [
+								{"name": "id", "type": "uint256"},
+								{"name": "amount", "type": "uint256"}
+							]
+						}
+					],
+					"anonymous": false
+				}
+			]`,
+		},
+		{
+			name:  "event with inline tuple array",
+			input: []string{"event Filled((uint256 id, string note)[] fills, address indexed maker)"},
+			expected: `[
+				{
+					"type": "event",
+					"name": "Filled",
+					"inputs": [
+						{
+							"name": "fills",
+							"type": "tuple[]",
+							"indexed": false,
+							"components": [
+								{"name": "id", "type": "uint256"},
+								{"name": "note", "type": "string"}
+							]
+						},
+						{"name": "maker", "type": "address", "indexed": true}
+					],
+					"anonymous": false
+				}
+			]`,
+		},
+		{
+			name:  "error with inline tuple",
+			input: []string{"error Rejected((uint256 id, uint256 amount) fill)"},
+			expected: `[
+				{
+					"type": "error",
+					"name": "Rejected",
+					"inputs": [
+						{
+							"name": "fill",
+							"type": "tuple",
+							"components": [
+								{"name": "id", "type": "uint256"},
+								{"name": "amount", "type": "uint256"}
+							]
+						}
+					]
+				}
+			]`,
+		},
+		{
+			name:  "multi-dimensional fixed array in struct",
+			input: []string{"struct Grid { uint256[2][3] cells; }", "function set(Grid grid)"},
+			expected: `[
+				{
+					"type": "function",
+					"name": "set",
+					"stateMutability": "nonpayable",
+					"inputs": [
+						{
+							"name": "grid",
+							"type": "tuple",
+							"internalType": "struct Grid",
+							"components": [
+								{"name": "cells", "type": "uint256[2][3]"}
+							]
+						}
+					],
+					"outputs": []
+				}
+			]`,
+		},
+		{
+			name: "whitespace in array brackets",
+			input: []string{
+				"struct Keys { bytes32 [3] keys; uint8 [ ] flags }",
+				"function set(uint256[ 2 ] values, address [] accounts, bytes32 [3] keys, (uint256 id) [ ] [2] ids, Keys [ 1 ] all) returns (uint256 [ ])",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "set",
+					"stateMutability": "nonpayable",
+					"inputs": [
+						{"name": "values", "type": "uint256[2]"},
+						{"name": "accounts", "type": "address[]"},
+						{"name": "keys", "type": "bytes32[3]"},
+						{"name": "ids", "type": "tuple[][2]", "components": [{"name": "id", "type": "uint256"}]},
+						{
+							"name": "all",
+							"type": "tuple[1]",
+							"internalType": "struct Keys[1]",
+							"components": [
+								{"name": "keys", "type": "bytes32[3]"},
+								{"name": "flags", "type": "uint8[]"}
+							]
+						}
+					],
+					"outputs": [{"name": "", "type": "uint256[]"}]
+				}
+			]`,
+		},
+		{
+			name:  "anonymous event",
+			input: []string{"event Raw(address indexed sender, uint256 value) anonymous"},
+			expected: `[
+				{
+					"type": "event",
+					"name": "Raw",
+					"inputs": [
+						{"name": "sender", "type": "address", "indexed": true},
+						{"name": "value", "type": "uint256", "indexed": false}
+					],
+					"anonymous": true
+				}
+			]`,
+		},
 		{
 			name:  "constructor",
 			input: []string{"constructor(address owner, uint256 initialSupply)"},
@@ -123,6 +426,20 @@ func TestParseHumanReadableABI(t *testing.T) {
 				}
 			]`,
 		},
+		{
+			name:  "custom error",
+			input: []string{"error InsufficientBalance(uint256 available, uint256 required)"},
+			expected: `[
+				{
+					"type": "error",
+					"name": "InsufficientBalance",
+					"inputs": [
+						{"name": "available", "type": "uint256"},
+						{"name": "required", "type": "uint256"}
+					]
+				}
+			]`,
+		},
 		{
 			name: "multiple functions",
 			input: []string{
@@ -554,6 +871,226 @@ func TestParseHumanReadableABI(t *testing.T) {
 				}
 			]`,
 		},
+		{
+			name: "two levels of nested tuples in return",
+			input: []string{
+				"function delegationTotalRewards(address delegatorAddress) view returns ((string validatorAddress, (string denom, uint256 amount, uint8 precision)[] reward)[] rewards, (string denom, uint256 amount, uint8 precision)[] total)",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "delegationTotalRewards",
+					"inputs": [
+						{"name": "delegatorAddress", "type": "address"}
+					],
+					"outputs": [
+						{
+							"name": "rewards",
+							"type": "tuple[]",
+							"components": [
+								{"name": "validatorAddress", "type": "string"},
+								{
+									"name": "reward",
+									"type": "tuple[]",
+									"components": [
+										{"name": "denom", "type": "string"},
+										{"name": "amount", "type": "uint256"},
+										{"name": "precision", "type": "uint8"}
+									]
+								}
+							]
+						},
+						{
+							"name": "total",
+							"type": "tuple[]",
+							"components": [
+								{"name": "denom", "type": "string"},
+								{"name": "amount", "type": "uint256"},
+								{"name": "precision", "type": "uint8"}
+							]
+						}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name: "nested tuples with named members",
+			input: []string{
+				"function getProposal(uint64 proposalId) view returns ((uint64 id, (string typeUrl, bytes value)[] messages, uint32 status, (string yes, string no) finalTallyResult, (string denom, uint256 amount)[] totalDeposit) proposal)",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "getProposal",
+					"inputs": [
+						{"name": "proposalId", "type": "uint64"}
+					],
+					"outputs": [
+						{
+							"name": "proposal",
+							"type": "tuple",
+							"components": [
+								{"name": "id", "type": "uint64"},
+								{
+									"name": "messages",
+									"type": "tuple[]",
+									"components": [
+										{"name": "typeUrl", "type": "string"},
+										{"name": "value", "type": "bytes"}
+									]
+								},
+								{"name": "status", "type": "uint32"},
+								{
+									"name": "finalTallyResult",
+									"type": "tuple",
+									"components": [
+										{"name": "yes", "type": "string"},
+										{"name": "no", "type": "string"}
+									]
+								},
+								{
+									"name": "totalDeposit",
+									"type": "tuple[]",
+									"components": [
+										{"name": "denom", "type": "string"},
+										{"name": "amount", "type": "uint256"}
+									]
+								}
+							]
+						}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name: "three levels of nested tuples with mixed array suffixes",
+			input: []string{
+				"function redelegations(address delegatorAddress, (bytes key, uint64 limit) pageRequest) view returns (((string delegatorAddress, (int64 creationHeight, uint256 sharesDst)[2][] entries) redelegation, (int64 height)[3] balances)[] response, (bytes nextKey, uint64 total) pageResponse)",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "redelegations",
+					"inputs": [
+						{"name": "delegatorAddress", "type": "address"},
+						{
+							"name": "pageRequest",
+							"type": "tuple",
+							"components": [
+								{"name": "key", "type": "bytes"},
+								{"name": "limit", "type": "uint64"}
+							]
+						}
+					],
+					"outputs": [
+						{
+							"name": "response",
+							"type": "tuple[]",
+							"components": [
+								{
+									"name": "redelegation",
+									"type": "tuple",
+									"components": [
+										{"name": "delegatorAddress", "type": "string"},
+										{
+											"name": "entries",
+											"type": "tuple[2][]",
+											"components": [
+												{"name": "creationHeight", "type": "int64"},
+												{"name": "sharesDst", "type": "uint256"}
+											]
+										}
+									]
+								},
+								{
+									"name": "balances",
+									"type": "tuple[3]",
+									"components": [
+										{"name": "height", "type": "int64"}
+									]
+								}
+							]
+						},
+						{
+							"name": "pageResponse",
+							"type": "tuple",
+							"components": [
+								{"name": "nextKey", "type": "bytes"},
+								{"name": "total", "type": "uint64"}
+							]
+						}
+					],
+					"stateMutability": "view"
+				}
+			]`,
+		},
+		{
+			name: "multi-line struct",
+			input: []string{
+				"struct Order {",
+				"    address maker;",
+				"    uint256 amount;",
+				"    bytes32 salt;",
+				"    bool filled;",
+				"}",
+				"function fill(Order order)",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "fill",
+					"inputs": [
+						{
+							"name": "order",
+							"type": "tuple",
+							"internalType": "struct Order",
+							"components": [
+								{"name": "maker", "type": "address"},
+								{"name": "amount", "type": "uint256"},
+								{"name": "salt", "type": "bytes32"},
+								{"name": "filled", "type": "bool"}
+							]
+						}
+					],
+					"outputs": [],
+					"stateMutability": "nonpayable"
+				}
+			]`,
+		},
+		{
+			name: "block comments",
+			input: []string{
+				"function a(uint256 x) /* inline */ returns (bool)",
+				"/* multi",
+				"line */",
+				"// see /* not a block comment",
+				"function b(/* to */ address)",
+			},
+			expected: `[
+				{
+					"type": "function",
+					"name": "a",
+					"inputs": [
+						{"name": "x", "type": "uint256"}
+					],
+					"outputs": [
+						{"name": "", "type": "bool"}
+					],
+					"stateMutability": "nonpayable"
+				},
+				{
+					"type": "function",
+					"name": "b",
+					"inputs": [
+						{"name": "", "type": "address"}
+					],
+					"outputs": [],
+					"stateMutability": "nonpayable"
+				}
+			]`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -582,8 +1119,9 @@ func TestParseHumanReadableABI(t *testing.T) {
 
 func TestParseHumanReadableABI_Errors(t *testing.T) {
 	tests := []struct {
-		name  string
-		input []string
+		name        string
+		input       []string
+		errContains string
 	}{
 		{
 			name:  "invalid function format",
@@ -601,6 +1139,89 @@ func TestParseHumanReadableABI_Errors(t *testing.T) {
 			name:  "unrecognized line",
 			input: []string{"invalid line format"},
 		},
+		{
+			name:  "conflicting state mutability",
+			input: []string{"function f() external view payable returns (uint256)"},
+		},
+		{
+			name:        "unterminated struct",
+			input:       []string{"struct Order {", "address maker;", "function f()"},
+			errContains: "unterminated struct definition: struct Order {",
+		},
+		{
+			name:  "unterminated block comment",
+			input: []string{"function f() /* view", "function g()"},
+		},
+		{
+			name:        "duplicate struct",
+			input:       []string{"struct User { string name; }", "struct User { address account; }", "function f(User user)"},
+			errContains: "duplicate struct User: struct User { address account; }",
+		},
+		{
+			name:        "struct without name",
+			input:       []string{"struct { string name; }", "function f(uint256 x)"},
+			errContains: "invalid struct signature (no name): struct { string name; }",
+		},
+		{
+			name:        "unmapped contract type",
+			input:       []string{"function setToken(IERC20 token)"},
+			errContains: "unknown type IERC20",
+		},
+		{
+			name:        "unmapped contract type in struct",
+			input:       []string{"struct Pool { IERC20 token; uint24 fee; }", "function addPool(Pool pool)"},
+			errContains: "invalid type in struct Pool: unknown type IERC20",
+		},
+		{
+			name:        "indexed tuple component",
+			input:       []string{"event Settled((uint256 indexed id, uint256 amount) fill)"},
+			errContains: "indexed is only allowed for event parameters: uint256 indexed id",
+		},
+		{
+			name:        "unbalanced array bracket",
+			input:       []string{"function f(uint256[2 values)"},
+			errContains: "invalid type format: uint256[2",
+		},
+		{
+			name:        "unbalanced array bracket in struct",
+			input:       []string{"struct S { uint256 [2 values; }", "function f(S s)"},
+			errContains: "invalid property \"uint256 [2 values\" in struct S",
+		},
+		{
+			name:        "indexed function parameter",
+			input:       []string{"function settle((uint256 id, uint256 amount) indexed fill)"},
+			errContains: "indexed is only allowed for event parameters",
+		},
+		{
+			name:        "zero-length array",
+			input:       []string{"function f(uint256[0] values)"},
+			errContains: "invalid array size '0': fixed arrays must have at least one element",
+		},
+		{
+			name:        "zero-length inner dimension",
+			input:       []string{"function f(uint256[0][] values)"},
+			errContains: "invalid array size '0'",
+		},
+		{
+			name:        "zero-length tuple array",
+			input:       []string{"function f((uint256 id)[0] values)"},
+			errContains: "invalid array size '0'",
+		},
+		{
+			name:        "oversized array",
+			input:       []string{"function f(uint256[65537] values)"},
+			errContains: "invalid array size '65537': exceeds the maximum of 65536",
+		},
+		{
+			name:        "oversized struct array",
+			input:       []string{"struct S { uint256 id; }", "function f(S[99999999] values)"},
+			errContains: "invalid array size '99999999'",
+		},
+		{
+			name:        "oversized array in struct",
+			input:       []string{"struct S { bytes32[99999999] ids; }", "function f(S s)"},
+			errContains: "invalid array size '99999999'",
+		},
 		{
 			name:  "unprocessed parentheses",
 			input: []string{"function communityPool() view returns (tuple(string denom, uint256 amount)[] coins)"},
@@ -611,6 +1232,157 @@ func TestParseHumanReadableABI_Errors(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := ParseHumanReadableABI(tt.input)
 			require.Error(t, err)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			}
 		})
 	}
 }
+
+func TestParseHumanReadableABI_ContractTypes(t *testing.T) {
+	result, err := ParseHumanReadableABI([]string{
+		"struct Pool { IERC20 token; IPool[] pools; }",
+		"function setToken(IERC20 token)",
+		"function addPool(Pool pool) returns (IPool)",
+		"event TokenSet(IERC20 indexed token)",
+	}, "IERC20", " IPool")
+	require.NoError(t, err)
+
+	abiDef, err := ethabi.JSON(bytes.NewReader(result))
+	require.NoError(t, err)
+
+	setToken := abiDef.Methods["setToken"]
+	require.Equal(t, "setToken(address)", setToken.Sig)
+	require.Equal(t, crypto.Keccak256([]byte("setToken(address)"))[:4], setToken.ID)
+	require.Equal(t, "addPool((address,address[]))", abiDef.Methods["addPool"].Sig)
+	require.Equal(t, ethabi.AddressTy, abiDef.Methods["addPool"].Outputs[0].Type.T)
+	require.Equal(t, "TokenSet(address)", abiDef.Events["TokenSet"].Sig)
+
+	// parameter names containing the type name are left alone
+	result, err = ParseSolidityInterface("interface IVault { function deposit(IERC20 IERC20Token) external; }", "IERC20")
+	require.NoError(t, err)
+	abiDef, err = ethabi.JSON(bytes.NewReader(result))
+	require.NoError(t, err)
+	require.Equal(t, "deposit(address)", abiDef.Methods["deposit"].Sig)
+	require.Equal(t, "IERC20Token", abiDef.Methods["deposit"].Inputs[0].Name)
+
+	_, err = ParseHumanReadableABI([]string{"function setToken(IERC20 token)"}, "IERC20 token")
+	require.ErrorContains(t, err, `invalid contract type name: "IERC20 token"`)
+}
+
+func TestParseStateMutability(t *testing.T) {
+	tests := []struct {
+		modifiers string
+		expected  string
+		hasError  bool
+	}{
+		{modifiers: "", expected: "nonpayable"},
+		{modifiers: " external ", expected: "nonpayable"},
+		{modifiers: "external nonpayable", expected: "nonpayable"},
+		{modifiers: "external view", expected: "view"},
+		{modifiers: "public pure virtual", expected: "pure"},
+		{modifiers: "internal payable override", expected: "payable"},
+		{modifiers: "private onlyOwner view whenNotPaused", expected: "view"},
+		{modifiers: "constant", expected: "view"},
+		{modifiers: "view constant", expected: "view"},
+		{modifiers: "view pure", hasError: true},
+		{modifiers: "payable nonpayable", hasError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modifiers, func(t *testing.T) {
+			mutability, err := parseStateMutability(tt.modifiers)
+			if tt.hasError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, mutability)
+		})
+	}
+}
+
+func TestParseSolidityInterface(t *testing.T) {
+	src := `
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+/// @title ERC20 subset
+interface IERC20 is IERC165 {
+    struct Allowance {
+        address spender; // who can spend
+        uint256 amount;
+    }
+
+    /* emitted on transfer */
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    function transfer(address to, uint256 amount) external returns (bool);
+
+    function allowances(
+        address owner
+    ) external view returns (Allowance[] memory);
+}
+`
+
+	result, err := ParseSolidityInterface(src)
+	require.NoError(t, err)
+
+	expected := `[
+		{
+			"type": "event",
+			"name": "Transfer",
+			"inputs": [
+				{"name": "from", "type": "address", "indexed": true},
+				{"name": "to", "type": "address", "indexed": true},
+				{"name": "value", "type": "uint256", "indexed": false}
+			],
+			"anonymous": false
+		},
+		{
+			"type": "function",
+			"name": "transfer",
+			"inputs": [
+				{"name": "to", "type": "address"},
+				{"name": "amount", "type": "uint256"}
+			],
+			"outputs": [
+				{"name": "", "type": "bool"}
+			],
+			"stateMutability": "nonpayable"
+		},
+		{
+			"type": "function",
+			"name": "allowances",
+			"inputs": [
+				{"name": "owner", "type": "address"}
+			],
+			"outputs": [
+				{
+					"name": "",
+					"type": "tuple[]",
+					"internalType": "struct Allowance[]",
+					"components": [
+						{"name": "spender", "type": "address"},
+						{"name": "amount", "type": "uint256"}
+					]
+				}
+			],
+			"stateMutability": "view"
+		}
+	]`
+
+	var expectedJSON, actualJSON interface{}
+	require.NoError(t, json.Unmarshal([]byte(expected), &expectedJSON))
+	require.NoError(t, json.Unmarshal(result, &actualJSON))
+	require.Equal(t, expectedJSON, actualJSON)
+
+	// statements without the interface wrapper
+	result, err = ParseSolidityInterface("function transfer(address,uint256) external returns (bool); function totalSupply() external view returns (uint256);")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(result, &actualJSON))
+	require.Len(t, actualJSON, 2)
+
+	_, err = ParseSolidityInterface("interface IERC20 { function transfer(address,uint256) external returns (bool);")
+	require.Error(t, err)
+}
@@ -0,0 +1,26 @@
+package abi
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/sha3"
+)
+
+// Keccak256 returns the Keccak-256 hash of the concatenated data, the legacy Keccak used by Ethereum,
+// not the standardized SHA3-256, the generated code uses it instead of go-ethereum/crypto.
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+	return h.Sum(nil)
+}
+
+// Keccak256Hash returns the Keccak-256 hash of the concatenated data as a common.Hash
+func Keccak256Hash(data ...[]byte) (hash common.Hash) {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+	h.Sum(hash[:0])
+	return hash
+}
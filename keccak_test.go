@@ -0,0 +1,46 @@
+package abi
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+)
+
+func TestKeccak256(t *testing.T) {
+	corpus := []string{
+		"",
+		"transfer(address,uint256)",
+		"Transfer(address,address,uint256)",
+		"balanceOf(address)",
+		"execute((address,uint256,bytes)[],bytes32)",
+		"(string,uint256)",
+		"EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)",
+		string(make([]byte, 200)), // longer than the rate of the sponge
+	}
+	for _, sig := range corpus {
+		require.Equal(t, crypto.Keccak256([]byte(sig)), Keccak256([]byte(sig)), sig)
+		require.Equal(t, crypto.Keccak256Hash([]byte(sig)), Keccak256Hash([]byte(sig)), sig)
+	}
+
+	// the data is concatenated
+	require.Equal(t, crypto.Keccak256([]byte("\x19\x01"), []byte("ab"), []byte("cd")), Keccak256([]byte("\x19\x01ab"), nil, []byte("cd")))
+	require.Equal(t, crypto.Keccak256Hash(), Keccak256Hash())
+}
+
+func TestRuntimeDependencies(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+
+	// the runtime must not depend on go-ethereum's crypto, directly or through accounts/abi or core/types
+	out, err := exec.Command("go", "list", "-deps", ".").CombinedOutput()
+	require.NoError(t, err, string(out))
+	for _, dep := range strings.Fields(string(out)) {
+		if strings.HasPrefix(dep, "github.com/ethereum/go-ethereum") {
+			require.Contains(t, []string{"github.com/ethereum/go-ethereum/common", "github.com/ethereum/go-ethereum/common/hexutil"}, dep)
+		}
+	}
+}
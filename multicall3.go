@@ -0,0 +1,238 @@
+package abi
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// Multicall3Address is the address Multicall3 is deployed at on most chains
+	Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+	// Aggregate3Selector is the selector of aggregate3((address,bool,bytes)[])
+	Aggregate3Selector = [4]byte{0x82, 0xad, 0x56, 0xcb}
+)
+
+const (
+	Multicall3CallStaticSize   = 96
+	Multicall3ResultStaticSize = 64
+)
+
+var (
+	_ Tuple = (*Multicall3Call)(nil)
+	_ Tuple = (*Multicall3Result)(nil)
+)
+
+// Multicall3Call represents the Call3 tuple of Multicall3
+type Multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// NewMulticall3Call constructs a Multicall3Call from a typed function call
+func NewMulticall3Call(target common.Address, allowFailure bool, call Method) (Multicall3Call, error) {
+	data, err := call.EncodeWithSelector()
+	if err != nil {
+		return Multicall3Call{}, err
+	}
+	return Multicall3Call{
+		Target:       target,
+		AllowFailure: allowFailure,
+		CallData:     data,
+	}, nil
+}
+
+// EncodedSize returns the total encoded size of Multicall3Call
+func (t Multicall3Call) EncodedSize() int {
+	return Multicall3CallStaticSize + SizeBytes(t.CallData)
+}
+
+// EncodeTo encodes Multicall3Call to ABI bytes in the provided buffer
+func (t Multicall3Call) EncodeTo(buf []byte) (int, error) {
+	if _, err := EncodeAddress(t.Target, buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := EncodeBool(t.AllowFailure, buf[32:]); err != nil {
+		return 0, err
+	}
+	ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(Multicall3CallStaticSize))
+	n, err := EncodeBytes(t.CallData, buf[Multicall3CallStaticSize:])
+	if err != nil {
+		return 0, err
+	}
+	return Multicall3CallStaticSize + n, nil
+}
+
+// Encode encodes Multicall3Call to ABI bytes
+func (t Multicall3Call) Encode() ([]byte, error) {
+	buf := make([]byte, t.EncodedSize())
+	if _, err := t.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes Multicall3Call from ABI bytes in the provided buffer
+func (t *Multicall3Call) Decode(data []byte) (int, error) {
+	if len(data) < Multicall3CallStaticSize {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	t.Target, _, err = DecodeAddress(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	t.AllowFailure, _, err = DecodeBool(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	offset, err := DecodeSize(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	if offset != Multicall3CallStaticSize {
+		return 0, ErrInvalidOffsetForDynamicField
+	}
+	var n int
+	t.CallData, n, err = DecodeBytes(data[offset:])
+	if err != nil {
+		return 0, err
+	}
+	return offset + n, nil
+}
+
+// Multicall3Result represents the Result tuple of Multicall3
+type Multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// EncodedSize returns the total encoded size of Multicall3Result
+func (t Multicall3Result) EncodedSize() int {
+	return Multicall3ResultStaticSize + SizeBytes(t.ReturnData)
+}
+
+// EncodeTo encodes Multicall3Result to ABI bytes in the provided buffer
+func (t Multicall3Result) EncodeTo(buf []byte) (int, error) {
+	if _, err := EncodeBool(t.Success, buf[0:]); err != nil {
+		return 0, err
+	}
+	ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(Multicall3ResultStaticSize))
+	n, err := EncodeBytes(t.ReturnData, buf[Multicall3ResultStaticSize:])
+	if err != nil {
+		return 0, err
+	}
+	return Multicall3ResultStaticSize + n, nil
+}
+
+// Encode encodes Multicall3Result to ABI bytes
+func (t Multicall3Result) Encode() ([]byte, error) {
+	buf := make([]byte, t.EncodedSize())
+	if _, err := t.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes Multicall3Result from ABI bytes in the provided buffer
+func (t *Multicall3Result) Decode(data []byte) (int, error) {
+	if len(data) < Multicall3ResultStaticSize {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	t.Success, _, err = DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	offset, err := DecodeSize(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	if offset != Multicall3ResultStaticSize {
+		return 0, ErrInvalidOffsetForDynamicField
+	}
+	var n int
+	t.ReturnData, n, err = DecodeBytes(data[offset:])
+	if err != nil {
+		return 0, err
+	}
+	return offset + n, nil
+}
+
+// BuildMulticall3 encodes the aggregate3 calldata of Multicall3 including function selector
+func BuildMulticall3(calls []Multicall3Call) ([]byte, error) {
+	// selector + offset + length + element offsets
+	size := 4 + 32 + 32 + 32*len(calls)
+	for _, call := range calls {
+		size += call.EncodedSize()
+	}
+
+	result := make([]byte, size)
+	copy(result[:4], Aggregate3Selector[:])
+	binary.BigEndian.PutUint64(result[4+24:4+32], 32)
+
+	buf := result[4+32:]
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(calls)))
+	buf = buf[32:]
+
+	dynamicOffset := 32 * len(calls)
+	for i, call := range calls {
+		binary.BigEndian.PutUint64(buf[i*32+24:i*32+32], uint64(dynamicOffset))
+		n, err := call.EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return nil, err
+		}
+		dynamicOffset += n
+	}
+	return result, nil
+}
+
+// DecodeMulticall3Result decodes the return data of aggregate3
+func DecodeMulticall3Result(data []byte) ([]Multicall3Result, error) {
+	if len(data) < 32 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, err
+	}
+	if offset != 32 {
+		return nil, ErrInvalidOffsetForDynamicField
+	}
+	data = data[offset:]
+
+	if len(data) < 32 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	result := make([]Multicall3Result, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := DecodeSize(data[i*32:])
+		if err != nil {
+			return nil, err
+		}
+		if dynamicOffset != tmp {
+			return nil, ErrInvalidOffsetForSliceElement
+		}
+		n, err := result[i].Decode(data[dynamicOffset:])
+		if err != nil {
+			return nil, err
+		}
+		dynamicOffset += n
+	}
+	return result, nil
+}
@@ -0,0 +1,102 @@
+package abi
+
+import (
+	"bytes"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+var multicall3ABI = []string{
+	"struct Call3 { address target; bool allowFailure; bytes callData }",
+	"struct Result { bool success; bytes returnData }",
+	"function aggregate3(Call3[] calls) payable returns (Result[] returnData)",
+}
+
+// call3 mirrors the Call3 tuple for go-ethereum packing
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// result3 mirrors the Result tuple for go-ethereum packing
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+func TestMulticall3(t *testing.T) {
+	abiJSON, err := ParseHumanReadableABI(multicall3ABI)
+	require.NoError(t, err)
+	abiDef, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	require.NoError(t, err)
+
+	require.Equal(t, Aggregate3Selector, [4]byte(abiDef.Methods["aggregate3"].ID))
+
+	calls := []Multicall3Call{
+		{Target: common.HexToAddress("0x1111111111111111111111111111111111111111"), AllowFailure: true, CallData: []byte{0x70, 0xa0, 0x82, 0x31, 0x01}},
+		{Target: common.HexToAddress("0x2222222222222222222222222222222222222222"), CallData: bytes.Repeat([]byte{0xab}, 68)},
+		{Target: Multicall3Address},
+	}
+
+	encoded, err := BuildMulticall3(calls)
+	require.NoError(t, err)
+
+	args := make([]call3, len(calls))
+	for i, c := range calls {
+		args[i] = call3(c)
+	}
+	expected, err := abiDef.Pack("aggregate3", args)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	// empty batch
+	encoded, err = BuildMulticall3(nil)
+	require.NoError(t, err)
+	expected, err = abiDef.Pack("aggregate3", []call3{})
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	results := []result3{
+		{Success: true, ReturnData: bytes.Repeat([]byte{0x01}, 32)},
+		{Success: false, ReturnData: []byte{0x08, 0xc3, 0x79, 0xa0}},
+		{Success: true, ReturnData: []byte{}},
+	}
+	returnData, err := abiDef.Methods["aggregate3"].Outputs.Pack(results)
+	require.NoError(t, err)
+
+	decoded, err := DecodeMulticall3Result(returnData)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(results))
+	for i, r := range results {
+		require.Equal(t, r.Success, decoded[i].Success)
+		require.Equal(t, r.ReturnData, decoded[i].ReturnData)
+	}
+
+	// truncated return data must not panic
+	for i := 0; i < len(returnData); i += 7 {
+		_, err := DecodeMulticall3Result(returnData[:i])
+		require.Error(t, err)
+	}
+}
+
+func TestNewMulticall3Call(t *testing.T) {
+	call := &BasicCall{Field4: "hello", Field5: []byte{0x01}}
+	expected, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	c, err := NewMulticall3Call(Multicall3Address, true, call)
+	require.NoError(t, err)
+	require.Equal(t, Multicall3Call{Target: Multicall3Address, AllowFailure: true, CallData: expected}, c)
+
+	encoded, err := c.Encode()
+	require.NoError(t, err)
+	var decoded Multicall3Call
+	n, err := decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, c, decoded)
+}
@@ -0,0 +1,99 @@
+package abi
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"unicode/utf8"
+
+	"github.com/holiman/uint256"
+)
+
+// RandomBigInt returns a random integer within the range of a bits wide abi integer
+func RandomBigInt(r *rand.Rand, bits int, signed bool) *big.Int {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	v := new(big.Int).Rand(r, limit)
+	if signed {
+		v.Sub(v, limit.Rsh(limit, 1))
+	}
+	return v
+}
+
+// RandomUint256 returns a random unsigned integer within the range of a bits wide abi integer
+func RandomUint256(r *rand.Rand, bits int) *uint256.Int {
+	var buf [32]byte
+	r.Read(buf[:])
+	v := new(uint256.Int).SetBytes32(buf[:])
+	return v.Rsh(v, uint(256-bits))
+}
+
+// RandomString returns a valid UTF-8 string of at most maxLen runes
+func RandomString(r *rand.Rand, maxLen int) string {
+	runes := make([]rune, r.Intn(maxLen+1))
+	for i := range runes {
+		for {
+			runes[i] = rune(r.Int31n(utf8.MaxRune + 1))
+			if utf8.ValidRune(runes[i]) {
+				break
+			}
+		}
+	}
+	return string(runes)
+}
+
+// RandomBytes returns random bytes of at most maxLen length
+func RandomBytes(r *rand.Rand, maxLen int) []byte {
+	b := make([]byte, r.Intn(maxLen+1))
+	r.Read(b)
+	return b
+}
+
+// RandomLen returns a random slice length of at most maxLen, or 0 when the depth budget is exhausted
+func RandomLen(r *rand.Rand, maxDepth, maxLen int) int {
+	if maxDepth <= 0 {
+		return 0
+	}
+	return r.Intn(maxLen + 1)
+}
+
+// CheckRoundTrip decodes data into v, if it succeeds, the decoded value must encode to
+// the same bytes after another Decode/Encode round trip, for fuzzing the decoders.
+func CheckRoundTrip(v Tuple, data []byte) error {
+	if _, err := v.Decode(data); err != nil {
+		return nil
+	}
+
+	encoded, err := v.Encode()
+	if err != nil {
+		return fmt.Errorf("encode decoded value: %w", err)
+	}
+	if _, err := v.Decode(encoded); err != nil {
+		return fmt.Errorf("decode encoded value: %w", err)
+	}
+	reencoded, err := v.Encode()
+	if err != nil {
+		return fmt.Errorf("encode decoded value: %w", err)
+	}
+	if !bytes.Equal(encoded, reencoded) {
+		return fmt.Errorf("round trip mismatch: %x != %x", encoded, reencoded)
+	}
+	return nil
+}
+
+// FuzzDecode decodes the calldata of selector followed by data with decode, e.g. the generated
+// DecodeBySelector, and checks the round trip of the decoded call. The errors of invalid input
+// are ignored, a panic of the decoder is returned as ErrDecoderPanic, for fuzz harnesses.
+func FuzzDecode(decode func([]byte) (Method, error), selector [4]byte, data []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrDecoderPanic, r)
+		}
+	}()
+
+	call, err := decode(append(selector[:], data...))
+	if err != nil {
+		return nil
+	}
+	return CheckRoundTrip(call, data)
+}
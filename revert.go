@@ -0,0 +1,143 @@
+package abi
+
+import (
+	"fmt"
+	"io"
+)
+
+var (
+	// RevertSelector is the selector of the builtin Error(string) revert
+	RevertSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	// PanicSelector is the selector of the builtin Panic(uint256) revert
+	PanicSelector = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// Well-known panic codes of Panic(uint256) reverts emitted by the Solidity compiler
+const (
+	PanicGeneric               = 0x00
+	PanicAssert                = 0x01
+	PanicOverflow              = 0x11
+	PanicDivisionByZero        = 0x12
+	PanicInvalidEnumValue      = 0x21
+	PanicInvalidStorageArray   = 0x22
+	PanicEmptyArrayPop         = 0x31
+	PanicArrayOutOfBounds      = 0x32
+	PanicOutOfMemory           = 0x41
+	PanicUninitializedFunction = 0x51
+)
+
+// panicReasons describes the well-known panic codes
+var panicReasons = map[uint64]string{
+	PanicGeneric:               "generic compiler panic",
+	PanicAssert:                "assert(false)",
+	PanicOverflow:              "arithmetic underflow or overflow",
+	PanicDivisionByZero:        "division or modulo by zero",
+	PanicInvalidEnumValue:      "invalid enum value",
+	PanicInvalidStorageArray:   "invalid encoded storage byte array",
+	PanicEmptyArrayPop:         "pop on empty array",
+	PanicArrayOutOfBounds:      "array index out of bounds",
+	PanicOutOfMemory:           "out of memory",
+	PanicUninitializedFunction: "call to uninitialized function",
+}
+
+// PanicReason returns the description of a well-known panic code, or "unknown panic code"
+func PanicReason(code uint64) string {
+	if reason, ok := panicReasons[code]; ok {
+		return reason
+	}
+	return "unknown panic code"
+}
+
+// RevertError is the error of a builtin Error(string) or Panic(uint256) revert
+type RevertError struct {
+	// Reason of the Error(string) revert
+	Reason string
+	// Panic is set for Panic(uint256) reverts
+	Panic bool
+	// Code of the Panic(uint256) revert
+	Code uint64
+}
+
+func (e *RevertError) Error() string {
+	if e.Panic {
+		return fmt.Sprintf("execution reverted: panic 0x%02x (%s)", e.Code, PanicReason(e.Code))
+	}
+	return "execution reverted: " + e.Reason
+}
+
+// UnpackRevert decodes the reason of an Error(string) revert,
+// returns ErrSelectorMismatch if data is not an Error(string) revert.
+func UnpackRevert(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != RevertSelector {
+		return "", ErrSelectorMismatch
+	}
+	data = data[4:]
+
+	if len(data) < 32 {
+		return "", io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return "", err
+	}
+	if offset < 32 || offset > len(data) {
+		return "", ErrInvalidOffsetForDynamicField
+	}
+
+	reason, _, err := DecodeString(data[offset:])
+	if err != nil {
+		return "", err
+	}
+	return reason, nil
+}
+
+// UnpackPanic decodes the code of a Panic(uint256) revert,
+// returns ErrSelectorMismatch if data is not a Panic(uint256) revert.
+func UnpackPanic(data []byte) (uint64, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PanicSelector {
+		return 0, ErrSelectorMismatch
+	}
+	if len(data) < 4+32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return DecodeUint[uint64](data[4:], MaxUint64)
+}
+
+// UnpackRevertError decodes either of the builtin reverts,
+// returns ErrSelectorMismatch if data is neither, e.g. a custom error.
+func UnpackRevertError(data []byte) (*RevertError, error) {
+	if len(data) >= 4 && [4]byte(data[:4]) == PanicSelector {
+		code, err := UnpackPanic(data)
+		if err != nil {
+			return nil, err
+		}
+		return &RevertError{Panic: true, Code: code}, nil
+	}
+
+	reason, err := UnpackRevert(data)
+	if err != nil {
+		return nil, err
+	}
+	return &RevertError{Reason: reason}, nil
+}
+
+// DecodeRevertReason decodes the reason of an Error(string) revert,
+// returns false if data is not a valid Error(string) revert.
+func DecodeRevertReason(data []byte) (string, bool) {
+	reason, err := UnpackRevert(data)
+	return reason, err == nil
+}
+
+// DecodePanic decodes the code of a Panic(uint256) revert,
+// returns false if data is not a valid Panic(uint256) revert.
+func DecodePanic(data []byte) (uint64, bool) {
+	code, err := UnpackPanic(data)
+	return code, err == nil
+}
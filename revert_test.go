@@ -0,0 +1,191 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+)
+
+func TestRevertSelectors(t *testing.T) {
+	require.Equal(t, RevertSelector, [4]byte(crypto.Keccak256([]byte("Error(string)"))[:4]))
+	require.Equal(t, PanicSelector, [4]byte(crypto.Keccak256([]byte("Panic(uint256)"))[:4]))
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	// revert("Not enough Ether provided.")
+	data, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000001a" +
+		"4e6f7420656e6f7567682045746865722070726f76696465642e000000000000")
+	require.NoError(t, err)
+
+	reason, ok := DecodeRevertReason(data)
+	require.True(t, ok)
+	require.Equal(t, "Not enough Ether provided.", reason)
+
+	_, ok = DecodePanic(data)
+	require.False(t, ok)
+
+	// truncated
+	_, ok = DecodeRevertReason(data[:len(data)-1])
+	require.False(t, ok)
+
+	// invalid offset
+	invalid := append([]byte{}, data...)
+	invalid[4+31] = 0xff
+	_, ok = DecodeRevertReason(invalid)
+	require.False(t, ok)
+
+	_, ok = DecodeRevertReason(nil)
+	require.False(t, ok)
+}
+
+func TestDecodePanic(t *testing.T) {
+	// arithmetic overflow
+	data, err := hex.DecodeString("4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	require.NoError(t, err)
+
+	code, ok := DecodePanic(data)
+	require.True(t, ok)
+	require.Equal(t, uint64(PanicOverflow), code)
+
+	_, ok = DecodeRevertReason(data)
+	require.False(t, ok)
+
+	// truncated
+	_, ok = DecodePanic(data[:35])
+	require.False(t, ok)
+
+	// code doesn't fit uint64
+	data[4] = 0x01
+	_, ok = DecodePanic(data)
+	require.False(t, ok)
+}
+
+func TestUnpackRevertError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected *RevertError
+		message  string
+	}{
+		{
+			// require(false, "Ownable: caller is not the owner")
+			"reason",
+			"08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572",
+			&RevertError{Reason: "Ownable: caller is not the owner"},
+			"execution reverted: Ownable: caller is not the owner",
+		},
+		{
+			// revert("")
+			"empty reason",
+			"08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000000",
+			&RevertError{},
+			"execution reverted: ",
+		},
+		{
+			// 1 / 0
+			"division by zero",
+			"4e487b71" +
+				"0000000000000000000000000000000000000000000000000000000000000012",
+			&RevertError{Panic: true, Code: PanicDivisionByZero},
+			"execution reverted: panic 0x12 (division or modulo by zero)",
+		},
+		{
+			// array index out of bounds
+			"out of bounds",
+			"4e487b71" +
+				"0000000000000000000000000000000000000000000000000000000000000032",
+			&RevertError{Panic: true, Code: PanicArrayOutOfBounds},
+			"execution reverted: panic 0x32 (array index out of bounds)",
+		},
+		{
+			"unknown panic code",
+			"4e487b71" +
+				"00000000000000000000000000000000000000000000000000000000000000ff",
+			&RevertError{Panic: true, Code: 0xff},
+			"execution reverted: panic 0xff (unknown panic code)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.data)
+			require.NoError(t, err)
+
+			revert, err := UnpackRevertError(data)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, revert)
+			require.Equal(t, tc.message, revert.Error())
+		})
+	}
+}
+
+func TestUnpackRevertMalformed(t *testing.T) {
+	reason, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000001a" +
+		"4e6f7420656e6f7567682045746865722070726f76696465642e000000000000")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected error
+	}{
+		{"empty", nil, io.ErrUnexpectedEOF},
+		{"custom error", []byte{0x01, 0x02, 0x03, 0x04}, ErrSelectorMismatch},
+		{"selector only", reason[:4], io.ErrUnexpectedEOF},
+		{"truncated reason", reason[:len(reason)-1], io.ErrUnexpectedEOF},
+		{"offset too small", patch(reason, 4+31, 0x00), ErrInvalidOffsetForDynamicField},
+		{"offset out of range", patch(reason, 4+31, 0xff), ErrInvalidOffsetForDynamicField},
+		{"non-canonical offset", patch(reason, 4, 0x01), ErrNonCanonicalSize},
+		{"huge length", patch(reason, 4+32+24, 0x7f), ErrSizeTooLarge},
+		{"length beyond data", patch(reason, 4+32+28, 0x01), io.ErrUnexpectedEOF},
+		{"dirty padding", patch(reason, len(reason)-1, 0x01), ErrDirtyPadding},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := UnpackRevertError(tc.data)
+			require.True(t, errors.Is(err, tc.expected), "%v", err)
+		})
+	}
+
+	panicData, err := hex.DecodeString("4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011")
+	require.NoError(t, err)
+
+	_, err = UnpackPanic(panicData[:35])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	_, err = UnpackPanic(patch(panicData, 4, 0x01))
+	require.Equal(t, ErrDirtyPadding, err)
+	_, err = UnpackPanic(reason)
+	require.Equal(t, ErrSelectorMismatch, err)
+
+	// no prefix of the payloads panics
+	for _, data := range [][]byte{reason, panicData} {
+		for i := range data {
+			require.NotPanics(t, func() {
+				_, _ = UnpackRevertError(data[:i])
+			})
+		}
+	}
+}
+
+// patch returns a copy of data with the byte at i replaced
+func patch(data []byte, i int, b byte) []byte {
+	data = bytes.Clone(data)
+	data[i] = b
+	return data
+}
@@ -0,0 +1,163 @@
+package abi
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// elementaryTypeRegex matches an elementary type with its size and array dimensions, e.g. uint256[2][]
+var elementaryTypeRegex = regexp.MustCompile(`^(address|bool|string|function|bytes|uint|int)(\d*)((?:\[\d*\])*)$`)
+
+// CanonicalSignature returns the canonical signature `name(type1,type2)` of a single
+// human-readable function, event or error definition, e.g.
+// "function transfer(address to, uint256 amount) returns (bool)" -> "transfer(address,uint256)".
+func CanonicalSignature(signature string) (string, error) {
+	item, err := parseLineWithStructs(strings.TrimSpace(signature), nil)
+	if err != nil {
+		return "", err
+	}
+
+	name, _ := item["name"].(string)
+	if name == "" {
+		return "", fmt.Errorf("signature has no name: %s", signature)
+	}
+	inputs, err := itemParameters(item["inputs"])
+	if err != nil {
+		return "", err
+	}
+	params, err := canonicalParameters(inputs)
+	if err != nil {
+		return "", err
+	}
+	return name + params, nil
+}
+
+// ComputeSelector computes the 4 bytes selector of a function or error signature,
+// the "function" keyword is optional, e.g. "transfer(address,uint256)".
+func ComputeSelector(signature string) ([4]byte, error) {
+	signature = strings.TrimSpace(signature)
+	if !strings.HasPrefix(signature, "function ") && !strings.HasPrefix(signature, "error ") {
+		signature = "function " + signature
+	}
+
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return [4]byte(Keccak256([]byte(canonical))[:4]), nil
+}
+
+// ComputeEventTopic0 computes the first topic of an event signature,
+// the "event" keyword is optional, e.g. "Transfer(address indexed from, address indexed to, uint256 value)".
+func ComputeEventTopic0(signature string) (common.Hash, error) {
+	signature = strings.TrimSpace(signature)
+	if !strings.HasPrefix(signature, "event ") {
+		signature = "event " + signature
+	}
+
+	canonical, err := CanonicalSignature(signature)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return Keccak256Hash([]byte(canonical)), nil
+}
+
+// canonicalParameters returns the canonical parameter list `(type1,type2)`
+func canonicalParameters(params []map[string]interface{}) (string, error) {
+	types := make([]string, len(params))
+	for i, param := range params {
+		typ, err := canonicalType(param)
+		if err != nil {
+			return "", err
+		}
+		types[i] = typ
+	}
+	return "(" + strings.Join(types, ",") + ")", nil
+}
+
+// canonicalType returns the canonical type of a parameter, tuples are expanded to their components
+func canonicalType(param map[string]interface{}) (string, error) {
+	typ, _ := param["type"].(string)
+	if suffix, ok := strings.CutPrefix(typ, "tuple"); ok {
+		components, err := itemParameters(param["components"])
+		if err != nil {
+			return "", err
+		}
+		params, err := canonicalParameters(components)
+		if err != nil {
+			return "", err
+		}
+		return params + suffix, nil
+	}
+
+	// validate the elementary type, unknown struct references are rejected here
+	if err := checkElementaryType(typ); err != nil {
+		return "", err
+	}
+	return typ, nil
+}
+
+// checkElementaryType validates an elementary type with optional array dimensions, the integers
+// are 8 to 256 bits in steps of 8 and the fixed bytes 1 to 32 bytes
+func checkElementaryType(typ string) error {
+	matches := elementaryTypeRegex.FindStringSubmatch(typ)
+	if matches == nil {
+		return fmt.Errorf("invalid type '%s'", typ)
+	}
+
+	base, sizeStr := matches[1], matches[2]
+	if sizeStr == "" {
+		if base == "uint" || base == "int" {
+			return fmt.Errorf("unsupported type '%s', the integer size is required", typ)
+		}
+		return nil
+	}
+	size, err := strconv.Atoi(sizeStr)
+	switch {
+	case err != nil:
+		return fmt.Errorf("invalid size of type '%s': %w", typ, err)
+	case base == "uint" || base == "int":
+		if size == 0 || size%8 != 0 || size > 256 {
+			return fmt.Errorf("unsupported type '%s', the integer size must be a multiple of 8 up to 256", typ)
+		}
+	case base == "bytes":
+		if size == 0 || size > 32 {
+			return fmt.Errorf("unsupported type '%s', the fixed bytes size must be between 1 and 32", typ)
+		}
+	default:
+		return fmt.Errorf("invalid type '%s'", typ)
+	}
+	return nil
+}
+
+// itemParameters returns the parameters of an ABI item, parsed from a human-readable line or decoded from JSON
+func itemParameters(v interface{}) ([]map[string]interface{}, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		params := make([]map[string]interface{}, len(v))
+		for i, p := range v {
+			param, ok := p.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid parameter #%d: %v", i+1, p)
+			}
+			params[i] = param
+		}
+		return params, nil
+	default:
+		return nil, fmt.Errorf("invalid parameters: %v", v)
+	}
+}
+
+// TypedDataDigest returns the EIP-712 digest to sign, keccak256("\x19\x01" ‖ domainSeparator ‖ structHash),
+// the domain separator is the hashStruct of the EIP712Domain, e.g. from the generated HashStruct.
+func TypedDataDigest(domainSeparator [32]byte, structHash [32]byte) [32]byte {
+	return Keccak256Hash([]byte("\x19\x01"), domainSeparator[:], structHash[:])
+}
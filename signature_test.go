@@ -0,0 +1,57 @@
+package abi
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+func TestComputeSelector(t *testing.T) {
+	testCases := []struct {
+		signature string
+		canonical string
+		selector  string
+	}{
+		{"transfer(address,uint256)", "transfer(address,uint256)", "a9059cbb"},
+		{"function transfer(address to, uint256 amount) returns (bool)", "transfer(address,uint256)", "a9059cbb"},
+		{"balanceOf(address)", "balanceOf(address)", "70a08231"},
+		{"function approve(address spender, uint amount)", "approve(address,uint256)", "095ea7b3"},
+		{"totalSupply()", "totalSupply()", "18160ddd"},
+		{"aggregate3((address target, bool allowFailure, bytes callData)[] calls)", "aggregate3((address,bool,bytes)[])", "82ad56cb"},
+		{"error Error(string)", "Error(string)", "08c379a0"},
+		{"error Panic(uint256 code)", "Panic(uint256)", "4e487b71"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.signature, func(t *testing.T) {
+			selector, err := ComputeSelector(tc.signature)
+			require.NoError(t, err)
+			require.Equal(t, tc.selector, hex.EncodeToString(selector[:]))
+		})
+	}
+
+	canonical, err := CanonicalSignature("function transfer(address to, uint256 amount) returns (bool)")
+	require.NoError(t, err)
+	require.Equal(t, "transfer(address,uint256)", canonical)
+
+	_, err = ComputeSelector("transfer(address")
+	require.Error(t, err)
+
+	_, err = ComputeSelector("transfer(foo)")
+	require.Error(t, err)
+}
+
+func TestComputeEventTopic0(t *testing.T) {
+	topic, err := ComputeEventTopic0("Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"), topic)
+
+	topic, err = ComputeEventTopic0("event Approval(address indexed owner, address indexed spender, uint256 value)")
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"), topic)
+
+	_, err = ComputeEventTopic0("Transfer(address indexed from")
+	require.Error(t, err)
+}
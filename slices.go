@@ -0,0 +1,119 @@
+package abi
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// The generic helpers below encode and decode the slices of tuples, the generated code calls them with the
+// Compact option instead of inlining the loops. PT is the pointer to the tuple struct, so they work with both
+// value and pointer receivers.
+
+// EncodeStaticSlice encodes the slice of static tuples to buf, the length followed by the elements of
+// elemSize bytes each, returns the number of bytes written.
+func EncodeStaticSlice[T any, PT interface {
+	*T
+	Encode
+}](buf []byte, items []T, elemSize int) (int, error) {
+	ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(items)))
+	buf = buf[32:]
+
+	for i := range items {
+		if _, err := PT(&items[i]).EncodeTo(buf[i*elemSize:]); err != nil {
+			return 0, err
+		}
+	}
+	return len(items)*elemSize + 32, nil
+}
+
+// EncodeDynamicSlice encodes the slice of dynamic tuples to buf, the length followed by the offset table
+// and the tails of the elements, returns the number of bytes written.
+func EncodeDynamicSlice[T any, PT interface {
+	*T
+	Encode
+}](buf []byte, items []T) (int, error) {
+	ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(items)))
+	buf = buf[32:]
+
+	dynamicOffset := len(items) * 32
+	for i := range items {
+		ClearWord(buf[i*32:])
+		binary.BigEndian.PutUint64(buf[i*32+24:i*32+32], uint64(dynamicOffset))
+
+		n, err := PT(&items[i]).EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset + 32, nil
+}
+
+// DecodeStaticSlice decodes the slice of static tuples of elemSize bytes each, reusing the backing array of dst,
+// returns the number of bytes read.
+func DecodeStaticSlice[T any, PT interface {
+	*T
+	Decode
+}](dst []T, data []byte, elemSize int) ([]T, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/elemSize {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	result := ResizeSlice(dst, length)
+	var offset int
+	for i := range result {
+		n, err := PT(&result[i]).Decode(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += n
+	}
+	return result, offset + 32, nil
+}
+
+// DecodeDynamicSlice decodes the slice of dynamic tuples, checking the offset table, reusing the backing
+// array of dst, returns the number of bytes read.
+func DecodeDynamicSlice[T any, PT interface {
+	*T
+	Decode
+}](dst []T, data []byte) ([]T, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	result := ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := range result {
+		offset, err := DecodeSize(data[i*32:])
+		if err != nil {
+			return nil, 0, err
+		}
+		if dynamicOffset != offset {
+			return nil, 0, ErrInvalidOffsetForSliceElement
+		}
+		n, err := PT(&result[i]).Decode(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
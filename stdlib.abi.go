@@ -1,11 +1,12 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: 92779fde441cfd1ac35fb31b2ac727fcf7b8d6575f330bec2459389ddc40c4dc
 
 package abi
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -29,8 +30,16 @@ const (
 	IntsID  = 2049564248
 )
 
+// Canonical function signatures
+const (
+	BasicSignature = "basic(bool,address,bytes32,string,bytes,bool[],address[],bytes32[],string[],bytes[])"
+	BytesSignature = "bytes(bytes1,bytes2,bytes3,bytes4,bytes5,bytes6,bytes7,bytes8,bytes9,bytes10,bytes11,bytes12,bytes13,bytes14,bytes15,bytes16,bytes17,bytes18,bytes19,bytes20,bytes21,bytes22,bytes23,bytes24,bytes25,bytes26,bytes27,bytes28,bytes29,bytes30,bytes31,bytes32,bytes1[],bytes2[],bytes3[],bytes4[],bytes5[],bytes6[],bytes7[],bytes8[],bytes9[],bytes10[],bytes11[],bytes12[],bytes13[],bytes14[],bytes15[],bytes16[],bytes17[],bytes18[],bytes19[],bytes20[],bytes21[],bytes22[],bytes23[],bytes24[],bytes25[],bytes26[],bytes27[],bytes28[],bytes29[],bytes30[],bytes31[],bytes32[])"
+	IntsSignature  = "ints(uint8,int8,uint16,int16,uint24,int24,uint32,int32,uint40,int40,uint48,int48,uint56,int56,uint64,int64,uint72,int72,uint80,int80,uint88,int88,uint96,int96,uint104,int104,uint112,int112,uint120,int120,uint128,int128,uint136,int136,uint144,int144,uint152,int152,uint160,int160,uint168,int168,uint176,int176,uint184,int184,uint192,int192,uint200,int200,uint208,int208,uint216,int216,uint224,int224,uint232,int232,uint240,int240,uint248,int248,uint256,int256,uint8[],int8[],uint16[],int16[],uint24[],int24[],uint32[],int32[],uint40[],int40[],uint48[],int48[],uint56[],int56[],uint64[],int64[],uint72[],int72[],uint80[],int80[],uint88[],int88[],uint96[],int96[],uint104[],int104[],uint112[],int112[],uint120[],int120[],uint128[],int128[],uint136[],int136[],uint144[],int144[],uint152[],int152[],uint160[],int160[],uint168[],int168[],uint176[],int176[],uint184[],int184[],uint192[],int192[],uint200[],int200[],uint208[],int208[],uint216[],int216[],uint224[],int224[],uint232[],int232[],uint240[],int240[],uint248[],int248[],uint256[],int256[])"
+)
+
 // EncodeAddress encodes address to ABI bytes
 func EncodeAddress(value common.Address, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[12:32], value[:])
 	return 32, nil
 }
@@ -38,6 +47,7 @@ func EncodeAddress(value common.Address, buf []byte) (int, error) {
 // EncodeAddressSlice encodes address[] to ABI bytes
 func EncodeAddressSlice(value []common.Address, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -56,6 +66,7 @@ func EncodeAddressSlice(value []common.Address, buf []byte) (int, error) {
 
 // EncodeBool encodes bool to ABI bytes
 func EncodeBool(value bool, buf []byte) (int, error) {
+	ClearWord(buf)
 	if value {
 		buf[31] = 1
 	}
@@ -65,6 +76,7 @@ func EncodeBool(value bool, buf []byte) (int, error) {
 // EncodeBoolSlice encodes bool[] to ABI bytes
 func EncodeBoolSlice(value []bool, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -84,22 +96,26 @@ func EncodeBoolSlice(value []bool, buf []byte) (int, error) {
 // EncodeBytes encodes bytes to ABI bytes
 func EncodeBytes(value []byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 
-	// Encode data
+	// Encode data and zero the padding
 	copy(buf[32:], value)
+	clear(buf[32+len(value) : 32+Pad32(len(value))])
 
 	return 32 + Pad32(len(value)), nil
 }
 
 // EncodeBytes1 encodes bytes1 to ABI bytes
 func EncodeBytes1(value [1]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:1], value[:])
 	return 1, nil
 }
 
 // EncodeBytes10 encodes bytes10 to ABI bytes
 func EncodeBytes10(value [10]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:10], value[:])
 	return 10, nil
 }
@@ -107,6 +123,7 @@ func EncodeBytes10(value [10]byte, buf []byte) (int, error) {
 // EncodeBytes10Slice encodes bytes10[] to ABI bytes
 func EncodeBytes10Slice(value [][10]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -125,6 +142,7 @@ func EncodeBytes10Slice(value [][10]byte, buf []byte) (int, error) {
 
 // EncodeBytes11 encodes bytes11 to ABI bytes
 func EncodeBytes11(value [11]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:11], value[:])
 	return 11, nil
 }
@@ -132,6 +150,7 @@ func EncodeBytes11(value [11]byte, buf []byte) (int, error) {
 // EncodeBytes11Slice encodes bytes11[] to ABI bytes
 func EncodeBytes11Slice(value [][11]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -150,6 +169,7 @@ func EncodeBytes11Slice(value [][11]byte, buf []byte) (int, error) {
 
 // EncodeBytes12 encodes bytes12 to ABI bytes
 func EncodeBytes12(value [12]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:12], value[:])
 	return 12, nil
 }
@@ -157,6 +177,7 @@ func EncodeBytes12(value [12]byte, buf []byte) (int, error) {
 // EncodeBytes12Slice encodes bytes12[] to ABI bytes
 func EncodeBytes12Slice(value [][12]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -175,6 +196,7 @@ func EncodeBytes12Slice(value [][12]byte, buf []byte) (int, error) {
 
 // EncodeBytes13 encodes bytes13 to ABI bytes
 func EncodeBytes13(value [13]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:13], value[:])
 	return 13, nil
 }
@@ -182,6 +204,7 @@ func EncodeBytes13(value [13]byte, buf []byte) (int, error) {
 // EncodeBytes13Slice encodes bytes13[] to ABI bytes
 func EncodeBytes13Slice(value [][13]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -200,6 +223,7 @@ func EncodeBytes13Slice(value [][13]byte, buf []byte) (int, error) {
 
 // EncodeBytes14 encodes bytes14 to ABI bytes
 func EncodeBytes14(value [14]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:14], value[:])
 	return 14, nil
 }
@@ -207,6 +231,7 @@ func EncodeBytes14(value [14]byte, buf []byte) (int, error) {
 // EncodeBytes14Slice encodes bytes14[] to ABI bytes
 func EncodeBytes14Slice(value [][14]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -225,6 +250,7 @@ func EncodeBytes14Slice(value [][14]byte, buf []byte) (int, error) {
 
 // EncodeBytes15 encodes bytes15 to ABI bytes
 func EncodeBytes15(value [15]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:15], value[:])
 	return 15, nil
 }
@@ -232,6 +258,7 @@ func EncodeBytes15(value [15]byte, buf []byte) (int, error) {
 // EncodeBytes15Slice encodes bytes15[] to ABI bytes
 func EncodeBytes15Slice(value [][15]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -250,6 +277,7 @@ func EncodeBytes15Slice(value [][15]byte, buf []byte) (int, error) {
 
 // EncodeBytes16 encodes bytes16 to ABI bytes
 func EncodeBytes16(value [16]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:16], value[:])
 	return 16, nil
 }
@@ -257,6 +285,7 @@ func EncodeBytes16(value [16]byte, buf []byte) (int, error) {
 // EncodeBytes16Slice encodes bytes16[] to ABI bytes
 func EncodeBytes16Slice(value [][16]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -275,6 +304,7 @@ func EncodeBytes16Slice(value [][16]byte, buf []byte) (int, error) {
 
 // EncodeBytes17 encodes bytes17 to ABI bytes
 func EncodeBytes17(value [17]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:17], value[:])
 	return 17, nil
 }
@@ -282,6 +312,7 @@ func EncodeBytes17(value [17]byte, buf []byte) (int, error) {
 // EncodeBytes17Slice encodes bytes17[] to ABI bytes
 func EncodeBytes17Slice(value [][17]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -300,6 +331,7 @@ func EncodeBytes17Slice(value [][17]byte, buf []byte) (int, error) {
 
 // EncodeBytes18 encodes bytes18 to ABI bytes
 func EncodeBytes18(value [18]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:18], value[:])
 	return 18, nil
 }
@@ -307,6 +339,7 @@ func EncodeBytes18(value [18]byte, buf []byte) (int, error) {
 // EncodeBytes18Slice encodes bytes18[] to ABI bytes
 func EncodeBytes18Slice(value [][18]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -325,6 +358,7 @@ func EncodeBytes18Slice(value [][18]byte, buf []byte) (int, error) {
 
 // EncodeBytes19 encodes bytes19 to ABI bytes
 func EncodeBytes19(value [19]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:19], value[:])
 	return 19, nil
 }
@@ -332,6 +366,7 @@ func EncodeBytes19(value [19]byte, buf []byte) (int, error) {
 // EncodeBytes19Slice encodes bytes19[] to ABI bytes
 func EncodeBytes19Slice(value [][19]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -351,6 +386,7 @@ func EncodeBytes19Slice(value [][19]byte, buf []byte) (int, error) {
 // EncodeBytes1Slice encodes bytes1[] to ABI bytes
 func EncodeBytes1Slice(value [][1]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -369,12 +405,14 @@ func EncodeBytes1Slice(value [][1]byte, buf []byte) (int, error) {
 
 // EncodeBytes2 encodes bytes2 to ABI bytes
 func EncodeBytes2(value [2]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:2], value[:])
 	return 2, nil
 }
 
 // EncodeBytes20 encodes bytes20 to ABI bytes
 func EncodeBytes20(value [20]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:20], value[:])
 	return 20, nil
 }
@@ -382,6 +420,7 @@ func EncodeBytes20(value [20]byte, buf []byte) (int, error) {
 // EncodeBytes20Slice encodes bytes20[] to ABI bytes
 func EncodeBytes20Slice(value [][20]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -400,6 +439,7 @@ func EncodeBytes20Slice(value [][20]byte, buf []byte) (int, error) {
 
 // EncodeBytes21 encodes bytes21 to ABI bytes
 func EncodeBytes21(value [21]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:21], value[:])
 	return 21, nil
 }
@@ -407,6 +447,7 @@ func EncodeBytes21(value [21]byte, buf []byte) (int, error) {
 // EncodeBytes21Slice encodes bytes21[] to ABI bytes
 func EncodeBytes21Slice(value [][21]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -425,6 +466,7 @@ func EncodeBytes21Slice(value [][21]byte, buf []byte) (int, error) {
 
 // EncodeBytes22 encodes bytes22 to ABI bytes
 func EncodeBytes22(value [22]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:22], value[:])
 	return 22, nil
 }
@@ -432,6 +474,7 @@ func EncodeBytes22(value [22]byte, buf []byte) (int, error) {
 // EncodeBytes22Slice encodes bytes22[] to ABI bytes
 func EncodeBytes22Slice(value [][22]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -450,6 +493,7 @@ func EncodeBytes22Slice(value [][22]byte, buf []byte) (int, error) {
 
 // EncodeBytes23 encodes bytes23 to ABI bytes
 func EncodeBytes23(value [23]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:23], value[:])
 	return 23, nil
 }
@@ -457,6 +501,7 @@ func EncodeBytes23(value [23]byte, buf []byte) (int, error) {
 // EncodeBytes23Slice encodes bytes23[] to ABI bytes
 func EncodeBytes23Slice(value [][23]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -475,6 +520,7 @@ func EncodeBytes23Slice(value [][23]byte, buf []byte) (int, error) {
 
 // EncodeBytes24 encodes bytes24 to ABI bytes
 func EncodeBytes24(value [24]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:24], value[:])
 	return 24, nil
 }
@@ -482,6 +528,7 @@ func EncodeBytes24(value [24]byte, buf []byte) (int, error) {
 // EncodeBytes24Slice encodes bytes24[] to ABI bytes
 func EncodeBytes24Slice(value [][24]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -500,6 +547,7 @@ func EncodeBytes24Slice(value [][24]byte, buf []byte) (int, error) {
 
 // EncodeBytes25 encodes bytes25 to ABI bytes
 func EncodeBytes25(value [25]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:25], value[:])
 	return 25, nil
 }
@@ -507,6 +555,7 @@ func EncodeBytes25(value [25]byte, buf []byte) (int, error) {
 // EncodeBytes25Slice encodes bytes25[] to ABI bytes
 func EncodeBytes25Slice(value [][25]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -525,6 +574,7 @@ func EncodeBytes25Slice(value [][25]byte, buf []byte) (int, error) {
 
 // EncodeBytes26 encodes bytes26 to ABI bytes
 func EncodeBytes26(value [26]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:26], value[:])
 	return 26, nil
 }
@@ -532,6 +582,7 @@ func EncodeBytes26(value [26]byte, buf []byte) (int, error) {
 // EncodeBytes26Slice encodes bytes26[] to ABI bytes
 func EncodeBytes26Slice(value [][26]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -550,6 +601,7 @@ func EncodeBytes26Slice(value [][26]byte, buf []byte) (int, error) {
 
 // EncodeBytes27 encodes bytes27 to ABI bytes
 func EncodeBytes27(value [27]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:27], value[:])
 	return 27, nil
 }
@@ -557,6 +609,7 @@ func EncodeBytes27(value [27]byte, buf []byte) (int, error) {
 // EncodeBytes27Slice encodes bytes27[] to ABI bytes
 func EncodeBytes27Slice(value [][27]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -575,6 +628,7 @@ func EncodeBytes27Slice(value [][27]byte, buf []byte) (int, error) {
 
 // EncodeBytes28 encodes bytes28 to ABI bytes
 func EncodeBytes28(value [28]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:28], value[:])
 	return 28, nil
 }
@@ -582,6 +636,7 @@ func EncodeBytes28(value [28]byte, buf []byte) (int, error) {
 // EncodeBytes28Slice encodes bytes28[] to ABI bytes
 func EncodeBytes28Slice(value [][28]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -600,6 +655,7 @@ func EncodeBytes28Slice(value [][28]byte, buf []byte) (int, error) {
 
 // EncodeBytes29 encodes bytes29 to ABI bytes
 func EncodeBytes29(value [29]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:29], value[:])
 	return 29, nil
 }
@@ -607,6 +663,7 @@ func EncodeBytes29(value [29]byte, buf []byte) (int, error) {
 // EncodeBytes29Slice encodes bytes29[] to ABI bytes
 func EncodeBytes29Slice(value [][29]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -626,6 +683,7 @@ func EncodeBytes29Slice(value [][29]byte, buf []byte) (int, error) {
 // EncodeBytes2Slice encodes bytes2[] to ABI bytes
 func EncodeBytes2Slice(value [][2]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -644,12 +702,14 @@ func EncodeBytes2Slice(value [][2]byte, buf []byte) (int, error) {
 
 // EncodeBytes3 encodes bytes3 to ABI bytes
 func EncodeBytes3(value [3]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:3], value[:])
 	return 3, nil
 }
 
 // EncodeBytes30 encodes bytes30 to ABI bytes
 func EncodeBytes30(value [30]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:30], value[:])
 	return 30, nil
 }
@@ -657,6 +717,7 @@ func EncodeBytes30(value [30]byte, buf []byte) (int, error) {
 // EncodeBytes30Slice encodes bytes30[] to ABI bytes
 func EncodeBytes30Slice(value [][30]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -675,6 +736,7 @@ func EncodeBytes30Slice(value [][30]byte, buf []byte) (int, error) {
 
 // EncodeBytes31 encodes bytes31 to ABI bytes
 func EncodeBytes31(value [31]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:31], value[:])
 	return 31, nil
 }
@@ -682,6 +744,7 @@ func EncodeBytes31(value [31]byte, buf []byte) (int, error) {
 // EncodeBytes31Slice encodes bytes31[] to ABI bytes
 func EncodeBytes31Slice(value [][31]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -700,6 +763,7 @@ func EncodeBytes31Slice(value [][31]byte, buf []byte) (int, error) {
 
 // EncodeBytes32 encodes bytes32 to ABI bytes
 func EncodeBytes32(value [32]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:32], value[:])
 	return 32, nil
 }
@@ -707,6 +771,7 @@ func EncodeBytes32(value [32]byte, buf []byte) (int, error) {
 // EncodeBytes32Slice encodes bytes32[] to ABI bytes
 func EncodeBytes32Slice(value [][32]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -726,6 +791,7 @@ func EncodeBytes32Slice(value [][32]byte, buf []byte) (int, error) {
 // EncodeBytes3Slice encodes bytes3[] to ABI bytes
 func EncodeBytes3Slice(value [][3]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -744,6 +810,7 @@ func EncodeBytes3Slice(value [][3]byte, buf []byte) (int, error) {
 
 // EncodeBytes4 encodes bytes4 to ABI bytes
 func EncodeBytes4(value [4]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:4], value[:])
 	return 4, nil
 }
@@ -751,6 +818,7 @@ func EncodeBytes4(value [4]byte, buf []byte) (int, error) {
 // EncodeBytes4Slice encodes bytes4[] to ABI bytes
 func EncodeBytes4Slice(value [][4]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -769,6 +837,7 @@ func EncodeBytes4Slice(value [][4]byte, buf []byte) (int, error) {
 
 // EncodeBytes5 encodes bytes5 to ABI bytes
 func EncodeBytes5(value [5]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:5], value[:])
 	return 5, nil
 }
@@ -776,6 +845,7 @@ func EncodeBytes5(value [5]byte, buf []byte) (int, error) {
 // EncodeBytes5Slice encodes bytes5[] to ABI bytes
 func EncodeBytes5Slice(value [][5]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -794,6 +864,7 @@ func EncodeBytes5Slice(value [][5]byte, buf []byte) (int, error) {
 
 // EncodeBytes6 encodes bytes6 to ABI bytes
 func EncodeBytes6(value [6]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:6], value[:])
 	return 6, nil
 }
@@ -801,6 +872,7 @@ func EncodeBytes6(value [6]byte, buf []byte) (int, error) {
 // EncodeBytes6Slice encodes bytes6[] to ABI bytes
 func EncodeBytes6Slice(value [][6]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -819,6 +891,7 @@ func EncodeBytes6Slice(value [][6]byte, buf []byte) (int, error) {
 
 // EncodeBytes7 encodes bytes7 to ABI bytes
 func EncodeBytes7(value [7]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:7], value[:])
 	return 7, nil
 }
@@ -826,6 +899,7 @@ func EncodeBytes7(value [7]byte, buf []byte) (int, error) {
 // EncodeBytes7Slice encodes bytes7[] to ABI bytes
 func EncodeBytes7Slice(value [][7]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -844,6 +918,7 @@ func EncodeBytes7Slice(value [][7]byte, buf []byte) (int, error) {
 
 // EncodeBytes8 encodes bytes8 to ABI bytes
 func EncodeBytes8(value [8]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:8], value[:])
 	return 8, nil
 }
@@ -851,6 +926,7 @@ func EncodeBytes8(value [8]byte, buf []byte) (int, error) {
 // EncodeBytes8Slice encodes bytes8[] to ABI bytes
 func EncodeBytes8Slice(value [][8]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -869,6 +945,7 @@ func EncodeBytes8Slice(value [][8]byte, buf []byte) (int, error) {
 
 // EncodeBytes9 encodes bytes9 to ABI bytes
 func EncodeBytes9(value [9]byte, buf []byte) (int, error) {
+	ClearWord(buf)
 	copy(buf[:9], value[:])
 	return 9, nil
 }
@@ -876,6 +953,7 @@ func EncodeBytes9(value [9]byte, buf []byte) (int, error) {
 // EncodeBytes9Slice encodes bytes9[] to ABI bytes
 func EncodeBytes9Slice(value [][9]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -895,6 +973,7 @@ func EncodeBytes9Slice(value [][9]byte, buf []byte) (int, error) {
 // EncodeBytesSlice encodes bytes[] to ABI bytes
 func EncodeBytesSlice(value [][]byte, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -903,6 +982,7 @@ func EncodeBytesSlice(value [][]byte, buf []byte) (int, error) {
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -919,6 +999,7 @@ func EncodeBytesSlice(value [][]byte, buf []byte) (int, error) {
 
 // EncodeInt104 encodes int104 to ABI bytes
 func EncodeInt104(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -928,6 +1009,7 @@ func EncodeInt104(value *big.Int, buf []byte) (int, error) {
 // EncodeInt104Slice encodes int104[] to ABI bytes
 func EncodeInt104Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -946,6 +1028,7 @@ func EncodeInt104Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt112 encodes int112 to ABI bytes
 func EncodeInt112(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -955,6 +1038,7 @@ func EncodeInt112(value *big.Int, buf []byte) (int, error) {
 // EncodeInt112Slice encodes int112[] to ABI bytes
 func EncodeInt112Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -973,6 +1057,7 @@ func EncodeInt112Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt120 encodes int120 to ABI bytes
 func EncodeInt120(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -982,6 +1067,7 @@ func EncodeInt120(value *big.Int, buf []byte) (int, error) {
 // EncodeInt120Slice encodes int120[] to ABI bytes
 func EncodeInt120Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1000,6 +1086,7 @@ func EncodeInt120Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt128 encodes int128 to ABI bytes
 func EncodeInt128(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1009,6 +1096,7 @@ func EncodeInt128(value *big.Int, buf []byte) (int, error) {
 // EncodeInt128Slice encodes int128[] to ABI bytes
 func EncodeInt128Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1027,6 +1115,7 @@ func EncodeInt128Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt136 encodes int136 to ABI bytes
 func EncodeInt136(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1036,6 +1125,7 @@ func EncodeInt136(value *big.Int, buf []byte) (int, error) {
 // EncodeInt136Slice encodes int136[] to ABI bytes
 func EncodeInt136Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1054,6 +1144,7 @@ func EncodeInt136Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt144 encodes int144 to ABI bytes
 func EncodeInt144(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1063,6 +1154,7 @@ func EncodeInt144(value *big.Int, buf []byte) (int, error) {
 // EncodeInt144Slice encodes int144[] to ABI bytes
 func EncodeInt144Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1081,6 +1173,7 @@ func EncodeInt144Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt152 encodes int152 to ABI bytes
 func EncodeInt152(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1090,6 +1183,7 @@ func EncodeInt152(value *big.Int, buf []byte) (int, error) {
 // EncodeInt152Slice encodes int152[] to ABI bytes
 func EncodeInt152Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1108,6 +1202,7 @@ func EncodeInt152Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt16 encodes int16 to ABI bytes
 func EncodeInt16(value int16, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint16(buf[30:32], uint16(value))
 	if value < 0 {
 		copy(buf, PaddingBytes16)
@@ -1117,6 +1212,7 @@ func EncodeInt16(value int16, buf []byte) (int, error) {
 
 // EncodeInt160 encodes int160 to ABI bytes
 func EncodeInt160(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1126,6 +1222,7 @@ func EncodeInt160(value *big.Int, buf []byte) (int, error) {
 // EncodeInt160Slice encodes int160[] to ABI bytes
 func EncodeInt160Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1144,6 +1241,7 @@ func EncodeInt160Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt168 encodes int168 to ABI bytes
 func EncodeInt168(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1153,6 +1251,7 @@ func EncodeInt168(value *big.Int, buf []byte) (int, error) {
 // EncodeInt168Slice encodes int168[] to ABI bytes
 func EncodeInt168Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1172,6 +1271,7 @@ func EncodeInt168Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeInt16Slice encodes int16[] to ABI bytes
 func EncodeInt16Slice(value []int16, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1190,6 +1290,7 @@ func EncodeInt16Slice(value []int16, buf []byte) (int, error) {
 
 // EncodeInt176 encodes int176 to ABI bytes
 func EncodeInt176(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1199,6 +1300,7 @@ func EncodeInt176(value *big.Int, buf []byte) (int, error) {
 // EncodeInt176Slice encodes int176[] to ABI bytes
 func EncodeInt176Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1217,6 +1319,7 @@ func EncodeInt176Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt184 encodes int184 to ABI bytes
 func EncodeInt184(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1226,6 +1329,7 @@ func EncodeInt184(value *big.Int, buf []byte) (int, error) {
 // EncodeInt184Slice encodes int184[] to ABI bytes
 func EncodeInt184Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1244,6 +1348,7 @@ func EncodeInt184Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt192 encodes int192 to ABI bytes
 func EncodeInt192(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1253,6 +1358,7 @@ func EncodeInt192(value *big.Int, buf []byte) (int, error) {
 // EncodeInt192Slice encodes int192[] to ABI bytes
 func EncodeInt192Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1271,6 +1377,7 @@ func EncodeInt192Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt200 encodes int200 to ABI bytes
 func EncodeInt200(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1280,6 +1387,7 @@ func EncodeInt200(value *big.Int, buf []byte) (int, error) {
 // EncodeInt200Slice encodes int200[] to ABI bytes
 func EncodeInt200Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1298,6 +1406,7 @@ func EncodeInt200Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt208 encodes int208 to ABI bytes
 func EncodeInt208(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1307,6 +1416,7 @@ func EncodeInt208(value *big.Int, buf []byte) (int, error) {
 // EncodeInt208Slice encodes int208[] to ABI bytes
 func EncodeInt208Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1325,6 +1435,7 @@ func EncodeInt208Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt216 encodes int216 to ABI bytes
 func EncodeInt216(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1334,6 +1445,7 @@ func EncodeInt216(value *big.Int, buf []byte) (int, error) {
 // EncodeInt216Slice encodes int216[] to ABI bytes
 func EncodeInt216Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1352,6 +1464,7 @@ func EncodeInt216Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt224 encodes int224 to ABI bytes
 func EncodeInt224(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1361,6 +1474,7 @@ func EncodeInt224(value *big.Int, buf []byte) (int, error) {
 // EncodeInt224Slice encodes int224[] to ABI bytes
 func EncodeInt224Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1379,6 +1493,7 @@ func EncodeInt224Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt232 encodes int232 to ABI bytes
 func EncodeInt232(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1388,6 +1503,7 @@ func EncodeInt232(value *big.Int, buf []byte) (int, error) {
 // EncodeInt232Slice encodes int232[] to ABI bytes
 func EncodeInt232Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1406,6 +1522,7 @@ func EncodeInt232Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt24 encodes int24 to ABI bytes
 func EncodeInt24(value int32, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
 	if value < 0 {
 		copy(buf, PaddingBytes32)
@@ -1415,6 +1532,7 @@ func EncodeInt24(value int32, buf []byte) (int, error) {
 
 // EncodeInt240 encodes int240 to ABI bytes
 func EncodeInt240(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1424,6 +1542,7 @@ func EncodeInt240(value *big.Int, buf []byte) (int, error) {
 // EncodeInt240Slice encodes int240[] to ABI bytes
 func EncodeInt240Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1442,6 +1561,7 @@ func EncodeInt240Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt248 encodes int248 to ABI bytes
 func EncodeInt248(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1451,6 +1571,7 @@ func EncodeInt248(value *big.Int, buf []byte) (int, error) {
 // EncodeInt248Slice encodes int248[] to ABI bytes
 func EncodeInt248Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1470,6 +1591,7 @@ func EncodeInt248Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeInt24Slice encodes int24[] to ABI bytes
 func EncodeInt24Slice(value []int32, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1488,6 +1610,7 @@ func EncodeInt24Slice(value []int32, buf []byte) (int, error) {
 
 // EncodeInt256 encodes int256 to ABI bytes
 func EncodeInt256(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1497,6 +1620,7 @@ func EncodeInt256(value *big.Int, buf []byte) (int, error) {
 // EncodeInt256Slice encodes int256[] to ABI bytes
 func EncodeInt256Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1515,6 +1639,7 @@ func EncodeInt256Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt32 encodes int32 to ABI bytes
 func EncodeInt32(value int32, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
 	if value < 0 {
 		copy(buf, PaddingBytes32)
@@ -1525,6 +1650,7 @@ func EncodeInt32(value int32, buf []byte) (int, error) {
 // EncodeInt32Slice encodes int32[] to ABI bytes
 func EncodeInt32Slice(value []int32, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1543,6 +1669,7 @@ func EncodeInt32Slice(value []int32, buf []byte) (int, error) {
 
 // EncodeInt40 encodes int40 to ABI bytes
 func EncodeInt40(value int64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	if value < 0 {
 		copy(buf, PaddingBytes64)
@@ -1553,6 +1680,7 @@ func EncodeInt40(value int64, buf []byte) (int, error) {
 // EncodeInt40Slice encodes int40[] to ABI bytes
 func EncodeInt40Slice(value []int64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1571,6 +1699,7 @@ func EncodeInt40Slice(value []int64, buf []byte) (int, error) {
 
 // EncodeInt48 encodes int48 to ABI bytes
 func EncodeInt48(value int64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	if value < 0 {
 		copy(buf, PaddingBytes64)
@@ -1581,6 +1710,7 @@ func EncodeInt48(value int64, buf []byte) (int, error) {
 // EncodeInt48Slice encodes int48[] to ABI bytes
 func EncodeInt48Slice(value []int64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1599,6 +1729,7 @@ func EncodeInt48Slice(value []int64, buf []byte) (int, error) {
 
 // EncodeInt56 encodes int56 to ABI bytes
 func EncodeInt56(value int64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	if value < 0 {
 		copy(buf, PaddingBytes64)
@@ -1609,6 +1740,7 @@ func EncodeInt56(value int64, buf []byte) (int, error) {
 // EncodeInt56Slice encodes int56[] to ABI bytes
 func EncodeInt56Slice(value []int64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1627,6 +1759,7 @@ func EncodeInt56Slice(value []int64, buf []byte) (int, error) {
 
 // EncodeInt64 encodes int64 to ABI bytes
 func EncodeInt64(value int64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	if value < 0 {
 		copy(buf, PaddingBytes64)
@@ -1637,6 +1770,7 @@ func EncodeInt64(value int64, buf []byte) (int, error) {
 // EncodeInt64Slice encodes int64[] to ABI bytes
 func EncodeInt64Slice(value []int64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1655,6 +1789,7 @@ func EncodeInt64Slice(value []int64, buf []byte) (int, error) {
 
 // EncodeInt72 encodes int72 to ABI bytes
 func EncodeInt72(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1664,6 +1799,7 @@ func EncodeInt72(value *big.Int, buf []byte) (int, error) {
 // EncodeInt72Slice encodes int72[] to ABI bytes
 func EncodeInt72Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1682,6 +1818,7 @@ func EncodeInt72Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt8 encodes int8 to ABI bytes
 func EncodeInt8(value int8, buf []byte) (int, error) {
+	ClearWord(buf)
 	buf[31] = byte(value)
 	if value < 0 {
 		copy(buf, PaddingBytes8)
@@ -1691,6 +1828,7 @@ func EncodeInt8(value int8, buf []byte) (int, error) {
 
 // EncodeInt80 encodes int80 to ABI bytes
 func EncodeInt80(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1700,6 +1838,7 @@ func EncodeInt80(value *big.Int, buf []byte) (int, error) {
 // EncodeInt80Slice encodes int80[] to ABI bytes
 func EncodeInt80Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1718,6 +1857,7 @@ func EncodeInt80Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeInt88 encodes int88 to ABI bytes
 func EncodeInt88(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1727,6 +1867,7 @@ func EncodeInt88(value *big.Int, buf []byte) (int, error) {
 // EncodeInt88Slice encodes int88[] to ABI bytes
 func EncodeInt88Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1746,6 +1887,7 @@ func EncodeInt88Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeInt8Slice encodes int8[] to ABI bytes
 func EncodeInt8Slice(value []int8, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1764,6 +1906,7 @@ func EncodeInt8Slice(value []int8, buf []byte) (int, error) {
 
 // EncodeInt96 encodes int96 to ABI bytes
 func EncodeInt96(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], true); err != nil {
 		return 0, err
 	}
@@ -1773,6 +1916,7 @@ func EncodeInt96(value *big.Int, buf []byte) (int, error) {
 // EncodeInt96Slice encodes int96[] to ABI bytes
 func EncodeInt96Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1792,10 +1936,12 @@ func EncodeInt96Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeString encodes string to ABI bytes
 func EncodeString(value string, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 
-	// Encode data
+	// Encode data and zero the padding
 	copy(buf[32:], []byte(value))
+	clear(buf[32+len(value) : 32+Pad32(len(value))])
 
 	return 32 + Pad32(len(value)), nil
 }
@@ -1803,6 +1949,7 @@ func EncodeString(value string, buf []byte) (int, error) {
 // EncodeStringSlice encodes string[] to ABI bytes
 func EncodeStringSlice(value []string, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1811,6 +1958,7 @@ func EncodeStringSlice(value []string, buf []byte) (int, error) {
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -1827,6 +1975,7 @@ func EncodeStringSlice(value []string, buf []byte) (int, error) {
 
 // EncodeUint104 encodes uint104 to ABI bytes
 func EncodeUint104(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1836,6 +1985,7 @@ func EncodeUint104(value *big.Int, buf []byte) (int, error) {
 // EncodeUint104Slice encodes uint104[] to ABI bytes
 func EncodeUint104Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1854,6 +2004,7 @@ func EncodeUint104Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint112 encodes uint112 to ABI bytes
 func EncodeUint112(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1863,6 +2014,7 @@ func EncodeUint112(value *big.Int, buf []byte) (int, error) {
 // EncodeUint112Slice encodes uint112[] to ABI bytes
 func EncodeUint112Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1881,6 +2033,7 @@ func EncodeUint112Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint120 encodes uint120 to ABI bytes
 func EncodeUint120(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1890,6 +2043,7 @@ func EncodeUint120(value *big.Int, buf []byte) (int, error) {
 // EncodeUint120Slice encodes uint120[] to ABI bytes
 func EncodeUint120Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1908,6 +2062,7 @@ func EncodeUint120Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint128 encodes uint128 to ABI bytes
 func EncodeUint128(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1917,6 +2072,7 @@ func EncodeUint128(value *big.Int, buf []byte) (int, error) {
 // EncodeUint128Slice encodes uint128[] to ABI bytes
 func EncodeUint128Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1935,6 +2091,7 @@ func EncodeUint128Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint136 encodes uint136 to ABI bytes
 func EncodeUint136(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1944,6 +2101,7 @@ func EncodeUint136(value *big.Int, buf []byte) (int, error) {
 // EncodeUint136Slice encodes uint136[] to ABI bytes
 func EncodeUint136Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1962,6 +2120,7 @@ func EncodeUint136Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint144 encodes uint144 to ABI bytes
 func EncodeUint144(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1971,6 +2130,7 @@ func EncodeUint144(value *big.Int, buf []byte) (int, error) {
 // EncodeUint144Slice encodes uint144[] to ABI bytes
 func EncodeUint144Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -1989,6 +2149,7 @@ func EncodeUint144Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint152 encodes uint152 to ABI bytes
 func EncodeUint152(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -1998,6 +2159,7 @@ func EncodeUint152(value *big.Int, buf []byte) (int, error) {
 // EncodeUint152Slice encodes uint152[] to ABI bytes
 func EncodeUint152Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2016,12 +2178,14 @@ func EncodeUint152Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint16 encodes uint16 to ABI bytes
 func EncodeUint16(value uint16, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint16(buf[30:32], uint16(value))
 	return 32, nil
 }
 
 // EncodeUint160 encodes uint160 to ABI bytes
 func EncodeUint160(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2031,6 +2195,7 @@ func EncodeUint160(value *big.Int, buf []byte) (int, error) {
 // EncodeUint160Slice encodes uint160[] to ABI bytes
 func EncodeUint160Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2049,6 +2214,7 @@ func EncodeUint160Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint168 encodes uint168 to ABI bytes
 func EncodeUint168(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2058,6 +2224,7 @@ func EncodeUint168(value *big.Int, buf []byte) (int, error) {
 // EncodeUint168Slice encodes uint168[] to ABI bytes
 func EncodeUint168Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2077,6 +2244,7 @@ func EncodeUint168Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeUint16Slice encodes uint16[] to ABI bytes
 func EncodeUint16Slice(value []uint16, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2095,6 +2263,7 @@ func EncodeUint16Slice(value []uint16, buf []byte) (int, error) {
 
 // EncodeUint176 encodes uint176 to ABI bytes
 func EncodeUint176(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2104,6 +2273,7 @@ func EncodeUint176(value *big.Int, buf []byte) (int, error) {
 // EncodeUint176Slice encodes uint176[] to ABI bytes
 func EncodeUint176Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2122,6 +2292,7 @@ func EncodeUint176Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint184 encodes uint184 to ABI bytes
 func EncodeUint184(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2131,6 +2302,7 @@ func EncodeUint184(value *big.Int, buf []byte) (int, error) {
 // EncodeUint184Slice encodes uint184[] to ABI bytes
 func EncodeUint184Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2149,6 +2321,7 @@ func EncodeUint184Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint192 encodes uint192 to ABI bytes
 func EncodeUint192(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2158,6 +2331,7 @@ func EncodeUint192(value *big.Int, buf []byte) (int, error) {
 // EncodeUint192Slice encodes uint192[] to ABI bytes
 func EncodeUint192Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2176,6 +2350,7 @@ func EncodeUint192Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint200 encodes uint200 to ABI bytes
 func EncodeUint200(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2185,6 +2360,7 @@ func EncodeUint200(value *big.Int, buf []byte) (int, error) {
 // EncodeUint200Slice encodes uint200[] to ABI bytes
 func EncodeUint200Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2203,6 +2379,7 @@ func EncodeUint200Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint208 encodes uint208 to ABI bytes
 func EncodeUint208(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2212,6 +2389,7 @@ func EncodeUint208(value *big.Int, buf []byte) (int, error) {
 // EncodeUint208Slice encodes uint208[] to ABI bytes
 func EncodeUint208Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2230,6 +2408,7 @@ func EncodeUint208Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint216 encodes uint216 to ABI bytes
 func EncodeUint216(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2239,6 +2418,7 @@ func EncodeUint216(value *big.Int, buf []byte) (int, error) {
 // EncodeUint216Slice encodes uint216[] to ABI bytes
 func EncodeUint216Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2257,6 +2437,7 @@ func EncodeUint216Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint224 encodes uint224 to ABI bytes
 func EncodeUint224(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2266,6 +2447,7 @@ func EncodeUint224(value *big.Int, buf []byte) (int, error) {
 // EncodeUint224Slice encodes uint224[] to ABI bytes
 func EncodeUint224Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2284,6 +2466,7 @@ func EncodeUint224Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint232 encodes uint232 to ABI bytes
 func EncodeUint232(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2293,6 +2476,7 @@ func EncodeUint232(value *big.Int, buf []byte) (int, error) {
 // EncodeUint232Slice encodes uint232[] to ABI bytes
 func EncodeUint232Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2311,12 +2495,14 @@ func EncodeUint232Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint24 encodes uint24 to ABI bytes
 func EncodeUint24(value uint32, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
 	return 32, nil
 }
 
 // EncodeUint240 encodes uint240 to ABI bytes
 func EncodeUint240(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2326,6 +2512,7 @@ func EncodeUint240(value *big.Int, buf []byte) (int, error) {
 // EncodeUint240Slice encodes uint240[] to ABI bytes
 func EncodeUint240Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2344,6 +2531,7 @@ func EncodeUint240Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint248 encodes uint248 to ABI bytes
 func EncodeUint248(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2353,6 +2541,7 @@ func EncodeUint248(value *big.Int, buf []byte) (int, error) {
 // EncodeUint248Slice encodes uint248[] to ABI bytes
 func EncodeUint248Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2372,6 +2561,7 @@ func EncodeUint248Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeUint24Slice encodes uint24[] to ABI bytes
 func EncodeUint24Slice(value []uint32, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2390,6 +2580,7 @@ func EncodeUint24Slice(value []uint32, buf []byte) (int, error) {
 
 // EncodeUint256 encodes uint256 to ABI bytes
 func EncodeUint256(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2399,6 +2590,7 @@ func EncodeUint256(value *big.Int, buf []byte) (int, error) {
 // EncodeUint256Slice encodes uint256[] to ABI bytes
 func EncodeUint256Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2417,6 +2609,7 @@ func EncodeUint256Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint32 encodes uint32 to ABI bytes
 func EncodeUint32(value uint32, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
 	return 32, nil
 }
@@ -2424,6 +2617,7 @@ func EncodeUint32(value uint32, buf []byte) (int, error) {
 // EncodeUint32Slice encodes uint32[] to ABI bytes
 func EncodeUint32Slice(value []uint32, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2442,6 +2636,7 @@ func EncodeUint32Slice(value []uint32, buf []byte) (int, error) {
 
 // EncodeUint40 encodes uint40 to ABI bytes
 func EncodeUint40(value uint64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	return 32, nil
 }
@@ -2449,6 +2644,7 @@ func EncodeUint40(value uint64, buf []byte) (int, error) {
 // EncodeUint40Slice encodes uint40[] to ABI bytes
 func EncodeUint40Slice(value []uint64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2467,6 +2663,7 @@ func EncodeUint40Slice(value []uint64, buf []byte) (int, error) {
 
 // EncodeUint48 encodes uint48 to ABI bytes
 func EncodeUint48(value uint64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	return 32, nil
 }
@@ -2474,6 +2671,7 @@ func EncodeUint48(value uint64, buf []byte) (int, error) {
 // EncodeUint48Slice encodes uint48[] to ABI bytes
 func EncodeUint48Slice(value []uint64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2492,6 +2690,7 @@ func EncodeUint48Slice(value []uint64, buf []byte) (int, error) {
 
 // EncodeUint56 encodes uint56 to ABI bytes
 func EncodeUint56(value uint64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	return 32, nil
 }
@@ -2499,6 +2698,7 @@ func EncodeUint56(value uint64, buf []byte) (int, error) {
 // EncodeUint56Slice encodes uint56[] to ABI bytes
 func EncodeUint56Slice(value []uint64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2517,6 +2717,7 @@ func EncodeUint56Slice(value []uint64, buf []byte) (int, error) {
 
 // EncodeUint64 encodes uint64 to ABI bytes
 func EncodeUint64(value uint64, buf []byte) (int, error) {
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
 	return 32, nil
 }
@@ -2524,6 +2725,7 @@ func EncodeUint64(value uint64, buf []byte) (int, error) {
 // EncodeUint64Slice encodes uint64[] to ABI bytes
 func EncodeUint64Slice(value []uint64, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2542,6 +2744,7 @@ func EncodeUint64Slice(value []uint64, buf []byte) (int, error) {
 
 // EncodeUint72 encodes uint72 to ABI bytes
 func EncodeUint72(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2551,6 +2754,7 @@ func EncodeUint72(value *big.Int, buf []byte) (int, error) {
 // EncodeUint72Slice encodes uint72[] to ABI bytes
 func EncodeUint72Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2569,12 +2773,14 @@ func EncodeUint72Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint8 encodes uint8 to ABI bytes
 func EncodeUint8(value uint8, buf []byte) (int, error) {
+	ClearWord(buf)
 	buf[31] = byte(value)
 	return 32, nil
 }
 
 // EncodeUint80 encodes uint80 to ABI bytes
 func EncodeUint80(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2584,6 +2790,7 @@ func EncodeUint80(value *big.Int, buf []byte) (int, error) {
 // EncodeUint80Slice encodes uint80[] to ABI bytes
 func EncodeUint80Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2602,6 +2809,7 @@ func EncodeUint80Slice(value []*big.Int, buf []byte) (int, error) {
 
 // EncodeUint88 encodes uint88 to ABI bytes
 func EncodeUint88(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2611,6 +2819,7 @@ func EncodeUint88(value *big.Int, buf []byte) (int, error) {
 // EncodeUint88Slice encodes uint88[] to ABI bytes
 func EncodeUint88Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2630,6 +2839,7 @@ func EncodeUint88Slice(value []*big.Int, buf []byte) (int, error) {
 // EncodeUint8Slice encodes uint8[] to ABI bytes
 func EncodeUint8Slice(value []uint8, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -2648,6 +2858,7 @@ func EncodeUint8Slice(value []uint8, buf []byte) (int, error) {
 
 // EncodeUint96 encodes uint96 to ABI bytes
 func EncodeUint96(value *big.Int, buf []byte) (int, error) {
+	ClearWord(buf)
 	if err := EncodeBigInt(value, buf[:32], false); err != nil {
 		return 0, err
 	}
@@ -2657,6 +2868,7 @@ func EncodeUint96(value *big.Int, buf []byte) (int, error) {
 // EncodeUint96Slice encodes uint96[] to ABI bytes
 func EncodeUint96Slice(value []*big.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -3294,10 +3506,8 @@ func SizeUint96Slice(value []*big.Int) int {
 // DecodeAddress decodes address from ABI bytes
 func DecodeAddress(data []byte) (common.Address, int, error) {
 	var result common.Address
-	for i := 0; i < 12; i++ {
-		if data[i] != 0x00 {
-			return result, 0, ErrDirtyPadding
-		}
+	if binary.BigEndian.Uint64(data[0:8]) != 0 || binary.BigEndian.Uint32(data[8:12]) != 0 {
+		return result, 0, ErrDirtyPadding
 	}
 	copy(result[:], data[12:32])
 	return result, 32, nil
@@ -3305,6 +3515,11 @@ func DecodeAddress(data []byte) (common.Address, int, error) {
 
 // DecodeAddressSlice decodes address[] from ABI bytes
 func DecodeAddressSlice(data []byte) ([]common.Address, int, error) {
+	return DecodeIntoAddressSlice(nil, data)
+}
+
+// DecodeIntoAddressSlice decodes address[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoAddressSlice(dst []common.Address, data []byte) ([]common.Address, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3314,7 +3529,7 @@ func DecodeAddressSlice(data []byte) ([]common.Address, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3322,7 +3537,7 @@ func DecodeAddressSlice(data []byte) ([]common.Address, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]common.Address, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeAddress(data[offset:])
 		if err != nil {
@@ -3336,12 +3551,10 @@ func DecodeAddressSlice(data []byte) ([]common.Address, int, error) {
 // DecodeBool decodes bool from ABI bytes
 func DecodeBool(data []byte) (bool, int, error) {
 	// Validate boolean encoding - only 0 or 1 are valid
-	for _, i := range data[:31] {
-		if i != 0 {
-			return false, 0, ErrDirtyPadding
-		}
+	if binary.BigEndian.Uint64(data[0:8])|binary.BigEndian.Uint64(data[8:16])|binary.BigEndian.Uint64(data[16:24]) != 0 {
+		return false, 0, ErrDirtyPadding
 	}
-	switch data[31] {
+	switch binary.BigEndian.Uint64(data[24:32]) {
 	case 0x01:
 		return true, 32, nil
 	case 0x00:
@@ -3353,6 +3566,11 @@ func DecodeBool(data []byte) (bool, int, error) {
 
 // DecodeBoolSlice decodes bool[] from ABI bytes
 func DecodeBoolSlice(data []byte) ([]bool, int, error) {
+	return DecodeIntoBoolSlice(nil, data)
+}
+
+// DecodeIntoBoolSlice decodes bool[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBoolSlice(dst []bool, data []byte) ([]bool, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3362,7 +3580,7 @@ func DecodeBoolSlice(data []byte) ([]bool, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3370,7 +3588,7 @@ func DecodeBoolSlice(data []byte) ([]bool, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]bool, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBool(data[offset:])
 		if err != nil {
@@ -3392,6 +3610,10 @@ func DecodeBytes(data []byte) ([]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
+	// check the length first, Pad32 overflows near MaxInt
+	if length > len(data) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
 	paddedLength := Pad32(length)
 	if len(data) < paddedLength {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3435,6 +3657,11 @@ func DecodeBytes10(data []byte) ([10]byte, int, error) {
 
 // DecodeBytes10Slice decodes bytes10[] from ABI bytes
 func DecodeBytes10Slice(data []byte) ([][10]byte, int, error) {
+	return DecodeIntoBytes10Slice(nil, data)
+}
+
+// DecodeIntoBytes10Slice decodes bytes10[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes10Slice(dst [][10]byte, data []byte) ([][10]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3444,7 +3671,7 @@ func DecodeBytes10Slice(data []byte) ([][10]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3452,7 +3679,7 @@ func DecodeBytes10Slice(data []byte) ([][10]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][10]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes10(data[offset:])
 		if err != nil {
@@ -3478,6 +3705,11 @@ func DecodeBytes11(data []byte) ([11]byte, int, error) {
 
 // DecodeBytes11Slice decodes bytes11[] from ABI bytes
 func DecodeBytes11Slice(data []byte) ([][11]byte, int, error) {
+	return DecodeIntoBytes11Slice(nil, data)
+}
+
+// DecodeIntoBytes11Slice decodes bytes11[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes11Slice(dst [][11]byte, data []byte) ([][11]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3487,7 +3719,7 @@ func DecodeBytes11Slice(data []byte) ([][11]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3495,7 +3727,7 @@ func DecodeBytes11Slice(data []byte) ([][11]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][11]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes11(data[offset:])
 		if err != nil {
@@ -3521,6 +3753,11 @@ func DecodeBytes12(data []byte) ([12]byte, int, error) {
 
 // DecodeBytes12Slice decodes bytes12[] from ABI bytes
 func DecodeBytes12Slice(data []byte) ([][12]byte, int, error) {
+	return DecodeIntoBytes12Slice(nil, data)
+}
+
+// DecodeIntoBytes12Slice decodes bytes12[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes12Slice(dst [][12]byte, data []byte) ([][12]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3530,7 +3767,7 @@ func DecodeBytes12Slice(data []byte) ([][12]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3538,7 +3775,7 @@ func DecodeBytes12Slice(data []byte) ([][12]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][12]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes12(data[offset:])
 		if err != nil {
@@ -3564,6 +3801,11 @@ func DecodeBytes13(data []byte) ([13]byte, int, error) {
 
 // DecodeBytes13Slice decodes bytes13[] from ABI bytes
 func DecodeBytes13Slice(data []byte) ([][13]byte, int, error) {
+	return DecodeIntoBytes13Slice(nil, data)
+}
+
+// DecodeIntoBytes13Slice decodes bytes13[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes13Slice(dst [][13]byte, data []byte) ([][13]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3573,7 +3815,7 @@ func DecodeBytes13Slice(data []byte) ([][13]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3581,7 +3823,7 @@ func DecodeBytes13Slice(data []byte) ([][13]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][13]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes13(data[offset:])
 		if err != nil {
@@ -3607,6 +3849,11 @@ func DecodeBytes14(data []byte) ([14]byte, int, error) {
 
 // DecodeBytes14Slice decodes bytes14[] from ABI bytes
 func DecodeBytes14Slice(data []byte) ([][14]byte, int, error) {
+	return DecodeIntoBytes14Slice(nil, data)
+}
+
+// DecodeIntoBytes14Slice decodes bytes14[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes14Slice(dst [][14]byte, data []byte) ([][14]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3616,7 +3863,7 @@ func DecodeBytes14Slice(data []byte) ([][14]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3624,7 +3871,7 @@ func DecodeBytes14Slice(data []byte) ([][14]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][14]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes14(data[offset:])
 		if err != nil {
@@ -3650,6 +3897,11 @@ func DecodeBytes15(data []byte) ([15]byte, int, error) {
 
 // DecodeBytes15Slice decodes bytes15[] from ABI bytes
 func DecodeBytes15Slice(data []byte) ([][15]byte, int, error) {
+	return DecodeIntoBytes15Slice(nil, data)
+}
+
+// DecodeIntoBytes15Slice decodes bytes15[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes15Slice(dst [][15]byte, data []byte) ([][15]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3659,7 +3911,7 @@ func DecodeBytes15Slice(data []byte) ([][15]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3667,7 +3919,7 @@ func DecodeBytes15Slice(data []byte) ([][15]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][15]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes15(data[offset:])
 		if err != nil {
@@ -3693,6 +3945,11 @@ func DecodeBytes16(data []byte) ([16]byte, int, error) {
 
 // DecodeBytes16Slice decodes bytes16[] from ABI bytes
 func DecodeBytes16Slice(data []byte) ([][16]byte, int, error) {
+	return DecodeIntoBytes16Slice(nil, data)
+}
+
+// DecodeIntoBytes16Slice decodes bytes16[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes16Slice(dst [][16]byte, data []byte) ([][16]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3702,7 +3959,7 @@ func DecodeBytes16Slice(data []byte) ([][16]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3710,7 +3967,7 @@ func DecodeBytes16Slice(data []byte) ([][16]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][16]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes16(data[offset:])
 		if err != nil {
@@ -3736,6 +3993,11 @@ func DecodeBytes17(data []byte) ([17]byte, int, error) {
 
 // DecodeBytes17Slice decodes bytes17[] from ABI bytes
 func DecodeBytes17Slice(data []byte) ([][17]byte, int, error) {
+	return DecodeIntoBytes17Slice(nil, data)
+}
+
+// DecodeIntoBytes17Slice decodes bytes17[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes17Slice(dst [][17]byte, data []byte) ([][17]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3745,7 +4007,7 @@ func DecodeBytes17Slice(data []byte) ([][17]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3753,7 +4015,7 @@ func DecodeBytes17Slice(data []byte) ([][17]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][17]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes17(data[offset:])
 		if err != nil {
@@ -3779,6 +4041,11 @@ func DecodeBytes18(data []byte) ([18]byte, int, error) {
 
 // DecodeBytes18Slice decodes bytes18[] from ABI bytes
 func DecodeBytes18Slice(data []byte) ([][18]byte, int, error) {
+	return DecodeIntoBytes18Slice(nil, data)
+}
+
+// DecodeIntoBytes18Slice decodes bytes18[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes18Slice(dst [][18]byte, data []byte) ([][18]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3788,7 +4055,7 @@ func DecodeBytes18Slice(data []byte) ([][18]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3796,7 +4063,7 @@ func DecodeBytes18Slice(data []byte) ([][18]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][18]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes18(data[offset:])
 		if err != nil {
@@ -3822,6 +4089,11 @@ func DecodeBytes19(data []byte) ([19]byte, int, error) {
 
 // DecodeBytes19Slice decodes bytes19[] from ABI bytes
 func DecodeBytes19Slice(data []byte) ([][19]byte, int, error) {
+	return DecodeIntoBytes19Slice(nil, data)
+}
+
+// DecodeIntoBytes19Slice decodes bytes19[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes19Slice(dst [][19]byte, data []byte) ([][19]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3831,7 +4103,7 @@ func DecodeBytes19Slice(data []byte) ([][19]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3839,7 +4111,7 @@ func DecodeBytes19Slice(data []byte) ([][19]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][19]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes19(data[offset:])
 		if err != nil {
@@ -3852,6 +4124,11 @@ func DecodeBytes19Slice(data []byte) ([][19]byte, int, error) {
 
 // DecodeBytes1Slice decodes bytes1[] from ABI bytes
 func DecodeBytes1Slice(data []byte) ([][1]byte, int, error) {
+	return DecodeIntoBytes1Slice(nil, data)
+}
+
+// DecodeIntoBytes1Slice decodes bytes1[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes1Slice(dst [][1]byte, data []byte) ([][1]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3861,7 +4138,7 @@ func DecodeBytes1Slice(data []byte) ([][1]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3869,7 +4146,7 @@ func DecodeBytes1Slice(data []byte) ([][1]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][1]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes1(data[offset:])
 		if err != nil {
@@ -3908,6 +4185,11 @@ func DecodeBytes20(data []byte) ([20]byte, int, error) {
 
 // DecodeBytes20Slice decodes bytes20[] from ABI bytes
 func DecodeBytes20Slice(data []byte) ([][20]byte, int, error) {
+	return DecodeIntoBytes20Slice(nil, data)
+}
+
+// DecodeIntoBytes20Slice decodes bytes20[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes20Slice(dst [][20]byte, data []byte) ([][20]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3917,7 +4199,7 @@ func DecodeBytes20Slice(data []byte) ([][20]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3925,7 +4207,7 @@ func DecodeBytes20Slice(data []byte) ([][20]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][20]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes20(data[offset:])
 		if err != nil {
@@ -3951,6 +4233,11 @@ func DecodeBytes21(data []byte) ([21]byte, int, error) {
 
 // DecodeBytes21Slice decodes bytes21[] from ABI bytes
 func DecodeBytes21Slice(data []byte) ([][21]byte, int, error) {
+	return DecodeIntoBytes21Slice(nil, data)
+}
+
+// DecodeIntoBytes21Slice decodes bytes21[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes21Slice(dst [][21]byte, data []byte) ([][21]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -3960,7 +4247,7 @@ func DecodeBytes21Slice(data []byte) ([][21]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -3968,7 +4255,7 @@ func DecodeBytes21Slice(data []byte) ([][21]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][21]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes21(data[offset:])
 		if err != nil {
@@ -3994,6 +4281,11 @@ func DecodeBytes22(data []byte) ([22]byte, int, error) {
 
 // DecodeBytes22Slice decodes bytes22[] from ABI bytes
 func DecodeBytes22Slice(data []byte) ([][22]byte, int, error) {
+	return DecodeIntoBytes22Slice(nil, data)
+}
+
+// DecodeIntoBytes22Slice decodes bytes22[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes22Slice(dst [][22]byte, data []byte) ([][22]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4003,7 +4295,7 @@ func DecodeBytes22Slice(data []byte) ([][22]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4011,7 +4303,7 @@ func DecodeBytes22Slice(data []byte) ([][22]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][22]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes22(data[offset:])
 		if err != nil {
@@ -4037,6 +4329,11 @@ func DecodeBytes23(data []byte) ([23]byte, int, error) {
 
 // DecodeBytes23Slice decodes bytes23[] from ABI bytes
 func DecodeBytes23Slice(data []byte) ([][23]byte, int, error) {
+	return DecodeIntoBytes23Slice(nil, data)
+}
+
+// DecodeIntoBytes23Slice decodes bytes23[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes23Slice(dst [][23]byte, data []byte) ([][23]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4046,7 +4343,7 @@ func DecodeBytes23Slice(data []byte) ([][23]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4054,7 +4351,7 @@ func DecodeBytes23Slice(data []byte) ([][23]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][23]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes23(data[offset:])
 		if err != nil {
@@ -4080,6 +4377,11 @@ func DecodeBytes24(data []byte) ([24]byte, int, error) {
 
 // DecodeBytes24Slice decodes bytes24[] from ABI bytes
 func DecodeBytes24Slice(data []byte) ([][24]byte, int, error) {
+	return DecodeIntoBytes24Slice(nil, data)
+}
+
+// DecodeIntoBytes24Slice decodes bytes24[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes24Slice(dst [][24]byte, data []byte) ([][24]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4089,7 +4391,7 @@ func DecodeBytes24Slice(data []byte) ([][24]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4097,7 +4399,7 @@ func DecodeBytes24Slice(data []byte) ([][24]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][24]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes24(data[offset:])
 		if err != nil {
@@ -4123,6 +4425,11 @@ func DecodeBytes25(data []byte) ([25]byte, int, error) {
 
 // DecodeBytes25Slice decodes bytes25[] from ABI bytes
 func DecodeBytes25Slice(data []byte) ([][25]byte, int, error) {
+	return DecodeIntoBytes25Slice(nil, data)
+}
+
+// DecodeIntoBytes25Slice decodes bytes25[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes25Slice(dst [][25]byte, data []byte) ([][25]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4132,7 +4439,7 @@ func DecodeBytes25Slice(data []byte) ([][25]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4140,7 +4447,7 @@ func DecodeBytes25Slice(data []byte) ([][25]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][25]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes25(data[offset:])
 		if err != nil {
@@ -4166,6 +4473,11 @@ func DecodeBytes26(data []byte) ([26]byte, int, error) {
 
 // DecodeBytes26Slice decodes bytes26[] from ABI bytes
 func DecodeBytes26Slice(data []byte) ([][26]byte, int, error) {
+	return DecodeIntoBytes26Slice(nil, data)
+}
+
+// DecodeIntoBytes26Slice decodes bytes26[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes26Slice(dst [][26]byte, data []byte) ([][26]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4175,7 +4487,7 @@ func DecodeBytes26Slice(data []byte) ([][26]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4183,7 +4495,7 @@ func DecodeBytes26Slice(data []byte) ([][26]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][26]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes26(data[offset:])
 		if err != nil {
@@ -4209,6 +4521,11 @@ func DecodeBytes27(data []byte) ([27]byte, int, error) {
 
 // DecodeBytes27Slice decodes bytes27[] from ABI bytes
 func DecodeBytes27Slice(data []byte) ([][27]byte, int, error) {
+	return DecodeIntoBytes27Slice(nil, data)
+}
+
+// DecodeIntoBytes27Slice decodes bytes27[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes27Slice(dst [][27]byte, data []byte) ([][27]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4218,7 +4535,7 @@ func DecodeBytes27Slice(data []byte) ([][27]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4226,7 +4543,7 @@ func DecodeBytes27Slice(data []byte) ([][27]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][27]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes27(data[offset:])
 		if err != nil {
@@ -4252,6 +4569,11 @@ func DecodeBytes28(data []byte) ([28]byte, int, error) {
 
 // DecodeBytes28Slice decodes bytes28[] from ABI bytes
 func DecodeBytes28Slice(data []byte) ([][28]byte, int, error) {
+	return DecodeIntoBytes28Slice(nil, data)
+}
+
+// DecodeIntoBytes28Slice decodes bytes28[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes28Slice(dst [][28]byte, data []byte) ([][28]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4261,7 +4583,7 @@ func DecodeBytes28Slice(data []byte) ([][28]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4269,7 +4591,7 @@ func DecodeBytes28Slice(data []byte) ([][28]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][28]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes28(data[offset:])
 		if err != nil {
@@ -4295,6 +4617,11 @@ func DecodeBytes29(data []byte) ([29]byte, int, error) {
 
 // DecodeBytes29Slice decodes bytes29[] from ABI bytes
 func DecodeBytes29Slice(data []byte) ([][29]byte, int, error) {
+	return DecodeIntoBytes29Slice(nil, data)
+}
+
+// DecodeIntoBytes29Slice decodes bytes29[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes29Slice(dst [][29]byte, data []byte) ([][29]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4304,7 +4631,7 @@ func DecodeBytes29Slice(data []byte) ([][29]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4312,7 +4639,7 @@ func DecodeBytes29Slice(data []byte) ([][29]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][29]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes29(data[offset:])
 		if err != nil {
@@ -4325,6 +4652,11 @@ func DecodeBytes29Slice(data []byte) ([][29]byte, int, error) {
 
 // DecodeBytes2Slice decodes bytes2[] from ABI bytes
 func DecodeBytes2Slice(data []byte) ([][2]byte, int, error) {
+	return DecodeIntoBytes2Slice(nil, data)
+}
+
+// DecodeIntoBytes2Slice decodes bytes2[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes2Slice(dst [][2]byte, data []byte) ([][2]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4334,7 +4666,7 @@ func DecodeBytes2Slice(data []byte) ([][2]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4342,7 +4674,7 @@ func DecodeBytes2Slice(data []byte) ([][2]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][2]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes2(data[offset:])
 		if err != nil {
@@ -4381,6 +4713,11 @@ func DecodeBytes30(data []byte) ([30]byte, int, error) {
 
 // DecodeBytes30Slice decodes bytes30[] from ABI bytes
 func DecodeBytes30Slice(data []byte) ([][30]byte, int, error) {
+	return DecodeIntoBytes30Slice(nil, data)
+}
+
+// DecodeIntoBytes30Slice decodes bytes30[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes30Slice(dst [][30]byte, data []byte) ([][30]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4390,7 +4727,7 @@ func DecodeBytes30Slice(data []byte) ([][30]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4398,7 +4735,7 @@ func DecodeBytes30Slice(data []byte) ([][30]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][30]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes30(data[offset:])
 		if err != nil {
@@ -4424,6 +4761,11 @@ func DecodeBytes31(data []byte) ([31]byte, int, error) {
 
 // DecodeBytes31Slice decodes bytes31[] from ABI bytes
 func DecodeBytes31Slice(data []byte) ([][31]byte, int, error) {
+	return DecodeIntoBytes31Slice(nil, data)
+}
+
+// DecodeIntoBytes31Slice decodes bytes31[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes31Slice(dst [][31]byte, data []byte) ([][31]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4433,7 +4775,7 @@ func DecodeBytes31Slice(data []byte) ([][31]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4441,7 +4783,7 @@ func DecodeBytes31Slice(data []byte) ([][31]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][31]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes31(data[offset:])
 		if err != nil {
@@ -4467,6 +4809,11 @@ func DecodeBytes32(data []byte) ([32]byte, int, error) {
 
 // DecodeBytes32Slice decodes bytes32[] from ABI bytes
 func DecodeBytes32Slice(data []byte) ([][32]byte, int, error) {
+	return DecodeIntoBytes32Slice(nil, data)
+}
+
+// DecodeIntoBytes32Slice decodes bytes32[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes32Slice(dst [][32]byte, data []byte) ([][32]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4476,7 +4823,7 @@ func DecodeBytes32Slice(data []byte) ([][32]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4484,7 +4831,7 @@ func DecodeBytes32Slice(data []byte) ([][32]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][32]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes32(data[offset:])
 		if err != nil {
@@ -4497,6 +4844,11 @@ func DecodeBytes32Slice(data []byte) ([][32]byte, int, error) {
 
 // DecodeBytes3Slice decodes bytes3[] from ABI bytes
 func DecodeBytes3Slice(data []byte) ([][3]byte, int, error) {
+	return DecodeIntoBytes3Slice(nil, data)
+}
+
+// DecodeIntoBytes3Slice decodes bytes3[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes3Slice(dst [][3]byte, data []byte) ([][3]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4506,7 +4858,7 @@ func DecodeBytes3Slice(data []byte) ([][3]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4514,7 +4866,7 @@ func DecodeBytes3Slice(data []byte) ([][3]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][3]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes3(data[offset:])
 		if err != nil {
@@ -4540,6 +4892,11 @@ func DecodeBytes4(data []byte) ([4]byte, int, error) {
 
 // DecodeBytes4Slice decodes bytes4[] from ABI bytes
 func DecodeBytes4Slice(data []byte) ([][4]byte, int, error) {
+	return DecodeIntoBytes4Slice(nil, data)
+}
+
+// DecodeIntoBytes4Slice decodes bytes4[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes4Slice(dst [][4]byte, data []byte) ([][4]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4549,7 +4906,7 @@ func DecodeBytes4Slice(data []byte) ([][4]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4557,7 +4914,7 @@ func DecodeBytes4Slice(data []byte) ([][4]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][4]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes4(data[offset:])
 		if err != nil {
@@ -4583,6 +4940,11 @@ func DecodeBytes5(data []byte) ([5]byte, int, error) {
 
 // DecodeBytes5Slice decodes bytes5[] from ABI bytes
 func DecodeBytes5Slice(data []byte) ([][5]byte, int, error) {
+	return DecodeIntoBytes5Slice(nil, data)
+}
+
+// DecodeIntoBytes5Slice decodes bytes5[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes5Slice(dst [][5]byte, data []byte) ([][5]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4592,7 +4954,7 @@ func DecodeBytes5Slice(data []byte) ([][5]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4600,7 +4962,7 @@ func DecodeBytes5Slice(data []byte) ([][5]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][5]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes5(data[offset:])
 		if err != nil {
@@ -4626,6 +4988,11 @@ func DecodeBytes6(data []byte) ([6]byte, int, error) {
 
 // DecodeBytes6Slice decodes bytes6[] from ABI bytes
 func DecodeBytes6Slice(data []byte) ([][6]byte, int, error) {
+	return DecodeIntoBytes6Slice(nil, data)
+}
+
+// DecodeIntoBytes6Slice decodes bytes6[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes6Slice(dst [][6]byte, data []byte) ([][6]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4635,7 +5002,7 @@ func DecodeBytes6Slice(data []byte) ([][6]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4643,7 +5010,7 @@ func DecodeBytes6Slice(data []byte) ([][6]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][6]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes6(data[offset:])
 		if err != nil {
@@ -4669,6 +5036,11 @@ func DecodeBytes7(data []byte) ([7]byte, int, error) {
 
 // DecodeBytes7Slice decodes bytes7[] from ABI bytes
 func DecodeBytes7Slice(data []byte) ([][7]byte, int, error) {
+	return DecodeIntoBytes7Slice(nil, data)
+}
+
+// DecodeIntoBytes7Slice decodes bytes7[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes7Slice(dst [][7]byte, data []byte) ([][7]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4678,7 +5050,7 @@ func DecodeBytes7Slice(data []byte) ([][7]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4686,7 +5058,7 @@ func DecodeBytes7Slice(data []byte) ([][7]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][7]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes7(data[offset:])
 		if err != nil {
@@ -4712,6 +5084,11 @@ func DecodeBytes8(data []byte) ([8]byte, int, error) {
 
 // DecodeBytes8Slice decodes bytes8[] from ABI bytes
 func DecodeBytes8Slice(data []byte) ([][8]byte, int, error) {
+	return DecodeIntoBytes8Slice(nil, data)
+}
+
+// DecodeIntoBytes8Slice decodes bytes8[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes8Slice(dst [][8]byte, data []byte) ([][8]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4721,7 +5098,7 @@ func DecodeBytes8Slice(data []byte) ([][8]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4729,7 +5106,7 @@ func DecodeBytes8Slice(data []byte) ([][8]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][8]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes8(data[offset:])
 		if err != nil {
@@ -4755,6 +5132,11 @@ func DecodeBytes9(data []byte) ([9]byte, int, error) {
 
 // DecodeBytes9Slice decodes bytes9[] from ABI bytes
 func DecodeBytes9Slice(data []byte) ([][9]byte, int, error) {
+	return DecodeIntoBytes9Slice(nil, data)
+}
+
+// DecodeIntoBytes9Slice decodes bytes9[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytes9Slice(dst [][9]byte, data []byte) ([][9]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4764,7 +5146,7 @@ func DecodeBytes9Slice(data []byte) ([][9]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4772,7 +5154,7 @@ func DecodeBytes9Slice(data []byte) ([][9]byte, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([][9]byte, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeBytes9(data[offset:])
 		if err != nil {
@@ -4785,6 +5167,11 @@ func DecodeBytes9Slice(data []byte) ([][9]byte, int, error) {
 
 // DecodeBytesSlice decodes bytes[] from ABI bytes
 func DecodeBytesSlice(data []byte) ([][]byte, int, error) {
+	return DecodeIntoBytesSlice(nil, data)
+}
+
+// DecodeIntoBytesSlice decodes bytes[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoBytesSlice(dst [][]byte, data []byte) ([][]byte, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4794,7 +5181,7 @@ func DecodeBytesSlice(data []byte) ([][]byte, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4802,7 +5189,7 @@ func DecodeBytesSlice(data []byte) ([][]byte, int, error) {
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([][]byte, length)
+	result := ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := DecodeSize(data[offset:])
@@ -4825,7 +5212,12 @@ func DecodeBytesSlice(data []byte) ([][]byte, int, error) {
 
 // DecodeInt104 decodes int104 from ABI bytes
 func DecodeInt104(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt104(nil, data)
+}
+
+// DecodeIntoInt104 decodes int104 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt104(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -4834,6 +5226,11 @@ func DecodeInt104(data []byte) (*big.Int, int, error) {
 
 // DecodeInt104Slice decodes int104[] from ABI bytes
 func DecodeInt104Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt104Slice(nil, data)
+}
+
+// DecodeIntoInt104Slice decodes int104[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt104Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4843,7 +5240,7 @@ func DecodeInt104Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4851,9 +5248,9 @@ func DecodeInt104Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt104(data[offset:])
+		result[i], n, err = DecodeIntoInt104(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -4864,7 +5261,12 @@ func DecodeInt104Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt112 decodes int112 from ABI bytes
 func DecodeInt112(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt112(nil, data)
+}
+
+// DecodeIntoInt112 decodes int112 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt112(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -4873,6 +5275,11 @@ func DecodeInt112(data []byte) (*big.Int, int, error) {
 
 // DecodeInt112Slice decodes int112[] from ABI bytes
 func DecodeInt112Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt112Slice(nil, data)
+}
+
+// DecodeIntoInt112Slice decodes int112[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt112Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4882,7 +5289,7 @@ func DecodeInt112Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4890,9 +5297,9 @@ func DecodeInt112Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt112(data[offset:])
+		result[i], n, err = DecodeIntoInt112(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -4903,7 +5310,12 @@ func DecodeInt112Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt120 decodes int120 from ABI bytes
 func DecodeInt120(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt120(nil, data)
+}
+
+// DecodeIntoInt120 decodes int120 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt120(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -4912,6 +5324,11 @@ func DecodeInt120(data []byte) (*big.Int, int, error) {
 
 // DecodeInt120Slice decodes int120[] from ABI bytes
 func DecodeInt120Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt120Slice(nil, data)
+}
+
+// DecodeIntoInt120Slice decodes int120[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt120Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4921,7 +5338,7 @@ func DecodeInt120Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4929,9 +5346,9 @@ func DecodeInt120Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt120(data[offset:])
+		result[i], n, err = DecodeIntoInt120(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -4942,7 +5359,12 @@ func DecodeInt120Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt128 decodes int128 from ABI bytes
 func DecodeInt128(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt128(nil, data)
+}
+
+// DecodeIntoInt128 decodes int128 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt128(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -4951,6 +5373,11 @@ func DecodeInt128(data []byte) (*big.Int, int, error) {
 
 // DecodeInt128Slice decodes int128[] from ABI bytes
 func DecodeInt128Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt128Slice(nil, data)
+}
+
+// DecodeIntoInt128Slice decodes int128[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt128Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4960,7 +5387,7 @@ func DecodeInt128Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -4968,9 +5395,9 @@ func DecodeInt128Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt128(data[offset:])
+		result[i], n, err = DecodeIntoInt128(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -4981,7 +5408,12 @@ func DecodeInt128Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt136 decodes int136 from ABI bytes
 func DecodeInt136(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt136(nil, data)
+}
+
+// DecodeIntoInt136 decodes int136 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt136(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -4990,6 +5422,11 @@ func DecodeInt136(data []byte) (*big.Int, int, error) {
 
 // DecodeInt136Slice decodes int136[] from ABI bytes
 func DecodeInt136Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt136Slice(nil, data)
+}
+
+// DecodeIntoInt136Slice decodes int136[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt136Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -4999,7 +5436,7 @@ func DecodeInt136Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5007,9 +5444,9 @@ func DecodeInt136Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt136(data[offset:])
+		result[i], n, err = DecodeIntoInt136(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5020,7 +5457,12 @@ func DecodeInt136Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt144 decodes int144 from ABI bytes
 func DecodeInt144(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt144(nil, data)
+}
+
+// DecodeIntoInt144 decodes int144 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt144(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5029,6 +5471,11 @@ func DecodeInt144(data []byte) (*big.Int, int, error) {
 
 // DecodeInt144Slice decodes int144[] from ABI bytes
 func DecodeInt144Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt144Slice(nil, data)
+}
+
+// DecodeIntoInt144Slice decodes int144[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt144Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5038,7 +5485,7 @@ func DecodeInt144Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5046,9 +5493,9 @@ func DecodeInt144Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt144(data[offset:])
+		result[i], n, err = DecodeIntoInt144(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5059,7 +5506,12 @@ func DecodeInt144Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt152 decodes int152 from ABI bytes
 func DecodeInt152(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt152(nil, data)
+}
+
+// DecodeIntoInt152 decodes int152 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt152(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5068,6 +5520,11 @@ func DecodeInt152(data []byte) (*big.Int, int, error) {
 
 // DecodeInt152Slice decodes int152[] from ABI bytes
 func DecodeInt152Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt152Slice(nil, data)
+}
+
+// DecodeIntoInt152Slice decodes int152[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt152Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5077,7 +5534,7 @@ func DecodeInt152Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5085,9 +5542,9 @@ func DecodeInt152Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt152(data[offset:])
+		result[i], n, err = DecodeIntoInt152(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5107,7 +5564,12 @@ func DecodeInt16(data []byte) (int16, int, error) {
 
 // DecodeInt160 decodes int160 from ABI bytes
 func DecodeInt160(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt160(nil, data)
+}
+
+// DecodeIntoInt160 decodes int160 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt160(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5116,6 +5578,11 @@ func DecodeInt160(data []byte) (*big.Int, int, error) {
 
 // DecodeInt160Slice decodes int160[] from ABI bytes
 func DecodeInt160Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt160Slice(nil, data)
+}
+
+// DecodeIntoInt160Slice decodes int160[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt160Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5125,7 +5592,7 @@ func DecodeInt160Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5133,9 +5600,9 @@ func DecodeInt160Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt160(data[offset:])
+		result[i], n, err = DecodeIntoInt160(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5146,7 +5613,12 @@ func DecodeInt160Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt168 decodes int168 from ABI bytes
 func DecodeInt168(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt168(nil, data)
+}
+
+// DecodeIntoInt168 decodes int168 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt168(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5155,6 +5627,11 @@ func DecodeInt168(data []byte) (*big.Int, int, error) {
 
 // DecodeInt168Slice decodes int168[] from ABI bytes
 func DecodeInt168Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt168Slice(nil, data)
+}
+
+// DecodeIntoInt168Slice decodes int168[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt168Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5164,7 +5641,7 @@ func DecodeInt168Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5172,9 +5649,9 @@ func DecodeInt168Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt168(data[offset:])
+		result[i], n, err = DecodeIntoInt168(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5185,6 +5662,11 @@ func DecodeInt168Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt16Slice decodes int16[] from ABI bytes
 func DecodeInt16Slice(data []byte) ([]int16, int, error) {
+	return DecodeIntoInt16Slice(nil, data)
+}
+
+// DecodeIntoInt16Slice decodes int16[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt16Slice(dst []int16, data []byte) ([]int16, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5194,7 +5676,7 @@ func DecodeInt16Slice(data []byte) ([]int16, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5202,7 +5684,7 @@ func DecodeInt16Slice(data []byte) ([]int16, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int16, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt16(data[offset:])
 		if err != nil {
@@ -5215,7 +5697,12 @@ func DecodeInt16Slice(data []byte) ([]int16, int, error) {
 
 // DecodeInt176 decodes int176 from ABI bytes
 func DecodeInt176(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt176(nil, data)
+}
+
+// DecodeIntoInt176 decodes int176 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt176(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5224,6 +5711,11 @@ func DecodeInt176(data []byte) (*big.Int, int, error) {
 
 // DecodeInt176Slice decodes int176[] from ABI bytes
 func DecodeInt176Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt176Slice(nil, data)
+}
+
+// DecodeIntoInt176Slice decodes int176[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt176Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5233,7 +5725,7 @@ func DecodeInt176Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5241,9 +5733,9 @@ func DecodeInt176Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt176(data[offset:])
+		result[i], n, err = DecodeIntoInt176(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5254,7 +5746,12 @@ func DecodeInt176Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt184 decodes int184 from ABI bytes
 func DecodeInt184(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt184(nil, data)
+}
+
+// DecodeIntoInt184 decodes int184 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt184(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5263,6 +5760,11 @@ func DecodeInt184(data []byte) (*big.Int, int, error) {
 
 // DecodeInt184Slice decodes int184[] from ABI bytes
 func DecodeInt184Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt184Slice(nil, data)
+}
+
+// DecodeIntoInt184Slice decodes int184[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt184Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5272,7 +5774,7 @@ func DecodeInt184Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5280,9 +5782,9 @@ func DecodeInt184Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt184(data[offset:])
+		result[i], n, err = DecodeIntoInt184(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5293,7 +5795,12 @@ func DecodeInt184Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt192 decodes int192 from ABI bytes
 func DecodeInt192(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt192(nil, data)
+}
+
+// DecodeIntoInt192 decodes int192 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt192(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5302,6 +5809,11 @@ func DecodeInt192(data []byte) (*big.Int, int, error) {
 
 // DecodeInt192Slice decodes int192[] from ABI bytes
 func DecodeInt192Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt192Slice(nil, data)
+}
+
+// DecodeIntoInt192Slice decodes int192[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt192Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5311,7 +5823,7 @@ func DecodeInt192Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5319,9 +5831,9 @@ func DecodeInt192Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt192(data[offset:])
+		result[i], n, err = DecodeIntoInt192(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5332,7 +5844,12 @@ func DecodeInt192Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt200 decodes int200 from ABI bytes
 func DecodeInt200(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt200(nil, data)
+}
+
+// DecodeIntoInt200 decodes int200 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt200(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5341,6 +5858,11 @@ func DecodeInt200(data []byte) (*big.Int, int, error) {
 
 // DecodeInt200Slice decodes int200[] from ABI bytes
 func DecodeInt200Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt200Slice(nil, data)
+}
+
+// DecodeIntoInt200Slice decodes int200[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt200Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5350,7 +5872,7 @@ func DecodeInt200Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5358,9 +5880,9 @@ func DecodeInt200Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt200(data[offset:])
+		result[i], n, err = DecodeIntoInt200(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5371,7 +5893,12 @@ func DecodeInt200Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt208 decodes int208 from ABI bytes
 func DecodeInt208(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt208(nil, data)
+}
+
+// DecodeIntoInt208 decodes int208 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt208(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5380,6 +5907,11 @@ func DecodeInt208(data []byte) (*big.Int, int, error) {
 
 // DecodeInt208Slice decodes int208[] from ABI bytes
 func DecodeInt208Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt208Slice(nil, data)
+}
+
+// DecodeIntoInt208Slice decodes int208[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt208Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5389,7 +5921,7 @@ func DecodeInt208Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5397,9 +5929,9 @@ func DecodeInt208Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt208(data[offset:])
+		result[i], n, err = DecodeIntoInt208(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5410,7 +5942,12 @@ func DecodeInt208Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt216 decodes int216 from ABI bytes
 func DecodeInt216(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt216(nil, data)
+}
+
+// DecodeIntoInt216 decodes int216 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt216(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5419,6 +5956,11 @@ func DecodeInt216(data []byte) (*big.Int, int, error) {
 
 // DecodeInt216Slice decodes int216[] from ABI bytes
 func DecodeInt216Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt216Slice(nil, data)
+}
+
+// DecodeIntoInt216Slice decodes int216[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt216Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5428,7 +5970,7 @@ func DecodeInt216Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5436,9 +5978,9 @@ func DecodeInt216Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt216(data[offset:])
+		result[i], n, err = DecodeIntoInt216(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5449,7 +5991,12 @@ func DecodeInt216Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt224 decodes int224 from ABI bytes
 func DecodeInt224(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt224(nil, data)
+}
+
+// DecodeIntoInt224 decodes int224 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt224(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5458,6 +6005,11 @@ func DecodeInt224(data []byte) (*big.Int, int, error) {
 
 // DecodeInt224Slice decodes int224[] from ABI bytes
 func DecodeInt224Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt224Slice(nil, data)
+}
+
+// DecodeIntoInt224Slice decodes int224[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt224Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5467,7 +6019,7 @@ func DecodeInt224Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5475,9 +6027,9 @@ func DecodeInt224Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt224(data[offset:])
+		result[i], n, err = DecodeIntoInt224(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5488,7 +6040,12 @@ func DecodeInt224Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt232 decodes int232 from ABI bytes
 func DecodeInt232(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt232(nil, data)
+}
+
+// DecodeIntoInt232 decodes int232 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt232(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5497,6 +6054,11 @@ func DecodeInt232(data []byte) (*big.Int, int, error) {
 
 // DecodeInt232Slice decodes int232[] from ABI bytes
 func DecodeInt232Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt232Slice(nil, data)
+}
+
+// DecodeIntoInt232Slice decodes int232[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt232Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5506,7 +6068,7 @@ func DecodeInt232Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5514,9 +6076,9 @@ func DecodeInt232Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt232(data[offset:])
+		result[i], n, err = DecodeIntoInt232(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5536,7 +6098,12 @@ func DecodeInt24(data []byte) (int32, int, error) {
 
 // DecodeInt240 decodes int240 from ABI bytes
 func DecodeInt240(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt240(nil, data)
+}
+
+// DecodeIntoInt240 decodes int240 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt240(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5545,6 +6112,11 @@ func DecodeInt240(data []byte) (*big.Int, int, error) {
 
 // DecodeInt240Slice decodes int240[] from ABI bytes
 func DecodeInt240Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt240Slice(nil, data)
+}
+
+// DecodeIntoInt240Slice decodes int240[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt240Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5554,7 +6126,7 @@ func DecodeInt240Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5562,9 +6134,9 @@ func DecodeInt240Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt240(data[offset:])
+		result[i], n, err = DecodeIntoInt240(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5575,7 +6147,12 @@ func DecodeInt240Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt248 decodes int248 from ABI bytes
 func DecodeInt248(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt248(nil, data)
+}
+
+// DecodeIntoInt248 decodes int248 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt248(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5584,6 +6161,11 @@ func DecodeInt248(data []byte) (*big.Int, int, error) {
 
 // DecodeInt248Slice decodes int248[] from ABI bytes
 func DecodeInt248Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt248Slice(nil, data)
+}
+
+// DecodeIntoInt248Slice decodes int248[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt248Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5593,7 +6175,7 @@ func DecodeInt248Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5601,9 +6183,9 @@ func DecodeInt248Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt248(data[offset:])
+		result[i], n, err = DecodeIntoInt248(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5614,6 +6196,11 @@ func DecodeInt248Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt24Slice decodes int24[] from ABI bytes
 func DecodeInt24Slice(data []byte) ([]int32, int, error) {
+	return DecodeIntoInt24Slice(nil, data)
+}
+
+// DecodeIntoInt24Slice decodes int24[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt24Slice(dst []int32, data []byte) ([]int32, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5623,7 +6210,7 @@ func DecodeInt24Slice(data []byte) ([]int32, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5631,7 +6218,7 @@ func DecodeInt24Slice(data []byte) ([]int32, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int32, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt24(data[offset:])
 		if err != nil {
@@ -5644,7 +6231,12 @@ func DecodeInt24Slice(data []byte) ([]int32, int, error) {
 
 // DecodeInt256 decodes int256 from ABI bytes
 func DecodeInt256(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt256(nil, data)
+}
+
+// DecodeIntoInt256 decodes int256 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt256(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5653,6 +6245,11 @@ func DecodeInt256(data []byte) (*big.Int, int, error) {
 
 // DecodeInt256Slice decodes int256[] from ABI bytes
 func DecodeInt256Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt256Slice(nil, data)
+}
+
+// DecodeIntoInt256Slice decodes int256[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt256Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5662,7 +6259,7 @@ func DecodeInt256Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5670,9 +6267,9 @@ func DecodeInt256Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt256(data[offset:])
+		result[i], n, err = DecodeIntoInt256(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5692,6 +6289,11 @@ func DecodeInt32(data []byte) (int32, int, error) {
 
 // DecodeInt32Slice decodes int32[] from ABI bytes
 func DecodeInt32Slice(data []byte) ([]int32, int, error) {
+	return DecodeIntoInt32Slice(nil, data)
+}
+
+// DecodeIntoInt32Slice decodes int32[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt32Slice(dst []int32, data []byte) ([]int32, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5701,7 +6303,7 @@ func DecodeInt32Slice(data []byte) ([]int32, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5709,7 +6311,7 @@ func DecodeInt32Slice(data []byte) ([]int32, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int32, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt32(data[offset:])
 		if err != nil {
@@ -5731,6 +6333,11 @@ func DecodeInt40(data []byte) (int64, int, error) {
 
 // DecodeInt40Slice decodes int40[] from ABI bytes
 func DecodeInt40Slice(data []byte) ([]int64, int, error) {
+	return DecodeIntoInt40Slice(nil, data)
+}
+
+// DecodeIntoInt40Slice decodes int40[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt40Slice(dst []int64, data []byte) ([]int64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5740,7 +6347,7 @@ func DecodeInt40Slice(data []byte) ([]int64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5748,7 +6355,7 @@ func DecodeInt40Slice(data []byte) ([]int64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt40(data[offset:])
 		if err != nil {
@@ -5770,6 +6377,11 @@ func DecodeInt48(data []byte) (int64, int, error) {
 
 // DecodeInt48Slice decodes int48[] from ABI bytes
 func DecodeInt48Slice(data []byte) ([]int64, int, error) {
+	return DecodeIntoInt48Slice(nil, data)
+}
+
+// DecodeIntoInt48Slice decodes int48[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt48Slice(dst []int64, data []byte) ([]int64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5779,7 +6391,7 @@ func DecodeInt48Slice(data []byte) ([]int64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5787,7 +6399,7 @@ func DecodeInt48Slice(data []byte) ([]int64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt48(data[offset:])
 		if err != nil {
@@ -5809,6 +6421,11 @@ func DecodeInt56(data []byte) (int64, int, error) {
 
 // DecodeInt56Slice decodes int56[] from ABI bytes
 func DecodeInt56Slice(data []byte) ([]int64, int, error) {
+	return DecodeIntoInt56Slice(nil, data)
+}
+
+// DecodeIntoInt56Slice decodes int56[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt56Slice(dst []int64, data []byte) ([]int64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5818,7 +6435,7 @@ func DecodeInt56Slice(data []byte) ([]int64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5826,7 +6443,7 @@ func DecodeInt56Slice(data []byte) ([]int64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt56(data[offset:])
 		if err != nil {
@@ -5848,6 +6465,11 @@ func DecodeInt64(data []byte) (int64, int, error) {
 
 // DecodeInt64Slice decodes int64[] from ABI bytes
 func DecodeInt64Slice(data []byte) ([]int64, int, error) {
+	return DecodeIntoInt64Slice(nil, data)
+}
+
+// DecodeIntoInt64Slice decodes int64[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt64Slice(dst []int64, data []byte) ([]int64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5857,7 +6479,7 @@ func DecodeInt64Slice(data []byte) ([]int64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5865,7 +6487,7 @@ func DecodeInt64Slice(data []byte) ([]int64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt64(data[offset:])
 		if err != nil {
@@ -5878,7 +6500,12 @@ func DecodeInt64Slice(data []byte) ([]int64, int, error) {
 
 // DecodeInt72 decodes int72 from ABI bytes
 func DecodeInt72(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt72(nil, data)
+}
+
+// DecodeIntoInt72 decodes int72 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt72(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5887,6 +6514,11 @@ func DecodeInt72(data []byte) (*big.Int, int, error) {
 
 // DecodeInt72Slice decodes int72[] from ABI bytes
 func DecodeInt72Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt72Slice(nil, data)
+}
+
+// DecodeIntoInt72Slice decodes int72[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt72Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5896,7 +6528,7 @@ func DecodeInt72Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5904,9 +6536,9 @@ func DecodeInt72Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt72(data[offset:])
+		result[i], n, err = DecodeIntoInt72(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5926,7 +6558,12 @@ func DecodeInt8(data []byte) (int8, int, error) {
 
 // DecodeInt80 decodes int80 from ABI bytes
 func DecodeInt80(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt80(nil, data)
+}
+
+// DecodeIntoInt80 decodes int80 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt80(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5935,6 +6572,11 @@ func DecodeInt80(data []byte) (*big.Int, int, error) {
 
 // DecodeInt80Slice decodes int80[] from ABI bytes
 func DecodeInt80Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt80Slice(nil, data)
+}
+
+// DecodeIntoInt80Slice decodes int80[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt80Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5944,7 +6586,7 @@ func DecodeInt80Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5952,9 +6594,9 @@ func DecodeInt80Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt80(data[offset:])
+		result[i], n, err = DecodeIntoInt80(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -5965,7 +6607,12 @@ func DecodeInt80Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt88 decodes int88 from ABI bytes
 func DecodeInt88(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt88(nil, data)
+}
+
+// DecodeIntoInt88 decodes int88 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt88(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -5974,6 +6621,11 @@ func DecodeInt88(data []byte) (*big.Int, int, error) {
 
 // DecodeInt88Slice decodes int88[] from ABI bytes
 func DecodeInt88Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt88Slice(nil, data)
+}
+
+// DecodeIntoInt88Slice decodes int88[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt88Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -5983,7 +6635,7 @@ func DecodeInt88Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -5991,9 +6643,9 @@ func DecodeInt88Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt88(data[offset:])
+		result[i], n, err = DecodeIntoInt88(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6004,6 +6656,11 @@ func DecodeInt88Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeInt8Slice decodes int8[] from ABI bytes
 func DecodeInt8Slice(data []byte) ([]int8, int, error) {
+	return DecodeIntoInt8Slice(nil, data)
+}
+
+// DecodeIntoInt8Slice decodes int8[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt8Slice(dst []int8, data []byte) ([]int8, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6013,7 +6670,7 @@ func DecodeInt8Slice(data []byte) ([]int8, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6021,7 +6678,7 @@ func DecodeInt8Slice(data []byte) ([]int8, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]int8, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeInt8(data[offset:])
 		if err != nil {
@@ -6034,7 +6691,12 @@ func DecodeInt8Slice(data []byte) ([]int8, int, error) {
 
 // DecodeInt96 decodes int96 from ABI bytes
 func DecodeInt96(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
+	return DecodeIntoInt96(nil, data)
+}
+
+// DecodeIntoInt96 decodes int96 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoInt96(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, true)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6043,6 +6705,11 @@ func DecodeInt96(data []byte) (*big.Int, int, error) {
 
 // DecodeInt96Slice decodes int96[] from ABI bytes
 func DecodeInt96Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoInt96Slice(nil, data)
+}
+
+// DecodeIntoInt96Slice decodes int96[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoInt96Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6052,7 +6719,7 @@ func DecodeInt96Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6060,9 +6727,9 @@ func DecodeInt96Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt96(data[offset:])
+		result[i], n, err = DecodeIntoInt96(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6082,6 +6749,10 @@ func DecodeString(data []byte) (string, int, error) {
 		return "", 0, err
 	}
 	data = data[32:]
+	// check the length first, Pad32 overflows near MaxInt
+	if length > len(data) {
+		return "", 0, io.ErrUnexpectedEOF
+	}
 	paddedLength := Pad32(length)
 	if len(data) < paddedLength {
 		return "", 0, io.ErrUnexpectedEOF
@@ -6099,6 +6770,11 @@ func DecodeString(data []byte) (string, int, error) {
 
 // DecodeStringSlice decodes string[] from ABI bytes
 func DecodeStringSlice(data []byte) ([]string, int, error) {
+	return DecodeIntoStringSlice(nil, data)
+}
+
+// DecodeIntoStringSlice decodes string[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoStringSlice(dst []string, data []byte) ([]string, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6108,7 +6784,7 @@ func DecodeStringSlice(data []byte) ([]string, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6116,7 +6792,7 @@ func DecodeStringSlice(data []byte) ([]string, int, error) {
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([]string, length)
+	result := ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := DecodeSize(data[offset:])
@@ -6139,7 +6815,12 @@ func DecodeStringSlice(data []byte) ([]string, int, error) {
 
 // DecodeUint104 decodes uint104 from ABI bytes
 func DecodeUint104(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint104(nil, data)
+}
+
+// DecodeIntoUint104 decodes uint104 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint104(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6148,6 +6829,11 @@ func DecodeUint104(data []byte) (*big.Int, int, error) {
 
 // DecodeUint104Slice decodes uint104[] from ABI bytes
 func DecodeUint104Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint104Slice(nil, data)
+}
+
+// DecodeIntoUint104Slice decodes uint104[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint104Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6157,7 +6843,7 @@ func DecodeUint104Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6165,9 +6851,9 @@ func DecodeUint104Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint104(data[offset:])
+		result[i], n, err = DecodeIntoUint104(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6178,7 +6864,12 @@ func DecodeUint104Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint112 decodes uint112 from ABI bytes
 func DecodeUint112(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint112(nil, data)
+}
+
+// DecodeIntoUint112 decodes uint112 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint112(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6187,6 +6878,11 @@ func DecodeUint112(data []byte) (*big.Int, int, error) {
 
 // DecodeUint112Slice decodes uint112[] from ABI bytes
 func DecodeUint112Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint112Slice(nil, data)
+}
+
+// DecodeIntoUint112Slice decodes uint112[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint112Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6196,7 +6892,7 @@ func DecodeUint112Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6204,9 +6900,9 @@ func DecodeUint112Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint112(data[offset:])
+		result[i], n, err = DecodeIntoUint112(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6217,7 +6913,12 @@ func DecodeUint112Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint120 decodes uint120 from ABI bytes
 func DecodeUint120(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint120(nil, data)
+}
+
+// DecodeIntoUint120 decodes uint120 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint120(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6226,6 +6927,11 @@ func DecodeUint120(data []byte) (*big.Int, int, error) {
 
 // DecodeUint120Slice decodes uint120[] from ABI bytes
 func DecodeUint120Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint120Slice(nil, data)
+}
+
+// DecodeIntoUint120Slice decodes uint120[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint120Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6235,7 +6941,7 @@ func DecodeUint120Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6243,9 +6949,9 @@ func DecodeUint120Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint120(data[offset:])
+		result[i], n, err = DecodeIntoUint120(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6256,7 +6962,12 @@ func DecodeUint120Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint128 decodes uint128 from ABI bytes
 func DecodeUint128(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint128(nil, data)
+}
+
+// DecodeIntoUint128 decodes uint128 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint128(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6265,6 +6976,11 @@ func DecodeUint128(data []byte) (*big.Int, int, error) {
 
 // DecodeUint128Slice decodes uint128[] from ABI bytes
 func DecodeUint128Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint128Slice(nil, data)
+}
+
+// DecodeIntoUint128Slice decodes uint128[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint128Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6274,7 +6990,7 @@ func DecodeUint128Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6282,9 +6998,9 @@ func DecodeUint128Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint128(data[offset:])
+		result[i], n, err = DecodeIntoUint128(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6295,7 +7011,12 @@ func DecodeUint128Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint136 decodes uint136 from ABI bytes
 func DecodeUint136(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint136(nil, data)
+}
+
+// DecodeIntoUint136 decodes uint136 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint136(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6304,6 +7025,11 @@ func DecodeUint136(data []byte) (*big.Int, int, error) {
 
 // DecodeUint136Slice decodes uint136[] from ABI bytes
 func DecodeUint136Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint136Slice(nil, data)
+}
+
+// DecodeIntoUint136Slice decodes uint136[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint136Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6313,7 +7039,7 @@ func DecodeUint136Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6321,9 +7047,9 @@ func DecodeUint136Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint136(data[offset:])
+		result[i], n, err = DecodeIntoUint136(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6334,7 +7060,12 @@ func DecodeUint136Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint144 decodes uint144 from ABI bytes
 func DecodeUint144(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint144(nil, data)
+}
+
+// DecodeIntoUint144 decodes uint144 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint144(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6343,6 +7074,11 @@ func DecodeUint144(data []byte) (*big.Int, int, error) {
 
 // DecodeUint144Slice decodes uint144[] from ABI bytes
 func DecodeUint144Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint144Slice(nil, data)
+}
+
+// DecodeIntoUint144Slice decodes uint144[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint144Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6352,7 +7088,7 @@ func DecodeUint144Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6360,9 +7096,9 @@ func DecodeUint144Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint144(data[offset:])
+		result[i], n, err = DecodeIntoUint144(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6373,7 +7109,12 @@ func DecodeUint144Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint152 decodes uint152 from ABI bytes
 func DecodeUint152(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint152(nil, data)
+}
+
+// DecodeIntoUint152 decodes uint152 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint152(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6382,6 +7123,11 @@ func DecodeUint152(data []byte) (*big.Int, int, error) {
 
 // DecodeUint152Slice decodes uint152[] from ABI bytes
 func DecodeUint152Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint152Slice(nil, data)
+}
+
+// DecodeIntoUint152Slice decodes uint152[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint152Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6391,7 +7137,7 @@ func DecodeUint152Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6399,9 +7145,9 @@ func DecodeUint152Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint152(data[offset:])
+		result[i], n, err = DecodeIntoUint152(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6421,7 +7167,12 @@ func DecodeUint16(data []byte) (uint16, int, error) {
 
 // DecodeUint160 decodes uint160 from ABI bytes
 func DecodeUint160(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint160(nil, data)
+}
+
+// DecodeIntoUint160 decodes uint160 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint160(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6430,6 +7181,11 @@ func DecodeUint160(data []byte) (*big.Int, int, error) {
 
 // DecodeUint160Slice decodes uint160[] from ABI bytes
 func DecodeUint160Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint160Slice(nil, data)
+}
+
+// DecodeIntoUint160Slice decodes uint160[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint160Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6439,7 +7195,7 @@ func DecodeUint160Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6447,9 +7203,9 @@ func DecodeUint160Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint160(data[offset:])
+		result[i], n, err = DecodeIntoUint160(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6460,7 +7216,12 @@ func DecodeUint160Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint168 decodes uint168 from ABI bytes
 func DecodeUint168(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint168(nil, data)
+}
+
+// DecodeIntoUint168 decodes uint168 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint168(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6469,6 +7230,11 @@ func DecodeUint168(data []byte) (*big.Int, int, error) {
 
 // DecodeUint168Slice decodes uint168[] from ABI bytes
 func DecodeUint168Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint168Slice(nil, data)
+}
+
+// DecodeIntoUint168Slice decodes uint168[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint168Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6478,7 +7244,7 @@ func DecodeUint168Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6486,9 +7252,9 @@ func DecodeUint168Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint168(data[offset:])
+		result[i], n, err = DecodeIntoUint168(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6499,6 +7265,11 @@ func DecodeUint168Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint16Slice decodes uint16[] from ABI bytes
 func DecodeUint16Slice(data []byte) ([]uint16, int, error) {
+	return DecodeIntoUint16Slice(nil, data)
+}
+
+// DecodeIntoUint16Slice decodes uint16[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint16Slice(dst []uint16, data []byte) ([]uint16, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6508,7 +7279,7 @@ func DecodeUint16Slice(data []byte) ([]uint16, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6516,7 +7287,7 @@ func DecodeUint16Slice(data []byte) ([]uint16, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint16, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint16(data[offset:])
 		if err != nil {
@@ -6529,7 +7300,12 @@ func DecodeUint16Slice(data []byte) ([]uint16, int, error) {
 
 // DecodeUint176 decodes uint176 from ABI bytes
 func DecodeUint176(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint176(nil, data)
+}
+
+// DecodeIntoUint176 decodes uint176 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint176(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6538,6 +7314,11 @@ func DecodeUint176(data []byte) (*big.Int, int, error) {
 
 // DecodeUint176Slice decodes uint176[] from ABI bytes
 func DecodeUint176Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint176Slice(nil, data)
+}
+
+// DecodeIntoUint176Slice decodes uint176[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint176Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6547,7 +7328,7 @@ func DecodeUint176Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6555,9 +7336,9 @@ func DecodeUint176Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint176(data[offset:])
+		result[i], n, err = DecodeIntoUint176(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6568,7 +7349,12 @@ func DecodeUint176Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint184 decodes uint184 from ABI bytes
 func DecodeUint184(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint184(nil, data)
+}
+
+// DecodeIntoUint184 decodes uint184 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint184(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6577,6 +7363,11 @@ func DecodeUint184(data []byte) (*big.Int, int, error) {
 
 // DecodeUint184Slice decodes uint184[] from ABI bytes
 func DecodeUint184Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint184Slice(nil, data)
+}
+
+// DecodeIntoUint184Slice decodes uint184[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint184Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6586,7 +7377,7 @@ func DecodeUint184Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6594,9 +7385,9 @@ func DecodeUint184Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint184(data[offset:])
+		result[i], n, err = DecodeIntoUint184(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6607,7 +7398,12 @@ func DecodeUint184Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint192 decodes uint192 from ABI bytes
 func DecodeUint192(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint192(nil, data)
+}
+
+// DecodeIntoUint192 decodes uint192 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint192(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6616,6 +7412,11 @@ func DecodeUint192(data []byte) (*big.Int, int, error) {
 
 // DecodeUint192Slice decodes uint192[] from ABI bytes
 func DecodeUint192Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint192Slice(nil, data)
+}
+
+// DecodeIntoUint192Slice decodes uint192[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint192Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6625,7 +7426,7 @@ func DecodeUint192Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6633,9 +7434,9 @@ func DecodeUint192Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint192(data[offset:])
+		result[i], n, err = DecodeIntoUint192(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6646,7 +7447,12 @@ func DecodeUint192Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint200 decodes uint200 from ABI bytes
 func DecodeUint200(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint200(nil, data)
+}
+
+// DecodeIntoUint200 decodes uint200 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint200(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6655,6 +7461,11 @@ func DecodeUint200(data []byte) (*big.Int, int, error) {
 
 // DecodeUint200Slice decodes uint200[] from ABI bytes
 func DecodeUint200Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint200Slice(nil, data)
+}
+
+// DecodeIntoUint200Slice decodes uint200[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint200Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6664,7 +7475,7 @@ func DecodeUint200Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6672,9 +7483,9 @@ func DecodeUint200Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint200(data[offset:])
+		result[i], n, err = DecodeIntoUint200(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6685,7 +7496,12 @@ func DecodeUint200Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint208 decodes uint208 from ABI bytes
 func DecodeUint208(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint208(nil, data)
+}
+
+// DecodeIntoUint208 decodes uint208 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint208(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6694,6 +7510,11 @@ func DecodeUint208(data []byte) (*big.Int, int, error) {
 
 // DecodeUint208Slice decodes uint208[] from ABI bytes
 func DecodeUint208Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint208Slice(nil, data)
+}
+
+// DecodeIntoUint208Slice decodes uint208[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint208Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6703,7 +7524,7 @@ func DecodeUint208Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6711,9 +7532,9 @@ func DecodeUint208Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint208(data[offset:])
+		result[i], n, err = DecodeIntoUint208(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6724,7 +7545,12 @@ func DecodeUint208Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint216 decodes uint216 from ABI bytes
 func DecodeUint216(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint216(nil, data)
+}
+
+// DecodeIntoUint216 decodes uint216 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint216(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6733,6 +7559,11 @@ func DecodeUint216(data []byte) (*big.Int, int, error) {
 
 // DecodeUint216Slice decodes uint216[] from ABI bytes
 func DecodeUint216Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint216Slice(nil, data)
+}
+
+// DecodeIntoUint216Slice decodes uint216[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint216Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6742,7 +7573,7 @@ func DecodeUint216Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6750,9 +7581,9 @@ func DecodeUint216Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint216(data[offset:])
+		result[i], n, err = DecodeIntoUint216(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6763,7 +7594,12 @@ func DecodeUint216Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint224 decodes uint224 from ABI bytes
 func DecodeUint224(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint224(nil, data)
+}
+
+// DecodeIntoUint224 decodes uint224 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint224(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6772,6 +7608,11 @@ func DecodeUint224(data []byte) (*big.Int, int, error) {
 
 // DecodeUint224Slice decodes uint224[] from ABI bytes
 func DecodeUint224Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint224Slice(nil, data)
+}
+
+// DecodeIntoUint224Slice decodes uint224[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint224Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6781,7 +7622,7 @@ func DecodeUint224Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6789,9 +7630,9 @@ func DecodeUint224Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint224(data[offset:])
+		result[i], n, err = DecodeIntoUint224(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6802,7 +7643,12 @@ func DecodeUint224Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint232 decodes uint232 from ABI bytes
 func DecodeUint232(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint232(nil, data)
+}
+
+// DecodeIntoUint232 decodes uint232 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint232(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6811,6 +7657,11 @@ func DecodeUint232(data []byte) (*big.Int, int, error) {
 
 // DecodeUint232Slice decodes uint232[] from ABI bytes
 func DecodeUint232Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint232Slice(nil, data)
+}
+
+// DecodeIntoUint232Slice decodes uint232[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint232Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6820,7 +7671,7 @@ func DecodeUint232Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6828,9 +7679,9 @@ func DecodeUint232Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint232(data[offset:])
+		result[i], n, err = DecodeIntoUint232(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6850,7 +7701,12 @@ func DecodeUint24(data []byte) (uint32, int, error) {
 
 // DecodeUint240 decodes uint240 from ABI bytes
 func DecodeUint240(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint240(nil, data)
+}
+
+// DecodeIntoUint240 decodes uint240 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint240(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6859,6 +7715,11 @@ func DecodeUint240(data []byte) (*big.Int, int, error) {
 
 // DecodeUint240Slice decodes uint240[] from ABI bytes
 func DecodeUint240Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint240Slice(nil, data)
+}
+
+// DecodeIntoUint240Slice decodes uint240[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint240Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6868,7 +7729,7 @@ func DecodeUint240Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6876,9 +7737,9 @@ func DecodeUint240Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint240(data[offset:])
+		result[i], n, err = DecodeIntoUint240(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6889,7 +7750,12 @@ func DecodeUint240Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint248 decodes uint248 from ABI bytes
 func DecodeUint248(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint248(nil, data)
+}
+
+// DecodeIntoUint248 decodes uint248 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint248(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6898,6 +7764,11 @@ func DecodeUint248(data []byte) (*big.Int, int, error) {
 
 // DecodeUint248Slice decodes uint248[] from ABI bytes
 func DecodeUint248Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint248Slice(nil, data)
+}
+
+// DecodeIntoUint248Slice decodes uint248[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint248Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6907,7 +7778,7 @@ func DecodeUint248Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6915,9 +7786,9 @@ func DecodeUint248Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint248(data[offset:])
+		result[i], n, err = DecodeIntoUint248(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -6928,6 +7799,11 @@ func DecodeUint248Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint24Slice decodes uint24[] from ABI bytes
 func DecodeUint24Slice(data []byte) ([]uint32, int, error) {
+	return DecodeIntoUint24Slice(nil, data)
+}
+
+// DecodeIntoUint24Slice decodes uint24[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint24Slice(dst []uint32, data []byte) ([]uint32, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6937,7 +7813,7 @@ func DecodeUint24Slice(data []byte) ([]uint32, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6945,7 +7821,7 @@ func DecodeUint24Slice(data []byte) ([]uint32, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint32, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint24(data[offset:])
 		if err != nil {
@@ -6958,7 +7834,12 @@ func DecodeUint24Slice(data []byte) ([]uint32, int, error) {
 
 // DecodeUint256 decodes uint256 from ABI bytes
 func DecodeUint256(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint256(nil, data)
+}
+
+// DecodeIntoUint256 decodes uint256 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint256(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6967,6 +7848,11 @@ func DecodeUint256(data []byte) (*big.Int, int, error) {
 
 // DecodeUint256Slice decodes uint256[] from ABI bytes
 func DecodeUint256Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint256Slice(nil, data)
+}
+
+// DecodeIntoUint256Slice decodes uint256[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint256Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -6976,7 +7862,7 @@ func DecodeUint256Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -6984,9 +7870,9 @@ func DecodeUint256Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint256(data[offset:])
+		result[i], n, err = DecodeIntoUint256(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -7006,6 +7892,11 @@ func DecodeUint32(data []byte) (uint32, int, error) {
 
 // DecodeUint32Slice decodes uint32[] from ABI bytes
 func DecodeUint32Slice(data []byte) ([]uint32, int, error) {
+	return DecodeIntoUint32Slice(nil, data)
+}
+
+// DecodeIntoUint32Slice decodes uint32[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint32Slice(dst []uint32, data []byte) ([]uint32, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7015,7 +7906,7 @@ func DecodeUint32Slice(data []byte) ([]uint32, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7023,7 +7914,7 @@ func DecodeUint32Slice(data []byte) ([]uint32, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint32, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint32(data[offset:])
 		if err != nil {
@@ -7045,6 +7936,11 @@ func DecodeUint40(data []byte) (uint64, int, error) {
 
 // DecodeUint40Slice decodes uint40[] from ABI bytes
 func DecodeUint40Slice(data []byte) ([]uint64, int, error) {
+	return DecodeIntoUint40Slice(nil, data)
+}
+
+// DecodeIntoUint40Slice decodes uint40[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint40Slice(dst []uint64, data []byte) ([]uint64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7054,7 +7950,7 @@ func DecodeUint40Slice(data []byte) ([]uint64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7062,7 +7958,7 @@ func DecodeUint40Slice(data []byte) ([]uint64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint40(data[offset:])
 		if err != nil {
@@ -7084,6 +7980,11 @@ func DecodeUint48(data []byte) (uint64, int, error) {
 
 // DecodeUint48Slice decodes uint48[] from ABI bytes
 func DecodeUint48Slice(data []byte) ([]uint64, int, error) {
+	return DecodeIntoUint48Slice(nil, data)
+}
+
+// DecodeIntoUint48Slice decodes uint48[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint48Slice(dst []uint64, data []byte) ([]uint64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7093,7 +7994,7 @@ func DecodeUint48Slice(data []byte) ([]uint64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7101,7 +8002,7 @@ func DecodeUint48Slice(data []byte) ([]uint64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint48(data[offset:])
 		if err != nil {
@@ -7123,6 +8024,11 @@ func DecodeUint56(data []byte) (uint64, int, error) {
 
 // DecodeUint56Slice decodes uint56[] from ABI bytes
 func DecodeUint56Slice(data []byte) ([]uint64, int, error) {
+	return DecodeIntoUint56Slice(nil, data)
+}
+
+// DecodeIntoUint56Slice decodes uint56[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint56Slice(dst []uint64, data []byte) ([]uint64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7132,7 +8038,7 @@ func DecodeUint56Slice(data []byte) ([]uint64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7140,7 +8046,7 @@ func DecodeUint56Slice(data []byte) ([]uint64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint56(data[offset:])
 		if err != nil {
@@ -7162,6 +8068,11 @@ func DecodeUint64(data []byte) (uint64, int, error) {
 
 // DecodeUint64Slice decodes uint64[] from ABI bytes
 func DecodeUint64Slice(data []byte) ([]uint64, int, error) {
+	return DecodeIntoUint64Slice(nil, data)
+}
+
+// DecodeIntoUint64Slice decodes uint64[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint64Slice(dst []uint64, data []byte) ([]uint64, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7171,7 +8082,7 @@ func DecodeUint64Slice(data []byte) ([]uint64, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7179,7 +8090,7 @@ func DecodeUint64Slice(data []byte) ([]uint64, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint64, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint64(data[offset:])
 		if err != nil {
@@ -7192,7 +8103,12 @@ func DecodeUint64Slice(data []byte) ([]uint64, int, error) {
 
 // DecodeUint72 decodes uint72 from ABI bytes
 func DecodeUint72(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint72(nil, data)
+}
+
+// DecodeIntoUint72 decodes uint72 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint72(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -7201,6 +8117,11 @@ func DecodeUint72(data []byte) (*big.Int, int, error) {
 
 // DecodeUint72Slice decodes uint72[] from ABI bytes
 func DecodeUint72Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint72Slice(nil, data)
+}
+
+// DecodeIntoUint72Slice decodes uint72[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint72Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7210,7 +8131,7 @@ func DecodeUint72Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7218,9 +8139,9 @@ func DecodeUint72Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint72(data[offset:])
+		result[i], n, err = DecodeIntoUint72(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -7240,7 +8161,12 @@ func DecodeUint8(data []byte) (uint8, int, error) {
 
 // DecodeUint80 decodes uint80 from ABI bytes
 func DecodeUint80(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint80(nil, data)
+}
+
+// DecodeIntoUint80 decodes uint80 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint80(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -7249,6 +8175,11 @@ func DecodeUint80(data []byte) (*big.Int, int, error) {
 
 // DecodeUint80Slice decodes uint80[] from ABI bytes
 func DecodeUint80Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint80Slice(nil, data)
+}
+
+// DecodeIntoUint80Slice decodes uint80[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint80Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7258,7 +8189,7 @@ func DecodeUint80Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7266,9 +8197,9 @@ func DecodeUint80Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint80(data[offset:])
+		result[i], n, err = DecodeIntoUint80(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -7279,7 +8210,12 @@ func DecodeUint80Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint88 decodes uint88 from ABI bytes
 func DecodeUint88(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint88(nil, data)
+}
+
+// DecodeIntoUint88 decodes uint88 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint88(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -7288,6 +8224,11 @@ func DecodeUint88(data []byte) (*big.Int, int, error) {
 
 // DecodeUint88Slice decodes uint88[] from ABI bytes
 func DecodeUint88Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint88Slice(nil, data)
+}
+
+// DecodeIntoUint88Slice decodes uint88[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint88Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7297,7 +8238,7 @@ func DecodeUint88Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7305,9 +8246,9 @@ func DecodeUint88Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint88(data[offset:])
+		result[i], n, err = DecodeIntoUint88(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -7318,6 +8259,11 @@ func DecodeUint88Slice(data []byte) ([]*big.Int, int, error) {
 
 // DecodeUint8Slice decodes uint8[] from ABI bytes
 func DecodeUint8Slice(data []byte) ([]uint8, int, error) {
+	return DecodeIntoUint8Slice(nil, data)
+}
+
+// DecodeIntoUint8Slice decodes uint8[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint8Slice(dst []uint8, data []byte) ([]uint8, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7327,7 +8273,7 @@ func DecodeUint8Slice(data []byte) ([]uint8, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7335,7 +8281,7 @@ func DecodeUint8Slice(data []byte) ([]uint8, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]uint8, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		result[i], n, err = DecodeUint8(data[offset:])
 		if err != nil {
@@ -7348,7 +8294,12 @@ func DecodeUint8Slice(data []byte) ([]uint8, int, error) {
 
 // DecodeUint96 decodes uint96 from ABI bytes
 func DecodeUint96(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], false)
+	return DecodeIntoUint96(nil, data)
+}
+
+// DecodeIntoUint96 decodes uint96 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint96(dst *big.Int, data []byte) (*big.Int, int, error) {
+	result, err := DecodeIntoBigInt(dst, data, false)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -7357,6 +8308,11 @@ func DecodeUint96(data []byte) (*big.Int, int, error) {
 
 // DecodeUint96Slice decodes uint96[] from ABI bytes
 func DecodeUint96Slice(data []byte) ([]*big.Int, int, error) {
+	return DecodeIntoUint96Slice(nil, data)
+}
+
+// DecodeIntoUint96Slice decodes uint96[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint96Slice(dst []*big.Int, data []byte) ([]*big.Int, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -7366,7 +8322,7 @@ func DecodeUint96Slice(data []byte) ([]*big.Int, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -7374,9 +8330,9 @@ func DecodeUint96Slice(data []byte) ([]*big.Int, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]*big.Int, length)
+	result := ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint96(data[offset:])
+		result[i], n, err = DecodeIntoUint96(result[i], data[offset:])
 		if err != nil {
 			return nil, 0, err
 		}
@@ -7700,9 +8656,11 @@ func PackedEncodeInt104(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 13 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:13], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:13], tmp[19:])
 	return 13, nil
 }
 
@@ -7711,9 +8669,11 @@ func PackedEncodeInt112(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 14 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:14], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:14], tmp[18:])
 	return 14, nil
 }
 
@@ -7722,9 +8682,11 @@ func PackedEncodeInt120(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 15 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:15], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:15], tmp[17:])
 	return 15, nil
 }
 
@@ -7733,9 +8695,11 @@ func PackedEncodeInt128(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 16 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:16], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:16], tmp[16:])
 	return 16, nil
 }
 
@@ -7744,9 +8708,11 @@ func PackedEncodeInt136(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 17 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:17], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:17], tmp[15:])
 	return 17, nil
 }
 
@@ -7755,9 +8721,11 @@ func PackedEncodeInt144(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 18 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:18], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:18], tmp[14:])
 	return 18, nil
 }
 
@@ -7766,9 +8734,11 @@ func PackedEncodeInt152(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 19 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:19], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:19], tmp[13:])
 	return 19, nil
 }
 
@@ -7786,9 +8756,11 @@ func PackedEncodeInt160(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 20 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:20], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:20], tmp[12:])
 	return 20, nil
 }
 
@@ -7797,9 +8769,11 @@ func PackedEncodeInt168(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 21 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:21], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:21], tmp[11:])
 	return 21, nil
 }
 
@@ -7808,9 +8782,11 @@ func PackedEncodeInt176(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 22 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:22], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:22], tmp[10:])
 	return 22, nil
 }
 
@@ -7819,9 +8795,11 @@ func PackedEncodeInt184(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 23 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:23], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:23], tmp[9:])
 	return 23, nil
 }
 
@@ -7830,9 +8808,11 @@ func PackedEncodeInt192(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 24 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:24], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:24], tmp[8:])
 	return 24, nil
 }
 
@@ -7841,9 +8821,11 @@ func PackedEncodeInt200(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 25 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:25], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:25], tmp[7:])
 	return 25, nil
 }
 
@@ -7852,9 +8834,11 @@ func PackedEncodeInt208(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 26 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:26], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:26], tmp[6:])
 	return 26, nil
 }
 
@@ -7863,9 +8847,11 @@ func PackedEncodeInt216(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 27 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:27], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:27], tmp[5:])
 	return 27, nil
 }
 
@@ -7874,9 +8860,11 @@ func PackedEncodeInt224(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 28 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:28], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:28], tmp[4:])
 	return 28, nil
 }
 
@@ -7885,9 +8873,11 @@ func PackedEncodeInt232(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 29 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:29], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:29], tmp[3:])
 	return 29, nil
 }
 
@@ -7907,9 +8897,11 @@ func PackedEncodeInt240(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 30 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:30], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:30], tmp[2:])
 	return 30, nil
 }
 
@@ -7918,9 +8910,11 @@ func PackedEncodeInt248(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 31 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:31], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:31], tmp[1:])
 	return 31, nil
 }
 
@@ -7929,9 +8923,11 @@ func PackedEncodeInt256(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 32 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:32], tmp[0:])
 	return 32, nil
 }
 
@@ -8000,9 +8996,11 @@ func PackedEncodeInt72(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 9 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:9], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:9], tmp[23:])
 	return 9, nil
 }
 
@@ -8020,9 +9018,11 @@ func PackedEncodeInt80(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 10 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:10], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:10], tmp[22:])
 	return 10, nil
 }
 
@@ -8031,9 +9031,11 @@ func PackedEncodeInt88(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 11 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:11], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:11], tmp[21:])
 	return 11, nil
 }
 
@@ -8042,9 +9044,11 @@ func PackedEncodeInt96(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 12 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:12], true); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], true); err != nil {
 		return 0, err
 	}
+	copy(buf[:12], tmp[20:])
 	return 12, nil
 }
 
@@ -8053,9 +9057,11 @@ func PackedEncodeUint104(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 13 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:13], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:13], tmp[19:])
 	return 13, nil
 }
 
@@ -8064,9 +9070,11 @@ func PackedEncodeUint112(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 14 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:14], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:14], tmp[18:])
 	return 14, nil
 }
 
@@ -8075,9 +9083,11 @@ func PackedEncodeUint120(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 15 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:15], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:15], tmp[17:])
 	return 15, nil
 }
 
@@ -8086,9 +9096,11 @@ func PackedEncodeUint128(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 16 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:16], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:16], tmp[16:])
 	return 16, nil
 }
 
@@ -8097,9 +9109,11 @@ func PackedEncodeUint136(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 17 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:17], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:17], tmp[15:])
 	return 17, nil
 }
 
@@ -8108,9 +9122,11 @@ func PackedEncodeUint144(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 18 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:18], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:18], tmp[14:])
 	return 18, nil
 }
 
@@ -8119,9 +9135,11 @@ func PackedEncodeUint152(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 19 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:19], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:19], tmp[13:])
 	return 19, nil
 }
 
@@ -8139,9 +9157,11 @@ func PackedEncodeUint160(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 20 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:20], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:20], tmp[12:])
 	return 20, nil
 }
 
@@ -8150,9 +9170,11 @@ func PackedEncodeUint168(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 21 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:21], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:21], tmp[11:])
 	return 21, nil
 }
 
@@ -8161,9 +9183,11 @@ func PackedEncodeUint176(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 22 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:22], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:22], tmp[10:])
 	return 22, nil
 }
 
@@ -8172,9 +9196,11 @@ func PackedEncodeUint184(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 23 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:23], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:23], tmp[9:])
 	return 23, nil
 }
 
@@ -8183,9 +9209,11 @@ func PackedEncodeUint192(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 24 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:24], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:24], tmp[8:])
 	return 24, nil
 }
 
@@ -8194,9 +9222,11 @@ func PackedEncodeUint200(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 25 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:25], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:25], tmp[7:])
 	return 25, nil
 }
 
@@ -8205,9 +9235,11 @@ func PackedEncodeUint208(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 26 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:26], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:26], tmp[6:])
 	return 26, nil
 }
 
@@ -8216,9 +9248,11 @@ func PackedEncodeUint216(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 27 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:27], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:27], tmp[5:])
 	return 27, nil
 }
 
@@ -8227,9 +9261,11 @@ func PackedEncodeUint224(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 28 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:28], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:28], tmp[4:])
 	return 28, nil
 }
 
@@ -8238,9 +9274,11 @@ func PackedEncodeUint232(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 29 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:29], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:29], tmp[3:])
 	return 29, nil
 }
 
@@ -8260,9 +9298,11 @@ func PackedEncodeUint240(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 30 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:30], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:30], tmp[2:])
 	return 30, nil
 }
 
@@ -8271,9 +9311,11 @@ func PackedEncodeUint248(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 31 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:31], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:31], tmp[1:])
 	return 31, nil
 }
 
@@ -8282,9 +9324,11 @@ func PackedEncodeUint256(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 32 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:32], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:32], tmp[0:])
 	return 32, nil
 }
 
@@ -8353,9 +9397,11 @@ func PackedEncodeUint72(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 9 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:9], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:9], tmp[23:])
 	return 9, nil
 }
 
@@ -8373,9 +9419,11 @@ func PackedEncodeUint80(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 10 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:10], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:10], tmp[22:])
 	return 10, nil
 }
 
@@ -8384,9 +9432,11 @@ func PackedEncodeUint88(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 11 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:11], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:11], tmp[21:])
 	return 11, nil
 }
 
@@ -8395,9 +9445,11 @@ func PackedEncodeUint96(value *big.Int, buf []byte) (int, error) {
 	if len(buf) < 12 {
 		return 0, io.ErrShortBuffer
 	}
-	if err := EncodeBigInt(value, buf[:12], false); err != nil {
+	var tmp [32]byte
+	if err := EncodeBigInt(value, tmp[:], false); err != nil {
 		return 0, err
 	}
+	copy(buf[:12], tmp[20:])
 	return 12, nil
 }
 
@@ -8748,9 +9800,9 @@ func PackedDecodeInt104(data []byte) (*big.Int, int, error) {
 	if len(data) < 13 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:13], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:13])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 104))
 	}
 	return result, 13, nil
 }
@@ -8760,9 +9812,9 @@ func PackedDecodeInt112(data []byte) (*big.Int, int, error) {
 	if len(data) < 14 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:14], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:14])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 112))
 	}
 	return result, 14, nil
 }
@@ -8772,9 +9824,9 @@ func PackedDecodeInt120(data []byte) (*big.Int, int, error) {
 	if len(data) < 15 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:15], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:15])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 120))
 	}
 	return result, 15, nil
 }
@@ -8784,9 +9836,9 @@ func PackedDecodeInt128(data []byte) (*big.Int, int, error) {
 	if len(data) < 16 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:16], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:16])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 128))
 	}
 	return result, 16, nil
 }
@@ -8796,9 +9848,9 @@ func PackedDecodeInt136(data []byte) (*big.Int, int, error) {
 	if len(data) < 17 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:17], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:17])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 136))
 	}
 	return result, 17, nil
 }
@@ -8808,9 +9860,9 @@ func PackedDecodeInt144(data []byte) (*big.Int, int, error) {
 	if len(data) < 18 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:18], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:18])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 144))
 	}
 	return result, 18, nil
 }
@@ -8820,9 +9872,9 @@ func PackedDecodeInt152(data []byte) (*big.Int, int, error) {
 	if len(data) < 19 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:19], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:19])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 152))
 	}
 	return result, 19, nil
 }
@@ -8840,9 +9892,9 @@ func PackedDecodeInt160(data []byte) (*big.Int, int, error) {
 	if len(data) < 20 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:20], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:20])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 160))
 	}
 	return result, 20, nil
 }
@@ -8852,9 +9904,9 @@ func PackedDecodeInt168(data []byte) (*big.Int, int, error) {
 	if len(data) < 21 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:21], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:21])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 168))
 	}
 	return result, 21, nil
 }
@@ -8864,9 +9916,9 @@ func PackedDecodeInt176(data []byte) (*big.Int, int, error) {
 	if len(data) < 22 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:22], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:22])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 176))
 	}
 	return result, 22, nil
 }
@@ -8876,9 +9928,9 @@ func PackedDecodeInt184(data []byte) (*big.Int, int, error) {
 	if len(data) < 23 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:23], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:23])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 184))
 	}
 	return result, 23, nil
 }
@@ -8888,9 +9940,9 @@ func PackedDecodeInt192(data []byte) (*big.Int, int, error) {
 	if len(data) < 24 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:24], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:24])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 192))
 	}
 	return result, 24, nil
 }
@@ -8900,9 +9952,9 @@ func PackedDecodeInt200(data []byte) (*big.Int, int, error) {
 	if len(data) < 25 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:25], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:25])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 200))
 	}
 	return result, 25, nil
 }
@@ -8912,9 +9964,9 @@ func PackedDecodeInt208(data []byte) (*big.Int, int, error) {
 	if len(data) < 26 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:26], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:26])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 208))
 	}
 	return result, 26, nil
 }
@@ -8924,9 +9976,9 @@ func PackedDecodeInt216(data []byte) (*big.Int, int, error) {
 	if len(data) < 27 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:27], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:27])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 216))
 	}
 	return result, 27, nil
 }
@@ -8936,9 +9988,9 @@ func PackedDecodeInt224(data []byte) (*big.Int, int, error) {
 	if len(data) < 28 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:28], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:28])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 224))
 	}
 	return result, 28, nil
 }
@@ -8948,9 +10000,9 @@ func PackedDecodeInt232(data []byte) (*big.Int, int, error) {
 	if len(data) < 29 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:29], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:29])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 232))
 	}
 	return result, 29, nil
 }
@@ -8972,9 +10024,9 @@ func PackedDecodeInt240(data []byte) (*big.Int, int, error) {
 	if len(data) < 30 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:30], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:30])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 240))
 	}
 	return result, 30, nil
 }
@@ -8984,9 +10036,9 @@ func PackedDecodeInt248(data []byte) (*big.Int, int, error) {
 	if len(data) < 31 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:31], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:31])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 248))
 	}
 	return result, 31, nil
 }
@@ -8996,9 +10048,9 @@ func PackedDecodeInt256(data []byte) (*big.Int, int, error) {
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:32])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 256))
 	}
 	return result, 32, nil
 }
@@ -9060,9 +10112,9 @@ func PackedDecodeInt72(data []byte) (*big.Int, int, error) {
 	if len(data) < 9 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:9], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:9])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 72))
 	}
 	return result, 9, nil
 }
@@ -9080,9 +10132,9 @@ func PackedDecodeInt80(data []byte) (*big.Int, int, error) {
 	if len(data) < 10 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:10], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:10])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 80))
 	}
 	return result, 10, nil
 }
@@ -9092,9 +10144,9 @@ func PackedDecodeInt88(data []byte) (*big.Int, int, error) {
 	if len(data) < 11 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:11], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:11])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 88))
 	}
 	return result, 11, nil
 }
@@ -9104,9 +10156,9 @@ func PackedDecodeInt96(data []byte) (*big.Int, int, error) {
 	if len(data) < 12 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result, err := DecodeBigInt(data[:12], true)
-	if err != nil {
-		return nil, 0, err
+	result := new(big.Int).SetBytes(data[:12])
+	if data[0]&0x80 != 0 {
+		result.Sub(result, new(big.Int).Lsh(big.NewInt(1), 96))
 	}
 	return result, 12, nil
 }
@@ -9277,126 +10329,3024 @@ func PackedDecodeUint24(data []byte) (uint32, int, error) {
 	if len(data) < 3 {
 		return 0, 0, io.ErrUnexpectedEOF
 	}
-	return uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2]), 3, nil
+	return uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2]), 3, nil
+}
+
+// PackedDecodeUint240 decodes uint240 from packed ABI bytes (no padding)
+func PackedDecodeUint240(data []byte) (*big.Int, int, error) {
+	if len(data) < 30 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:30])
+	return result, 30, nil
+}
+
+// PackedDecodeUint248 decodes uint248 from packed ABI bytes (no padding)
+func PackedDecodeUint248(data []byte) (*big.Int, int, error) {
+	if len(data) < 31 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:31])
+	return result, 31, nil
+}
+
+// PackedDecodeUint256 decodes uint256 from packed ABI bytes (no padding)
+func PackedDecodeUint256(data []byte) (*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:32])
+	return result, 32, nil
+}
+
+// PackedDecodeUint32 decodes uint32 from packed ABI bytes (no padding)
+func PackedDecodeUint32(data []byte) (uint32, int, error) {
+	if len(data) < 4 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint32(data[:4]), 4, nil
+}
+
+// PackedDecodeUint40 decodes uint40 from packed ABI bytes (no padding)
+func PackedDecodeUint40(data []byte) (uint64, int, error) {
+	if len(data) < 5 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return uint64(data[0])<<32 | uint64(data[1])<<24 | uint64(data[2])<<16 | uint64(data[3])<<8 | uint64(data[4]), 5, nil
+}
+
+// PackedDecodeUint48 decodes uint48 from packed ABI bytes (no padding)
+func PackedDecodeUint48(data []byte) (uint64, int, error) {
+	if len(data) < 6 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return uint64(data[0])<<40 | uint64(data[1])<<32 | uint64(data[2])<<24 | uint64(data[3])<<16 | uint64(data[4])<<8 | uint64(data[5]), 6, nil
+}
+
+// PackedDecodeUint56 decodes uint56 from packed ABI bytes (no padding)
+func PackedDecodeUint56(data []byte) (uint64, int, error) {
+	if len(data) < 7 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return uint64(data[0])<<48 | uint64(data[1])<<40 | uint64(data[2])<<32 | uint64(data[3])<<24 | uint64(data[4])<<16 | uint64(data[5])<<8 | uint64(data[6]), 7, nil
+}
+
+// PackedDecodeUint64 decodes uint64 from packed ABI bytes (no padding)
+func PackedDecodeUint64(data []byte) (uint64, int, error) {
+	if len(data) < 8 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint64(data[:8]), 8, nil
+}
+
+// PackedDecodeUint72 decodes uint72 from packed ABI bytes (no padding)
+func PackedDecodeUint72(data []byte) (*big.Int, int, error) {
+	if len(data) < 9 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:9])
+	return result, 9, nil
+}
+
+// PackedDecodeUint8 decodes uint8 from packed ABI bytes (no padding)
+func PackedDecodeUint8(data []byte) (uint8, int, error) {
+	if len(data) < 1 {
+		return 0, 0, io.ErrUnexpectedEOF
+	}
+	return data[0], 1, nil
+}
+
+// PackedDecodeUint80 decodes uint80 from packed ABI bytes (no padding)
+func PackedDecodeUint80(data []byte) (*big.Int, int, error) {
+	if len(data) < 10 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:10])
+	return result, 10, nil
+}
+
+// PackedDecodeUint88 decodes uint88 from packed ABI bytes (no padding)
+func PackedDecodeUint88(data []byte) (*big.Int, int, error) {
+	if len(data) < 11 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:11])
+	return result, 11, nil
+}
+
+// PackedDecodeUint96 decodes uint96 from packed ABI bytes (no padding)
+func PackedDecodeUint96(data []byte) (*big.Int, int, error) {
+	if len(data) < 12 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(big.Int).SetBytes(data[:12])
+	return result, 12, nil
+}
+
+// EncodeTopLevelAddressSlice encodes address[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelAddressSlice(value []common.Address) ([]byte, error) {
+	buf := make([]byte, 32+SizeAddressSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeAddressSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelAddressSlice decodes address[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelAddressSlice(data []byte) ([]common.Address, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeAddressSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBoolSlice encodes bool[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBoolSlice(value []bool) ([]byte, error) {
+	buf := make([]byte, 32+SizeBoolSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBoolSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBoolSlice decodes bool[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBoolSlice(data []byte) ([]bool, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBoolSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes10Slice encodes bytes10[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes10Slice(value [][10]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes10Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes10Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes10Slice decodes bytes10[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes10Slice(data []byte) ([][10]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes10Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes11Slice encodes bytes11[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes11Slice(value [][11]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes11Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes11Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes11Slice decodes bytes11[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes11Slice(data []byte) ([][11]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes11Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes12Slice encodes bytes12[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes12Slice(value [][12]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes12Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes12Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes12Slice decodes bytes12[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes12Slice(data []byte) ([][12]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes12Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes13Slice encodes bytes13[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes13Slice(value [][13]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes13Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes13Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes13Slice decodes bytes13[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes13Slice(data []byte) ([][13]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes13Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes14Slice encodes bytes14[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes14Slice(value [][14]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes14Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes14Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes14Slice decodes bytes14[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes14Slice(data []byte) ([][14]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes14Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes15Slice encodes bytes15[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes15Slice(value [][15]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes15Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes15Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes15Slice decodes bytes15[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes15Slice(data []byte) ([][15]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes15Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes16Slice encodes bytes16[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes16Slice(value [][16]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes16Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes16Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes16Slice decodes bytes16[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes16Slice(data []byte) ([][16]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes16Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes17Slice encodes bytes17[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes17Slice(value [][17]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes17Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes17Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes17Slice decodes bytes17[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes17Slice(data []byte) ([][17]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes17Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes18Slice encodes bytes18[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes18Slice(value [][18]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes18Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes18Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes18Slice decodes bytes18[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes18Slice(data []byte) ([][18]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes18Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes19Slice encodes bytes19[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes19Slice(value [][19]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes19Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes19Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes19Slice decodes bytes19[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes19Slice(data []byte) ([][19]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes19Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes1Slice encodes bytes1[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes1Slice(value [][1]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes1Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes1Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes1Slice decodes bytes1[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes1Slice(data []byte) ([][1]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes1Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes20Slice encodes bytes20[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes20Slice(value [][20]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes20Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes20Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes20Slice decodes bytes20[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes20Slice(data []byte) ([][20]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes20Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes21Slice encodes bytes21[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes21Slice(value [][21]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes21Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes21Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes21Slice decodes bytes21[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes21Slice(data []byte) ([][21]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes21Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes22Slice encodes bytes22[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes22Slice(value [][22]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes22Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes22Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes22Slice decodes bytes22[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes22Slice(data []byte) ([][22]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes22Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes23Slice encodes bytes23[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes23Slice(value [][23]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes23Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes23Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes23Slice decodes bytes23[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes23Slice(data []byte) ([][23]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes23Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes24Slice encodes bytes24[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes24Slice(value [][24]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes24Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes24Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes24Slice decodes bytes24[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes24Slice(data []byte) ([][24]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes24Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes25Slice encodes bytes25[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes25Slice(value [][25]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes25Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes25Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes25Slice decodes bytes25[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes25Slice(data []byte) ([][25]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes25Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes26Slice encodes bytes26[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes26Slice(value [][26]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes26Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes26Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes26Slice decodes bytes26[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes26Slice(data []byte) ([][26]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes26Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes27Slice encodes bytes27[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes27Slice(value [][27]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes27Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes27Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes27Slice decodes bytes27[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes27Slice(data []byte) ([][27]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes27Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes28Slice encodes bytes28[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes28Slice(value [][28]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes28Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes28Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes28Slice decodes bytes28[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes28Slice(data []byte) ([][28]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes28Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes29Slice encodes bytes29[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes29Slice(value [][29]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes29Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes29Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes29Slice decodes bytes29[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes29Slice(data []byte) ([][29]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes29Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes2Slice encodes bytes2[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes2Slice(value [][2]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes2Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes2Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes2Slice decodes bytes2[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes2Slice(data []byte) ([][2]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes2Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes30Slice encodes bytes30[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes30Slice(value [][30]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes30Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes30Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes30Slice decodes bytes30[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes30Slice(data []byte) ([][30]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes30Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes31Slice encodes bytes31[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes31Slice(value [][31]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes31Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes31Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes31Slice decodes bytes31[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes31Slice(data []byte) ([][31]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes31Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes32Slice encodes bytes32[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes32Slice(value [][32]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes32Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes32Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes32Slice decodes bytes32[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes32Slice(data []byte) ([][32]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes32Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes3Slice encodes bytes3[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes3Slice(value [][3]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes3Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes3Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes3Slice decodes bytes3[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes3Slice(data []byte) ([][3]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes3Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes4Slice encodes bytes4[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes4Slice(value [][4]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes4Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes4Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes4Slice decodes bytes4[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes4Slice(data []byte) ([][4]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes4Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes5Slice encodes bytes5[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes5Slice(value [][5]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes5Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes5Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes5Slice decodes bytes5[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes5Slice(data []byte) ([][5]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes5Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes6Slice encodes bytes6[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes6Slice(value [][6]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes6Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes6Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes6Slice decodes bytes6[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes6Slice(data []byte) ([][6]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes6Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes7Slice encodes bytes7[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes7Slice(value [][7]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes7Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes7Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes7Slice decodes bytes7[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes7Slice(data []byte) ([][7]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes7Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes8Slice encodes bytes8[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes8Slice(value [][8]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes8Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes8Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes8Slice decodes bytes8[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes8Slice(data []byte) ([][8]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes8Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytes9Slice encodes bytes9[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytes9Slice(value [][9]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytes9Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytes9Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytes9Slice decodes bytes9[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytes9Slice(data []byte) ([][9]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytes9Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelBytesSlice encodes bytes[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelBytesSlice(value [][]byte) ([]byte, error) {
+	buf := make([]byte, 32+SizeBytesSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeBytesSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelBytesSlice decodes bytes[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelBytesSlice(data []byte) ([][]byte, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeBytesSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt104Slice encodes int104[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt104Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt104Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt104Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt104Slice decodes int104[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt104Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt104Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt112Slice encodes int112[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt112Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt112Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt112Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt112Slice decodes int112[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt112Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt112Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt120Slice encodes int120[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt120Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt120Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt120Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt120Slice decodes int120[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt120Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt120Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt128Slice encodes int128[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt128Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt128Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt128Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt128Slice decodes int128[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt128Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt128Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt136Slice encodes int136[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt136Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt136Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt136Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt136Slice decodes int136[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt136Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt136Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt144Slice encodes int144[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt144Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt144Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt144Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt144Slice decodes int144[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt144Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt144Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt152Slice encodes int152[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt152Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt152Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt152Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt152Slice decodes int152[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt152Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt152Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt160Slice encodes int160[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt160Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt160Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt160Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt160Slice decodes int160[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt160Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt160Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt168Slice encodes int168[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt168Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt168Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt168Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt168Slice decodes int168[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt168Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt168Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt16Slice encodes int16[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt16Slice(value []int16) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt16Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt16Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt16Slice decodes int16[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt16Slice(data []byte) ([]int16, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt16Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt176Slice encodes int176[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt176Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt176Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt176Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt176Slice decodes int176[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt176Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt176Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt184Slice encodes int184[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt184Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt184Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt184Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt184Slice decodes int184[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt184Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt184Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt192Slice encodes int192[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt192Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt192Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt192Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt192Slice decodes int192[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt192Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt192Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt200Slice encodes int200[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt200Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt200Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt200Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt200Slice decodes int200[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt200Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt200Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt208Slice encodes int208[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt208Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt208Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt208Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt208Slice decodes int208[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt208Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt208Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt216Slice encodes int216[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt216Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt216Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt216Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt216Slice decodes int216[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt216Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt216Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt224Slice encodes int224[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt224Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt224Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt224Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt224Slice decodes int224[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt224Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt224Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt232Slice encodes int232[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt232Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt232Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt232Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt232Slice decodes int232[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt232Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt232Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt240Slice encodes int240[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt240Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt240Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt240Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt240Slice decodes int240[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt240Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt240Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt248Slice encodes int248[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt248Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt248Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt248Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt248Slice decodes int248[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt248Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt248Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt24Slice encodes int24[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt24Slice(value []int32) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt24Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt24Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt24Slice decodes int24[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt24Slice(data []byte) ([]int32, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt24Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt256Slice encodes int256[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt256Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt256Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt256Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt256Slice decodes int256[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt256Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt256Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt32Slice encodes int32[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt32Slice(value []int32) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt32Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt32Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt32Slice decodes int32[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt32Slice(data []byte) ([]int32, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt32Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt40Slice encodes int40[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt40Slice(value []int64) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt40Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt40Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt40Slice decodes int40[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt40Slice(data []byte) ([]int64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt40Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt48Slice encodes int48[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt48Slice(value []int64) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt48Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt48Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt48Slice decodes int48[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt48Slice(data []byte) ([]int64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt48Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt56Slice encodes int56[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt56Slice(value []int64) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt56Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt56Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt56Slice decodes int56[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt56Slice(data []byte) ([]int64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt56Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt64Slice encodes int64[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt64Slice(value []int64) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt64Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt64Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt64Slice decodes int64[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt64Slice(data []byte) ([]int64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt64Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt72Slice encodes int72[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt72Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt72Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt72Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt72Slice decodes int72[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt72Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt72Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt80Slice encodes int80[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt80Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt80Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt80Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt80Slice decodes int80[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt80Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt80Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt88Slice encodes int88[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt88Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt88Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt88Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt88Slice decodes int88[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt88Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt88Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt8Slice encodes int8[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt8Slice(value []int8) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt8Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt8Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt8Slice decodes int8[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt8Slice(data []byte) ([]int8, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt8Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelInt96Slice encodes int96[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelInt96Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeInt96Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeInt96Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelInt96Slice decodes int96[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelInt96Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeInt96Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelStringSlice encodes string[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelStringSlice(value []string) ([]byte, error) {
+	buf := make([]byte, 32+SizeStringSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeStringSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelStringSlice decodes string[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelStringSlice(data []byte) ([]string, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeStringSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint104Slice encodes uint104[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint104Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint104Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint104Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint104Slice decodes uint104[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint104Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint104Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint112Slice encodes uint112[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint112Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint112Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint112Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint112Slice decodes uint112[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint112Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint112Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint120Slice encodes uint120[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint120Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint120Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint120Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint120Slice decodes uint120[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint120Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint120Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint128Slice encodes uint128[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint128Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint128Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint128Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint128Slice decodes uint128[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint128Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint128Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint136Slice encodes uint136[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint136Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint136Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint136Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint136Slice decodes uint136[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint136Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint136Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint144Slice encodes uint144[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint144Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint144Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint144Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint144Slice decodes uint144[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint144Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint144Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint152Slice encodes uint152[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint152Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint152Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint152Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint152Slice decodes uint152[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint152Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint152Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint160Slice encodes uint160[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint160Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint160Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint160Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint160Slice decodes uint160[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint160Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint160Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint168Slice encodes uint168[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint168Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint168Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint168Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint168Slice decodes uint168[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint168Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint168Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint16Slice encodes uint16[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint16Slice(value []uint16) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint16Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint16Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint16Slice decodes uint16[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint16Slice(data []byte) ([]uint16, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint16Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint176Slice encodes uint176[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint176Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint176Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint176Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint176Slice decodes uint176[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint176Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint176Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint184Slice encodes uint184[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint184Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint184Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint184Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint184Slice decodes uint184[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint184Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint184Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint192Slice encodes uint192[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint192Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint192Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint192Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint192Slice decodes uint192[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint192Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint192Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint200Slice encodes uint200[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint200Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint200Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint200Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint200Slice decodes uint200[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint200Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint200Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint208Slice encodes uint208[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint208Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint208Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint208Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint208Slice decodes uint208[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint208Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint208Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint216Slice encodes uint216[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint216Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint216Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint216Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint216Slice decodes uint216[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint216Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint216Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint224Slice encodes uint224[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint224Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint224Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint224Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint224Slice decodes uint224[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint224Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint224Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint232Slice encodes uint232[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint232Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint232Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint232Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint232Slice decodes uint232[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint232Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint232Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint240Slice encodes uint240[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint240Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint240Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint240Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint240Slice decodes uint240[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint240Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint240Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint248Slice encodes uint248[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint248Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint248Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint248Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint248Slice decodes uint248[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint248Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint248Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint24Slice encodes uint24[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint24Slice(value []uint32) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint24Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint24Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint24Slice decodes uint24[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint24Slice(data []byte) ([]uint32, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint24Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint256Slice encodes uint256[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint256Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint256Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint256Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint256Slice decodes uint256[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint256Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint256Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint32Slice encodes uint32[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint32Slice(value []uint32) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint32Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint32Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint32Slice decodes uint32[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint32Slice(data []byte) ([]uint32, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint32Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint40Slice encodes uint40[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint40Slice(value []uint64) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint40Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint40Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint40Slice decodes uint40[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint40Slice(data []byte) ([]uint64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint40Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint48Slice encodes uint48[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint48Slice(value []uint64) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint48Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint48Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint48Slice decodes uint48[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint48Slice(data []byte) ([]uint64, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint48Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint56Slice encodes uint56[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint56Slice(value []uint64) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint56Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint56Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// PackedDecodeUint240 decodes uint240 from packed ABI bytes (no padding)
-func PackedDecodeUint240(data []byte) (*big.Int, int, error) {
-	if len(data) < 30 {
+// DecodeTopLevelUint56Slice decodes uint56[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint56Slice(data []byte) ([]uint64, int, error) {
+	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result := new(big.Int).SetBytes(data[:30])
-	return result, 30, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint56Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint248 decodes uint248 from packed ABI bytes (no padding)
-func PackedDecodeUint248(data []byte) (*big.Int, int, error) {
-	if len(data) < 31 {
-		return nil, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint64Slice encodes uint64[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint64Slice(value []uint64) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint64Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint64Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	result := new(big.Int).SetBytes(data[:31])
-	return result, 31, nil
+	return buf, nil
 }
 
-// PackedDecodeUint256 decodes uint256 from packed ABI bytes (no padding)
-func PackedDecodeUint256(data []byte) (*big.Int, int, error) {
+// DecodeTopLevelUint64Slice decodes uint64[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint64Slice(data []byte) ([]uint64, int, error) {
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result := new(big.Int).SetBytes(data[:32])
-	return result, 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint64Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint32 decodes uint32 from packed ABI bytes (no padding)
-func PackedDecodeUint32(data []byte) (uint32, int, error) {
-	if len(data) < 4 {
-		return 0, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint72Slice encodes uint72[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint72Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint72Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint72Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	return binary.BigEndian.Uint32(data[:4]), 4, nil
+	return buf, nil
 }
 
-// PackedDecodeUint40 decodes uint40 from packed ABI bytes (no padding)
-func PackedDecodeUint40(data []byte) (uint64, int, error) {
-	if len(data) < 5 {
-		return 0, 0, io.ErrUnexpectedEOF
+// DecodeTopLevelUint72Slice decodes uint72[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint72Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return uint64(data[0])<<32 | uint64(data[1])<<24 | uint64(data[2])<<16 | uint64(data[3])<<8 | uint64(data[4]), 5, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint72Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint48 decodes uint48 from packed ABI bytes (no padding)
-func PackedDecodeUint48(data []byte) (uint64, int, error) {
-	if len(data) < 6 {
-		return 0, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint80Slice encodes uint80[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint80Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint80Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint80Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	return uint64(data[0])<<40 | uint64(data[1])<<32 | uint64(data[2])<<24 | uint64(data[3])<<16 | uint64(data[4])<<8 | uint64(data[5]), 6, nil
+	return buf, nil
 }
 
-// PackedDecodeUint56 decodes uint56 from packed ABI bytes (no padding)
-func PackedDecodeUint56(data []byte) (uint64, int, error) {
-	if len(data) < 7 {
-		return 0, 0, io.ErrUnexpectedEOF
+// DecodeTopLevelUint80Slice decodes uint80[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint80Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return uint64(data[0])<<48 | uint64(data[1])<<40 | uint64(data[2])<<32 | uint64(data[3])<<24 | uint64(data[4])<<16 | uint64(data[5])<<8 | uint64(data[6]), 7, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint80Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint64 decodes uint64 from packed ABI bytes (no padding)
-func PackedDecodeUint64(data []byte) (uint64, int, error) {
-	if len(data) < 8 {
-		return 0, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint88Slice encodes uint88[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint88Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint88Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint88Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	return binary.BigEndian.Uint64(data[:8]), 8, nil
+	return buf, nil
 }
 
-// PackedDecodeUint72 decodes uint72 from packed ABI bytes (no padding)
-func PackedDecodeUint72(data []byte) (*big.Int, int, error) {
-	if len(data) < 9 {
+// DecodeTopLevelUint88Slice decodes uint88[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint88Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result := new(big.Int).SetBytes(data[:9])
-	return result, 9, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint88Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint8 decodes uint8 from packed ABI bytes (no padding)
-func PackedDecodeUint8(data []byte) (uint8, int, error) {
-	if len(data) < 1 {
-		return 0, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint8Slice encodes uint8[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint8Slice(value []uint8) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint8Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint8Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	return data[0], 1, nil
+	return buf, nil
 }
 
-// PackedDecodeUint80 decodes uint80 from packed ABI bytes (no padding)
-func PackedDecodeUint80(data []byte) (*big.Int, int, error) {
-	if len(data) < 10 {
+// DecodeTopLevelUint8Slice decodes uint8[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint8Slice(data []byte) ([]uint8, int, error) {
+	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result := new(big.Int).SetBytes(data[:10])
-	return result, 10, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint8Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// PackedDecodeUint88 decodes uint88 from packed ABI bytes (no padding)
-func PackedDecodeUint88(data []byte) (*big.Int, int, error) {
-	if len(data) < 11 {
-		return nil, 0, io.ErrUnexpectedEOF
+// EncodeTopLevelUint96Slice encodes uint96[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint96Slice(value []*big.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint96Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint96Slice(value, buf[32:]); err != nil {
+		return nil, err
 	}
-	result := new(big.Int).SetBytes(data[:11])
-	return result, 11, nil
+	return buf, nil
 }
 
-// PackedDecodeUint96 decodes uint96 from packed ABI bytes (no padding)
-func PackedDecodeUint96(data []byte) (*big.Int, int, error) {
-	if len(data) < 12 {
+// DecodeTopLevelUint96Slice decodes uint96[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint96Slice(data []byte) ([]*big.Int, int, error) {
+	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
-	result := new(big.Int).SetBytes(data[:12])
-	return result, 12, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint96Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
 var _ Method = (*BasicCall)(nil)
 
 const BasicCallStaticSize = 320
 
-var _ Tuple = (*BasicCall)(nil)
-
 // BasicCall represents an ABI tuple
 type BasicCall struct {
 	Field1  bool
@@ -9450,6 +13400,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field4: string
 	// Encode offset pointer
+	ClearWord(buf[96:])
 	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeString(value.Field4, buf[dynamicOffset:])
@@ -9460,6 +13411,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field5: bytes
 	// Encode offset pointer
+	ClearWord(buf[128:])
 	binary.BigEndian.PutUint64(buf[128+24:128+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes(value.Field5, buf[dynamicOffset:])
@@ -9470,6 +13422,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field6: bool[]
 	// Encode offset pointer
+	ClearWord(buf[160:])
 	binary.BigEndian.PutUint64(buf[160+24:160+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBoolSlice(value.Field6, buf[dynamicOffset:])
@@ -9480,6 +13433,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field7: address[]
 	// Encode offset pointer
+	ClearWord(buf[192:])
 	binary.BigEndian.PutUint64(buf[192+24:192+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeAddressSlice(value.Field7, buf[dynamicOffset:])
@@ -9490,6 +13444,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field8: bytes32[]
 	// Encode offset pointer
+	ClearWord(buf[224:])
 	binary.BigEndian.PutUint64(buf[224+24:224+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes32Slice(value.Field8, buf[dynamicOffset:])
@@ -9500,6 +13455,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field9: string[]
 	// Encode offset pointer
+	ClearWord(buf[256:])
 	binary.BigEndian.PutUint64(buf[256+24:256+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeStringSlice(value.Field9, buf[dynamicOffset:])
@@ -9510,6 +13466,7 @@ func (value BasicCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field10: bytes[]
 	// Encode offset pointer
+	ClearWord(buf[288:])
 	binary.BigEndian.PutUint64(buf[288+24:288+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytesSlice(value.Field10, buf[dynamicOffset:])
@@ -9664,6 +13621,27 @@ func (t *BasicCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BasicCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *BasicCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes BasicCall from the hex string with an optional 0x prefix
+func (t *BasicCall) DecodeHex(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BasicCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ Tuple = (*BasicCall)(nil)
+var _ Decoder = (*BasicCall)(nil)
+
 // GetMethodName returns the function name
 func (t BasicCall) GetMethodName() string {
 	return "basic"
@@ -9679,9 +13657,14 @@ func (t BasicCall) GetMethodSelector() [4]byte {
 	return BasicSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of basic arguments including function selector
+func (t BasicCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes basic arguments to ABI bytes including function selector
 func (t BasicCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], BasicSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -9689,6 +13672,59 @@ func (t BasicCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes basic arguments to 0x prefixed hex string
+func (t BasicCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes basic arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t BasicCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the basic calldata, returns 0 if encoding fails
+func (t BasicCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes basic arguments from ABI bytes including function selector
+func (t *BasicCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BasicSelector {
+		return 0, ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes basic arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *BasicCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BasicCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewBasicCall constructs a new BasicCall
 func NewBasicCall(
 	field1 bool,
@@ -9725,8 +13761,6 @@ var _ Method = (*BytesCall)(nil)
 
 const BytesCallStaticSize = 2048
 
-var _ Tuple = (*BytesCall)(nil)
-
 // BytesCall represents an ABI tuple
 type BytesCall struct {
 	Field1  [1]byte
@@ -10004,6 +14038,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field33: bytes1[]
 	// Encode offset pointer
+	ClearWord(buf[1024:])
 	binary.BigEndian.PutUint64(buf[1024+24:1024+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes1Slice(value.Field33, buf[dynamicOffset:])
@@ -10014,6 +14049,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field34: bytes2[]
 	// Encode offset pointer
+	ClearWord(buf[1056:])
 	binary.BigEndian.PutUint64(buf[1056+24:1056+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes2Slice(value.Field34, buf[dynamicOffset:])
@@ -10024,6 +14060,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field35: bytes3[]
 	// Encode offset pointer
+	ClearWord(buf[1088:])
 	binary.BigEndian.PutUint64(buf[1088+24:1088+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes3Slice(value.Field35, buf[dynamicOffset:])
@@ -10034,6 +14071,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field36: bytes4[]
 	// Encode offset pointer
+	ClearWord(buf[1120:])
 	binary.BigEndian.PutUint64(buf[1120+24:1120+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes4Slice(value.Field36, buf[dynamicOffset:])
@@ -10044,6 +14082,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field37: bytes5[]
 	// Encode offset pointer
+	ClearWord(buf[1152:])
 	binary.BigEndian.PutUint64(buf[1152+24:1152+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes5Slice(value.Field37, buf[dynamicOffset:])
@@ -10054,6 +14093,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field38: bytes6[]
 	// Encode offset pointer
+	ClearWord(buf[1184:])
 	binary.BigEndian.PutUint64(buf[1184+24:1184+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes6Slice(value.Field38, buf[dynamicOffset:])
@@ -10064,6 +14104,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field39: bytes7[]
 	// Encode offset pointer
+	ClearWord(buf[1216:])
 	binary.BigEndian.PutUint64(buf[1216+24:1216+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes7Slice(value.Field39, buf[dynamicOffset:])
@@ -10074,6 +14115,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field40: bytes8[]
 	// Encode offset pointer
+	ClearWord(buf[1248:])
 	binary.BigEndian.PutUint64(buf[1248+24:1248+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes8Slice(value.Field40, buf[dynamicOffset:])
@@ -10084,6 +14126,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field41: bytes9[]
 	// Encode offset pointer
+	ClearWord(buf[1280:])
 	binary.BigEndian.PutUint64(buf[1280+24:1280+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes9Slice(value.Field41, buf[dynamicOffset:])
@@ -10094,6 +14137,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field42: bytes10[]
 	// Encode offset pointer
+	ClearWord(buf[1312:])
 	binary.BigEndian.PutUint64(buf[1312+24:1312+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes10Slice(value.Field42, buf[dynamicOffset:])
@@ -10104,6 +14148,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field43: bytes11[]
 	// Encode offset pointer
+	ClearWord(buf[1344:])
 	binary.BigEndian.PutUint64(buf[1344+24:1344+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes11Slice(value.Field43, buf[dynamicOffset:])
@@ -10114,6 +14159,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field44: bytes12[]
 	// Encode offset pointer
+	ClearWord(buf[1376:])
 	binary.BigEndian.PutUint64(buf[1376+24:1376+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes12Slice(value.Field44, buf[dynamicOffset:])
@@ -10124,6 +14170,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field45: bytes13[]
 	// Encode offset pointer
+	ClearWord(buf[1408:])
 	binary.BigEndian.PutUint64(buf[1408+24:1408+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes13Slice(value.Field45, buf[dynamicOffset:])
@@ -10134,6 +14181,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field46: bytes14[]
 	// Encode offset pointer
+	ClearWord(buf[1440:])
 	binary.BigEndian.PutUint64(buf[1440+24:1440+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes14Slice(value.Field46, buf[dynamicOffset:])
@@ -10144,6 +14192,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field47: bytes15[]
 	// Encode offset pointer
+	ClearWord(buf[1472:])
 	binary.BigEndian.PutUint64(buf[1472+24:1472+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes15Slice(value.Field47, buf[dynamicOffset:])
@@ -10154,6 +14203,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field48: bytes16[]
 	// Encode offset pointer
+	ClearWord(buf[1504:])
 	binary.BigEndian.PutUint64(buf[1504+24:1504+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes16Slice(value.Field48, buf[dynamicOffset:])
@@ -10164,6 +14214,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field49: bytes17[]
 	// Encode offset pointer
+	ClearWord(buf[1536:])
 	binary.BigEndian.PutUint64(buf[1536+24:1536+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes17Slice(value.Field49, buf[dynamicOffset:])
@@ -10174,6 +14225,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field50: bytes18[]
 	// Encode offset pointer
+	ClearWord(buf[1568:])
 	binary.BigEndian.PutUint64(buf[1568+24:1568+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes18Slice(value.Field50, buf[dynamicOffset:])
@@ -10184,6 +14236,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field51: bytes19[]
 	// Encode offset pointer
+	ClearWord(buf[1600:])
 	binary.BigEndian.PutUint64(buf[1600+24:1600+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes19Slice(value.Field51, buf[dynamicOffset:])
@@ -10194,6 +14247,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field52: bytes20[]
 	// Encode offset pointer
+	ClearWord(buf[1632:])
 	binary.BigEndian.PutUint64(buf[1632+24:1632+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes20Slice(value.Field52, buf[dynamicOffset:])
@@ -10204,6 +14258,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field53: bytes21[]
 	// Encode offset pointer
+	ClearWord(buf[1664:])
 	binary.BigEndian.PutUint64(buf[1664+24:1664+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes21Slice(value.Field53, buf[dynamicOffset:])
@@ -10214,6 +14269,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field54: bytes22[]
 	// Encode offset pointer
+	ClearWord(buf[1696:])
 	binary.BigEndian.PutUint64(buf[1696+24:1696+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes22Slice(value.Field54, buf[dynamicOffset:])
@@ -10224,6 +14280,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field55: bytes23[]
 	// Encode offset pointer
+	ClearWord(buf[1728:])
 	binary.BigEndian.PutUint64(buf[1728+24:1728+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes23Slice(value.Field55, buf[dynamicOffset:])
@@ -10234,6 +14291,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field56: bytes24[]
 	// Encode offset pointer
+	ClearWord(buf[1760:])
 	binary.BigEndian.PutUint64(buf[1760+24:1760+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes24Slice(value.Field56, buf[dynamicOffset:])
@@ -10244,6 +14302,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field57: bytes25[]
 	// Encode offset pointer
+	ClearWord(buf[1792:])
 	binary.BigEndian.PutUint64(buf[1792+24:1792+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes25Slice(value.Field57, buf[dynamicOffset:])
@@ -10254,6 +14313,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field58: bytes26[]
 	// Encode offset pointer
+	ClearWord(buf[1824:])
 	binary.BigEndian.PutUint64(buf[1824+24:1824+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes26Slice(value.Field58, buf[dynamicOffset:])
@@ -10264,6 +14324,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field59: bytes27[]
 	// Encode offset pointer
+	ClearWord(buf[1856:])
 	binary.BigEndian.PutUint64(buf[1856+24:1856+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes27Slice(value.Field59, buf[dynamicOffset:])
@@ -10274,6 +14335,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field60: bytes28[]
 	// Encode offset pointer
+	ClearWord(buf[1888:])
 	binary.BigEndian.PutUint64(buf[1888+24:1888+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes28Slice(value.Field60, buf[dynamicOffset:])
@@ -10284,6 +14346,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field61: bytes29[]
 	// Encode offset pointer
+	ClearWord(buf[1920:])
 	binary.BigEndian.PutUint64(buf[1920+24:1920+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes29Slice(value.Field61, buf[dynamicOffset:])
@@ -10294,6 +14357,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field62: bytes30[]
 	// Encode offset pointer
+	ClearWord(buf[1952:])
 	binary.BigEndian.PutUint64(buf[1952+24:1952+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes30Slice(value.Field62, buf[dynamicOffset:])
@@ -10304,6 +14368,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field63: bytes31[]
 	// Encode offset pointer
+	ClearWord(buf[1984:])
 	binary.BigEndian.PutUint64(buf[1984+24:1984+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes31Slice(value.Field63, buf[dynamicOffset:])
@@ -10314,6 +14379,7 @@ func (value BytesCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field64: bytes32[]
 	// Encode offset pointer
+	ClearWord(buf[2016:])
 	binary.BigEndian.PutUint64(buf[2016+24:2016+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeBytes32Slice(value.Field64, buf[dynamicOffset:])
@@ -10988,6 +15054,27 @@ func (t *BytesCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BytesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *BytesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes BytesCall from the hex string with an optional 0x prefix
+func (t *BytesCall) DecodeHex(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BytesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ Tuple = (*BytesCall)(nil)
+var _ Decoder = (*BytesCall)(nil)
+
 // GetMethodName returns the function name
 func (t BytesCall) GetMethodName() string {
 	return "bytes"
@@ -11003,9 +15090,14 @@ func (t BytesCall) GetMethodSelector() [4]byte {
 	return BytesSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of bytes arguments including function selector
+func (t BytesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes bytes arguments to ABI bytes including function selector
 func (t BytesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], BytesSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -11013,6 +15105,59 @@ func (t BytesCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes bytes arguments to 0x prefixed hex string
+func (t BytesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes bytes arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t BytesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the bytes calldata, returns 0 if encoding fails
+func (t BytesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes bytes arguments from ABI bytes including function selector
+func (t *BytesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BytesSelector {
+		return 0, ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes bytes arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *BytesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BytesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewBytesCall constructs a new BytesCall
 func NewBytesCall(
 	field1 [1]byte,
@@ -11157,8 +15302,6 @@ var _ Method = (*IntsCall)(nil)
 
 const IntsCallStaticSize = 4096
 
-var _ Tuple = (*IntsCall)(nil)
-
 // IntsCall represents an ABI tuple
 type IntsCall struct {
 	Field1   uint8
@@ -11692,6 +15835,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field65: uint8[]
 	// Encode offset pointer
+	ClearWord(buf[2048:])
 	binary.BigEndian.PutUint64(buf[2048+24:2048+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint8Slice(value.Field65, buf[dynamicOffset:])
@@ -11702,6 +15846,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field66: int8[]
 	// Encode offset pointer
+	ClearWord(buf[2080:])
 	binary.BigEndian.PutUint64(buf[2080+24:2080+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt8Slice(value.Field66, buf[dynamicOffset:])
@@ -11712,6 +15857,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field67: uint16[]
 	// Encode offset pointer
+	ClearWord(buf[2112:])
 	binary.BigEndian.PutUint64(buf[2112+24:2112+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint16Slice(value.Field67, buf[dynamicOffset:])
@@ -11722,6 +15868,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field68: int16[]
 	// Encode offset pointer
+	ClearWord(buf[2144:])
 	binary.BigEndian.PutUint64(buf[2144+24:2144+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt16Slice(value.Field68, buf[dynamicOffset:])
@@ -11732,6 +15879,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field69: uint24[]
 	// Encode offset pointer
+	ClearWord(buf[2176:])
 	binary.BigEndian.PutUint64(buf[2176+24:2176+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint24Slice(value.Field69, buf[dynamicOffset:])
@@ -11742,6 +15890,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field70: int24[]
 	// Encode offset pointer
+	ClearWord(buf[2208:])
 	binary.BigEndian.PutUint64(buf[2208+24:2208+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt24Slice(value.Field70, buf[dynamicOffset:])
@@ -11752,6 +15901,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field71: uint32[]
 	// Encode offset pointer
+	ClearWord(buf[2240:])
 	binary.BigEndian.PutUint64(buf[2240+24:2240+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint32Slice(value.Field71, buf[dynamicOffset:])
@@ -11762,6 +15912,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field72: int32[]
 	// Encode offset pointer
+	ClearWord(buf[2272:])
 	binary.BigEndian.PutUint64(buf[2272+24:2272+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt32Slice(value.Field72, buf[dynamicOffset:])
@@ -11772,6 +15923,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field73: uint40[]
 	// Encode offset pointer
+	ClearWord(buf[2304:])
 	binary.BigEndian.PutUint64(buf[2304+24:2304+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint40Slice(value.Field73, buf[dynamicOffset:])
@@ -11782,6 +15934,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field74: int40[]
 	// Encode offset pointer
+	ClearWord(buf[2336:])
 	binary.BigEndian.PutUint64(buf[2336+24:2336+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt40Slice(value.Field74, buf[dynamicOffset:])
@@ -11792,6 +15945,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field75: uint48[]
 	// Encode offset pointer
+	ClearWord(buf[2368:])
 	binary.BigEndian.PutUint64(buf[2368+24:2368+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint48Slice(value.Field75, buf[dynamicOffset:])
@@ -11802,6 +15956,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field76: int48[]
 	// Encode offset pointer
+	ClearWord(buf[2400:])
 	binary.BigEndian.PutUint64(buf[2400+24:2400+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt48Slice(value.Field76, buf[dynamicOffset:])
@@ -11812,6 +15967,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field77: uint56[]
 	// Encode offset pointer
+	ClearWord(buf[2432:])
 	binary.BigEndian.PutUint64(buf[2432+24:2432+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint56Slice(value.Field77, buf[dynamicOffset:])
@@ -11822,6 +15978,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field78: int56[]
 	// Encode offset pointer
+	ClearWord(buf[2464:])
 	binary.BigEndian.PutUint64(buf[2464+24:2464+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt56Slice(value.Field78, buf[dynamicOffset:])
@@ -11832,6 +15989,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field79: uint64[]
 	// Encode offset pointer
+	ClearWord(buf[2496:])
 	binary.BigEndian.PutUint64(buf[2496+24:2496+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint64Slice(value.Field79, buf[dynamicOffset:])
@@ -11842,6 +16000,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field80: int64[]
 	// Encode offset pointer
+	ClearWord(buf[2528:])
 	binary.BigEndian.PutUint64(buf[2528+24:2528+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt64Slice(value.Field80, buf[dynamicOffset:])
@@ -11852,6 +16011,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field81: uint72[]
 	// Encode offset pointer
+	ClearWord(buf[2560:])
 	binary.BigEndian.PutUint64(buf[2560+24:2560+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint72Slice(value.Field81, buf[dynamicOffset:])
@@ -11862,6 +16022,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field82: int72[]
 	// Encode offset pointer
+	ClearWord(buf[2592:])
 	binary.BigEndian.PutUint64(buf[2592+24:2592+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt72Slice(value.Field82, buf[dynamicOffset:])
@@ -11872,6 +16033,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field83: uint80[]
 	// Encode offset pointer
+	ClearWord(buf[2624:])
 	binary.BigEndian.PutUint64(buf[2624+24:2624+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint80Slice(value.Field83, buf[dynamicOffset:])
@@ -11882,6 +16044,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field84: int80[]
 	// Encode offset pointer
+	ClearWord(buf[2656:])
 	binary.BigEndian.PutUint64(buf[2656+24:2656+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt80Slice(value.Field84, buf[dynamicOffset:])
@@ -11892,6 +16055,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field85: uint88[]
 	// Encode offset pointer
+	ClearWord(buf[2688:])
 	binary.BigEndian.PutUint64(buf[2688+24:2688+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint88Slice(value.Field85, buf[dynamicOffset:])
@@ -11902,6 +16066,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field86: int88[]
 	// Encode offset pointer
+	ClearWord(buf[2720:])
 	binary.BigEndian.PutUint64(buf[2720+24:2720+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt88Slice(value.Field86, buf[dynamicOffset:])
@@ -11912,6 +16077,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field87: uint96[]
 	// Encode offset pointer
+	ClearWord(buf[2752:])
 	binary.BigEndian.PutUint64(buf[2752+24:2752+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint96Slice(value.Field87, buf[dynamicOffset:])
@@ -11922,6 +16088,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field88: int96[]
 	// Encode offset pointer
+	ClearWord(buf[2784:])
 	binary.BigEndian.PutUint64(buf[2784+24:2784+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt96Slice(value.Field88, buf[dynamicOffset:])
@@ -11932,6 +16099,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field89: uint104[]
 	// Encode offset pointer
+	ClearWord(buf[2816:])
 	binary.BigEndian.PutUint64(buf[2816+24:2816+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint104Slice(value.Field89, buf[dynamicOffset:])
@@ -11942,6 +16110,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field90: int104[]
 	// Encode offset pointer
+	ClearWord(buf[2848:])
 	binary.BigEndian.PutUint64(buf[2848+24:2848+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt104Slice(value.Field90, buf[dynamicOffset:])
@@ -11952,6 +16121,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field91: uint112[]
 	// Encode offset pointer
+	ClearWord(buf[2880:])
 	binary.BigEndian.PutUint64(buf[2880+24:2880+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint112Slice(value.Field91, buf[dynamicOffset:])
@@ -11962,6 +16132,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field92: int112[]
 	// Encode offset pointer
+	ClearWord(buf[2912:])
 	binary.BigEndian.PutUint64(buf[2912+24:2912+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt112Slice(value.Field92, buf[dynamicOffset:])
@@ -11972,6 +16143,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field93: uint120[]
 	// Encode offset pointer
+	ClearWord(buf[2944:])
 	binary.BigEndian.PutUint64(buf[2944+24:2944+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint120Slice(value.Field93, buf[dynamicOffset:])
@@ -11982,6 +16154,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field94: int120[]
 	// Encode offset pointer
+	ClearWord(buf[2976:])
 	binary.BigEndian.PutUint64(buf[2976+24:2976+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt120Slice(value.Field94, buf[dynamicOffset:])
@@ -11992,6 +16165,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field95: uint128[]
 	// Encode offset pointer
+	ClearWord(buf[3008:])
 	binary.BigEndian.PutUint64(buf[3008+24:3008+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint128Slice(value.Field95, buf[dynamicOffset:])
@@ -12002,6 +16176,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field96: int128[]
 	// Encode offset pointer
+	ClearWord(buf[3040:])
 	binary.BigEndian.PutUint64(buf[3040+24:3040+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt128Slice(value.Field96, buf[dynamicOffset:])
@@ -12012,6 +16187,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field97: uint136[]
 	// Encode offset pointer
+	ClearWord(buf[3072:])
 	binary.BigEndian.PutUint64(buf[3072+24:3072+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint136Slice(value.Field97, buf[dynamicOffset:])
@@ -12022,6 +16198,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field98: int136[]
 	// Encode offset pointer
+	ClearWord(buf[3104:])
 	binary.BigEndian.PutUint64(buf[3104+24:3104+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt136Slice(value.Field98, buf[dynamicOffset:])
@@ -12032,6 +16209,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field99: uint144[]
 	// Encode offset pointer
+	ClearWord(buf[3136:])
 	binary.BigEndian.PutUint64(buf[3136+24:3136+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint144Slice(value.Field99, buf[dynamicOffset:])
@@ -12042,6 +16220,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field100: int144[]
 	// Encode offset pointer
+	ClearWord(buf[3168:])
 	binary.BigEndian.PutUint64(buf[3168+24:3168+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt144Slice(value.Field100, buf[dynamicOffset:])
@@ -12052,6 +16231,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field101: uint152[]
 	// Encode offset pointer
+	ClearWord(buf[3200:])
 	binary.BigEndian.PutUint64(buf[3200+24:3200+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint152Slice(value.Field101, buf[dynamicOffset:])
@@ -12062,6 +16242,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field102: int152[]
 	// Encode offset pointer
+	ClearWord(buf[3232:])
 	binary.BigEndian.PutUint64(buf[3232+24:3232+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt152Slice(value.Field102, buf[dynamicOffset:])
@@ -12072,6 +16253,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field103: uint160[]
 	// Encode offset pointer
+	ClearWord(buf[3264:])
 	binary.BigEndian.PutUint64(buf[3264+24:3264+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint160Slice(value.Field103, buf[dynamicOffset:])
@@ -12082,6 +16264,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field104: int160[]
 	// Encode offset pointer
+	ClearWord(buf[3296:])
 	binary.BigEndian.PutUint64(buf[3296+24:3296+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt160Slice(value.Field104, buf[dynamicOffset:])
@@ -12092,6 +16275,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field105: uint168[]
 	// Encode offset pointer
+	ClearWord(buf[3328:])
 	binary.BigEndian.PutUint64(buf[3328+24:3328+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint168Slice(value.Field105, buf[dynamicOffset:])
@@ -12102,6 +16286,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field106: int168[]
 	// Encode offset pointer
+	ClearWord(buf[3360:])
 	binary.BigEndian.PutUint64(buf[3360+24:3360+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt168Slice(value.Field106, buf[dynamicOffset:])
@@ -12112,6 +16297,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field107: uint176[]
 	// Encode offset pointer
+	ClearWord(buf[3392:])
 	binary.BigEndian.PutUint64(buf[3392+24:3392+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint176Slice(value.Field107, buf[dynamicOffset:])
@@ -12122,6 +16308,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field108: int176[]
 	// Encode offset pointer
+	ClearWord(buf[3424:])
 	binary.BigEndian.PutUint64(buf[3424+24:3424+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt176Slice(value.Field108, buf[dynamicOffset:])
@@ -12132,6 +16319,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field109: uint184[]
 	// Encode offset pointer
+	ClearWord(buf[3456:])
 	binary.BigEndian.PutUint64(buf[3456+24:3456+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint184Slice(value.Field109, buf[dynamicOffset:])
@@ -12142,6 +16330,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field110: int184[]
 	// Encode offset pointer
+	ClearWord(buf[3488:])
 	binary.BigEndian.PutUint64(buf[3488+24:3488+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt184Slice(value.Field110, buf[dynamicOffset:])
@@ -12152,6 +16341,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field111: uint192[]
 	// Encode offset pointer
+	ClearWord(buf[3520:])
 	binary.BigEndian.PutUint64(buf[3520+24:3520+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint192Slice(value.Field111, buf[dynamicOffset:])
@@ -12162,6 +16352,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field112: int192[]
 	// Encode offset pointer
+	ClearWord(buf[3552:])
 	binary.BigEndian.PutUint64(buf[3552+24:3552+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt192Slice(value.Field112, buf[dynamicOffset:])
@@ -12172,6 +16363,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field113: uint200[]
 	// Encode offset pointer
+	ClearWord(buf[3584:])
 	binary.BigEndian.PutUint64(buf[3584+24:3584+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint200Slice(value.Field113, buf[dynamicOffset:])
@@ -12182,6 +16374,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field114: int200[]
 	// Encode offset pointer
+	ClearWord(buf[3616:])
 	binary.BigEndian.PutUint64(buf[3616+24:3616+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt200Slice(value.Field114, buf[dynamicOffset:])
@@ -12192,6 +16385,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field115: uint208[]
 	// Encode offset pointer
+	ClearWord(buf[3648:])
 	binary.BigEndian.PutUint64(buf[3648+24:3648+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint208Slice(value.Field115, buf[dynamicOffset:])
@@ -12202,6 +16396,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field116: int208[]
 	// Encode offset pointer
+	ClearWord(buf[3680:])
 	binary.BigEndian.PutUint64(buf[3680+24:3680+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt208Slice(value.Field116, buf[dynamicOffset:])
@@ -12212,6 +16407,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field117: uint216[]
 	// Encode offset pointer
+	ClearWord(buf[3712:])
 	binary.BigEndian.PutUint64(buf[3712+24:3712+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint216Slice(value.Field117, buf[dynamicOffset:])
@@ -12222,6 +16418,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field118: int216[]
 	// Encode offset pointer
+	ClearWord(buf[3744:])
 	binary.BigEndian.PutUint64(buf[3744+24:3744+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt216Slice(value.Field118, buf[dynamicOffset:])
@@ -12232,6 +16429,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field119: uint224[]
 	// Encode offset pointer
+	ClearWord(buf[3776:])
 	binary.BigEndian.PutUint64(buf[3776+24:3776+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint224Slice(value.Field119, buf[dynamicOffset:])
@@ -12242,6 +16440,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field120: int224[]
 	// Encode offset pointer
+	ClearWord(buf[3808:])
 	binary.BigEndian.PutUint64(buf[3808+24:3808+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt224Slice(value.Field120, buf[dynamicOffset:])
@@ -12252,6 +16451,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field121: uint232[]
 	// Encode offset pointer
+	ClearWord(buf[3840:])
 	binary.BigEndian.PutUint64(buf[3840+24:3840+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint232Slice(value.Field121, buf[dynamicOffset:])
@@ -12262,6 +16462,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field122: int232[]
 	// Encode offset pointer
+	ClearWord(buf[3872:])
 	binary.BigEndian.PutUint64(buf[3872+24:3872+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt232Slice(value.Field122, buf[dynamicOffset:])
@@ -12272,6 +16473,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field123: uint240[]
 	// Encode offset pointer
+	ClearWord(buf[3904:])
 	binary.BigEndian.PutUint64(buf[3904+24:3904+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint240Slice(value.Field123, buf[dynamicOffset:])
@@ -12282,6 +16484,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field124: int240[]
 	// Encode offset pointer
+	ClearWord(buf[3936:])
 	binary.BigEndian.PutUint64(buf[3936+24:3936+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt240Slice(value.Field124, buf[dynamicOffset:])
@@ -12292,6 +16495,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field125: uint248[]
 	// Encode offset pointer
+	ClearWord(buf[3968:])
 	binary.BigEndian.PutUint64(buf[3968+24:3968+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint248Slice(value.Field125, buf[dynamicOffset:])
@@ -12302,6 +16506,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field126: int248[]
 	// Encode offset pointer
+	ClearWord(buf[4000:])
 	binary.BigEndian.PutUint64(buf[4000+24:4000+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt248Slice(value.Field126, buf[dynamicOffset:])
@@ -12312,6 +16517,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field127: uint256[]
 	// Encode offset pointer
+	ClearWord(buf[4032:])
 	binary.BigEndian.PutUint64(buf[4032+24:4032+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUint256Slice(value.Field127, buf[dynamicOffset:])
@@ -12322,6 +16528,7 @@ func (value IntsCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field128: int256[]
 	// Encode offset pointer
+	ClearWord(buf[4064:])
 	binary.BigEndian.PutUint64(buf[4064+24:4064+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeInt256Slice(value.Field128, buf[dynamicOffset:])
@@ -12434,242 +16641,242 @@ func (t *IntsCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Field17: uint72
-	t.Field17, _, err = DecodeUint72(data[512:])
+	t.Field17, _, err = DecodeIntoUint72(t.Field17, data[512:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field18: int72
-	t.Field18, _, err = DecodeInt72(data[544:])
+	t.Field18, _, err = DecodeIntoInt72(t.Field18, data[544:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field19: uint80
-	t.Field19, _, err = DecodeUint80(data[576:])
+	t.Field19, _, err = DecodeIntoUint80(t.Field19, data[576:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field20: int80
-	t.Field20, _, err = DecodeInt80(data[608:])
+	t.Field20, _, err = DecodeIntoInt80(t.Field20, data[608:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field21: uint88
-	t.Field21, _, err = DecodeUint88(data[640:])
+	t.Field21, _, err = DecodeIntoUint88(t.Field21, data[640:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field22: int88
-	t.Field22, _, err = DecodeInt88(data[672:])
+	t.Field22, _, err = DecodeIntoInt88(t.Field22, data[672:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field23: uint96
-	t.Field23, _, err = DecodeUint96(data[704:])
+	t.Field23, _, err = DecodeIntoUint96(t.Field23, data[704:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field24: int96
-	t.Field24, _, err = DecodeInt96(data[736:])
+	t.Field24, _, err = DecodeIntoInt96(t.Field24, data[736:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field25: uint104
-	t.Field25, _, err = DecodeUint104(data[768:])
+	t.Field25, _, err = DecodeIntoUint104(t.Field25, data[768:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field26: int104
-	t.Field26, _, err = DecodeInt104(data[800:])
+	t.Field26, _, err = DecodeIntoInt104(t.Field26, data[800:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field27: uint112
-	t.Field27, _, err = DecodeUint112(data[832:])
+	t.Field27, _, err = DecodeIntoUint112(t.Field27, data[832:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field28: int112
-	t.Field28, _, err = DecodeInt112(data[864:])
+	t.Field28, _, err = DecodeIntoInt112(t.Field28, data[864:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field29: uint120
-	t.Field29, _, err = DecodeUint120(data[896:])
+	t.Field29, _, err = DecodeIntoUint120(t.Field29, data[896:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field30: int120
-	t.Field30, _, err = DecodeInt120(data[928:])
+	t.Field30, _, err = DecodeIntoInt120(t.Field30, data[928:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field31: uint128
-	t.Field31, _, err = DecodeUint128(data[960:])
+	t.Field31, _, err = DecodeIntoUint128(t.Field31, data[960:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field32: int128
-	t.Field32, _, err = DecodeInt128(data[992:])
+	t.Field32, _, err = DecodeIntoInt128(t.Field32, data[992:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field33: uint136
-	t.Field33, _, err = DecodeUint136(data[1024:])
+	t.Field33, _, err = DecodeIntoUint136(t.Field33, data[1024:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field34: int136
-	t.Field34, _, err = DecodeInt136(data[1056:])
+	t.Field34, _, err = DecodeIntoInt136(t.Field34, data[1056:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field35: uint144
-	t.Field35, _, err = DecodeUint144(data[1088:])
+	t.Field35, _, err = DecodeIntoUint144(t.Field35, data[1088:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field36: int144
-	t.Field36, _, err = DecodeInt144(data[1120:])
+	t.Field36, _, err = DecodeIntoInt144(t.Field36, data[1120:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field37: uint152
-	t.Field37, _, err = DecodeUint152(data[1152:])
+	t.Field37, _, err = DecodeIntoUint152(t.Field37, data[1152:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field38: int152
-	t.Field38, _, err = DecodeInt152(data[1184:])
+	t.Field38, _, err = DecodeIntoInt152(t.Field38, data[1184:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field39: uint160
-	t.Field39, _, err = DecodeUint160(data[1216:])
+	t.Field39, _, err = DecodeIntoUint160(t.Field39, data[1216:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field40: int160
-	t.Field40, _, err = DecodeInt160(data[1248:])
+	t.Field40, _, err = DecodeIntoInt160(t.Field40, data[1248:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field41: uint168
-	t.Field41, _, err = DecodeUint168(data[1280:])
+	t.Field41, _, err = DecodeIntoUint168(t.Field41, data[1280:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field42: int168
-	t.Field42, _, err = DecodeInt168(data[1312:])
+	t.Field42, _, err = DecodeIntoInt168(t.Field42, data[1312:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field43: uint176
-	t.Field43, _, err = DecodeUint176(data[1344:])
+	t.Field43, _, err = DecodeIntoUint176(t.Field43, data[1344:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field44: int176
-	t.Field44, _, err = DecodeInt176(data[1376:])
+	t.Field44, _, err = DecodeIntoInt176(t.Field44, data[1376:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field45: uint184
-	t.Field45, _, err = DecodeUint184(data[1408:])
+	t.Field45, _, err = DecodeIntoUint184(t.Field45, data[1408:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field46: int184
-	t.Field46, _, err = DecodeInt184(data[1440:])
+	t.Field46, _, err = DecodeIntoInt184(t.Field46, data[1440:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field47: uint192
-	t.Field47, _, err = DecodeUint192(data[1472:])
+	t.Field47, _, err = DecodeIntoUint192(t.Field47, data[1472:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field48: int192
-	t.Field48, _, err = DecodeInt192(data[1504:])
+	t.Field48, _, err = DecodeIntoInt192(t.Field48, data[1504:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field49: uint200
-	t.Field49, _, err = DecodeUint200(data[1536:])
+	t.Field49, _, err = DecodeIntoUint200(t.Field49, data[1536:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field50: int200
-	t.Field50, _, err = DecodeInt200(data[1568:])
+	t.Field50, _, err = DecodeIntoInt200(t.Field50, data[1568:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field51: uint208
-	t.Field51, _, err = DecodeUint208(data[1600:])
+	t.Field51, _, err = DecodeIntoUint208(t.Field51, data[1600:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field52: int208
-	t.Field52, _, err = DecodeInt208(data[1632:])
+	t.Field52, _, err = DecodeIntoInt208(t.Field52, data[1632:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field53: uint216
-	t.Field53, _, err = DecodeUint216(data[1664:])
+	t.Field53, _, err = DecodeIntoUint216(t.Field53, data[1664:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field54: int216
-	t.Field54, _, err = DecodeInt216(data[1696:])
+	t.Field54, _, err = DecodeIntoInt216(t.Field54, data[1696:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field55: uint224
-	t.Field55, _, err = DecodeUint224(data[1728:])
+	t.Field55, _, err = DecodeIntoUint224(t.Field55, data[1728:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field56: int224
-	t.Field56, _, err = DecodeInt224(data[1760:])
+	t.Field56, _, err = DecodeIntoInt224(t.Field56, data[1760:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field57: uint232
-	t.Field57, _, err = DecodeUint232(data[1792:])
+	t.Field57, _, err = DecodeIntoUint232(t.Field57, data[1792:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field58: int232
-	t.Field58, _, err = DecodeInt232(data[1824:])
+	t.Field58, _, err = DecodeIntoInt232(t.Field58, data[1824:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field59: uint240
-	t.Field59, _, err = DecodeUint240(data[1856:])
+	t.Field59, _, err = DecodeIntoUint240(t.Field59, data[1856:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field60: int240
-	t.Field60, _, err = DecodeInt240(data[1888:])
+	t.Field60, _, err = DecodeIntoInt240(t.Field60, data[1888:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field61: uint248
-	t.Field61, _, err = DecodeUint248(data[1920:])
+	t.Field61, _, err = DecodeIntoUint248(t.Field61, data[1920:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field62: int248
-	t.Field62, _, err = DecodeInt248(data[1952:])
+	t.Field62, _, err = DecodeIntoInt248(t.Field62, data[1952:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field63: uint256
-	t.Field63, _, err = DecodeUint256(data[1984:])
+	t.Field63, _, err = DecodeIntoUint256(t.Field63, data[1984:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Field64: int256
-	t.Field64, _, err = DecodeInt256(data[2016:])
+	t.Field64, _, err = DecodeIntoInt256(t.Field64, data[2016:])
 	if err != nil {
 		return 0, err
 	}
@@ -13636,6 +17843,27 @@ func (t *IntsCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes IntsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *IntsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes IntsCall from the hex string with an optional 0x prefix
+func (t *IntsCall) DecodeHex(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode IntsCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ Tuple = (*IntsCall)(nil)
+var _ Decoder = (*IntsCall)(nil)
+
 // GetMethodName returns the function name
 func (t IntsCall) GetMethodName() string {
 	return "ints"
@@ -13651,9 +17879,14 @@ func (t IntsCall) GetMethodSelector() [4]byte {
 	return IntsSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of ints arguments including function selector
+func (t IntsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes ints arguments to ABI bytes including function selector
 func (t IntsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], IntsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -13661,6 +17894,59 @@ func (t IntsCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes ints arguments to 0x prefixed hex string
+func (t IntsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes ints arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t IntsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the ints calldata, returns 0 if encoding fails
+func (t IntsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes ints arguments from ABI bytes including function selector
+func (t *IntsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != IntsSelector {
+		return 0, ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes ints arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *IntsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode IntsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewIntsCall constructs a new IntsCall
 func NewIntsCall(
 	field1 uint8,
@@ -1,16 +1,13 @@
 package abi
 
 import (
-	"bytes"
 	"fmt"
 	"slices"
 	"strings"
-
-	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 )
 
 //go:generate go run ./cmd -var StdlibABI -output=stdlib.abi.go -stdlib
-//go:generate go run ./cmd -var StdlibABI -output=stdlib_uint256.abi.go -stdlib -uint256
+//go:generate go run ./cmd -var StdlibUint256ABI -output=stdlib_uint256.abi.go -stdlib -uint256
 
 var StdlibABI = []string{
 	"function basic(bool,address,bytes32,string,bytes,bool[],address[],bytes32[],string[],bytes[]) returns ()",
@@ -18,24 +15,10 @@ var StdlibABI = []string{
 	"function bytes(bytes1,bytes2,bytes3,bytes4,bytes5,bytes6,bytes7,bytes8,bytes9,bytes10,bytes11,bytes12,bytes13,bytes14,bytes15,bytes16,bytes17,bytes18,bytes19,bytes20,bytes21,bytes22,bytes23,bytes24,bytes25,bytes26,bytes27,bytes28,bytes29,bytes30,bytes31,bytes32,bytes1[],bytes2[],bytes3[],bytes4[],bytes5[],bytes6[],bytes7[],bytes8[],bytes9[],bytes10[],bytes11[],bytes12[],bytes13[],bytes14[],bytes15[],bytes16[],bytes17[],bytes18[],bytes19[],bytes20[],bytes21[],bytes22[],bytes23[],bytes24[],bytes25[],bytes26[],bytes27[],bytes28[],bytes29[],bytes30[],bytes31[],bytes32[]) returns ()",
 }
 
-var stdlibTypes map[string]struct{}
-
-func init() {
-	bz, err := ParseHumanReadableABI(StdlibABI)
-	if err != nil {
-		panic(err)
-	}
-	abi, err := ethabi.JSON(bytes.NewReader(bz))
-	if err != nil {
-		panic(err)
-	}
-
-	stdlibTypes = make(map[string]struct{})
-	for _, method := range abi.Methods {
-		for _, input := range method.Inputs {
-			stdlibTypes[GenTypeIdentifier(input.Type)] = struct{}{}
-		}
-	}
+// StdlibUint256ABI contains the unsigned integers represented by holiman/uint256.Int with the uint256 option,
+// their functions are suffixed with U256, so both representations are always available.
+var StdlibUint256ABI = []string{
+	"function uints(uint72,uint80,uint88,uint96,uint104,uint112,uint120,uint128,uint136,uint144,uint152,uint160,uint168,uint176,uint184,uint192,uint200,uint208,uint216,uint224,uint232,uint240,uint248,uint256,uint72[],uint80[],uint88[],uint96[],uint104[],uint112[],uint120[],uint128[],uint136[],uint144[],uint152[],uint160[],uint168[],uint176[],uint184[],uint192[],uint200[],uint208[],uint216[],uint224[],uint232[],uint240[],uint248[],uint256[]) returns ()",
 }
 
 // GenStdlibSignature generates the standard library function signature.
@@ -65,8 +48,3 @@ func GenStdlibSignature() string {
 
 	return fmt.Sprintf("function stdlib(%s) returns ()", strings.Join(types, ","))
 }
-
-func IsStdlibType(ident string) bool {
-	_, ok := stdlibTypes[ident]
-	return ok
-}
@@ -1,51 +1,53 @@
-//go:build uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: 97677ef58b8940d3a4d4e9dc5f49afdfc3663d85fad1601d80918b9894377a0e
 
 package abi
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
-	"math/big"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 )
 
 // Function selectors
 var (
-	// basic(bool,address,bytes32,string,bytes,bool[],address[],bytes32[],string[],bytes[])
-	BasicSelector = [4]byte{0x83, 0x98, 0x77, 0x8c}
-	// bytes(bytes1,bytes2,bytes3,bytes4,bytes5,bytes6,bytes7,bytes8,bytes9,bytes10,bytes11,bytes12,bytes13,bytes14,bytes15,bytes16,bytes17,bytes18,bytes19,bytes20,bytes21,bytes22,bytes23,bytes24,bytes25,bytes26,bytes27,bytes28,bytes29,bytes30,bytes31,bytes32,bytes1[],bytes2[],bytes3[],bytes4[],bytes5[],bytes6[],bytes7[],bytes8[],bytes9[],bytes10[],bytes11[],bytes12[],bytes13[],bytes14[],bytes15[],bytes16[],bytes17[],bytes18[],bytes19[],bytes20[],bytes21[],bytes22[],bytes23[],bytes24[],bytes25[],bytes26[],bytes27[],bytes28[],bytes29[],bytes30[],bytes31[],bytes32[])
-	BytesSelector = [4]byte{0xe3, 0x92, 0xd4, 0xc7}
-	// ints(uint8,int8,uint16,int16,uint24,int24,uint32,int32,uint40,int40,uint48,int48,uint56,int56,uint64,int64,uint72,int72,uint80,int80,uint88,int88,uint96,int96,uint104,int104,uint112,int112,uint120,int120,uint128,int128,uint136,int136,uint144,int144,uint152,int152,uint160,int160,uint168,int168,uint176,int176,uint184,int184,uint192,int192,uint200,int200,uint208,int208,uint216,int216,uint224,int224,uint232,int232,uint240,int240,uint248,int248,uint256,int256,uint8[],int8[],uint16[],int16[],uint24[],int24[],uint32[],int32[],uint40[],int40[],uint48[],int48[],uint56[],int56[],uint64[],int64[],uint72[],int72[],uint80[],int80[],uint88[],int88[],uint96[],int96[],uint104[],int104[],uint112[],int112[],uint120[],int120[],uint128[],int128[],uint136[],int136[],uint144[],int144[],uint152[],int152[],uint160[],int160[],uint168[],int168[],uint176[],int176[],uint184[],int184[],uint192[],int192[],uint200[],int200[],uint208[],int208[],uint216[],int216[],uint224[],int224[],uint232[],int232[],uint240[],int240[],uint248[],int248[],uint256[],int256[])
-	IntsSelector = [4]byte{0x7a, 0x29, 0xde, 0x58}
+	// uints(uint72,uint80,uint88,uint96,uint104,uint112,uint120,uint128,uint136,uint144,uint152,uint160,uint168,uint176,uint184,uint192,uint200,uint208,uint216,uint224,uint232,uint240,uint248,uint256,uint72[],uint80[],uint88[],uint96[],uint104[],uint112[],uint120[],uint128[],uint136[],uint144[],uint152[],uint160[],uint168[],uint176[],uint184[],uint192[],uint200[],uint208[],uint216[],uint224[],uint232[],uint240[],uint248[],uint256[])
+	UintsSelector = [4]byte{0xea, 0x47, 0xa5, 0x4d}
 )
 
 // Big endian integer versions of function selectors
 const (
-	BasicID = 2207807372
-	BytesID = 3818050759
-	IntsID  = 2049564248
+	UintsID = 3930563917
+)
+
+// Canonical function signatures
+const (
+	UintsSignature = "uints(uint72,uint80,uint88,uint96,uint104,uint112,uint120,uint128,uint136,uint144,uint152,uint160,uint168,uint176,uint184,uint192,uint200,uint208,uint216,uint224,uint232,uint240,uint248,uint256,uint72[],uint80[],uint88[],uint96[],uint104[],uint112[],uint120[],uint128[],uint136[],uint144[],uint152[],uint160[],uint168[],uint176[],uint184[],uint192[],uint200[],uint208[],uint216[],uint224[],uint232[],uint240[],uint248[],uint256[])"
 )
 
-// EncodeAddress encodes address to ABI bytes
-func EncodeAddress(value common.Address, buf []byte) (int, error) {
-	copy(buf[12:32], value[:])
+// EncodeUint104U256 encodes uint104 to ABI bytes
+func EncodeUint104U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
 	return 32, nil
 }
 
-// EncodeAddressSlice encodes address[] to ABI bytes
-func EncodeAddressSlice(value []common.Address, buf []byte) (int, error) {
+// EncodeUint104SliceU256 encodes uint104[] to ABI bytes
+func EncodeUint104SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeAddress(elem, buf[offset:])
+		n, err := EncodeUint104U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -55,24 +57,26 @@ func EncodeAddressSlice(value []common.Address, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBool encodes bool to ABI bytes
-func EncodeBool(value bool, buf []byte) (int, error) {
-	if value {
-		buf[31] = 1
+// EncodeUint112U256 encodes uint112 to ABI bytes
+func EncodeUint112U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
 	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
 	return 32, nil
 }
 
-// EncodeBoolSlice encodes bool[] to ABI bytes
-func EncodeBoolSlice(value []bool, buf []byte) (int, error) {
+// EncodeUint112SliceU256 encodes uint112[] to ABI bytes
+func EncodeUint112SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBool(elem, buf[offset:])
+		n, err := EncodeUint112U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -82,39 +86,26 @@ func EncodeBoolSlice(value []bool, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes encodes bytes to ABI bytes
-func EncodeBytes(value []byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-
-	// Encode data
-	copy(buf[32:], value)
-
-	return 32 + Pad32(len(value)), nil
-}
-
-// EncodeBytes1 encodes bytes1 to ABI bytes
-func EncodeBytes1(value [1]byte, buf []byte) (int, error) {
-	copy(buf[:1], value[:])
-	return 1, nil
-}
-
-// EncodeBytes10 encodes bytes10 to ABI bytes
-func EncodeBytes10(value [10]byte, buf []byte) (int, error) {
-	copy(buf[:10], value[:])
-	return 10, nil
+// EncodeUint120U256 encodes uint120 to ABI bytes
+func EncodeUint120U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes10Slice encodes bytes10[] to ABI bytes
-func EncodeBytes10Slice(value [][10]byte, buf []byte) (int, error) {
+// EncodeUint120SliceU256 encodes uint120[] to ABI bytes
+func EncodeUint120SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes10(elem, buf[offset:])
+		n, err := EncodeUint120U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -124,22 +115,26 @@ func EncodeBytes10Slice(value [][10]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes11 encodes bytes11 to ABI bytes
-func EncodeBytes11(value [11]byte, buf []byte) (int, error) {
-	copy(buf[:11], value[:])
-	return 11, nil
+// EncodeUint128U256 encodes uint128 to ABI bytes
+func EncodeUint128U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes11Slice encodes bytes11[] to ABI bytes
-func EncodeBytes11Slice(value [][11]byte, buf []byte) (int, error) {
+// EncodeUint128SliceU256 encodes uint128[] to ABI bytes
+func EncodeUint128SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes11(elem, buf[offset:])
+		n, err := EncodeUint128U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -149,22 +144,26 @@ func EncodeBytes11Slice(value [][11]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes12 encodes bytes12 to ABI bytes
-func EncodeBytes12(value [12]byte, buf []byte) (int, error) {
-	copy(buf[:12], value[:])
-	return 12, nil
+// EncodeUint136U256 encodes uint136 to ABI bytes
+func EncodeUint136U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes12Slice encodes bytes12[] to ABI bytes
-func EncodeBytes12Slice(value [][12]byte, buf []byte) (int, error) {
+// EncodeUint136SliceU256 encodes uint136[] to ABI bytes
+func EncodeUint136SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes12(elem, buf[offset:])
+		n, err := EncodeUint136U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -174,22 +173,26 @@ func EncodeBytes12Slice(value [][12]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes13 encodes bytes13 to ABI bytes
-func EncodeBytes13(value [13]byte, buf []byte) (int, error) {
-	copy(buf[:13], value[:])
-	return 13, nil
+// EncodeUint144U256 encodes uint144 to ABI bytes
+func EncodeUint144U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes13Slice encodes bytes13[] to ABI bytes
-func EncodeBytes13Slice(value [][13]byte, buf []byte) (int, error) {
+// EncodeUint144SliceU256 encodes uint144[] to ABI bytes
+func EncodeUint144SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes13(elem, buf[offset:])
+		n, err := EncodeUint144U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -199,22 +202,26 @@ func EncodeBytes13Slice(value [][13]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes14 encodes bytes14 to ABI bytes
-func EncodeBytes14(value [14]byte, buf []byte) (int, error) {
-	copy(buf[:14], value[:])
-	return 14, nil
+// EncodeUint152U256 encodes uint152 to ABI bytes
+func EncodeUint152U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes14Slice encodes bytes14[] to ABI bytes
-func EncodeBytes14Slice(value [][14]byte, buf []byte) (int, error) {
+// EncodeUint152SliceU256 encodes uint152[] to ABI bytes
+func EncodeUint152SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes14(elem, buf[offset:])
+		n, err := EncodeUint152U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -224,22 +231,26 @@ func EncodeBytes14Slice(value [][14]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes15 encodes bytes15 to ABI bytes
-func EncodeBytes15(value [15]byte, buf []byte) (int, error) {
-	copy(buf[:15], value[:])
-	return 15, nil
+// EncodeUint160U256 encodes uint160 to ABI bytes
+func EncodeUint160U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes15Slice encodes bytes15[] to ABI bytes
-func EncodeBytes15Slice(value [][15]byte, buf []byte) (int, error) {
+// EncodeUint160SliceU256 encodes uint160[] to ABI bytes
+func EncodeUint160SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes15(elem, buf[offset:])
+		n, err := EncodeUint160U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -249,22 +260,26 @@ func EncodeBytes15Slice(value [][15]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes16 encodes bytes16 to ABI bytes
-func EncodeBytes16(value [16]byte, buf []byte) (int, error) {
-	copy(buf[:16], value[:])
-	return 16, nil
+// EncodeUint168U256 encodes uint168 to ABI bytes
+func EncodeUint168U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes16Slice encodes bytes16[] to ABI bytes
-func EncodeBytes16Slice(value [][16]byte, buf []byte) (int, error) {
+// EncodeUint168SliceU256 encodes uint168[] to ABI bytes
+func EncodeUint168SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes16(elem, buf[offset:])
+		n, err := EncodeUint168U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -274,22 +289,26 @@ func EncodeBytes16Slice(value [][16]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes17 encodes bytes17 to ABI bytes
-func EncodeBytes17(value [17]byte, buf []byte) (int, error) {
-	copy(buf[:17], value[:])
-	return 17, nil
+// EncodeUint176U256 encodes uint176 to ABI bytes
+func EncodeUint176U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes17Slice encodes bytes17[] to ABI bytes
-func EncodeBytes17Slice(value [][17]byte, buf []byte) (int, error) {
+// EncodeUint176SliceU256 encodes uint176[] to ABI bytes
+func EncodeUint176SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes17(elem, buf[offset:])
+		n, err := EncodeUint176U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -299,22 +318,26 @@ func EncodeBytes17Slice(value [][17]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes18 encodes bytes18 to ABI bytes
-func EncodeBytes18(value [18]byte, buf []byte) (int, error) {
-	copy(buf[:18], value[:])
-	return 18, nil
+// EncodeUint184U256 encodes uint184 to ABI bytes
+func EncodeUint184U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes18Slice encodes bytes18[] to ABI bytes
-func EncodeBytes18Slice(value [][18]byte, buf []byte) (int, error) {
+// EncodeUint184SliceU256 encodes uint184[] to ABI bytes
+func EncodeUint184SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes18(elem, buf[offset:])
+		n, err := EncodeUint184U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -324,22 +347,26 @@ func EncodeBytes18Slice(value [][18]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes19 encodes bytes19 to ABI bytes
-func EncodeBytes19(value [19]byte, buf []byte) (int, error) {
-	copy(buf[:19], value[:])
-	return 19, nil
+// EncodeUint192U256 encodes uint192 to ABI bytes
+func EncodeUint192U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes19Slice encodes bytes19[] to ABI bytes
-func EncodeBytes19Slice(value [][19]byte, buf []byte) (int, error) {
+// EncodeUint192SliceU256 encodes uint192[] to ABI bytes
+func EncodeUint192SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes19(elem, buf[offset:])
+		n, err := EncodeUint192U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -349,16 +376,26 @@ func EncodeBytes19Slice(value [][19]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes1Slice encodes bytes1[] to ABI bytes
-func EncodeBytes1Slice(value [][1]byte, buf []byte) (int, error) {
+// EncodeUint200U256 encodes uint200 to ABI bytes
+func EncodeUint200U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
+}
+
+// EncodeUint200SliceU256 encodes uint200[] to ABI bytes
+func EncodeUint200SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes1(elem, buf[offset:])
+		n, err := EncodeUint200U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -368,28 +405,26 @@ func EncodeBytes1Slice(value [][1]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes2 encodes bytes2 to ABI bytes
-func EncodeBytes2(value [2]byte, buf []byte) (int, error) {
-	copy(buf[:2], value[:])
-	return 2, nil
-}
-
-// EncodeBytes20 encodes bytes20 to ABI bytes
-func EncodeBytes20(value [20]byte, buf []byte) (int, error) {
-	copy(buf[:20], value[:])
-	return 20, nil
+// EncodeUint208U256 encodes uint208 to ABI bytes
+func EncodeUint208U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes20Slice encodes bytes20[] to ABI bytes
-func EncodeBytes20Slice(value [][20]byte, buf []byte) (int, error) {
+// EncodeUint208SliceU256 encodes uint208[] to ABI bytes
+func EncodeUint208SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes20(elem, buf[offset:])
+		n, err := EncodeUint208U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -399,22 +434,26 @@ func EncodeBytes20Slice(value [][20]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes21 encodes bytes21 to ABI bytes
-func EncodeBytes21(value [21]byte, buf []byte) (int, error) {
-	copy(buf[:21], value[:])
-	return 21, nil
+// EncodeUint216U256 encodes uint216 to ABI bytes
+func EncodeUint216U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes21Slice encodes bytes21[] to ABI bytes
-func EncodeBytes21Slice(value [][21]byte, buf []byte) (int, error) {
+// EncodeUint216SliceU256 encodes uint216[] to ABI bytes
+func EncodeUint216SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes21(elem, buf[offset:])
+		n, err := EncodeUint216U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -424,22 +463,26 @@ func EncodeBytes21Slice(value [][21]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes22 encodes bytes22 to ABI bytes
-func EncodeBytes22(value [22]byte, buf []byte) (int, error) {
-	copy(buf[:22], value[:])
-	return 22, nil
+// EncodeUint224U256 encodes uint224 to ABI bytes
+func EncodeUint224U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes22Slice encodes bytes22[] to ABI bytes
-func EncodeBytes22Slice(value [][22]byte, buf []byte) (int, error) {
+// EncodeUint224SliceU256 encodes uint224[] to ABI bytes
+func EncodeUint224SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes22(elem, buf[offset:])
+		n, err := EncodeUint224U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -449,22 +492,26 @@ func EncodeBytes22Slice(value [][22]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes23 encodes bytes23 to ABI bytes
-func EncodeBytes23(value [23]byte, buf []byte) (int, error) {
-	copy(buf[:23], value[:])
-	return 23, nil
+// EncodeUint232U256 encodes uint232 to ABI bytes
+func EncodeUint232U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes23Slice encodes bytes23[] to ABI bytes
-func EncodeBytes23Slice(value [][23]byte, buf []byte) (int, error) {
+// EncodeUint232SliceU256 encodes uint232[] to ABI bytes
+func EncodeUint232SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes23(elem, buf[offset:])
+		n, err := EncodeUint232U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -474,22 +521,26 @@ func EncodeBytes23Slice(value [][23]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes24 encodes bytes24 to ABI bytes
-func EncodeBytes24(value [24]byte, buf []byte) (int, error) {
-	copy(buf[:24], value[:])
-	return 24, nil
+// EncodeUint240U256 encodes uint240 to ABI bytes
+func EncodeUint240U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes24Slice encodes bytes24[] to ABI bytes
-func EncodeBytes24Slice(value [][24]byte, buf []byte) (int, error) {
+// EncodeUint240SliceU256 encodes uint240[] to ABI bytes
+func EncodeUint240SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes24(elem, buf[offset:])
+		n, err := EncodeUint240U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -499,22 +550,26 @@ func EncodeBytes24Slice(value [][24]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes25 encodes bytes25 to ABI bytes
-func EncodeBytes25(value [25]byte, buf []byte) (int, error) {
-	copy(buf[:25], value[:])
-	return 25, nil
+// EncodeUint248U256 encodes uint248 to ABI bytes
+func EncodeUint248U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes25Slice encodes bytes25[] to ABI bytes
-func EncodeBytes25Slice(value [][25]byte, buf []byte) (int, error) {
+// EncodeUint248SliceU256 encodes uint248[] to ABI bytes
+func EncodeUint248SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes25(elem, buf[offset:])
+		n, err := EncodeUint248U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -524,22 +579,26 @@ func EncodeBytes25Slice(value [][25]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes26 encodes bytes26 to ABI bytes
-func EncodeBytes26(value [26]byte, buf []byte) (int, error) {
-	copy(buf[:26], value[:])
-	return 26, nil
+// EncodeUint256U256 encodes uint256 to ABI bytes
+func EncodeUint256U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes26Slice encodes bytes26[] to ABI bytes
-func EncodeBytes26Slice(value [][26]byte, buf []byte) (int, error) {
+// EncodeUint256SliceU256 encodes uint256[] to ABI bytes
+func EncodeUint256SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes26(elem, buf[offset:])
+		n, err := EncodeUint256U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -549,22 +608,26 @@ func EncodeBytes26Slice(value [][26]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes27 encodes bytes27 to ABI bytes
-func EncodeBytes27(value [27]byte, buf []byte) (int, error) {
-	copy(buf[:27], value[:])
-	return 27, nil
+// EncodeUint72U256 encodes uint72 to ABI bytes
+func EncodeUint72U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes27Slice encodes bytes27[] to ABI bytes
-func EncodeBytes27Slice(value [][27]byte, buf []byte) (int, error) {
+// EncodeUint72SliceU256 encodes uint72[] to ABI bytes
+func EncodeUint72SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes27(elem, buf[offset:])
+		n, err := EncodeUint72U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -574,22 +637,26 @@ func EncodeBytes27Slice(value [][27]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes28 encodes bytes28 to ABI bytes
-func EncodeBytes28(value [28]byte, buf []byte) (int, error) {
-	copy(buf[:28], value[:])
-	return 28, nil
+// EncodeUint80U256 encodes uint80 to ABI bytes
+func EncodeUint80U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes28Slice encodes bytes28[] to ABI bytes
-func EncodeBytes28Slice(value [][28]byte, buf []byte) (int, error) {
+// EncodeUint80SliceU256 encodes uint80[] to ABI bytes
+func EncodeUint80SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes28(elem, buf[offset:])
+		n, err := EncodeUint80U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -599,22 +666,26 @@ func EncodeBytes28Slice(value [][28]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes29 encodes bytes29 to ABI bytes
-func EncodeBytes29(value [29]byte, buf []byte) (int, error) {
-	copy(buf[:29], value[:])
-	return 29, nil
+// EncodeUint88U256 encodes uint88 to ABI bytes
+func EncodeUint88U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
 }
 
-// EncodeBytes29Slice encodes bytes29[] to ABI bytes
-func EncodeBytes29Slice(value [][29]byte, buf []byte) (int, error) {
+// EncodeUint88SliceU256 encodes uint88[] to ABI bytes
+func EncodeUint88SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes29(elem, buf[offset:])
+		n, err := EncodeUint88U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -624,16 +695,26 @@ func EncodeBytes29Slice(value [][29]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes2Slice encodes bytes2[] to ABI bytes
-func EncodeBytes2Slice(value [][2]byte, buf []byte) (int, error) {
+// EncodeUint96U256 encodes uint96 to ABI bytes
+func EncodeUint96U256(value *uint256.Int, buf []byte) (int, error) {
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
+	return 32, nil
+}
+
+// EncodeUint96SliceU256 encodes uint96[] to ABI bytes
+func EncodeUint96SliceU256(value []*uint256.Int, buf []byte) (int, error) {
 	// Encode length
+	ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
 	// Encode elements with static types
 	var offset int
 	for _, elem := range value {
-		n, err := EncodeBytes2(elem, buf[offset:])
+		n, err := EncodeUint96U256(elem, buf[offset:])
 		if err != nil {
 			return 0, err
 		}
@@ -643,12990 +724,3645 @@ func EncodeBytes2Slice(value [][2]byte, buf []byte) (int, error) {
 	return offset + 32, nil
 }
 
-// EncodeBytes3 encodes bytes3 to ABI bytes
-func EncodeBytes3(value [3]byte, buf []byte) (int, error) {
-	copy(buf[:3], value[:])
-	return 3, nil
+// SizeUint104SliceU256 returns the encoded size of uint104[]
+func SizeUint104SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes30 encodes bytes30 to ABI bytes
-func EncodeBytes30(value [30]byte, buf []byte) (int, error) {
-	copy(buf[:30], value[:])
-	return 30, nil
+// SizeUint112SliceU256 returns the encoded size of uint112[]
+func SizeUint112SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes30Slice encodes bytes30[] to ABI bytes
-func EncodeBytes30Slice(value [][30]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes30(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
-
-	return offset + 32, nil
+// SizeUint120SliceU256 returns the encoded size of uint120[]
+func SizeUint120SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes31 encodes bytes31 to ABI bytes
-func EncodeBytes31(value [31]byte, buf []byte) (int, error) {
-	copy(buf[:31], value[:])
-	return 31, nil
+// SizeUint128SliceU256 returns the encoded size of uint128[]
+func SizeUint128SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes31Slice encodes bytes31[] to ABI bytes
-func EncodeBytes31Slice(value [][31]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes31(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
-
-	return offset + 32, nil
+// SizeUint136SliceU256 returns the encoded size of uint136[]
+func SizeUint136SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes32 encodes bytes32 to ABI bytes
-func EncodeBytes32(value [32]byte, buf []byte) (int, error) {
-	copy(buf[:32], value[:])
-	return 32, nil
+// SizeUint144SliceU256 returns the encoded size of uint144[]
+func SizeUint144SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes32Slice encodes bytes32[] to ABI bytes
-func EncodeBytes32Slice(value [][32]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// SizeUint152SliceU256 returns the encoded size of uint152[]
+func SizeUint152SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes32(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
+// SizeUint160SliceU256 returns the encoded size of uint160[]
+func SizeUint160SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	return offset + 32, nil
+// SizeUint168SliceU256 returns the encoded size of uint168[]
+func SizeUint168SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes3Slice encodes bytes3[] to ABI bytes
-func EncodeBytes3Slice(value [][3]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// SizeUint176SliceU256 returns the encoded size of uint176[]
+func SizeUint176SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes3(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
+// SizeUint184SliceU256 returns the encoded size of uint184[]
+func SizeUint184SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	return offset + 32, nil
+// SizeUint192SliceU256 returns the encoded size of uint192[]
+func SizeUint192SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes4 encodes bytes4 to ABI bytes
-func EncodeBytes4(value [4]byte, buf []byte) (int, error) {
-	copy(buf[:4], value[:])
-	return 4, nil
+// SizeUint200SliceU256 returns the encoded size of uint200[]
+func SizeUint200SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes4Slice encodes bytes4[] to ABI bytes
-func EncodeBytes4Slice(value [][4]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// SizeUint208SliceU256 returns the encoded size of uint208[]
+func SizeUint208SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes4(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
+// SizeUint216SliceU256 returns the encoded size of uint216[]
+func SizeUint216SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	return offset + 32, nil
+// SizeUint224SliceU256 returns the encoded size of uint224[]
+func SizeUint224SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes5 encodes bytes5 to ABI bytes
-func EncodeBytes5(value [5]byte, buf []byte) (int, error) {
-	copy(buf[:5], value[:])
-	return 5, nil
+// SizeUint232SliceU256 returns the encoded size of uint232[]
+func SizeUint232SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes5Slice encodes bytes5[] to ABI bytes
-func EncodeBytes5Slice(value [][5]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// SizeUint240SliceU256 returns the encoded size of uint240[]
+func SizeUint240SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes5(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
+// SizeUint248SliceU256 returns the encoded size of uint248[]
+func SizeUint248SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	return offset + 32, nil
+// SizeUint256SliceU256 returns the encoded size of uint256[]
+func SizeUint256SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes6 encodes bytes6 to ABI bytes
-func EncodeBytes6(value [6]byte, buf []byte) (int, error) {
-	copy(buf[:6], value[:])
-	return 6, nil
+// SizeUint72SliceU256 returns the encoded size of uint72[]
+func SizeUint72SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes6Slice encodes bytes6[] to ABI bytes
-func EncodeBytes6Slice(value [][6]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// SizeUint80SliceU256 returns the encoded size of uint80[]
+func SizeUint80SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes6(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
+// SizeUint88SliceU256 returns the encoded size of uint88[]
+func SizeUint88SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
+}
 
-	return offset + 32, nil
+// SizeUint96SliceU256 returns the encoded size of uint96[]
+func SizeUint96SliceU256(value []*uint256.Int) int {
+	size := 32 + 32*len(value) // length + static elements
+	return size
 }
 
-// EncodeBytes7 encodes bytes7 to ABI bytes
-func EncodeBytes7(value [7]byte, buf []byte) (int, error) {
-	copy(buf[:7], value[:])
-	return 7, nil
+// DecodeUint104U256 decodes uint104 from ABI bytes
+func DecodeUint104U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint104U256(nil, data)
 }
 
-// EncodeBytes7Slice encodes bytes7[] to ABI bytes
-func EncodeBytes7Slice(value [][7]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes7(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeIntoUint104U256 decodes uint104 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint104U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeBytes8 encodes bytes8 to ABI bytes
-func EncodeBytes8(value [8]byte, buf []byte) (int, error) {
-	copy(buf[:8], value[:])
-	return 8, nil
+// DecodeUint104SliceU256 decodes uint104[] from ABI bytes
+func DecodeUint104SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint104SliceU256(nil, data)
 }
 
-// EncodeBytes8Slice encodes bytes8[] to ABI bytes
-func EncodeBytes8Slice(value [][8]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes8(elem, buf[offset:])
+// DecodeIntoUint104SliceU256 decodes uint104[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint104SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint104U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint112U256 decodes uint112 from ABI bytes
+func DecodeUint112U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint112U256(nil, data)
 }
 
-// EncodeBytes9 encodes bytes9 to ABI bytes
-func EncodeBytes9(value [9]byte, buf []byte) (int, error) {
-	copy(buf[:9], value[:])
-	return 9, nil
+// DecodeIntoUint112U256 decodes uint112 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint112U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeBytes9Slice encodes bytes9[] to ABI bytes
-func EncodeBytes9Slice(value [][9]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint112SliceU256 decodes uint112[] from ABI bytes
+func DecodeUint112SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint112SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeBytes9(elem, buf[offset:])
+// DecodeIntoUint112SliceU256 decodes uint112[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint112SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint112U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint120U256 decodes uint120 from ABI bytes
+func DecodeUint120U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint120U256(nil, data)
 }
 
-// EncodeBytesSlice encodes bytes[] to ABI bytes
-func EncodeBytesSlice(value [][]byte, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeIntoUint120U256 decodes uint120 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint120U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
+}
 
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+// DecodeUint120SliceU256 decodes uint120[] from ABI bytes
+func DecodeUint120SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint120SliceU256(nil, data)
+}
 
-		// Write element at dynamic region
-		n, err := EncodeBytes(elem, buf[dynamicOffset:])
+// DecodeIntoUint120SliceU256 decodes uint120[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint120SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint120U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
-		dynamicOffset += n
+		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return dynamicOffset + 32, nil
+// DecodeUint128U256 decodes uint128 from ABI bytes
+func DecodeUint128U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint128U256(nil, data)
 }
 
-// EncodeInt104 encodes int104 to ABI bytes
-func EncodeInt104(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint128U256 decodes uint128 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint128U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt104Slice encodes int104[] to ABI bytes
-func EncodeInt104Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint128SliceU256 decodes uint128[] from ABI bytes
+func DecodeUint128SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint128SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt104(elem, buf[offset:])
+// DecodeIntoUint128SliceU256 decodes uint128[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint128SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint128U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint136U256 decodes uint136 from ABI bytes
+func DecodeUint136U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint136U256(nil, data)
 }
 
-// EncodeInt112 encodes int112 to ABI bytes
-func EncodeInt112(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint136U256 decodes uint136 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint136U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt112Slice encodes int112[] to ABI bytes
-func EncodeInt112Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint136SliceU256 decodes uint136[] from ABI bytes
+func DecodeUint136SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint136SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt112(elem, buf[offset:])
+// DecodeIntoUint136SliceU256 decodes uint136[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint136SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint136U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint144U256 decodes uint144 from ABI bytes
+func DecodeUint144U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint144U256(nil, data)
 }
 
-// EncodeInt120 encodes int120 to ABI bytes
-func EncodeInt120(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint144U256 decodes uint144 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint144U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt120Slice encodes int120[] to ABI bytes
-func EncodeInt120Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint144SliceU256 decodes uint144[] from ABI bytes
+func DecodeUint144SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint144SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt120(elem, buf[offset:])
+// DecodeIntoUint144SliceU256 decodes uint144[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint144SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint144U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint152U256 decodes uint152 from ABI bytes
+func DecodeUint152U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint152U256(nil, data)
 }
 
-// EncodeInt128 encodes int128 to ABI bytes
-func EncodeInt128(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint152U256 decodes uint152 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint152U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt128Slice encodes int128[] to ABI bytes
-func EncodeInt128Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint152SliceU256 decodes uint152[] from ABI bytes
+func DecodeUint152SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint152SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt128(elem, buf[offset:])
+// DecodeIntoUint152SliceU256 decodes uint152[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint152SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint152U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint160U256 decodes uint160 from ABI bytes
+func DecodeUint160U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint160U256(nil, data)
 }
 
-// EncodeInt136 encodes int136 to ABI bytes
-func EncodeInt136(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint160U256 decodes uint160 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint160U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt136Slice encodes int136[] to ABI bytes
-func EncodeInt136Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint160SliceU256 decodes uint160[] from ABI bytes
+func DecodeUint160SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint160SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt136(elem, buf[offset:])
+// DecodeIntoUint160SliceU256 decodes uint160[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint160SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint160U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint168U256 decodes uint168 from ABI bytes
+func DecodeUint168U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint168U256(nil, data)
 }
 
-// EncodeInt144 encodes int144 to ABI bytes
-func EncodeInt144(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint168U256 decodes uint168 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint168U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt144Slice encodes int144[] to ABI bytes
-func EncodeInt144Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint168SliceU256 decodes uint168[] from ABI bytes
+func DecodeUint168SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint168SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt144(elem, buf[offset:])
+// DecodeIntoUint168SliceU256 decodes uint168[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint168SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint168U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint176U256 decodes uint176 from ABI bytes
+func DecodeUint176U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint176U256(nil, data)
 }
 
-// EncodeInt152 encodes int152 to ABI bytes
-func EncodeInt152(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint176U256 decodes uint176 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint176U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt152Slice encodes int152[] to ABI bytes
-func EncodeInt152Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint176SliceU256 decodes uint176[] from ABI bytes
+func DecodeUint176SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint176SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt152(elem, buf[offset:])
+// DecodeIntoUint176SliceU256 decodes uint176[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint176SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint176U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
-
-	return offset + 32, nil
+	return result, offset + 32, nil
 }
 
-// EncodeInt16 encodes int16 to ABI bytes
-func EncodeInt16(value int16, buf []byte) (int, error) {
-	binary.BigEndian.PutUint16(buf[30:32], uint16(value))
-	if value < 0 {
-		copy(buf, PaddingBytes16)
-	}
-	return 32, nil
+// DecodeUint184U256 decodes uint184 from ABI bytes
+func DecodeUint184U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint184U256(nil, data)
 }
 
-// EncodeInt160 encodes int160 to ABI bytes
-func EncodeInt160(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint184U256 decodes uint184 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint184U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt160Slice encodes int160[] to ABI bytes
-func EncodeInt160Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint184SliceU256 decodes uint184[] from ABI bytes
+func DecodeUint184SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint184SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt160(elem, buf[offset:])
+// DecodeIntoUint184SliceU256 decodes uint184[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint184SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint184U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
-
-	return offset + 32, nil
+	return result, offset + 32, nil
 }
 
-// EncodeInt168 encodes int168 to ABI bytes
-func EncodeInt168(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
-	}
-	return 32, nil
+// DecodeUint192U256 decodes uint192 from ABI bytes
+func DecodeUint192U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint192U256(nil, data)
 }
 
-// EncodeInt168Slice encodes int168[] to ABI bytes
-func EncodeInt168Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt168(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeIntoUint192U256 decodes uint192 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint192U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt16Slice encodes int16[] to ABI bytes
-func EncodeInt16Slice(value []int16, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint192SliceU256 decodes uint192[] from ABI bytes
+func DecodeUint192SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint192SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt16(elem, buf[offset:])
+// DecodeIntoUint192SliceU256 decodes uint192[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint192SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint192U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint200U256 decodes uint200 from ABI bytes
+func DecodeUint200U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint200U256(nil, data)
 }
 
-// EncodeInt176 encodes int176 to ABI bytes
-func EncodeInt176(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint200U256 decodes uint200 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint200U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt176Slice encodes int176[] to ABI bytes
-func EncodeInt176Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint200SliceU256 decodes uint200[] from ABI bytes
+func DecodeUint200SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint200SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt176(elem, buf[offset:])
+// DecodeIntoUint200SliceU256 decodes uint200[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint200SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint200U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint208U256 decodes uint208 from ABI bytes
+func DecodeUint208U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint208U256(nil, data)
 }
 
-// EncodeInt184 encodes int184 to ABI bytes
-func EncodeInt184(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint208U256 decodes uint208 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint208U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt184Slice encodes int184[] to ABI bytes
-func EncodeInt184Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint208SliceU256 decodes uint208[] from ABI bytes
+func DecodeUint208SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint208SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt184(elem, buf[offset:])
+// DecodeIntoUint208SliceU256 decodes uint208[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint208SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint208U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint216U256 decodes uint216 from ABI bytes
+func DecodeUint216U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint216U256(nil, data)
 }
 
-// EncodeInt192 encodes int192 to ABI bytes
-func EncodeInt192(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint216U256 decodes uint216 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint216U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt192Slice encodes int192[] to ABI bytes
-func EncodeInt192Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint216SliceU256 decodes uint216[] from ABI bytes
+func DecodeUint216SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint216SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt192(elem, buf[offset:])
+// DecodeIntoUint216SliceU256 decodes uint216[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint216SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint216U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint224U256 decodes uint224 from ABI bytes
+func DecodeUint224U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint224U256(nil, data)
 }
 
-// EncodeInt200 encodes int200 to ABI bytes
-func EncodeInt200(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint224U256 decodes uint224 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint224U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt200Slice encodes int200[] to ABI bytes
-func EncodeInt200Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint224SliceU256 decodes uint224[] from ABI bytes
+func DecodeUint224SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint224SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt200(elem, buf[offset:])
+// DecodeIntoUint224SliceU256 decodes uint224[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint224SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint224U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint232U256 decodes uint232 from ABI bytes
+func DecodeUint232U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint232U256(nil, data)
 }
 
-// EncodeInt208 encodes int208 to ABI bytes
-func EncodeInt208(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint232U256 decodes uint232 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint232U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt208Slice encodes int208[] to ABI bytes
-func EncodeInt208Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint232SliceU256 decodes uint232[] from ABI bytes
+func DecodeUint232SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint232SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt208(elem, buf[offset:])
+// DecodeIntoUint232SliceU256 decodes uint232[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint232SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint232U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint240U256 decodes uint240 from ABI bytes
+func DecodeUint240U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint240U256(nil, data)
 }
 
-// EncodeInt216 encodes int216 to ABI bytes
-func EncodeInt216(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint240U256 decodes uint240 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint240U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt216Slice encodes int216[] to ABI bytes
-func EncodeInt216Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint240SliceU256 decodes uint240[] from ABI bytes
+func DecodeUint240SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint240SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt216(elem, buf[offset:])
+// DecodeIntoUint240SliceU256 decodes uint240[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint240SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint240U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint248U256 decodes uint248 from ABI bytes
+func DecodeUint248U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint248U256(nil, data)
 }
 
-// EncodeInt224 encodes int224 to ABI bytes
-func EncodeInt224(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint248U256 decodes uint248 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint248U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt224Slice encodes int224[] to ABI bytes
-func EncodeInt224Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint248SliceU256 decodes uint248[] from ABI bytes
+func DecodeUint248SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint248SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt224(elem, buf[offset:])
+// DecodeIntoUint248SliceU256 decodes uint248[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint248SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint248U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint256U256 decodes uint256 from ABI bytes
+func DecodeUint256U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint256U256(nil, data)
 }
 
-// EncodeInt232 encodes int232 to ABI bytes
-func EncodeInt232(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint256U256 decodes uint256 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint256U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt232Slice encodes int232[] to ABI bytes
-func EncodeInt232Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint256SliceU256 decodes uint256[] from ABI bytes
+func DecodeUint256SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint256SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt232(elem, buf[offset:])
+// DecodeIntoUint256SliceU256 decodes uint256[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint256SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint256U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
-
-	return offset + 32, nil
+	return result, offset + 32, nil
 }
 
-// EncodeInt24 encodes int24 to ABI bytes
-func EncodeInt24(value int32, buf []byte) (int, error) {
-	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
-	if value < 0 {
-		copy(buf, PaddingBytes32)
-	}
-	return 32, nil
+// DecodeUint72U256 decodes uint72 from ABI bytes
+func DecodeUint72U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint72U256(nil, data)
 }
 
-// EncodeInt240 encodes int240 to ABI bytes
-func EncodeInt240(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint72U256 decodes uint72 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint72U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt240Slice encodes int240[] to ABI bytes
-func EncodeInt240Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint72SliceU256 decodes uint72[] from ABI bytes
+func DecodeUint72SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint72SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt240(elem, buf[offset:])
+// DecodeIntoUint72SliceU256 decodes uint72[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint72SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint72U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
-
-	return offset + 32, nil
+	return result, offset + 32, nil
 }
 
-// EncodeInt248 encodes int248 to ABI bytes
-func EncodeInt248(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
-	}
-	return 32, nil
+// DecodeUint80U256 decodes uint80 from ABI bytes
+func DecodeUint80U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint80U256(nil, data)
 }
 
-// EncodeInt248Slice encodes int248[] to ABI bytes
-func EncodeInt248Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt248(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeIntoUint80U256 decodes uint80 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint80U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt24Slice encodes int24[] to ABI bytes
-func EncodeInt24Slice(value []int32, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint80SliceU256 decodes uint80[] from ABI bytes
+func DecodeUint80SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint80SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt24(elem, buf[offset:])
+// DecodeIntoUint80SliceU256 decodes uint80[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint80SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint80U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint88U256 decodes uint88 from ABI bytes
+func DecodeUint88U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint88U256(nil, data)
 }
 
-// EncodeInt256 encodes int256 to ABI bytes
-func EncodeInt256(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// DecodeIntoUint88U256 decodes uint88 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint88U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt256Slice encodes int256[] to ABI bytes
-func EncodeInt256Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint88SliceU256 decodes uint88[] from ABI bytes
+func DecodeUint88SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint88SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt256(elem, buf[offset:])
+// DecodeIntoUint88SliceU256 decodes uint88[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint88SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint88U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
+	return result, offset + 32, nil
+}
 
-	return offset + 32, nil
+// DecodeUint96U256 decodes uint96 from ABI bytes
+func DecodeUint96U256(data []byte) (*uint256.Int, int, error) {
+	return DecodeIntoUint96U256(nil, data)
 }
 
-// EncodeInt32 encodes int32 to ABI bytes
-func EncodeInt32(value int32, buf []byte) (int, error) {
-	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
-	if value < 0 {
-		copy(buf, PaddingBytes32)
+// DecodeIntoUint96U256 decodes uint96 from ABI bytes into dst, allocates if dst is nil
+func DecodeIntoUint96U256(dst *uint256.Int, data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-	return 32, nil
+	if dst == nil {
+		dst = new(uint256.Int)
+	}
+	dst.SetBytes32(data[:32])
+	return dst, 32, nil
 }
 
-// EncodeInt32Slice encodes int32[] to ABI bytes
-func EncodeInt32Slice(value []int32, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// DecodeUint96SliceU256 decodes uint96[] from ABI bytes
+func DecodeUint96SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	return DecodeIntoUint96SliceU256(nil, data)
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt32(elem, buf[offset:])
+// DecodeIntoUint96SliceU256 decodes uint96[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint96SliceU256(dst []*uint256.Int, data []byte) ([]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with static types
+	result := ResizeSlice(dst, length)
+	for i := 0; i < length; i++ {
+		result[i], n, err = DecodeIntoUint96U256(result[i], data[offset:])
 		if err != nil {
-			return 0, err
+			return nil, 0, err
 		}
 		offset += n
 	}
-
-	return offset + 32, nil
+	return result, offset + 32, nil
 }
 
-// EncodeInt40 encodes int40 to ABI bytes
-func EncodeInt40(value int64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	if value < 0 {
-		copy(buf, PaddingBytes64)
+// PackedEncodeUint104U256 encodes uint104 to packed ABI bytes (no padding)
+func PackedEncodeUint104U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 13 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:13], tmp[19:])
+	return 13, nil
 }
 
-// EncodeInt40Slice encodes int40[] to ABI bytes
-func EncodeInt40Slice(value []int64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt40(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint112U256 encodes uint112 to packed ABI bytes (no padding)
+func PackedEncodeUint112U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 14 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:14], tmp[18:])
+	return 14, nil
 }
 
-// EncodeInt48 encodes int48 to ABI bytes
-func EncodeInt48(value int64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	if value < 0 {
-		copy(buf, PaddingBytes64)
+// PackedEncodeUint120U256 encodes uint120 to packed ABI bytes (no padding)
+func PackedEncodeUint120U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 15 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:15], tmp[17:])
+	return 15, nil
 }
 
-// EncodeInt48Slice encodes int48[] to ABI bytes
-func EncodeInt48Slice(value []int64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt48(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint128U256 encodes uint128 to packed ABI bytes (no padding)
+func PackedEncodeUint128U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 16 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:16], tmp[16:])
+	return 16, nil
 }
 
-// EncodeInt56 encodes int56 to ABI bytes
-func EncodeInt56(value int64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	if value < 0 {
-		copy(buf, PaddingBytes64)
+// PackedEncodeUint136U256 encodes uint136 to packed ABI bytes (no padding)
+func PackedEncodeUint136U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 17 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:17], tmp[15:])
+	return 17, nil
 }
 
-// EncodeInt56Slice encodes int56[] to ABI bytes
-func EncodeInt56Slice(value []int64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt56(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint144U256 encodes uint144 to packed ABI bytes (no padding)
+func PackedEncodeUint144U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 18 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:18], tmp[14:])
+	return 18, nil
 }
 
-// EncodeInt64 encodes int64 to ABI bytes
-func EncodeInt64(value int64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	if value < 0 {
-		copy(buf, PaddingBytes64)
+// PackedEncodeUint152U256 encodes uint152 to packed ABI bytes (no padding)
+func PackedEncodeUint152U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 19 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:19], tmp[13:])
+	return 19, nil
 }
 
-// EncodeInt64Slice encodes int64[] to ABI bytes
-func EncodeInt64Slice(value []int64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt64(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint160U256 encodes uint160 to packed ABI bytes (no padding)
+func PackedEncodeUint160U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 20 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:20], tmp[12:])
+	return 20, nil
 }
 
-// EncodeInt72 encodes int72 to ABI bytes
-func EncodeInt72(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// PackedEncodeUint168U256 encodes uint168 to packed ABI bytes (no padding)
+func PackedEncodeUint168U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 21 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:21], tmp[11:])
+	return 21, nil
 }
 
-// EncodeInt72Slice encodes int72[] to ABI bytes
-func EncodeInt72Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt72(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint176U256 encodes uint176 to packed ABI bytes (no padding)
+func PackedEncodeUint176U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 22 {
+		return 0, io.ErrShortBuffer
 	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:22], tmp[10:])
+	return 22, nil
+}
 
-	return offset + 32, nil
+// PackedEncodeUint184U256 encodes uint184 to packed ABI bytes (no padding)
+func PackedEncodeUint184U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 23 {
+		return 0, io.ErrShortBuffer
+	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:23], tmp[9:])
+	return 23, nil
 }
 
-// EncodeInt8 encodes int8 to ABI bytes
-func EncodeInt8(value int8, buf []byte) (int, error) {
-	buf[31] = byte(value)
-	if value < 0 {
-		copy(buf, PaddingBytes8)
+// PackedEncodeUint192U256 encodes uint192 to packed ABI bytes (no padding)
+func PackedEncodeUint192U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 24 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:24], tmp[8:])
+	return 24, nil
 }
 
-// EncodeInt80 encodes int80 to ABI bytes
-func EncodeInt80(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// PackedEncodeUint200U256 encodes uint200 to packed ABI bytes (no padding)
+func PackedEncodeUint200U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 25 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:25], tmp[7:])
+	return 25, nil
 }
 
-// EncodeInt80Slice encodes int80[] to ABI bytes
-func EncodeInt80Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt80(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint208U256 encodes uint208 to packed ABI bytes (no padding)
+func PackedEncodeUint208U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 26 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:26], tmp[6:])
+	return 26, nil
 }
 
-// EncodeInt88 encodes int88 to ABI bytes
-func EncodeInt88(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// PackedEncodeUint216U256 encodes uint216 to packed ABI bytes (no padding)
+func PackedEncodeUint216U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 27 {
+		return 0, io.ErrShortBuffer
 	}
-	return 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:27], tmp[5:])
+	return 27, nil
 }
 
-// EncodeInt88Slice encodes int88[] to ABI bytes
-func EncodeInt88Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt88(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint224U256 encodes uint224 to packed ABI bytes (no padding)
+func PackedEncodeUint224U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 28 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:28], tmp[4:])
+	return 28, nil
 }
 
-// EncodeInt8Slice encodes int8[] to ABI bytes
-func EncodeInt8Slice(value []int8, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// PackedEncodeUint232U256 encodes uint232 to packed ABI bytes (no padding)
+func PackedEncodeUint232U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 29 {
+		return 0, io.ErrShortBuffer
+	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:29], tmp[3:])
+	return 29, nil
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt8(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint240U256 encodes uint240 to packed ABI bytes (no padding)
+func PackedEncodeUint240U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 30 {
+		return 0, io.ErrShortBuffer
 	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:30], tmp[2:])
+	return 30, nil
+}
 
-	return offset + 32, nil
+// PackedEncodeUint248U256 encodes uint248 to packed ABI bytes (no padding)
+func PackedEncodeUint248U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 31 {
+		return 0, io.ErrShortBuffer
+	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:31], tmp[1:])
+	return 31, nil
 }
 
-// EncodeInt96 encodes int96 to ABI bytes
-func EncodeInt96(value *big.Int, buf []byte) (int, error) {
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
+// PackedEncodeUint256U256 encodes uint256 to packed ABI bytes (no padding)
+func PackedEncodeUint256U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 32 {
+		return 0, io.ErrShortBuffer
 	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	value.WriteToArray32((*[32]byte)(buf[:32]))
 	return 32, nil
 }
 
-// EncodeInt96Slice encodes int96[] to ABI bytes
-func EncodeInt96Slice(value []*big.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeInt96(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedEncodeUint72U256 encodes uint72 to packed ABI bytes (no padding)
+func PackedEncodeUint72U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 9 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return offset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:9], tmp[23:])
+	return 9, nil
 }
 
-// EncodeString encodes string to ABI bytes
-func EncodeString(value string, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-
-	// Encode data
-	copy(buf[32:], []byte(value))
-
-	return 32 + Pad32(len(value)), nil
+// PackedEncodeUint80U256 encodes uint80 to packed ABI bytes (no padding)
+func PackedEncodeUint80U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 10 {
+		return 0, io.ErrShortBuffer
+	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:10], tmp[22:])
+	return 10, nil
 }
 
-// EncodeStringSlice encodes string[] to ABI bytes
-func EncodeStringSlice(value []string, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := EncodeString(elem, buf[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+// PackedEncodeUint88U256 encodes uint88 to packed ABI bytes (no padding)
+func PackedEncodeUint88U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 11 {
+		return 0, io.ErrShortBuffer
 	}
-
-	return dynamicOffset + 32, nil
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:11], tmp[21:])
+	return 11, nil
 }
 
-// EncodeUint104 encodes uint104 to ABI bytes
-func EncodeUint104(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedEncodeUint96U256 encodes uint96 to packed ABI bytes (no padding)
+func PackedEncodeUint96U256(value *uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 12 {
+		return 0, io.ErrShortBuffer
+	}
+	if value == nil {
+		return 0, ErrNilInteger
+	}
+	var tmp [32]byte
+	value.WriteToArray32(&tmp)
+	copy(buf[:12], tmp[20:])
+	return 12, nil
 }
 
-// EncodeUint104Slice encodes uint104[] to ABI bytes
-func EncodeUint104Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint104(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint104U256 decodes uint104 from packed ABI bytes (no padding)
+func PackedDecodeUint104U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 13 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:13])
+	return result, 13, nil
 }
 
-// EncodeUint112 encodes uint112 to ABI bytes
-func EncodeUint112(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint112U256 decodes uint112 from packed ABI bytes (no padding)
+func PackedDecodeUint112U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 14 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:14])
+	return result, 14, nil
 }
 
-// EncodeUint112Slice encodes uint112[] to ABI bytes
-func EncodeUint112Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint112(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint120U256 decodes uint120 from packed ABI bytes (no padding)
+func PackedDecodeUint120U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 15 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:15])
+	return result, 15, nil
 }
 
-// EncodeUint120 encodes uint120 to ABI bytes
-func EncodeUint120(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint128U256 decodes uint128 from packed ABI bytes (no padding)
+func PackedDecodeUint128U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 16 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:16])
+	return result, 16, nil
 }
 
-// EncodeUint120Slice encodes uint120[] to ABI bytes
-func EncodeUint120Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint120(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint136U256 decodes uint136 from packed ABI bytes (no padding)
+func PackedDecodeUint136U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 17 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:17])
+	return result, 17, nil
 }
 
-// EncodeUint128 encodes uint128 to ABI bytes
-func EncodeUint128(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint144U256 decodes uint144 from packed ABI bytes (no padding)
+func PackedDecodeUint144U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 18 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:18])
+	return result, 18, nil
 }
 
-// EncodeUint128Slice encodes uint128[] to ABI bytes
-func EncodeUint128Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint128(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint152U256 decodes uint152 from packed ABI bytes (no padding)
+func PackedDecodeUint152U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 19 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:19])
+	return result, 19, nil
 }
 
-// EncodeUint136 encodes uint136 to ABI bytes
-func EncodeUint136(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint160U256 decodes uint160 from packed ABI bytes (no padding)
+func PackedDecodeUint160U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 20 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:20])
+	return result, 20, nil
 }
 
-// EncodeUint136Slice encodes uint136[] to ABI bytes
-func EncodeUint136Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint136(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint168U256 decodes uint168 from packed ABI bytes (no padding)
+func PackedDecodeUint168U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 21 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
-}
-
-// EncodeUint144 encodes uint144 to ABI bytes
-func EncodeUint144(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:21])
+	return result, 21, nil
 }
 
-// EncodeUint144Slice encodes uint144[] to ABI bytes
-func EncodeUint144Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint144(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint176U256 decodes uint176 from packed ABI bytes (no padding)
+func PackedDecodeUint176U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 22 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:22])
+	return result, 22, nil
 }
 
-// EncodeUint152 encodes uint152 to ABI bytes
-func EncodeUint152(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint184U256 decodes uint184 from packed ABI bytes (no padding)
+func PackedDecodeUint184U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 23 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:23])
+	return result, 23, nil
 }
 
-// EncodeUint152Slice encodes uint152[] to ABI bytes
-func EncodeUint152Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint152(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint192U256 decodes uint192 from packed ABI bytes (no padding)
+func PackedDecodeUint192U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 24 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:24])
+	return result, 24, nil
 }
 
-// EncodeUint16 encodes uint16 to ABI bytes
-func EncodeUint16(value uint16, buf []byte) (int, error) {
-	binary.BigEndian.PutUint16(buf[30:32], uint16(value))
-	return 32, nil
+// PackedDecodeUint200U256 decodes uint200 from packed ABI bytes (no padding)
+func PackedDecodeUint200U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 25 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:25])
+	return result, 25, nil
 }
 
-// EncodeUint160 encodes uint160 to ABI bytes
-func EncodeUint160(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint208U256 decodes uint208 from packed ABI bytes (no padding)
+func PackedDecodeUint208U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 26 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:26])
+	return result, 26, nil
 }
 
-// EncodeUint160Slice encodes uint160[] to ABI bytes
-func EncodeUint160Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint160(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint216U256 decodes uint216 from packed ABI bytes (no padding)
+func PackedDecodeUint216U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 27 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:27])
+	return result, 27, nil
 }
 
-// EncodeUint168 encodes uint168 to ABI bytes
-func EncodeUint168(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint224U256 decodes uint224 from packed ABI bytes (no padding)
+func PackedDecodeUint224U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 28 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:28])
+	return result, 28, nil
 }
 
-// EncodeUint168Slice encodes uint168[] to ABI bytes
-func EncodeUint168Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint168(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint232U256 decodes uint232 from packed ABI bytes (no padding)
+func PackedDecodeUint232U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 29 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:29])
+	return result, 29, nil
 }
 
-// EncodeUint16Slice encodes uint16[] to ABI bytes
-func EncodeUint16Slice(value []uint16, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint16(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint240U256 decodes uint240 from packed ABI bytes (no padding)
+func PackedDecodeUint240U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 30 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:30])
+	return result, 30, nil
 }
 
-// EncodeUint176 encodes uint176 to ABI bytes
-func EncodeUint176(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint248U256 decodes uint248 from packed ABI bytes (no padding)
+func PackedDecodeUint248U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 31 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:31])
+	return result, 31, nil
 }
 
-// EncodeUint176Slice encodes uint176[] to ABI bytes
-func EncodeUint176Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint176(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint256U256 decodes uint256 from packed ABI bytes (no padding)
+func PackedDecodeUint256U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes32(data[:32])
+	return result, 32, nil
 }
 
-// EncodeUint184 encodes uint184 to ABI bytes
-func EncodeUint184(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint72U256 decodes uint72 from packed ABI bytes (no padding)
+func PackedDecodeUint72U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 9 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:9])
+	return result, 9, nil
 }
 
-// EncodeUint184Slice encodes uint184[] to ABI bytes
-func EncodeUint184Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint184(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint80U256 decodes uint80 from packed ABI bytes (no padding)
+func PackedDecodeUint80U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 10 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:10])
+	return result, 10, nil
 }
 
-// EncodeUint192 encodes uint192 to ABI bytes
-func EncodeUint192(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// PackedDecodeUint88U256 decodes uint88 from packed ABI bytes (no padding)
+func PackedDecodeUint88U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 11 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	result := new(uint256.Int)
+	result.SetBytes(data[:11])
+	return result, 11, nil
 }
 
-// EncodeUint192Slice encodes uint192[] to ABI bytes
-func EncodeUint192Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint192(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// PackedDecodeUint96U256 decodes uint96 from packed ABI bytes (no padding)
+func PackedDecodeUint96U256(data []byte) (*uint256.Int, int, error) {
+	if len(data) < 12 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	result := new(uint256.Int)
+	result.SetBytes(data[:12])
+	return result, 12, nil
 }
 
-// EncodeUint200 encodes uint200 to ABI bytes
-func EncodeUint200(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint104SliceU256 encodes uint104[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint104SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint104SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint104SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint200Slice encodes uint200[] to ABI bytes
-func EncodeUint200Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint200(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint104SliceU256 decodes uint104[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint104SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint104SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint208 encodes uint208 to ABI bytes
-func EncodeUint208(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint112SliceU256 encodes uint112[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint112SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint112SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint112SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint208Slice encodes uint208[] to ABI bytes
-func EncodeUint208Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint208(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint112SliceU256 decodes uint112[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint112SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint112SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint216 encodes uint216 to ABI bytes
-func EncodeUint216(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint120SliceU256 encodes uint120[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint120SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint120SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint120SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint216Slice encodes uint216[] to ABI bytes
-func EncodeUint216Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint216(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint120SliceU256 decodes uint120[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint120SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint120SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint224 encodes uint224 to ABI bytes
-func EncodeUint224(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint128SliceU256 encodes uint128[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint128SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint128SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint128SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint224Slice encodes uint224[] to ABI bytes
-func EncodeUint224Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint224(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint128SliceU256 decodes uint128[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint128SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint128SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint232 encodes uint232 to ABI bytes
-func EncodeUint232(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint136SliceU256 encodes uint136[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint136SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint136SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint136SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint232Slice encodes uint232[] to ABI bytes
-func EncodeUint232Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint232(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint136SliceU256 decodes uint136[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint136SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint136SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint24 encodes uint24 to ABI bytes
-func EncodeUint24(value uint32, buf []byte) (int, error) {
-	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
-	return 32, nil
+// EncodeTopLevelUint144SliceU256 encodes uint144[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint144SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint144SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint144SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint240 encodes uint240 to ABI bytes
-func EncodeUint240(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// DecodeTopLevelUint144SliceU256 decodes uint144[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint144SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint144SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint240Slice encodes uint240[] to ABI bytes
-func EncodeUint240Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint240(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// EncodeTopLevelUint152SliceU256 encodes uint152[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint152SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint152SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint152SliceU256(value, buf[32:]); err != nil {
+		return nil, err
 	}
-
-	return offset + 32, nil
+	return buf, nil
 }
 
-// EncodeUint248 encodes uint248 to ABI bytes
-func EncodeUint248(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// DecodeTopLevelUint152SliceU256 decodes uint152[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint152SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint152SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint248Slice encodes uint248[] to ABI bytes
-func EncodeUint248Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint248(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// EncodeTopLevelUint160SliceU256 encodes uint160[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint160SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint160SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint160SliceU256(value, buf[32:]); err != nil {
+		return nil, err
 	}
-
-	return offset + 32, nil
+	return buf, nil
 }
 
-// EncodeUint24Slice encodes uint24[] to ABI bytes
-func EncodeUint24Slice(value []uint32, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint24(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint160SliceU256 decodes uint160[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint160SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint160SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint256 encodes uint256 to ABI bytes
-func EncodeUint256(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint168SliceU256 encodes uint168[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint168SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint168SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint168SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint256Slice encodes uint256[] to ABI bytes
-func EncodeUint256Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint256(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint168SliceU256 decodes uint168[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint168SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint168SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint32 encodes uint32 to ABI bytes
-func EncodeUint32(value uint32, buf []byte) (int, error) {
-	binary.BigEndian.PutUint32(buf[28:32], uint32(value))
-	return 32, nil
+// EncodeTopLevelUint176SliceU256 encodes uint176[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint176SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint176SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint176SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint32Slice encodes uint32[] to ABI bytes
-func EncodeUint32Slice(value []uint32, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint32(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint176SliceU256 decodes uint176[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint176SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint176SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
 	}
+	return result, 32 + n, nil
+}
 
-	return offset + 32, nil
+// EncodeTopLevelUint184SliceU256 encodes uint184[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint184SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint184SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint184SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint40 encodes uint40 to ABI bytes
-func EncodeUint40(value uint64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	return 32, nil
+// DecodeTopLevelUint184SliceU256 decodes uint184[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint184SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint184SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint40Slice encodes uint40[] to ABI bytes
-func EncodeUint40Slice(value []uint64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
+// EncodeTopLevelUint192SliceU256 encodes uint192[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint192SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint192SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint192SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
 
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint40(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint192SliceU256 decodes uint192[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint192SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
-}
-
-// EncodeUint48 encodes uint48 to ABI bytes
-func EncodeUint48(value uint64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	return 32, nil
-}
-
-// EncodeUint48Slice encodes uint48[] to ABI bytes
-func EncodeUint48Slice(value []uint64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint48(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
 	}
-
-	return offset + 32, nil
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint192SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint56 encodes uint56 to ABI bytes
-func EncodeUint56(value uint64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	return 32, nil
+// EncodeTopLevelUint200SliceU256 encodes uint200[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint200SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint200SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint200SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint56Slice encodes uint56[] to ABI bytes
-func EncodeUint56Slice(value []uint64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint56(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint200SliceU256 decodes uint200[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint200SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint200SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint64 encodes uint64 to ABI bytes
-func EncodeUint64(value uint64, buf []byte) (int, error) {
-	binary.BigEndian.PutUint64(buf[24:32], uint64(value))
-	return 32, nil
+// EncodeTopLevelUint208SliceU256 encodes uint208[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint208SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint208SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint208SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint64Slice encodes uint64[] to ABI bytes
-func EncodeUint64Slice(value []uint64, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint64(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint208SliceU256 decodes uint208[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint208SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint208SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint72 encodes uint72 to ABI bytes
-func EncodeUint72(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint216SliceU256 encodes uint216[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint216SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint216SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint216SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint72Slice encodes uint72[] to ABI bytes
-func EncodeUint72Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint72(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint216SliceU256 decodes uint216[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint216SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint216SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint8 encodes uint8 to ABI bytes
-func EncodeUint8(value uint8, buf []byte) (int, error) {
-	buf[31] = byte(value)
-	return 32, nil
+// EncodeTopLevelUint224SliceU256 encodes uint224[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint224SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint224SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint224SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint80 encodes uint80 to ABI bytes
-func EncodeUint80(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// DecodeTopLevelUint224SliceU256 decodes uint224[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint224SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint224SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint80Slice encodes uint80[] to ABI bytes
-func EncodeUint80Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint80(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// EncodeTopLevelUint232SliceU256 encodes uint232[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint232SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint232SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint232SliceU256(value, buf[32:]); err != nil {
+		return nil, err
 	}
-
-	return offset + 32, nil
+	return buf, nil
 }
 
-// EncodeUint88 encodes uint88 to ABI bytes
-func EncodeUint88(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// DecodeTopLevelUint232SliceU256 decodes uint232[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint232SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint232SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint88Slice encodes uint88[] to ABI bytes
-func EncodeUint88Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint88(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// EncodeTopLevelUint240SliceU256 encodes uint240[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint240SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint240SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint240SliceU256(value, buf[32:]); err != nil {
+		return nil, err
 	}
-
-	return offset + 32, nil
+	return buf, nil
 }
 
-// EncodeUint8Slice encodes uint8[] to ABI bytes
-func EncodeUint8Slice(value []uint8, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint8(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint240SliceU256 decodes uint240[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint240SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint240SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// EncodeUint96 encodes uint96 to ABI bytes
-func EncodeUint96(value *uint256.Int, buf []byte) (int, error) {
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
+// EncodeTopLevelUint248SliceU256 encodes uint248[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint248SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint248SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint248SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// EncodeUint96Slice encodes uint96[] to ABI bytes
-func EncodeUint96Slice(value []*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with static types
-	var offset int
-	for _, elem := range value {
-		n, err := EncodeUint96(elem, buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
+// DecodeTopLevelUint248SliceU256 decodes uint248[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint248SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
 	}
-
-	return offset + 32, nil
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint248SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// SizeAddressSlice returns the encoded size of address[]
-func SizeAddressSlice(value []common.Address) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// EncodeTopLevelUint256SliceU256 encodes uint256[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint256SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint256SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint256SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeBoolSlice returns the encoded size of bool[]
-func SizeBoolSlice(value []bool) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// DecodeTopLevelUint256SliceU256 decodes uint256[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint256SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint256SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// SizeBytes returns the encoded size of bytes
-func SizeBytes(value []byte) int {
-	size := 32 + Pad32(len(value)) // length + padded bytes data
-	return size
+// EncodeTopLevelUint72SliceU256 encodes uint72[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint72SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint72SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint72SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeBytes10Slice returns the encoded size of bytes10[]
-func SizeBytes10Slice(value [][10]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// DecodeTopLevelUint72SliceU256 decodes uint72[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint72SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint72SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// SizeBytes11Slice returns the encoded size of bytes11[]
-func SizeBytes11Slice(value [][11]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// EncodeTopLevelUint80SliceU256 encodes uint80[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint80SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint80SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint80SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeBytes12Slice returns the encoded size of bytes12[]
-func SizeBytes12Slice(value [][12]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeBytes13Slice returns the encoded size of bytes13[]
-func SizeBytes13Slice(value [][13]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// DecodeTopLevelUint80SliceU256 decodes uint80[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint80SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint80SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// SizeBytes14Slice returns the encoded size of bytes14[]
-func SizeBytes14Slice(value [][14]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// EncodeTopLevelUint88SliceU256 encodes uint88[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint88SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint88SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint88SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeBytes15Slice returns the encoded size of bytes15[]
-func SizeBytes15Slice(value [][15]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// DecodeTopLevelUint88SliceU256 decodes uint88[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint88SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint88SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
 }
 
-// SizeBytes16Slice returns the encoded size of bytes16[]
-func SizeBytes16Slice(value [][16]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// EncodeTopLevelUint96SliceU256 encodes uint96[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint96SliceU256(value []*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint96SliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint96SliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeBytes17Slice returns the encoded size of bytes17[]
-func SizeBytes17Slice(value [][17]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+// DecodeTopLevelUint96SliceU256 decodes uint96[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint96SliceU256(data []byte) ([]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint96SliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+var _ Method = (*UintsCall)(nil)
+
+const UintsCallStaticSize = 1536
+
+// UintsCall represents an ABI tuple
+type UintsCall struct {
+	Field1  *uint256.Int
+	Field2  *uint256.Int
+	Field3  *uint256.Int
+	Field4  *uint256.Int
+	Field5  *uint256.Int
+	Field6  *uint256.Int
+	Field7  *uint256.Int
+	Field8  *uint256.Int
+	Field9  *uint256.Int
+	Field10 *uint256.Int
+	Field11 *uint256.Int
+	Field12 *uint256.Int
+	Field13 *uint256.Int
+	Field14 *uint256.Int
+	Field15 *uint256.Int
+	Field16 *uint256.Int
+	Field17 *uint256.Int
+	Field18 *uint256.Int
+	Field19 *uint256.Int
+	Field20 *uint256.Int
+	Field21 *uint256.Int
+	Field22 *uint256.Int
+	Field23 *uint256.Int
+	Field24 *uint256.Int
+	Field25 []*uint256.Int
+	Field26 []*uint256.Int
+	Field27 []*uint256.Int
+	Field28 []*uint256.Int
+	Field29 []*uint256.Int
+	Field30 []*uint256.Int
+	Field31 []*uint256.Int
+	Field32 []*uint256.Int
+	Field33 []*uint256.Int
+	Field34 []*uint256.Int
+	Field35 []*uint256.Int
+	Field36 []*uint256.Int
+	Field37 []*uint256.Int
+	Field38 []*uint256.Int
+	Field39 []*uint256.Int
+	Field40 []*uint256.Int
+	Field41 []*uint256.Int
+	Field42 []*uint256.Int
+	Field43 []*uint256.Int
+	Field44 []*uint256.Int
+	Field45 []*uint256.Int
+	Field46 []*uint256.Int
+	Field47 []*uint256.Int
+	Field48 []*uint256.Int
+}
+
+// EncodedSize returns the total encoded size of UintsCall
+func (t UintsCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeUint72SliceU256(t.Field25)
+	dynamicSize += SizeUint80SliceU256(t.Field26)
+	dynamicSize += SizeUint88SliceU256(t.Field27)
+	dynamicSize += SizeUint96SliceU256(t.Field28)
+	dynamicSize += SizeUint104SliceU256(t.Field29)
+	dynamicSize += SizeUint112SliceU256(t.Field30)
+	dynamicSize += SizeUint120SliceU256(t.Field31)
+	dynamicSize += SizeUint128SliceU256(t.Field32)
+	dynamicSize += SizeUint136SliceU256(t.Field33)
+	dynamicSize += SizeUint144SliceU256(t.Field34)
+	dynamicSize += SizeUint152SliceU256(t.Field35)
+	dynamicSize += SizeUint160SliceU256(t.Field36)
+	dynamicSize += SizeUint168SliceU256(t.Field37)
+	dynamicSize += SizeUint176SliceU256(t.Field38)
+	dynamicSize += SizeUint184SliceU256(t.Field39)
+	dynamicSize += SizeUint192SliceU256(t.Field40)
+	dynamicSize += SizeUint200SliceU256(t.Field41)
+	dynamicSize += SizeUint208SliceU256(t.Field42)
+	dynamicSize += SizeUint216SliceU256(t.Field43)
+	dynamicSize += SizeUint224SliceU256(t.Field44)
+	dynamicSize += SizeUint232SliceU256(t.Field45)
+	dynamicSize += SizeUint240SliceU256(t.Field46)
+	dynamicSize += SizeUint248SliceU256(t.Field47)
+	dynamicSize += SizeUint256SliceU256(t.Field48)
+
+	return UintsCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes UintsCall to ABI bytes in the provided buffer
+func (value UintsCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := UintsCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Field1: uint72
+	if _, err := EncodeUint72U256(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes18Slice returns the encoded size of bytes18[]
-func SizeBytes18Slice(value [][18]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field2: uint80
+	if _, err := EncodeUint80U256(value.Field2, buf[32:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes19Slice returns the encoded size of bytes19[]
-func SizeBytes19Slice(value [][19]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field3: uint88
+	if _, err := EncodeUint88U256(value.Field3, buf[64:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes1Slice returns the encoded size of bytes1[]
-func SizeBytes1Slice(value [][1]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field4: uint96
+	if _, err := EncodeUint96U256(value.Field4, buf[96:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes20Slice returns the encoded size of bytes20[]
-func SizeBytes20Slice(value [][20]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field5: uint104
+	if _, err := EncodeUint104U256(value.Field5, buf[128:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes21Slice returns the encoded size of bytes21[]
-func SizeBytes21Slice(value [][21]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field6: uint112
+	if _, err := EncodeUint112U256(value.Field6, buf[160:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes22Slice returns the encoded size of bytes22[]
-func SizeBytes22Slice(value [][22]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field7: uint120
+	if _, err := EncodeUint120U256(value.Field7, buf[192:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes23Slice returns the encoded size of bytes23[]
-func SizeBytes23Slice(value [][23]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field8: uint128
+	if _, err := EncodeUint128U256(value.Field8, buf[224:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes24Slice returns the encoded size of bytes24[]
-func SizeBytes24Slice(value [][24]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field9: uint136
+	if _, err := EncodeUint136U256(value.Field9, buf[256:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes25Slice returns the encoded size of bytes25[]
-func SizeBytes25Slice(value [][25]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field10: uint144
+	if _, err := EncodeUint144U256(value.Field10, buf[288:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes26Slice returns the encoded size of bytes26[]
-func SizeBytes26Slice(value [][26]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field11: uint152
+	if _, err := EncodeUint152U256(value.Field11, buf[320:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes27Slice returns the encoded size of bytes27[]
-func SizeBytes27Slice(value [][27]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field12: uint160
+	if _, err := EncodeUint160U256(value.Field12, buf[352:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes28Slice returns the encoded size of bytes28[]
-func SizeBytes28Slice(value [][28]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field13: uint168
+	if _, err := EncodeUint168U256(value.Field13, buf[384:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes29Slice returns the encoded size of bytes29[]
-func SizeBytes29Slice(value [][29]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field14: uint176
+	if _, err := EncodeUint176U256(value.Field14, buf[416:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes2Slice returns the encoded size of bytes2[]
-func SizeBytes2Slice(value [][2]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field15: uint184
+	if _, err := EncodeUint184U256(value.Field15, buf[448:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes30Slice returns the encoded size of bytes30[]
-func SizeBytes30Slice(value [][30]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field16: uint192
+	if _, err := EncodeUint192U256(value.Field16, buf[480:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes31Slice returns the encoded size of bytes31[]
-func SizeBytes31Slice(value [][31]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field17: uint200
+	if _, err := EncodeUint200U256(value.Field17, buf[512:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes32Slice returns the encoded size of bytes32[]
-func SizeBytes32Slice(value [][32]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field18: uint208
+	if _, err := EncodeUint208U256(value.Field18, buf[544:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes3Slice returns the encoded size of bytes3[]
-func SizeBytes3Slice(value [][3]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field19: uint216
+	if _, err := EncodeUint216U256(value.Field19, buf[576:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes4Slice returns the encoded size of bytes4[]
-func SizeBytes4Slice(value [][4]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field20: uint224
+	if _, err := EncodeUint224U256(value.Field20, buf[608:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes5Slice returns the encoded size of bytes5[]
-func SizeBytes5Slice(value [][5]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field21: uint232
+	if _, err := EncodeUint232U256(value.Field21, buf[640:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes6Slice returns the encoded size of bytes6[]
-func SizeBytes6Slice(value [][6]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field22: uint240
+	if _, err := EncodeUint240U256(value.Field22, buf[672:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes7Slice returns the encoded size of bytes7[]
-func SizeBytes7Slice(value [][7]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field23: uint248
+	if _, err := EncodeUint248U256(value.Field23, buf[704:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes8Slice returns the encoded size of bytes8[]
-func SizeBytes8Slice(value [][8]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field24: uint256
+	if _, err := EncodeUint256U256(value.Field24, buf[736:]); err != nil {
+		return 0, err
+	}
 
-// SizeBytes9Slice returns the encoded size of bytes9[]
-func SizeBytes9Slice(value [][9]byte) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field25: uint72[]
+	// Encode offset pointer
+	ClearWord(buf[768:])
+	binary.BigEndian.PutUint64(buf[768+24:768+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint72SliceU256(value.Field25, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeBytesSlice returns the encoded size of bytes[]
-func SizeBytesSlice(value [][]byte) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += SizeBytes(elem)
+	// Field Field26: uint80[]
+	// Encode offset pointer
+	ClearWord(buf[800:])
+	binary.BigEndian.PutUint64(buf[800+24:800+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint80SliceU256(value.Field26, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
 	}
-	return size
-}
+	dynamicOffset += n
 
-// SizeInt104Slice returns the encoded size of int104[]
-func SizeInt104Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field27: uint88[]
+	// Encode offset pointer
+	ClearWord(buf[832:])
+	binary.BigEndian.PutUint64(buf[832+24:832+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint88SliceU256(value.Field27, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt112Slice returns the encoded size of int112[]
-func SizeInt112Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field28: uint96[]
+	// Encode offset pointer
+	ClearWord(buf[864:])
+	binary.BigEndian.PutUint64(buf[864+24:864+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint96SliceU256(value.Field28, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt120Slice returns the encoded size of int120[]
-func SizeInt120Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field29: uint104[]
+	// Encode offset pointer
+	ClearWord(buf[896:])
+	binary.BigEndian.PutUint64(buf[896+24:896+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint104SliceU256(value.Field29, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt128Slice returns the encoded size of int128[]
-func SizeInt128Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field30: uint112[]
+	// Encode offset pointer
+	ClearWord(buf[928:])
+	binary.BigEndian.PutUint64(buf[928+24:928+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint112SliceU256(value.Field30, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt136Slice returns the encoded size of int136[]
-func SizeInt136Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field31: uint120[]
+	// Encode offset pointer
+	ClearWord(buf[960:])
+	binary.BigEndian.PutUint64(buf[960+24:960+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint120SliceU256(value.Field31, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt144Slice returns the encoded size of int144[]
-func SizeInt144Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field32: uint128[]
+	// Encode offset pointer
+	ClearWord(buf[992:])
+	binary.BigEndian.PutUint64(buf[992+24:992+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint128SliceU256(value.Field32, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt152Slice returns the encoded size of int152[]
-func SizeInt152Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field33: uint136[]
+	// Encode offset pointer
+	ClearWord(buf[1024:])
+	binary.BigEndian.PutUint64(buf[1024+24:1024+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint136SliceU256(value.Field33, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt160Slice returns the encoded size of int160[]
-func SizeInt160Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field34: uint144[]
+	// Encode offset pointer
+	ClearWord(buf[1056:])
+	binary.BigEndian.PutUint64(buf[1056+24:1056+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint144SliceU256(value.Field34, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeInt168Slice returns the encoded size of int168[]
-func SizeInt168Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt16Slice returns the encoded size of int16[]
-func SizeInt16Slice(value []int16) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt176Slice returns the encoded size of int176[]
-func SizeInt176Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt184Slice returns the encoded size of int184[]
-func SizeInt184Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt192Slice returns the encoded size of int192[]
-func SizeInt192Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt200Slice returns the encoded size of int200[]
-func SizeInt200Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt208Slice returns the encoded size of int208[]
-func SizeInt208Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt216Slice returns the encoded size of int216[]
-func SizeInt216Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt224Slice returns the encoded size of int224[]
-func SizeInt224Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt232Slice returns the encoded size of int232[]
-func SizeInt232Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt240Slice returns the encoded size of int240[]
-func SizeInt240Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt248Slice returns the encoded size of int248[]
-func SizeInt248Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt24Slice returns the encoded size of int24[]
-func SizeInt24Slice(value []int32) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt256Slice returns the encoded size of int256[]
-func SizeInt256Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt32Slice returns the encoded size of int32[]
-func SizeInt32Slice(value []int32) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt40Slice returns the encoded size of int40[]
-func SizeInt40Slice(value []int64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt48Slice returns the encoded size of int48[]
-func SizeInt48Slice(value []int64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt56Slice returns the encoded size of int56[]
-func SizeInt56Slice(value []int64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt64Slice returns the encoded size of int64[]
-func SizeInt64Slice(value []int64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt72Slice returns the encoded size of int72[]
-func SizeInt72Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt80Slice returns the encoded size of int80[]
-func SizeInt80Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt88Slice returns the encoded size of int88[]
-func SizeInt88Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt8Slice returns the encoded size of int8[]
-func SizeInt8Slice(value []int8) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeInt96Slice returns the encoded size of int96[]
-func SizeInt96Slice(value []*big.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeString returns the encoded size of string
-func SizeString(value string) int {
-	size := 32 + Pad32(len(value)) // length + padded string data
-	return size
-}
-
-// SizeStringSlice returns the encoded size of string[]
-func SizeStringSlice(value []string) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += SizeString(elem)
+	// Field Field35: uint152[]
+	// Encode offset pointer
+	ClearWord(buf[1088:])
+	binary.BigEndian.PutUint64(buf[1088+24:1088+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint152SliceU256(value.Field35, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
 	}
-	return size
-}
-
-// SizeUint104Slice returns the encoded size of uint104[]
-func SizeUint104Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint112Slice returns the encoded size of uint112[]
-func SizeUint112Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	dynamicOffset += n
 
-// SizeUint120Slice returns the encoded size of uint120[]
-func SizeUint120Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field36: uint160[]
+	// Encode offset pointer
+	ClearWord(buf[1120:])
+	binary.BigEndian.PutUint64(buf[1120+24:1120+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint160SliceU256(value.Field36, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint128Slice returns the encoded size of uint128[]
-func SizeUint128Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field37: uint168[]
+	// Encode offset pointer
+	ClearWord(buf[1152:])
+	binary.BigEndian.PutUint64(buf[1152+24:1152+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint168SliceU256(value.Field37, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint136Slice returns the encoded size of uint136[]
-func SizeUint136Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field38: uint176[]
+	// Encode offset pointer
+	ClearWord(buf[1184:])
+	binary.BigEndian.PutUint64(buf[1184+24:1184+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint176SliceU256(value.Field38, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint144Slice returns the encoded size of uint144[]
-func SizeUint144Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field39: uint184[]
+	// Encode offset pointer
+	ClearWord(buf[1216:])
+	binary.BigEndian.PutUint64(buf[1216+24:1216+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint184SliceU256(value.Field39, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint152Slice returns the encoded size of uint152[]
-func SizeUint152Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field40: uint192[]
+	// Encode offset pointer
+	ClearWord(buf[1248:])
+	binary.BigEndian.PutUint64(buf[1248+24:1248+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint192SliceU256(value.Field40, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint160Slice returns the encoded size of uint160[]
-func SizeUint160Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field41: uint200[]
+	// Encode offset pointer
+	ClearWord(buf[1280:])
+	binary.BigEndian.PutUint64(buf[1280+24:1280+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint200SliceU256(value.Field41, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint168Slice returns the encoded size of uint168[]
-func SizeUint168Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field42: uint208[]
+	// Encode offset pointer
+	ClearWord(buf[1312:])
+	binary.BigEndian.PutUint64(buf[1312+24:1312+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint208SliceU256(value.Field42, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint16Slice returns the encoded size of uint16[]
-func SizeUint16Slice(value []uint16) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field43: uint216[]
+	// Encode offset pointer
+	ClearWord(buf[1344:])
+	binary.BigEndian.PutUint64(buf[1344+24:1344+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint216SliceU256(value.Field43, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint176Slice returns the encoded size of uint176[]
-func SizeUint176Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field44: uint224[]
+	// Encode offset pointer
+	ClearWord(buf[1376:])
+	binary.BigEndian.PutUint64(buf[1376+24:1376+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint224SliceU256(value.Field44, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint184Slice returns the encoded size of uint184[]
-func SizeUint184Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field45: uint232[]
+	// Encode offset pointer
+	ClearWord(buf[1408:])
+	binary.BigEndian.PutUint64(buf[1408+24:1408+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint232SliceU256(value.Field45, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint192Slice returns the encoded size of uint192[]
-func SizeUint192Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field46: uint240[]
+	// Encode offset pointer
+	ClearWord(buf[1440:])
+	binary.BigEndian.PutUint64(buf[1440+24:1440+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint240SliceU256(value.Field46, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint200Slice returns the encoded size of uint200[]
-func SizeUint200Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field47: uint248[]
+	// Encode offset pointer
+	ClearWord(buf[1472:])
+	binary.BigEndian.PutUint64(buf[1472+24:1472+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint248SliceU256(value.Field47, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint208Slice returns the encoded size of uint208[]
-func SizeUint208Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
+	// Field Field48: uint256[]
+	// Encode offset pointer
+	ClearWord(buf[1504:])
+	binary.BigEndian.PutUint64(buf[1504+24:1504+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint256SliceU256(value.Field48, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
 
-// SizeUint216Slice returns the encoded size of uint216[]
-func SizeUint216Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+	return dynamicOffset, nil
 }
 
-// SizeUint224Slice returns the encoded size of uint224[]
-func SizeUint224Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
+// Encode encodes UintsCall to ABI bytes
+func (value UintsCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// SizeUint232Slice returns the encoded size of uint232[]
-func SizeUint232Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint240Slice returns the encoded size of uint240[]
-func SizeUint240Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint248Slice returns the encoded size of uint248[]
-func SizeUint248Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint24Slice returns the encoded size of uint24[]
-func SizeUint24Slice(value []uint32) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint256Slice returns the encoded size of uint256[]
-func SizeUint256Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint32Slice returns the encoded size of uint32[]
-func SizeUint32Slice(value []uint32) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint40Slice returns the encoded size of uint40[]
-func SizeUint40Slice(value []uint64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint48Slice returns the encoded size of uint48[]
-func SizeUint48Slice(value []uint64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint56Slice returns the encoded size of uint56[]
-func SizeUint56Slice(value []uint64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint64Slice returns the encoded size of uint64[]
-func SizeUint64Slice(value []uint64) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint72Slice returns the encoded size of uint72[]
-func SizeUint72Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint80Slice returns the encoded size of uint80[]
-func SizeUint80Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint88Slice returns the encoded size of uint88[]
-func SizeUint88Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint8Slice returns the encoded size of uint8[]
-func SizeUint8Slice(value []uint8) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// SizeUint96Slice returns the encoded size of uint96[]
-func SizeUint96Slice(value []*uint256.Int) int {
-	size := 32 + 32*len(value) // length + static elements
-	return size
-}
-
-// DecodeAddress decodes address from ABI bytes
-func DecodeAddress(data []byte) (common.Address, int, error) {
-	var result common.Address
-	for i := 0; i < 12; i++ {
-		if data[i] != 0x00 {
-			return result, 0, ErrDirtyPadding
-		}
-	}
-	copy(result[:], data[12:32])
-	return result, 32, nil
-}
-
-// DecodeAddressSlice decodes address[] from ABI bytes
-func DecodeAddressSlice(data []byte) ([]common.Address, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+// Decode decodes UintsCall from ABI bytes in the provided buffer
+func (t *UintsCall) Decode(data []byte) (int, error) {
+	if len(data) < 1536 {
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
+		err    error
 		n      int
 		offset int
 	)
-	// Decode elements with static types
-	result := make([]common.Address, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeAddress(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBool decodes bool from ABI bytes
-func DecodeBool(data []byte) (bool, int, error) {
-	// Validate boolean encoding - only 0 or 1 are valid
-	for _, i := range data[:31] {
-		if i != 0 {
-			return false, 0, ErrDirtyPadding
-		}
-	}
-	switch data[31] {
-	case 0x01:
-		return true, 32, nil
-	case 0x00:
-		return false, 32, nil
-	default:
-		return false, 0, ErrDirtyPadding
-	}
-}
-
-// DecodeBoolSlice decodes bool[] from ABI bytes
-func DecodeBoolSlice(data []byte) ([]bool, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
+	dynamicOffset := 1536
+	// Decode static field Field1: uint72
+	t.Field1, _, err = DecodeIntoUint72U256(t.Field1, data[0:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]bool, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBool(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes decodes bytes from ABI bytes
-func DecodeBytes(data []byte) ([]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, err
 	}
-	length, err := DecodeSize(data)
+	// Decode static field Field2: uint80
+	t.Field2, _, err = DecodeIntoUint80U256(t.Field2, data[32:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	paddedLength := Pad32(length)
-	if len(data) < paddedLength {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	// check padding bytes
-	for i := length; i < paddedLength; i++ {
-		if data[i] != 0x00 {
-			return nil, 0, ErrDirtyPadding
-		}
-	}
-
-	// Decode data
-	return data[:length], 32 + Pad32(length), nil
-}
-
-// DecodeBytes1 decodes bytes1 from ABI bytes
-func DecodeBytes1(data []byte) ([1]byte, int, error) {
-	// Validate padding bytes for fixed bytes[1]
-	for i := 1; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [1]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [1]byte
-	copy(result[:], data[:1])
-	return result, 1, nil
-}
-
-// DecodeBytes10 decodes bytes10 from ABI bytes
-func DecodeBytes10(data []byte) ([10]byte, int, error) {
-	// Validate padding bytes for fixed bytes[10]
-	for i := 10; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [10]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [10]byte
-	copy(result[:], data[:10])
-	return result, 10, nil
-}
-
-// DecodeBytes10Slice decodes bytes10[] from ABI bytes
-func DecodeBytes10Slice(data []byte) ([][10]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, err
 	}
-	length, err := DecodeSize(data)
+	// Decode static field Field3: uint88
+	t.Field3, _, err = DecodeIntoUint88U256(t.Field3, data[64:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][10]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes10(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes11 decodes bytes11 from ABI bytes
-func DecodeBytes11(data []byte) ([11]byte, int, error) {
-	// Validate padding bytes for fixed bytes[11]
-	for i := 11; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [11]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [11]byte
-	copy(result[:], data[:11])
-	return result, 11, nil
-}
-
-// DecodeBytes11Slice decodes bytes11[] from ABI bytes
-func DecodeBytes11Slice(data []byte) ([][11]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, err
 	}
-	length, err := DecodeSize(data)
+	// Decode static field Field4: uint96
+	t.Field4, _, err = DecodeIntoUint96U256(t.Field4, data[96:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][11]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes11(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes12 decodes bytes12 from ABI bytes
-func DecodeBytes12(data []byte) ([12]byte, int, error) {
-	// Validate padding bytes for fixed bytes[12]
-	for i := 12; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [12]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [12]byte
-	copy(result[:], data[:12])
-	return result, 12, nil
-}
-
-// DecodeBytes12Slice decodes bytes12[] from ABI bytes
-func DecodeBytes12Slice(data []byte) ([][12]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, err
 	}
-	length, err := DecodeSize(data)
+	// Decode static field Field5: uint104
+	t.Field5, _, err = DecodeIntoUint104U256(t.Field5, data[128:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, err
 	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][12]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes12(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
+	// Decode static field Field6: uint112
+	t.Field6, _, err = DecodeIntoUint112U256(t.Field6, data[160:])
+	if err != nil {
+		return 0, err
 	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes13 decodes bytes13 from ABI bytes
-func DecodeBytes13(data []byte) ([13]byte, int, error) {
-	// Validate padding bytes for fixed bytes[13]
-	for i := 13; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [13]byte{}, 0, ErrDirtyPadding
-		}
+	// Decode static field Field7: uint120
+	t.Field7, _, err = DecodeIntoUint120U256(t.Field7, data[192:])
+	if err != nil {
+		return 0, err
 	}
-	var result [13]byte
-	copy(result[:], data[:13])
-	return result, 13, nil
-}
-
-// DecodeBytes13Slice decodes bytes13[] from ABI bytes
-func DecodeBytes13Slice(data []byte) ([][13]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+	// Decode static field Field8: uint128
+	t.Field8, _, err = DecodeIntoUint128U256(t.Field8, data[224:])
+	if err != nil {
+		return 0, err
 	}
-	length, err := DecodeSize(data)
+	// Decode static field Field9: uint136
+	t.Field9, _, err = DecodeIntoUint136U256(t.Field9, data[256:])
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+	// Decode static field Field10: uint144
+	t.Field10, _, err = DecodeIntoUint144U256(t.Field10, data[288:])
+	if err != nil {
+		return 0, err
 	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][13]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes13(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
+	// Decode static field Field11: uint152
+	t.Field11, _, err = DecodeIntoUint152U256(t.Field11, data[320:])
+	if err != nil {
+		return 0, err
 	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes14 decodes bytes14 from ABI bytes
-func DecodeBytes14(data []byte) ([14]byte, int, error) {
-	// Validate padding bytes for fixed bytes[14]
-	for i := 14; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [14]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [14]byte
-	copy(result[:], data[:14])
-	return result, 14, nil
-}
-
-// DecodeBytes14Slice decodes bytes14[] from ABI bytes
-func DecodeBytes14Slice(data []byte) ([][14]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][14]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes14(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes15 decodes bytes15 from ABI bytes
-func DecodeBytes15(data []byte) ([15]byte, int, error) {
-	// Validate padding bytes for fixed bytes[15]
-	for i := 15; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [15]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [15]byte
-	copy(result[:], data[:15])
-	return result, 15, nil
-}
-
-// DecodeBytes15Slice decodes bytes15[] from ABI bytes
-func DecodeBytes15Slice(data []byte) ([][15]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][15]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes15(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes16 decodes bytes16 from ABI bytes
-func DecodeBytes16(data []byte) ([16]byte, int, error) {
-	// Validate padding bytes for fixed bytes[16]
-	for i := 16; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [16]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [16]byte
-	copy(result[:], data[:16])
-	return result, 16, nil
-}
-
-// DecodeBytes16Slice decodes bytes16[] from ABI bytes
-func DecodeBytes16Slice(data []byte) ([][16]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][16]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes16(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes17 decodes bytes17 from ABI bytes
-func DecodeBytes17(data []byte) ([17]byte, int, error) {
-	// Validate padding bytes for fixed bytes[17]
-	for i := 17; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [17]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [17]byte
-	copy(result[:], data[:17])
-	return result, 17, nil
-}
-
-// DecodeBytes17Slice decodes bytes17[] from ABI bytes
-func DecodeBytes17Slice(data []byte) ([][17]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][17]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes17(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes18 decodes bytes18 from ABI bytes
-func DecodeBytes18(data []byte) ([18]byte, int, error) {
-	// Validate padding bytes for fixed bytes[18]
-	for i := 18; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [18]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [18]byte
-	copy(result[:], data[:18])
-	return result, 18, nil
-}
-
-// DecodeBytes18Slice decodes bytes18[] from ABI bytes
-func DecodeBytes18Slice(data []byte) ([][18]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][18]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes18(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes19 decodes bytes19 from ABI bytes
-func DecodeBytes19(data []byte) ([19]byte, int, error) {
-	// Validate padding bytes for fixed bytes[19]
-	for i := 19; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [19]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [19]byte
-	copy(result[:], data[:19])
-	return result, 19, nil
-}
-
-// DecodeBytes19Slice decodes bytes19[] from ABI bytes
-func DecodeBytes19Slice(data []byte) ([][19]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][19]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes19(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes1Slice decodes bytes1[] from ABI bytes
-func DecodeBytes1Slice(data []byte) ([][1]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][1]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes1(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes2 decodes bytes2 from ABI bytes
-func DecodeBytes2(data []byte) ([2]byte, int, error) {
-	// Validate padding bytes for fixed bytes[2]
-	for i := 2; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [2]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [2]byte
-	copy(result[:], data[:2])
-	return result, 2, nil
-}
-
-// DecodeBytes20 decodes bytes20 from ABI bytes
-func DecodeBytes20(data []byte) ([20]byte, int, error) {
-	// Validate padding bytes for fixed bytes[20]
-	for i := 20; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [20]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [20]byte
-	copy(result[:], data[:20])
-	return result, 20, nil
-}
-
-// DecodeBytes20Slice decodes bytes20[] from ABI bytes
-func DecodeBytes20Slice(data []byte) ([][20]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][20]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes20(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes21 decodes bytes21 from ABI bytes
-func DecodeBytes21(data []byte) ([21]byte, int, error) {
-	// Validate padding bytes for fixed bytes[21]
-	for i := 21; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [21]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [21]byte
-	copy(result[:], data[:21])
-	return result, 21, nil
-}
-
-// DecodeBytes21Slice decodes bytes21[] from ABI bytes
-func DecodeBytes21Slice(data []byte) ([][21]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
+	// Decode static field Field12: uint160
+	t.Field12, _, err = DecodeIntoUint160U256(t.Field12, data[352:])
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][21]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes21(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes22 decodes bytes22 from ABI bytes
-func DecodeBytes22(data []byte) ([22]byte, int, error) {
-	// Validate padding bytes for fixed bytes[22]
-	for i := 22; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [22]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [22]byte
-	copy(result[:], data[:22])
-	return result, 22, nil
-}
-
-// DecodeBytes22Slice decodes bytes22[] from ABI bytes
-func DecodeBytes22Slice(data []byte) ([][22]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][22]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes22(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes23 decodes bytes23 from ABI bytes
-func DecodeBytes23(data []byte) ([23]byte, int, error) {
-	// Validate padding bytes for fixed bytes[23]
-	for i := 23; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [23]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [23]byte
-	copy(result[:], data[:23])
-	return result, 23, nil
-}
-
-// DecodeBytes23Slice decodes bytes23[] from ABI bytes
-func DecodeBytes23Slice(data []byte) ([][23]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][23]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes23(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes24 decodes bytes24 from ABI bytes
-func DecodeBytes24(data []byte) ([24]byte, int, error) {
-	// Validate padding bytes for fixed bytes[24]
-	for i := 24; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [24]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [24]byte
-	copy(result[:], data[:24])
-	return result, 24, nil
-}
-
-// DecodeBytes24Slice decodes bytes24[] from ABI bytes
-func DecodeBytes24Slice(data []byte) ([][24]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][24]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes24(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes25 decodes bytes25 from ABI bytes
-func DecodeBytes25(data []byte) ([25]byte, int, error) {
-	// Validate padding bytes for fixed bytes[25]
-	for i := 25; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [25]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [25]byte
-	copy(result[:], data[:25])
-	return result, 25, nil
-}
-
-// DecodeBytes25Slice decodes bytes25[] from ABI bytes
-func DecodeBytes25Slice(data []byte) ([][25]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][25]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes25(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes26 decodes bytes26 from ABI bytes
-func DecodeBytes26(data []byte) ([26]byte, int, error) {
-	// Validate padding bytes for fixed bytes[26]
-	for i := 26; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [26]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [26]byte
-	copy(result[:], data[:26])
-	return result, 26, nil
-}
-
-// DecodeBytes26Slice decodes bytes26[] from ABI bytes
-func DecodeBytes26Slice(data []byte) ([][26]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][26]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes26(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes27 decodes bytes27 from ABI bytes
-func DecodeBytes27(data []byte) ([27]byte, int, error) {
-	// Validate padding bytes for fixed bytes[27]
-	for i := 27; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [27]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [27]byte
-	copy(result[:], data[:27])
-	return result, 27, nil
-}
-
-// DecodeBytes27Slice decodes bytes27[] from ABI bytes
-func DecodeBytes27Slice(data []byte) ([][27]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][27]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes27(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes28 decodes bytes28 from ABI bytes
-func DecodeBytes28(data []byte) ([28]byte, int, error) {
-	// Validate padding bytes for fixed bytes[28]
-	for i := 28; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [28]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [28]byte
-	copy(result[:], data[:28])
-	return result, 28, nil
-}
-
-// DecodeBytes28Slice decodes bytes28[] from ABI bytes
-func DecodeBytes28Slice(data []byte) ([][28]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][28]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes28(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes29 decodes bytes29 from ABI bytes
-func DecodeBytes29(data []byte) ([29]byte, int, error) {
-	// Validate padding bytes for fixed bytes[29]
-	for i := 29; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [29]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [29]byte
-	copy(result[:], data[:29])
-	return result, 29, nil
-}
-
-// DecodeBytes29Slice decodes bytes29[] from ABI bytes
-func DecodeBytes29Slice(data []byte) ([][29]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][29]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes29(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes2Slice decodes bytes2[] from ABI bytes
-func DecodeBytes2Slice(data []byte) ([][2]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][2]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes2(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes3 decodes bytes3 from ABI bytes
-func DecodeBytes3(data []byte) ([3]byte, int, error) {
-	// Validate padding bytes for fixed bytes[3]
-	for i := 3; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [3]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [3]byte
-	copy(result[:], data[:3])
-	return result, 3, nil
-}
-
-// DecodeBytes30 decodes bytes30 from ABI bytes
-func DecodeBytes30(data []byte) ([30]byte, int, error) {
-	// Validate padding bytes for fixed bytes[30]
-	for i := 30; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [30]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [30]byte
-	copy(result[:], data[:30])
-	return result, 30, nil
-}
-
-// DecodeBytes30Slice decodes bytes30[] from ABI bytes
-func DecodeBytes30Slice(data []byte) ([][30]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][30]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes30(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes31 decodes bytes31 from ABI bytes
-func DecodeBytes31(data []byte) ([31]byte, int, error) {
-	// Validate padding bytes for fixed bytes[31]
-	for i := 31; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [31]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [31]byte
-	copy(result[:], data[:31])
-	return result, 31, nil
-}
-
-// DecodeBytes31Slice decodes bytes31[] from ABI bytes
-func DecodeBytes31Slice(data []byte) ([][31]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][31]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes31(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes32 decodes bytes32 from ABI bytes
-func DecodeBytes32(data []byte) ([32]byte, int, error) {
-	// Validate padding bytes for fixed bytes[32]
-	for i := 32; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [32]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [32]byte
-	copy(result[:], data[:32])
-	return result, 32, nil
-}
-
-// DecodeBytes32Slice decodes bytes32[] from ABI bytes
-func DecodeBytes32Slice(data []byte) ([][32]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][32]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes32(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes3Slice decodes bytes3[] from ABI bytes
-func DecodeBytes3Slice(data []byte) ([][3]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][3]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes3(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes4 decodes bytes4 from ABI bytes
-func DecodeBytes4(data []byte) ([4]byte, int, error) {
-	// Validate padding bytes for fixed bytes[4]
-	for i := 4; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [4]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [4]byte
-	copy(result[:], data[:4])
-	return result, 4, nil
-}
-
-// DecodeBytes4Slice decodes bytes4[] from ABI bytes
-func DecodeBytes4Slice(data []byte) ([][4]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][4]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes4(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes5 decodes bytes5 from ABI bytes
-func DecodeBytes5(data []byte) ([5]byte, int, error) {
-	// Validate padding bytes for fixed bytes[5]
-	for i := 5; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [5]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [5]byte
-	copy(result[:], data[:5])
-	return result, 5, nil
-}
-
-// DecodeBytes5Slice decodes bytes5[] from ABI bytes
-func DecodeBytes5Slice(data []byte) ([][5]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][5]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes5(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes6 decodes bytes6 from ABI bytes
-func DecodeBytes6(data []byte) ([6]byte, int, error) {
-	// Validate padding bytes for fixed bytes[6]
-	for i := 6; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [6]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [6]byte
-	copy(result[:], data[:6])
-	return result, 6, nil
-}
-
-// DecodeBytes6Slice decodes bytes6[] from ABI bytes
-func DecodeBytes6Slice(data []byte) ([][6]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][6]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes6(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes7 decodes bytes7 from ABI bytes
-func DecodeBytes7(data []byte) ([7]byte, int, error) {
-	// Validate padding bytes for fixed bytes[7]
-	for i := 7; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [7]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [7]byte
-	copy(result[:], data[:7])
-	return result, 7, nil
-}
-
-// DecodeBytes7Slice decodes bytes7[] from ABI bytes
-func DecodeBytes7Slice(data []byte) ([][7]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][7]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes7(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes8 decodes bytes8 from ABI bytes
-func DecodeBytes8(data []byte) ([8]byte, int, error) {
-	// Validate padding bytes for fixed bytes[8]
-	for i := 8; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [8]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [8]byte
-	copy(result[:], data[:8])
-	return result, 8, nil
-}
-
-// DecodeBytes8Slice decodes bytes8[] from ABI bytes
-func DecodeBytes8Slice(data []byte) ([][8]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][8]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes8(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytes9 decodes bytes9 from ABI bytes
-func DecodeBytes9(data []byte) ([9]byte, int, error) {
-	// Validate padding bytes for fixed bytes[9]
-	for i := 9; i < 32; i++ {
-		if data[i] != 0x00 {
-			return [9]byte{}, 0, ErrDirtyPadding
-		}
-	}
-	var result [9]byte
-	copy(result[:], data[:9])
-	return result, 9, nil
-}
-
-// DecodeBytes9Slice decodes bytes9[] from ABI bytes
-func DecodeBytes9Slice(data []byte) ([][9]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([][9]byte, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeBytes9(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeBytesSlice decodes bytes[] from ABI bytes
-func DecodeBytesSlice(data []byte) ([][]byte, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with dynamic types
-	result := make([][]byte, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := DecodeSize(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, ErrInvalidOffsetForSliceElement
-		}
-		result[i], n, err = DecodeBytes(data[dynamicOffset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		dynamicOffset += n
-	}
-	return result, dynamicOffset + 32, nil
-}
-
-// DecodeInt104 decodes int104 from ABI bytes
-func DecodeInt104(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt104Slice decodes int104[] from ABI bytes
-func DecodeInt104Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt104(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt112 decodes int112 from ABI bytes
-func DecodeInt112(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt112Slice decodes int112[] from ABI bytes
-func DecodeInt112Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt112(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt120 decodes int120 from ABI bytes
-func DecodeInt120(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt120Slice decodes int120[] from ABI bytes
-func DecodeInt120Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt120(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt128 decodes int128 from ABI bytes
-func DecodeInt128(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt128Slice decodes int128[] from ABI bytes
-func DecodeInt128Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt128(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt136 decodes int136 from ABI bytes
-func DecodeInt136(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt136Slice decodes int136[] from ABI bytes
-func DecodeInt136Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt136(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt144 decodes int144 from ABI bytes
-func DecodeInt144(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt144Slice decodes int144[] from ABI bytes
-func DecodeInt144Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt144(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt152 decodes int152 from ABI bytes
-func DecodeInt152(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt152Slice decodes int152[] from ABI bytes
-func DecodeInt152Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt152(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt16 decodes int16 from ABI bytes
-func DecodeInt16(data []byte) (int16, int, error) {
-	result, err := DecodeInt[int16](data, MinInt16, MaxInt16)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt160 decodes int160 from ABI bytes
-func DecodeInt160(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt160Slice decodes int160[] from ABI bytes
-func DecodeInt160Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt160(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt168 decodes int168 from ABI bytes
-func DecodeInt168(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt168Slice decodes int168[] from ABI bytes
-func DecodeInt168Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt168(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt16Slice decodes int16[] from ABI bytes
-func DecodeInt16Slice(data []byte) ([]int16, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int16, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt16(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt176 decodes int176 from ABI bytes
-func DecodeInt176(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt176Slice decodes int176[] from ABI bytes
-func DecodeInt176Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt176(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt184 decodes int184 from ABI bytes
-func DecodeInt184(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt184Slice decodes int184[] from ABI bytes
-func DecodeInt184Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt184(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt192 decodes int192 from ABI bytes
-func DecodeInt192(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt192Slice decodes int192[] from ABI bytes
-func DecodeInt192Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt192(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt200 decodes int200 from ABI bytes
-func DecodeInt200(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt200Slice decodes int200[] from ABI bytes
-func DecodeInt200Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt200(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt208 decodes int208 from ABI bytes
-func DecodeInt208(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt208Slice decodes int208[] from ABI bytes
-func DecodeInt208Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt208(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt216 decodes int216 from ABI bytes
-func DecodeInt216(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt216Slice decodes int216[] from ABI bytes
-func DecodeInt216Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt216(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt224 decodes int224 from ABI bytes
-func DecodeInt224(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt224Slice decodes int224[] from ABI bytes
-func DecodeInt224Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt224(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt232 decodes int232 from ABI bytes
-func DecodeInt232(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt232Slice decodes int232[] from ABI bytes
-func DecodeInt232Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt232(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt24 decodes int24 from ABI bytes
-func DecodeInt24(data []byte) (int32, int, error) {
-	result, err := DecodeInt[int32](data, MinInt24, MaxInt24)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt240 decodes int240 from ABI bytes
-func DecodeInt240(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt240Slice decodes int240[] from ABI bytes
-func DecodeInt240Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt240(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt248 decodes int248 from ABI bytes
-func DecodeInt248(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt248Slice decodes int248[] from ABI bytes
-func DecodeInt248Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt248(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt24Slice decodes int24[] from ABI bytes
-func DecodeInt24Slice(data []byte) ([]int32, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int32, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt24(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt256 decodes int256 from ABI bytes
-func DecodeInt256(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt256Slice decodes int256[] from ABI bytes
-func DecodeInt256Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt256(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt32 decodes int32 from ABI bytes
-func DecodeInt32(data []byte) (int32, int, error) {
-	result, err := DecodeInt[int32](data, MinInt32, MaxInt32)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt32Slice decodes int32[] from ABI bytes
-func DecodeInt32Slice(data []byte) ([]int32, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int32, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt32(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt40 decodes int40 from ABI bytes
-func DecodeInt40(data []byte) (int64, int, error) {
-	result, err := DecodeInt[int64](data, MinInt40, MaxInt40)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt40Slice decodes int40[] from ABI bytes
-func DecodeInt40Slice(data []byte) ([]int64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt40(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt48 decodes int48 from ABI bytes
-func DecodeInt48(data []byte) (int64, int, error) {
-	result, err := DecodeInt[int64](data, MinInt48, MaxInt48)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt48Slice decodes int48[] from ABI bytes
-func DecodeInt48Slice(data []byte) ([]int64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt48(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt56 decodes int56 from ABI bytes
-func DecodeInt56(data []byte) (int64, int, error) {
-	result, err := DecodeInt[int64](data, MinInt56, MaxInt56)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt56Slice decodes int56[] from ABI bytes
-func DecodeInt56Slice(data []byte) ([]int64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt56(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt64 decodes int64 from ABI bytes
-func DecodeInt64(data []byte) (int64, int, error) {
-	result, err := DecodeInt[int64](data, MinInt64, MaxInt64)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt64Slice decodes int64[] from ABI bytes
-func DecodeInt64Slice(data []byte) ([]int64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt64(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt72 decodes int72 from ABI bytes
-func DecodeInt72(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt72Slice decodes int72[] from ABI bytes
-func DecodeInt72Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt72(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt8 decodes int8 from ABI bytes
-func DecodeInt8(data []byte) (int8, int, error) {
-	result, err := DecodeInt[int8](data, MinInt8, MaxInt8)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt80 decodes int80 from ABI bytes
-func DecodeInt80(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt80Slice decodes int80[] from ABI bytes
-func DecodeInt80Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt80(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt88 decodes int88 from ABI bytes
-func DecodeInt88(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt88Slice decodes int88[] from ABI bytes
-func DecodeInt88Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt88(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt8Slice decodes int8[] from ABI bytes
-func DecodeInt8Slice(data []byte) ([]int8, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]int8, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt8(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeInt96 decodes int96 from ABI bytes
-func DecodeInt96(data []byte) (*big.Int, int, error) {
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeInt96Slice decodes int96[] from ABI bytes
-func DecodeInt96Slice(data []byte) ([]*big.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*big.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeInt96(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeString decodes string from ABI bytes
-func DecodeString(data []byte) (string, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return "", 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return "", 0, err
-	}
-	data = data[32:]
-	paddedLength := Pad32(length)
-	if len(data) < paddedLength {
-		return "", 0, io.ErrUnexpectedEOF
-	}
-	// check padding bytes
-	for i := length; i < paddedLength; i++ {
-		if data[i] != 0x00 {
-			return "", 0, ErrDirtyPadding
-		}
-	}
-
-	// Decode data
-	return string(data[:length]), 32 + Pad32(length), nil
-}
-
-// DecodeStringSlice decodes string[] from ABI bytes
-func DecodeStringSlice(data []byte) ([]string, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with dynamic types
-	result := make([]string, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := DecodeSize(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, ErrInvalidOffsetForSliceElement
-		}
-		result[i], n, err = DecodeString(data[dynamicOffset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		dynamicOffset += n
-	}
-	return result, dynamicOffset + 32, nil
-}
-
-// DecodeUint104 decodes uint104 from ABI bytes
-func DecodeUint104(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint104Slice decodes uint104[] from ABI bytes
-func DecodeUint104Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint104(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint112 decodes uint112 from ABI bytes
-func DecodeUint112(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint112Slice decodes uint112[] from ABI bytes
-func DecodeUint112Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint112(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint120 decodes uint120 from ABI bytes
-func DecodeUint120(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint120Slice decodes uint120[] from ABI bytes
-func DecodeUint120Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint120(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint128 decodes uint128 from ABI bytes
-func DecodeUint128(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint128Slice decodes uint128[] from ABI bytes
-func DecodeUint128Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint128(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint136 decodes uint136 from ABI bytes
-func DecodeUint136(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint136Slice decodes uint136[] from ABI bytes
-func DecodeUint136Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint136(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint144 decodes uint144 from ABI bytes
-func DecodeUint144(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint144Slice decodes uint144[] from ABI bytes
-func DecodeUint144Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint144(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint152 decodes uint152 from ABI bytes
-func DecodeUint152(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint152Slice decodes uint152[] from ABI bytes
-func DecodeUint152Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint152(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint16 decodes uint16 from ABI bytes
-func DecodeUint16(data []byte) (uint16, int, error) {
-	result, err := DecodeUint[uint16](data, MaxUint16)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint160 decodes uint160 from ABI bytes
-func DecodeUint160(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint160Slice decodes uint160[] from ABI bytes
-func DecodeUint160Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint160(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint168 decodes uint168 from ABI bytes
-func DecodeUint168(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint168Slice decodes uint168[] from ABI bytes
-func DecodeUint168Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint168(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint16Slice decodes uint16[] from ABI bytes
-func DecodeUint16Slice(data []byte) ([]uint16, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint16, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint16(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint176 decodes uint176 from ABI bytes
-func DecodeUint176(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint176Slice decodes uint176[] from ABI bytes
-func DecodeUint176Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint176(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint184 decodes uint184 from ABI bytes
-func DecodeUint184(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint184Slice decodes uint184[] from ABI bytes
-func DecodeUint184Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint184(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint192 decodes uint192 from ABI bytes
-func DecodeUint192(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint192Slice decodes uint192[] from ABI bytes
-func DecodeUint192Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint192(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint200 decodes uint200 from ABI bytes
-func DecodeUint200(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint200Slice decodes uint200[] from ABI bytes
-func DecodeUint200Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint200(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint208 decodes uint208 from ABI bytes
-func DecodeUint208(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint208Slice decodes uint208[] from ABI bytes
-func DecodeUint208Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint208(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint216 decodes uint216 from ABI bytes
-func DecodeUint216(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint216Slice decodes uint216[] from ABI bytes
-func DecodeUint216Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint216(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint224 decodes uint224 from ABI bytes
-func DecodeUint224(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint224Slice decodes uint224[] from ABI bytes
-func DecodeUint224Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint224(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint232 decodes uint232 from ABI bytes
-func DecodeUint232(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint232Slice decodes uint232[] from ABI bytes
-func DecodeUint232Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint232(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint24 decodes uint24 from ABI bytes
-func DecodeUint24(data []byte) (uint32, int, error) {
-	result, err := DecodeUint[uint32](data, MaxUint24)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint240 decodes uint240 from ABI bytes
-func DecodeUint240(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint240Slice decodes uint240[] from ABI bytes
-func DecodeUint240Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint240(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint248 decodes uint248 from ABI bytes
-func DecodeUint248(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint248Slice decodes uint248[] from ABI bytes
-func DecodeUint248Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint248(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint24Slice decodes uint24[] from ABI bytes
-func DecodeUint24Slice(data []byte) ([]uint32, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint32, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint24(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint256 decodes uint256 from ABI bytes
-func DecodeUint256(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint256Slice decodes uint256[] from ABI bytes
-func DecodeUint256Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint256(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint32 decodes uint32 from ABI bytes
-func DecodeUint32(data []byte) (uint32, int, error) {
-	result, err := DecodeUint[uint32](data, MaxUint32)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint32Slice decodes uint32[] from ABI bytes
-func DecodeUint32Slice(data []byte) ([]uint32, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint32, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint32(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint40 decodes uint40 from ABI bytes
-func DecodeUint40(data []byte) (uint64, int, error) {
-	result, err := DecodeUint[uint64](data, MaxUint40)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint40Slice decodes uint40[] from ABI bytes
-func DecodeUint40Slice(data []byte) ([]uint64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint40(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint48 decodes uint48 from ABI bytes
-func DecodeUint48(data []byte) (uint64, int, error) {
-	result, err := DecodeUint[uint64](data, MaxUint48)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint48Slice decodes uint48[] from ABI bytes
-func DecodeUint48Slice(data []byte) ([]uint64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint48(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint56 decodes uint56 from ABI bytes
-func DecodeUint56(data []byte) (uint64, int, error) {
-	result, err := DecodeUint[uint64](data, MaxUint56)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint56Slice decodes uint56[] from ABI bytes
-func DecodeUint56Slice(data []byte) ([]uint64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint56(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint64 decodes uint64 from ABI bytes
-func DecodeUint64(data []byte) (uint64, int, error) {
-	result, err := DecodeUint[uint64](data, MaxUint64)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint64Slice decodes uint64[] from ABI bytes
-func DecodeUint64Slice(data []byte) ([]uint64, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint64, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint64(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint72 decodes uint72 from ABI bytes
-func DecodeUint72(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint72Slice decodes uint72[] from ABI bytes
-func DecodeUint72Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint72(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint8 decodes uint8 from ABI bytes
-func DecodeUint8(data []byte) (uint8, int, error) {
-	result, err := DecodeUint[uint8](data, MaxUint8)
-	if err != nil {
-		return 0, 0, err
-	}
-	return result, 32, nil
-}
-
-// DecodeUint80 decodes uint80 from ABI bytes
-func DecodeUint80(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint80Slice decodes uint80[] from ABI bytes
-func DecodeUint80Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint80(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint88 decodes uint88 from ABI bytes
-func DecodeUint88(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint88Slice decodes uint88[] from ABI bytes
-func DecodeUint88Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint88(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint8Slice decodes uint8[] from ABI bytes
-func DecodeUint8Slice(data []byte) ([]uint8, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]uint8, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint8(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// DecodeUint96 decodes uint96 from ABI bytes
-func DecodeUint96(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// DecodeUint96Slice decodes uint96[] from ABI bytes
-func DecodeUint96Slice(data []byte) ([]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with static types
-	result := make([]*uint256.Int, length)
-	for i := 0; i < length; i++ {
-		result[i], n, err = DecodeUint96(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += n
-	}
-	return result, offset + 32, nil
-}
-
-// PackedEncodeAddress encodes address to packed ABI bytes (no padding)
-func PackedEncodeAddress(value common.Address, buf []byte) (int, error) {
-	if len(buf) < 20 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:20], value[:])
-	return 20, nil
-}
-
-// PackedEncodeBool encodes bool to packed ABI bytes (no padding)
-func PackedEncodeBool(value bool, buf []byte) (int, error) {
-	if len(buf) < 1 {
-		return 0, io.ErrShortBuffer
-	}
-	if value {
-		buf[0] = 1
-	} else {
-		buf[0] = 0
-	}
-	return 1, nil
-}
-
-// PackedEncodeBytes1 encodes bytes1 to packed ABI bytes (no padding)
-func PackedEncodeBytes1(value [1]byte, buf []byte) (int, error) {
-	if len(buf) < 1 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:1], value[:])
-	return 1, nil
-}
-
-// PackedEncodeBytes10 encodes bytes10 to packed ABI bytes (no padding)
-func PackedEncodeBytes10(value [10]byte, buf []byte) (int, error) {
-	if len(buf) < 10 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:10], value[:])
-	return 10, nil
-}
-
-// PackedEncodeBytes11 encodes bytes11 to packed ABI bytes (no padding)
-func PackedEncodeBytes11(value [11]byte, buf []byte) (int, error) {
-	if len(buf) < 11 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:11], value[:])
-	return 11, nil
-}
-
-// PackedEncodeBytes12 encodes bytes12 to packed ABI bytes (no padding)
-func PackedEncodeBytes12(value [12]byte, buf []byte) (int, error) {
-	if len(buf) < 12 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:12], value[:])
-	return 12, nil
-}
-
-// PackedEncodeBytes13 encodes bytes13 to packed ABI bytes (no padding)
-func PackedEncodeBytes13(value [13]byte, buf []byte) (int, error) {
-	if len(buf) < 13 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:13], value[:])
-	return 13, nil
-}
-
-// PackedEncodeBytes14 encodes bytes14 to packed ABI bytes (no padding)
-func PackedEncodeBytes14(value [14]byte, buf []byte) (int, error) {
-	if len(buf) < 14 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:14], value[:])
-	return 14, nil
-}
-
-// PackedEncodeBytes15 encodes bytes15 to packed ABI bytes (no padding)
-func PackedEncodeBytes15(value [15]byte, buf []byte) (int, error) {
-	if len(buf) < 15 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:15], value[:])
-	return 15, nil
-}
-
-// PackedEncodeBytes16 encodes bytes16 to packed ABI bytes (no padding)
-func PackedEncodeBytes16(value [16]byte, buf []byte) (int, error) {
-	if len(buf) < 16 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:16], value[:])
-	return 16, nil
-}
-
-// PackedEncodeBytes17 encodes bytes17 to packed ABI bytes (no padding)
-func PackedEncodeBytes17(value [17]byte, buf []byte) (int, error) {
-	if len(buf) < 17 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:17], value[:])
-	return 17, nil
-}
-
-// PackedEncodeBytes18 encodes bytes18 to packed ABI bytes (no padding)
-func PackedEncodeBytes18(value [18]byte, buf []byte) (int, error) {
-	if len(buf) < 18 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:18], value[:])
-	return 18, nil
-}
-
-// PackedEncodeBytes19 encodes bytes19 to packed ABI bytes (no padding)
-func PackedEncodeBytes19(value [19]byte, buf []byte) (int, error) {
-	if len(buf) < 19 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:19], value[:])
-	return 19, nil
-}
-
-// PackedEncodeBytes2 encodes bytes2 to packed ABI bytes (no padding)
-func PackedEncodeBytes2(value [2]byte, buf []byte) (int, error) {
-	if len(buf) < 2 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:2], value[:])
-	return 2, nil
-}
-
-// PackedEncodeBytes20 encodes bytes20 to packed ABI bytes (no padding)
-func PackedEncodeBytes20(value [20]byte, buf []byte) (int, error) {
-	if len(buf) < 20 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:20], value[:])
-	return 20, nil
-}
-
-// PackedEncodeBytes21 encodes bytes21 to packed ABI bytes (no padding)
-func PackedEncodeBytes21(value [21]byte, buf []byte) (int, error) {
-	if len(buf) < 21 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:21], value[:])
-	return 21, nil
-}
-
-// PackedEncodeBytes22 encodes bytes22 to packed ABI bytes (no padding)
-func PackedEncodeBytes22(value [22]byte, buf []byte) (int, error) {
-	if len(buf) < 22 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:22], value[:])
-	return 22, nil
-}
-
-// PackedEncodeBytes23 encodes bytes23 to packed ABI bytes (no padding)
-func PackedEncodeBytes23(value [23]byte, buf []byte) (int, error) {
-	if len(buf) < 23 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:23], value[:])
-	return 23, nil
-}
-
-// PackedEncodeBytes24 encodes bytes24 to packed ABI bytes (no padding)
-func PackedEncodeBytes24(value [24]byte, buf []byte) (int, error) {
-	if len(buf) < 24 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:24], value[:])
-	return 24, nil
-}
-
-// PackedEncodeBytes25 encodes bytes25 to packed ABI bytes (no padding)
-func PackedEncodeBytes25(value [25]byte, buf []byte) (int, error) {
-	if len(buf) < 25 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:25], value[:])
-	return 25, nil
-}
-
-// PackedEncodeBytes26 encodes bytes26 to packed ABI bytes (no padding)
-func PackedEncodeBytes26(value [26]byte, buf []byte) (int, error) {
-	if len(buf) < 26 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:26], value[:])
-	return 26, nil
-}
-
-// PackedEncodeBytes27 encodes bytes27 to packed ABI bytes (no padding)
-func PackedEncodeBytes27(value [27]byte, buf []byte) (int, error) {
-	if len(buf) < 27 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:27], value[:])
-	return 27, nil
-}
-
-// PackedEncodeBytes28 encodes bytes28 to packed ABI bytes (no padding)
-func PackedEncodeBytes28(value [28]byte, buf []byte) (int, error) {
-	if len(buf) < 28 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:28], value[:])
-	return 28, nil
-}
-
-// PackedEncodeBytes29 encodes bytes29 to packed ABI bytes (no padding)
-func PackedEncodeBytes29(value [29]byte, buf []byte) (int, error) {
-	if len(buf) < 29 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:29], value[:])
-	return 29, nil
-}
-
-// PackedEncodeBytes3 encodes bytes3 to packed ABI bytes (no padding)
-func PackedEncodeBytes3(value [3]byte, buf []byte) (int, error) {
-	if len(buf) < 3 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:3], value[:])
-	return 3, nil
-}
-
-// PackedEncodeBytes30 encodes bytes30 to packed ABI bytes (no padding)
-func PackedEncodeBytes30(value [30]byte, buf []byte) (int, error) {
-	if len(buf) < 30 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:30], value[:])
-	return 30, nil
-}
-
-// PackedEncodeBytes31 encodes bytes31 to packed ABI bytes (no padding)
-func PackedEncodeBytes31(value [31]byte, buf []byte) (int, error) {
-	if len(buf) < 31 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:31], value[:])
-	return 31, nil
-}
-
-// PackedEncodeBytes32 encodes bytes32 to packed ABI bytes (no padding)
-func PackedEncodeBytes32(value [32]byte, buf []byte) (int, error) {
-	if len(buf) < 32 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:32], value[:])
-	return 32, nil
-}
-
-// PackedEncodeBytes4 encodes bytes4 to packed ABI bytes (no padding)
-func PackedEncodeBytes4(value [4]byte, buf []byte) (int, error) {
-	if len(buf) < 4 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:4], value[:])
-	return 4, nil
-}
-
-// PackedEncodeBytes5 encodes bytes5 to packed ABI bytes (no padding)
-func PackedEncodeBytes5(value [5]byte, buf []byte) (int, error) {
-	if len(buf) < 5 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:5], value[:])
-	return 5, nil
-}
-
-// PackedEncodeBytes6 encodes bytes6 to packed ABI bytes (no padding)
-func PackedEncodeBytes6(value [6]byte, buf []byte) (int, error) {
-	if len(buf) < 6 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:6], value[:])
-	return 6, nil
-}
-
-// PackedEncodeBytes7 encodes bytes7 to packed ABI bytes (no padding)
-func PackedEncodeBytes7(value [7]byte, buf []byte) (int, error) {
-	if len(buf) < 7 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:7], value[:])
-	return 7, nil
-}
-
-// PackedEncodeBytes8 encodes bytes8 to packed ABI bytes (no padding)
-func PackedEncodeBytes8(value [8]byte, buf []byte) (int, error) {
-	if len(buf) < 8 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:8], value[:])
-	return 8, nil
-}
-
-// PackedEncodeBytes9 encodes bytes9 to packed ABI bytes (no padding)
-func PackedEncodeBytes9(value [9]byte, buf []byte) (int, error) {
-	if len(buf) < 9 {
-		return 0, io.ErrShortBuffer
-	}
-	copy(buf[:9], value[:])
-	return 9, nil
-}
-
-// PackedEncodeInt104 encodes int104 to packed ABI bytes (no padding)
-func PackedEncodeInt104(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 13 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:13], true); err != nil {
-		return 0, err
-	}
-	return 13, nil
-}
-
-// PackedEncodeInt112 encodes int112 to packed ABI bytes (no padding)
-func PackedEncodeInt112(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 14 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:14], true); err != nil {
-		return 0, err
-	}
-	return 14, nil
-}
-
-// PackedEncodeInt120 encodes int120 to packed ABI bytes (no padding)
-func PackedEncodeInt120(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 15 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:15], true); err != nil {
-		return 0, err
-	}
-	return 15, nil
-}
-
-// PackedEncodeInt128 encodes int128 to packed ABI bytes (no padding)
-func PackedEncodeInt128(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 16 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:16], true); err != nil {
-		return 0, err
-	}
-	return 16, nil
-}
-
-// PackedEncodeInt136 encodes int136 to packed ABI bytes (no padding)
-func PackedEncodeInt136(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 17 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:17], true); err != nil {
-		return 0, err
-	}
-	return 17, nil
-}
-
-// PackedEncodeInt144 encodes int144 to packed ABI bytes (no padding)
-func PackedEncodeInt144(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 18 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:18], true); err != nil {
-		return 0, err
-	}
-	return 18, nil
-}
-
-// PackedEncodeInt152 encodes int152 to packed ABI bytes (no padding)
-func PackedEncodeInt152(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 19 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:19], true); err != nil {
-		return 0, err
-	}
-	return 19, nil
-}
-
-// PackedEncodeInt16 encodes int16 to packed ABI bytes (no padding)
-func PackedEncodeInt16(value int16, buf []byte) (int, error) {
-	if len(buf) < 2 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint16(buf[:2], uint16(value))
-	return 2, nil
-}
-
-// PackedEncodeInt160 encodes int160 to packed ABI bytes (no padding)
-func PackedEncodeInt160(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 20 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:20], true); err != nil {
-		return 0, err
-	}
-	return 20, nil
-}
-
-// PackedEncodeInt168 encodes int168 to packed ABI bytes (no padding)
-func PackedEncodeInt168(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 21 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:21], true); err != nil {
-		return 0, err
-	}
-	return 21, nil
-}
-
-// PackedEncodeInt176 encodes int176 to packed ABI bytes (no padding)
-func PackedEncodeInt176(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 22 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:22], true); err != nil {
-		return 0, err
-	}
-	return 22, nil
-}
-
-// PackedEncodeInt184 encodes int184 to packed ABI bytes (no padding)
-func PackedEncodeInt184(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 23 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:23], true); err != nil {
-		return 0, err
-	}
-	return 23, nil
-}
-
-// PackedEncodeInt192 encodes int192 to packed ABI bytes (no padding)
-func PackedEncodeInt192(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 24 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:24], true); err != nil {
-		return 0, err
-	}
-	return 24, nil
-}
-
-// PackedEncodeInt200 encodes int200 to packed ABI bytes (no padding)
-func PackedEncodeInt200(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 25 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:25], true); err != nil {
-		return 0, err
-	}
-	return 25, nil
-}
-
-// PackedEncodeInt208 encodes int208 to packed ABI bytes (no padding)
-func PackedEncodeInt208(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 26 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:26], true); err != nil {
-		return 0, err
-	}
-	return 26, nil
-}
-
-// PackedEncodeInt216 encodes int216 to packed ABI bytes (no padding)
-func PackedEncodeInt216(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 27 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:27], true); err != nil {
-		return 0, err
-	}
-	return 27, nil
-}
-
-// PackedEncodeInt224 encodes int224 to packed ABI bytes (no padding)
-func PackedEncodeInt224(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 28 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:28], true); err != nil {
-		return 0, err
-	}
-	return 28, nil
-}
-
-// PackedEncodeInt232 encodes int232 to packed ABI bytes (no padding)
-func PackedEncodeInt232(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 29 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:29], true); err != nil {
-		return 0, err
-	}
-	return 29, nil
-}
-
-// PackedEncodeInt24 encodes int24 to packed ABI bytes (no padding)
-func PackedEncodeInt24(value int32, buf []byte) (int, error) {
-	if len(buf) < 3 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 16)
-	buf[1] = byte(value >> 8)
-	buf[2] = byte(value)
-	return 3, nil
-}
-
-// PackedEncodeInt240 encodes int240 to packed ABI bytes (no padding)
-func PackedEncodeInt240(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 30 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:30], true); err != nil {
-		return 0, err
-	}
-	return 30, nil
-}
-
-// PackedEncodeInt248 encodes int248 to packed ABI bytes (no padding)
-func PackedEncodeInt248(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 31 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:31], true); err != nil {
-		return 0, err
-	}
-	return 31, nil
-}
-
-// PackedEncodeInt256 encodes int256 to packed ABI bytes (no padding)
-func PackedEncodeInt256(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 32 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:32], true); err != nil {
-		return 0, err
-	}
-	return 32, nil
-}
-
-// PackedEncodeInt32 encodes int32 to packed ABI bytes (no padding)
-func PackedEncodeInt32(value int32, buf []byte) (int, error) {
-	if len(buf) < 4 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint32(buf[:4], uint32(value))
-	return 4, nil
-}
-
-// PackedEncodeInt40 encodes int40 to packed ABI bytes (no padding)
-func PackedEncodeInt40(value int64, buf []byte) (int, error) {
-	if len(buf) < 5 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 32)
-	buf[1] = byte(value >> 24)
-	buf[2] = byte(value >> 16)
-	buf[3] = byte(value >> 8)
-	buf[4] = byte(value)
-	return 5, nil
-}
-
-// PackedEncodeInt48 encodes int48 to packed ABI bytes (no padding)
-func PackedEncodeInt48(value int64, buf []byte) (int, error) {
-	if len(buf) < 6 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 40)
-	buf[1] = byte(value >> 32)
-	buf[2] = byte(value >> 24)
-	buf[3] = byte(value >> 16)
-	buf[4] = byte(value >> 8)
-	buf[5] = byte(value)
-	return 6, nil
-}
-
-// PackedEncodeInt56 encodes int56 to packed ABI bytes (no padding)
-func PackedEncodeInt56(value int64, buf []byte) (int, error) {
-	if len(buf) < 7 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 48)
-	buf[1] = byte(value >> 40)
-	buf[2] = byte(value >> 32)
-	buf[3] = byte(value >> 24)
-	buf[4] = byte(value >> 16)
-	buf[5] = byte(value >> 8)
-	buf[6] = byte(value)
-	return 7, nil
-}
-
-// PackedEncodeInt64 encodes int64 to packed ABI bytes (no padding)
-func PackedEncodeInt64(value int64, buf []byte) (int, error) {
-	if len(buf) < 8 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint64(buf[:8], uint64(value))
-	return 8, nil
-}
-
-// PackedEncodeInt72 encodes int72 to packed ABI bytes (no padding)
-func PackedEncodeInt72(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 9 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:9], true); err != nil {
-		return 0, err
-	}
-	return 9, nil
-}
-
-// PackedEncodeInt8 encodes int8 to packed ABI bytes (no padding)
-func PackedEncodeInt8(value int8, buf []byte) (int, error) {
-	if len(buf) < 1 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value)
-	return 1, nil
-}
-
-// PackedEncodeInt80 encodes int80 to packed ABI bytes (no padding)
-func PackedEncodeInt80(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 10 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:10], true); err != nil {
-		return 0, err
-	}
-	return 10, nil
-}
-
-// PackedEncodeInt88 encodes int88 to packed ABI bytes (no padding)
-func PackedEncodeInt88(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 11 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:11], true); err != nil {
-		return 0, err
-	}
-	return 11, nil
-}
-
-// PackedEncodeInt96 encodes int96 to packed ABI bytes (no padding)
-func PackedEncodeInt96(value *big.Int, buf []byte) (int, error) {
-	if len(buf) < 12 {
-		return 0, io.ErrShortBuffer
-	}
-	if err := EncodeBigInt(value, buf[:12], true); err != nil {
-		return 0, err
-	}
-	return 12, nil
-}
-
-// PackedEncodeUint104 encodes uint104 to packed ABI bytes (no padding)
-func PackedEncodeUint104(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 13 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:13], tmp[19:])
-	return 13, nil
-}
-
-// PackedEncodeUint112 encodes uint112 to packed ABI bytes (no padding)
-func PackedEncodeUint112(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 14 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:14], tmp[18:])
-	return 14, nil
-}
-
-// PackedEncodeUint120 encodes uint120 to packed ABI bytes (no padding)
-func PackedEncodeUint120(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 15 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:15], tmp[17:])
-	return 15, nil
-}
-
-// PackedEncodeUint128 encodes uint128 to packed ABI bytes (no padding)
-func PackedEncodeUint128(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 16 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:16], tmp[16:])
-	return 16, nil
-}
-
-// PackedEncodeUint136 encodes uint136 to packed ABI bytes (no padding)
-func PackedEncodeUint136(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 17 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:17], tmp[15:])
-	return 17, nil
-}
-
-// PackedEncodeUint144 encodes uint144 to packed ABI bytes (no padding)
-func PackedEncodeUint144(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 18 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:18], tmp[14:])
-	return 18, nil
-}
-
-// PackedEncodeUint152 encodes uint152 to packed ABI bytes (no padding)
-func PackedEncodeUint152(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 19 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:19], tmp[13:])
-	return 19, nil
-}
-
-// PackedEncodeUint16 encodes uint16 to packed ABI bytes (no padding)
-func PackedEncodeUint16(value uint16, buf []byte) (int, error) {
-	if len(buf) < 2 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint16(buf[:2], uint16(value))
-	return 2, nil
-}
-
-// PackedEncodeUint160 encodes uint160 to packed ABI bytes (no padding)
-func PackedEncodeUint160(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 20 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:20], tmp[12:])
-	return 20, nil
-}
-
-// PackedEncodeUint168 encodes uint168 to packed ABI bytes (no padding)
-func PackedEncodeUint168(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 21 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:21], tmp[11:])
-	return 21, nil
-}
-
-// PackedEncodeUint176 encodes uint176 to packed ABI bytes (no padding)
-func PackedEncodeUint176(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 22 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:22], tmp[10:])
-	return 22, nil
-}
-
-// PackedEncodeUint184 encodes uint184 to packed ABI bytes (no padding)
-func PackedEncodeUint184(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 23 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:23], tmp[9:])
-	return 23, nil
-}
-
-// PackedEncodeUint192 encodes uint192 to packed ABI bytes (no padding)
-func PackedEncodeUint192(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 24 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:24], tmp[8:])
-	return 24, nil
-}
-
-// PackedEncodeUint200 encodes uint200 to packed ABI bytes (no padding)
-func PackedEncodeUint200(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 25 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:25], tmp[7:])
-	return 25, nil
-}
-
-// PackedEncodeUint208 encodes uint208 to packed ABI bytes (no padding)
-func PackedEncodeUint208(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 26 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:26], tmp[6:])
-	return 26, nil
-}
-
-// PackedEncodeUint216 encodes uint216 to packed ABI bytes (no padding)
-func PackedEncodeUint216(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 27 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:27], tmp[5:])
-	return 27, nil
-}
-
-// PackedEncodeUint224 encodes uint224 to packed ABI bytes (no padding)
-func PackedEncodeUint224(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 28 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:28], tmp[4:])
-	return 28, nil
-}
-
-// PackedEncodeUint232 encodes uint232 to packed ABI bytes (no padding)
-func PackedEncodeUint232(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 29 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:29], tmp[3:])
-	return 29, nil
-}
-
-// PackedEncodeUint24 encodes uint24 to packed ABI bytes (no padding)
-func PackedEncodeUint24(value uint32, buf []byte) (int, error) {
-	if len(buf) < 3 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 16)
-	buf[1] = byte(value >> 8)
-	buf[2] = byte(value)
-	return 3, nil
-}
-
-// PackedEncodeUint240 encodes uint240 to packed ABI bytes (no padding)
-func PackedEncodeUint240(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 30 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:30], tmp[2:])
-	return 30, nil
-}
-
-// PackedEncodeUint248 encodes uint248 to packed ABI bytes (no padding)
-func PackedEncodeUint248(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 31 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:31], tmp[1:])
-	return 31, nil
-}
-
-// PackedEncodeUint256 encodes uint256 to packed ABI bytes (no padding)
-func PackedEncodeUint256(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 32 {
-		return 0, io.ErrShortBuffer
-	}
-	value.WriteToArray32((*[32]byte)(buf[:32]))
-	return 32, nil
-}
-
-// PackedEncodeUint32 encodes uint32 to packed ABI bytes (no padding)
-func PackedEncodeUint32(value uint32, buf []byte) (int, error) {
-	if len(buf) < 4 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint32(buf[:4], uint32(value))
-	return 4, nil
-}
-
-// PackedEncodeUint40 encodes uint40 to packed ABI bytes (no padding)
-func PackedEncodeUint40(value uint64, buf []byte) (int, error) {
-	if len(buf) < 5 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 32)
-	buf[1] = byte(value >> 24)
-	buf[2] = byte(value >> 16)
-	buf[3] = byte(value >> 8)
-	buf[4] = byte(value)
-	return 5, nil
-}
-
-// PackedEncodeUint48 encodes uint48 to packed ABI bytes (no padding)
-func PackedEncodeUint48(value uint64, buf []byte) (int, error) {
-	if len(buf) < 6 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 40)
-	buf[1] = byte(value >> 32)
-	buf[2] = byte(value >> 24)
-	buf[3] = byte(value >> 16)
-	buf[4] = byte(value >> 8)
-	buf[5] = byte(value)
-	return 6, nil
-}
-
-// PackedEncodeUint56 encodes uint56 to packed ABI bytes (no padding)
-func PackedEncodeUint56(value uint64, buf []byte) (int, error) {
-	if len(buf) < 7 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value >> 48)
-	buf[1] = byte(value >> 40)
-	buf[2] = byte(value >> 32)
-	buf[3] = byte(value >> 24)
-	buf[4] = byte(value >> 16)
-	buf[5] = byte(value >> 8)
-	buf[6] = byte(value)
-	return 7, nil
-}
-
-// PackedEncodeUint64 encodes uint64 to packed ABI bytes (no padding)
-func PackedEncodeUint64(value uint64, buf []byte) (int, error) {
-	if len(buf) < 8 {
-		return 0, io.ErrShortBuffer
-	}
-	binary.BigEndian.PutUint64(buf[:8], uint64(value))
-	return 8, nil
-}
-
-// PackedEncodeUint72 encodes uint72 to packed ABI bytes (no padding)
-func PackedEncodeUint72(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 9 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:9], tmp[23:])
-	return 9, nil
-}
-
-// PackedEncodeUint8 encodes uint8 to packed ABI bytes (no padding)
-func PackedEncodeUint8(value uint8, buf []byte) (int, error) {
-	if len(buf) < 1 {
-		return 0, io.ErrShortBuffer
-	}
-	buf[0] = byte(value)
-	return 1, nil
-}
-
-// PackedEncodeUint80 encodes uint80 to packed ABI bytes (no padding)
-func PackedEncodeUint80(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 10 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:10], tmp[22:])
-	return 10, nil
-}
-
-// PackedEncodeUint88 encodes uint88 to packed ABI bytes (no padding)
-func PackedEncodeUint88(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 11 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:11], tmp[21:])
-	return 11, nil
-}
-
-// PackedEncodeUint96 encodes uint96 to packed ABI bytes (no padding)
-func PackedEncodeUint96(value *uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 12 {
-		return 0, io.ErrShortBuffer
-	}
-	var tmp [32]byte
-	value.WriteToArray32(&tmp)
-	copy(buf[:12], tmp[20:])
-	return 12, nil
-}
-
-// PackedDecodeAddress decodes address from packed ABI bytes (no padding)
-func PackedDecodeAddress(data []byte) (common.Address, int, error) {
-	if len(data) < 20 {
-		return common.Address{}, 0, io.ErrUnexpectedEOF
-	}
-	var result common.Address
-	copy(result[:], data[:20])
-	return result, 20, nil
-}
-
-// PackedDecodeBool decodes bool from packed ABI bytes (no padding)
-func PackedDecodeBool(data []byte) (bool, int, error) {
-	switch data[0] {
-	case 0x00:
-		return false, 1, nil
-	case 0x01:
-		return true, 1, nil
-	default:
-		return false, 0, ErrDirtyPadding
-	}
-}
-
-// PackedDecodeBytes1 decodes bytes1 from packed ABI bytes (no padding)
-func PackedDecodeBytes1(data []byte) ([1]byte, int, error) {
-	if len(data) < 1 {
-		return [1]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [1]byte
-	copy(result[:], data[:1])
-	return result, 1, nil
-}
-
-// PackedDecodeBytes10 decodes bytes10 from packed ABI bytes (no padding)
-func PackedDecodeBytes10(data []byte) ([10]byte, int, error) {
-	if len(data) < 10 {
-		return [10]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [10]byte
-	copy(result[:], data[:10])
-	return result, 10, nil
-}
-
-// PackedDecodeBytes11 decodes bytes11 from packed ABI bytes (no padding)
-func PackedDecodeBytes11(data []byte) ([11]byte, int, error) {
-	if len(data) < 11 {
-		return [11]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [11]byte
-	copy(result[:], data[:11])
-	return result, 11, nil
-}
-
-// PackedDecodeBytes12 decodes bytes12 from packed ABI bytes (no padding)
-func PackedDecodeBytes12(data []byte) ([12]byte, int, error) {
-	if len(data) < 12 {
-		return [12]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [12]byte
-	copy(result[:], data[:12])
-	return result, 12, nil
-}
-
-// PackedDecodeBytes13 decodes bytes13 from packed ABI bytes (no padding)
-func PackedDecodeBytes13(data []byte) ([13]byte, int, error) {
-	if len(data) < 13 {
-		return [13]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [13]byte
-	copy(result[:], data[:13])
-	return result, 13, nil
-}
-
-// PackedDecodeBytes14 decodes bytes14 from packed ABI bytes (no padding)
-func PackedDecodeBytes14(data []byte) ([14]byte, int, error) {
-	if len(data) < 14 {
-		return [14]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [14]byte
-	copy(result[:], data[:14])
-	return result, 14, nil
-}
-
-// PackedDecodeBytes15 decodes bytes15 from packed ABI bytes (no padding)
-func PackedDecodeBytes15(data []byte) ([15]byte, int, error) {
-	if len(data) < 15 {
-		return [15]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [15]byte
-	copy(result[:], data[:15])
-	return result, 15, nil
-}
-
-// PackedDecodeBytes16 decodes bytes16 from packed ABI bytes (no padding)
-func PackedDecodeBytes16(data []byte) ([16]byte, int, error) {
-	if len(data) < 16 {
-		return [16]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [16]byte
-	copy(result[:], data[:16])
-	return result, 16, nil
-}
-
-// PackedDecodeBytes17 decodes bytes17 from packed ABI bytes (no padding)
-func PackedDecodeBytes17(data []byte) ([17]byte, int, error) {
-	if len(data) < 17 {
-		return [17]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [17]byte
-	copy(result[:], data[:17])
-	return result, 17, nil
-}
-
-// PackedDecodeBytes18 decodes bytes18 from packed ABI bytes (no padding)
-func PackedDecodeBytes18(data []byte) ([18]byte, int, error) {
-	if len(data) < 18 {
-		return [18]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [18]byte
-	copy(result[:], data[:18])
-	return result, 18, nil
-}
-
-// PackedDecodeBytes19 decodes bytes19 from packed ABI bytes (no padding)
-func PackedDecodeBytes19(data []byte) ([19]byte, int, error) {
-	if len(data) < 19 {
-		return [19]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [19]byte
-	copy(result[:], data[:19])
-	return result, 19, nil
-}
-
-// PackedDecodeBytes2 decodes bytes2 from packed ABI bytes (no padding)
-func PackedDecodeBytes2(data []byte) ([2]byte, int, error) {
-	if len(data) < 2 {
-		return [2]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [2]byte
-	copy(result[:], data[:2])
-	return result, 2, nil
-}
-
-// PackedDecodeBytes20 decodes bytes20 from packed ABI bytes (no padding)
-func PackedDecodeBytes20(data []byte) ([20]byte, int, error) {
-	if len(data) < 20 {
-		return [20]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [20]byte
-	copy(result[:], data[:20])
-	return result, 20, nil
-}
-
-// PackedDecodeBytes21 decodes bytes21 from packed ABI bytes (no padding)
-func PackedDecodeBytes21(data []byte) ([21]byte, int, error) {
-	if len(data) < 21 {
-		return [21]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [21]byte
-	copy(result[:], data[:21])
-	return result, 21, nil
-}
-
-// PackedDecodeBytes22 decodes bytes22 from packed ABI bytes (no padding)
-func PackedDecodeBytes22(data []byte) ([22]byte, int, error) {
-	if len(data) < 22 {
-		return [22]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [22]byte
-	copy(result[:], data[:22])
-	return result, 22, nil
-}
-
-// PackedDecodeBytes23 decodes bytes23 from packed ABI bytes (no padding)
-func PackedDecodeBytes23(data []byte) ([23]byte, int, error) {
-	if len(data) < 23 {
-		return [23]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [23]byte
-	copy(result[:], data[:23])
-	return result, 23, nil
-}
-
-// PackedDecodeBytes24 decodes bytes24 from packed ABI bytes (no padding)
-func PackedDecodeBytes24(data []byte) ([24]byte, int, error) {
-	if len(data) < 24 {
-		return [24]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [24]byte
-	copy(result[:], data[:24])
-	return result, 24, nil
-}
-
-// PackedDecodeBytes25 decodes bytes25 from packed ABI bytes (no padding)
-func PackedDecodeBytes25(data []byte) ([25]byte, int, error) {
-	if len(data) < 25 {
-		return [25]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [25]byte
-	copy(result[:], data[:25])
-	return result, 25, nil
-}
-
-// PackedDecodeBytes26 decodes bytes26 from packed ABI bytes (no padding)
-func PackedDecodeBytes26(data []byte) ([26]byte, int, error) {
-	if len(data) < 26 {
-		return [26]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [26]byte
-	copy(result[:], data[:26])
-	return result, 26, nil
-}
-
-// PackedDecodeBytes27 decodes bytes27 from packed ABI bytes (no padding)
-func PackedDecodeBytes27(data []byte) ([27]byte, int, error) {
-	if len(data) < 27 {
-		return [27]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [27]byte
-	copy(result[:], data[:27])
-	return result, 27, nil
-}
-
-// PackedDecodeBytes28 decodes bytes28 from packed ABI bytes (no padding)
-func PackedDecodeBytes28(data []byte) ([28]byte, int, error) {
-	if len(data) < 28 {
-		return [28]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [28]byte
-	copy(result[:], data[:28])
-	return result, 28, nil
-}
-
-// PackedDecodeBytes29 decodes bytes29 from packed ABI bytes (no padding)
-func PackedDecodeBytes29(data []byte) ([29]byte, int, error) {
-	if len(data) < 29 {
-		return [29]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [29]byte
-	copy(result[:], data[:29])
-	return result, 29, nil
-}
-
-// PackedDecodeBytes3 decodes bytes3 from packed ABI bytes (no padding)
-func PackedDecodeBytes3(data []byte) ([3]byte, int, error) {
-	if len(data) < 3 {
-		return [3]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [3]byte
-	copy(result[:], data[:3])
-	return result, 3, nil
-}
-
-// PackedDecodeBytes30 decodes bytes30 from packed ABI bytes (no padding)
-func PackedDecodeBytes30(data []byte) ([30]byte, int, error) {
-	if len(data) < 30 {
-		return [30]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [30]byte
-	copy(result[:], data[:30])
-	return result, 30, nil
-}
-
-// PackedDecodeBytes31 decodes bytes31 from packed ABI bytes (no padding)
-func PackedDecodeBytes31(data []byte) ([31]byte, int, error) {
-	if len(data) < 31 {
-		return [31]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [31]byte
-	copy(result[:], data[:31])
-	return result, 31, nil
-}
-
-// PackedDecodeBytes32 decodes bytes32 from packed ABI bytes (no padding)
-func PackedDecodeBytes32(data []byte) ([32]byte, int, error) {
-	if len(data) < 32 {
-		return [32]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [32]byte
-	copy(result[:], data[:32])
-	return result, 32, nil
-}
-
-// PackedDecodeBytes4 decodes bytes4 from packed ABI bytes (no padding)
-func PackedDecodeBytes4(data []byte) ([4]byte, int, error) {
-	if len(data) < 4 {
-		return [4]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [4]byte
-	copy(result[:], data[:4])
-	return result, 4, nil
-}
-
-// PackedDecodeBytes5 decodes bytes5 from packed ABI bytes (no padding)
-func PackedDecodeBytes5(data []byte) ([5]byte, int, error) {
-	if len(data) < 5 {
-		return [5]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [5]byte
-	copy(result[:], data[:5])
-	return result, 5, nil
-}
-
-// PackedDecodeBytes6 decodes bytes6 from packed ABI bytes (no padding)
-func PackedDecodeBytes6(data []byte) ([6]byte, int, error) {
-	if len(data) < 6 {
-		return [6]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [6]byte
-	copy(result[:], data[:6])
-	return result, 6, nil
-}
-
-// PackedDecodeBytes7 decodes bytes7 from packed ABI bytes (no padding)
-func PackedDecodeBytes7(data []byte) ([7]byte, int, error) {
-	if len(data) < 7 {
-		return [7]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [7]byte
-	copy(result[:], data[:7])
-	return result, 7, nil
-}
-
-// PackedDecodeBytes8 decodes bytes8 from packed ABI bytes (no padding)
-func PackedDecodeBytes8(data []byte) ([8]byte, int, error) {
-	if len(data) < 8 {
-		return [8]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [8]byte
-	copy(result[:], data[:8])
-	return result, 8, nil
-}
-
-// PackedDecodeBytes9 decodes bytes9 from packed ABI bytes (no padding)
-func PackedDecodeBytes9(data []byte) ([9]byte, int, error) {
-	if len(data) < 9 {
-		return [9]byte{}, 0, io.ErrUnexpectedEOF
-	}
-	var result [9]byte
-	copy(result[:], data[:9])
-	return result, 9, nil
-}
-
-// PackedDecodeInt104 decodes int104 from packed ABI bytes (no padding)
-func PackedDecodeInt104(data []byte) (*big.Int, int, error) {
-	if len(data) < 13 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:13], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 13, nil
-}
-
-// PackedDecodeInt112 decodes int112 from packed ABI bytes (no padding)
-func PackedDecodeInt112(data []byte) (*big.Int, int, error) {
-	if len(data) < 14 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:14], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 14, nil
-}
-
-// PackedDecodeInt120 decodes int120 from packed ABI bytes (no padding)
-func PackedDecodeInt120(data []byte) (*big.Int, int, error) {
-	if len(data) < 15 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:15], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 15, nil
-}
-
-// PackedDecodeInt128 decodes int128 from packed ABI bytes (no padding)
-func PackedDecodeInt128(data []byte) (*big.Int, int, error) {
-	if len(data) < 16 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:16], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 16, nil
-}
-
-// PackedDecodeInt136 decodes int136 from packed ABI bytes (no padding)
-func PackedDecodeInt136(data []byte) (*big.Int, int, error) {
-	if len(data) < 17 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:17], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 17, nil
-}
-
-// PackedDecodeInt144 decodes int144 from packed ABI bytes (no padding)
-func PackedDecodeInt144(data []byte) (*big.Int, int, error) {
-	if len(data) < 18 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:18], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 18, nil
-}
-
-// PackedDecodeInt152 decodes int152 from packed ABI bytes (no padding)
-func PackedDecodeInt152(data []byte) (*big.Int, int, error) {
-	if len(data) < 19 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:19], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 19, nil
-}
-
-// PackedDecodeInt16 decodes int16 from packed ABI bytes (no padding)
-func PackedDecodeInt16(data []byte) (int16, int, error) {
-	if len(data) < 2 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return int16(binary.BigEndian.Uint16(data[:2])), 2, nil
-}
-
-// PackedDecodeInt160 decodes int160 from packed ABI bytes (no padding)
-func PackedDecodeInt160(data []byte) (*big.Int, int, error) {
-	if len(data) < 20 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:20], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 20, nil
-}
-
-// PackedDecodeInt168 decodes int168 from packed ABI bytes (no padding)
-func PackedDecodeInt168(data []byte) (*big.Int, int, error) {
-	if len(data) < 21 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:21], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 21, nil
-}
-
-// PackedDecodeInt176 decodes int176 from packed ABI bytes (no padding)
-func PackedDecodeInt176(data []byte) (*big.Int, int, error) {
-	if len(data) < 22 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:22], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 22, nil
-}
-
-// PackedDecodeInt184 decodes int184 from packed ABI bytes (no padding)
-func PackedDecodeInt184(data []byte) (*big.Int, int, error) {
-	if len(data) < 23 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:23], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 23, nil
-}
-
-// PackedDecodeInt192 decodes int192 from packed ABI bytes (no padding)
-func PackedDecodeInt192(data []byte) (*big.Int, int, error) {
-	if len(data) < 24 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:24], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 24, nil
-}
-
-// PackedDecodeInt200 decodes int200 from packed ABI bytes (no padding)
-func PackedDecodeInt200(data []byte) (*big.Int, int, error) {
-	if len(data) < 25 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:25], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 25, nil
-}
-
-// PackedDecodeInt208 decodes int208 from packed ABI bytes (no padding)
-func PackedDecodeInt208(data []byte) (*big.Int, int, error) {
-	if len(data) < 26 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:26], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 26, nil
-}
-
-// PackedDecodeInt216 decodes int216 from packed ABI bytes (no padding)
-func PackedDecodeInt216(data []byte) (*big.Int, int, error) {
-	if len(data) < 27 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:27], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 27, nil
-}
-
-// PackedDecodeInt224 decodes int224 from packed ABI bytes (no padding)
-func PackedDecodeInt224(data []byte) (*big.Int, int, error) {
-	if len(data) < 28 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:28], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 28, nil
-}
-
-// PackedDecodeInt232 decodes int232 from packed ABI bytes (no padding)
-func PackedDecodeInt232(data []byte) (*big.Int, int, error) {
-	if len(data) < 29 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:29], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 29, nil
-}
-
-// PackedDecodeInt24 decodes int24 from packed ABI bytes (no padding)
-func PackedDecodeInt24(data []byte) (int32, int, error) {
-	if len(data) < 3 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	v := int32(data[0])<<16 | int32(data[1])<<8 | int32(data[2])
-	if data[0]&0x80 != 0 {
-		v = v | (^int32(0) << 24)
-	}
-	return v, 3, nil
-}
-
-// PackedDecodeInt240 decodes int240 from packed ABI bytes (no padding)
-func PackedDecodeInt240(data []byte) (*big.Int, int, error) {
-	if len(data) < 30 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:30], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 30, nil
-}
-
-// PackedDecodeInt248 decodes int248 from packed ABI bytes (no padding)
-func PackedDecodeInt248(data []byte) (*big.Int, int, error) {
-	if len(data) < 31 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:31], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 31, nil
-}
-
-// PackedDecodeInt256 decodes int256 from packed ABI bytes (no padding)
-func PackedDecodeInt256(data []byte) (*big.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:32], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 32, nil
-}
-
-// PackedDecodeInt32 decodes int32 from packed ABI bytes (no padding)
-func PackedDecodeInt32(data []byte) (int32, int, error) {
-	if len(data) < 4 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return int32(binary.BigEndian.Uint32(data[:4])), 4, nil
-}
-
-// PackedDecodeInt40 decodes int40 from packed ABI bytes (no padding)
-func PackedDecodeInt40(data []byte) (int64, int, error) {
-	if len(data) < 5 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	v := int64(data[0])<<32 | int64(data[1])<<24 | int64(data[2])<<16 | int64(data[3])<<8 | int64(data[4])
-	if data[0]&0x80 != 0 {
-		v = v | (^int64(0) << 40)
-	}
-	return v, 5, nil
-}
-
-// PackedDecodeInt48 decodes int48 from packed ABI bytes (no padding)
-func PackedDecodeInt48(data []byte) (int64, int, error) {
-	if len(data) < 6 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	v := int64(data[0])<<40 | int64(data[1])<<32 | int64(data[2])<<24 | int64(data[3])<<16 | int64(data[4])<<8 | int64(data[5])
-	if data[0]&0x80 != 0 {
-		v = v | (^int64(0) << 48)
-	}
-	return v, 6, nil
-}
-
-// PackedDecodeInt56 decodes int56 from packed ABI bytes (no padding)
-func PackedDecodeInt56(data []byte) (int64, int, error) {
-	if len(data) < 7 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	v := int64(data[0])<<48 | int64(data[1])<<40 | int64(data[2])<<32 | int64(data[3])<<24 | int64(data[4])<<16 | int64(data[5])<<8 | int64(data[6])
-	if data[0]&0x80 != 0 {
-		v = v | (^int64(0) << 56)
-	}
-	return v, 7, nil
-}
-
-// PackedDecodeInt64 decodes int64 from packed ABI bytes (no padding)
-func PackedDecodeInt64(data []byte) (int64, int, error) {
-	if len(data) < 8 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return int64(binary.BigEndian.Uint64(data[:8])), 8, nil
-}
-
-// PackedDecodeInt72 decodes int72 from packed ABI bytes (no padding)
-func PackedDecodeInt72(data []byte) (*big.Int, int, error) {
-	if len(data) < 9 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:9], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 9, nil
-}
-
-// PackedDecodeInt8 decodes int8 from packed ABI bytes (no padding)
-func PackedDecodeInt8(data []byte) (int8, int, error) {
-	if len(data) < 1 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return int8(data[0]), 1, nil
-}
-
-// PackedDecodeInt80 decodes int80 from packed ABI bytes (no padding)
-func PackedDecodeInt80(data []byte) (*big.Int, int, error) {
-	if len(data) < 10 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:10], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 10, nil
-}
-
-// PackedDecodeInt88 decodes int88 from packed ABI bytes (no padding)
-func PackedDecodeInt88(data []byte) (*big.Int, int, error) {
-	if len(data) < 11 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:11], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 11, nil
-}
-
-// PackedDecodeInt96 decodes int96 from packed ABI bytes (no padding)
-func PackedDecodeInt96(data []byte) (*big.Int, int, error) {
-	if len(data) < 12 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result, err := DecodeBigInt(data[:12], true)
-	if err != nil {
-		return nil, 0, err
-	}
-	return result, 12, nil
-}
-
-// PackedDecodeUint104 decodes uint104 from packed ABI bytes (no padding)
-func PackedDecodeUint104(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 13 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:13])
-	return result, 13, nil
-}
-
-// PackedDecodeUint112 decodes uint112 from packed ABI bytes (no padding)
-func PackedDecodeUint112(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 14 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:14])
-	return result, 14, nil
-}
-
-// PackedDecodeUint120 decodes uint120 from packed ABI bytes (no padding)
-func PackedDecodeUint120(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 15 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:15])
-	return result, 15, nil
-}
-
-// PackedDecodeUint128 decodes uint128 from packed ABI bytes (no padding)
-func PackedDecodeUint128(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 16 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:16])
-	return result, 16, nil
-}
-
-// PackedDecodeUint136 decodes uint136 from packed ABI bytes (no padding)
-func PackedDecodeUint136(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 17 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:17])
-	return result, 17, nil
-}
-
-// PackedDecodeUint144 decodes uint144 from packed ABI bytes (no padding)
-func PackedDecodeUint144(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 18 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:18])
-	return result, 18, nil
-}
-
-// PackedDecodeUint152 decodes uint152 from packed ABI bytes (no padding)
-func PackedDecodeUint152(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 19 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:19])
-	return result, 19, nil
-}
-
-// PackedDecodeUint16 decodes uint16 from packed ABI bytes (no padding)
-func PackedDecodeUint16(data []byte) (uint16, int, error) {
-	if len(data) < 2 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return binary.BigEndian.Uint16(data[:2]), 2, nil
-}
-
-// PackedDecodeUint160 decodes uint160 from packed ABI bytes (no padding)
-func PackedDecodeUint160(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 20 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:20])
-	return result, 20, nil
-}
-
-// PackedDecodeUint168 decodes uint168 from packed ABI bytes (no padding)
-func PackedDecodeUint168(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 21 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:21])
-	return result, 21, nil
-}
-
-// PackedDecodeUint176 decodes uint176 from packed ABI bytes (no padding)
-func PackedDecodeUint176(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 22 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:22])
-	return result, 22, nil
-}
-
-// PackedDecodeUint184 decodes uint184 from packed ABI bytes (no padding)
-func PackedDecodeUint184(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 23 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:23])
-	return result, 23, nil
-}
-
-// PackedDecodeUint192 decodes uint192 from packed ABI bytes (no padding)
-func PackedDecodeUint192(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 24 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:24])
-	return result, 24, nil
-}
-
-// PackedDecodeUint200 decodes uint200 from packed ABI bytes (no padding)
-func PackedDecodeUint200(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 25 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:25])
-	return result, 25, nil
-}
-
-// PackedDecodeUint208 decodes uint208 from packed ABI bytes (no padding)
-func PackedDecodeUint208(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 26 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:26])
-	return result, 26, nil
-}
-
-// PackedDecodeUint216 decodes uint216 from packed ABI bytes (no padding)
-func PackedDecodeUint216(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 27 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:27])
-	return result, 27, nil
-}
-
-// PackedDecodeUint224 decodes uint224 from packed ABI bytes (no padding)
-func PackedDecodeUint224(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 28 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:28])
-	return result, 28, nil
-}
-
-// PackedDecodeUint232 decodes uint232 from packed ABI bytes (no padding)
-func PackedDecodeUint232(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 29 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:29])
-	return result, 29, nil
-}
-
-// PackedDecodeUint24 decodes uint24 from packed ABI bytes (no padding)
-func PackedDecodeUint24(data []byte) (uint32, int, error) {
-	if len(data) < 3 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return uint32(data[0])<<16 | uint32(data[1])<<8 | uint32(data[2]), 3, nil
-}
-
-// PackedDecodeUint240 decodes uint240 from packed ABI bytes (no padding)
-func PackedDecodeUint240(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 30 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:30])
-	return result, 30, nil
-}
-
-// PackedDecodeUint248 decodes uint248 from packed ABI bytes (no padding)
-func PackedDecodeUint248(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 31 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:31])
-	return result, 31, nil
-}
-
-// PackedDecodeUint256 decodes uint256 from packed ABI bytes (no padding)
-func PackedDecodeUint256(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes32(data[:32])
-	return result, 32, nil
-}
-
-// PackedDecodeUint32 decodes uint32 from packed ABI bytes (no padding)
-func PackedDecodeUint32(data []byte) (uint32, int, error) {
-	if len(data) < 4 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return binary.BigEndian.Uint32(data[:4]), 4, nil
-}
-
-// PackedDecodeUint40 decodes uint40 from packed ABI bytes (no padding)
-func PackedDecodeUint40(data []byte) (uint64, int, error) {
-	if len(data) < 5 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return uint64(data[0])<<32 | uint64(data[1])<<24 | uint64(data[2])<<16 | uint64(data[3])<<8 | uint64(data[4]), 5, nil
-}
-
-// PackedDecodeUint48 decodes uint48 from packed ABI bytes (no padding)
-func PackedDecodeUint48(data []byte) (uint64, int, error) {
-	if len(data) < 6 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return uint64(data[0])<<40 | uint64(data[1])<<32 | uint64(data[2])<<24 | uint64(data[3])<<16 | uint64(data[4])<<8 | uint64(data[5]), 6, nil
-}
-
-// PackedDecodeUint56 decodes uint56 from packed ABI bytes (no padding)
-func PackedDecodeUint56(data []byte) (uint64, int, error) {
-	if len(data) < 7 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return uint64(data[0])<<48 | uint64(data[1])<<40 | uint64(data[2])<<32 | uint64(data[3])<<24 | uint64(data[4])<<16 | uint64(data[5])<<8 | uint64(data[6]), 7, nil
-}
-
-// PackedDecodeUint64 decodes uint64 from packed ABI bytes (no padding)
-func PackedDecodeUint64(data []byte) (uint64, int, error) {
-	if len(data) < 8 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return binary.BigEndian.Uint64(data[:8]), 8, nil
-}
-
-// PackedDecodeUint72 decodes uint72 from packed ABI bytes (no padding)
-func PackedDecodeUint72(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 9 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:9])
-	return result, 9, nil
-}
-
-// PackedDecodeUint8 decodes uint8 from packed ABI bytes (no padding)
-func PackedDecodeUint8(data []byte) (uint8, int, error) {
-	if len(data) < 1 {
-		return 0, 0, io.ErrUnexpectedEOF
-	}
-	return data[0], 1, nil
-}
-
-// PackedDecodeUint80 decodes uint80 from packed ABI bytes (no padding)
-func PackedDecodeUint80(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 10 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:10])
-	return result, 10, nil
-}
-
-// PackedDecodeUint88 decodes uint88 from packed ABI bytes (no padding)
-func PackedDecodeUint88(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 11 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:11])
-	return result, 11, nil
-}
-
-// PackedDecodeUint96 decodes uint96 from packed ABI bytes (no padding)
-func PackedDecodeUint96(data []byte) (*uint256.Int, int, error) {
-	if len(data) < 12 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	result := new(uint256.Int)
-	result.SetBytes(data[:12])
-	return result, 12, nil
-}
-
-var _ Method = (*BasicCall)(nil)
-
-const BasicCallStaticSize = 320
-
-var _ Tuple = (*BasicCall)(nil)
-
-// BasicCall represents an ABI tuple
-type BasicCall struct {
-	Field1  bool
-	Field2  common.Address
-	Field3  [32]byte
-	Field4  string
-	Field5  []byte
-	Field6  []bool
-	Field7  []common.Address
-	Field8  [][32]byte
-	Field9  []string
-	Field10 [][]byte
-}
-
-// EncodedSize returns the total encoded size of BasicCall
-func (t BasicCall) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += SizeString(t.Field4)
-	dynamicSize += SizeBytes(t.Field5)
-	dynamicSize += SizeBoolSlice(t.Field6)
-	dynamicSize += SizeAddressSlice(t.Field7)
-	dynamicSize += SizeBytes32Slice(t.Field8)
-	dynamicSize += SizeStringSlice(t.Field9)
-	dynamicSize += SizeBytesSlice(t.Field10)
-
-	return BasicCallStaticSize + dynamicSize
-}
-
-// EncodeTo encodes BasicCall to ABI bytes in the provided buffer
-func (value BasicCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := BasicCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Field1: bool
-	if _, err := EncodeBool(value.Field1, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field2: address
-	if _, err := EncodeAddress(value.Field2, buf[32:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field3: bytes32
-	if _, err := EncodeBytes32(value.Field3, buf[64:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field4: string
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeString(value.Field4, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field5: bytes
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[128+24:128+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes(value.Field5, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field6: bool[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[160+24:160+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBoolSlice(value.Field6, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field7: address[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[192+24:192+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeAddressSlice(value.Field7, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field8: bytes32[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[224+24:224+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes32Slice(value.Field8, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field9: string[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[256+24:256+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeStringSlice(value.Field9, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field10: bytes[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[288+24:288+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytesSlice(value.Field10, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	return dynamicOffset, nil
-}
-
-// Encode encodes BasicCall to ABI bytes
-func (value BasicCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// Decode decodes BasicCall from ABI bytes in the provided buffer
-func (t *BasicCall) Decode(data []byte) (int, error) {
-	if len(data) < 320 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var (
-		err    error
-		n      int
-		offset int
-	)
-	dynamicOffset := 320
-	// Decode static field Field1: bool
-	t.Field1, _, err = DecodeBool(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field2: address
-	t.Field2, _, err = DecodeAddress(data[32:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field3: bytes32
-	t.Field3, _, err = DecodeBytes32(data[64:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode dynamic field Field4
-	{
-		offset, err = DecodeSize(data[96:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field4, n, err = DecodeString(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field5
-	{
-		offset, err = DecodeSize(data[128:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field5, n, err = DecodeBytes(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field6
-	{
-		offset, err = DecodeSize(data[160:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field6, n, err = DecodeBoolSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field7
-	{
-		offset, err = DecodeSize(data[192:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field7, n, err = DecodeAddressSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field8
-	{
-		offset, err = DecodeSize(data[224:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field8, n, err = DecodeBytes32Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field9
-	{
-		offset, err = DecodeSize(data[256:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field9, n, err = DecodeStringSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field10
-	{
-		offset, err = DecodeSize(data[288:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field10, n, err = DecodeBytesSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	return dynamicOffset, nil
-}
-
-// GetMethodName returns the function name
-func (t BasicCall) GetMethodName() string {
-	return "basic"
-}
-
-// GetMethodID returns the function id
-func (t BasicCall) GetMethodID() uint32 {
-	return BasicID
-}
-
-// GetMethodSelector returns the function selector
-func (t BasicCall) GetMethodSelector() [4]byte {
-	return BasicSelector
-}
-
-// EncodeWithSelector encodes basic arguments to ABI bytes including function selector
-func (t BasicCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], BasicSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// NewBasicCall constructs a new BasicCall
-func NewBasicCall(
-	field1 bool,
-	field2 common.Address,
-	field3 [32]byte,
-	field4 string,
-	field5 []byte,
-	field6 []bool,
-	field7 []common.Address,
-	field8 [][32]byte,
-	field9 []string,
-	field10 [][]byte,
-) *BasicCall {
-	return &BasicCall{
-		Field1:  field1,
-		Field2:  field2,
-		Field3:  field3,
-		Field4:  field4,
-		Field5:  field5,
-		Field6:  field6,
-		Field7:  field7,
-		Field8:  field8,
-		Field9:  field9,
-		Field10: field10,
-	}
-}
-
-// BasicReturn represents the output arguments for basic function
-type BasicReturn struct {
-	EmptyTuple
-}
-
-var _ Method = (*BytesCall)(nil)
-
-const BytesCallStaticSize = 2048
-
-var _ Tuple = (*BytesCall)(nil)
-
-// BytesCall represents an ABI tuple
-type BytesCall struct {
-	Field1  [1]byte
-	Field2  [2]byte
-	Field3  [3]byte
-	Field4  [4]byte
-	Field5  [5]byte
-	Field6  [6]byte
-	Field7  [7]byte
-	Field8  [8]byte
-	Field9  [9]byte
-	Field10 [10]byte
-	Field11 [11]byte
-	Field12 [12]byte
-	Field13 [13]byte
-	Field14 [14]byte
-	Field15 [15]byte
-	Field16 [16]byte
-	Field17 [17]byte
-	Field18 [18]byte
-	Field19 [19]byte
-	Field20 [20]byte
-	Field21 [21]byte
-	Field22 [22]byte
-	Field23 [23]byte
-	Field24 [24]byte
-	Field25 [25]byte
-	Field26 [26]byte
-	Field27 [27]byte
-	Field28 [28]byte
-	Field29 [29]byte
-	Field30 [30]byte
-	Field31 [31]byte
-	Field32 [32]byte
-	Field33 [][1]byte
-	Field34 [][2]byte
-	Field35 [][3]byte
-	Field36 [][4]byte
-	Field37 [][5]byte
-	Field38 [][6]byte
-	Field39 [][7]byte
-	Field40 [][8]byte
-	Field41 [][9]byte
-	Field42 [][10]byte
-	Field43 [][11]byte
-	Field44 [][12]byte
-	Field45 [][13]byte
-	Field46 [][14]byte
-	Field47 [][15]byte
-	Field48 [][16]byte
-	Field49 [][17]byte
-	Field50 [][18]byte
-	Field51 [][19]byte
-	Field52 [][20]byte
-	Field53 [][21]byte
-	Field54 [][22]byte
-	Field55 [][23]byte
-	Field56 [][24]byte
-	Field57 [][25]byte
-	Field58 [][26]byte
-	Field59 [][27]byte
-	Field60 [][28]byte
-	Field61 [][29]byte
-	Field62 [][30]byte
-	Field63 [][31]byte
-	Field64 [][32]byte
-}
-
-// EncodedSize returns the total encoded size of BytesCall
-func (t BytesCall) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += SizeBytes1Slice(t.Field33)
-	dynamicSize += SizeBytes2Slice(t.Field34)
-	dynamicSize += SizeBytes3Slice(t.Field35)
-	dynamicSize += SizeBytes4Slice(t.Field36)
-	dynamicSize += SizeBytes5Slice(t.Field37)
-	dynamicSize += SizeBytes6Slice(t.Field38)
-	dynamicSize += SizeBytes7Slice(t.Field39)
-	dynamicSize += SizeBytes8Slice(t.Field40)
-	dynamicSize += SizeBytes9Slice(t.Field41)
-	dynamicSize += SizeBytes10Slice(t.Field42)
-	dynamicSize += SizeBytes11Slice(t.Field43)
-	dynamicSize += SizeBytes12Slice(t.Field44)
-	dynamicSize += SizeBytes13Slice(t.Field45)
-	dynamicSize += SizeBytes14Slice(t.Field46)
-	dynamicSize += SizeBytes15Slice(t.Field47)
-	dynamicSize += SizeBytes16Slice(t.Field48)
-	dynamicSize += SizeBytes17Slice(t.Field49)
-	dynamicSize += SizeBytes18Slice(t.Field50)
-	dynamicSize += SizeBytes19Slice(t.Field51)
-	dynamicSize += SizeBytes20Slice(t.Field52)
-	dynamicSize += SizeBytes21Slice(t.Field53)
-	dynamicSize += SizeBytes22Slice(t.Field54)
-	dynamicSize += SizeBytes23Slice(t.Field55)
-	dynamicSize += SizeBytes24Slice(t.Field56)
-	dynamicSize += SizeBytes25Slice(t.Field57)
-	dynamicSize += SizeBytes26Slice(t.Field58)
-	dynamicSize += SizeBytes27Slice(t.Field59)
-	dynamicSize += SizeBytes28Slice(t.Field60)
-	dynamicSize += SizeBytes29Slice(t.Field61)
-	dynamicSize += SizeBytes30Slice(t.Field62)
-	dynamicSize += SizeBytes31Slice(t.Field63)
-	dynamicSize += SizeBytes32Slice(t.Field64)
-
-	return BytesCallStaticSize + dynamicSize
-}
-
-// EncodeTo encodes BytesCall to ABI bytes in the provided buffer
-func (value BytesCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := BytesCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Field1: bytes1
-	if _, err := EncodeBytes1(value.Field1, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field2: bytes2
-	if _, err := EncodeBytes2(value.Field2, buf[32:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field3: bytes3
-	if _, err := EncodeBytes3(value.Field3, buf[64:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field4: bytes4
-	if _, err := EncodeBytes4(value.Field4, buf[96:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field5: bytes5
-	if _, err := EncodeBytes5(value.Field5, buf[128:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field6: bytes6
-	if _, err := EncodeBytes6(value.Field6, buf[160:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field7: bytes7
-	if _, err := EncodeBytes7(value.Field7, buf[192:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field8: bytes8
-	if _, err := EncodeBytes8(value.Field8, buf[224:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field9: bytes9
-	if _, err := EncodeBytes9(value.Field9, buf[256:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field10: bytes10
-	if _, err := EncodeBytes10(value.Field10, buf[288:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field11: bytes11
-	if _, err := EncodeBytes11(value.Field11, buf[320:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field12: bytes12
-	if _, err := EncodeBytes12(value.Field12, buf[352:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field13: bytes13
-	if _, err := EncodeBytes13(value.Field13, buf[384:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field14: bytes14
-	if _, err := EncodeBytes14(value.Field14, buf[416:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field15: bytes15
-	if _, err := EncodeBytes15(value.Field15, buf[448:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field16: bytes16
-	if _, err := EncodeBytes16(value.Field16, buf[480:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field17: bytes17
-	if _, err := EncodeBytes17(value.Field17, buf[512:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field18: bytes18
-	if _, err := EncodeBytes18(value.Field18, buf[544:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field19: bytes19
-	if _, err := EncodeBytes19(value.Field19, buf[576:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field20: bytes20
-	if _, err := EncodeBytes20(value.Field20, buf[608:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field21: bytes21
-	if _, err := EncodeBytes21(value.Field21, buf[640:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field22: bytes22
-	if _, err := EncodeBytes22(value.Field22, buf[672:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field23: bytes23
-	if _, err := EncodeBytes23(value.Field23, buf[704:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field24: bytes24
-	if _, err := EncodeBytes24(value.Field24, buf[736:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field25: bytes25
-	if _, err := EncodeBytes25(value.Field25, buf[768:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field26: bytes26
-	if _, err := EncodeBytes26(value.Field26, buf[800:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field27: bytes27
-	if _, err := EncodeBytes27(value.Field27, buf[832:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field28: bytes28
-	if _, err := EncodeBytes28(value.Field28, buf[864:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field29: bytes29
-	if _, err := EncodeBytes29(value.Field29, buf[896:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field30: bytes30
-	if _, err := EncodeBytes30(value.Field30, buf[928:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field31: bytes31
-	if _, err := EncodeBytes31(value.Field31, buf[960:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field32: bytes32
-	if _, err := EncodeBytes32(value.Field32, buf[992:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field33: bytes1[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1024+24:1024+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes1Slice(value.Field33, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field34: bytes2[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1056+24:1056+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes2Slice(value.Field34, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field35: bytes3[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1088+24:1088+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes3Slice(value.Field35, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field36: bytes4[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1120+24:1120+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes4Slice(value.Field36, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field37: bytes5[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1152+24:1152+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes5Slice(value.Field37, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field38: bytes6[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1184+24:1184+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes6Slice(value.Field38, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field39: bytes7[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1216+24:1216+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes7Slice(value.Field39, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field40: bytes8[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1248+24:1248+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes8Slice(value.Field40, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field41: bytes9[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1280+24:1280+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes9Slice(value.Field41, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field42: bytes10[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1312+24:1312+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes10Slice(value.Field42, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field43: bytes11[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1344+24:1344+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes11Slice(value.Field43, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field44: bytes12[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1376+24:1376+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes12Slice(value.Field44, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field45: bytes13[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1408+24:1408+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes13Slice(value.Field45, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field46: bytes14[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1440+24:1440+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes14Slice(value.Field46, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field47: bytes15[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1472+24:1472+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes15Slice(value.Field47, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field48: bytes16[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1504+24:1504+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes16Slice(value.Field48, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field49: bytes17[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1536+24:1536+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes17Slice(value.Field49, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field50: bytes18[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1568+24:1568+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes18Slice(value.Field50, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field51: bytes19[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1600+24:1600+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes19Slice(value.Field51, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field52: bytes20[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1632+24:1632+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes20Slice(value.Field52, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field53: bytes21[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1664+24:1664+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes21Slice(value.Field53, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field54: bytes22[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1696+24:1696+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes22Slice(value.Field54, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field55: bytes23[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1728+24:1728+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes23Slice(value.Field55, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field56: bytes24[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1760+24:1760+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes24Slice(value.Field56, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field57: bytes25[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1792+24:1792+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes25Slice(value.Field57, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field58: bytes26[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1824+24:1824+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes26Slice(value.Field58, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field59: bytes27[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1856+24:1856+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes27Slice(value.Field59, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field60: bytes28[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1888+24:1888+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes28Slice(value.Field60, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field61: bytes29[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1920+24:1920+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes29Slice(value.Field61, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field62: bytes30[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1952+24:1952+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes30Slice(value.Field62, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field63: bytes31[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[1984+24:1984+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes31Slice(value.Field63, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field64: bytes32[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2016+24:2016+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeBytes32Slice(value.Field64, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	return dynamicOffset, nil
-}
-
-// Encode encodes BytesCall to ABI bytes
-func (value BytesCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// Decode decodes BytesCall from ABI bytes in the provided buffer
-func (t *BytesCall) Decode(data []byte) (int, error) {
-	if len(data) < 2048 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var (
-		err    error
-		n      int
-		offset int
-	)
-	dynamicOffset := 2048
-	// Decode static field Field1: bytes1
-	t.Field1, _, err = DecodeBytes1(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field2: bytes2
-	t.Field2, _, err = DecodeBytes2(data[32:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field3: bytes3
-	t.Field3, _, err = DecodeBytes3(data[64:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field4: bytes4
-	t.Field4, _, err = DecodeBytes4(data[96:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field5: bytes5
-	t.Field5, _, err = DecodeBytes5(data[128:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field6: bytes6
-	t.Field6, _, err = DecodeBytes6(data[160:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field7: bytes7
-	t.Field7, _, err = DecodeBytes7(data[192:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field8: bytes8
-	t.Field8, _, err = DecodeBytes8(data[224:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field9: bytes9
-	t.Field9, _, err = DecodeBytes9(data[256:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field10: bytes10
-	t.Field10, _, err = DecodeBytes10(data[288:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field11: bytes11
-	t.Field11, _, err = DecodeBytes11(data[320:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field12: bytes12
-	t.Field12, _, err = DecodeBytes12(data[352:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field13: bytes13
-	t.Field13, _, err = DecodeBytes13(data[384:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field14: bytes14
-	t.Field14, _, err = DecodeBytes14(data[416:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field15: bytes15
-	t.Field15, _, err = DecodeBytes15(data[448:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field16: bytes16
-	t.Field16, _, err = DecodeBytes16(data[480:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field17: bytes17
-	t.Field17, _, err = DecodeBytes17(data[512:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field18: bytes18
-	t.Field18, _, err = DecodeBytes18(data[544:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field19: bytes19
-	t.Field19, _, err = DecodeBytes19(data[576:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field20: bytes20
-	t.Field20, _, err = DecodeBytes20(data[608:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field21: bytes21
-	t.Field21, _, err = DecodeBytes21(data[640:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field22: bytes22
-	t.Field22, _, err = DecodeBytes22(data[672:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field23: bytes23
-	t.Field23, _, err = DecodeBytes23(data[704:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field24: bytes24
-	t.Field24, _, err = DecodeBytes24(data[736:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field25: bytes25
-	t.Field25, _, err = DecodeBytes25(data[768:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field26: bytes26
-	t.Field26, _, err = DecodeBytes26(data[800:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field27: bytes27
-	t.Field27, _, err = DecodeBytes27(data[832:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field28: bytes28
-	t.Field28, _, err = DecodeBytes28(data[864:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field29: bytes29
-	t.Field29, _, err = DecodeBytes29(data[896:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field30: bytes30
-	t.Field30, _, err = DecodeBytes30(data[928:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field31: bytes31
-	t.Field31, _, err = DecodeBytes31(data[960:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field32: bytes32
-	t.Field32, _, err = DecodeBytes32(data[992:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode dynamic field Field33
-	{
-		offset, err = DecodeSize(data[1024:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field33, n, err = DecodeBytes1Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field34
-	{
-		offset, err = DecodeSize(data[1056:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field34, n, err = DecodeBytes2Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field35
-	{
-		offset, err = DecodeSize(data[1088:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field35, n, err = DecodeBytes3Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field36
-	{
-		offset, err = DecodeSize(data[1120:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field36, n, err = DecodeBytes4Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field37
-	{
-		offset, err = DecodeSize(data[1152:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field37, n, err = DecodeBytes5Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field38
-	{
-		offset, err = DecodeSize(data[1184:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field38, n, err = DecodeBytes6Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field39
-	{
-		offset, err = DecodeSize(data[1216:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field39, n, err = DecodeBytes7Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field40
-	{
-		offset, err = DecodeSize(data[1248:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field40, n, err = DecodeBytes8Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field41
-	{
-		offset, err = DecodeSize(data[1280:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field41, n, err = DecodeBytes9Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field42
-	{
-		offset, err = DecodeSize(data[1312:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field42, n, err = DecodeBytes10Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field43
-	{
-		offset, err = DecodeSize(data[1344:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field43, n, err = DecodeBytes11Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field44
-	{
-		offset, err = DecodeSize(data[1376:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field44, n, err = DecodeBytes12Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field45
-	{
-		offset, err = DecodeSize(data[1408:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field45, n, err = DecodeBytes13Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field46
-	{
-		offset, err = DecodeSize(data[1440:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field46, n, err = DecodeBytes14Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field47
-	{
-		offset, err = DecodeSize(data[1472:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field47, n, err = DecodeBytes15Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field48
-	{
-		offset, err = DecodeSize(data[1504:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field48, n, err = DecodeBytes16Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field49
-	{
-		offset, err = DecodeSize(data[1536:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field49, n, err = DecodeBytes17Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field50
-	{
-		offset, err = DecodeSize(data[1568:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field50, n, err = DecodeBytes18Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field51
-	{
-		offset, err = DecodeSize(data[1600:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field51, n, err = DecodeBytes19Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field52
-	{
-		offset, err = DecodeSize(data[1632:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field52, n, err = DecodeBytes20Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field53
-	{
-		offset, err = DecodeSize(data[1664:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field53, n, err = DecodeBytes21Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field54
-	{
-		offset, err = DecodeSize(data[1696:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field54, n, err = DecodeBytes22Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field55
-	{
-		offset, err = DecodeSize(data[1728:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field55, n, err = DecodeBytes23Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field56
-	{
-		offset, err = DecodeSize(data[1760:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field56, n, err = DecodeBytes24Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field57
-	{
-		offset, err = DecodeSize(data[1792:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field57, n, err = DecodeBytes25Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field58
-	{
-		offset, err = DecodeSize(data[1824:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field58, n, err = DecodeBytes26Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field59
-	{
-		offset, err = DecodeSize(data[1856:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field59, n, err = DecodeBytes27Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field60
-	{
-		offset, err = DecodeSize(data[1888:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field60, n, err = DecodeBytes28Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field61
-	{
-		offset, err = DecodeSize(data[1920:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field61, n, err = DecodeBytes29Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field62
-	{
-		offset, err = DecodeSize(data[1952:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field62, n, err = DecodeBytes30Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field63
-	{
-		offset, err = DecodeSize(data[1984:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field63, n, err = DecodeBytes31Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field64
-	{
-		offset, err = DecodeSize(data[2016:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field64, n, err = DecodeBytes32Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	return dynamicOffset, nil
-}
-
-// GetMethodName returns the function name
-func (t BytesCall) GetMethodName() string {
-	return "bytes"
-}
-
-// GetMethodID returns the function id
-func (t BytesCall) GetMethodID() uint32 {
-	return BytesID
-}
-
-// GetMethodSelector returns the function selector
-func (t BytesCall) GetMethodSelector() [4]byte {
-	return BytesSelector
-}
-
-// EncodeWithSelector encodes bytes arguments to ABI bytes including function selector
-func (t BytesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], BytesSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// NewBytesCall constructs a new BytesCall
-func NewBytesCall(
-	field1 [1]byte,
-	field2 [2]byte,
-	field3 [3]byte,
-	field4 [4]byte,
-	field5 [5]byte,
-	field6 [6]byte,
-	field7 [7]byte,
-	field8 [8]byte,
-	field9 [9]byte,
-	field10 [10]byte,
-	field11 [11]byte,
-	field12 [12]byte,
-	field13 [13]byte,
-	field14 [14]byte,
-	field15 [15]byte,
-	field16 [16]byte,
-	field17 [17]byte,
-	field18 [18]byte,
-	field19 [19]byte,
-	field20 [20]byte,
-	field21 [21]byte,
-	field22 [22]byte,
-	field23 [23]byte,
-	field24 [24]byte,
-	field25 [25]byte,
-	field26 [26]byte,
-	field27 [27]byte,
-	field28 [28]byte,
-	field29 [29]byte,
-	field30 [30]byte,
-	field31 [31]byte,
-	field32 [32]byte,
-	field33 [][1]byte,
-	field34 [][2]byte,
-	field35 [][3]byte,
-	field36 [][4]byte,
-	field37 [][5]byte,
-	field38 [][6]byte,
-	field39 [][7]byte,
-	field40 [][8]byte,
-	field41 [][9]byte,
-	field42 [][10]byte,
-	field43 [][11]byte,
-	field44 [][12]byte,
-	field45 [][13]byte,
-	field46 [][14]byte,
-	field47 [][15]byte,
-	field48 [][16]byte,
-	field49 [][17]byte,
-	field50 [][18]byte,
-	field51 [][19]byte,
-	field52 [][20]byte,
-	field53 [][21]byte,
-	field54 [][22]byte,
-	field55 [][23]byte,
-	field56 [][24]byte,
-	field57 [][25]byte,
-	field58 [][26]byte,
-	field59 [][27]byte,
-	field60 [][28]byte,
-	field61 [][29]byte,
-	field62 [][30]byte,
-	field63 [][31]byte,
-	field64 [][32]byte,
-) *BytesCall {
-	return &BytesCall{
-		Field1:  field1,
-		Field2:  field2,
-		Field3:  field3,
-		Field4:  field4,
-		Field5:  field5,
-		Field6:  field6,
-		Field7:  field7,
-		Field8:  field8,
-		Field9:  field9,
-		Field10: field10,
-		Field11: field11,
-		Field12: field12,
-		Field13: field13,
-		Field14: field14,
-		Field15: field15,
-		Field16: field16,
-		Field17: field17,
-		Field18: field18,
-		Field19: field19,
-		Field20: field20,
-		Field21: field21,
-		Field22: field22,
-		Field23: field23,
-		Field24: field24,
-		Field25: field25,
-		Field26: field26,
-		Field27: field27,
-		Field28: field28,
-		Field29: field29,
-		Field30: field30,
-		Field31: field31,
-		Field32: field32,
-		Field33: field33,
-		Field34: field34,
-		Field35: field35,
-		Field36: field36,
-		Field37: field37,
-		Field38: field38,
-		Field39: field39,
-		Field40: field40,
-		Field41: field41,
-		Field42: field42,
-		Field43: field43,
-		Field44: field44,
-		Field45: field45,
-		Field46: field46,
-		Field47: field47,
-		Field48: field48,
-		Field49: field49,
-		Field50: field50,
-		Field51: field51,
-		Field52: field52,
-		Field53: field53,
-		Field54: field54,
-		Field55: field55,
-		Field56: field56,
-		Field57: field57,
-		Field58: field58,
-		Field59: field59,
-		Field60: field60,
-		Field61: field61,
-		Field62: field62,
-		Field63: field63,
-		Field64: field64,
-	}
-}
-
-// BytesReturn represents the output arguments for bytes function
-type BytesReturn struct {
-	EmptyTuple
-}
-
-var _ Method = (*IntsCall)(nil)
-
-const IntsCallStaticSize = 4096
-
-var _ Tuple = (*IntsCall)(nil)
-
-// IntsCall represents an ABI tuple
-type IntsCall struct {
-	Field1   uint8
-	Field2   int8
-	Field3   uint16
-	Field4   int16
-	Field5   uint32
-	Field6   int32
-	Field7   uint32
-	Field8   int32
-	Field9   uint64
-	Field10  int64
-	Field11  uint64
-	Field12  int64
-	Field13  uint64
-	Field14  int64
-	Field15  uint64
-	Field16  int64
-	Field17  *uint256.Int
-	Field18  *big.Int
-	Field19  *uint256.Int
-	Field20  *big.Int
-	Field21  *uint256.Int
-	Field22  *big.Int
-	Field23  *uint256.Int
-	Field24  *big.Int
-	Field25  *uint256.Int
-	Field26  *big.Int
-	Field27  *uint256.Int
-	Field28  *big.Int
-	Field29  *uint256.Int
-	Field30  *big.Int
-	Field31  *uint256.Int
-	Field32  *big.Int
-	Field33  *uint256.Int
-	Field34  *big.Int
-	Field35  *uint256.Int
-	Field36  *big.Int
-	Field37  *uint256.Int
-	Field38  *big.Int
-	Field39  *uint256.Int
-	Field40  *big.Int
-	Field41  *uint256.Int
-	Field42  *big.Int
-	Field43  *uint256.Int
-	Field44  *big.Int
-	Field45  *uint256.Int
-	Field46  *big.Int
-	Field47  *uint256.Int
-	Field48  *big.Int
-	Field49  *uint256.Int
-	Field50  *big.Int
-	Field51  *uint256.Int
-	Field52  *big.Int
-	Field53  *uint256.Int
-	Field54  *big.Int
-	Field55  *uint256.Int
-	Field56  *big.Int
-	Field57  *uint256.Int
-	Field58  *big.Int
-	Field59  *uint256.Int
-	Field60  *big.Int
-	Field61  *uint256.Int
-	Field62  *big.Int
-	Field63  *uint256.Int
-	Field64  *big.Int
-	Field65  []uint8
-	Field66  []int8
-	Field67  []uint16
-	Field68  []int16
-	Field69  []uint32
-	Field70  []int32
-	Field71  []uint32
-	Field72  []int32
-	Field73  []uint64
-	Field74  []int64
-	Field75  []uint64
-	Field76  []int64
-	Field77  []uint64
-	Field78  []int64
-	Field79  []uint64
-	Field80  []int64
-	Field81  []*uint256.Int
-	Field82  []*big.Int
-	Field83  []*uint256.Int
-	Field84  []*big.Int
-	Field85  []*uint256.Int
-	Field86  []*big.Int
-	Field87  []*uint256.Int
-	Field88  []*big.Int
-	Field89  []*uint256.Int
-	Field90  []*big.Int
-	Field91  []*uint256.Int
-	Field92  []*big.Int
-	Field93  []*uint256.Int
-	Field94  []*big.Int
-	Field95  []*uint256.Int
-	Field96  []*big.Int
-	Field97  []*uint256.Int
-	Field98  []*big.Int
-	Field99  []*uint256.Int
-	Field100 []*big.Int
-	Field101 []*uint256.Int
-	Field102 []*big.Int
-	Field103 []*uint256.Int
-	Field104 []*big.Int
-	Field105 []*uint256.Int
-	Field106 []*big.Int
-	Field107 []*uint256.Int
-	Field108 []*big.Int
-	Field109 []*uint256.Int
-	Field110 []*big.Int
-	Field111 []*uint256.Int
-	Field112 []*big.Int
-	Field113 []*uint256.Int
-	Field114 []*big.Int
-	Field115 []*uint256.Int
-	Field116 []*big.Int
-	Field117 []*uint256.Int
-	Field118 []*big.Int
-	Field119 []*uint256.Int
-	Field120 []*big.Int
-	Field121 []*uint256.Int
-	Field122 []*big.Int
-	Field123 []*uint256.Int
-	Field124 []*big.Int
-	Field125 []*uint256.Int
-	Field126 []*big.Int
-	Field127 []*uint256.Int
-	Field128 []*big.Int
-}
-
-// EncodedSize returns the total encoded size of IntsCall
-func (t IntsCall) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += SizeUint8Slice(t.Field65)
-	dynamicSize += SizeInt8Slice(t.Field66)
-	dynamicSize += SizeUint16Slice(t.Field67)
-	dynamicSize += SizeInt16Slice(t.Field68)
-	dynamicSize += SizeUint24Slice(t.Field69)
-	dynamicSize += SizeInt24Slice(t.Field70)
-	dynamicSize += SizeUint32Slice(t.Field71)
-	dynamicSize += SizeInt32Slice(t.Field72)
-	dynamicSize += SizeUint40Slice(t.Field73)
-	dynamicSize += SizeInt40Slice(t.Field74)
-	dynamicSize += SizeUint48Slice(t.Field75)
-	dynamicSize += SizeInt48Slice(t.Field76)
-	dynamicSize += SizeUint56Slice(t.Field77)
-	dynamicSize += SizeInt56Slice(t.Field78)
-	dynamicSize += SizeUint64Slice(t.Field79)
-	dynamicSize += SizeInt64Slice(t.Field80)
-	dynamicSize += SizeUint72Slice(t.Field81)
-	dynamicSize += SizeInt72Slice(t.Field82)
-	dynamicSize += SizeUint80Slice(t.Field83)
-	dynamicSize += SizeInt80Slice(t.Field84)
-	dynamicSize += SizeUint88Slice(t.Field85)
-	dynamicSize += SizeInt88Slice(t.Field86)
-	dynamicSize += SizeUint96Slice(t.Field87)
-	dynamicSize += SizeInt96Slice(t.Field88)
-	dynamicSize += SizeUint104Slice(t.Field89)
-	dynamicSize += SizeInt104Slice(t.Field90)
-	dynamicSize += SizeUint112Slice(t.Field91)
-	dynamicSize += SizeInt112Slice(t.Field92)
-	dynamicSize += SizeUint120Slice(t.Field93)
-	dynamicSize += SizeInt120Slice(t.Field94)
-	dynamicSize += SizeUint128Slice(t.Field95)
-	dynamicSize += SizeInt128Slice(t.Field96)
-	dynamicSize += SizeUint136Slice(t.Field97)
-	dynamicSize += SizeInt136Slice(t.Field98)
-	dynamicSize += SizeUint144Slice(t.Field99)
-	dynamicSize += SizeInt144Slice(t.Field100)
-	dynamicSize += SizeUint152Slice(t.Field101)
-	dynamicSize += SizeInt152Slice(t.Field102)
-	dynamicSize += SizeUint160Slice(t.Field103)
-	dynamicSize += SizeInt160Slice(t.Field104)
-	dynamicSize += SizeUint168Slice(t.Field105)
-	dynamicSize += SizeInt168Slice(t.Field106)
-	dynamicSize += SizeUint176Slice(t.Field107)
-	dynamicSize += SizeInt176Slice(t.Field108)
-	dynamicSize += SizeUint184Slice(t.Field109)
-	dynamicSize += SizeInt184Slice(t.Field110)
-	dynamicSize += SizeUint192Slice(t.Field111)
-	dynamicSize += SizeInt192Slice(t.Field112)
-	dynamicSize += SizeUint200Slice(t.Field113)
-	dynamicSize += SizeInt200Slice(t.Field114)
-	dynamicSize += SizeUint208Slice(t.Field115)
-	dynamicSize += SizeInt208Slice(t.Field116)
-	dynamicSize += SizeUint216Slice(t.Field117)
-	dynamicSize += SizeInt216Slice(t.Field118)
-	dynamicSize += SizeUint224Slice(t.Field119)
-	dynamicSize += SizeInt224Slice(t.Field120)
-	dynamicSize += SizeUint232Slice(t.Field121)
-	dynamicSize += SizeInt232Slice(t.Field122)
-	dynamicSize += SizeUint240Slice(t.Field123)
-	dynamicSize += SizeInt240Slice(t.Field124)
-	dynamicSize += SizeUint248Slice(t.Field125)
-	dynamicSize += SizeInt248Slice(t.Field126)
-	dynamicSize += SizeUint256Slice(t.Field127)
-	dynamicSize += SizeInt256Slice(t.Field128)
-
-	return IntsCallStaticSize + dynamicSize
-}
-
-// EncodeTo encodes IntsCall to ABI bytes in the provided buffer
-func (value IntsCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := IntsCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Field1: uint8
-	if _, err := EncodeUint8(value.Field1, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field2: int8
-	if _, err := EncodeInt8(value.Field2, buf[32:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field3: uint16
-	if _, err := EncodeUint16(value.Field3, buf[64:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field4: int16
-	if _, err := EncodeInt16(value.Field4, buf[96:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field5: uint24
-	if _, err := EncodeUint24(value.Field5, buf[128:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field6: int24
-	if _, err := EncodeInt24(value.Field6, buf[160:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field7: uint32
-	if _, err := EncodeUint32(value.Field7, buf[192:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field8: int32
-	if _, err := EncodeInt32(value.Field8, buf[224:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field9: uint40
-	if _, err := EncodeUint40(value.Field9, buf[256:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field10: int40
-	if _, err := EncodeInt40(value.Field10, buf[288:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field11: uint48
-	if _, err := EncodeUint48(value.Field11, buf[320:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field12: int48
-	if _, err := EncodeInt48(value.Field12, buf[352:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field13: uint56
-	if _, err := EncodeUint56(value.Field13, buf[384:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field14: int56
-	if _, err := EncodeInt56(value.Field14, buf[416:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field15: uint64
-	if _, err := EncodeUint64(value.Field15, buf[448:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field16: int64
-	if _, err := EncodeInt64(value.Field16, buf[480:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field17: uint72
-	if _, err := EncodeUint72(value.Field17, buf[512:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field18: int72
-	if _, err := EncodeInt72(value.Field18, buf[544:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field19: uint80
-	if _, err := EncodeUint80(value.Field19, buf[576:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field20: int80
-	if _, err := EncodeInt80(value.Field20, buf[608:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field21: uint88
-	if _, err := EncodeUint88(value.Field21, buf[640:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field22: int88
-	if _, err := EncodeInt88(value.Field22, buf[672:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field23: uint96
-	if _, err := EncodeUint96(value.Field23, buf[704:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field24: int96
-	if _, err := EncodeInt96(value.Field24, buf[736:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field25: uint104
-	if _, err := EncodeUint104(value.Field25, buf[768:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field26: int104
-	if _, err := EncodeInt104(value.Field26, buf[800:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field27: uint112
-	if _, err := EncodeUint112(value.Field27, buf[832:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field28: int112
-	if _, err := EncodeInt112(value.Field28, buf[864:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field29: uint120
-	if _, err := EncodeUint120(value.Field29, buf[896:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field30: int120
-	if _, err := EncodeInt120(value.Field30, buf[928:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field31: uint128
-	if _, err := EncodeUint128(value.Field31, buf[960:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field32: int128
-	if _, err := EncodeInt128(value.Field32, buf[992:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field33: uint136
-	if _, err := EncodeUint136(value.Field33, buf[1024:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field34: int136
-	if _, err := EncodeInt136(value.Field34, buf[1056:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field35: uint144
-	if _, err := EncodeUint144(value.Field35, buf[1088:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field36: int144
-	if _, err := EncodeInt144(value.Field36, buf[1120:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field37: uint152
-	if _, err := EncodeUint152(value.Field37, buf[1152:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field38: int152
-	if _, err := EncodeInt152(value.Field38, buf[1184:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field39: uint160
-	if _, err := EncodeUint160(value.Field39, buf[1216:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field40: int160
-	if _, err := EncodeInt160(value.Field40, buf[1248:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field41: uint168
-	if _, err := EncodeUint168(value.Field41, buf[1280:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field42: int168
-	if _, err := EncodeInt168(value.Field42, buf[1312:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field43: uint176
-	if _, err := EncodeUint176(value.Field43, buf[1344:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field44: int176
-	if _, err := EncodeInt176(value.Field44, buf[1376:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field45: uint184
-	if _, err := EncodeUint184(value.Field45, buf[1408:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field46: int184
-	if _, err := EncodeInt184(value.Field46, buf[1440:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field47: uint192
-	if _, err := EncodeUint192(value.Field47, buf[1472:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field48: int192
-	if _, err := EncodeInt192(value.Field48, buf[1504:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field49: uint200
-	if _, err := EncodeUint200(value.Field49, buf[1536:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field50: int200
-	if _, err := EncodeInt200(value.Field50, buf[1568:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field51: uint208
-	if _, err := EncodeUint208(value.Field51, buf[1600:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field52: int208
-	if _, err := EncodeInt208(value.Field52, buf[1632:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field53: uint216
-	if _, err := EncodeUint216(value.Field53, buf[1664:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field54: int216
-	if _, err := EncodeInt216(value.Field54, buf[1696:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field55: uint224
-	if _, err := EncodeUint224(value.Field55, buf[1728:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field56: int224
-	if _, err := EncodeInt224(value.Field56, buf[1760:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field57: uint232
-	if _, err := EncodeUint232(value.Field57, buf[1792:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field58: int232
-	if _, err := EncodeInt232(value.Field58, buf[1824:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field59: uint240
-	if _, err := EncodeUint240(value.Field59, buf[1856:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field60: int240
-	if _, err := EncodeInt240(value.Field60, buf[1888:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field61: uint248
-	if _, err := EncodeUint248(value.Field61, buf[1920:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field62: int248
-	if _, err := EncodeInt248(value.Field62, buf[1952:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field63: uint256
-	if _, err := EncodeUint256(value.Field63, buf[1984:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field64: int256
-	if _, err := EncodeInt256(value.Field64, buf[2016:]); err != nil {
-		return 0, err
-	}
-
-	// Field Field65: uint8[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2048+24:2048+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint8Slice(value.Field65, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field66: int8[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2080+24:2080+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt8Slice(value.Field66, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field67: uint16[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2112+24:2112+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint16Slice(value.Field67, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field68: int16[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2144+24:2144+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt16Slice(value.Field68, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field69: uint24[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2176+24:2176+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint24Slice(value.Field69, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field70: int24[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2208+24:2208+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt24Slice(value.Field70, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field71: uint32[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2240+24:2240+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint32Slice(value.Field71, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field72: int32[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2272+24:2272+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt32Slice(value.Field72, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field73: uint40[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2304+24:2304+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint40Slice(value.Field73, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field74: int40[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2336+24:2336+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt40Slice(value.Field74, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field75: uint48[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2368+24:2368+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint48Slice(value.Field75, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field76: int48[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2400+24:2400+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt48Slice(value.Field76, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field77: uint56[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2432+24:2432+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint56Slice(value.Field77, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field78: int56[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2464+24:2464+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt56Slice(value.Field78, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field79: uint64[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2496+24:2496+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint64Slice(value.Field79, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field80: int64[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2528+24:2528+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt64Slice(value.Field80, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field81: uint72[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2560+24:2560+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint72Slice(value.Field81, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field82: int72[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2592+24:2592+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt72Slice(value.Field82, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field83: uint80[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2624+24:2624+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint80Slice(value.Field83, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field84: int80[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2656+24:2656+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt80Slice(value.Field84, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field85: uint88[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2688+24:2688+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint88Slice(value.Field85, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field86: int88[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2720+24:2720+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt88Slice(value.Field86, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field87: uint96[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2752+24:2752+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint96Slice(value.Field87, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field88: int96[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2784+24:2784+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt96Slice(value.Field88, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field89: uint104[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2816+24:2816+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint104Slice(value.Field89, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field90: int104[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2848+24:2848+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt104Slice(value.Field90, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field91: uint112[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2880+24:2880+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint112Slice(value.Field91, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field92: int112[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2912+24:2912+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt112Slice(value.Field92, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field93: uint120[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2944+24:2944+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint120Slice(value.Field93, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field94: int120[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[2976+24:2976+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt120Slice(value.Field94, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field95: uint128[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3008+24:3008+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint128Slice(value.Field95, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field96: int128[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3040+24:3040+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt128Slice(value.Field96, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field97: uint136[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3072+24:3072+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint136Slice(value.Field97, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field98: int136[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3104+24:3104+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt136Slice(value.Field98, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field99: uint144[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3136+24:3136+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint144Slice(value.Field99, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field100: int144[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3168+24:3168+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt144Slice(value.Field100, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field101: uint152[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3200+24:3200+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint152Slice(value.Field101, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field102: int152[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3232+24:3232+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt152Slice(value.Field102, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field103: uint160[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3264+24:3264+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint160Slice(value.Field103, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field104: int160[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3296+24:3296+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt160Slice(value.Field104, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field105: uint168[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3328+24:3328+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint168Slice(value.Field105, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field106: int168[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3360+24:3360+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt168Slice(value.Field106, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field107: uint176[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3392+24:3392+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint176Slice(value.Field107, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field108: int176[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3424+24:3424+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt176Slice(value.Field108, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field109: uint184[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3456+24:3456+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint184Slice(value.Field109, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field110: int184[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3488+24:3488+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt184Slice(value.Field110, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field111: uint192[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3520+24:3520+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint192Slice(value.Field111, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field112: int192[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3552+24:3552+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt192Slice(value.Field112, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field113: uint200[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3584+24:3584+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint200Slice(value.Field113, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field114: int200[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3616+24:3616+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt200Slice(value.Field114, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field115: uint208[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3648+24:3648+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint208Slice(value.Field115, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field116: int208[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3680+24:3680+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt208Slice(value.Field116, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field117: uint216[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3712+24:3712+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint216Slice(value.Field117, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field118: int216[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3744+24:3744+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt216Slice(value.Field118, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field119: uint224[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3776+24:3776+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint224Slice(value.Field119, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field120: int224[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3808+24:3808+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt224Slice(value.Field120, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field121: uint232[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3840+24:3840+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint232Slice(value.Field121, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field122: int232[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3872+24:3872+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt232Slice(value.Field122, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field123: uint240[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3904+24:3904+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint240Slice(value.Field123, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field124: int240[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3936+24:3936+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt240Slice(value.Field124, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field125: uint248[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[3968+24:3968+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint248Slice(value.Field125, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field126: int248[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[4000+24:4000+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt248Slice(value.Field126, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field127: uint256[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[4032+24:4032+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint256Slice(value.Field127, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	// Field Field128: int256[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[4064+24:4064+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeInt256Slice(value.Field128, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	return dynamicOffset, nil
-}
-
-// Encode encodes IntsCall to ABI bytes
-func (value IntsCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// Decode decodes IntsCall from ABI bytes in the provided buffer
-func (t *IntsCall) Decode(data []byte) (int, error) {
-	if len(data) < 4096 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var (
-		err    error
-		n      int
-		offset int
-	)
-	dynamicOffset := 4096
-	// Decode static field Field1: uint8
-	t.Field1, _, err = DecodeUint8(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field2: int8
-	t.Field2, _, err = DecodeInt8(data[32:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field3: uint16
-	t.Field3, _, err = DecodeUint16(data[64:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field4: int16
-	t.Field4, _, err = DecodeInt16(data[96:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field5: uint24
-	t.Field5, _, err = DecodeUint24(data[128:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field6: int24
-	t.Field6, _, err = DecodeInt24(data[160:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field7: uint32
-	t.Field7, _, err = DecodeUint32(data[192:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field8: int32
-	t.Field8, _, err = DecodeInt32(data[224:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field9: uint40
-	t.Field9, _, err = DecodeUint40(data[256:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field10: int40
-	t.Field10, _, err = DecodeInt40(data[288:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field11: uint48
-	t.Field11, _, err = DecodeUint48(data[320:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field12: int48
-	t.Field12, _, err = DecodeInt48(data[352:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field13: uint56
-	t.Field13, _, err = DecodeUint56(data[384:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field14: int56
-	t.Field14, _, err = DecodeInt56(data[416:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field15: uint64
-	t.Field15, _, err = DecodeUint64(data[448:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field16: int64
-	t.Field16, _, err = DecodeInt64(data[480:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field17: uint72
-	t.Field17, _, err = DecodeUint72(data[512:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field18: int72
-	t.Field18, _, err = DecodeInt72(data[544:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field19: uint80
-	t.Field19, _, err = DecodeUint80(data[576:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field20: int80
-	t.Field20, _, err = DecodeInt80(data[608:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field21: uint88
-	t.Field21, _, err = DecodeUint88(data[640:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field22: int88
-	t.Field22, _, err = DecodeInt88(data[672:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field23: uint96
-	t.Field23, _, err = DecodeUint96(data[704:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field24: int96
-	t.Field24, _, err = DecodeInt96(data[736:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field25: uint104
-	t.Field25, _, err = DecodeUint104(data[768:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field26: int104
-	t.Field26, _, err = DecodeInt104(data[800:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field27: uint112
-	t.Field27, _, err = DecodeUint112(data[832:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field28: int112
-	t.Field28, _, err = DecodeInt112(data[864:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field29: uint120
-	t.Field29, _, err = DecodeUint120(data[896:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field30: int120
-	t.Field30, _, err = DecodeInt120(data[928:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field31: uint128
-	t.Field31, _, err = DecodeUint128(data[960:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field32: int128
-	t.Field32, _, err = DecodeInt128(data[992:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field33: uint136
-	t.Field33, _, err = DecodeUint136(data[1024:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field34: int136
-	t.Field34, _, err = DecodeInt136(data[1056:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field35: uint144
-	t.Field35, _, err = DecodeUint144(data[1088:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field36: int144
-	t.Field36, _, err = DecodeInt144(data[1120:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field37: uint152
-	t.Field37, _, err = DecodeUint152(data[1152:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field38: int152
-	t.Field38, _, err = DecodeInt152(data[1184:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field39: uint160
-	t.Field39, _, err = DecodeUint160(data[1216:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field40: int160
-	t.Field40, _, err = DecodeInt160(data[1248:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field41: uint168
-	t.Field41, _, err = DecodeUint168(data[1280:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field42: int168
-	t.Field42, _, err = DecodeInt168(data[1312:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field43: uint176
-	t.Field43, _, err = DecodeUint176(data[1344:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field44: int176
-	t.Field44, _, err = DecodeInt176(data[1376:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field45: uint184
-	t.Field45, _, err = DecodeUint184(data[1408:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field46: int184
-	t.Field46, _, err = DecodeInt184(data[1440:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field47: uint192
-	t.Field47, _, err = DecodeUint192(data[1472:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field48: int192
-	t.Field48, _, err = DecodeInt192(data[1504:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field49: uint200
-	t.Field49, _, err = DecodeUint200(data[1536:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field50: int200
-	t.Field50, _, err = DecodeInt200(data[1568:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field51: uint208
-	t.Field51, _, err = DecodeUint208(data[1600:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field52: int208
-	t.Field52, _, err = DecodeInt208(data[1632:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field53: uint216
-	t.Field53, _, err = DecodeUint216(data[1664:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field54: int216
-	t.Field54, _, err = DecodeInt216(data[1696:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field55: uint224
-	t.Field55, _, err = DecodeUint224(data[1728:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field56: int224
-	t.Field56, _, err = DecodeInt224(data[1760:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field57: uint232
-	t.Field57, _, err = DecodeUint232(data[1792:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field58: int232
-	t.Field58, _, err = DecodeInt232(data[1824:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field59: uint240
-	t.Field59, _, err = DecodeUint240(data[1856:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field60: int240
-	t.Field60, _, err = DecodeInt240(data[1888:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field61: uint248
-	t.Field61, _, err = DecodeUint248(data[1920:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field62: int248
-	t.Field62, _, err = DecodeInt248(data[1952:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field63: uint256
-	t.Field63, _, err = DecodeUint256(data[1984:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field Field64: int256
-	t.Field64, _, err = DecodeInt256(data[2016:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode dynamic field Field65
-	{
-		offset, err = DecodeSize(data[2048:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field65, n, err = DecodeUint8Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field66
-	{
-		offset, err = DecodeSize(data[2080:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field66, n, err = DecodeInt8Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field67
-	{
-		offset, err = DecodeSize(data[2112:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field67, n, err = DecodeUint16Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field68
-	{
-		offset, err = DecodeSize(data[2144:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field68, n, err = DecodeInt16Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field69
-	{
-		offset, err = DecodeSize(data[2176:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field69, n, err = DecodeUint24Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field70
-	{
-		offset, err = DecodeSize(data[2208:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field70, n, err = DecodeInt24Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field71
-	{
-		offset, err = DecodeSize(data[2240:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field71, n, err = DecodeUint32Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field72
-	{
-		offset, err = DecodeSize(data[2272:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field72, n, err = DecodeInt32Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field73
-	{
-		offset, err = DecodeSize(data[2304:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field73, n, err = DecodeUint40Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field74
-	{
-		offset, err = DecodeSize(data[2336:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field74, n, err = DecodeInt40Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field75
-	{
-		offset, err = DecodeSize(data[2368:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field75, n, err = DecodeUint48Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field76
-	{
-		offset, err = DecodeSize(data[2400:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field76, n, err = DecodeInt48Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field77
-	{
-		offset, err = DecodeSize(data[2432:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field77, n, err = DecodeUint56Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field78
-	{
-		offset, err = DecodeSize(data[2464:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field78, n, err = DecodeInt56Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field79
-	{
-		offset, err = DecodeSize(data[2496:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field79, n, err = DecodeUint64Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field80
-	{
-		offset, err = DecodeSize(data[2528:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field80, n, err = DecodeInt64Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field81
-	{
-		offset, err = DecodeSize(data[2560:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field81, n, err = DecodeUint72Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field82
-	{
-		offset, err = DecodeSize(data[2592:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field82, n, err = DecodeInt72Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field83
-	{
-		offset, err = DecodeSize(data[2624:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field83, n, err = DecodeUint80Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field84
-	{
-		offset, err = DecodeSize(data[2656:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field84, n, err = DecodeInt80Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field85
-	{
-		offset, err = DecodeSize(data[2688:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field85, n, err = DecodeUint88Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field86
-	{
-		offset, err = DecodeSize(data[2720:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field86, n, err = DecodeInt88Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field87
-	{
-		offset, err = DecodeSize(data[2752:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field87, n, err = DecodeUint96Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field88
-	{
-		offset, err = DecodeSize(data[2784:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field88, n, err = DecodeInt96Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field89
-	{
-		offset, err = DecodeSize(data[2816:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field89, n, err = DecodeUint104Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field90
-	{
-		offset, err = DecodeSize(data[2848:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field90, n, err = DecodeInt104Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field91
-	{
-		offset, err = DecodeSize(data[2880:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field91, n, err = DecodeUint112Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field92
-	{
-		offset, err = DecodeSize(data[2912:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field92, n, err = DecodeInt112Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field93
-	{
-		offset, err = DecodeSize(data[2944:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field93, n, err = DecodeUint120Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field Field94
-	{
-		offset, err = DecodeSize(data[2976:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field94, n, err = DecodeInt120Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+		return 0, err
 	}
-	// Decode dynamic field Field95
-	{
-		offset, err = DecodeSize(data[3008:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field95, n, err = DecodeUint128Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field13: uint168
+	t.Field13, _, err = DecodeIntoUint168U256(t.Field13, data[384:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field96
-	{
-		offset, err = DecodeSize(data[3040:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field96, n, err = DecodeInt128Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field14: uint176
+	t.Field14, _, err = DecodeIntoUint176U256(t.Field14, data[416:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field97
-	{
-		offset, err = DecodeSize(data[3072:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field97, n, err = DecodeUint136Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field15: uint184
+	t.Field15, _, err = DecodeIntoUint184U256(t.Field15, data[448:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field98
-	{
-		offset, err = DecodeSize(data[3104:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field98, n, err = DecodeInt136Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field16: uint192
+	t.Field16, _, err = DecodeIntoUint192U256(t.Field16, data[480:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field99
-	{
-		offset, err = DecodeSize(data[3136:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field99, n, err = DecodeUint144Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field17: uint200
+	t.Field17, _, err = DecodeIntoUint200U256(t.Field17, data[512:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field100
-	{
-		offset, err = DecodeSize(data[3168:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field100, n, err = DecodeInt144Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field18: uint208
+	t.Field18, _, err = DecodeIntoUint208U256(t.Field18, data[544:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field101
-	{
-		offset, err = DecodeSize(data[3200:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field101, n, err = DecodeUint152Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field19: uint216
+	t.Field19, _, err = DecodeIntoUint216U256(t.Field19, data[576:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field102
-	{
-		offset, err = DecodeSize(data[3232:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field102, n, err = DecodeInt152Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field20: uint224
+	t.Field20, _, err = DecodeIntoUint224U256(t.Field20, data[608:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field103
-	{
-		offset, err = DecodeSize(data[3264:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field103, n, err = DecodeUint160Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field21: uint232
+	t.Field21, _, err = DecodeIntoUint232U256(t.Field21, data[640:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field104
-	{
-		offset, err = DecodeSize(data[3296:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, ErrInvalidOffsetForDynamicField
-		}
-		t.Field104, n, err = DecodeInt160Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Field22: uint240
+	t.Field22, _, err = DecodeIntoUint240U256(t.Field22, data[672:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Field23: uint248
+	t.Field23, _, err = DecodeIntoUint248U256(t.Field23, data[704:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Field24: uint256
+	t.Field24, _, err = DecodeIntoUint256U256(t.Field24, data[736:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Field105
+	// Decode dynamic field Field25
 	{
-		offset, err = DecodeSize(data[3328:])
+		offset, err = DecodeSize(data[768:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field105, n, err = DecodeUint168Slice(data[dynamicOffset:])
+		t.Field25, n, err = DecodeUint72SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field106
+	// Decode dynamic field Field26
 	{
-		offset, err = DecodeSize(data[3360:])
+		offset, err = DecodeSize(data[800:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field106, n, err = DecodeInt168Slice(data[dynamicOffset:])
+		t.Field26, n, err = DecodeUint80SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field107
+	// Decode dynamic field Field27
 	{
-		offset, err = DecodeSize(data[3392:])
+		offset, err = DecodeSize(data[832:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field107, n, err = DecodeUint176Slice(data[dynamicOffset:])
+		t.Field27, n, err = DecodeUint88SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field108
+	// Decode dynamic field Field28
 	{
-		offset, err = DecodeSize(data[3424:])
+		offset, err = DecodeSize(data[864:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field108, n, err = DecodeInt176Slice(data[dynamicOffset:])
+		t.Field28, n, err = DecodeUint96SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field109
+	// Decode dynamic field Field29
 	{
-		offset, err = DecodeSize(data[3456:])
+		offset, err = DecodeSize(data[896:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field109, n, err = DecodeUint184Slice(data[dynamicOffset:])
+		t.Field29, n, err = DecodeUint104SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field110
+	// Decode dynamic field Field30
 	{
-		offset, err = DecodeSize(data[3488:])
+		offset, err = DecodeSize(data[928:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field110, n, err = DecodeInt184Slice(data[dynamicOffset:])
+		t.Field30, n, err = DecodeUint112SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field111
+	// Decode dynamic field Field31
 	{
-		offset, err = DecodeSize(data[3520:])
+		offset, err = DecodeSize(data[960:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field111, n, err = DecodeUint192Slice(data[dynamicOffset:])
+		t.Field31, n, err = DecodeUint120SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field112
+	// Decode dynamic field Field32
 	{
-		offset, err = DecodeSize(data[3552:])
+		offset, err = DecodeSize(data[992:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field112, n, err = DecodeInt192Slice(data[dynamicOffset:])
+		t.Field32, n, err = DecodeUint128SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field113
+	// Decode dynamic field Field33
 	{
-		offset, err = DecodeSize(data[3584:])
+		offset, err = DecodeSize(data[1024:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field113, n, err = DecodeUint200Slice(data[dynamicOffset:])
+		t.Field33, n, err = DecodeUint136SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field114
+	// Decode dynamic field Field34
 	{
-		offset, err = DecodeSize(data[3616:])
+		offset, err = DecodeSize(data[1056:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field114, n, err = DecodeInt200Slice(data[dynamicOffset:])
+		t.Field34, n, err = DecodeUint144SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field115
+	// Decode dynamic field Field35
 	{
-		offset, err = DecodeSize(data[3648:])
+		offset, err = DecodeSize(data[1088:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field115, n, err = DecodeUint208Slice(data[dynamicOffset:])
+		t.Field35, n, err = DecodeUint152SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field116
+	// Decode dynamic field Field36
 	{
-		offset, err = DecodeSize(data[3680:])
+		offset, err = DecodeSize(data[1120:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field116, n, err = DecodeInt208Slice(data[dynamicOffset:])
+		t.Field36, n, err = DecodeUint160SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field117
+	// Decode dynamic field Field37
 	{
-		offset, err = DecodeSize(data[3712:])
+		offset, err = DecodeSize(data[1152:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field117, n, err = DecodeUint216Slice(data[dynamicOffset:])
+		t.Field37, n, err = DecodeUint168SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field118
+	// Decode dynamic field Field38
 	{
-		offset, err = DecodeSize(data[3744:])
+		offset, err = DecodeSize(data[1184:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field118, n, err = DecodeInt216Slice(data[dynamicOffset:])
+		t.Field38, n, err = DecodeUint176SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field119
+	// Decode dynamic field Field39
 	{
-		offset, err = DecodeSize(data[3776:])
+		offset, err = DecodeSize(data[1216:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field119, n, err = DecodeUint224Slice(data[dynamicOffset:])
+		t.Field39, n, err = DecodeUint184SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field120
+	// Decode dynamic field Field40
 	{
-		offset, err = DecodeSize(data[3808:])
+		offset, err = DecodeSize(data[1248:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field120, n, err = DecodeInt224Slice(data[dynamicOffset:])
+		t.Field40, n, err = DecodeUint192SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field121
+	// Decode dynamic field Field41
 	{
-		offset, err = DecodeSize(data[3840:])
+		offset, err = DecodeSize(data[1280:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field121, n, err = DecodeUint232Slice(data[dynamicOffset:])
+		t.Field41, n, err = DecodeUint200SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field122
+	// Decode dynamic field Field42
 	{
-		offset, err = DecodeSize(data[3872:])
+		offset, err = DecodeSize(data[1312:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field122, n, err = DecodeInt232Slice(data[dynamicOffset:])
+		t.Field42, n, err = DecodeUint208SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field123
+	// Decode dynamic field Field43
 	{
-		offset, err = DecodeSize(data[3904:])
+		offset, err = DecodeSize(data[1344:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field123, n, err = DecodeUint240Slice(data[dynamicOffset:])
+		t.Field43, n, err = DecodeUint216SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field124
+	// Decode dynamic field Field44
 	{
-		offset, err = DecodeSize(data[3936:])
+		offset, err = DecodeSize(data[1376:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field124, n, err = DecodeInt240Slice(data[dynamicOffset:])
+		t.Field44, n, err = DecodeUint224SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field125
+	// Decode dynamic field Field45
 	{
-		offset, err = DecodeSize(data[3968:])
+		offset, err = DecodeSize(data[1408:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field125, n, err = DecodeUint248Slice(data[dynamicOffset:])
+		t.Field45, n, err = DecodeUint232SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field126
+	// Decode dynamic field Field46
 	{
-		offset, err = DecodeSize(data[4000:])
+		offset, err = DecodeSize(data[1440:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field126, n, err = DecodeInt248Slice(data[dynamicOffset:])
+		t.Field46, n, err = DecodeUint240SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field127
+	// Decode dynamic field Field47
 	{
-		offset, err = DecodeSize(data[4032:])
+		offset, err = DecodeSize(data[1472:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field127, n, err = DecodeUint256Slice(data[dynamicOffset:])
+		t.Field47, n, err = DecodeUint248SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Field128
+	// Decode dynamic field Field48
 	{
-		offset, err = DecodeSize(data[4064:])
+		offset, err = DecodeSize(data[1504:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, ErrInvalidOffsetForDynamicField
 		}
-		t.Field128, n, err = DecodeInt256Slice(data[dynamicOffset:])
+		t.Field48, n, err = DecodeUint256SliceU256(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -13635,295 +4371,214 @@ func (t *IntsCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UintsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *UintsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UintsCall from the hex string with an optional 0x prefix
+func (t *UintsCall) DecodeHex(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UintsCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ Tuple = (*UintsCall)(nil)
+var _ Decoder = (*UintsCall)(nil)
+
 // GetMethodName returns the function name
-func (t IntsCall) GetMethodName() string {
-	return "ints"
+func (t UintsCall) GetMethodName() string {
+	return "uints"
 }
 
 // GetMethodID returns the function id
-func (t IntsCall) GetMethodID() uint32 {
-	return IntsID
+func (t UintsCall) GetMethodID() uint32 {
+	return UintsID
 }
 
 // GetMethodSelector returns the function selector
-func (t IntsCall) GetMethodSelector() [4]byte {
-	return IntsSelector
+func (t UintsCall) GetMethodSelector() [4]byte {
+	return UintsSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of uints arguments including function selector
+func (t UintsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
 }
 
-// EncodeWithSelector encodes ints arguments to ABI bytes including function selector
-func (t IntsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], IntsSelector[:])
+// EncodeWithSelector encodes uints arguments to ABI bytes including function selector
+func (t UintsCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], UintsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewIntsCall constructs a new IntsCall
-func NewIntsCall(
-	field1 uint8,
-	field2 int8,
-	field3 uint16,
-	field4 int16,
-	field5 uint32,
-	field6 int32,
-	field7 uint32,
-	field8 int32,
-	field9 uint64,
-	field10 int64,
-	field11 uint64,
-	field12 int64,
-	field13 uint64,
-	field14 int64,
-	field15 uint64,
-	field16 int64,
+// EncodeHex encodes uints arguments to 0x prefixed hex string
+func (t UintsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes uints arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t UintsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the uints calldata, returns 0 if encoding fails
+func (t UintsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes uints arguments from ABI bytes including function selector
+func (t *UintsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != UintsSelector {
+		return 0, ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes uints arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *UintsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UintsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewUintsCall constructs a new UintsCall
+func NewUintsCall(
+	field1 *uint256.Int,
+	field2 *uint256.Int,
+	field3 *uint256.Int,
+	field4 *uint256.Int,
+	field5 *uint256.Int,
+	field6 *uint256.Int,
+	field7 *uint256.Int,
+	field8 *uint256.Int,
+	field9 *uint256.Int,
+	field10 *uint256.Int,
+	field11 *uint256.Int,
+	field12 *uint256.Int,
+	field13 *uint256.Int,
+	field14 *uint256.Int,
+	field15 *uint256.Int,
+	field16 *uint256.Int,
 	field17 *uint256.Int,
-	field18 *big.Int,
+	field18 *uint256.Int,
 	field19 *uint256.Int,
-	field20 *big.Int,
+	field20 *uint256.Int,
 	field21 *uint256.Int,
-	field22 *big.Int,
+	field22 *uint256.Int,
 	field23 *uint256.Int,
-	field24 *big.Int,
-	field25 *uint256.Int,
-	field26 *big.Int,
-	field27 *uint256.Int,
-	field28 *big.Int,
-	field29 *uint256.Int,
-	field30 *big.Int,
-	field31 *uint256.Int,
-	field32 *big.Int,
-	field33 *uint256.Int,
-	field34 *big.Int,
-	field35 *uint256.Int,
-	field36 *big.Int,
-	field37 *uint256.Int,
-	field38 *big.Int,
-	field39 *uint256.Int,
-	field40 *big.Int,
-	field41 *uint256.Int,
-	field42 *big.Int,
-	field43 *uint256.Int,
-	field44 *big.Int,
-	field45 *uint256.Int,
-	field46 *big.Int,
-	field47 *uint256.Int,
-	field48 *big.Int,
-	field49 *uint256.Int,
-	field50 *big.Int,
-	field51 *uint256.Int,
-	field52 *big.Int,
-	field53 *uint256.Int,
-	field54 *big.Int,
-	field55 *uint256.Int,
-	field56 *big.Int,
-	field57 *uint256.Int,
-	field58 *big.Int,
-	field59 *uint256.Int,
-	field60 *big.Int,
-	field61 *uint256.Int,
-	field62 *big.Int,
-	field63 *uint256.Int,
-	field64 *big.Int,
-	field65 []uint8,
-	field66 []int8,
-	field67 []uint16,
-	field68 []int16,
-	field69 []uint32,
-	field70 []int32,
-	field71 []uint32,
-	field72 []int32,
-	field73 []uint64,
-	field74 []int64,
-	field75 []uint64,
-	field76 []int64,
-	field77 []uint64,
-	field78 []int64,
-	field79 []uint64,
-	field80 []int64,
-	field81 []*uint256.Int,
-	field82 []*big.Int,
-	field83 []*uint256.Int,
-	field84 []*big.Int,
-	field85 []*uint256.Int,
-	field86 []*big.Int,
-	field87 []*uint256.Int,
-	field88 []*big.Int,
-	field89 []*uint256.Int,
-	field90 []*big.Int,
-	field91 []*uint256.Int,
-	field92 []*big.Int,
-	field93 []*uint256.Int,
-	field94 []*big.Int,
-	field95 []*uint256.Int,
-	field96 []*big.Int,
-	field97 []*uint256.Int,
-	field98 []*big.Int,
-	field99 []*uint256.Int,
-	field100 []*big.Int,
-	field101 []*uint256.Int,
-	field102 []*big.Int,
-	field103 []*uint256.Int,
-	field104 []*big.Int,
-	field105 []*uint256.Int,
-	field106 []*big.Int,
-	field107 []*uint256.Int,
-	field108 []*big.Int,
-	field109 []*uint256.Int,
-	field110 []*big.Int,
-	field111 []*uint256.Int,
-	field112 []*big.Int,
-	field113 []*uint256.Int,
-	field114 []*big.Int,
-	field115 []*uint256.Int,
-	field116 []*big.Int,
-	field117 []*uint256.Int,
-	field118 []*big.Int,
-	field119 []*uint256.Int,
-	field120 []*big.Int,
-	field121 []*uint256.Int,
-	field122 []*big.Int,
-	field123 []*uint256.Int,
-	field124 []*big.Int,
-	field125 []*uint256.Int,
-	field126 []*big.Int,
-	field127 []*uint256.Int,
-	field128 []*big.Int,
-) *IntsCall {
-	return &IntsCall{
-		Field1:   field1,
-		Field2:   field2,
-		Field3:   field3,
-		Field4:   field4,
-		Field5:   field5,
-		Field6:   field6,
-		Field7:   field7,
-		Field8:   field8,
-		Field9:   field9,
-		Field10:  field10,
-		Field11:  field11,
-		Field12:  field12,
-		Field13:  field13,
-		Field14:  field14,
-		Field15:  field15,
-		Field16:  field16,
-		Field17:  field17,
-		Field18:  field18,
-		Field19:  field19,
-		Field20:  field20,
-		Field21:  field21,
-		Field22:  field22,
-		Field23:  field23,
-		Field24:  field24,
-		Field25:  field25,
-		Field26:  field26,
-		Field27:  field27,
-		Field28:  field28,
-		Field29:  field29,
-		Field30:  field30,
-		Field31:  field31,
-		Field32:  field32,
-		Field33:  field33,
-		Field34:  field34,
-		Field35:  field35,
-		Field36:  field36,
-		Field37:  field37,
-		Field38:  field38,
-		Field39:  field39,
-		Field40:  field40,
-		Field41:  field41,
-		Field42:  field42,
-		Field43:  field43,
-		Field44:  field44,
-		Field45:  field45,
-		Field46:  field46,
-		Field47:  field47,
-		Field48:  field48,
-		Field49:  field49,
-		Field50:  field50,
-		Field51:  field51,
-		Field52:  field52,
-		Field53:  field53,
-		Field54:  field54,
-		Field55:  field55,
-		Field56:  field56,
-		Field57:  field57,
-		Field58:  field58,
-		Field59:  field59,
-		Field60:  field60,
-		Field61:  field61,
-		Field62:  field62,
-		Field63:  field63,
-		Field64:  field64,
-		Field65:  field65,
-		Field66:  field66,
-		Field67:  field67,
-		Field68:  field68,
-		Field69:  field69,
-		Field70:  field70,
-		Field71:  field71,
-		Field72:  field72,
-		Field73:  field73,
-		Field74:  field74,
-		Field75:  field75,
-		Field76:  field76,
-		Field77:  field77,
-		Field78:  field78,
-		Field79:  field79,
-		Field80:  field80,
-		Field81:  field81,
-		Field82:  field82,
-		Field83:  field83,
-		Field84:  field84,
-		Field85:  field85,
-		Field86:  field86,
-		Field87:  field87,
-		Field88:  field88,
-		Field89:  field89,
-		Field90:  field90,
-		Field91:  field91,
-		Field92:  field92,
-		Field93:  field93,
-		Field94:  field94,
-		Field95:  field95,
-		Field96:  field96,
-		Field97:  field97,
-		Field98:  field98,
-		Field99:  field99,
-		Field100: field100,
-		Field101: field101,
-		Field102: field102,
-		Field103: field103,
-		Field104: field104,
-		Field105: field105,
-		Field106: field106,
-		Field107: field107,
-		Field108: field108,
-		Field109: field109,
-		Field110: field110,
-		Field111: field111,
-		Field112: field112,
-		Field113: field113,
-		Field114: field114,
-		Field115: field115,
-		Field116: field116,
-		Field117: field117,
-		Field118: field118,
-		Field119: field119,
-		Field120: field120,
-		Field121: field121,
-		Field122: field122,
-		Field123: field123,
-		Field124: field124,
-		Field125: field125,
-		Field126: field126,
-		Field127: field127,
-		Field128: field128,
-	}
-}
-
-// IntsReturn represents the output arguments for ints function
-type IntsReturn struct {
+	field24 *uint256.Int,
+	field25 []*uint256.Int,
+	field26 []*uint256.Int,
+	field27 []*uint256.Int,
+	field28 []*uint256.Int,
+	field29 []*uint256.Int,
+	field30 []*uint256.Int,
+	field31 []*uint256.Int,
+	field32 []*uint256.Int,
+	field33 []*uint256.Int,
+	field34 []*uint256.Int,
+	field35 []*uint256.Int,
+	field36 []*uint256.Int,
+	field37 []*uint256.Int,
+	field38 []*uint256.Int,
+	field39 []*uint256.Int,
+	field40 []*uint256.Int,
+	field41 []*uint256.Int,
+	field42 []*uint256.Int,
+	field43 []*uint256.Int,
+	field44 []*uint256.Int,
+	field45 []*uint256.Int,
+	field46 []*uint256.Int,
+	field47 []*uint256.Int,
+	field48 []*uint256.Int,
+) *UintsCall {
+	return &UintsCall{
+		Field1:  field1,
+		Field2:  field2,
+		Field3:  field3,
+		Field4:  field4,
+		Field5:  field5,
+		Field6:  field6,
+		Field7:  field7,
+		Field8:  field8,
+		Field9:  field9,
+		Field10: field10,
+		Field11: field11,
+		Field12: field12,
+		Field13: field13,
+		Field14: field14,
+		Field15: field15,
+		Field16: field16,
+		Field17: field17,
+		Field18: field18,
+		Field19: field19,
+		Field20: field20,
+		Field21: field21,
+		Field22: field22,
+		Field23: field23,
+		Field24: field24,
+		Field25: field25,
+		Field26: field26,
+		Field27: field27,
+		Field28: field28,
+		Field29: field29,
+		Field30: field30,
+		Field31: field31,
+		Field32: field32,
+		Field33: field33,
+		Field34: field34,
+		Field35: field35,
+		Field36: field36,
+		Field37: field37,
+		Field38: field38,
+		Field39: field39,
+		Field40: field40,
+		Field41: field41,
+		Field42: field42,
+		Field43: field43,
+		Field44: field44,
+		Field45: field45,
+		Field46: field46,
+		Field47: field47,
+		Field48: field48,
+	}
+}
+
+// UintsReturn represents the output arguments for uints function
+type UintsReturn struct {
 	EmptyTuple
 }
@@ -4,18 +4,24 @@ package tests
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
 	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/test-go/testify/require"
 
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/yihuang/go-abi"
 )
 
-//go:generate go run ../cmd -var TestABI -output test.abi.go -prefix test -buildtag=!uint256
-//go:generate go run ../cmd -var TestABI -output test_uint256.abi.go -prefix test -buildtag=uint256 -uint256
+//go:generate go run ../cmd -var TestABI -output test.abi.go -prefix test -buildtag=!uint256 -testhelpers
+//go:generate go run ../cmd -var TestABI -output test_uint256.abi.go -prefix test -buildtag=uint256 -uint256 -testhelpers
 
 // TestABI contains human-readable ABI definitions for testing
 var TestABI = []string{
@@ -35,7 +41,9 @@ var TestABI = []string{
 	"function communityPool() view returns ((string denom, uint256 amount)[] coins)",
 	"event DynamicIndexed(string indexed denom)",
 	"event EmptyIndexed(string denom)",
+	"event HashedIndexed(bytes indexed data, uint256[10] indexed balances, address indexed owner)",
 	"function emptyArgs() returns ()",
+	"function totalSupply() view returns (uint256)",
 	"function understore(string _name) returns ()",
 	"function multiTransfer(address[] recipients, uint256[] amounts)",
 }
@@ -68,6 +76,8 @@ func TestTransferEncoding(t *testing.T) {
 	encoded, err := args.EncodeWithSelector()
 	require.NoError(t, err)
 
+	require.Equal(t, len(encoded), args.EncodedSizeWithSelector())
+
 	// Get go-ethereum encoding
 	goEthEncoded, err := TestABIDef.Pack("transfer", to, amount)
 	require.NoError(t, err)
@@ -77,6 +87,125 @@ func TestTransferEncoding(t *testing.T) {
 	DecodeRoundTrip(t, args)
 }
 
+func TestTransferCalldataCost(t *testing.T) {
+	args := &TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+
+	// selector a9059cbb: 4 non-zero bytes
+	// address: 12 zero bytes of padding, 20 non-zero bytes
+	// amount 0x03e8: 30 zero bytes, 2 non-zero bytes
+	zeros, nonZeros := uint64(12+30), uint64(4+20+2)
+	require.Equal(t, zeros*4+nonZeros*16, args.CalldataCost(4, 16))
+	require.Equal(t, uint64(584), args.CalldataCost(4, 16))
+
+	// EIP-2028 pricing
+	require.Equal(t, zeros*4+nonZeros*68, args.CalldataCost(4, 68))
+
+	// selector only
+	var empty EmptyArgsCall
+	require.Equal(t, uint64(4*16), empty.CalldataCost(4, 16))
+}
+
+func TestTransferDecodeReuse(t *testing.T) {
+	args := &TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+	encoded, err := args.Encode()
+	require.NoError(t, err)
+
+	var decoded TransferCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	amount := decoded.Amount
+
+	// the big.Int of the reused struct is overwritten in place
+	allocs := testing.AllocsPerRun(10, func() {
+		if _, err := decoded.Decode(encoded); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.True(t, amount == decoded.Amount)
+	require.Equal(t, *args, decoded)
+}
+
+func TestTransferEncodeHex(t *testing.T) {
+	args := &TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	calldata, err := args.EncodeHexWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(encoded), calldata)
+	require.Equal(t, "0xa9059cbb000000000000000000000000742d35cc6634c0532925a3b8d4c9d7b6f7e5c3a300000000000000000000000000000000000000000000000000000000000003e8", calldata)
+
+	data, err := args.EncodeHex()
+	require.NoError(t, err)
+	require.Equal(t, "0x"+calldata[10:], data)
+
+	var empty EmptyArgsCall
+	calldata, err = empty.EncodeHexWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(EmptyArgsSelector[:]), calldata)
+}
+
+func TestTransferDecodeHex(t *testing.T) {
+	args := TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	// with and without the 0x prefix
+	for _, s := range []string{hex.EncodeToString(encoded), "0x" + hex.EncodeToString(encoded)} {
+		var decoded TransferCall
+		n, err := decoded.DecodeHexWithSelector(s)
+		require.NoError(t, err)
+		require.Equal(t, len(encoded), n)
+		require.Equal(t, args, decoded)
+	}
+
+	data, err := args.EncodeHex()
+	require.NoError(t, err)
+	var decoded TransferCall
+	_, err = decoded.DecodeHex(data)
+	require.NoError(t, err)
+	require.Equal(t, args, decoded)
+
+	for s, expected := range map[string]string{
+		"0xa9059cb":  "decode TransferCall: odd length: invalid hex string",
+		"0xa9059cbg": "decode TransferCall: invalid hex string: bad character 'g' at 7",
+	} {
+		_, err = decoded.DecodeHexWithSelector(s)
+		require.EqualError(t, err, expected)
+		require.True(t, errors.Is(err, abi.ErrInvalidHex))
+	}
+
+	// the empty string is valid hex, but too short for the call
+	_, err = decoded.DecodeHex("")
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	_, err = decoded.DecodeHexWithSelector("0x")
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+
+	// single return value of a view function
+	balance, err := DecodeBalanceOfHex("0x00000000000000000000000000000000000000000000000000000000000003e8")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), balance)
+
+	balance, err = DecodeBalanceOfHex("0x3e8")
+	require.EqualError(t, err, "decode BalanceOfReturn: odd length: invalid hex string")
+	require.Nil(t, balance)
+}
+
 func TestSetMessageEncoding(t *testing.T) {
 	message := "Hello, World!"
 
@@ -222,6 +351,8 @@ func TestEmptyFuncCall(t *testing.T) {
 	encoded, err := args.EncodeWithSelector()
 	require.NoError(t, err)
 
+	require.Equal(t, 4, args.EncodedSizeWithSelector())
+
 	// Get go-ethereum encoding
 	goEthEncoded, err := TestABIDef.Pack("emptyArgs")
 	require.NoError(t, err)
@@ -231,6 +362,106 @@ func TestEmptyFuncCall(t *testing.T) {
 	DecodeRoundTrip(t, args)
 }
 
+func TestZeroInputsCall(t *testing.T) {
+	call := NewTotalSupplyCall()
+	require.Equal(t, 0, call.EncodedSize())
+	require.Equal(t, TotalSupplySelector, call.GetMethodSelector())
+	require.Equal(t, "totalSupply", call.GetMethodName())
+
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	goEthEncoded, err := TestABIDef.Pack("totalSupply")
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	n, err := call.Decode(nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// the outputs still get a Return struct
+	output, err := TestABIDef.Methods["totalSupply"].Outputs.Pack(big.NewInt(1000))
+	require.NoError(t, err)
+	supply, err := DecodeTotalSupplyReturn(output)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), supply)
+}
+
+func TestSingleResult(t *testing.T) {
+	output, err := TestABIDef.Methods["balanceOf"].Outputs.Pack(big.NewInt(1000))
+	require.NoError(t, err)
+
+	balance, err := DecodeBalanceOf(output)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), balance)
+
+	encoded, err := EncodeBalanceOfResult(balance)
+	require.NoError(t, err)
+	require.Equal(t, output, encoded)
+
+	// the same validations as the Return struct
+	dirty := make([]byte, 32)
+	dirty[31] = 2
+	for data, expected := range map[string]error{string(dirty): abi.ErrDirtyPadding, string(dirty[:31]): io.ErrUnexpectedEOF} {
+		_, err := DecodeTransferReturn([]byte(data))
+		require.True(t, errors.Is(err, expected))
+		_, err = DecodeTransfer([]byte(data))
+		require.True(t, errors.Is(err, expected))
+	}
+}
+
+func TestDecoderInterface(t *testing.T) {
+	transfer := TransferCall{To: common.HexToAddress("0x1000000000000000000000000000000000000000"), Amount: big.NewInt(1)}
+	message := SetMessageCall{Message: "hello"}
+
+	values := []abi.Tuple{&transfer, &message}
+	decoders := []abi.Decoder{new(TransferCall), new(SetMessageCall)}
+	for i, d := range decoders {
+		data, err := values[i].Encode()
+		require.NoError(t, err)
+		n, err := d.Decode(data)
+		require.NoError(t, err)
+		require.Equal(t, len(data), n)
+	}
+	require.Equal(t, transfer, *decoders[0].(*TransferCall))
+	require.Equal(t, message, *decoders[1].(*SetMessageCall))
+}
+
+// roundTrip re-encodes a value decoded generically through abi.Codec
+func roundTrip[T any, PT abi.Codec[T]](t *testing.T, value T) T {
+	data, err := PT(&value).Encode()
+	require.NoError(t, err)
+
+	var decoded T
+	n, err := PT(&decoded).Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), n)
+
+	reencoded := make([]byte, PT(&decoded).EncodedSize())
+	_, err = PT(&decoded).EncodeTo(reencoded)
+	require.NoError(t, err)
+	require.Equal(t, data, reencoded)
+	return decoded
+}
+
+func TestCodecInterface(t *testing.T) {
+	transfer := TransferCall{To: common.HexToAddress("0x1000000000000000000000000000000000000000"), Amount: big.NewInt(1)}
+	require.Equal(t, transfer, roundTrip(t, transfer))
+
+	user := User{Address: common.HexToAddress("0x01"), Name: "alice", Age: big.NewInt(30)}
+	require.Equal(t, user, roundTrip(t, user))
+}
+
+func TestFunctionSignatures(t *testing.T) {
+	require.Equal(t, "transfer(address,uint256)", TransferSignature)
+	require.Equal(t, TestABIDef.Methods["balanceOf"].Sig, BalanceOfSignature)
+	require.Equal(t, TestABIDef.Methods["totalSupply"].Sig, TotalSupplySignature)
+
+	selector, err := abi.ComputeSelector(TransferSignature)
+	require.NoError(t, err)
+	require.Equal(t, TransferSelector, selector)
+}
+
 func TestUnderstoreFieldName(t *testing.T) {
 	name := "TestName"
 
@@ -251,3 +482,108 @@ func TestUnderstoreFieldName(t *testing.T) {
 
 	DecodeRoundTrip(t, args)
 }
+
+func TestDecodeWithSelector(t *testing.T) {
+	args := &TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	var decoded TransferCall
+	n, err := decoded.DecodeWithSelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, args, &decoded)
+
+	// selector of another method
+	copy(encoded[:4], BalanceOfSelector[:])
+	_, err = decoded.DecodeWithSelector(encoded)
+	require.True(t, errors.Is(err, abi.ErrSelectorMismatch))
+
+	// too short to contain a selector
+	_, err = decoded.DecodeWithSelector(encoded[:3])
+	require.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+
+	// methods without arguments only consume the selector
+	var empty EmptyArgsCall
+	n, err = empty.DecodeWithSelector(EmptyArgsSelector[:])
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
+
+func TestDecodeStrict(t *testing.T) {
+	call := &TransferCall{
+		To:     common.HexToAddress("0x742d35Cc6634C0532925a3b8D4C9D7B6f7e5c3a3"),
+		Amount: big.NewInt(1000),
+	}
+	callData, err := call.Encode()
+	require.NoError(t, err)
+
+	ret := &BalanceOfReturn{Field1: big.NewInt(1000)}
+	retData, err := ret.Encode()
+	require.NoError(t, err)
+
+	msg := &SetMessageCall{Message: "hello"}
+	msgData, err := msg.Encode()
+	require.NoError(t, err)
+	// set an upper byte of the offset and of the length word
+	dirtyOffset := slices.Clone(msgData)
+	dirtyOffset[23] = 1
+	dirtyLength := slices.Clone(msgData)
+	dirtyLength[32] = 0x80
+
+	tests := []struct {
+		name    string
+		decoded interface{ DecodeStrict([]byte) error }
+		data    []byte
+		err     error
+	}{
+		{"call exact length", &TransferCall{}, callData, nil},
+		{"call zero padded", &TransferCall{}, append(slices.Clone(callData), 0), abi.ErrTrailingBytes},
+		{"call non-zero trailing", &TransferCall{}, append(slices.Clone(callData), 1), abi.ErrTrailingBytes},
+		{"return exact length", &BalanceOfReturn{}, retData, nil},
+		{"return zero padded", &BalanceOfReturn{}, append(slices.Clone(retData), make([]byte, 31)...), nil},
+		{"return zero padded too long", &BalanceOfReturn{}, append(slices.Clone(retData), make([]byte, 32)...), abi.ErrTrailingBytes},
+		{"return non-zero trailing", &BalanceOfReturn{}, append(slices.Clone(retData), 0, 1), abi.ErrTrailingBytes},
+		{"empty call exact length", &EmptyArgsCall{}, nil, nil},
+		{"empty call trailing", &EmptyArgsCall{}, []byte{0}, abi.ErrTrailingBytes},
+		{"truncated", &TransferCall{}, callData[:40], io.ErrUnexpectedEOF},
+		{"non-canonical offset", &SetMessageCall{}, dirtyOffset, abi.ErrNonCanonicalSize},
+		{"non-canonical length", &SetMessageCall{}, dirtyLength, abi.ErrNonCanonicalSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.err, tt.decoded.DecodeStrict(tt.data))
+		})
+	}
+}
+
+func TestDecodeHugeLength(t *testing.T) {
+	// a length word of 2^40 is rejected before any allocation, on every dynamic type
+	tests := []struct {
+		name    string
+		call    interface{ Encode() ([]byte, error) }
+		decoded abi.Decode
+		length  int // position of the length word
+	}{
+		{"string", &SetMessageCall{Message: "hello"}, &SetMessageCall{}, 32},
+		{"bytes", &SetDataCall{Value: []byte("hello")}, &SetDataCall{}, 64},
+		{"static slice", &MultiTransferCall{Recipients: []common.Address{{}}, Amounts: []*big.Int{big.NewInt(1)}}, &MultiTransferCall{}, 64},
+		{"dynamic slice", &BatchProcessCall{Users: []UserData{{Id: big.NewInt(1), Data: UserMetadata{Value: "a"}}}}, &BatchProcessCall{}, 32},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.call.Encode()
+			require.NoError(t, err)
+			binary.BigEndian.PutUint64(data[tt.length+24:tt.length+32], 1<<40)
+
+			_, err = tt.decoded.Decode(data)
+			require.Equal(t, abi.ErrSizeTooLarge, err)
+		})
+	}
+}
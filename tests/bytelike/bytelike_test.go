@@ -0,0 +1,95 @@
+package bytelike
+
+import (
+	"bytes"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+// uint8[] maps to []uint8 like bytes maps to []byte, but it's encoded as one word per element
+//go:generate go run ../../cmd -var BytelikeTestABI -output bytelike.abi.go -package bytelike
+
+var BytelikeTestABI = []string{
+	"function f(uint8[] xs, bytes ys)",
+	"function g(uint8[3] xs, bytes3 ys) returns (uint8[] xs, bytes ys)",
+	"event Logged(uint8[] indexed xs, bytes indexed ys)",
+}
+
+func parsedABI(t *testing.T) ethabi.ABI {
+	abiJSON, err := abi.ParseHumanReadableABI(BytelikeTestABI)
+	require.NoError(t, err)
+	parsed, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestBytelikeEncoding(t *testing.T) {
+	parsed := parsedABI(t)
+	call := FCall{Xs: []uint8{1, 2, 3}, Ys: []byte{1, 2, 3}}
+
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+	expected, err := parsed.Pack("f", call.Xs, call.Ys)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	// offsets, uint8[] length and 3 words, bytes length and 1 padded word
+	require.Len(t, encoded, 4+32*2+32*4+32*2)
+	args := encoded[4:]
+	require.Equal(t, common.LeftPadBytes([]byte{2}, 32), args[32*4:32*5], "uint8[] element is a word")
+	require.Equal(t, common.RightPadBytes([]byte{1, 2, 3}, 32), args[32*7:32*8], "bytes are packed")
+
+	var decoded FCall
+	_, err = decoded.Decode(encoded[4:])
+	require.NoError(t, err)
+	require.Equal(t, call, decoded)
+
+	// same values, the two fields encode differently
+	xs, err := abi.EncodeUint8Slice(call.Xs, make([]byte, abi.SizeUint8Slice(call.Xs)))
+	require.NoError(t, err)
+	ys, err := abi.EncodeBytes(call.Ys, make([]byte, abi.SizeBytes(call.Ys)))
+	require.NoError(t, err)
+	require.NotEqual(t, xs, ys)
+}
+
+func TestBytelikeFixedArrays(t *testing.T) {
+	parsed := parsedABI(t)
+	call := GCall{Xs: [3]uint8{1, 2, 3}, Ys: [3]byte{1, 2, 3}}
+
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+	expected, err := parsed.Pack("g", call.Xs, call.Ys)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+	require.Len(t, encoded, 4+32*4)
+
+	ret := GReturn{Xs: []uint8{255, 0}, Ys: []byte{255, 0}}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+	expected, err = parsed.Methods["g"].Outputs.Pack(ret.Xs, ret.Ys)
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	xs, ys, err := DecodeGReturn(encoded)
+	require.NoError(t, err)
+	require.Equal(t, ret.Xs, xs)
+	require.Equal(t, ret.Ys, ys)
+}
+
+func TestBytelikeTopics(t *testing.T) {
+	event := NewLoggedEvent([]uint8{1, 2}, []byte{1, 2})
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	require.Len(t, topics, 3)
+
+	// uint8[] is hashed in the in-place encoding, bytes are hashed verbatim
+	words := append(common.LeftPadBytes([]byte{1}, 32), common.LeftPadBytes([]byte{2}, 32)...)
+	require.Equal(t, crypto.Keccak256Hash(words), topics[1])
+	require.Equal(t, crypto.Keccak256Hash([]byte{1, 2}), topics[2])
+}
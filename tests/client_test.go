@@ -0,0 +1,156 @@
+//go:build !uint256
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../cmd -var ClientTestABI -output client.abi.go -prefix client -client TokenClient -caller Token
+
+var ClientTestABI = []string{
+	"function tokenBalance(address owner) view returns (uint256)",
+	"function tokenTransfer(address to, uint256 amount) returns (bool)",
+	"function tokenPause()",
+}
+
+var ClientTestABIDef ethabi.ABI
+
+func init() {
+	var err error
+	abiJSON, err := abi.ParseHumanReadableABI(ClientTestABI)
+	if err != nil {
+		panic(err)
+	}
+	ClientTestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// mockCaller checks the calldata and returns canned output
+type mockCaller struct {
+	t      *testing.T
+	addr   common.Address
+	data   []byte
+	output []byte
+	err    error
+}
+
+func (m *mockCaller) CallContract(ctx context.Context, to common.Address, data []byte) ([]byte, error) {
+	require.Equal(m.t, m.addr, to)
+	require.Equal(m.t, m.data, data)
+	return m.output, m.err
+}
+
+func TestClient(t *testing.T) {
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	owner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	caller := &mockCaller{t: t, addr: addr}
+	client := NewTokenClient(caller, addr)
+	require.Equal(t, addr, client.Address())
+
+	var err error
+	caller.data, err = ClientTestABIDef.Pack("tokenBalance", owner)
+	require.NoError(t, err)
+	caller.output, err = ClientTestABIDef.Methods["tokenBalance"].Outputs.Pack(big.NewInt(1000))
+	require.NoError(t, err)
+
+	balance, err := client.TokenBalance(context.Background(), owner)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), balance.Field1)
+
+	caller.data, err = ClientTestABIDef.Pack("tokenTransfer", owner, big.NewInt(100))
+	require.NoError(t, err)
+	caller.output, err = ClientTestABIDef.Methods["tokenTransfer"].Outputs.Pack(true)
+	require.NoError(t, err)
+
+	transfer, err := client.TokenTransfer(context.Background(), owner, big.NewInt(100))
+	require.NoError(t, err)
+	require.True(t, transfer.Field1)
+
+	caller.data, err = ClientTestABIDef.Pack("tokenPause")
+	require.NoError(t, err)
+	caller.output = nil
+	require.NoError(t, client.TokenPause(context.Background()))
+
+	// errors from the caller are returned as is
+	callErr := errors.New("execution reverted")
+	caller.err = callErr
+	caller.data, err = ClientTestABIDef.Pack("tokenTransfer", owner, big.NewInt(100))
+	require.NoError(t, err)
+	_, err = client.TokenTransfer(context.Background(), owner, big.NewInt(100))
+	require.Equal(t, callErr, err)
+	caller.data, err = ClientTestABIDef.Pack("tokenPause")
+	require.NoError(t, err)
+	require.Equal(t, callErr, client.TokenPause(context.Background()))
+
+	// malformed output
+	caller.err = nil
+	caller.data, err = ClientTestABIDef.Pack("tokenBalance", owner)
+	require.NoError(t, err)
+	caller.output = []byte{1, 2, 3}
+	_, err = client.TokenBalance(context.Background(), owner)
+	require.Error(t, err)
+}
+
+// mockBackend checks the call message and returns canned output
+type mockBackend struct {
+	t      *testing.T
+	addr   common.Address
+	data   []byte
+	output []byte
+	err    error
+}
+
+func (m *mockBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	require.Equal(m.t, &m.addr, call.To)
+	require.Equal(m.t, m.data, call.Data)
+	require.Nil(m.t, blockNumber)
+	return m.output, m.err
+}
+
+func TestCaller(t *testing.T) {
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	owner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	backend := &mockBackend{t: t, addr: addr}
+	caller := NewTokenCaller(backend, addr)
+	require.Equal(t, addr, caller.Address())
+
+	// view functions are called through the backend
+	var err error
+	backend.data, err = ClientTestABIDef.Pack("tokenBalance", owner)
+	require.NoError(t, err)
+	backend.output, err = ClientTestABIDef.Methods["tokenBalance"].Outputs.Pack(big.NewInt(1000))
+	require.NoError(t, err)
+
+	balance, err := caller.TokenBalance(context.Background(), owner)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), balance.Field1)
+
+	callErr := errors.New("execution reverted")
+	backend.err = callErr
+	_, err = caller.TokenBalance(context.Background(), owner)
+	require.Equal(t, callErr, err)
+
+	// state changing functions only return the calldata
+	data, err := caller.TokenTransferTxData(owner, big.NewInt(100))
+	require.NoError(t, err)
+	expected, err := ClientTestABIDef.Pack("tokenTransfer", owner, big.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	data, err = caller.TokenPauseTxData()
+	require.NoError(t, err)
+	require.Equal(t, TokenPauseSelector[:], data)
+}
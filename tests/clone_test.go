@@ -0,0 +1,140 @@
+//go:build !uint256
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+func TestCloneComplexDynamicTuples(t *testing.T) {
+	original := createComplexDynamicTuplesData()
+	expected, err := original.Encode()
+	require.NoError(t, err)
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	// mutate every mutable field of the clone
+	for i := range clone.Users {
+		clone.Users[i].Id.SetInt64(-1)
+		clone.Users[i].Profile.Name = "mutated"
+		clone.Users[i].Profile.Emails[0] = "mutated"
+		clone.Users[i].Profile.Metadata.CreatedAt.SetInt64(-1)
+		clone.Users[i].Profile.Metadata.Tags[0] = "mutated"
+	}
+	clone.Users[0] = User2{}
+
+	encoded, err := original.Encode()
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+}
+
+func TestCloneMixedTypes(t *testing.T) {
+	original := createMixedTypesData()
+	expected, err := original.Encode()
+	require.NoError(t, err)
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	clone.FixedData[0] = 0xff
+	clone.DynamicData[0] = 0xff
+	for i := range clone.Items {
+		clone.Items[i].Data[0] = 0xff
+		clone.Items[i].Active = !clone.Items[i].Active
+	}
+
+	encoded, err := original.Encode()
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+}
+
+func TestCloneNestedArrays(t *testing.T) {
+	original := createNestedDynamicArraysData()
+	expected, err := original.Encode()
+	require.NoError(t, err)
+
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	clone.Matrix[0][0].SetInt64(-1)
+	clone.AddressMatrix[0][0][0] = common.Address{}
+
+	encoded, err := original.Encode()
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	fixed := TestFixedArraysCall{
+		Uints: [3]*big.Int{big.NewInt(1), nil, big.NewInt(3)},
+	}
+	fixedClone := fixed.Clone()
+	require.Equal(t, fixed, fixedClone)
+	require.Nil(t, fixedClone.Uints[1])
+	fixedClone.Uints[0].SetInt64(-1)
+	require.Equal(t, big.NewInt(1), fixed.Uints[0])
+
+	points := TestStaticTupleArrayCall{
+		Points: [3]Point{{X: big.NewInt(1)}, {X: big.NewInt(2)}, {}},
+	}
+	pointsClone := points.Clone()
+	require.Equal(t, points, pointsClone)
+	pointsClone.Points[0].X.SetInt64(-1)
+	require.Equal(t, big.NewInt(1), points.Points[0].X)
+}
+
+func TestCloneDecodedOutlivesBuffer(t *testing.T) {
+	original := createMixedTypesData()
+	buf, err := original.Encode()
+	require.NoError(t, err)
+
+	// decoded byte slices share the backing array of buf
+	var decoded TestMixedTypesCall
+	_, err = decoded.Decode(buf)
+	require.NoError(t, err)
+	clone := decoded.Clone()
+
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	require.NotEqual(t, original.DynamicData, decoded.DynamicData)
+	require.Equal(t, original, clone)
+}
+
+func TestCloneNil(t *testing.T) {
+	var args TestComplexDynamicTuplesCall
+	clone := args.Clone()
+	require.Nil(t, clone.Users)
+
+	var item Item
+	require.Nil(t, item.Clone().Data)
+}
+
+func BenchmarkClone_ComplexDynamicTuples(b *testing.B) {
+	args := createComplexDynamicTuplesData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = args.Clone()
+	}
+}
+
+// BenchmarkEncodeDecodeClone_ComplexDynamicTuples clones through an encode/decode round trip for comparison
+func BenchmarkEncodeDecodeClone_ComplexDynamicTuples(b *testing.B) {
+	args := createComplexDynamicTuplesData()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		encoded, err := args.Encode()
+		if err != nil {
+			b.Fatal(err)
+		}
+		var decoded TestComplexDynamicTuplesCall
+		if _, err := decoded.Decode(encoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package combined
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+// the contracts of a combined ABI, the items used by both contracts are generated in shared.abi.go
+//go:generate go run ../../cmd -input combined.json -output . -package combined -combined -enums
+
+func TestCombinedSharedTuple(t *testing.T) {
+	coin := Coin{Denom: "atom", Amount: big.NewInt(100)}
+
+	// Coin is generated once and used by both contracts
+	transfer := NewTransferCall(common.HexToAddress("0x01"), coin)
+	deposit := NewDepositCall([]Coin{coin}, Lock{Until: 1, Holders: []common.Address{{}}})
+
+	data, err := transfer.EncodeWithSelector()
+	require.NoError(t, err)
+	call, err := TokenDecodeBySelector(data)
+	require.NoError(t, err)
+	require.Equal(t, transfer, call)
+
+	data, err = deposit.EncodeWithSelector()
+	require.NoError(t, err)
+	call, err = VaultDecodeBySelector(data)
+	require.NoError(t, err)
+	require.Equal(t, deposit, call)
+
+	// the functions of each contract only decode their own calls
+	_, err = TokenDecodeBySelector(data)
+	require.Equal(t, abi.ErrUnknownSelector, err)
+}
+
+func TestCombinedSharedMethod(t *testing.T) {
+	data, err := NewOwnerCall().EncodeWithSelector()
+	require.NoError(t, err)
+
+	// the shared function is decoded by both contracts
+	for _, decode := range []func([]byte) (abi.Method, error){TokenDecodeBySelector, VaultDecodeBySelector} {
+		call, err := decode(data)
+		require.NoError(t, err)
+		require.IsType(t, &OwnerCall{}, call)
+	}
+}
+
+func TestCombinedSharedEvent(t *testing.T) {
+	require.Equal(t, "OwnershipTransferred", TokenEvents[OwnershipTransferredEventTopic])
+	require.Equal(t, "OwnershipTransferred", VaultEvents[OwnershipTransferredEventTopic])
+	require.Equal(t, "Transfer", TokenEvents[TransferEventTopic])
+	require.NotContains(t, VaultEvents, TransferEventTopic)
+
+	event := NewOwnershipTransferredEvent(common.HexToAddress("0x01"), common.HexToAddress("0x02"))
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+
+	var decoded OwnershipTransferredEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, *event, decoded)
+}
+
+func TestCombinedSharedEnum(t *testing.T) {
+	// Status is an enum of both contracts, the type is generated once
+	data, err := NewSetStatusCall(Status(2)).Encode()
+	require.NoError(t, err)
+
+	status, err := DecodeCurrentStatus(data)
+	require.NoError(t, err)
+	require.Equal(t, Status(2), status)
+}
@@ -0,0 +1,102 @@
+package compact
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+	"github.com/yihuang/go-abi/tests/compact/compact"
+	"github.com/yihuang/go-abi/tests/compact/inline"
+)
+
+// the same ABI generated with the inlined loops and the generic slice helpers, the encodings must be identical
+//go:generate go run ../../cmd -var CompactTestABI -output inline/compact.abi.go -package inline -testhelpers
+//go:generate go run ../../cmd -var CompactTestABI -output compact/compact.abi.go -package compact -testhelpers -compact -pointer-receivers
+
+var CompactTestABI = []string{
+	"struct Point { uint256 x; address owner }",
+	"struct Item { uint32 id; bytes data; bool active }",
+	"struct Holder { uint256 id; Item[] items; Point[] points; Item[2] pair }",
+	"function points(Point[] points, Point[][] grid) returns (Point[])",
+	"function items(Item[] items, Item[][2] nested) returns (Item[])",
+	"function holders(Holder[] holders, string label) returns (Holder[])",
+	"event Moved(Point[] points, address indexed sender)",
+}
+
+// roundTrip decodes the inline encoding of src with dst, the re-encoding must be byte-identical
+func roundTrip(t *testing.T, src, dst abi.Tuple) {
+	t.Helper()
+	encoded, err := src.Encode()
+	require.NoError(t, err)
+
+	n, err := dst.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, src.EncodedSize(), dst.EncodedSize())
+
+	reencoded, err := dst.Encode()
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+
+}
+
+func TestCompactIdentical(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 50; i++ {
+		points := inline.RandomPointsCall(r, 3, 4)
+		roundTrip(t, &points, &compact.PointsCall{})
+		pointsRet := inline.RandomPointsReturn(r, 3, 4)
+		roundTrip(t, &pointsRet, &compact.PointsReturn{})
+
+		items := inline.RandomItemsCall(r, 3, 4)
+		roundTrip(t, &items, &compact.ItemsCall{})
+		itemsRet := inline.RandomItemsReturn(r, 3, 4)
+		roundTrip(t, &itemsRet, &compact.ItemsReturn{})
+
+		holders := inline.RandomHoldersCall(r, 3, 4)
+		roundTrip(t, &holders, &compact.HoldersCall{})
+		holdersRet := inline.RandomHoldersReturn(r, 3, 4)
+		roundTrip(t, &holdersRet, &compact.HoldersReturn{})
+	}
+}
+
+func TestCompactEmpty(t *testing.T) {
+	roundTrip(t, &inline.HoldersCall{}, &compact.HoldersCall{})
+
+	call := compact.HoldersCall{Holders: []compact.Holder{{Id: big.NewInt(1), Items: []compact.Item{}, Points: []compact.Point{}, Pair: [2]compact.Item{{Data: []byte{}}, {Data: []byte{}}}}}}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+	var decoded compact.HoldersCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, decoded)
+}
+
+func TestCompactErrors(t *testing.T) {
+	call := inline.RandomItemsCall(rand.New(rand.NewSource(1)), 3, 4)
+	call.Items = append(call.Items, inline.Item{Id: 1, Data: []byte{1, 2, 3}})
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	for i := 0; i < len(encoded); i += 7 {
+		var expected inline.ItemsCall
+		_, expectedErr := expected.Decode(encoded[:i])
+		var actual compact.ItemsCall
+		_, actualErr := actual.Decode(encoded[:i])
+		require.Equal(t, expectedErr, actualErr, "truncated at %d", i)
+	}
+
+	// corrupt the first offset of the items slice
+	corrupted := append([]byte{}, encoded...)
+	corrupted[64+32+31]++
+	var expected inline.ItemsCall
+	_, expectedErr := expected.Decode(corrupted)
+	require.True(t, errors.Is(expectedErr, abi.ErrInvalidOffsetForSliceElement))
+	var actual compact.ItemsCall
+	_, actualErr := actual.Decode(corrupted)
+	require.Equal(t, expectedErr, actualErr)
+}
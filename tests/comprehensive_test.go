@@ -4,8 +4,12 @@ package tests
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/test-go/testify/require"
@@ -15,15 +19,21 @@ import (
 	"github.com/yihuang/go-abi"
 )
 
-//go:generate go run ../cmd -var ComprehensiveTestABI -output comprehensive.abi.go --external-tuples User=User
-//go:generate go run ../cmd -var ComprehensiveTestABI -output comprehensive_uint256.abi.go --external-tuples User=User -buildtag=uint256 -uint256
+//go:generate go run ../cmd -var ComprehensiveTestABI -output comprehensive.abi.go --external-tuples User=User -buildtag=!uint256 -clone -decode-into -testhelpers
+//go:generate go run ../cmd -var ComprehensiveTestABI -output comprehensive_uint256.abi.go --external-tuples User=User -buildtag=uint256 -uint256 -clone -decode-into -testhelpers
 
 // ComprehensiveTestABI contains human-readable ABI definitions for comprehensive testing
 var ComprehensiveTestABI = []string{
 	"function testSmallIntegers(uint8 u8, uint16 u16, uint24 u24, uint32 u32, uint64 u64, int8 i8, int16 i16, int24 i24, int32 i32, int64 i64) returns (bool)",
 	"function testNonStandardIntegers(uint24 u24, uint48 u48, uint72 u72, uint96 u96, uint120 u120, int24 i24, int48 i48, int72 i72, int96 i96, int120 i120) returns (bool)",
 	"function testFixedArrays(address[5] addresses, uint256[3] uints, bytes32[2] bytes32s) returns (bool)",
+	"struct Point { uint256 x; address owner }",
+	"function testStaticTupleArray(Point[3] points, address[4] owners) returns (Point[2])",
+	"function testStaticOutputs(address[3] owners) returns (uint256[3] balances, bool ok)",
+	"function testStaticTupleOutputs(Point[2] pair, bool flag) returns (Point[2] pair, uint256[2][2] grid, bool ok, string note)",
+	"function testNestedFixedArrays(uint256[2][3] matrix, address[3][2] owners) returns (uint256[2][3])",
 	"function testFixedBytes(bytes3 data3, bytes7 data7, bytes15 data15) returns (bytes32)",
+	"function logs(bytes[] entries) returns (bytes[])",
 	"function testNestedDynamicArrays(uint256[][] matrix, address[][3][] addressMatrix, string[][] dymMatrix) returns (bool)",
 	"struct UserMetadata2 { uint256 createdAt; string[] tags }",
 	"struct UserProfile { string name; string[] emails; UserMetadata2 metadata }",
@@ -31,6 +41,10 @@ var ComprehensiveTestABI = []string{
 	"function testComplexDynamicTuples(User2[] users) returns (bool)",
 	"struct Item { uint32 id; bytes data; bool active }",
 	"function testMixedTypes(bytes32 fixedData, bytes dynamicData, bool flag, uint8 count, Item[] items) returns (bool)",
+	"function testDynamicFixedArrays(string[3] names, bytes[2] blobs, Item[2] pair) returns (bool)",
+	"struct FixedArrayHolder { uint256 id; string[3] names; bytes[2] blobs; Item[2] pair }",
+	"function testNestedDynamicFixedArrays(FixedArrayHolder[] holders) returns (bool)",
+	"function tags(string[3] t) returns (string[3])",
 	"struct Level4 { uint256 value; string description }",
 	"struct Level3 { Level4 level3 }",
 	"struct Level2 { Level3 level2 }",
@@ -48,6 +62,14 @@ var ComprehensiveTestABI = []string{
 	"event UserCreated(User user, address indexed creator)",
 	"event Complex(string message, uint256[] numbers, address indexed sender)",
 	"event IndexOnly(address indexed sender)",
+
+	// Fallback and receive functions
+	"fallback() payable",
+	"receive() payable",
+
+	// Custom error definitions for testing
+	"error InsufficientBalance(uint256 available, uint256 required)",
+	"error Unauthorized()",
 }
 
 var ComprehensiveTestABIDef ethabi.ABI
@@ -165,6 +187,161 @@ func TestComprehensiveFixedArrays(t *testing.T) {
 	DecodeRoundTrip(t, args)
 }
 
+func TestComprehensiveStaticTupleArray(t *testing.T) {
+	args := &TestStaticTupleArrayCall{
+		Points: [3]Point{
+			{X: big.NewInt(1), Owner: common.HexToAddress("0x1111111111111111111111111111111111111111")},
+			{X: big.NewInt(2), Owner: common.HexToAddress("0x2222222222222222222222222222222222222222")},
+			{X: big.NewInt(3), Owner: common.HexToAddress("0x3333333333333333333333333333333333333333")},
+		},
+		Owners: [4]common.Address{
+			common.HexToAddress("0x4444444444444444444444444444444444444444"),
+			common.HexToAddress("0x5555555555555555555555555555555555555555"),
+			common.HexToAddress("0x6666666666666666666666666666666666666666"),
+			common.HexToAddress("0x7777777777777777777777777777777777777777"),
+		},
+	}
+
+	// Test encoding with selector
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	// Get go-ethereum encoding
+	goEthEncoded, err := ComprehensiveTestABIDef.Pack("testStaticTupleArray",
+		args.Points, args.Owners)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, args)
+
+	ret := &TestStaticTupleArrayReturn{
+		Field1: [2]Point{args.Points[2], args.Points[0]},
+	}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+
+	goEthEncoded, err = ComprehensiveTestABIDef.Methods["testStaticTupleArray"].Outputs.Pack(ret.Field1)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, ret)
+}
+
+// TestComprehensiveMultiWordStaticOutputs checks the fields after the fixed arrays and static tuples,
+// which take N words in the head, are encoded and decoded at the right offsets.
+func TestComprehensiveMultiWordStaticOutputs(t *testing.T) {
+	method := ComprehensiveTestABIDef.Methods["testStaticOutputs"]
+	ret := &TestStaticOutputsReturn{
+		Balances: [3]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3)},
+		Ok:       true,
+	}
+	encoded, err := ret.Encode()
+	require.NoError(t, err)
+	require.Equal(t, 4*32, len(encoded))
+
+	goEthEncoded, err := method.Outputs.Pack(ret.Balances, ret.Ok)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	unpacked, err := method.Outputs.Unpack(encoded)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{ret.Balances, true}, unpacked)
+
+	balances, ok, err := DecodeTestStaticOutputsReturn(goEthEncoded)
+	require.NoError(t, err)
+	require.Equal(t, ret.Balances, balances)
+	require.True(t, ok)
+	DecodeRoundTrip(t, ret)
+
+	points := [2]Point{
+		{X: big.NewInt(1), Owner: common.HexToAddress("0x1111111111111111111111111111111111111111")},
+		{X: big.NewInt(2), Owner: common.HexToAddress("0x2222222222222222222222222222222222222222")},
+	}
+	call := &TestStaticTupleOutputsCall{Pair: points, Flag: true}
+	encoded, err = call.EncodeWithSelector()
+	require.NoError(t, err)
+	goEthEncoded, err = ComprehensiveTestABIDef.Pack("testStaticTupleOutputs", call.Pair, call.Flag)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+	DecodeRoundTrip(t, call)
+
+	method = ComprehensiveTestABIDef.Methods["testStaticTupleOutputs"]
+	positions := &TestStaticTupleOutputsReturn{
+		Pair: points,
+		Grid: [2][2]*big.Int{{big.NewInt(3), big.NewInt(4)}, {big.NewInt(5), big.NewInt(6)}},
+		Ok:   true,
+		Note: "static head of 9 words",
+	}
+	encoded, err = positions.Encode()
+	require.NoError(t, err)
+	goEthEncoded, err = method.Outputs.Pack(positions.Pair, positions.Grid, positions.Ok, positions.Note)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	var decoded TestStaticTupleOutputsReturn
+	_, err = decoded.Decode(goEthEncoded)
+	require.NoError(t, err)
+	require.Equal(t, *positions, decoded)
+
+	unpacked, err = method.Outputs.Unpack(encoded)
+	require.NoError(t, err)
+	require.Len(t, unpacked, 4)
+	require.Equal(t, positions.Grid, unpacked[1])
+	require.Equal(t, true, unpacked[2])
+	require.Equal(t, positions.Note, unpacked[3])
+}
+
+func TestComprehensiveNestedFixedArrays(t *testing.T) {
+	args := &TestNestedFixedArraysCall{
+		Matrix: [3][2]*big.Int{
+			{big.NewInt(1), big.NewInt(2)},
+			{big.NewInt(3), big.NewInt(4)},
+			{big.NewInt(5), big.NewInt(6)},
+		},
+		Owners: [2][3]common.Address{
+			{
+				common.HexToAddress("0x1111111111111111111111111111111111111111"),
+				common.HexToAddress("0x2222222222222222222222222222222222222222"),
+				common.HexToAddress("0x3333333333333333333333333333333333333333"),
+			},
+			{
+				common.HexToAddress("0x4444444444444444444444444444444444444444"),
+				common.HexToAddress("0x5555555555555555555555555555555555555555"),
+				common.HexToAddress("0x6666666666666666666666666666666666666666"),
+			},
+		},
+	}
+
+	// nested static arrays are fully inlined in the head
+	require.Equal(t, 6*32+6*32, args.EncodedSize())
+
+	// Test encoding with selector
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	// Get go-ethereum encoding
+	goEthEncoded, err := ComprehensiveTestABIDef.Pack("testNestedFixedArrays",
+		args.Matrix, args.Owners)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, args)
+
+	ret := &TestNestedFixedArraysReturn{Field1: args.Matrix}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+
+	goEthEncoded, err = ComprehensiveTestABIDef.Methods["testNestedFixedArrays"].Outputs.Pack(ret.Field1)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, ret)
+}
+
 func TestComprehensiveFixedBytes(t *testing.T) {
 	args := &TestFixedBytesCall{
 		Data3:  [3]byte{0x01, 0x02, 0x03},
@@ -211,7 +388,7 @@ func TestComprehensiveNestedDynamicArrays(t *testing.T) {
 	args := &TestNestedDynamicArraysCall{
 		Matrix:        matrix,
 		AddressMatrix: addressMatrix,
-		DymMatrix:     [][]string{}, // make equality check happy
+		DymMatrix:     [][]string{}, // decoding gives an empty slice rather than nil
 	}
 
 	// Get go-ethereum encoding
@@ -229,6 +406,102 @@ func TestComprehensiveNestedDynamicArrays(t *testing.T) {
 	DecodeRoundTrip(t, args)
 }
 
+// TestComprehensiveEmptySlices checks empty slices in the first, middle and last fields of dynamic tuples
+// agree with go-ethereum, and don't shift the offsets of the fields after them.
+func TestComprehensiveEmptySlices(t *testing.T) {
+	matrix := [][]*big.Int{{big.NewInt(1)}, {}}
+	addressMatrix := [][3][]common.Address{{{}, {common.HexToAddress("0x1111111111111111111111111111111111111111")}, {}}}
+	dymMatrix := [][]string{{"a"}, {}}
+
+	for i, args := range []*TestNestedDynamicArraysCall{
+		{Matrix: [][]*big.Int{}, AddressMatrix: addressMatrix, DymMatrix: dymMatrix},
+		{Matrix: matrix, AddressMatrix: [][3][]common.Address{}, DymMatrix: dymMatrix},
+		{Matrix: matrix, AddressMatrix: addressMatrix, DymMatrix: [][]string{}},
+		{Matrix: [][]*big.Int{}, AddressMatrix: [][3][]common.Address{}, DymMatrix: [][]string{}},
+		{Matrix: [][]*big.Int{{}}, AddressMatrix: [][3][]common.Address{{{}, {}, {}}}, DymMatrix: [][]string{{}}},
+	} {
+		goEthEncoded, err := ComprehensiveTestABIDef.Pack("testNestedDynamicArrays",
+			args.Matrix, args.AddressMatrix, args.DymMatrix)
+		require.NoError(t, err, i)
+
+		encoded, err := args.EncodeWithSelector()
+		require.NoError(t, err, i)
+		require.Equal(t, goEthEncoded, encoded, i)
+
+		var decoded TestNestedDynamicArraysCall
+		_, err = decoded.DecodeWithSelector(goEthEncoded)
+		require.NoError(t, err, i)
+		require.Equal(t, *args, decoded, i)
+		require.NoError(t, decoded.DecodeStrict(goEthEncoded[4:]), i)
+	}
+
+	metadata := UserMetadata2{CreatedAt: big.NewInt(1), Tags: []string{}}
+	for i, profile := range []UserProfile{
+		{Name: "", Emails: []string{"a@b.c"}, Metadata: UserMetadata2{CreatedAt: big.NewInt(1), Tags: []string{"t"}}},
+		{Name: "alice", Emails: []string{}, Metadata: UserMetadata2{CreatedAt: big.NewInt(1), Tags: []string{"t"}}},
+		{Name: "alice", Emails: []string{"a@b.c"}, Metadata: metadata},
+		{Name: "", Emails: []string{}, Metadata: metadata},
+	} {
+		args := &TestComplexDynamicTuplesCall{Users: []User2{
+			{Id: big.NewInt(1), Profile: profile},
+			{Id: big.NewInt(2), Profile: UserProfile{Name: "bob", Emails: []string{"x"}, Metadata: metadata}},
+		}}
+
+		goEthEncoded, err := ComprehensiveTestABIDef.Pack("testComplexDynamicTuples", args.Users)
+		require.NoError(t, err, i)
+
+		encoded, err := args.EncodeWithSelector()
+		require.NoError(t, err, i)
+		require.Equal(t, goEthEncoded, encoded, i)
+
+		var decoded TestComplexDynamicTuplesCall
+		_, err = decoded.DecodeWithSelector(goEthEncoded)
+		require.NoError(t, err, i)
+		require.Equal(t, *args, decoded, i)
+
+		_, err = decoded.DecodeInto(goEthEncoded[4:])
+		require.NoError(t, err, i)
+		require.Equal(t, *args, decoded, i)
+	}
+}
+
+func TestComprehensiveBytesSlice(t *testing.T) {
+	args := &LogsCall{
+		Entries: [][]byte{
+			{},
+			{0x01, 0x02, 0x03},
+			bytes.Repeat([]byte{0x04}, 32),
+			bytes.Repeat([]byte{0x05}, 33),
+		},
+	}
+
+	// Test encoding with selector
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	// Get go-ethereum encoding
+	goEthEncoded, err := ComprehensiveTestABIDef.Pack("logs", args.Entries)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, args)
+
+	ret := &LogsReturn{Field1: args.Entries[1:]}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+
+	goEthEncoded, err = ComprehensiveTestABIDef.Methods["logs"].Outputs.Pack(ret.Field1)
+	require.NoError(t, err)
+
+	require.Equal(t, encoded, goEthEncoded)
+
+	DecodeRoundTrip(t, ret)
+
+	// empty slice
+	DecodeRoundTrip(t, &LogsCall{Entries: [][]byte{}})
+}
+
 func TestComprehensiveComplexDynamicTuples(t *testing.T) {
 	users := []User2{
 		{
@@ -313,6 +586,277 @@ func TestComprehensiveMixedTypes(t *testing.T) {
 	DecodeRoundTrip(t, args)
 }
 
+func TestComprehensiveEncodeToDirtyBuffer(t *testing.T) {
+	for _, args := range []abi.Tuple{
+		&TestMixedTypesCall{
+			FixedData:   [32]byte{0x01},
+			DynamicData: []byte{0x02, 0x03},
+			Flag:        false,
+			Count:       1,
+			Items:       []Item{{Id: 1, Data: []byte{0x04}, Active: false}},
+		},
+		&TestSmallIntegersCall{U8: 1, U64: 2, I8: 3, I64: 4},
+		&TestFixedBytesCall{Data3: [3]byte{0x01}},
+		&TestFixedArraysCall{Uints: [3]*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(2)}},
+		&TestNestedDynamicArraysCall{DymMatrix: [][]string{{"a", ""}, {"bc"}}},
+	} {
+		t.Run(fmt.Sprintf("%T", args), func(t *testing.T) {
+			expected, err := args.Encode()
+			require.NoError(t, err)
+
+			// encode twice into a buffer full of garbage
+			buf := bytes.Repeat([]byte{0xff}, len(expected))
+			for i := 0; i < 2; i++ {
+				n, err := args.EncodeTo(buf)
+				require.NoError(t, err)
+				require.Equal(t, len(expected), n)
+				require.Equal(t, expected, buf)
+			}
+		})
+	}
+}
+
+func TestComprehensiveDecodeInto(t *testing.T) {
+	large := createComplexDynamicTuplesData()
+	small := TestComplexDynamicTuplesCall{Users: large.Users[1:2]}
+
+	largeEncoded, err := large.Encode()
+	require.NoError(t, err)
+	smallEncoded, err := small.Encode()
+	require.NoError(t, err)
+
+	var decoded TestComplexDynamicTuplesCall
+	n, err := decoded.DecodeInto(largeEncoded)
+	require.NoError(t, err)
+	require.Equal(t, len(largeEncoded), n)
+	require.Equal(t, large, decoded)
+	users := decoded.Users
+	tags := decoded.Users[0].Profile.Metadata.Tags
+
+	// shrinking reuses the backing arrays of the slices and nested slices
+	n, err = decoded.DecodeInto(smallEncoded)
+	require.NoError(t, err)
+	require.Equal(t, len(smallEncoded), n)
+	require.Equal(t, small, decoded)
+	require.True(t, &users[0] == &decoded.Users[0])
+	require.True(t, &tags[0] == &decoded.Users[0].Profile.Metadata.Tags[0])
+
+	// growing past the capacity allocates again
+	n, err = decoded.DecodeInto(largeEncoded)
+	require.NoError(t, err)
+	require.Equal(t, len(largeEncoded), n)
+	require.Equal(t, large, decoded)
+
+	// nested slices of different lengths between messages
+	matrix := createNestedDynamicArraysData()
+	matrix.DymMatrix = [][]string{{"x"}, {"y", "z"}, {"w"}}
+	matrixEncoded, err := matrix.Encode()
+	require.NoError(t, err)
+	reversed := TestNestedDynamicArraysCall{
+		Matrix:        slices.Clone(matrix.Matrix),
+		AddressMatrix: matrix.AddressMatrix,
+		DymMatrix:     [][]string{{"a", "b", "c"}, {}},
+	}
+	slices.Reverse(reversed.Matrix)
+	reversedEncoded, err := reversed.Encode()
+	require.NoError(t, err)
+
+	var decodedMatrix TestNestedDynamicArraysCall
+	for _, expected := range []struct {
+		value   TestNestedDynamicArraysCall
+		encoded []byte
+	}{{matrix, matrixEncoded}, {reversed, reversedEncoded}, {matrix, matrixEncoded}} {
+		_, err = decodedMatrix.DecodeInto(expected.encoded)
+		require.NoError(t, err)
+		require.Equal(t, expected.value.Matrix, decodedMatrix.Matrix)
+		require.Equal(t, expected.value.DymMatrix, decodedMatrix.DymMatrix)
+	}
+}
+
+func TestComprehensiveReset(t *testing.T) {
+	mixed := createMixedTypesData()
+	encoded, err := mixed.Encode()
+	require.NoError(t, err)
+
+	var decoded TestMixedTypesCall
+	_, err = decoded.DecodeInto(encoded)
+	require.NoError(t, err)
+	require.Equal(t, mixed, decoded)
+
+	decoded.Reset()
+	require.Equal(t, TestMixedTypesCall{}, decoded)
+
+	holder := FixedArrayHolder{Id: big.NewInt(1), Names: [3]string{"a", "b", "c"}, Pair: [2]Item{{Id: 1, Data: []byte{1}}}}
+	holder.Reset()
+	require.Equal(t, FixedArrayHolder{}, holder)
+
+	nested := createComplexDynamicTuplesData()
+	allocs := testing.AllocsPerRun(10, func() {
+		nested.Reset()
+	})
+	require.Zero(t, allocs)
+	require.Equal(t, TestComplexDynamicTuplesCall{}, nested)
+}
+
+// randomCalls constructs every call of the comprehensive ABI with random arguments
+var randomCalls = []func(r *rand.Rand, maxDepth, maxLen int) abi.Method{
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomLogsCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTagsCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestComplexDynamicTuplesCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestDeeplyNestedCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestDynamicFixedArraysCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestExternalTupleCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestFixedArraysCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestFixedBytesCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestMixedTypesCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestNestedDynamicArraysCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestNestedDynamicFixedArraysCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestNestedFixedArraysCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestNestedStructCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestNonStandardIntegersCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestSmallIntegersCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestStaticOutputsCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestStaticTupleArrayCall(r, maxDepth, maxLen); return &v },
+	func(r *rand.Rand, maxDepth, maxLen int) abi.Method { v := RandomTestStaticTupleOutputsCall(r, maxDepth, maxLen); return &v },
+}
+
+// TestComprehensiveRandomCalls checks random calls round-trip and agree with go-ethereum,
+// which must decode the encoding and pack the decoded values to the same bytes.
+func TestComprehensiveRandomCalls(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		for _, random := range randomCalls {
+			call := random(r, 3, 4)
+			encoded, err := call.EncodeWithSelector()
+			require.NoError(t, err)
+
+			method := ComprehensiveTestABIDef.Methods[call.GetMethodName()]
+			args, err := method.Inputs.Unpack(encoded[4:])
+			require.NoError(t, err, method.Name)
+			expected, err := method.Inputs.Pack(args...)
+			require.NoError(t, err, method.Name)
+			require.Equal(t, expected, encoded[4:], method.Name)
+
+			require.NoError(t, abi.CheckRoundTrip(call, encoded[4:]), method.Name)
+		}
+	}
+}
+
+func TestComprehensiveDecodeBySelector(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, random := range randomCalls {
+		call := random(r, 3, 4)
+		encoded, err := call.EncodeWithSelector()
+		require.NoError(t, err)
+
+		decoded, err := DecodeBySelector(encoded)
+		require.NoError(t, err, call.GetMethodName())
+		require.Equal(t, call, decoded)
+	}
+
+	_, err := DecodeBySelector([]byte{0xde, 0xad, 0xbe, 0xef})
+	require.True(t, errors.Is(err, abi.ErrUnknownSelector))
+
+	_, err = DecodeBySelector([]byte{0xde, 0xad})
+	require.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+}
+
+// FuzzComprehensiveDecodeBySelector throws arbitrary calldata at the decoders of every function,
+// seeded with the encodings of random calls, the decoders must never panic.
+func FuzzComprehensiveDecodeBySelector(f *testing.F) {
+	r := rand.New(rand.NewSource(3))
+	for _, random := range randomCalls {
+		call := random(r, 3, 4)
+		encoded, err := call.EncodeWithSelector()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(encoded[:4], encoded[4:])
+	}
+
+	f.Fuzz(func(t *testing.T, selector []byte, data []byte) {
+		if len(selector) < 4 {
+			return
+		}
+		if err := abi.FuzzDecode(DecodeBySelector, [4]byte(selector), data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestComprehensiveDynamicFixedArrays(t *testing.T) {
+	names := [3]string{"alice", "", "a name longer than thirty two bytes to span two words"}
+	blobs := [2][]byte{{0x01, 0x02}, bytes.Repeat([]byte{0x03}, 33)}
+	pair := [2]Item{
+		{Id: 1, Data: []byte{0x04}, Active: true},
+		{Id: 2, Data: []byte{}, Active: false},
+	}
+
+	args := &TestDynamicFixedArraysCall{Names: names, Blobs: blobs, Pair: pair}
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), args.EncodedSizeWithSelector())
+
+	goEthEncoded, err := ComprehensiveTestABIDef.Pack("testDynamicFixedArrays", names, blobs, pair)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	DecodeRoundTrip(t, args)
+
+	// nested inside a dynamic tuple
+	nested := &TestNestedDynamicFixedArraysCall{
+		Holders: []FixedArrayHolder{
+			{Id: big.NewInt(1), Names: names, Blobs: blobs, Pair: pair},
+			{Id: big.NewInt(2), Names: [3]string{"x", "y", "z"}, Blobs: [2][]byte{{}, {0x05}}, Pair: [2]Item{pair[1], pair[0]}},
+		},
+	}
+
+	encoded, err = nested.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), nested.EncodedSizeWithSelector())
+
+	goEthEncoded, err = ComprehensiveTestABIDef.Pack("testNestedDynamicFixedArrays", nested.Holders)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	DecodeRoundTrip(t, nested)
+}
+
+func TestComprehensiveStringArray(t *testing.T) {
+	tags := [3]string{"", "short", "a tag longer than thirty two bytes to span two words"}
+	args := &TagsCall{T: tags}
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), args.EncodedSizeWithSelector())
+
+	goEthEncoded, err := ComprehensiveTestABIDef.Pack("tags", tags)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	// the offsets are relative to the start of the array, which is itself behind an offset
+	require.Equal(t, uint64(32), new(big.Int).SetBytes(encoded[4:36]).Uint64())
+	require.Equal(t, uint64(96), new(big.Int).SetBytes(encoded[36:68]).Uint64())
+
+	DecodeRoundTrip(t, args)
+
+	ret := TagsReturn{Field1: tags}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+	goEthEncoded, err = ComprehensiveTestABIDef.Methods["tags"].Outputs.Pack(tags)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	unpacked, err := ComprehensiveTestABIDef.Unpack("tags", encoded)
+	require.NoError(t, err)
+	require.Equal(t, tags, unpacked[0])
+
+	decoded, err := DecodeTagsReturn(encoded)
+	require.NoError(t, err)
+	require.Equal(t, tags, decoded)
+}
+
 func TestComprehensiveDeeplyNested(t *testing.T) {
 	data := Level1{
 		Level1: Level2{
@@ -400,3 +944,88 @@ func TestNestedStruct(t *testing.T) {
 
 	DecodeRoundTrip(t, args)
 }
+
+func TestComprehensiveFallbackReceive(t *testing.T) {
+	require.True(t, ComprehensiveTestABIDef.HasFallback())
+	require.True(t, ComprehensiveTestABIDef.HasReceive())
+	require.Equal(t, ComprehensiveTestABIDef.Fallback.StateMutability, FallbackStateMutability)
+	require.Equal(t, ComprehensiveTestABIDef.Receive.StateMutability, ReceiveStateMutability)
+
+	// calldata is passed through as is, including data shorter than a selector
+	for _, data := range [][]byte{{}, {0x01, 0x02}, {0xde, 0xad, 0xbe, 0xef, 0x01}} {
+		var call FallbackCall
+		n, err := call.DecodeWithSelector(data)
+		require.NoError(t, err)
+		require.Equal(t, len(data), n)
+		require.Equal(t, data, call.Data)
+
+		encoded, err := call.EncodeWithSelector()
+		require.NoError(t, err)
+		require.Equal(t, data, encoded)
+	}
+
+	var receive ReceiveCall
+	encoded, err := receive.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+
+	_, err = receive.DecodeWithSelector(nil)
+	require.NoError(t, err)
+	_, err = receive.DecodeWithSelector([]byte{0x01})
+	require.Equal(t, abi.ErrTrailingBytes, err)
+}
+
+func TestComprehensiveTopLevelSlices(t *testing.T) {
+	mixed := createMixedTypesData()
+	itemsArgs := ethabi.Arguments{ComprehensiveTestABIDef.Methods["testMixedTypes"].Inputs[4]}
+
+	encoded, err := EncodeTopLevelItemSlice(mixed.Items)
+	require.NoError(t, err)
+
+	goEthEncoded, err := itemsArgs.Pack(mixed.Items)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	items, n, err := DecodeTopLevelItemSlice(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, mixed.Items, items)
+
+	nested := createNestedDynamicArraysData()
+	matrixArgs := ethabi.Arguments{ComprehensiveTestABIDef.Methods["testNestedDynamicArrays"].Inputs[0]}
+
+	encoded, err = EncodeTopLevelUint256SliceSlice(nested.Matrix)
+	require.NoError(t, err)
+
+	goEthEncoded, err = matrixArgs.Pack(nested.Matrix)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	matrix, _, err := DecodeTopLevelUint256SliceSlice(encoded)
+	require.NoError(t, err)
+	require.Equal(t, nested.Matrix, matrix)
+
+	// primitive slices are exported from the runtime package
+	uints := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	uintsType, err := ethabi.NewType("uint256[]", "", nil)
+	require.NoError(t, err)
+
+	encoded, err = abi.EncodeTopLevelUint256Slice(uints)
+	require.NoError(t, err)
+
+	goEthEncoded, err = ethabi.Arguments{{Type: uintsType}}.Pack(uints)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	decoded, _, err := abi.DecodeTopLevelUint256Slice(encoded)
+	require.NoError(t, err)
+	require.Equal(t, uints, decoded)
+
+	// the leading offset word must be canonical
+	encoded[31] = 0x40
+	_, _, err = abi.DecodeTopLevelUint256Slice(encoded)
+	require.Equal(t, abi.ErrInvalidOffsetForDynamicField, err)
+
+	_, _, err = abi.DecodeTopLevelUint256Slice(encoded[:16])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
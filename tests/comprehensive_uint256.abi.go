@@ -1,13 +1,19 @@
 //go:build uint256
 
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: b77d3cc6ec3974dd76503119fb391493348d9dd3325abd9050d08f51be86f89f
 
 package tests
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
+	"math/rand"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
@@ -16,10 +22,16 @@ import (
 
 // Function selectors
 var (
+	// logs(bytes[])
+	LogsSelector = [4]byte{0x71, 0xb7, 0xc8, 0x82}
+	// tags(string[3])
+	TagsSelector = [4]byte{0x84, 0x39, 0x70, 0x03}
 	// testComplexDynamicTuples((uint256,(string,string[],(uint256,string[])))[])
 	TestComplexDynamicTuplesSelector = [4]byte{0xc0, 0x96, 0x4c, 0x93}
 	// testDeeplyNested(((((uint256,string)))))
 	TestDeeplyNestedSelector = [4]byte{0x21, 0x75, 0xe8, 0x54}
+	// testDynamicFixedArrays(string[3],bytes[2],(uint32,bytes,bool)[2])
+	TestDynamicFixedArraysSelector = [4]byte{0xd3, 0x7d, 0x18, 0x84}
 	// testExternalTuple((address,string,uint256))
 	TestExternalTupleSelector = [4]byte{0x96, 0x39, 0x8b, 0x38}
 	// testFixedArrays(address[5],uint256[3],bytes32[2])
@@ -30,31 +42,336 @@ var (
 	TestMixedTypesSelector = [4]byte{0x85, 0x8a, 0xe6, 0x15}
 	// testNestedDynamicArrays(uint256[][],address[][3][],string[][])
 	TestNestedDynamicArraysSelector = [4]byte{0x1a, 0xdd, 0xf6, 0x20}
+	// testNestedDynamicFixedArrays((uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[])
+	TestNestedDynamicFixedArraysSelector = [4]byte{0xdf, 0x78, 0xf1, 0x88}
+	// testNestedFixedArrays(uint256[2][3],address[3][2])
+	TestNestedFixedArraysSelector = [4]byte{0xce, 0x33, 0x9c, 0x8c}
 	// testNestedStruct(((address,string,uint256)[]))
 	TestNestedStructSelector = [4]byte{0xe8, 0x3b, 0x85, 0x67}
 	// testNonStandardIntegers(uint24,uint48,uint72,uint96,uint120,int24,int48,int72,int96,int120)
 	TestNonStandardIntegersSelector = [4]byte{0x70, 0xda, 0xa4, 0x3a}
 	// testSmallIntegers(uint8,uint16,uint24,uint32,uint64,int8,int16,int24,int32,int64)
 	TestSmallIntegersSelector = [4]byte{0xab, 0xa8, 0x9e, 0xc2}
+	// testStaticOutputs(address[3])
+	TestStaticOutputsSelector = [4]byte{0x29, 0x0d, 0x26, 0x32}
+	// testStaticTupleArray((uint256,address)[3],address[4])
+	TestStaticTupleArraySelector = [4]byte{0x7b, 0x72, 0xd6, 0xa1}
+	// testStaticTupleOutputs((uint256,address)[2],bool)
+	TestStaticTupleOutputsSelector = [4]byte{0x88, 0xa5, 0x90, 0x87}
 )
 
 // Big endian integer versions of function selectors
 const (
-	TestComplexDynamicTuplesID = 3231075475
-	TestDeeplyNestedID         = 561375316
-	TestExternalTupleID        = 2520353592
-	TestFixedArraysID          = 599279196
-	TestFixedBytesID           = 1158656686
-	TestMixedTypesID           = 2240472597
-	TestNestedDynamicArraysID  = 450754080
-	TestNestedStructID         = 3896214887
-	TestNonStandardIntegersID  = 1893377082
-	TestSmallIntegersID        = 2879954626
+	LogsID                         = 1907869826
+	TagsID                         = 2218356739
+	TestComplexDynamicTuplesID     = 3231075475
+	TestDeeplyNestedID             = 561375316
+	TestDynamicFixedArraysID       = 3548190852
+	TestExternalTupleID            = 2520353592
+	TestFixedArraysID              = 599279196
+	TestFixedBytesID               = 1158656686
+	TestMixedTypesID               = 2240472597
+	TestNestedDynamicArraysID      = 450754080
+	TestNestedDynamicFixedArraysID = 3749245320
+	TestNestedFixedArraysID        = 3459488908
+	TestNestedStructID             = 3896214887
+	TestNonStandardIntegersID      = 1893377082
+	TestSmallIntegersID            = 2879954626
+	TestStaticOutputsID            = 688727602
+	TestStaticTupleArrayID         = 2071123617
+	TestStaticTupleOutputsID       = 2292551815
 )
 
-const GroupStaticSize = 32
+// Canonical function signatures
+const (
+	LogsSignature                         = "logs(bytes[])"
+	TagsSignature                         = "tags(string[3])"
+	TestComplexDynamicTuplesSignature     = "testComplexDynamicTuples((uint256,(string,string[],(uint256,string[])))[])"
+	TestDeeplyNestedSignature             = "testDeeplyNested(((((uint256,string)))))"
+	TestDynamicFixedArraysSignature       = "testDynamicFixedArrays(string[3],bytes[2],(uint32,bytes,bool)[2])"
+	TestExternalTupleSignature            = "testExternalTuple((address,string,uint256))"
+	TestFixedArraysSignature              = "testFixedArrays(address[5],uint256[3],bytes32[2])"
+	TestFixedBytesSignature               = "testFixedBytes(bytes3,bytes7,bytes15)"
+	TestMixedTypesSignature               = "testMixedTypes(bytes32,bytes,bool,uint8,(uint32,bytes,bool)[])"
+	TestNestedDynamicArraysSignature      = "testNestedDynamicArrays(uint256[][],address[][3][],string[][])"
+	TestNestedDynamicFixedArraysSignature = "testNestedDynamicFixedArrays((uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[])"
+	TestNestedFixedArraysSignature        = "testNestedFixedArrays(uint256[2][3],address[3][2])"
+	TestNestedStructSignature             = "testNestedStruct(((address,string,uint256)[]))"
+	TestNonStandardIntegersSignature      = "testNonStandardIntegers(uint24,uint48,uint72,uint96,uint120,int24,int48,int72,int96,int120)"
+	TestSmallIntegersSignature            = "testSmallIntegers(uint8,uint16,uint24,uint32,uint64,int8,int16,int24,int32,int64)"
+	TestStaticOutputsSignature            = "testStaticOutputs(address[3])"
+	TestStaticTupleArraySignature         = "testStaticTupleArray((uint256,address)[3],address[4])"
+	TestStaticTupleOutputsSignature       = "testStaticTupleOutputs((uint256,address)[2],bool)"
+)
 
-var _ abi.Tuple = (*Group)(nil)
+const FixedArrayHolderStaticSize = 128
+
+// FixedArrayHolder represents an ABI tuple
+type FixedArrayHolder struct {
+	Id    *uint256.Int
+	Names [3]string
+	Blobs [2][]byte
+	Pair  [2]Item
+}
+
+// EncodedSize returns the total encoded size of FixedArrayHolder
+func (t FixedArrayHolder) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeStringArray3(t.Names)
+	dynamicSize += SizeBytesArray2(t.Blobs)
+	dynamicSize += SizeItemArray2(t.Pair)
+
+	return FixedArrayHolderStaticSize + dynamicSize
+}
+
+// EncodeTo encodes FixedArrayHolder to ABI bytes in the provided buffer
+func (value FixedArrayHolder) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := FixedArrayHolderStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Id: uint256
+	if _, err := abi.EncodeUint256U256(value.Id, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Names: string[3]
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeStringArray3(value.Names, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field Blobs: bytes[2]
+	// Encode offset pointer
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeBytesArray2(value.Blobs, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field Pair: (uint32,bytes,bool)[2]
+	// Encode offset pointer
+	abi.ClearWord(buf[96:])
+	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeItemArray2(value.Pair, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes FixedArrayHolder to ABI bytes
+func (value FixedArrayHolder) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes FixedArrayHolder from ABI bytes in the provided buffer
+func (t *FixedArrayHolder) Decode(data []byte) (int, error) {
+	if len(data) < 128 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 128
+	// Decode static field Id: uint256
+	t.Id, _, err = abi.DecodeIntoUint256U256(t.Id, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Names
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Names, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Blobs
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Blobs, n, err = DecodeBytesArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Pair
+	{
+		offset, err = abi.DecodeSize(data[96:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Pair, n, err = DecodeItemArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes FixedArrayHolder from ABI bytes, rejecting unexpected trailing bytes
+func (t *FixedArrayHolder) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes FixedArrayHolder from the hex string with an optional 0x prefix
+func (t *FixedArrayHolder) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode FixedArrayHolder: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes FixedArrayHolder from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *FixedArrayHolder) DecodeInto(data []byte) (int, error) {
+	if len(data) < 128 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 128
+	// Decode static field Id: uint256
+	t.Id, _, err = abi.DecodeIntoUint256U256(t.Id, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Names
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Names, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Blobs
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Blobs, n, err = DecodeBytesArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Pair
+	{
+		offset, err = abi.DecodeSize(data[96:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Pair, n, err = DecodeItemArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of FixedArrayHolder to the zero value, to reuse it from a pool
+func (t *FixedArrayHolder) Reset() {
+	t.Id = nil
+	t.Names = [3]string{}
+	t.Blobs = [2][]byte{}
+	t.Pair = [2]Item{}
+}
+
+// Clone returns a deep copy of FixedArrayHolder
+func (t FixedArrayHolder) Clone() FixedArrayHolder {
+	c := t
+	if t.Id != nil {
+		c.Id = new(uint256.Int).Set(t.Id)
+	}
+	for i0 := range t.Blobs {
+		c.Blobs[i0] = bytes.Clone(t.Blobs[i0])
+	}
+	for i0 := range t.Pair {
+		c.Pair[i0] = t.Pair[i0].Clone()
+	}
+	return c
+}
+
+var _ abi.Tuple = (*FixedArrayHolder)(nil)
+var _ abi.Decoder = (*FixedArrayHolder)(nil)
+
+// RandomFixedArrayHolder returns a FixedArrayHolder filled with random values, for property based tests
+func RandomFixedArrayHolder(r *rand.Rand, maxDepth, maxLen int) FixedArrayHolder {
+	var t FixedArrayHolder
+	t.Id = abi.RandomUint256(r, 256)
+	for i0 := range t.Names {
+		t.Names[i0] = abi.RandomString(r, maxLen)
+	}
+	for i0 := range t.Blobs {
+		t.Blobs[i0] = abi.RandomBytes(r, maxLen)
+	}
+	for i0 := range t.Pair {
+		t.Pair[i0] = RandomItem(r, maxDepth-1, maxLen)
+	}
+	return t
+}
+
+const GroupStaticSize = 32
 
 // Group represents an ABI tuple
 type Group struct {
@@ -79,6 +396,7 @@ func (value Group) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Users: (address,string,uint256)[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = EncodeUserSlice(value.Users, buf[dynamicOffset:])
@@ -128,9 +446,80 @@ func (t *Group) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const ItemStaticSize = 96
+// DecodeStrict decodes Group from ABI bytes, rejecting unexpected trailing bytes
+func (t *Group) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*Item)(nil)
+// DecodeHex decodes Group from the hex string with an optional 0x prefix
+func (t *Group) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Group: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes Group from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Group) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Users
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Users, n, err = DecodeIntoUserSlice(t.Users, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of Group to the zero value, to reuse it from a pool
+func (t *Group) Reset() {
+	t.Users = nil
+}
+
+// Clone returns a deep copy of Group
+func (t Group) Clone() Group {
+	c := t
+	c.Users = slices.Clone(t.Users)
+	return c
+}
+
+var _ abi.Tuple = (*Group)(nil)
+var _ abi.Decoder = (*Group)(nil)
+
+// RandomGroup returns a Group filled with random values, for property based tests
+func RandomGroup(r *rand.Rand, maxDepth, maxLen int) Group {
+	var t Group
+	t.Users = make([]User, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Users {
+		t.Users[i0] = RandomUser(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
+const ItemStaticSize = 96
 
 // Item represents an ABI tuple
 type Item struct {
@@ -162,6 +551,7 @@ func (value Item) EncodeTo(buf []byte) (int, error) {
 
 	// Field Data: bytes
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeBytes(value.Data, buf[dynamicOffset:])
@@ -226,33 +616,116 @@ func (t *Item) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const Level1StaticSize = 32
-
-var _ abi.Tuple = (*Level1)(nil)
-
-// Level1 represents an ABI tuple
-type Level1 struct {
-	Level1 Level2
+// DecodeStrict decodes Item from ABI bytes, rejecting unexpected trailing bytes
+func (t *Item) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// EncodedSize returns the total encoded size of Level1
-func (t Level1) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += t.Level1.EncodedSize()
-
-	return Level1StaticSize + dynamicSize
+// DecodeHex decodes Item from the hex string with an optional 0x prefix
+func (t *Item) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Item: %w", err)
+	}
+	return t.Decode(data)
 }
 
-// EncodeTo encodes Level1 to ABI bytes in the provided buffer
-func (value Level1) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := Level1StaticSize // Start dynamic data after static section
+// DecodeInto decodes Item from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Item) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
 	var (
-		err error
-		n   int
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 96
+	// Decode static field Id: uint32
+	t.Id, _, err = abi.DecodeUint32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Data
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Data, n, err = abi.DecodeBytes(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode static field Active: bool
+	t.Active, _, err = abi.DecodeBool(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of Item to the zero value, to reuse it from a pool
+func (t *Item) Reset() {
+	t.Id = 0
+	t.Data = nil
+	t.Active = false
+}
+
+// Clone returns a deep copy of Item
+func (t Item) Clone() Item {
+	c := t
+	c.Data = bytes.Clone(t.Data)
+	return c
+}
+
+var _ abi.Tuple = (*Item)(nil)
+var _ abi.Decoder = (*Item)(nil)
+
+// RandomItem returns a Item filled with random values, for property based tests
+func RandomItem(r *rand.Rand, maxDepth, maxLen int) Item {
+	var t Item
+	t.Id = uint32(r.Uint64() >> 32)
+	t.Data = abi.RandomBytes(r, maxLen)
+	t.Active = r.Intn(2) == 1
+	return t
+}
+
+const Level1StaticSize = 32
+
+// Level1 represents an ABI tuple
+type Level1 struct {
+	Level1 Level2
+}
+
+// EncodedSize returns the total encoded size of Level1
+func (t Level1) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += t.Level1.EncodedSize()
+
+	return Level1StaticSize + dynamicSize
+}
+
+// EncodeTo encodes Level1 to ABI bytes in the provided buffer
+func (value Level1) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := Level1StaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
 	)
 	// Field Level1: (((uint256,string)))
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Level1.EncodeTo(buf[dynamicOffset:])
@@ -302,9 +775,77 @@ func (t *Level1) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const Level2StaticSize = 32
+// DecodeStrict decodes Level1 from ABI bytes, rejecting unexpected trailing bytes
+func (t *Level1) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*Level2)(nil)
+// DecodeHex decodes Level1 from the hex string with an optional 0x prefix
+func (t *Level1) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Level1: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes Level1 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Level1) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Level1
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Level1.DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of Level1 to the zero value, to reuse it from a pool
+func (t *Level1) Reset() {
+	t.Level1.Reset()
+}
+
+// Clone returns a deep copy of Level1
+func (t Level1) Clone() Level1 {
+	c := t
+	c.Level1 = t.Level1.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*Level1)(nil)
+var _ abi.Decoder = (*Level1)(nil)
+
+// RandomLevel1 returns a Level1 filled with random values, for property based tests
+func RandomLevel1(r *rand.Rand, maxDepth, maxLen int) Level1 {
+	var t Level1
+	t.Level1 = RandomLevel2(r, maxDepth-1, maxLen)
+	return t
+}
+
+const Level2StaticSize = 32
 
 // Level2 represents an ABI tuple
 type Level2 struct {
@@ -329,6 +870,7 @@ func (value Level2) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Level2: ((uint256,string))
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Level2.EncodeTo(buf[dynamicOffset:])
@@ -378,9 +920,77 @@ func (t *Level2) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const Level3StaticSize = 32
+// DecodeStrict decodes Level2 from ABI bytes, rejecting unexpected trailing bytes
+func (t *Level2) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*Level3)(nil)
+// DecodeHex decodes Level2 from the hex string with an optional 0x prefix
+func (t *Level2) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Level2: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes Level2 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Level2) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Level2
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Level2.DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of Level2 to the zero value, to reuse it from a pool
+func (t *Level2) Reset() {
+	t.Level2.Reset()
+}
+
+// Clone returns a deep copy of Level2
+func (t Level2) Clone() Level2 {
+	c := t
+	c.Level2 = t.Level2.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*Level2)(nil)
+var _ abi.Decoder = (*Level2)(nil)
+
+// RandomLevel2 returns a Level2 filled with random values, for property based tests
+func RandomLevel2(r *rand.Rand, maxDepth, maxLen int) Level2 {
+	var t Level2
+	t.Level2 = RandomLevel3(r, maxDepth-1, maxLen)
+	return t
+}
+
+const Level3StaticSize = 32
 
 // Level3 represents an ABI tuple
 type Level3 struct {
@@ -405,6 +1015,7 @@ func (value Level3) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Level3: (uint256,string)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Level3.EncodeTo(buf[dynamicOffset:])
@@ -454,9 +1065,77 @@ func (t *Level3) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const Level4StaticSize = 64
+// DecodeStrict decodes Level3 from ABI bytes, rejecting unexpected trailing bytes
+func (t *Level3) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*Level4)(nil)
+// DecodeHex decodes Level3 from the hex string with an optional 0x prefix
+func (t *Level3) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Level3: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes Level3 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Level3) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Level3
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Level3.DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of Level3 to the zero value, to reuse it from a pool
+func (t *Level3) Reset() {
+	t.Level3.Reset()
+}
+
+// Clone returns a deep copy of Level3
+func (t Level3) Clone() Level3 {
+	c := t
+	c.Level3 = t.Level3.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*Level3)(nil)
+var _ abi.Decoder = (*Level3)(nil)
+
+// RandomLevel3 returns a Level3 filled with random values, for property based tests
+func RandomLevel3(r *rand.Rand, maxDepth, maxLen int) Level3 {
+	var t Level3
+	t.Level3 = RandomLevel4(r, maxDepth-1, maxLen)
+	return t
+}
+
+const Level4StaticSize = 64
 
 // Level4 represents an ABI tuple
 type Level4 struct {
@@ -481,12 +1160,13 @@ func (value Level4) EncodeTo(buf []byte) (int, error) {
 		n   int
 	)
 	// Field Value: uint256
-	if _, err := abi.EncodeUint256(value.Value, buf[0:]); err != nil {
+	if _, err := abi.EncodeUint256U256(value.Value, buf[0:]); err != nil {
 		return 0, err
 	}
 
 	// Field Description: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Description, buf[dynamicOffset:])
@@ -519,7 +1199,7 @@ func (t *Level4) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 64
 	// Decode static field Value: uint256
-	t.Value, _, err = abi.DecodeUint256(data[0:])
+	t.Value, _, err = abi.DecodeIntoUint256U256(t.Value, data[0:])
 	if err != nil {
 		return 0, err
 	}
@@ -541,61 +1221,27 @@ func (t *Level4) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const User2StaticSize = 64
-
-var _ abi.Tuple = (*User2)(nil)
-
-// User2 represents an ABI tuple
-type User2 struct {
-	Id      *uint256.Int
-	Profile UserProfile
-}
-
-// EncodedSize returns the total encoded size of User2
-func (t User2) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += t.Profile.EncodedSize()
-
-	return User2StaticSize + dynamicSize
-}
-
-// EncodeTo encodes User2 to ABI bytes in the provided buffer
-func (value User2) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := User2StaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Id: uint256
-	if _, err := abi.EncodeUint256(value.Id, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Profile: (string,string[],(uint256,string[]))
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = value.Profile.EncodeTo(buf[dynamicOffset:])
+// DecodeStrict decodes Level4 from ABI bytes, rejecting unexpected trailing bytes
+func (t *Level4) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	dynamicOffset += n
-
-	return dynamicOffset, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// Encode encodes User2 to ABI bytes
-func (value User2) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+// DecodeHex decodes Level4 from the hex string with an optional 0x prefix
+func (t *Level4) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Level4: %w", err)
 	}
-	return buf, nil
+	return t.Decode(data)
 }
 
-// Decode decodes User2 from ABI bytes in the provided buffer
-func (t *User2) Decode(data []byte) (int, error) {
+// DecodeInto decodes Level4 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Level4) DecodeInto(data []byte) (int, error) {
 	if len(data) < 64 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -605,12 +1251,12 @@ func (t *User2) Decode(data []byte) (int, error) {
 		offset int
 	)
 	dynamicOffset := 64
-	// Decode static field Id: uint256
-	t.Id, _, err = abi.DecodeUint256(data[0:])
+	// Decode static field Value: uint256
+	t.Value, _, err = abi.DecodeIntoUint256U256(t.Value, data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode dynamic field Profile
+	// Decode dynamic field Description
 	{
 		offset, err = abi.DecodeSize(data[32:])
 		if err != nil {
@@ -619,7 +1265,7 @@ func (t *User2) Decode(data []byte) (int, error) {
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		n, err = t.Profile.Decode(data[dynamicOffset:])
+		t.Description, n, err = abi.DecodeString(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -628,52 +1274,66 @@ func (t *User2) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const UserMetadata2StaticSize = 64
+// Reset sets every field of Level4 to the zero value, to reuse it from a pool
+func (t *Level4) Reset() {
+	t.Value = nil
+	t.Description = ""
+}
 
-var _ abi.Tuple = (*UserMetadata2)(nil)
+// Clone returns a deep copy of Level4
+func (t Level4) Clone() Level4 {
+	c := t
+	if t.Value != nil {
+		c.Value = new(uint256.Int).Set(t.Value)
+	}
+	return c
+}
 
-// UserMetadata2 represents an ABI tuple
-type UserMetadata2 struct {
-	CreatedAt *uint256.Int
-	Tags      []string
+var _ abi.Tuple = (*Level4)(nil)
+var _ abi.Decoder = (*Level4)(nil)
+
+// RandomLevel4 returns a Level4 filled with random values, for property based tests
+func RandomLevel4(r *rand.Rand, maxDepth, maxLen int) Level4 {
+	var t Level4
+	t.Value = abi.RandomUint256(r, 256)
+	t.Description = abi.RandomString(r, maxLen)
+	return t
 }
 
-// EncodedSize returns the total encoded size of UserMetadata2
-func (t UserMetadata2) EncodedSize() int {
+const PointStaticSize = 64
+
+// Point represents an ABI tuple
+type Point struct {
+	X     *uint256.Int
+	Owner common.Address
+}
+
+// EncodedSize returns the total encoded size of Point
+func (t Point) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += abi.SizeStringSlice(t.Tags)
 
-	return UserMetadata2StaticSize + dynamicSize
+	return PointStaticSize + dynamicSize
 }
 
-// EncodeTo encodes UserMetadata2 to ABI bytes in the provided buffer
-func (value UserMetadata2) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes Point to ABI bytes in the provided buffer
+func (value Point) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := UserMetadata2StaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field CreatedAt: uint256
-	if _, err := abi.EncodeUint256(value.CreatedAt, buf[0:]); err != nil {
+	dynamicOffset := PointStaticSize // Start dynamic data after static section
+	// Field X: uint256
+	if _, err := abi.EncodeUint256U256(value.X, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field Tags: string[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = abi.EncodeStringSlice(value.Tags, buf[dynamicOffset:])
-	if err != nil {
+	// Field Owner: address
+	if _, err := abi.EncodeAddress(value.Owner, buf[32:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes UserMetadata2 to ABI bytes
-func (value UserMetadata2) Encode() ([]byte, error) {
+// Encode encodes Point to ABI bytes
+func (value Point) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -681,94 +1341,189 @@ func (value UserMetadata2) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes UserMetadata2 from ABI bytes in the provided buffer
-func (t *UserMetadata2) Decode(data []byte) (int, error) {
+// Decode decodes Point from ABI bytes in the provided buffer
+func (t *Point) Decode(data []byte) (int, error) {
 	if len(data) < 64 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		err    error
-		n      int
-		offset int
+		err error
 	)
 	dynamicOffset := 64
-	// Decode static field CreatedAt: uint256
-	t.CreatedAt, _, err = abi.DecodeUint256(data[0:])
+	// Decode static field X: uint256
+	t.X, _, err = abi.DecodeIntoUint256U256(t.X, data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode dynamic field Tags
-	{
-		offset, err = abi.DecodeSize(data[32:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.Tags, n, err = abi.DecodeStringSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field Owner: address
+	t.Owner, _, err = abi.DecodeAddress(data[32:])
+	if err != nil {
+		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-const UserProfileStaticSize = 96
+// DecodeStrict decodes Point from ABI bytes, rejecting unexpected trailing bytes
+func (t *Point) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*UserProfile)(nil)
+// DecodeHex decodes Point from the hex string with an optional 0x prefix
+func (t *Point) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Point: %w", err)
+	}
+	return t.Decode(data)
+}
 
-// UserProfile represents an ABI tuple
-type UserProfile struct {
-	Name     string
-	Emails   []string
-	Metadata UserMetadata2
+// DecodeInto decodes Point from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *Point) DecodeInto(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 64
+	// Decode static field X: uint256
+	t.X, _, err = abi.DecodeIntoUint256U256(t.X, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Owner: address
+	t.Owner, _, err = abi.DecodeAddress(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
 }
 
-// EncodedSize returns the total encoded size of UserProfile
-func (t UserProfile) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += abi.SizeString(t.Name)
-	dynamicSize += abi.SizeStringSlice(t.Emails)
-	dynamicSize += t.Metadata.EncodedSize()
+// Reset sets every field of Point to the zero value, to reuse it from a pool
+func (t *Point) Reset() {
+	t.X = nil
+	t.Owner = common.Address{}
+}
 
-	return UserProfileStaticSize + dynamicSize
+// Clone returns a deep copy of Point
+func (t Point) Clone() Point {
+	c := t
+	if t.X != nil {
+		c.X = new(uint256.Int).Set(t.X)
+	}
+	return c
 }
 
-// EncodeTo encodes UserProfile to ABI bytes in the provided buffer
-func (value UserProfile) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := UserProfileStaticSize // Start dynamic data after static section
+// PackedEncodedSize returns the packed encoded size of Point
+func (t Point) PackedEncodedSize() int {
+	return 52
+}
+
+// PackedEncodeTo encodes Point to packed ABI bytes in the provided buffer
+func (value Point) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
-		err error
-		n   int
+		offset int
+		n      int
+		err    error
 	)
-	// Field Name: string
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
+	// Field X: uint256
+	n, err = abi.PackedEncodeUint256U256(value.X, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
+	offset += n
 
-	// Field Emails: string[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = abi.EncodeStringSlice(value.Emails, buf[dynamicOffset:])
+	// Field Owner: address
+	n, err = abi.PackedEncodeAddress(value.Owner, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
+	offset += n
 
-	// Field Metadata: (uint256,string[])
+	return offset, nil
+}
+
+// PackedEncode encodes Point to packed ABI bytes
+func (value Point) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes Point from packed ABI bytes
+func (t *Point) PackedDecode(data []byte) (int, error) {
+	if len(data) < 52 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field X: uint256
+	t.X, _, err = abi.PackedDecodeUint256U256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Owner: address
+	t.Owner, _, err = abi.PackedDecodeAddress(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	return 52, nil
+}
+
+var _ abi.Tuple = (*Point)(nil)
+var _ abi.Decoder = (*Point)(nil)
+var _ abi.PackedTuple = (*Point)(nil)
+
+// RandomPoint returns a Point filled with random values, for property based tests
+func RandomPoint(r *rand.Rand, maxDepth, maxLen int) Point {
+	var t Point
+	t.X = abi.RandomUint256(r, 256)
+	r.Read(t.Owner[:])
+	return t
+}
+
+const User2StaticSize = 64
+
+// User2 represents an ABI tuple
+type User2 struct {
+	Id      *uint256.Int
+	Profile UserProfile
+}
+
+// EncodedSize returns the total encoded size of User2
+func (t User2) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += t.Profile.EncodedSize()
+
+	return User2StaticSize + dynamicSize
+}
+
+// EncodeTo encodes User2 to ABI bytes in the provided buffer
+func (value User2) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := User2StaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Id: uint256
+	if _, err := abi.EncodeUint256U256(value.Id, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Profile: (string,string[],(uint256,string[]))
 	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
-	n, err = value.Metadata.EncodeTo(buf[dynamicOffset:])
+	n, err = value.Profile.EncodeTo(buf[dynamicOffset:])
 	if err != nil {
 		return 0, err
 	}
@@ -777,8 +1532,8 @@ func (value UserProfile) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes UserProfile to ABI bytes
-func (value UserProfile) Encode() ([]byte, error) {
+// Encode encodes User2 to ABI bytes
+func (value User2) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -786,9 +1541,9 @@ func (value UserProfile) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes UserProfile from ABI bytes in the provided buffer
-func (t *UserProfile) Decode(data []byte) (int, error) {
-	if len(data) < 96 {
+// Decode decodes User2 from ABI bytes in the provided buffer
+func (t *User2) Decode(data []byte) (int, error) {
+	if len(data) < 64 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -796,23 +1551,13 @@ func (t *UserProfile) Decode(data []byte) (int, error) {
 		n      int
 		offset int
 	)
-	dynamicOffset := 96
-	// Decode dynamic field Name
-	{
-		offset, err = abi.DecodeSize(data[0:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.Name, n, err = abi.DecodeString(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	dynamicOffset := 64
+	// Decode static field Id: uint256
+	t.Id, _, err = abi.DecodeIntoUint256U256(t.Id, data[0:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode dynamic field Emails
+	// Decode dynamic field Profile
 	{
 		offset, err = abi.DecodeSize(data[32:])
 		if err != nil {
@@ -821,22 +1566,60 @@ func (t *UserProfile) Decode(data []byte) (int, error) {
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		t.Emails, n, err = abi.DecodeStringSlice(data[dynamicOffset:])
+		n, err = t.Profile.Decode(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-	// Decode dynamic field Metadata
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes User2 from ABI bytes, rejecting unexpected trailing bytes
+func (t *User2) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes User2 from the hex string with an optional 0x prefix
+func (t *User2) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode User2: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes User2 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *User2) DecodeInto(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 64
+	// Decode static field Id: uint256
+	t.Id, _, err = abi.DecodeIntoUint256U256(t.Id, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Profile
 	{
-		offset, err = abi.DecodeSize(data[64:])
+		offset, err = abi.DecodeSize(data[32:])
 		if err != nil {
 			return 0, err
 		}
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		n, err = t.Metadata.Decode(data[dynamicOffset:])
+		n, err = t.Profile.DecodeInto(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -845,52 +1628,68 @@ func (t *UserProfile) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// EncodeAddressArray5 encodes address[5] to ABI bytes
-func EncodeAddressArray5(value [5]common.Address, buf []byte) (int, error) {
-	// Encode fixed-size array with static elements
-	if _, err := abi.EncodeAddress(value[0], buf[0:]); err != nil {
-		return 0, err
-	}
-	if _, err := abi.EncodeAddress(value[1], buf[32:]); err != nil {
-		return 0, err
-	}
-	if _, err := abi.EncodeAddress(value[2], buf[64:]); err != nil {
-		return 0, err
-	}
-	if _, err := abi.EncodeAddress(value[3], buf[96:]); err != nil {
-		return 0, err
-	}
-	if _, err := abi.EncodeAddress(value[4], buf[128:]); err != nil {
-		return 0, err
-	}
-
-	return 160, nil
+// Reset sets every field of User2 to the zero value, to reuse it from a pool
+func (t *User2) Reset() {
+	t.Id = nil
+	t.Profile.Reset()
 }
 
-// EncodeAddressSliceArray3 encodes address[][3] to ABI bytes
-func EncodeAddressSliceArray3(value [3][]common.Address, buf []byte) (int, error) {
-	// Encode fixed-size array with dynamic elements
+// Clone returns a deep copy of User2
+func (t User2) Clone() User2 {
+	c := t
+	if t.Id != nil {
+		c.Id = new(uint256.Int).Set(t.Id)
+	}
+	c.Profile = t.Profile.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*User2)(nil)
+var _ abi.Decoder = (*User2)(nil)
+
+// RandomUser2 returns a User2 filled with random values, for property based tests
+func RandomUser2(r *rand.Rand, maxDepth, maxLen int) User2 {
+	var t User2
+	t.Id = abi.RandomUint256(r, 256)
+	t.Profile = RandomUserProfile(r, maxDepth-1, maxLen)
+	return t
+}
+
+const UserMetadata2StaticSize = 64
+
+// UserMetadata2 represents an ABI tuple
+type UserMetadata2 struct {
+	CreatedAt *uint256.Int
+	Tags      []string
+}
+
+// EncodedSize returns the total encoded size of UserMetadata2
+func (t UserMetadata2) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += abi.SizeStringSlice(t.Tags)
+
+	return UserMetadata2StaticSize + dynamicSize
+}
+
+// EncodeTo encodes UserMetadata2 to ABI bytes in the provided buffer
+func (value UserMetadata2) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := UserMetadata2StaticSize // Start dynamic data after static section
 	var (
-		n   int
 		err error
+		n   int
 	)
-	dynamicOffset := 32 * 3
-	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
-	n, err = abi.EncodeAddressSlice(value[0], buf[dynamicOffset:])
-	if err != nil {
+	// Field CreatedAt: uint256
+	if _, err := abi.EncodeUint256U256(value.CreatedAt, buf[0:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
+	// Field Tags: string[]
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	n, err = abi.EncodeAddressSlice(value[1], buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
-	}
-	dynamicOffset += n
-
-	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
-	n, err = abi.EncodeAddressSlice(value[2], buf[dynamicOffset:])
+	// Encode dynamic data
+	n, err = abi.EncodeStringSlice(value.Tags, buf[dynamicOffset:])
 	if err != nil {
 		return 0, err
 	}
@@ -899,775 +1698,7260 @@ func EncodeAddressSliceArray3(value [3][]common.Address, buf []byte) (int, error
 	return dynamicOffset, nil
 }
 
-// EncodeAddressSliceArray3Slice encodes address[][3][] to ABI bytes
-func EncodeAddressSliceArray3Slice(value [][3][]common.Address, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := EncodeAddressSliceArray3(elem, buf[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+// Encode encodes UserMetadata2 to ABI bytes
+func (value UserMetadata2) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
 	}
-
-	return dynamicOffset + 32, nil
+	return buf, nil
 }
 
-// EncodeBytes32Array2 encodes bytes32[2] to ABI bytes
-func EncodeBytes32Array2(value [2][32]byte, buf []byte) (int, error) {
-	// Encode fixed-size array with static elements
-	if _, err := abi.EncodeBytes32(value[0], buf[0:]); err != nil {
-		return 0, err
+// Decode decodes UserMetadata2 from ABI bytes in the provided buffer
+func (t *UserMetadata2) Decode(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	if _, err := abi.EncodeBytes32(value[1], buf[32:]); err != nil {
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 64
+	// Decode static field CreatedAt: uint256
+	t.CreatedAt, _, err = abi.DecodeIntoUint256U256(t.CreatedAt, data[0:])
+	if err != nil {
 		return 0, err
 	}
-
-	return 64, nil
-}
-
-// EncodeItemSlice encodes (uint32,bytes,bool)[] to ABI bytes
-func EncodeItemSlice(value []Item, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := elem.EncodeTo(buf[dynamicOffset:])
+	// Decode dynamic field Tags
+	{
+		offset, err = abi.DecodeSize(data[32:])
 		if err != nil {
 			return 0, err
 		}
-		dynamicOffset += n
-	}
-
-	return dynamicOffset + 32, nil
-}
-
-// EncodeStringSliceSlice encodes string[][] to ABI bytes
-func EncodeStringSliceSlice(value [][]string, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := abi.EncodeStringSlice(elem, buf[dynamicOffset:])
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Tags, n, err = abi.DecodeStringSlice(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
+	return dynamicOffset, nil
+}
 
-	return dynamicOffset + 32, nil
+// DecodeStrict decodes UserMetadata2 from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserMetadata2) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// EncodeUint256Array3 encodes uint256[3] to ABI bytes
-func EncodeUint256Array3(value [3]*uint256.Int, buf []byte) (int, error) {
-	// Encode fixed-size array with static elements
-	if _, err := abi.EncodeUint256(value[0], buf[0:]); err != nil {
-		return 0, err
+// DecodeHex decodes UserMetadata2 from the hex string with an optional 0x prefix
+func (t *UserMetadata2) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UserMetadata2: %w", err)
 	}
-	if _, err := abi.EncodeUint256(value[1], buf[32:]); err != nil {
-		return 0, err
+	return t.Decode(data)
+}
+
+// DecodeInto decodes UserMetadata2 from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *UserMetadata2) DecodeInto(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	if _, err := abi.EncodeUint256(value[2], buf[64:]); err != nil {
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 64
+	// Decode static field CreatedAt: uint256
+	t.CreatedAt, _, err = abi.DecodeIntoUint256U256(t.CreatedAt, data[0:])
+	if err != nil {
 		return 0, err
 	}
-
-	return 96, nil
-}
-
-// EncodeUint256SliceSlice encodes uint256[][] to ABI bytes
-func EncodeUint256SliceSlice(value [][]*uint256.Int, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := abi.EncodeUint256Slice(elem, buf[dynamicOffset:])
+	// Decode dynamic field Tags
+	{
+		offset, err = abi.DecodeSize(data[32:])
 		if err != nil {
 			return 0, err
 		}
-		dynamicOffset += n
-	}
-
-	return dynamicOffset + 32, nil
-}
-
-// EncodeUser2Slice encodes (uint256,(string,string[],(uint256,string[])))[] to ABI bytes
-func EncodeUser2Slice(value []User2, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
-
-		// Write element at dynamic region
-		n, err := elem.EncodeTo(buf[dynamicOffset:])
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Tags, n, err = abi.DecodeIntoStringSlice(t.Tags, data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
 		dynamicOffset += n
 	}
-
-	return dynamicOffset + 32, nil
+	return dynamicOffset, nil
 }
 
-// EncodeUserSlice encodes (address,string,uint256)[] to ABI bytes
-func EncodeUserSlice(value []User, buf []byte) (int, error) {
-	// Encode length
-	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
-	buf = buf[32:]
-
-	// Encode elements with dynamic types
-	var offset int
-	dynamicOffset := len(value) * 32
-	for _, elem := range value {
-		// Write offset for element
-		offset += 32
-		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+// Reset sets every field of UserMetadata2 to the zero value, to reuse it from a pool
+func (t *UserMetadata2) Reset() {
+	t.CreatedAt = nil
+	t.Tags = nil
+}
 
-		// Write element at dynamic region
-		n, err := elem.EncodeTo(buf[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+// Clone returns a deep copy of UserMetadata2
+func (t UserMetadata2) Clone() UserMetadata2 {
+	c := t
+	if t.CreatedAt != nil {
+		c.CreatedAt = new(uint256.Int).Set(t.CreatedAt)
 	}
-
-	return dynamicOffset + 32, nil
+	c.Tags = slices.Clone(t.Tags)
+	return c
 }
 
-// SizeAddressSliceArray3 returns the encoded size of address[][3]
-func SizeAddressSliceArray3(value [3][]common.Address) int {
-	size := 32 * 3 // offsets
-	size += abi.SizeAddressSlice(value[0])
-	size += abi.SizeAddressSlice(value[1])
-	size += abi.SizeAddressSlice(value[2])
-	return size
-}
+var _ abi.Tuple = (*UserMetadata2)(nil)
+var _ abi.Decoder = (*UserMetadata2)(nil)
 
-// SizeAddressSliceArray3Slice returns the encoded size of address[][3][]
-func SizeAddressSliceArray3Slice(value [][3][]common.Address) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += SizeAddressSliceArray3(elem)
+// RandomUserMetadata2 returns a UserMetadata2 filled with random values, for property based tests
+func RandomUserMetadata2(r *rand.Rand, maxDepth, maxLen int) UserMetadata2 {
+	var t UserMetadata2
+	t.CreatedAt = abi.RandomUint256(r, 256)
+	t.Tags = make([]string, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Tags {
+		t.Tags[i0] = abi.RandomString(r, maxLen)
 	}
-	return size
+	return t
 }
 
-// SizeItemSlice returns the encoded size of (uint32,bytes,bool)[]
-func SizeItemSlice(value []Item) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += elem.EncodedSize()
-	}
-	return size
-}
-
-// SizeStringSliceSlice returns the encoded size of string[][]
-func SizeStringSliceSlice(value [][]string) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += abi.SizeStringSlice(elem)
-	}
-	return size
-}
+const UserProfileStaticSize = 96
 
-// SizeUint256SliceSlice returns the encoded size of uint256[][]
-func SizeUint256SliceSlice(value [][]*uint256.Int) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += abi.SizeUint256Slice(elem)
-	}
-	return size
+// UserProfile represents an ABI tuple
+type UserProfile struct {
+	Name     string
+	Emails   []string
+	Metadata UserMetadata2
 }
 
-// SizeUser2Slice returns the encoded size of (uint256,(string,string[],(uint256,string[])))[]
-func SizeUser2Slice(value []User2) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += elem.EncodedSize()
-	}
-	return size
-}
+// EncodedSize returns the total encoded size of UserProfile
+func (t UserProfile) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += abi.SizeString(t.Name)
+	dynamicSize += abi.SizeStringSlice(t.Emails)
+	dynamicSize += t.Metadata.EncodedSize()
 
-// SizeUserSlice returns the encoded size of (address,string,uint256)[]
-func SizeUserSlice(value []User) int {
-	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
-	for _, elem := range value {
-		size += elem.EncodedSize()
-	}
-	return size
+	return UserProfileStaticSize + dynamicSize
 }
 
-// DecodeAddressArray5 decodes address[5] from ABI bytes
-func DecodeAddressArray5(data []byte) ([5]common.Address, int, error) {
-	// Decode fixed-size array with static elements
+// EncodeTo encodes UserProfile to ABI bytes in the provided buffer
+func (value UserProfile) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := UserProfileStaticSize // Start dynamic data after static section
 	var (
-		result [5]common.Address
-		err    error
+		err error
+		n   int
 	)
-	if len(data) < 160 {
-		return result, 0, io.ErrUnexpectedEOF
-	}
-	// Element 0
-	result[0], _, err = abi.DecodeAddress(data[0:])
-	if err != nil {
-		return result, 0, err
-	}
-	// Element 1
-	result[1], _, err = abi.DecodeAddress(data[32:])
+	// Field Name: string
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
 	if err != nil {
-		return result, 0, err
+		return 0, err
 	}
-	// Element 2
-	result[2], _, err = abi.DecodeAddress(data[64:])
+	dynamicOffset += n
+
+	// Field Emails: string[]
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeStringSlice(value.Emails, buf[dynamicOffset:])
 	if err != nil {
-		return result, 0, err
+		return 0, err
 	}
-	// Element 3
-	result[3], _, err = abi.DecodeAddress(data[96:])
+	dynamicOffset += n
+
+	// Field Metadata: (uint256,string[])
+	// Encode offset pointer
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = value.Metadata.EncodeTo(buf[dynamicOffset:])
 	if err != nil {
-		return result, 0, err
+		return 0, err
 	}
-	// Element 4
-	result[4], _, err = abi.DecodeAddress(data[128:])
-	if err != nil {
-		return result, 0, err
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes UserProfile to ABI bytes
+func (value UserProfile) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
 	}
-	return result, 160, nil
+	return buf, nil
 }
 
-// DecodeAddressSliceArray3 decodes address[][3] from ABI bytes
-func DecodeAddressSliceArray3(data []byte) ([3][]common.Address, int, error) {
-	// Decode fixed-size array with dynamic elements
-	var result [3][]common.Address
+// Decode decodes UserProfile from ABI bytes in the provided buffer
+func (t *UserProfile) Decode(data []byte) (int, error) {
 	if len(data) < 96 {
-		return result, 0, io.ErrUnexpectedEOF
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		n   int
-		err error
-		tmp int
+		err    error
+		n      int
+		offset int
 	)
-	offset := 0
 	dynamicOffset := 96
-	for i := 0; i < 3; i++ {
-		tmp, err = abi.DecodeSize(data[offset:])
+	// Decode dynamic field Name
+	{
+		offset, err = abi.DecodeSize(data[0:])
 		if err != nil {
-			return result, 0, err
+			return 0, err
 		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return result, 0, abi.ErrInvalidOffsetForArrayElement
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		result[i], n, err = abi.DecodeAddressSlice(data[dynamicOffset:])
+		t.Name, n, err = abi.DecodeString(data[dynamicOffset:])
 		if err != nil {
-			return result, 0, err
+			return 0, err
 		}
 		dynamicOffset += n
 	}
-	return result, dynamicOffset, nil
-}
-
-// DecodeAddressSliceArray3Slice decodes address[][3][] from ABI bytes
-func DecodeAddressSliceArray3Slice(data []byte) ([][3][]common.Address, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := abi.DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+	// Decode dynamic field Emails
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Emails, n, err = abi.DecodeStringSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
 	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with dynamic types
-	result := make([][3][]common.Address, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
+	// Decode dynamic field Metadata
+	{
+		offset, err = abi.DecodeSize(data[64:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		result[i], n, err = DecodeAddressSliceArray3(data[dynamicOffset:])
+		n, err = t.Metadata.Decode(data[dynamicOffset:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		dynamicOffset += n
 	}
-	return result, dynamicOffset + 32, nil
+	return dynamicOffset, nil
 }
 
-// DecodeBytes32Array2 decodes bytes32[2] from ABI bytes
-func DecodeBytes32Array2(data []byte) ([2][32]byte, int, error) {
-	// Decode fixed-size array with static elements
-	var (
-		result [2][32]byte
-		err    error
-	)
-	if len(data) < 64 {
-		return result, 0, io.ErrUnexpectedEOF
-	}
-	// Element 0
-	result[0], _, err = abi.DecodeBytes32(data[0:])
+// DecodeStrict decodes UserProfile from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserProfile) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return result, 0, err
-	}
-	// Element 1
-	result[1], _, err = abi.DecodeBytes32(data[32:])
-	if err != nil {
-		return result, 0, err
+		return err
 	}
-	return result, 64, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// DecodeItemSlice decodes (uint32,bytes,bool)[] from ABI bytes
-func DecodeItemSlice(data []byte) ([]Item, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := abi.DecodeSize(data)
+// DecodeHex decodes UserProfile from the hex string with an optional 0x prefix
+func (t *UserProfile) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return nil, 0, err
+		return 0, fmt.Errorf("decode UserProfile: %w", err)
 	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+	return t.Decode(data)
+}
+
+// DecodeInto decodes UserProfile from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *UserProfile) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
+		err    error
 		n      int
 		offset int
 	)
-	// Decode elements with dynamic types
-	result := make([]Item, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
+	dynamicOffset := 96
+	// Decode dynamic field Name
+	{
+		offset, err = abi.DecodeSize(data[0:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		n, err = result[i].Decode(data[dynamicOffset:])
+		t.Name, n, err = abi.DecodeString(data[dynamicOffset:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		dynamicOffset += n
 	}
-	return result, dynamicOffset + 32, nil
-}
-
-// DecodeStringSliceSlice decodes string[][] from ABI bytes
-func DecodeStringSliceSlice(data []byte) ([][]string, int, error) {
+	// Decode dynamic field Emails
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Emails, n, err = abi.DecodeIntoStringSlice(t.Emails, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Metadata
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Metadata.DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of UserProfile to the zero value, to reuse it from a pool
+func (t *UserProfile) Reset() {
+	t.Name = ""
+	t.Emails = nil
+	t.Metadata.Reset()
+}
+
+// Clone returns a deep copy of UserProfile
+func (t UserProfile) Clone() UserProfile {
+	c := t
+	c.Emails = slices.Clone(t.Emails)
+	c.Metadata = t.Metadata.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*UserProfile)(nil)
+var _ abi.Decoder = (*UserProfile)(nil)
+
+// RandomUserProfile returns a UserProfile filled with random values, for property based tests
+func RandomUserProfile(r *rand.Rand, maxDepth, maxLen int) UserProfile {
+	var t UserProfile
+	t.Name = abi.RandomString(r, maxLen)
+	t.Emails = make([]string, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Emails {
+		t.Emails[i0] = abi.RandomString(r, maxLen)
+	}
+	t.Metadata = RandomUserMetadata2(r, maxDepth-1, maxLen)
+	return t
+}
+
+// EncodeAddressArray3 encodes address[3] to ABI bytes
+func EncodeAddressArray3(value [3]common.Address, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeAddress(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[2], buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return 96, nil
+}
+
+// EncodeAddressArray3Array2 encodes address[3][2] to ABI bytes
+func EncodeAddressArray3Array2(value [2][3]common.Address, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := EncodeAddressArray3(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := EncodeAddressArray3(value[1], buf[96:]); err != nil {
+		return 0, err
+	}
+
+	return 192, nil
+}
+
+// EncodeAddressArray4 encodes address[4] to ABI bytes
+func EncodeAddressArray4(value [4]common.Address, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeAddress(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[2], buf[64:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[3], buf[96:]); err != nil {
+		return 0, err
+	}
+
+	return 128, nil
+}
+
+// EncodeAddressArray5 encodes address[5] to ABI bytes
+func EncodeAddressArray5(value [5]common.Address, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeAddress(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[2], buf[64:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[3], buf[96:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeAddress(value[4], buf[128:]); err != nil {
+		return 0, err
+	}
+
+	return 160, nil
+}
+
+// EncodeAddressSliceArray3 encodes address[][3] to ABI bytes
+func EncodeAddressSliceArray3(value [3][]common.Address, buf []byte) (int, error) {
+	// Encode fixed-size array with dynamic elements
+	var (
+		n   int
+		err error
+	)
+	dynamicOffset := 32 * 3
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	n, err = abi.EncodeAddressSlice(value[0], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	n, err = abi.EncodeAddressSlice(value[1], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	n, err = abi.EncodeAddressSlice(value[2], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// EncodeAddressSliceArray3Slice encodes address[][3][] to ABI bytes
+func EncodeAddressSliceArray3Slice(value [][3][]common.Address, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := EncodeAddressSliceArray3(elem, buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodeBytes32Array2 encodes bytes32[2] to ABI bytes
+func EncodeBytes32Array2(value [2][32]byte, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeBytes32(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeBytes32(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+
+	return 64, nil
+}
+
+// EncodeBytesArray2 encodes bytes[2] to ABI bytes
+func EncodeBytesArray2(value [2][]byte, buf []byte) (int, error) {
+	// Encode fixed-size array with dynamic elements
+	var (
+		n   int
+		err error
+	)
+	dynamicOffset := 32 * 2
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	n, err = abi.EncodeBytes(value[0], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	n, err = abi.EncodeBytes(value[1], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// EncodeFixedArrayHolderSlice encodes (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[] to ABI bytes
+func EncodeFixedArrayHolderSlice(value []FixedArrayHolder, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := elem.EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodeItemArray2 encodes (uint32,bytes,bool)[2] to ABI bytes
+func EncodeItemArray2(value [2]Item, buf []byte) (int, error) {
+	// Encode fixed-size array with dynamic elements
+	var (
+		n   int
+		err error
+	)
+	dynamicOffset := 32 * 2
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	n, err = value[0].EncodeTo(buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	n, err = value[1].EncodeTo(buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// EncodeItemSlice encodes (uint32,bytes,bool)[] to ABI bytes
+func EncodeItemSlice(value []Item, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := elem.EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodePointArray2 encodes (uint256,address)[2] to ABI bytes
+func EncodePointArray2(value [2]Point, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := value[0].EncodeTo(buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := value[1].EncodeTo(buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return 128, nil
+}
+
+// EncodePointArray3 encodes (uint256,address)[3] to ABI bytes
+func EncodePointArray3(value [3]Point, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := value[0].EncodeTo(buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := value[1].EncodeTo(buf[64:]); err != nil {
+		return 0, err
+	}
+	if _, err := value[2].EncodeTo(buf[128:]); err != nil {
+		return 0, err
+	}
+
+	return 192, nil
+}
+
+// EncodeStringArray3 encodes string[3] to ABI bytes
+func EncodeStringArray3(value [3]string, buf []byte) (int, error) {
+	// Encode fixed-size array with dynamic elements
+	var (
+		n   int
+		err error
+	)
+	dynamicOffset := 32 * 3
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	n, err = abi.EncodeString(value[0], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	n, err = abi.EncodeString(value[1], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	n, err = abi.EncodeString(value[2], buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// EncodeStringSliceSlice encodes string[][] to ABI bytes
+func EncodeStringSliceSlice(value [][]string, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := abi.EncodeStringSlice(elem, buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodeUint256Array2U256 encodes uint256[2] to ABI bytes
+func EncodeUint256Array2U256(value [2]*uint256.Int, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeUint256U256(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeUint256U256(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+
+	return 64, nil
+}
+
+// EncodeUint256Array2Array2U256 encodes uint256[2][2] to ABI bytes
+func EncodeUint256Array2Array2U256(value [2][2]*uint256.Int, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := EncodeUint256Array2U256(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := EncodeUint256Array2U256(value[1], buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return 128, nil
+}
+
+// EncodeUint256Array2Array3U256 encodes uint256[2][3] to ABI bytes
+func EncodeUint256Array2Array3U256(value [3][2]*uint256.Int, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := EncodeUint256Array2U256(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := EncodeUint256Array2U256(value[1], buf[64:]); err != nil {
+		return 0, err
+	}
+	if _, err := EncodeUint256Array2U256(value[2], buf[128:]); err != nil {
+		return 0, err
+	}
+
+	return 192, nil
+}
+
+// EncodeUint256Array3U256 encodes uint256[3] to ABI bytes
+func EncodeUint256Array3U256(value [3]*uint256.Int, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeUint256U256(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeUint256U256(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeUint256U256(value[2], buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return 96, nil
+}
+
+// EncodeUint256SliceSliceU256 encodes uint256[][] to ABI bytes
+func EncodeUint256SliceSliceU256(value [][]*uint256.Int, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := abi.EncodeUint256SliceU256(elem, buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodeUser2Slice encodes (uint256,(string,string[],(uint256,string[])))[] to ABI bytes
+func EncodeUser2Slice(value []User2, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := elem.EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// EncodeUserSlice encodes (address,string,uint256)[] to ABI bytes
+func EncodeUserSlice(value []User, buf []byte) (int, error) {
+	// Encode length
+	abi.ClearWord(buf)
+	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
+	buf = buf[32:]
+
+	// Encode elements with dynamic types
+	var offset int
+	dynamicOffset := len(value) * 32
+	for _, elem := range value {
+		// Write offset for element
+		abi.ClearWord(buf[offset:])
+		offset += 32
+		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
+
+		// Write element at dynamic region
+		n, err := elem.EncodeTo(buf[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+
+	return dynamicOffset + 32, nil
+}
+
+// SizeAddressSliceArray3 returns the encoded size of address[][3]
+func SizeAddressSliceArray3(value [3][]common.Address) int {
+	size := 32 * 3 // offsets
+	size += abi.SizeAddressSlice(value[0])
+	size += abi.SizeAddressSlice(value[1])
+	size += abi.SizeAddressSlice(value[2])
+	return size
+}
+
+// SizeAddressSliceArray3Slice returns the encoded size of address[][3][]
+func SizeAddressSliceArray3Slice(value [][3][]common.Address) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += SizeAddressSliceArray3(elem)
+	}
+	return size
+}
+
+// SizeBytesArray2 returns the encoded size of bytes[2]
+func SizeBytesArray2(value [2][]byte) int {
+	size := 32 * 2 // offsets
+	size += abi.SizeBytes(value[0])
+	size += abi.SizeBytes(value[1])
+	return size
+}
+
+// SizeFixedArrayHolderSlice returns the encoded size of (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[]
+func SizeFixedArrayHolderSlice(value []FixedArrayHolder) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += elem.EncodedSize()
+	}
+	return size
+}
+
+// SizeItemArray2 returns the encoded size of (uint32,bytes,bool)[2]
+func SizeItemArray2(value [2]Item) int {
+	size := 32 * 2 // offsets
+	size += value[0].EncodedSize()
+	size += value[1].EncodedSize()
+	return size
+}
+
+// SizeItemSlice returns the encoded size of (uint32,bytes,bool)[]
+func SizeItemSlice(value []Item) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += elem.EncodedSize()
+	}
+	return size
+}
+
+// SizeStringArray3 returns the encoded size of string[3]
+func SizeStringArray3(value [3]string) int {
+	size := 32 * 3 // offsets
+	size += abi.SizeString(value[0])
+	size += abi.SizeString(value[1])
+	size += abi.SizeString(value[2])
+	return size
+}
+
+// SizeStringSliceSlice returns the encoded size of string[][]
+func SizeStringSliceSlice(value [][]string) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += abi.SizeStringSlice(elem)
+	}
+	return size
+}
+
+// SizeUint256SliceSliceU256 returns the encoded size of uint256[][]
+func SizeUint256SliceSliceU256(value [][]*uint256.Int) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += abi.SizeUint256SliceU256(elem)
+	}
+	return size
+}
+
+// SizeUser2Slice returns the encoded size of (uint256,(string,string[],(uint256,string[])))[]
+func SizeUser2Slice(value []User2) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += elem.EncodedSize()
+	}
+	return size
+}
+
+// SizeUserSlice returns the encoded size of (address,string,uint256)[]
+func SizeUserSlice(value []User) int {
+	size := 32 + 32*len(value) // length + offset pointers for dynamic elements
+	for _, elem := range value {
+		size += elem.EncodedSize()
+	}
+	return size
+}
+
+// DecodeAddressArray3 decodes address[3] from ABI bytes
+func DecodeAddressArray3(data []byte) ([3]common.Address, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [3]common.Address
+		err    error
+	)
+	if len(data) < 96 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeAddress(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeAddress(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	result[2], _, err = abi.DecodeAddress(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 96, nil
+}
+
+// DecodeAddressArray3Array2 decodes address[3][2] from ABI bytes
+func DecodeAddressArray3Array2(data []byte) ([2][3]common.Address, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2][3]common.Address
+		err    error
+	)
+	if len(data) < 192 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = DecodeAddressArray3(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = DecodeAddressArray3(data[96:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 192, nil
+}
+
+// DecodeAddressArray4 decodes address[4] from ABI bytes
+func DecodeAddressArray4(data []byte) ([4]common.Address, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [4]common.Address
+		err    error
+	)
+	if len(data) < 128 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeAddress(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeAddress(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	result[2], _, err = abi.DecodeAddress(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 3
+	result[3], _, err = abi.DecodeAddress(data[96:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 128, nil
+}
+
+// DecodeAddressArray5 decodes address[5] from ABI bytes
+func DecodeAddressArray5(data []byte) ([5]common.Address, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [5]common.Address
+		err    error
+	)
+	if len(data) < 160 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeAddress(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeAddress(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	result[2], _, err = abi.DecodeAddress(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 3
+	result[3], _, err = abi.DecodeAddress(data[96:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 4
+	result[4], _, err = abi.DecodeAddress(data[128:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 160, nil
+}
+
+// DecodeAddressSliceArray3 decodes address[][3] from ABI bytes
+func DecodeAddressSliceArray3(data []byte) ([3][]common.Address, int, error) {
+	// Decode fixed-size array with dynamic elements
+	var result [3][]common.Address
+	if len(data) < 96 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n   int
+		err error
+		tmp int
+	)
+	offset := 0
+	dynamicOffset := 96
+	for i := 0; i < 3; i++ {
+		tmp, err = abi.DecodeSize(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return result, 0, abi.ErrInvalidOffsetForArrayElement
+		}
+		result[i], n, err = abi.DecodeIntoAddressSlice(result[i], data[dynamicOffset:])
+		if err != nil {
+			return result, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset, nil
+}
+
+// DecodeAddressSliceArray3Slice decodes address[][3][] from ABI bytes
+func DecodeAddressSliceArray3Slice(data []byte) ([][3][]common.Address, int, error) {
+	return DecodeIntoAddressSliceArray3Slice(nil, data)
+}
+
+// DecodeIntoAddressSliceArray3Slice decodes address[][3][] from ABI bytes, reusing the backing array of dst
+func DecodeIntoAddressSliceArray3Slice(dst [][3][]common.Address, data []byte) ([][3][]common.Address, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		result[i], n, err = DecodeAddressSliceArray3(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodeBytes32Array2 decodes bytes32[2] from ABI bytes
+func DecodeBytes32Array2(data []byte) ([2][32]byte, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2][32]byte
+		err    error
+	)
+	if len(data) < 64 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeBytes32(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 64, nil
+}
+
+// DecodeBytesArray2 decodes bytes[2] from ABI bytes
+func DecodeBytesArray2(data []byte) ([2][]byte, int, error) {
+	// Decode fixed-size array with dynamic elements
+	var result [2][]byte
+	if len(data) < 64 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n   int
+		err error
+		tmp int
+	)
+	offset := 0
+	dynamicOffset := 64
+	for i := 0; i < 2; i++ {
+		tmp, err = abi.DecodeSize(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return result, 0, abi.ErrInvalidOffsetForArrayElement
+		}
+		result[i], n, err = abi.DecodeBytes(data[dynamicOffset:])
+		if err != nil {
+			return result, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset, nil
+}
+
+// DecodeFixedArrayHolderSlice decodes (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[] from ABI bytes
+func DecodeFixedArrayHolderSlice(data []byte) ([]FixedArrayHolder, int, error) {
+	return DecodeIntoFixedArrayHolderSlice(nil, data)
+}
+
+// DecodeIntoFixedArrayHolderSlice decodes (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoFixedArrayHolderSlice(dst []FixedArrayHolder, data []byte) ([]FixedArrayHolder, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		n, err = result[i].DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodeItemArray2 decodes (uint32,bytes,bool)[2] from ABI bytes
+func DecodeItemArray2(data []byte) ([2]Item, int, error) {
+	// Decode fixed-size array with dynamic elements
+	var result [2]Item
+	if len(data) < 64 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n   int
+		err error
+		tmp int
+	)
+	offset := 0
+	dynamicOffset := 64
+	for i := 0; i < 2; i++ {
+		tmp, err = abi.DecodeSize(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return result, 0, abi.ErrInvalidOffsetForArrayElement
+		}
+		n, err = result[i].DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return result, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset, nil
+}
+
+// DecodeItemSlice decodes (uint32,bytes,bool)[] from ABI bytes
+func DecodeItemSlice(data []byte) ([]Item, int, error) {
+	return DecodeIntoItemSlice(nil, data)
+}
+
+// DecodeIntoItemSlice decodes (uint32,bytes,bool)[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoItemSlice(dst []Item, data []byte) ([]Item, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		n, err = result[i].DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodePointArray2 decodes (uint256,address)[2] from ABI bytes
+func DecodePointArray2(data []byte) ([2]Point, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2]Point
+		err    error
+	)
+	if len(data) < 128 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	_, err = result[0].Decode(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	_, err = result[1].Decode(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 128, nil
+}
+
+// DecodePointArray3 decodes (uint256,address)[3] from ABI bytes
+func DecodePointArray3(data []byte) ([3]Point, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [3]Point
+		err    error
+	)
+	if len(data) < 192 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	_, err = result[0].Decode(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	_, err = result[1].Decode(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	_, err = result[2].Decode(data[128:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 192, nil
+}
+
+// DecodeStringArray3 decodes string[3] from ABI bytes
+func DecodeStringArray3(data []byte) ([3]string, int, error) {
+	// Decode fixed-size array with dynamic elements
+	var result [3]string
+	if len(data) < 96 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n   int
+		err error
+		tmp int
+	)
+	offset := 0
+	dynamicOffset := 96
+	for i := 0; i < 3; i++ {
+		tmp, err = abi.DecodeSize(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return result, 0, abi.ErrInvalidOffsetForArrayElement
+		}
+		result[i], n, err = abi.DecodeString(data[dynamicOffset:])
+		if err != nil {
+			return result, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset, nil
+}
+
+// DecodeStringSliceSlice decodes string[][] from ABI bytes
+func DecodeStringSliceSlice(data []byte) ([][]string, int, error) {
+	return DecodeIntoStringSliceSlice(nil, data)
+}
+
+// DecodeIntoStringSliceSlice decodes string[][] from ABI bytes, reusing the backing array of dst
+func DecodeIntoStringSliceSlice(dst [][]string, data []byte) ([][]string, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		result[i], n, err = abi.DecodeIntoStringSlice(result[i], data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodeUint256Array2U256 decodes uint256[2] from ABI bytes
+func DecodeUint256Array2U256(data []byte) ([2]*uint256.Int, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2]*uint256.Int
+		err    error
+	)
+	if len(data) < 64 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeUint256U256(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeUint256U256(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 64, nil
+}
+
+// DecodeUint256Array2Array2U256 decodes uint256[2][2] from ABI bytes
+func DecodeUint256Array2Array2U256(data []byte) ([2][2]*uint256.Int, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2][2]*uint256.Int
+		err    error
+	)
+	if len(data) < 128 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = DecodeUint256Array2U256(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = DecodeUint256Array2U256(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 128, nil
+}
+
+// DecodeUint256Array2Array3U256 decodes uint256[2][3] from ABI bytes
+func DecodeUint256Array2Array3U256(data []byte) ([3][2]*uint256.Int, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [3][2]*uint256.Int
+		err    error
+	)
+	if len(data) < 192 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = DecodeUint256Array2U256(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = DecodeUint256Array2U256(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	result[2], _, err = DecodeUint256Array2U256(data[128:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 192, nil
+}
+
+// DecodeUint256Array3U256 decodes uint256[3] from ABI bytes
+func DecodeUint256Array3U256(data []byte) ([3]*uint256.Int, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [3]*uint256.Int
+		err    error
+	)
+	if len(data) < 96 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeUint256U256(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeUint256U256(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 2
+	result[2], _, err = abi.DecodeUint256U256(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 96, nil
+}
+
+// DecodeUint256SliceSliceU256 decodes uint256[][] from ABI bytes
+func DecodeUint256SliceSliceU256(data []byte) ([][]*uint256.Int, int, error) {
+	return DecodeIntoUint256SliceSliceU256(nil, data)
+}
+
+// DecodeIntoUint256SliceSliceU256 decodes uint256[][] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUint256SliceSliceU256(dst [][]*uint256.Int, data []byte) ([][]*uint256.Int, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		result[i], n, err = abi.DecodeIntoUint256SliceU256(result[i], data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodeUser2Slice decodes (uint256,(string,string[],(uint256,string[])))[] from ABI bytes
+func DecodeUser2Slice(data []byte) ([]User2, int, error) {
+	return DecodeIntoUser2Slice(nil, data)
+}
+
+// DecodeIntoUser2Slice decodes (uint256,(string,string[],(uint256,string[])))[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUser2Slice(dst []User2, data []byte) ([]User2, int, error) {
+	// Decode length
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		n, err = result[i].DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// DecodeUserSlice decodes (address,string,uint256)[] from ABI bytes
+func DecodeUserSlice(data []byte) ([]User, int, error) {
+	return DecodeIntoUserSlice(nil, data)
+}
+
+// DecodeIntoUserSlice decodes (address,string,uint256)[] from ABI bytes, reusing the backing array of dst
+func DecodeIntoUserSlice(dst []User, data []byte) ([]User, int, error) {
 	// Decode length
 	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	length, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[32:]
+	if length > len(data)/32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		n      int
+		offset int
+	)
+	// Decode elements with dynamic types
+	result := abi.ResizeSlice(dst, length)
+	dynamicOffset := length * 32
+	for i := 0; i < length; i++ {
+		tmp, err := abi.DecodeSize(data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		offset += 32
+
+		if dynamicOffset != tmp {
+			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		}
+		n, err = result[i].Decode(data[dynamicOffset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		dynamicOffset += n
+	}
+	return result, dynamicOffset + 32, nil
+}
+
+// PackedEncodeAddressArray3 encodes address[3] to packed ABI bytes (no padding)
+func PackedEncodeAddressArray3(value [3]common.Address, buf []byte) (int, error) {
+	if len(buf) < 60 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 3; i++ {
+		n, err := abi.PackedEncodeAddress(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 60, nil
+}
+
+// PackedEncodeAddressArray3Array2 encodes address[3][2] to packed ABI bytes (no padding)
+func PackedEncodeAddressArray3Array2(value [2][3]common.Address, buf []byte) (int, error) {
+	if len(buf) < 120 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := PackedEncodeAddressArray3(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 120, nil
+}
+
+// PackedEncodeAddressArray4 encodes address[4] to packed ABI bytes (no padding)
+func PackedEncodeAddressArray4(value [4]common.Address, buf []byte) (int, error) {
+	if len(buf) < 80 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 4; i++ {
+		n, err := abi.PackedEncodeAddress(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 80, nil
+}
+
+// PackedEncodeAddressArray5 encodes address[5] to packed ABI bytes (no padding)
+func PackedEncodeAddressArray5(value [5]common.Address, buf []byte) (int, error) {
+	if len(buf) < 100 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 5; i++ {
+		n, err := abi.PackedEncodeAddress(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 100, nil
+}
+
+// PackedEncodeBytes32Array2 encodes bytes32[2] to packed ABI bytes (no padding)
+func PackedEncodeBytes32Array2(value [2][32]byte, buf []byte) (int, error) {
+	if len(buf) < 64 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := abi.PackedEncodeBytes32(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 64, nil
+}
+
+// PackedEncodePointArray2 encodes (uint256,address)[2] to packed ABI bytes (no padding)
+func PackedEncodePointArray2(value [2]Point, buf []byte) (int, error) {
+	if len(buf) < 104 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := value[i].PackedEncodeTo(buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 104, nil
+}
+
+// PackedEncodePointArray3 encodes (uint256,address)[3] to packed ABI bytes (no padding)
+func PackedEncodePointArray3(value [3]Point, buf []byte) (int, error) {
+	if len(buf) < 156 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 3; i++ {
+		n, err := value[i].PackedEncodeTo(buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 156, nil
+}
+
+// PackedEncodeUint256Array2U256 encodes uint256[2] to packed ABI bytes (no padding)
+func PackedEncodeUint256Array2U256(value [2]*uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 64 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := abi.PackedEncodeUint256U256(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 64, nil
+}
+
+// PackedEncodeUint256Array2Array2U256 encodes uint256[2][2] to packed ABI bytes (no padding)
+func PackedEncodeUint256Array2Array2U256(value [2][2]*uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 128 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := PackedEncodeUint256Array2U256(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 128, nil
+}
+
+// PackedEncodeUint256Array2Array3U256 encodes uint256[2][3] to packed ABI bytes (no padding)
+func PackedEncodeUint256Array2Array3U256(value [3][2]*uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 192 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 3; i++ {
+		n, err := PackedEncodeUint256Array2U256(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 192, nil
+}
+
+// PackedEncodeUint256Array3U256 encodes uint256[3] to packed ABI bytes (no padding)
+func PackedEncodeUint256Array3U256(value [3]*uint256.Int, buf []byte) (int, error) {
+	if len(buf) < 96 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 3; i++ {
+		n, err := abi.PackedEncodeUint256U256(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 96, nil
+}
+
+// PackedDecodeAddressArray3 decodes address[3] from packed ABI bytes (no padding)
+func PackedDecodeAddressArray3(data []byte) ([3]common.Address, int, error) {
+	if len(data) < 60 {
+		return [3]common.Address{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [3]common.Address
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 3; i++ {
+		result[i], n, err = abi.PackedDecodeAddress(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 60, nil
+}
+
+// PackedDecodeAddressArray3Array2 decodes address[3][2] from packed ABI bytes (no padding)
+func PackedDecodeAddressArray3Array2(data []byte) ([2][3]common.Address, int, error) {
+	if len(data) < 120 {
+		return [2][3]common.Address{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2][3]common.Address
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		result[i], n, err = PackedDecodeAddressArray3(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 120, nil
+}
+
+// PackedDecodeAddressArray4 decodes address[4] from packed ABI bytes (no padding)
+func PackedDecodeAddressArray4(data []byte) ([4]common.Address, int, error) {
+	if len(data) < 80 {
+		return [4]common.Address{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [4]common.Address
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 4; i++ {
+		result[i], n, err = abi.PackedDecodeAddress(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 80, nil
+}
+
+// PackedDecodeAddressArray5 decodes address[5] from packed ABI bytes (no padding)
+func PackedDecodeAddressArray5(data []byte) ([5]common.Address, int, error) {
+	if len(data) < 100 {
+		return [5]common.Address{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [5]common.Address
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 5; i++ {
+		result[i], n, err = abi.PackedDecodeAddress(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 100, nil
+}
+
+// PackedDecodeBytes32Array2 decodes bytes32[2] from packed ABI bytes (no padding)
+func PackedDecodeBytes32Array2(data []byte) ([2][32]byte, int, error) {
+	if len(data) < 64 {
+		return [2][32]byte{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2][32]byte
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		result[i], n, err = abi.PackedDecodeBytes32(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 64, nil
+}
+
+// PackedDecodePointArray2 decodes (uint256,address)[2] from packed ABI bytes (no padding)
+func PackedDecodePointArray2(data []byte) ([2]Point, int, error) {
+	if len(data) < 104 {
+		return [2]Point{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2]Point
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		n, err = result[i].PackedDecode(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 104, nil
+}
+
+// PackedDecodePointArray3 decodes (uint256,address)[3] from packed ABI bytes (no padding)
+func PackedDecodePointArray3(data []byte) ([3]Point, int, error) {
+	if len(data) < 156 {
+		return [3]Point{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [3]Point
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 3; i++ {
+		n, err = result[i].PackedDecode(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 156, nil
+}
+
+// PackedDecodeUint256Array2U256 decodes uint256[2] from packed ABI bytes (no padding)
+func PackedDecodeUint256Array2U256(data []byte) ([2]*uint256.Int, int, error) {
+	if len(data) < 64 {
+		return [2]*uint256.Int{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2]*uint256.Int
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		result[i], n, err = abi.PackedDecodeUint256U256(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 64, nil
+}
+
+// PackedDecodeUint256Array2Array2U256 decodes uint256[2][2] from packed ABI bytes (no padding)
+func PackedDecodeUint256Array2Array2U256(data []byte) ([2][2]*uint256.Int, int, error) {
+	if len(data) < 128 {
+		return [2][2]*uint256.Int{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2][2]*uint256.Int
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		result[i], n, err = PackedDecodeUint256Array2U256(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 128, nil
+}
+
+// PackedDecodeUint256Array2Array3U256 decodes uint256[2][3] from packed ABI bytes (no padding)
+func PackedDecodeUint256Array2Array3U256(data []byte) ([3][2]*uint256.Int, int, error) {
+	if len(data) < 192 {
+		return [3][2]*uint256.Int{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [3][2]*uint256.Int
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 3; i++ {
+		result[i], n, err = PackedDecodeUint256Array2U256(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 192, nil
+}
+
+// PackedDecodeUint256Array3U256 decodes uint256[3] from packed ABI bytes (no padding)
+func PackedDecodeUint256Array3U256(data []byte) ([3]*uint256.Int, int, error) {
+	if len(data) < 96 {
+		return [3]*uint256.Int{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [3]*uint256.Int
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 3; i++ {
+		result[i], n, err = abi.PackedDecodeUint256U256(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 96, nil
+}
+
+// EncodeTopLevelAddressSliceArray3Slice encodes address[][3][] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelAddressSliceArray3Slice(value [][3][]common.Address) ([]byte, error) {
+	buf := make([]byte, 32+SizeAddressSliceArray3Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeAddressSliceArray3Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelAddressSliceArray3Slice decodes address[][3][] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelAddressSliceArray3Slice(data []byte) ([][3][]common.Address, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeAddressSliceArray3Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelFixedArrayHolderSlice encodes (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelFixedArrayHolderSlice(value []FixedArrayHolder) ([]byte, error) {
+	buf := make([]byte, 32+SizeFixedArrayHolderSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeFixedArrayHolderSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelFixedArrayHolderSlice decodes (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelFixedArrayHolderSlice(data []byte) ([]FixedArrayHolder, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeFixedArrayHolderSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelItemSlice encodes (uint32,bytes,bool)[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelItemSlice(value []Item) ([]byte, error) {
+	buf := make([]byte, 32+SizeItemSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeItemSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelItemSlice decodes (uint32,bytes,bool)[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelItemSlice(data []byte) ([]Item, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeItemSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelStringSliceSlice encodes string[][] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelStringSliceSlice(value [][]string) ([]byte, error) {
+	buf := make([]byte, 32+SizeStringSliceSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeStringSliceSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelStringSliceSlice decodes string[][] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelStringSliceSlice(data []byte) ([][]string, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeStringSliceSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUint256SliceSliceU256 encodes uint256[][] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUint256SliceSliceU256(value [][]*uint256.Int) ([]byte, error) {
+	buf := make([]byte, 32+SizeUint256SliceSliceU256(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUint256SliceSliceU256(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUint256SliceSliceU256 decodes uint256[][] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUint256SliceSliceU256(data []byte) ([][]*uint256.Int, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUint256SliceSliceU256(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUser2Slice encodes (uint256,(string,string[],(uint256,string[])))[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUser2Slice(value []User2) ([]byte, error) {
+	buf := make([]byte, 32+SizeUser2Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUser2Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUser2Slice decodes (uint256,(string,string[],(uint256,string[])))[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUser2Slice(data []byte) ([]User2, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUser2Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// EncodeTopLevelUserSlice encodes (address,string,uint256)[] to ABI bytes as a single top-level value, including the leading offset word
+func EncodeTopLevelUserSlice(value []User) ([]byte, error) {
+	buf := make([]byte, 32+SizeUserSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := EncodeUserSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// DecodeTopLevelUserSlice decodes (address,string,uint256)[] from ABI bytes of a single top-level value, including the leading offset word
+func DecodeTopLevelUserSlice(data []byte) ([]User, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := DecodeUserSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+var _ abi.Method = (*LogsCall)(nil)
+
+const LogsCallStaticSize = 32
+
+// LogsCall represents an ABI tuple
+type LogsCall struct {
+	Entries [][]byte
+}
+
+// EncodedSize returns the total encoded size of LogsCall
+func (t LogsCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += abi.SizeBytesSlice(t.Entries)
+
+	return LogsCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes LogsCall to ABI bytes in the provided buffer
+func (value LogsCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := LogsCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Entries: bytes[]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeBytesSlice(value.Entries, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes LogsCall to ABI bytes
+func (value LogsCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes LogsCall from ABI bytes in the provided buffer
+func (t *LogsCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Entries
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Entries, n, err = abi.DecodeBytesSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes LogsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *LogsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes LogsCall from the hex string with an optional 0x prefix
+func (t *LogsCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode LogsCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes LogsCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *LogsCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Entries
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Entries, n, err = abi.DecodeIntoBytesSlice(t.Entries, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of LogsCall to the zero value, to reuse it from a pool
+func (t *LogsCall) Reset() {
+	t.Entries = nil
+}
+
+// Clone returns a deep copy of LogsCall
+func (t LogsCall) Clone() LogsCall {
+	c := t
+	if t.Entries != nil {
+		c.Entries = make([][]byte, len(t.Entries))
+		for i0 := range t.Entries {
+			c.Entries[i0] = bytes.Clone(t.Entries[i0])
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*LogsCall)(nil)
+var _ abi.Decoder = (*LogsCall)(nil)
+
+// RandomLogsCall returns a LogsCall filled with random values, for property based tests
+func RandomLogsCall(r *rand.Rand, maxDepth, maxLen int) LogsCall {
+	var t LogsCall
+	t.Entries = make([][]byte, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Entries {
+		t.Entries[i0] = abi.RandomBytes(r, maxLen)
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t LogsCall) GetMethodName() string {
+	return "logs"
+}
+
+// GetMethodID returns the function id
+func (t LogsCall) GetMethodID() uint32 {
+	return LogsID
+}
+
+// GetMethodSelector returns the function selector
+func (t LogsCall) GetMethodSelector() [4]byte {
+	return LogsSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of logs arguments including function selector
+func (t LogsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes logs arguments to ABI bytes including function selector
+func (t LogsCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], LogsSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes logs arguments to 0x prefixed hex string
+func (t LogsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes logs arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t LogsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the logs calldata, returns 0 if encoding fails
+func (t LogsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes logs arguments from ABI bytes including function selector
+func (t *LogsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != LogsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes logs arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *LogsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode LogsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewLogsCall constructs a new LogsCall
+func NewLogsCall(
+	entries [][]byte,
+) *LogsCall {
+	return &LogsCall{
+		Entries: entries,
+	}
+}
+
+const LogsReturnStaticSize = 32
+
+// LogsReturn represents an ABI tuple
+type LogsReturn struct {
+	Field1 [][]byte
+}
+
+// EncodedSize returns the total encoded size of LogsReturn
+func (t LogsReturn) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += abi.SizeBytesSlice(t.Field1)
+
+	return LogsReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes LogsReturn to ABI bytes in the provided buffer
+func (value LogsReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := LogsReturnStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Field1: bytes[]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeBytesSlice(value.Field1, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes LogsReturn to ABI bytes
+func (value LogsReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes LogsReturn from ABI bytes in the provided buffer
+func (t *LogsReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Field1
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Field1, n, err = abi.DecodeBytesSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes LogsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *LogsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes LogsReturn from the hex string with an optional 0x prefix
+func (t *LogsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode LogsReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes LogsReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *LogsReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Field1
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Field1, n, err = abi.DecodeIntoBytesSlice(t.Field1, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of LogsReturn to the zero value, to reuse it from a pool
+func (t *LogsReturn) Reset() {
+	t.Field1 = nil
+}
+
+// Clone returns a deep copy of LogsReturn
+func (t LogsReturn) Clone() LogsReturn {
+	c := t
+	if t.Field1 != nil {
+		c.Field1 = make([][]byte, len(t.Field1))
+		for i0 := range t.Field1 {
+			c.Field1[i0] = bytes.Clone(t.Field1[i0])
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*LogsReturn)(nil)
+var _ abi.Decoder = (*LogsReturn)(nil)
+
+// RandomLogsReturn returns a LogsReturn filled with random values, for property based tests
+func RandomLogsReturn(r *rand.Rand, maxDepth, maxLen int) LogsReturn {
+	var t LogsReturn
+	t.Field1 = make([][]byte, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Field1 {
+		t.Field1[i0] = abi.RandomBytes(r, maxLen)
+	}
+	return t
+}
+
+// DecodeLogsReturn decodes the return data of logs into its values
+func DecodeLogsReturn(data []byte) (r1 [][]byte, err error) {
+	var result LogsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeLogs decodes the single return value of logs
+func DecodeLogs(data []byte) ([][]byte, error) {
+	return DecodeLogsReturn(data)
+}
+
+// DecodeLogsHex decodes the single return value of logs from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeLogsHex(s string) ([][]byte, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero [][]byte
+		return zero, fmt.Errorf("decode LogsReturn: %w", err)
+	}
+	return DecodeLogsReturn(data)
+}
+
+// EncodeLogsResult encodes the single return value of logs, e.g. for the return data of precompiles
+func EncodeLogsResult(v [][]byte) ([]byte, error) {
+	result := LogsReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TagsCall)(nil)
+
+const TagsCallStaticSize = 32
+
+// TagsCall represents an ABI tuple
+type TagsCall struct {
+	T [3]string
+}
+
+// EncodedSize returns the total encoded size of TagsCall
+func (t TagsCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeStringArray3(t.T)
+
+	return TagsCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TagsCall to ABI bytes in the provided buffer
+func (value TagsCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TagsCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field T: string[3]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeStringArray3(value.T, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TagsCall to ABI bytes
+func (value TagsCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TagsCall from ABI bytes in the provided buffer
+func (t *TagsCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field T
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.T, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TagsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TagsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TagsCall from the hex string with an optional 0x prefix
+func (t *TagsCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TagsCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TagsCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TagsCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field T
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.T, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TagsCall to the zero value, to reuse it from a pool
+func (t *TagsCall) Reset() {
+	t.T = [3]string{}
+}
+
+// Clone returns a deep copy of TagsCall
+func (t TagsCall) Clone() TagsCall {
+	c := t
+	return c
+}
+
+var _ abi.Tuple = (*TagsCall)(nil)
+var _ abi.Decoder = (*TagsCall)(nil)
+
+// RandomTagsCall returns a TagsCall filled with random values, for property based tests
+func RandomTagsCall(r *rand.Rand, maxDepth, maxLen int) TagsCall {
+	var t TagsCall
+	for i0 := range t.T {
+		t.T[i0] = abi.RandomString(r, maxLen)
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TagsCall) GetMethodName() string {
+	return "tags"
+}
+
+// GetMethodID returns the function id
+func (t TagsCall) GetMethodID() uint32 {
+	return TagsID
+}
+
+// GetMethodSelector returns the function selector
+func (t TagsCall) GetMethodSelector() [4]byte {
+	return TagsSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of tags arguments including function selector
+func (t TagsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes tags arguments to ABI bytes including function selector
+func (t TagsCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TagsSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes tags arguments to 0x prefixed hex string
+func (t TagsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes tags arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TagsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the tags calldata, returns 0 if encoding fails
+func (t TagsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes tags arguments from ABI bytes including function selector
+func (t *TagsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TagsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes tags arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TagsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TagsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTagsCall constructs a new TagsCall
+func NewTagsCall(
+	t [3]string,
+) *TagsCall {
+	return &TagsCall{
+		T: t,
+	}
+}
+
+const TagsReturnStaticSize = 32
+
+// TagsReturn represents an ABI tuple
+type TagsReturn struct {
+	Field1 [3]string
+}
+
+// EncodedSize returns the total encoded size of TagsReturn
+func (t TagsReturn) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeStringArray3(t.Field1)
+
+	return TagsReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TagsReturn to ABI bytes in the provided buffer
+func (value TagsReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TagsReturnStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Field1: string[3]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeStringArray3(value.Field1, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TagsReturn to ABI bytes
+func (value TagsReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TagsReturn from ABI bytes in the provided buffer
+func (t *TagsReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Field1
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Field1, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TagsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TagsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TagsReturn from the hex string with an optional 0x prefix
+func (t *TagsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TagsReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TagsReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TagsReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Field1
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Field1, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TagsReturn to the zero value, to reuse it from a pool
+func (t *TagsReturn) Reset() {
+	t.Field1 = [3]string{}
+}
+
+// Clone returns a deep copy of TagsReturn
+func (t TagsReturn) Clone() TagsReturn {
+	c := t
+	return c
+}
+
+var _ abi.Tuple = (*TagsReturn)(nil)
+var _ abi.Decoder = (*TagsReturn)(nil)
+
+// RandomTagsReturn returns a TagsReturn filled with random values, for property based tests
+func RandomTagsReturn(r *rand.Rand, maxDepth, maxLen int) TagsReturn {
+	var t TagsReturn
+	for i0 := range t.Field1 {
+		t.Field1[i0] = abi.RandomString(r, maxLen)
+	}
+	return t
+}
+
+// DecodeTagsReturn decodes the return data of tags into its values
+func DecodeTagsReturn(data []byte) (r1 [3]string, err error) {
+	var result TagsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTags decodes the single return value of tags
+func DecodeTags(data []byte) ([3]string, error) {
+	return DecodeTagsReturn(data)
+}
+
+// DecodeTagsHex decodes the single return value of tags from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTagsHex(s string) ([3]string, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero [3]string
+		return zero, fmt.Errorf("decode TagsReturn: %w", err)
+	}
+	return DecodeTagsReturn(data)
+}
+
+// EncodeTagsResult encodes the single return value of tags, e.g. for the return data of precompiles
+func EncodeTagsResult(v [3]string) ([]byte, error) {
+	result := TagsReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestComplexDynamicTuplesCall)(nil)
+
+const TestComplexDynamicTuplesCallStaticSize = 32
+
+// TestComplexDynamicTuplesCall represents an ABI tuple
+type TestComplexDynamicTuplesCall struct {
+	Users []User2
+}
+
+// EncodedSize returns the total encoded size of TestComplexDynamicTuplesCall
+func (t TestComplexDynamicTuplesCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeUser2Slice(t.Users)
+
+	return TestComplexDynamicTuplesCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestComplexDynamicTuplesCall to ABI bytes in the provided buffer
+func (value TestComplexDynamicTuplesCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestComplexDynamicTuplesCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Users: (uint256,(string,string[],(uint256,string[])))[]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUser2Slice(value.Users, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestComplexDynamicTuplesCall to ABI bytes
+func (value TestComplexDynamicTuplesCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestComplexDynamicTuplesCall from ABI bytes in the provided buffer
+func (t *TestComplexDynamicTuplesCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Users
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Users, n, err = DecodeUser2Slice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestComplexDynamicTuplesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestComplexDynamicTuplesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestComplexDynamicTuplesCall from the hex string with an optional 0x prefix
+func (t *TestComplexDynamicTuplesCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestComplexDynamicTuplesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestComplexDynamicTuplesCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestComplexDynamicTuplesCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Users
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Users, n, err = DecodeIntoUser2Slice(t.Users, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestComplexDynamicTuplesCall to the zero value, to reuse it from a pool
+func (t *TestComplexDynamicTuplesCall) Reset() {
+	t.Users = nil
+}
+
+// Clone returns a deep copy of TestComplexDynamicTuplesCall
+func (t TestComplexDynamicTuplesCall) Clone() TestComplexDynamicTuplesCall {
+	c := t
+	if t.Users != nil {
+		c.Users = make([]User2, len(t.Users))
+		for i0 := range t.Users {
+			c.Users[i0] = t.Users[i0].Clone()
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*TestComplexDynamicTuplesCall)(nil)
+var _ abi.Decoder = (*TestComplexDynamicTuplesCall)(nil)
+
+// RandomTestComplexDynamicTuplesCall returns a TestComplexDynamicTuplesCall filled with random values, for property based tests
+func RandomTestComplexDynamicTuplesCall(r *rand.Rand, maxDepth, maxLen int) TestComplexDynamicTuplesCall {
+	var t TestComplexDynamicTuplesCall
+	t.Users = make([]User2, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Users {
+		t.Users[i0] = RandomUser2(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestComplexDynamicTuplesCall) GetMethodName() string {
+	return "testComplexDynamicTuples"
+}
+
+// GetMethodID returns the function id
+func (t TestComplexDynamicTuplesCall) GetMethodID() uint32 {
+	return TestComplexDynamicTuplesID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestComplexDynamicTuplesCall) GetMethodSelector() [4]byte {
+	return TestComplexDynamicTuplesSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testComplexDynamicTuples arguments including function selector
+func (t TestComplexDynamicTuplesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testComplexDynamicTuples arguments to ABI bytes including function selector
+func (t TestComplexDynamicTuplesCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestComplexDynamicTuplesSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testComplexDynamicTuples arguments to 0x prefixed hex string
+func (t TestComplexDynamicTuplesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testComplexDynamicTuples arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestComplexDynamicTuplesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testComplexDynamicTuples calldata, returns 0 if encoding fails
+func (t TestComplexDynamicTuplesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testComplexDynamicTuples arguments from ABI bytes including function selector
+func (t *TestComplexDynamicTuplesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestComplexDynamicTuplesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testComplexDynamicTuples arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestComplexDynamicTuplesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestComplexDynamicTuplesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestComplexDynamicTuplesCall constructs a new TestComplexDynamicTuplesCall
+func NewTestComplexDynamicTuplesCall(
+	users []User2,
+) *TestComplexDynamicTuplesCall {
+	return &TestComplexDynamicTuplesCall{
+		Users: users,
+	}
+}
+
+const TestComplexDynamicTuplesReturnStaticSize = 32
+
+// TestComplexDynamicTuplesReturn represents an ABI tuple
+type TestComplexDynamicTuplesReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestComplexDynamicTuplesReturn
+func (t TestComplexDynamicTuplesReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestComplexDynamicTuplesReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestComplexDynamicTuplesReturn to ABI bytes in the provided buffer
+func (value TestComplexDynamicTuplesReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestComplexDynamicTuplesReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestComplexDynamicTuplesReturn to ABI bytes
+func (value TestComplexDynamicTuplesReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestComplexDynamicTuplesReturn from ABI bytes in the provided buffer
+func (t *TestComplexDynamicTuplesReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestComplexDynamicTuplesReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestComplexDynamicTuplesReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestComplexDynamicTuplesReturn from the hex string with an optional 0x prefix
+func (t *TestComplexDynamicTuplesReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestComplexDynamicTuplesReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestComplexDynamicTuplesReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestComplexDynamicTuplesReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestComplexDynamicTuplesReturn to the zero value, to reuse it from a pool
+func (t *TestComplexDynamicTuplesReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestComplexDynamicTuplesReturn
+func (t TestComplexDynamicTuplesReturn) Clone() TestComplexDynamicTuplesReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestComplexDynamicTuplesReturn
+func (t TestComplexDynamicTuplesReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestComplexDynamicTuplesReturn to packed ABI bytes in the provided buffer
+func (value TestComplexDynamicTuplesReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestComplexDynamicTuplesReturn to packed ABI bytes
+func (value TestComplexDynamicTuplesReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestComplexDynamicTuplesReturn from packed ABI bytes
+func (t *TestComplexDynamicTuplesReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestComplexDynamicTuplesReturn)(nil)
+var _ abi.Decoder = (*TestComplexDynamicTuplesReturn)(nil)
+var _ abi.PackedTuple = (*TestComplexDynamicTuplesReturn)(nil)
+
+// RandomTestComplexDynamicTuplesReturn returns a TestComplexDynamicTuplesReturn filled with random values, for property based tests
+func RandomTestComplexDynamicTuplesReturn(r *rand.Rand, maxDepth, maxLen int) TestComplexDynamicTuplesReturn {
+	var t TestComplexDynamicTuplesReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestComplexDynamicTuplesReturn decodes the return data of testComplexDynamicTuples into its values
+func DecodeTestComplexDynamicTuplesReturn(data []byte) (r1 bool, err error) {
+	var result TestComplexDynamicTuplesReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestComplexDynamicTuples decodes the single return value of testComplexDynamicTuples
+func DecodeTestComplexDynamicTuples(data []byte) (bool, error) {
+	return DecodeTestComplexDynamicTuplesReturn(data)
+}
+
+// DecodeTestComplexDynamicTuplesHex decodes the single return value of testComplexDynamicTuples from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestComplexDynamicTuplesHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestComplexDynamicTuplesReturn: %w", err)
+	}
+	return DecodeTestComplexDynamicTuplesReturn(data)
+}
+
+// EncodeTestComplexDynamicTuplesResult encodes the single return value of testComplexDynamicTuples, e.g. for the return data of precompiles
+func EncodeTestComplexDynamicTuplesResult(v bool) ([]byte, error) {
+	result := TestComplexDynamicTuplesReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestDeeplyNestedCall)(nil)
+
+const TestDeeplyNestedCallStaticSize = 32
+
+// TestDeeplyNestedCall represents an ABI tuple
+type TestDeeplyNestedCall struct {
+	Data Level1
+}
+
+// EncodedSize returns the total encoded size of TestDeeplyNestedCall
+func (t TestDeeplyNestedCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += t.Data.EncodedSize()
+
+	return TestDeeplyNestedCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestDeeplyNestedCall to ABI bytes in the provided buffer
+func (value TestDeeplyNestedCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestDeeplyNestedCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Data: ((((uint256,string))))
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = value.Data.EncodeTo(buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestDeeplyNestedCall to ABI bytes
+func (value TestDeeplyNestedCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestDeeplyNestedCall from ABI bytes in the provided buffer
+func (t *TestDeeplyNestedCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Data
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Data.Decode(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestDeeplyNestedCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestDeeplyNestedCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestDeeplyNestedCall from the hex string with an optional 0x prefix
+func (t *TestDeeplyNestedCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDeeplyNestedCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestDeeplyNestedCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestDeeplyNestedCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Data
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Data.DecodeInto(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestDeeplyNestedCall to the zero value, to reuse it from a pool
+func (t *TestDeeplyNestedCall) Reset() {
+	t.Data.Reset()
+}
+
+// Clone returns a deep copy of TestDeeplyNestedCall
+func (t TestDeeplyNestedCall) Clone() TestDeeplyNestedCall {
+	c := t
+	c.Data = t.Data.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*TestDeeplyNestedCall)(nil)
+var _ abi.Decoder = (*TestDeeplyNestedCall)(nil)
+
+// RandomTestDeeplyNestedCall returns a TestDeeplyNestedCall filled with random values, for property based tests
+func RandomTestDeeplyNestedCall(r *rand.Rand, maxDepth, maxLen int) TestDeeplyNestedCall {
+	var t TestDeeplyNestedCall
+	t.Data = RandomLevel1(r, maxDepth-1, maxLen)
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestDeeplyNestedCall) GetMethodName() string {
+	return "testDeeplyNested"
+}
+
+// GetMethodID returns the function id
+func (t TestDeeplyNestedCall) GetMethodID() uint32 {
+	return TestDeeplyNestedID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestDeeplyNestedCall) GetMethodSelector() [4]byte {
+	return TestDeeplyNestedSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testDeeplyNested arguments including function selector
+func (t TestDeeplyNestedCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testDeeplyNested arguments to ABI bytes including function selector
+func (t TestDeeplyNestedCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestDeeplyNestedSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testDeeplyNested arguments to 0x prefixed hex string
+func (t TestDeeplyNestedCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testDeeplyNested arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestDeeplyNestedCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testDeeplyNested calldata, returns 0 if encoding fails
+func (t TestDeeplyNestedCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testDeeplyNested arguments from ABI bytes including function selector
+func (t *TestDeeplyNestedCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestDeeplyNestedSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testDeeplyNested arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestDeeplyNestedCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDeeplyNestedCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestDeeplyNestedCall constructs a new TestDeeplyNestedCall
+func NewTestDeeplyNestedCall(
+	data Level1,
+) *TestDeeplyNestedCall {
+	return &TestDeeplyNestedCall{
+		Data: data,
+	}
+}
+
+const TestDeeplyNestedReturnStaticSize = 32
+
+// TestDeeplyNestedReturn represents an ABI tuple
+type TestDeeplyNestedReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestDeeplyNestedReturn
+func (t TestDeeplyNestedReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestDeeplyNestedReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestDeeplyNestedReturn to ABI bytes in the provided buffer
+func (value TestDeeplyNestedReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestDeeplyNestedReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestDeeplyNestedReturn to ABI bytes
+func (value TestDeeplyNestedReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestDeeplyNestedReturn from ABI bytes in the provided buffer
+func (t *TestDeeplyNestedReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestDeeplyNestedReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestDeeplyNestedReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestDeeplyNestedReturn from the hex string with an optional 0x prefix
+func (t *TestDeeplyNestedReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDeeplyNestedReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestDeeplyNestedReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestDeeplyNestedReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestDeeplyNestedReturn to the zero value, to reuse it from a pool
+func (t *TestDeeplyNestedReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestDeeplyNestedReturn
+func (t TestDeeplyNestedReturn) Clone() TestDeeplyNestedReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestDeeplyNestedReturn
+func (t TestDeeplyNestedReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestDeeplyNestedReturn to packed ABI bytes in the provided buffer
+func (value TestDeeplyNestedReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestDeeplyNestedReturn to packed ABI bytes
+func (value TestDeeplyNestedReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestDeeplyNestedReturn from packed ABI bytes
+func (t *TestDeeplyNestedReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestDeeplyNestedReturn)(nil)
+var _ abi.Decoder = (*TestDeeplyNestedReturn)(nil)
+var _ abi.PackedTuple = (*TestDeeplyNestedReturn)(nil)
+
+// RandomTestDeeplyNestedReturn returns a TestDeeplyNestedReturn filled with random values, for property based tests
+func RandomTestDeeplyNestedReturn(r *rand.Rand, maxDepth, maxLen int) TestDeeplyNestedReturn {
+	var t TestDeeplyNestedReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestDeeplyNestedReturn decodes the return data of testDeeplyNested into its values
+func DecodeTestDeeplyNestedReturn(data []byte) (r1 bool, err error) {
+	var result TestDeeplyNestedReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestDeeplyNested decodes the single return value of testDeeplyNested
+func DecodeTestDeeplyNested(data []byte) (bool, error) {
+	return DecodeTestDeeplyNestedReturn(data)
+}
+
+// DecodeTestDeeplyNestedHex decodes the single return value of testDeeplyNested from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestDeeplyNestedHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestDeeplyNestedReturn: %w", err)
+	}
+	return DecodeTestDeeplyNestedReturn(data)
+}
+
+// EncodeTestDeeplyNestedResult encodes the single return value of testDeeplyNested, e.g. for the return data of precompiles
+func EncodeTestDeeplyNestedResult(v bool) ([]byte, error) {
+	result := TestDeeplyNestedReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestDynamicFixedArraysCall)(nil)
+
+const TestDynamicFixedArraysCallStaticSize = 96
+
+// TestDynamicFixedArraysCall represents an ABI tuple
+type TestDynamicFixedArraysCall struct {
+	Names [3]string
+	Blobs [2][]byte
+	Pair  [2]Item
+}
+
+// EncodedSize returns the total encoded size of TestDynamicFixedArraysCall
+func (t TestDynamicFixedArraysCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeStringArray3(t.Names)
+	dynamicSize += SizeBytesArray2(t.Blobs)
+	dynamicSize += SizeItemArray2(t.Pair)
+
+	return TestDynamicFixedArraysCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestDynamicFixedArraysCall to ABI bytes in the provided buffer
+func (value TestDynamicFixedArraysCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestDynamicFixedArraysCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Names: string[3]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeStringArray3(value.Names, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field Blobs: bytes[2]
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeBytesArray2(value.Blobs, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field Pair: (uint32,bytes,bool)[2]
+	// Encode offset pointer
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeItemArray2(value.Pair, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestDynamicFixedArraysCall to ABI bytes
+func (value TestDynamicFixedArraysCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestDynamicFixedArraysCall from ABI bytes in the provided buffer
+func (t *TestDynamicFixedArraysCall) Decode(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 96
+	// Decode dynamic field Names
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Names, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Blobs
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Blobs, n, err = DecodeBytesArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Pair
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Pair, n, err = DecodeItemArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestDynamicFixedArraysCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestDynamicFixedArraysCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestDynamicFixedArraysCall from the hex string with an optional 0x prefix
+func (t *TestDynamicFixedArraysCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDynamicFixedArraysCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestDynamicFixedArraysCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestDynamicFixedArraysCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 96
+	// Decode dynamic field Names
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Names, n, err = DecodeStringArray3(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Blobs
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Blobs, n, err = DecodeBytesArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Pair
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Pair, n, err = DecodeItemArray2(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestDynamicFixedArraysCall to the zero value, to reuse it from a pool
+func (t *TestDynamicFixedArraysCall) Reset() {
+	t.Names = [3]string{}
+	t.Blobs = [2][]byte{}
+	t.Pair = [2]Item{}
+}
+
+// Clone returns a deep copy of TestDynamicFixedArraysCall
+func (t TestDynamicFixedArraysCall) Clone() TestDynamicFixedArraysCall {
+	c := t
+	for i0 := range t.Blobs {
+		c.Blobs[i0] = bytes.Clone(t.Blobs[i0])
+	}
+	for i0 := range t.Pair {
+		c.Pair[i0] = t.Pair[i0].Clone()
+	}
+	return c
+}
+
+var _ abi.Tuple = (*TestDynamicFixedArraysCall)(nil)
+var _ abi.Decoder = (*TestDynamicFixedArraysCall)(nil)
+
+// RandomTestDynamicFixedArraysCall returns a TestDynamicFixedArraysCall filled with random values, for property based tests
+func RandomTestDynamicFixedArraysCall(r *rand.Rand, maxDepth, maxLen int) TestDynamicFixedArraysCall {
+	var t TestDynamicFixedArraysCall
+	for i0 := range t.Names {
+		t.Names[i0] = abi.RandomString(r, maxLen)
+	}
+	for i0 := range t.Blobs {
+		t.Blobs[i0] = abi.RandomBytes(r, maxLen)
+	}
+	for i0 := range t.Pair {
+		t.Pair[i0] = RandomItem(r, maxDepth-1, maxLen)
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestDynamicFixedArraysCall) GetMethodName() string {
+	return "testDynamicFixedArrays"
+}
+
+// GetMethodID returns the function id
+func (t TestDynamicFixedArraysCall) GetMethodID() uint32 {
+	return TestDynamicFixedArraysID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestDynamicFixedArraysCall) GetMethodSelector() [4]byte {
+	return TestDynamicFixedArraysSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testDynamicFixedArrays arguments including function selector
+func (t TestDynamicFixedArraysCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testDynamicFixedArrays arguments to ABI bytes including function selector
+func (t TestDynamicFixedArraysCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestDynamicFixedArraysSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testDynamicFixedArrays arguments to 0x prefixed hex string
+func (t TestDynamicFixedArraysCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testDynamicFixedArrays arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestDynamicFixedArraysCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testDynamicFixedArrays calldata, returns 0 if encoding fails
+func (t TestDynamicFixedArraysCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testDynamicFixedArrays arguments from ABI bytes including function selector
+func (t *TestDynamicFixedArraysCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestDynamicFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testDynamicFixedArrays arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestDynamicFixedArraysCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDynamicFixedArraysCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestDynamicFixedArraysCall constructs a new TestDynamicFixedArraysCall
+func NewTestDynamicFixedArraysCall(
+	names [3]string,
+	blobs [2][]byte,
+	pair [2]Item,
+) *TestDynamicFixedArraysCall {
+	return &TestDynamicFixedArraysCall{
+		Names: names,
+		Blobs: blobs,
+		Pair:  pair,
+	}
+}
+
+const TestDynamicFixedArraysReturnStaticSize = 32
+
+// TestDynamicFixedArraysReturn represents an ABI tuple
+type TestDynamicFixedArraysReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestDynamicFixedArraysReturn
+func (t TestDynamicFixedArraysReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestDynamicFixedArraysReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestDynamicFixedArraysReturn to ABI bytes in the provided buffer
+func (value TestDynamicFixedArraysReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestDynamicFixedArraysReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestDynamicFixedArraysReturn to ABI bytes
+func (value TestDynamicFixedArraysReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestDynamicFixedArraysReturn from ABI bytes in the provided buffer
+func (t *TestDynamicFixedArraysReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestDynamicFixedArraysReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestDynamicFixedArraysReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestDynamicFixedArraysReturn from the hex string with an optional 0x prefix
+func (t *TestDynamicFixedArraysReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestDynamicFixedArraysReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestDynamicFixedArraysReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestDynamicFixedArraysReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestDynamicFixedArraysReturn to the zero value, to reuse it from a pool
+func (t *TestDynamicFixedArraysReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestDynamicFixedArraysReturn
+func (t TestDynamicFixedArraysReturn) Clone() TestDynamicFixedArraysReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestDynamicFixedArraysReturn
+func (t TestDynamicFixedArraysReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestDynamicFixedArraysReturn to packed ABI bytes in the provided buffer
+func (value TestDynamicFixedArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestDynamicFixedArraysReturn to packed ABI bytes
+func (value TestDynamicFixedArraysReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestDynamicFixedArraysReturn from packed ABI bytes
+func (t *TestDynamicFixedArraysReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestDynamicFixedArraysReturn)(nil)
+var _ abi.Decoder = (*TestDynamicFixedArraysReturn)(nil)
+var _ abi.PackedTuple = (*TestDynamicFixedArraysReturn)(nil)
+
+// RandomTestDynamicFixedArraysReturn returns a TestDynamicFixedArraysReturn filled with random values, for property based tests
+func RandomTestDynamicFixedArraysReturn(r *rand.Rand, maxDepth, maxLen int) TestDynamicFixedArraysReturn {
+	var t TestDynamicFixedArraysReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestDynamicFixedArraysReturn decodes the return data of testDynamicFixedArrays into its values
+func DecodeTestDynamicFixedArraysReturn(data []byte) (r1 bool, err error) {
+	var result TestDynamicFixedArraysReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestDynamicFixedArrays decodes the single return value of testDynamicFixedArrays
+func DecodeTestDynamicFixedArrays(data []byte) (bool, error) {
+	return DecodeTestDynamicFixedArraysReturn(data)
+}
+
+// DecodeTestDynamicFixedArraysHex decodes the single return value of testDynamicFixedArrays from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestDynamicFixedArraysHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestDynamicFixedArraysReturn: %w", err)
+	}
+	return DecodeTestDynamicFixedArraysReturn(data)
+}
+
+// EncodeTestDynamicFixedArraysResult encodes the single return value of testDynamicFixedArrays, e.g. for the return data of precompiles
+func EncodeTestDynamicFixedArraysResult(v bool) ([]byte, error) {
+	result := TestDynamicFixedArraysReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestExternalTupleCall)(nil)
+
+const TestExternalTupleCallStaticSize = 32
+
+// TestExternalTupleCall represents an ABI tuple
+type TestExternalTupleCall struct {
+	User User
+}
+
+// EncodedSize returns the total encoded size of TestExternalTupleCall
+func (t TestExternalTupleCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += t.User.EncodedSize()
+
+	return TestExternalTupleCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestExternalTupleCall to ABI bytes in the provided buffer
+func (value TestExternalTupleCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestExternalTupleCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field User: (address,string,uint256)
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = value.User.EncodeTo(buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestExternalTupleCall to ABI bytes
+func (value TestExternalTupleCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestExternalTupleCall from ABI bytes in the provided buffer
+func (t *TestExternalTupleCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field User
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.User.Decode(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestExternalTupleCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestExternalTupleCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestExternalTupleCall from the hex string with an optional 0x prefix
+func (t *TestExternalTupleCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestExternalTupleCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestExternalTupleCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestExternalTupleCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field User
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.User.Decode(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestExternalTupleCall to the zero value, to reuse it from a pool
+func (t *TestExternalTupleCall) Reset() {
+	t.User = User{}
+}
+
+// Clone returns a deep copy of TestExternalTupleCall
+func (t TestExternalTupleCall) Clone() TestExternalTupleCall {
+	c := t
+	return c
+}
+
+var _ abi.Tuple = (*TestExternalTupleCall)(nil)
+var _ abi.Decoder = (*TestExternalTupleCall)(nil)
+
+// RandomTestExternalTupleCall returns a TestExternalTupleCall filled with random values, for property based tests
+func RandomTestExternalTupleCall(r *rand.Rand, maxDepth, maxLen int) TestExternalTupleCall {
+	var t TestExternalTupleCall
+	t.User = RandomUser(r, maxDepth-1, maxLen)
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestExternalTupleCall) GetMethodName() string {
+	return "testExternalTuple"
+}
+
+// GetMethodID returns the function id
+func (t TestExternalTupleCall) GetMethodID() uint32 {
+	return TestExternalTupleID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestExternalTupleCall) GetMethodSelector() [4]byte {
+	return TestExternalTupleSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testExternalTuple arguments including function selector
+func (t TestExternalTupleCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testExternalTuple arguments to ABI bytes including function selector
+func (t TestExternalTupleCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestExternalTupleSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testExternalTuple arguments to 0x prefixed hex string
+func (t TestExternalTupleCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testExternalTuple arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestExternalTupleCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testExternalTuple calldata, returns 0 if encoding fails
+func (t TestExternalTupleCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testExternalTuple arguments from ABI bytes including function selector
+func (t *TestExternalTupleCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestExternalTupleSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testExternalTuple arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestExternalTupleCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestExternalTupleCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestExternalTupleCall constructs a new TestExternalTupleCall
+func NewTestExternalTupleCall(
+	user User,
+) *TestExternalTupleCall {
+	return &TestExternalTupleCall{
+		User: user,
+	}
+}
+
+const TestExternalTupleReturnStaticSize = 32
+
+// TestExternalTupleReturn represents an ABI tuple
+type TestExternalTupleReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestExternalTupleReturn
+func (t TestExternalTupleReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestExternalTupleReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestExternalTupleReturn to ABI bytes in the provided buffer
+func (value TestExternalTupleReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestExternalTupleReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestExternalTupleReturn to ABI bytes
+func (value TestExternalTupleReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestExternalTupleReturn from ABI bytes in the provided buffer
+func (t *TestExternalTupleReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestExternalTupleReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestExternalTupleReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestExternalTupleReturn from the hex string with an optional 0x prefix
+func (t *TestExternalTupleReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestExternalTupleReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestExternalTupleReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestExternalTupleReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestExternalTupleReturn to the zero value, to reuse it from a pool
+func (t *TestExternalTupleReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestExternalTupleReturn
+func (t TestExternalTupleReturn) Clone() TestExternalTupleReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestExternalTupleReturn
+func (t TestExternalTupleReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestExternalTupleReturn to packed ABI bytes in the provided buffer
+func (value TestExternalTupleReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestExternalTupleReturn to packed ABI bytes
+func (value TestExternalTupleReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestExternalTupleReturn from packed ABI bytes
+func (t *TestExternalTupleReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestExternalTupleReturn)(nil)
+var _ abi.Decoder = (*TestExternalTupleReturn)(nil)
+var _ abi.PackedTuple = (*TestExternalTupleReturn)(nil)
+
+// RandomTestExternalTupleReturn returns a TestExternalTupleReturn filled with random values, for property based tests
+func RandomTestExternalTupleReturn(r *rand.Rand, maxDepth, maxLen int) TestExternalTupleReturn {
+	var t TestExternalTupleReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestExternalTupleReturn decodes the return data of testExternalTuple into its values
+func DecodeTestExternalTupleReturn(data []byte) (r1 bool, err error) {
+	var result TestExternalTupleReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestExternalTuple decodes the single return value of testExternalTuple
+func DecodeTestExternalTuple(data []byte) (bool, error) {
+	return DecodeTestExternalTupleReturn(data)
+}
+
+// DecodeTestExternalTupleHex decodes the single return value of testExternalTuple from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestExternalTupleHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestExternalTupleReturn: %w", err)
+	}
+	return DecodeTestExternalTupleReturn(data)
+}
+
+// EncodeTestExternalTupleResult encodes the single return value of testExternalTuple, e.g. for the return data of precompiles
+func EncodeTestExternalTupleResult(v bool) ([]byte, error) {
+	result := TestExternalTupleReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestFixedArraysCall)(nil)
+
+const TestFixedArraysCallStaticSize = 320
+
+// TestFixedArraysCall represents an ABI tuple
+type TestFixedArraysCall struct {
+	Addresses [5]common.Address
+	Uints     [3]*uint256.Int
+	Bytes32s  [2][32]byte
+}
+
+// EncodedSize returns the total encoded size of TestFixedArraysCall
+func (t TestFixedArraysCall) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestFixedArraysCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestFixedArraysCall to ABI bytes in the provided buffer
+func (value TestFixedArraysCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestFixedArraysCallStaticSize // Start dynamic data after static section
+	// Field Addresses: address[5]
+	if _, err := EncodeAddressArray5(value.Addresses, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Uints: uint256[3]
+	if _, err := EncodeUint256Array3U256(value.Uints, buf[160:]); err != nil {
+		return 0, err
+	}
+
+	// Field Bytes32s: bytes32[2]
+	if _, err := EncodeBytes32Array2(value.Bytes32s, buf[256:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestFixedArraysCall to ABI bytes
+func (value TestFixedArraysCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestFixedArraysCall from ABI bytes in the provided buffer
+func (t *TestFixedArraysCall) Decode(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field Addresses: address[5]
+	t.Addresses, _, err = DecodeAddressArray5(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Uints: uint256[3]
+	t.Uints, _, err = DecodeUint256Array3U256(data[160:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Bytes32s: bytes32[2]
+	t.Bytes32s, _, err = DecodeBytes32Array2(data[256:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestFixedArraysCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestFixedArraysCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestFixedArraysCall from the hex string with an optional 0x prefix
+func (t *TestFixedArraysCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedArraysCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestFixedArraysCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestFixedArraysCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field Addresses: address[5]
+	t.Addresses, _, err = DecodeAddressArray5(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Uints: uint256[3]
+	t.Uints, _, err = DecodeUint256Array3U256(data[160:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Bytes32s: bytes32[2]
+	t.Bytes32s, _, err = DecodeBytes32Array2(data[256:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestFixedArraysCall to the zero value, to reuse it from a pool
+func (t *TestFixedArraysCall) Reset() {
+	t.Addresses = [5]common.Address{}
+	t.Uints = [3]*uint256.Int{}
+	t.Bytes32s = [2][32]byte{}
+}
+
+// Clone returns a deep copy of TestFixedArraysCall
+func (t TestFixedArraysCall) Clone() TestFixedArraysCall {
+	c := t
+	for i0 := range t.Uints {
+		if t.Uints[i0] != nil {
+			c.Uints[i0] = new(uint256.Int).Set(t.Uints[i0])
+		}
+	}
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestFixedArraysCall
+func (t TestFixedArraysCall) PackedEncodedSize() int {
+	return 260
+}
+
+// PackedEncodeTo encodes TestFixedArraysCall to packed ABI bytes in the provided buffer
+func (value TestFixedArraysCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Addresses: address[5]
+	n, err = PackedEncodeAddressArray5(value.Addresses, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Uints: uint256[3]
+	n, err = PackedEncodeUint256Array3U256(value.Uints, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Bytes32s: bytes32[2]
+	n, err = PackedEncodeBytes32Array2(value.Bytes32s, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestFixedArraysCall to packed ABI bytes
+func (value TestFixedArraysCall) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestFixedArraysCall from packed ABI bytes
+func (t *TestFixedArraysCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 260 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Addresses: address[5]
+	t.Addresses, _, err = PackedDecodeAddressArray5(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Uints: uint256[3]
+	t.Uints, _, err = PackedDecodeUint256Array3U256(data[100:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Bytes32s: bytes32[2]
+	t.Bytes32s, _, err = PackedDecodeBytes32Array2(data[196:])
+	if err != nil {
+		return 0, err
+	}
+	return 260, nil
+}
+
+var _ abi.Tuple = (*TestFixedArraysCall)(nil)
+var _ abi.Decoder = (*TestFixedArraysCall)(nil)
+var _ abi.PackedTuple = (*TestFixedArraysCall)(nil)
+
+// RandomTestFixedArraysCall returns a TestFixedArraysCall filled with random values, for property based tests
+func RandomTestFixedArraysCall(r *rand.Rand, maxDepth, maxLen int) TestFixedArraysCall {
+	var t TestFixedArraysCall
+	for i0 := range t.Addresses {
+		r.Read(t.Addresses[i0][:])
+	}
+	for i0 := range t.Uints {
+		t.Uints[i0] = abi.RandomUint256(r, 256)
+	}
+	for i0 := range t.Bytes32s {
+		r.Read(t.Bytes32s[i0][:])
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestFixedArraysCall) GetMethodName() string {
+	return "testFixedArrays"
+}
+
+// GetMethodID returns the function id
+func (t TestFixedArraysCall) GetMethodID() uint32 {
+	return TestFixedArraysID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestFixedArraysCall) GetMethodSelector() [4]byte {
+	return TestFixedArraysSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testFixedArrays arguments including function selector
+func (t TestFixedArraysCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testFixedArrays arguments to ABI bytes including function selector
+func (t TestFixedArraysCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestFixedArraysSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testFixedArrays arguments to 0x prefixed hex string
+func (t TestFixedArraysCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testFixedArrays arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestFixedArraysCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testFixedArrays calldata, returns 0 if encoding fails
+func (t TestFixedArraysCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testFixedArrays arguments from ABI bytes including function selector
+func (t *TestFixedArraysCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testFixedArrays arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestFixedArraysCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedArraysCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes testFixedArrays arguments to packed ABI bytes including function selector
+func (t TestFixedArraysCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestFixedArraysSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes testFixedArrays arguments from packed ABI bytes including function selector
+func (t *TestFixedArraysCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewTestFixedArraysCall constructs a new TestFixedArraysCall
+func NewTestFixedArraysCall(
+	addresses [5]common.Address,
+	uints [3]*uint256.Int,
+	bytes32s [2][32]byte,
+) *TestFixedArraysCall {
+	return &TestFixedArraysCall{
+		Addresses: addresses,
+		Uints:     uints,
+		Bytes32s:  bytes32s,
+	}
+}
+
+const TestFixedArraysReturnStaticSize = 32
+
+// TestFixedArraysReturn represents an ABI tuple
+type TestFixedArraysReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestFixedArraysReturn
+func (t TestFixedArraysReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestFixedArraysReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestFixedArraysReturn to ABI bytes in the provided buffer
+func (value TestFixedArraysReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestFixedArraysReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestFixedArraysReturn to ABI bytes
+func (value TestFixedArraysReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestFixedArraysReturn from ABI bytes in the provided buffer
+func (t *TestFixedArraysReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestFixedArraysReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestFixedArraysReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestFixedArraysReturn from the hex string with an optional 0x prefix
+func (t *TestFixedArraysReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedArraysReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestFixedArraysReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestFixedArraysReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestFixedArraysReturn to the zero value, to reuse it from a pool
+func (t *TestFixedArraysReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestFixedArraysReturn
+func (t TestFixedArraysReturn) Clone() TestFixedArraysReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestFixedArraysReturn
+func (t TestFixedArraysReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestFixedArraysReturn to packed ABI bytes in the provided buffer
+func (value TestFixedArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestFixedArraysReturn to packed ABI bytes
+func (value TestFixedArraysReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestFixedArraysReturn from packed ABI bytes
+func (t *TestFixedArraysReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestFixedArraysReturn)(nil)
+var _ abi.Decoder = (*TestFixedArraysReturn)(nil)
+var _ abi.PackedTuple = (*TestFixedArraysReturn)(nil)
+
+// RandomTestFixedArraysReturn returns a TestFixedArraysReturn filled with random values, for property based tests
+func RandomTestFixedArraysReturn(r *rand.Rand, maxDepth, maxLen int) TestFixedArraysReturn {
+	var t TestFixedArraysReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestFixedArraysReturn decodes the return data of testFixedArrays into its values
+func DecodeTestFixedArraysReturn(data []byte) (r1 bool, err error) {
+	var result TestFixedArraysReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestFixedArrays decodes the single return value of testFixedArrays
+func DecodeTestFixedArrays(data []byte) (bool, error) {
+	return DecodeTestFixedArraysReturn(data)
+}
+
+// DecodeTestFixedArraysHex decodes the single return value of testFixedArrays from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestFixedArraysHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestFixedArraysReturn: %w", err)
+	}
+	return DecodeTestFixedArraysReturn(data)
+}
+
+// EncodeTestFixedArraysResult encodes the single return value of testFixedArrays, e.g. for the return data of precompiles
+func EncodeTestFixedArraysResult(v bool) ([]byte, error) {
+	result := TestFixedArraysReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestFixedBytesCall)(nil)
+
+const TestFixedBytesCallStaticSize = 96
+
+// TestFixedBytesCall represents an ABI tuple
+type TestFixedBytesCall struct {
+	Data3  [3]byte
+	Data7  [7]byte
+	Data15 [15]byte
+}
+
+// EncodedSize returns the total encoded size of TestFixedBytesCall
+func (t TestFixedBytesCall) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestFixedBytesCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestFixedBytesCall to ABI bytes in the provided buffer
+func (value TestFixedBytesCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestFixedBytesCallStaticSize // Start dynamic data after static section
+	// Field Data3: bytes3
+	if _, err := abi.EncodeBytes3(value.Data3, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Data7: bytes7
+	if _, err := abi.EncodeBytes7(value.Data7, buf[32:]); err != nil {
+		return 0, err
+	}
+
+	// Field Data15: bytes15
+	if _, err := abi.EncodeBytes15(value.Data15, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestFixedBytesCall to ABI bytes
+func (value TestFixedBytesCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestFixedBytesCall from ABI bytes in the provided buffer
+func (t *TestFixedBytesCall) Decode(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 96
+	// Decode static field Data3: bytes3
+	t.Data3, _, err = abi.DecodeBytes3(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Data7: bytes7
+	t.Data7, _, err = abi.DecodeBytes7(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Data15: bytes15
+	t.Data15, _, err = abi.DecodeBytes15(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestFixedBytesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestFixedBytesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestFixedBytesCall from the hex string with an optional 0x prefix
+func (t *TestFixedBytesCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedBytesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestFixedBytesCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestFixedBytesCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 96
+	// Decode static field Data3: bytes3
+	t.Data3, _, err = abi.DecodeBytes3(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Data7: bytes7
+	t.Data7, _, err = abi.DecodeBytes7(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Data15: bytes15
+	t.Data15, _, err = abi.DecodeBytes15(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestFixedBytesCall to the zero value, to reuse it from a pool
+func (t *TestFixedBytesCall) Reset() {
+	t.Data3 = [3]byte{}
+	t.Data7 = [7]byte{}
+	t.Data15 = [15]byte{}
+}
+
+// Clone returns a deep copy of TestFixedBytesCall
+func (t TestFixedBytesCall) Clone() TestFixedBytesCall {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestFixedBytesCall
+func (t TestFixedBytesCall) PackedEncodedSize() int {
+	return 25
+}
+
+// PackedEncodeTo encodes TestFixedBytesCall to packed ABI bytes in the provided buffer
+func (value TestFixedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Data3: bytes3
+	n, err = abi.PackedEncodeBytes3(value.Data3, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Data7: bytes7
+	n, err = abi.PackedEncodeBytes7(value.Data7, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Data15: bytes15
+	n, err = abi.PackedEncodeBytes15(value.Data15, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestFixedBytesCall to packed ABI bytes
+func (value TestFixedBytesCall) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestFixedBytesCall from packed ABI bytes
+func (t *TestFixedBytesCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 25 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Data3: bytes3
+	t.Data3, _, err = abi.PackedDecodeBytes3(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Data7: bytes7
+	t.Data7, _, err = abi.PackedDecodeBytes7(data[3:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Data15: bytes15
+	t.Data15, _, err = abi.PackedDecodeBytes15(data[10:])
+	if err != nil {
+		return 0, err
+	}
+	return 25, nil
+}
+
+var _ abi.Tuple = (*TestFixedBytesCall)(nil)
+var _ abi.Decoder = (*TestFixedBytesCall)(nil)
+var _ abi.PackedTuple = (*TestFixedBytesCall)(nil)
+
+// RandomTestFixedBytesCall returns a TestFixedBytesCall filled with random values, for property based tests
+func RandomTestFixedBytesCall(r *rand.Rand, maxDepth, maxLen int) TestFixedBytesCall {
+	var t TestFixedBytesCall
+	r.Read(t.Data3[:])
+	r.Read(t.Data7[:])
+	r.Read(t.Data15[:])
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestFixedBytesCall) GetMethodName() string {
+	return "testFixedBytes"
+}
+
+// GetMethodID returns the function id
+func (t TestFixedBytesCall) GetMethodID() uint32 {
+	return TestFixedBytesID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestFixedBytesCall) GetMethodSelector() [4]byte {
+	return TestFixedBytesSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testFixedBytes arguments including function selector
+func (t TestFixedBytesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testFixedBytes arguments to ABI bytes including function selector
+func (t TestFixedBytesCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestFixedBytesSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testFixedBytes arguments to 0x prefixed hex string
+func (t TestFixedBytesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testFixedBytes arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestFixedBytesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testFixedBytes calldata, returns 0 if encoding fails
+func (t TestFixedBytesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testFixedBytes arguments from ABI bytes including function selector
+func (t *TestFixedBytesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestFixedBytesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testFixedBytes arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestFixedBytesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedBytesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes testFixedBytes arguments to packed ABI bytes including function selector
+func (t TestFixedBytesCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestFixedBytesSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes testFixedBytes arguments from packed ABI bytes including function selector
+func (t *TestFixedBytesCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestFixedBytesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewTestFixedBytesCall constructs a new TestFixedBytesCall
+func NewTestFixedBytesCall(
+	data3 [3]byte,
+	data7 [7]byte,
+	data15 [15]byte,
+) *TestFixedBytesCall {
+	return &TestFixedBytesCall{
+		Data3:  data3,
+		Data7:  data7,
+		Data15: data15,
+	}
+}
+
+const TestFixedBytesReturnStaticSize = 32
+
+// TestFixedBytesReturn represents an ABI tuple
+type TestFixedBytesReturn struct {
+	Field1 [32]byte
+}
+
+// EncodedSize returns the total encoded size of TestFixedBytesReturn
+func (t TestFixedBytesReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestFixedBytesReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestFixedBytesReturn to ABI bytes in the provided buffer
+func (value TestFixedBytesReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestFixedBytesReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bytes32
+	if _, err := abi.EncodeBytes32(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestFixedBytesReturn to ABI bytes
+func (value TestFixedBytesReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestFixedBytesReturn from ABI bytes in the provided buffer
+func (t *TestFixedBytesReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bytes32
+	t.Field1, _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestFixedBytesReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestFixedBytesReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestFixedBytesReturn from the hex string with an optional 0x prefix
+func (t *TestFixedBytesReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestFixedBytesReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestFixedBytesReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestFixedBytesReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bytes32
+	t.Field1, _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestFixedBytesReturn to the zero value, to reuse it from a pool
+func (t *TestFixedBytesReturn) Reset() {
+	t.Field1 = [32]byte{}
+}
+
+// Clone returns a deep copy of TestFixedBytesReturn
+func (t TestFixedBytesReturn) Clone() TestFixedBytesReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestFixedBytesReturn
+func (t TestFixedBytesReturn) PackedEncodedSize() int {
+	return 32
+}
+
+// PackedEncodeTo encodes TestFixedBytesReturn to packed ABI bytes in the provided buffer
+func (value TestFixedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bytes32
+	n, err = abi.PackedEncodeBytes32(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestFixedBytesReturn to packed ABI bytes
+func (value TestFixedBytesReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestFixedBytesReturn from packed ABI bytes
+func (t *TestFixedBytesReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bytes32
+	t.Field1, _, err = abi.PackedDecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 32, nil
+}
+
+var _ abi.Tuple = (*TestFixedBytesReturn)(nil)
+var _ abi.Decoder = (*TestFixedBytesReturn)(nil)
+var _ abi.PackedTuple = (*TestFixedBytesReturn)(nil)
+
+// RandomTestFixedBytesReturn returns a TestFixedBytesReturn filled with random values, for property based tests
+func RandomTestFixedBytesReturn(r *rand.Rand, maxDepth, maxLen int) TestFixedBytesReturn {
+	var t TestFixedBytesReturn
+	r.Read(t.Field1[:])
+	return t
+}
+
+// DecodeTestFixedBytesReturn decodes the return data of testFixedBytes into its values
+func DecodeTestFixedBytesReturn(data []byte) (r1 [32]byte, err error) {
+	var result TestFixedBytesReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestFixedBytes decodes the single return value of testFixedBytes
+func DecodeTestFixedBytes(data []byte) ([32]byte, error) {
+	return DecodeTestFixedBytesReturn(data)
+}
+
+// DecodeTestFixedBytesHex decodes the single return value of testFixedBytes from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestFixedBytesHex(s string) ([32]byte, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero [32]byte
+		return zero, fmt.Errorf("decode TestFixedBytesReturn: %w", err)
+	}
+	return DecodeTestFixedBytesReturn(data)
+}
+
+// EncodeTestFixedBytesResult encodes the single return value of testFixedBytes, e.g. for the return data of precompiles
+func EncodeTestFixedBytesResult(v [32]byte) ([]byte, error) {
+	result := TestFixedBytesReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestMixedTypesCall)(nil)
+
+const TestMixedTypesCallStaticSize = 160
+
+// TestMixedTypesCall represents an ABI tuple
+type TestMixedTypesCall struct {
+	FixedData   [32]byte
+	DynamicData []byte
+	Flag        bool
+	Count       uint8
+	Items       []Item
+}
+
+// EncodedSize returns the total encoded size of TestMixedTypesCall
+func (t TestMixedTypesCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += abi.SizeBytes(t.DynamicData)
+	dynamicSize += SizeItemSlice(t.Items)
+
+	return TestMixedTypesCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestMixedTypesCall to ABI bytes in the provided buffer
+func (value TestMixedTypesCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestMixedTypesCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field FixedData: bytes32
+	if _, err := abi.EncodeBytes32(value.FixedData, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field DynamicData: bytes
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeBytes(value.DynamicData, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field Flag: bool
+	if _, err := abi.EncodeBool(value.Flag, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	// Field Count: uint8
+	if _, err := abi.EncodeUint8(value.Count, buf[96:]); err != nil {
+		return 0, err
+	}
+
+	// Field Items: (uint32,bytes,bool)[]
+	// Encode offset pointer
+	abi.ClearWord(buf[128:])
+	binary.BigEndian.PutUint64(buf[128+24:128+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeItemSlice(value.Items, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestMixedTypesCall to ABI bytes
+func (value TestMixedTypesCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestMixedTypesCall from ABI bytes in the provided buffer
+func (t *TestMixedTypesCall) Decode(data []byte) (int, error) {
+	if len(data) < 160 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 160
+	// Decode static field FixedData: bytes32
+	t.FixedData, _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field DynamicData
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.DynamicData, n, err = abi.DecodeBytes(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode static field Flag: bool
+	t.Flag, _, err = abi.DecodeBool(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Count: uint8
+	t.Count, _, err = abi.DecodeUint8(data[96:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Items
+	{
+		offset, err = abi.DecodeSize(data[128:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Items, n, err = DecodeItemSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestMixedTypesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestMixedTypesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestMixedTypesCall from the hex string with an optional 0x prefix
+func (t *TestMixedTypesCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestMixedTypesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestMixedTypesCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestMixedTypesCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 160 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 160
+	// Decode static field FixedData: bytes32
+	t.FixedData, _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field DynamicData
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.DynamicData, n, err = abi.DecodeBytes(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode static field Flag: bool
+	t.Flag, _, err = abi.DecodeBool(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Count: uint8
+	t.Count, _, err = abi.DecodeUint8(data[96:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Items
+	{
+		offset, err = abi.DecodeSize(data[128:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Items, n, err = DecodeIntoItemSlice(t.Items, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestMixedTypesCall to the zero value, to reuse it from a pool
+func (t *TestMixedTypesCall) Reset() {
+	t.FixedData = [32]byte{}
+	t.DynamicData = nil
+	t.Flag = false
+	t.Count = 0
+	t.Items = nil
+}
+
+// Clone returns a deep copy of TestMixedTypesCall
+func (t TestMixedTypesCall) Clone() TestMixedTypesCall {
+	c := t
+	c.DynamicData = bytes.Clone(t.DynamicData)
+	if t.Items != nil {
+		c.Items = make([]Item, len(t.Items))
+		for i0 := range t.Items {
+			c.Items[i0] = t.Items[i0].Clone()
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*TestMixedTypesCall)(nil)
+var _ abi.Decoder = (*TestMixedTypesCall)(nil)
+
+// RandomTestMixedTypesCall returns a TestMixedTypesCall filled with random values, for property based tests
+func RandomTestMixedTypesCall(r *rand.Rand, maxDepth, maxLen int) TestMixedTypesCall {
+	var t TestMixedTypesCall
+	r.Read(t.FixedData[:])
+	t.DynamicData = abi.RandomBytes(r, maxLen)
+	t.Flag = r.Intn(2) == 1
+	t.Count = uint8(r.Uint64() >> 56)
+	t.Items = make([]Item, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Items {
+		t.Items[i0] = RandomItem(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestMixedTypesCall) GetMethodName() string {
+	return "testMixedTypes"
+}
+
+// GetMethodID returns the function id
+func (t TestMixedTypesCall) GetMethodID() uint32 {
+	return TestMixedTypesID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestMixedTypesCall) GetMethodSelector() [4]byte {
+	return TestMixedTypesSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testMixedTypes arguments including function selector
+func (t TestMixedTypesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testMixedTypes arguments to ABI bytes including function selector
+func (t TestMixedTypesCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestMixedTypesSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testMixedTypes arguments to 0x prefixed hex string
+func (t TestMixedTypesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testMixedTypes arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestMixedTypesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testMixedTypes calldata, returns 0 if encoding fails
+func (t TestMixedTypesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testMixedTypes arguments from ABI bytes including function selector
+func (t *TestMixedTypesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestMixedTypesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testMixedTypes arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestMixedTypesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestMixedTypesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestMixedTypesCall constructs a new TestMixedTypesCall
+func NewTestMixedTypesCall(
+	fixedData [32]byte,
+	dynamicData []byte,
+	flag bool,
+	count uint8,
+	items []Item,
+) *TestMixedTypesCall {
+	return &TestMixedTypesCall{
+		FixedData:   fixedData,
+		DynamicData: dynamicData,
+		Flag:        flag,
+		Count:       count,
+		Items:       items,
+	}
+}
+
+const TestMixedTypesReturnStaticSize = 32
+
+// TestMixedTypesReturn represents an ABI tuple
+type TestMixedTypesReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestMixedTypesReturn
+func (t TestMixedTypesReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestMixedTypesReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestMixedTypesReturn to ABI bytes in the provided buffer
+func (value TestMixedTypesReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestMixedTypesReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestMixedTypesReturn to ABI bytes
+func (value TestMixedTypesReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestMixedTypesReturn from ABI bytes in the provided buffer
+func (t *TestMixedTypesReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestMixedTypesReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestMixedTypesReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestMixedTypesReturn from the hex string with an optional 0x prefix
+func (t *TestMixedTypesReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestMixedTypesReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestMixedTypesReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestMixedTypesReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestMixedTypesReturn to the zero value, to reuse it from a pool
+func (t *TestMixedTypesReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestMixedTypesReturn
+func (t TestMixedTypesReturn) Clone() TestMixedTypesReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestMixedTypesReturn
+func (t TestMixedTypesReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestMixedTypesReturn to packed ABI bytes in the provided buffer
+func (value TestMixedTypesReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestMixedTypesReturn to packed ABI bytes
+func (value TestMixedTypesReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestMixedTypesReturn from packed ABI bytes
+func (t *TestMixedTypesReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TestMixedTypesReturn)(nil)
+var _ abi.Decoder = (*TestMixedTypesReturn)(nil)
+var _ abi.PackedTuple = (*TestMixedTypesReturn)(nil)
+
+// RandomTestMixedTypesReturn returns a TestMixedTypesReturn filled with random values, for property based tests
+func RandomTestMixedTypesReturn(r *rand.Rand, maxDepth, maxLen int) TestMixedTypesReturn {
+	var t TestMixedTypesReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestMixedTypesReturn decodes the return data of testMixedTypes into its values
+func DecodeTestMixedTypesReturn(data []byte) (r1 bool, err error) {
+	var result TestMixedTypesReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestMixedTypes decodes the single return value of testMixedTypes
+func DecodeTestMixedTypes(data []byte) (bool, error) {
+	return DecodeTestMixedTypesReturn(data)
+}
+
+// DecodeTestMixedTypesHex decodes the single return value of testMixedTypes from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestMixedTypesHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestMixedTypesReturn: %w", err)
+	}
+	return DecodeTestMixedTypesReturn(data)
+}
+
+// EncodeTestMixedTypesResult encodes the single return value of testMixedTypes, e.g. for the return data of precompiles
+func EncodeTestMixedTypesResult(v bool) ([]byte, error) {
+	result := TestMixedTypesReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestNestedDynamicArraysCall)(nil)
+
+const TestNestedDynamicArraysCallStaticSize = 96
+
+// TestNestedDynamicArraysCall represents an ABI tuple
+type TestNestedDynamicArraysCall struct {
+	Matrix        [][]*uint256.Int
+	AddressMatrix [][3][]common.Address
+	DymMatrix     [][]string
+}
+
+// EncodedSize returns the total encoded size of TestNestedDynamicArraysCall
+func (t TestNestedDynamicArraysCall) EncodedSize() int {
+	dynamicSize := 0
+	dynamicSize += SizeUint256SliceSliceU256(t.Matrix)
+	dynamicSize += SizeAddressSliceArray3Slice(t.AddressMatrix)
+	dynamicSize += SizeStringSliceSlice(t.DymMatrix)
+
+	return TestNestedDynamicArraysCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestNestedDynamicArraysCall to ABI bytes in the provided buffer
+func (value TestNestedDynamicArraysCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestNestedDynamicArraysCallStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Matrix: uint256[][]
+	// Encode offset pointer
+	abi.ClearWord(buf[0:])
+	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeUint256SliceSliceU256(value.Matrix, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field AddressMatrix: address[][3][]
+	// Encode offset pointer
+	abi.ClearWord(buf[32:])
+	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeAddressSliceArray3Slice(value.AddressMatrix, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	// Field DymMatrix: string[][]
+	// Encode offset pointer
+	abi.ClearWord(buf[64:])
+	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = EncodeStringSliceSlice(value.DymMatrix, buf[dynamicOffset:])
+	if err != nil {
+		return 0, err
+	}
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestNestedDynamicArraysCall to ABI bytes
+func (value TestNestedDynamicArraysCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestNestedDynamicArraysCall from ABI bytes in the provided buffer
+func (t *TestNestedDynamicArraysCall) Decode(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 96
+	// Decode dynamic field Matrix
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Matrix, n, err = DecodeUint256SliceSliceU256(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
 	}
-	length, err := abi.DecodeSize(data)
+	// Decode dynamic field AddressMatrix
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.AddressMatrix, n, err = DecodeAddressSliceArray3Slice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field DymMatrix
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.DymMatrix, n, err = DecodeStringSliceSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestNestedDynamicArraysCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedDynamicArraysCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return nil, 0, err
+		return err
 	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestNestedDynamicArraysCall from the hex string with an optional 0x prefix
+func (t *TestNestedDynamicArraysCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicArraysCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedDynamicArraysCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedDynamicArraysCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
+		err    error
 		n      int
 		offset int
 	)
-	// Decode elements with dynamic types
-	result := make([][]string, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
+	dynamicOffset := 96
+	// Decode dynamic field Matrix
+	{
+		offset, err = abi.DecodeSize(data[0:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		result[i], n, err = abi.DecodeStringSlice(data[dynamicOffset:])
+		t.Matrix, n, err = DecodeIntoUint256SliceSliceU256(t.Matrix, data[dynamicOffset:])
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		dynamicOffset += n
 	}
-	return result, dynamicOffset + 32, nil
+	// Decode dynamic field AddressMatrix
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.AddressMatrix, n, err = DecodeIntoAddressSliceArray3Slice(t.AddressMatrix, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field DymMatrix
+	{
+		offset, err = abi.DecodeSize(data[64:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.DymMatrix, n, err = DecodeIntoStringSliceSlice(t.DymMatrix, data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
 }
 
-// DecodeUint256Array3 decodes uint256[3] from ABI bytes
-func DecodeUint256Array3(data []byte) ([3]*uint256.Int, int, error) {
-	// Decode fixed-size array with static elements
-	var (
-		result [3]*uint256.Int
-		err    error
-	)
-	if len(data) < 96 {
-		return result, 0, io.ErrUnexpectedEOF
+// Reset sets every field of TestNestedDynamicArraysCall to the zero value, to reuse it from a pool
+func (t *TestNestedDynamicArraysCall) Reset() {
+	t.Matrix = nil
+	t.AddressMatrix = nil
+	t.DymMatrix = nil
+}
+
+// Clone returns a deep copy of TestNestedDynamicArraysCall
+func (t TestNestedDynamicArraysCall) Clone() TestNestedDynamicArraysCall {
+	c := t
+	if t.Matrix != nil {
+		c.Matrix = make([][]*uint256.Int, len(t.Matrix))
+		for i0 := range t.Matrix {
+			if t.Matrix[i0] != nil {
+				c.Matrix[i0] = make([]*uint256.Int, len(t.Matrix[i0]))
+				for i1 := range t.Matrix[i0] {
+					if t.Matrix[i0][i1] != nil {
+						c.Matrix[i0][i1] = new(uint256.Int).Set(t.Matrix[i0][i1])
+					}
+				}
+			}
+		}
 	}
-	// Element 0
-	result[0], _, err = abi.DecodeUint256(data[0:])
+	if t.AddressMatrix != nil {
+		c.AddressMatrix = make([][3][]common.Address, len(t.AddressMatrix))
+		for i0 := range t.AddressMatrix {
+			for i1 := range t.AddressMatrix[i0] {
+				c.AddressMatrix[i0][i1] = slices.Clone(t.AddressMatrix[i0][i1])
+			}
+		}
+	}
+	if t.DymMatrix != nil {
+		c.DymMatrix = make([][]string, len(t.DymMatrix))
+		for i0 := range t.DymMatrix {
+			c.DymMatrix[i0] = slices.Clone(t.DymMatrix[i0])
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*TestNestedDynamicArraysCall)(nil)
+var _ abi.Decoder = (*TestNestedDynamicArraysCall)(nil)
+
+// RandomTestNestedDynamicArraysCall returns a TestNestedDynamicArraysCall filled with random values, for property based tests
+func RandomTestNestedDynamicArraysCall(r *rand.Rand, maxDepth, maxLen int) TestNestedDynamicArraysCall {
+	var t TestNestedDynamicArraysCall
+	t.Matrix = make([][]*uint256.Int, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Matrix {
+		t.Matrix[i0] = make([]*uint256.Int, abi.RandomLen(r, maxDepth-1, maxLen))
+		for i1 := range t.Matrix[i0] {
+			t.Matrix[i0][i1] = abi.RandomUint256(r, 256)
+		}
+	}
+	t.AddressMatrix = make([][3][]common.Address, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.AddressMatrix {
+		for i1 := range t.AddressMatrix[i0] {
+			t.AddressMatrix[i0][i1] = make([]common.Address, abi.RandomLen(r, maxDepth-1, maxLen))
+			for i2 := range t.AddressMatrix[i0][i1] {
+				r.Read(t.AddressMatrix[i0][i1][i2][:])
+			}
+		}
+	}
+	t.DymMatrix = make([][]string, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.DymMatrix {
+		t.DymMatrix[i0] = make([]string, abi.RandomLen(r, maxDepth-1, maxLen))
+		for i1 := range t.DymMatrix[i0] {
+			t.DymMatrix[i0][i1] = abi.RandomString(r, maxLen)
+		}
+	}
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestNestedDynamicArraysCall) GetMethodName() string {
+	return "testNestedDynamicArrays"
+}
+
+// GetMethodID returns the function id
+func (t TestNestedDynamicArraysCall) GetMethodID() uint32 {
+	return TestNestedDynamicArraysID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestNestedDynamicArraysCall) GetMethodSelector() [4]byte {
+	return TestNestedDynamicArraysSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testNestedDynamicArrays arguments including function selector
+func (t TestNestedDynamicArraysCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testNestedDynamicArrays arguments to ABI bytes including function selector
+func (t TestNestedDynamicArraysCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestNestedDynamicArraysSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes testNestedDynamicArrays arguments to 0x prefixed hex string
+func (t TestNestedDynamicArraysCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
 	if err != nil {
-		return result, 0, err
+		return "", err
 	}
-	// Element 1
-	result[1], _, err = abi.DecodeUint256(data[32:])
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testNestedDynamicArrays arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestNestedDynamicArraysCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
 	if err != nil {
-		return result, 0, err
+		return "", err
 	}
-	// Element 2
-	result[2], _, err = abi.DecodeUint256(data[64:])
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testNestedDynamicArrays calldata, returns 0 if encoding fails
+func (t TestNestedDynamicArraysCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
 	if err != nil {
-		return result, 0, err
+		return 0
 	}
-	return result, 96, nil
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
 }
 
-// DecodeUint256SliceSlice decodes uint256[][] from ABI bytes
-func DecodeUint256SliceSlice(data []byte) ([][]*uint256.Int, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
+// DecodeWithSelector decodes testNestedDynamicArrays arguments from ABI bytes including function selector
+func (t *TestNestedDynamicArraysCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	length, err := abi.DecodeSize(data)
+	if [4]byte(data[:4]) != TestNestedDynamicArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testNestedDynamicArrays arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestNestedDynamicArraysCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicArraysCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestNestedDynamicArraysCall constructs a new TestNestedDynamicArraysCall
+func NewTestNestedDynamicArraysCall(
+	matrix [][]*uint256.Int,
+	addressMatrix [][3][]common.Address,
+	dymMatrix [][]string,
+) *TestNestedDynamicArraysCall {
+	return &TestNestedDynamicArraysCall{
+		Matrix:        matrix,
+		AddressMatrix: addressMatrix,
+		DymMatrix:     dymMatrix,
+	}
+}
+
+const TestNestedDynamicArraysReturnStaticSize = 32
+
+// TestNestedDynamicArraysReturn represents an ABI tuple
+type TestNestedDynamicArraysReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestNestedDynamicArraysReturn
+func (t TestNestedDynamicArraysReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestNestedDynamicArraysReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestNestedDynamicArraysReturn to ABI bytes in the provided buffer
+func (value TestNestedDynamicArraysReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestNestedDynamicArraysReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestNestedDynamicArraysReturn to ABI bytes
+func (value TestNestedDynamicArraysReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestNestedDynamicArraysReturn from ABI bytes in the provided buffer
+func (t *TestNestedDynamicArraysReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		n      int
-		offset int
+		err error
 	)
-	// Decode elements with dynamic types
-	result := make([][]*uint256.Int, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
-		}
-		result[i], n, err = abi.DecodeUint256Slice(data[dynamicOffset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		dynamicOffset += n
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
 	}
-	return result, dynamicOffset + 32, nil
+	return dynamicOffset, nil
 }
 
-// DecodeUser2Slice decodes (uint256,(string,string[],(uint256,string[])))[] from ABI bytes
-func DecodeUser2Slice(data []byte) ([]User2, int, error) {
-	// Decode length
-	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := abi.DecodeSize(data)
+// DecodeStrict decodes TestNestedDynamicArraysReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedDynamicArraysReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+		return err
 	}
-	var (
-		n      int
-		offset int
-	)
-	// Decode elements with dynamic types
-	result := make([]User2, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += 32
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
 
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
-		}
-		n, err = result[i].Decode(data[dynamicOffset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		dynamicOffset += n
+// DecodeHex decodes TestNestedDynamicArraysReturn from the hex string with an optional 0x prefix
+func (t *TestNestedDynamicArraysReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicArraysReturn: %w", err)
 	}
-	return result, dynamicOffset + 32, nil
+	return t.Decode(data)
 }
 
-// DecodeUserSlice decodes (address,string,uint256)[] from ABI bytes
-func DecodeUserSlice(data []byte) ([]User, int, error) {
-	// Decode length
+// DecodeInto decodes TestNestedDynamicArraysReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedDynamicArraysReturn) DecodeInto(data []byte) (int, error) {
 	if len(data) < 32 {
-		return nil, 0, io.ErrUnexpectedEOF
-	}
-	length, err := abi.DecodeSize(data)
-	if err != nil {
-		return nil, 0, err
-	}
-	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
-		return nil, 0, io.ErrUnexpectedEOF
+		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		n      int
-		offset int
+		err error
 	)
-	// Decode elements with dynamic types
-	result := make([]User, length)
-	dynamicOffset := length * 32
-	for i := 0; i < length; i++ {
-		tmp, err := abi.DecodeSize(data[offset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		offset += 32
-
-		if dynamicOffset != tmp {
-			return nil, 0, abi.ErrInvalidOffsetForSliceElement
-		}
-		n, err = result[i].Decode(data[dynamicOffset:])
-		if err != nil {
-			return nil, 0, err
-		}
-		dynamicOffset += n
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
 	}
-	return result, dynamicOffset + 32, nil
+	return dynamicOffset, nil
 }
 
-// PackedEncodeAddressArray5 encodes address[5] to packed ABI bytes (no padding)
-func PackedEncodeAddressArray5(value [5]common.Address, buf []byte) (int, error) {
-	if len(buf) < 100 {
-		return 0, io.ErrShortBuffer
-	}
-	// Encode fixed-size array elements sequentially (no padding)
-	var offset int
-	for i := 0; i < 5; i++ {
-		n, err := abi.PackedEncodeAddress(value[i], buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
-	return 100, nil
+// Reset sets every field of TestNestedDynamicArraysReturn to the zero value, to reuse it from a pool
+func (t *TestNestedDynamicArraysReturn) Reset() {
+	t.Field1 = false
 }
 
-// PackedEncodeBytes32Array2 encodes bytes32[2] to packed ABI bytes (no padding)
-func PackedEncodeBytes32Array2(value [2][32]byte, buf []byte) (int, error) {
-	if len(buf) < 64 {
-		return 0, io.ErrShortBuffer
-	}
-	// Encode fixed-size array elements sequentially (no padding)
-	var offset int
-	for i := 0; i < 2; i++ {
-		n, err := abi.PackedEncodeBytes32(value[i], buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
-	return 64, nil
+// Clone returns a deep copy of TestNestedDynamicArraysReturn
+func (t TestNestedDynamicArraysReturn) Clone() TestNestedDynamicArraysReturn {
+	c := t
+	return c
 }
 
-// PackedEncodeUint256Array3 encodes uint256[3] to packed ABI bytes (no padding)
-func PackedEncodeUint256Array3(value [3]*uint256.Int, buf []byte) (int, error) {
-	if len(buf) < 96 {
-		return 0, io.ErrShortBuffer
-	}
-	// Encode fixed-size array elements sequentially (no padding)
-	var offset int
-	for i := 0; i < 3; i++ {
-		n, err := abi.PackedEncodeUint256(value[i], buf[offset:])
-		if err != nil {
-			return 0, err
-		}
-		offset += n
-	}
-	return 96, nil
+// PackedEncodedSize returns the packed encoded size of TestNestedDynamicArraysReturn
+func (t TestNestedDynamicArraysReturn) PackedEncodedSize() int {
+	return 1
 }
 
-// PackedDecodeAddressArray5 decodes address[5] from packed ABI bytes (no padding)
-func PackedDecodeAddressArray5(data []byte) ([5]common.Address, int, error) {
-	if len(data) < 100 {
-		return [5]common.Address{}, 0, io.ErrUnexpectedEOF
-	}
+// PackedEncodeTo encodes TestNestedDynamicArraysReturn to packed ABI bytes in the provided buffer
+func (value TestNestedDynamicArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
-		result [5]common.Address
 		offset int
 		n      int
 		err    error
 	)
-	for i := 0; i < 5; i++ {
-		result[i], n, err = abi.PackedDecodeAddress(data[offset:])
-		if err != nil {
-			return result, 0, err
-		}
-		offset += n
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
 	}
-	return result, 100, nil
+	offset += n
+
+	return offset, nil
 }
 
-// PackedDecodeBytes32Array2 decodes bytes32[2] from packed ABI bytes (no padding)
-func PackedDecodeBytes32Array2(data []byte) ([2][32]byte, int, error) {
-	if len(data) < 64 {
-		return [2][32]byte{}, 0, io.ErrUnexpectedEOF
+// PackedEncode encodes TestNestedDynamicArraysReturn to packed ABI bytes
+func (value TestNestedDynamicArraysReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
 	}
-	var (
-		result [2][32]byte
-		offset int
-		n      int
-		err    error
-	)
-	for i := 0; i < 2; i++ {
-		result[i], n, err = abi.PackedDecodeBytes32(data[offset:])
-		if err != nil {
-			return result, 0, err
-		}
-		offset += n
+	return buf, nil
+}
+
+// PackedDecode decodes TestNestedDynamicArraysReturn from packed ABI bytes
+func (t *TestNestedDynamicArraysReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	return result, 64, nil
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
 }
 
-// PackedDecodeUint256Array3 decodes uint256[3] from packed ABI bytes (no padding)
-func PackedDecodeUint256Array3(data []byte) ([3]*uint256.Int, int, error) {
-	if len(data) < 96 {
-		return [3]*uint256.Int{}, 0, io.ErrUnexpectedEOF
+var _ abi.Tuple = (*TestNestedDynamicArraysReturn)(nil)
+var _ abi.Decoder = (*TestNestedDynamicArraysReturn)(nil)
+var _ abi.PackedTuple = (*TestNestedDynamicArraysReturn)(nil)
+
+// RandomTestNestedDynamicArraysReturn returns a TestNestedDynamicArraysReturn filled with random values, for property based tests
+func RandomTestNestedDynamicArraysReturn(r *rand.Rand, maxDepth, maxLen int) TestNestedDynamicArraysReturn {
+	var t TestNestedDynamicArraysReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTestNestedDynamicArraysReturn decodes the return data of testNestedDynamicArrays into its values
+func DecodeTestNestedDynamicArraysReturn(data []byte) (r1 bool, err error) {
+	var result TestNestedDynamicArraysReturn
+	if _, err = result.Decode(data); err != nil {
+		return
 	}
-	var (
-		result [3]*uint256.Int
-		offset int
-		n      int
-		err    error
-	)
-	for i := 0; i < 3; i++ {
-		result[i], n, err = abi.PackedDecodeUint256(data[offset:])
-		if err != nil {
-			return result, 0, err
-		}
-		offset += n
+	return result.Field1, nil
+}
+
+// DecodeTestNestedDynamicArrays decodes the single return value of testNestedDynamicArrays
+func DecodeTestNestedDynamicArrays(data []byte) (bool, error) {
+	return DecodeTestNestedDynamicArraysReturn(data)
+}
+
+// DecodeTestNestedDynamicArraysHex decodes the single return value of testNestedDynamicArrays from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestNestedDynamicArraysHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestNestedDynamicArraysReturn: %w", err)
 	}
-	return result, 96, nil
+	return DecodeTestNestedDynamicArraysReturn(data)
 }
 
-var _ abi.Method = (*TestComplexDynamicTuplesCall)(nil)
+// EncodeTestNestedDynamicArraysResult encodes the single return value of testNestedDynamicArrays, e.g. for the return data of precompiles
+func EncodeTestNestedDynamicArraysResult(v bool) ([]byte, error) {
+	result := TestNestedDynamicArraysReturn{Field1: v}
+	return result.Encode()
+}
 
-const TestComplexDynamicTuplesCallStaticSize = 32
+var _ abi.Method = (*TestNestedDynamicFixedArraysCall)(nil)
 
-var _ abi.Tuple = (*TestComplexDynamicTuplesCall)(nil)
+const TestNestedDynamicFixedArraysCallStaticSize = 32
 
-// TestComplexDynamicTuplesCall represents an ABI tuple
-type TestComplexDynamicTuplesCall struct {
-	Users []User2
+// TestNestedDynamicFixedArraysCall represents an ABI tuple
+type TestNestedDynamicFixedArraysCall struct {
+	Holders []FixedArrayHolder
 }
 
-// EncodedSize returns the total encoded size of TestComplexDynamicTuplesCall
-func (t TestComplexDynamicTuplesCall) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestNestedDynamicFixedArraysCall
+func (t TestNestedDynamicFixedArraysCall) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += SizeUser2Slice(t.Users)
+	dynamicSize += SizeFixedArrayHolderSlice(t.Holders)
 
-	return TestComplexDynamicTuplesCallStaticSize + dynamicSize
+	return TestNestedDynamicFixedArraysCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestComplexDynamicTuplesCall to ABI bytes in the provided buffer
-func (value TestComplexDynamicTuplesCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedDynamicFixedArraysCall to ABI bytes in the provided buffer
+func (value TestNestedDynamicFixedArraysCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestComplexDynamicTuplesCallStaticSize // Start dynamic data after static section
+	dynamicOffset := TestNestedDynamicFixedArraysCallStaticSize // Start dynamic data after static section
 	var (
 		err error
 		n   int
 	)
-	// Field Users: (uint256,(string,string[],(uint256,string[])))[]
+	// Field Holders: (uint256,string[3],bytes[2],(uint32,bytes,bool)[2])[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
-	n, err = EncodeUser2Slice(value.Users, buf[dynamicOffset:])
+	n, err = EncodeFixedArrayHolderSlice(value.Holders, buf[dynamicOffset:])
 	if err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
-
+	dynamicOffset += n
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestNestedDynamicFixedArraysCall to ABI bytes
+func (value TestNestedDynamicFixedArraysCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestNestedDynamicFixedArraysCall from ABI bytes in the provided buffer
+func (t *TestNestedDynamicFixedArraysCall) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Holders
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Holders, n, err = DecodeFixedArrayHolderSlice(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestComplexDynamicTuplesCall to ABI bytes
-func (value TestComplexDynamicTuplesCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+// DecodeStrict decodes TestNestedDynamicFixedArraysCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedDynamicFixedArraysCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
 	}
-	return buf, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// Decode decodes TestComplexDynamicTuplesCall from ABI bytes in the provided buffer
-func (t *TestComplexDynamicTuplesCall) Decode(data []byte) (int, error) {
+// DecodeHex decodes TestNestedDynamicFixedArraysCall from the hex string with an optional 0x prefix
+func (t *TestNestedDynamicFixedArraysCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicFixedArraysCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedDynamicFixedArraysCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedDynamicFixedArraysCall) DecodeInto(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -1677,7 +8961,7 @@ func (t *TestComplexDynamicTuplesCall) Decode(data []byte) (int, error) {
 		offset int
 	)
 	dynamicOffset := 32
-	// Decode dynamic field Users
+	// Decode dynamic field Holders
 	{
 		offset, err = abi.DecodeSize(data[0:])
 		if err != nil {
@@ -1686,7 +8970,7 @@ func (t *TestComplexDynamicTuplesCall) Decode(data []byte) (int, error) {
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		t.Users, n, err = DecodeUser2Slice(data[dynamicOffset:])
+		t.Holders, n, err = DecodeIntoFixedArrayHolderSlice(t.Holders, data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -1695,61 +8979,146 @@ func (t *TestComplexDynamicTuplesCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// Reset sets every field of TestNestedDynamicFixedArraysCall to the zero value, to reuse it from a pool
+func (t *TestNestedDynamicFixedArraysCall) Reset() {
+	t.Holders = nil
+}
+
+// Clone returns a deep copy of TestNestedDynamicFixedArraysCall
+func (t TestNestedDynamicFixedArraysCall) Clone() TestNestedDynamicFixedArraysCall {
+	c := t
+	if t.Holders != nil {
+		c.Holders = make([]FixedArrayHolder, len(t.Holders))
+		for i0 := range t.Holders {
+			c.Holders[i0] = t.Holders[i0].Clone()
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*TestNestedDynamicFixedArraysCall)(nil)
+var _ abi.Decoder = (*TestNestedDynamicFixedArraysCall)(nil)
+
+// RandomTestNestedDynamicFixedArraysCall returns a TestNestedDynamicFixedArraysCall filled with random values, for property based tests
+func RandomTestNestedDynamicFixedArraysCall(r *rand.Rand, maxDepth, maxLen int) TestNestedDynamicFixedArraysCall {
+	var t TestNestedDynamicFixedArraysCall
+	t.Holders = make([]FixedArrayHolder, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Holders {
+		t.Holders[i0] = RandomFixedArrayHolder(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
 // GetMethodName returns the function name
-func (t TestComplexDynamicTuplesCall) GetMethodName() string {
-	return "testComplexDynamicTuples"
+func (t TestNestedDynamicFixedArraysCall) GetMethodName() string {
+	return "testNestedDynamicFixedArrays"
 }
 
 // GetMethodID returns the function id
-func (t TestComplexDynamicTuplesCall) GetMethodID() uint32 {
-	return TestComplexDynamicTuplesID
+func (t TestNestedDynamicFixedArraysCall) GetMethodID() uint32 {
+	return TestNestedDynamicFixedArraysID
 }
 
 // GetMethodSelector returns the function selector
-func (t TestComplexDynamicTuplesCall) GetMethodSelector() [4]byte {
-	return TestComplexDynamicTuplesSelector
+func (t TestNestedDynamicFixedArraysCall) GetMethodSelector() [4]byte {
+	return TestNestedDynamicFixedArraysSelector
 }
 
-// EncodeWithSelector encodes testComplexDynamicTuples arguments to ABI bytes including function selector
-func (t TestComplexDynamicTuplesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestComplexDynamicTuplesSelector[:])
+// EncodedSizeWithSelector returns the encoded size of testNestedDynamicFixedArrays arguments including function selector
+func (t TestNestedDynamicFixedArraysCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testNestedDynamicFixedArrays arguments to ABI bytes including function selector
+func (t TestNestedDynamicFixedArraysCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestNestedDynamicFixedArraysSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewTestComplexDynamicTuplesCall constructs a new TestComplexDynamicTuplesCall
-func NewTestComplexDynamicTuplesCall(
-	users []User2,
-) *TestComplexDynamicTuplesCall {
-	return &TestComplexDynamicTuplesCall{
-		Users: users,
+// EncodeHex encodes testNestedDynamicFixedArrays arguments to 0x prefixed hex string
+func (t TestNestedDynamicFixedArraysCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const TestComplexDynamicTuplesReturnStaticSize = 32
+// EncodeHexWithSelector encodes testNestedDynamicFixedArrays arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestNestedDynamicFixedArraysCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*TestComplexDynamicTuplesReturn)(nil)
-var _ abi.PackedTuple = (*TestComplexDynamicTuplesReturn)(nil)
+// CalldataCost returns the gas cost of the testNestedDynamicFixedArrays calldata, returns 0 if encoding fails
+func (t TestNestedDynamicFixedArraysCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-// TestComplexDynamicTuplesReturn represents an ABI tuple
-type TestComplexDynamicTuplesReturn struct {
+// DecodeWithSelector decodes testNestedDynamicFixedArrays arguments from ABI bytes including function selector
+func (t *TestNestedDynamicFixedArraysCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNestedDynamicFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes testNestedDynamicFixedArrays arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestNestedDynamicFixedArraysCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicFixedArraysCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTestNestedDynamicFixedArraysCall constructs a new TestNestedDynamicFixedArraysCall
+func NewTestNestedDynamicFixedArraysCall(
+	holders []FixedArrayHolder,
+) *TestNestedDynamicFixedArraysCall {
+	return &TestNestedDynamicFixedArraysCall{
+		Holders: holders,
+	}
+}
+
+const TestNestedDynamicFixedArraysReturnStaticSize = 32
+
+// TestNestedDynamicFixedArraysReturn represents an ABI tuple
+type TestNestedDynamicFixedArraysReturn struct {
 	Field1 bool
 }
 
-// EncodedSize returns the total encoded size of TestComplexDynamicTuplesReturn
-func (t TestComplexDynamicTuplesReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestNestedDynamicFixedArraysReturn
+func (t TestNestedDynamicFixedArraysReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestComplexDynamicTuplesReturnStaticSize + dynamicSize
+	return TestNestedDynamicFixedArraysReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestComplexDynamicTuplesReturn to ABI bytes in the provided buffer
-func (value TestComplexDynamicTuplesReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedDynamicFixedArraysReturn to ABI bytes in the provided buffer
+func (value TestNestedDynamicFixedArraysReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestComplexDynamicTuplesReturnStaticSize // Start dynamic data after static section
+	dynamicOffset := TestNestedDynamicFixedArraysReturnStaticSize // Start dynamic data after static section
 	// Field Field1: bool
 	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
@@ -1758,8 +9127,8 @@ func (value TestComplexDynamicTuplesReturn) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestComplexDynamicTuplesReturn to ABI bytes
-func (value TestComplexDynamicTuplesReturn) Encode() ([]byte, error) {
+// Encode encodes TestNestedDynamicFixedArraysReturn to ABI bytes
+func (value TestNestedDynamicFixedArraysReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -1767,8 +9136,8 @@ func (value TestComplexDynamicTuplesReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestComplexDynamicTuplesReturn from ABI bytes in the provided buffer
-func (t *TestComplexDynamicTuplesReturn) Decode(data []byte) (int, error) {
+// Decode decodes TestNestedDynamicFixedArraysReturn from ABI bytes in the provided buffer
+func (t *TestNestedDynamicFixedArraysReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -1784,13 +9153,61 @@ func (t *TestComplexDynamicTuplesReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestComplexDynamicTuplesReturn
-func (t TestComplexDynamicTuplesReturn) PackedEncodedSize() int {
+// DecodeStrict decodes TestNestedDynamicFixedArraysReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedDynamicFixedArraysReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestNestedDynamicFixedArraysReturn from the hex string with an optional 0x prefix
+func (t *TestNestedDynamicFixedArraysReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedDynamicFixedArraysReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedDynamicFixedArraysReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedDynamicFixedArraysReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestNestedDynamicFixedArraysReturn to the zero value, to reuse it from a pool
+func (t *TestNestedDynamicFixedArraysReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestNestedDynamicFixedArraysReturn
+func (t TestNestedDynamicFixedArraysReturn) Clone() TestNestedDynamicFixedArraysReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestNestedDynamicFixedArraysReturn
+func (t TestNestedDynamicFixedArraysReturn) PackedEncodedSize() int {
 	return 1
 }
 
-// PackedEncodeTo encodes TestComplexDynamicTuplesReturn to packed ABI bytes in the provided buffer
-func (value TestComplexDynamicTuplesReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes TestNestedDynamicFixedArraysReturn to packed ABI bytes in the provided buffer
+func (value TestNestedDynamicFixedArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
@@ -1807,8 +9224,8 @@ func (value TestComplexDynamicTuplesReturn) PackedEncodeTo(buf []byte) (int, err
 	return offset, nil
 }
 
-// PackedEncode encodes TestComplexDynamicTuplesReturn to packed ABI bytes
-func (value TestComplexDynamicTuplesReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestNestedDynamicFixedArraysReturn to packed ABI bytes
+func (value TestNestedDynamicFixedArraysReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -1816,8 +9233,8 @@ func (value TestComplexDynamicTuplesReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestComplexDynamicTuplesReturn from packed ABI bytes
-func (t *TestComplexDynamicTuplesReturn) PackedDecode(data []byte) (int, error) {
+// PackedDecode decodes TestNestedDynamicFixedArraysReturn from packed ABI bytes
+func (t *TestNestedDynamicFixedArraysReturn) PackedDecode(data []byte) (int, error) {
 	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -1830,48 +9247,84 @@ func (t *TestComplexDynamicTuplesReturn) PackedDecode(data []byte) (int, error)
 	return 1, nil
 }
 
-var _ abi.Method = (*TestDeeplyNestedCall)(nil)
+var _ abi.Tuple = (*TestNestedDynamicFixedArraysReturn)(nil)
+var _ abi.Decoder = (*TestNestedDynamicFixedArraysReturn)(nil)
+var _ abi.PackedTuple = (*TestNestedDynamicFixedArraysReturn)(nil)
 
-const TestDeeplyNestedCallStaticSize = 32
+// RandomTestNestedDynamicFixedArraysReturn returns a TestNestedDynamicFixedArraysReturn filled with random values, for property based tests
+func RandomTestNestedDynamicFixedArraysReturn(r *rand.Rand, maxDepth, maxLen int) TestNestedDynamicFixedArraysReturn {
+	var t TestNestedDynamicFixedArraysReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
 
-var _ abi.Tuple = (*TestDeeplyNestedCall)(nil)
+// DecodeTestNestedDynamicFixedArraysReturn decodes the return data of testNestedDynamicFixedArrays into its values
+func DecodeTestNestedDynamicFixedArraysReturn(data []byte) (r1 bool, err error) {
+	var result TestNestedDynamicFixedArraysReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-// TestDeeplyNestedCall represents an ABI tuple
-type TestDeeplyNestedCall struct {
-	Data Level1
+// DecodeTestNestedDynamicFixedArrays decodes the single return value of testNestedDynamicFixedArrays
+func DecodeTestNestedDynamicFixedArrays(data []byte) (bool, error) {
+	return DecodeTestNestedDynamicFixedArraysReturn(data)
 }
 
-// EncodedSize returns the total encoded size of TestDeeplyNestedCall
-func (t TestDeeplyNestedCall) EncodedSize() int {
+// DecodeTestNestedDynamicFixedArraysHex decodes the single return value of testNestedDynamicFixedArrays from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestNestedDynamicFixedArraysHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestNestedDynamicFixedArraysReturn: %w", err)
+	}
+	return DecodeTestNestedDynamicFixedArraysReturn(data)
+}
+
+// EncodeTestNestedDynamicFixedArraysResult encodes the single return value of testNestedDynamicFixedArrays, e.g. for the return data of precompiles
+func EncodeTestNestedDynamicFixedArraysResult(v bool) ([]byte, error) {
+	result := TestNestedDynamicFixedArraysReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestNestedFixedArraysCall)(nil)
+
+const TestNestedFixedArraysCallStaticSize = 384
+
+// TestNestedFixedArraysCall represents an ABI tuple
+type TestNestedFixedArraysCall struct {
+	Matrix [3][2]*uint256.Int
+	Owners [2][3]common.Address
+}
+
+// EncodedSize returns the total encoded size of TestNestedFixedArraysCall
+func (t TestNestedFixedArraysCall) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += t.Data.EncodedSize()
 
-	return TestDeeplyNestedCallStaticSize + dynamicSize
+	return TestNestedFixedArraysCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestDeeplyNestedCall to ABI bytes in the provided buffer
-func (value TestDeeplyNestedCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedFixedArraysCall to ABI bytes in the provided buffer
+func (value TestNestedFixedArraysCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestDeeplyNestedCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Data: ((((uint256,string))))
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = value.Data.EncodeTo(buf[dynamicOffset:])
-	if err != nil {
+	dynamicOffset := TestNestedFixedArraysCallStaticSize // Start dynamic data after static section
+	// Field Matrix: uint256[2][3]
+	if _, err := EncodeUint256Array2Array3U256(value.Matrix, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Owners: address[3][2]
+	if _, err := EncodeAddressArray3Array2(value.Owners, buf[192:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestDeeplyNestedCall to ABI bytes
-func (value TestDeeplyNestedCall) Encode() ([]byte, error) {
+// Encode encodes TestNestedFixedArraysCall to ABI bytes
+func (value TestNestedFixedArraysCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -1879,100 +9332,313 @@ func (value TestDeeplyNestedCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestDeeplyNestedCall from ABI bytes in the provided buffer
-func (t *TestDeeplyNestedCall) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// Decode decodes TestNestedFixedArraysCall from ABI bytes in the provided buffer
+func (t *TestNestedFixedArraysCall) Decode(data []byte) (int, error) {
+	if len(data) < 384 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		err    error
-		n      int
-		offset int
+		err error
 	)
-	dynamicOffset := 32
-	// Decode dynamic field Data
-	{
-		offset, err = abi.DecodeSize(data[0:])
-		if err != nil {
-			return 0, err
+	dynamicOffset := 384
+	// Decode static field Matrix: uint256[2][3]
+	t.Matrix, _, err = DecodeUint256Array2Array3U256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Owners: address[3][2]
+	t.Owners, _, err = DecodeAddressArray3Array2(data[192:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestNestedFixedArraysCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedFixedArraysCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestNestedFixedArraysCall from the hex string with an optional 0x prefix
+func (t *TestNestedFixedArraysCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedFixedArraysCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedFixedArraysCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedFixedArraysCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 384 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 384
+	// Decode static field Matrix: uint256[2][3]
+	t.Matrix, _, err = DecodeUint256Array2Array3U256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Owners: address[3][2]
+	t.Owners, _, err = DecodeAddressArray3Array2(data[192:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestNestedFixedArraysCall to the zero value, to reuse it from a pool
+func (t *TestNestedFixedArraysCall) Reset() {
+	t.Matrix = [3][2]*uint256.Int{}
+	t.Owners = [2][3]common.Address{}
+}
+
+// Clone returns a deep copy of TestNestedFixedArraysCall
+func (t TestNestedFixedArraysCall) Clone() TestNestedFixedArraysCall {
+	c := t
+	for i0 := range t.Matrix {
+		for i1 := range t.Matrix[i0] {
+			if t.Matrix[i0][i1] != nil {
+				c.Matrix[i0][i1] = new(uint256.Int).Set(t.Matrix[i0][i1])
+			}
 		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestNestedFixedArraysCall
+func (t TestNestedFixedArraysCall) PackedEncodedSize() int {
+	return 312
+}
+
+// PackedEncodeTo encodes TestNestedFixedArraysCall to packed ABI bytes in the provided buffer
+func (value TestNestedFixedArraysCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Matrix: uint256[2][3]
+	n, err = PackedEncodeUint256Array2Array3U256(value.Matrix, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Owners: address[3][2]
+	n, err = PackedEncodeAddressArray3Array2(value.Owners, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestNestedFixedArraysCall to packed ABI bytes
+func (value TestNestedFixedArraysCall) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestNestedFixedArraysCall from packed ABI bytes
+func (t *TestNestedFixedArraysCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 312 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Matrix: uint256[2][3]
+	t.Matrix, _, err = PackedDecodeUint256Array2Array3U256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Owners: address[3][2]
+	t.Owners, _, err = PackedDecodeAddressArray3Array2(data[192:])
+	if err != nil {
+		return 0, err
+	}
+	return 312, nil
+}
+
+var _ abi.Tuple = (*TestNestedFixedArraysCall)(nil)
+var _ abi.Decoder = (*TestNestedFixedArraysCall)(nil)
+var _ abi.PackedTuple = (*TestNestedFixedArraysCall)(nil)
+
+// RandomTestNestedFixedArraysCall returns a TestNestedFixedArraysCall filled with random values, for property based tests
+func RandomTestNestedFixedArraysCall(r *rand.Rand, maxDepth, maxLen int) TestNestedFixedArraysCall {
+	var t TestNestedFixedArraysCall
+	for i0 := range t.Matrix {
+		for i1 := range t.Matrix[i0] {
+			t.Matrix[i0][i1] = abi.RandomUint256(r, 256)
 		}
-		n, err = t.Data.Decode(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
+	}
+	for i0 := range t.Owners {
+		for i1 := range t.Owners[i0] {
+			r.Read(t.Owners[i0][i1][:])
 		}
-		dynamicOffset += n
 	}
-	return dynamicOffset, nil
+	return t
 }
 
 // GetMethodName returns the function name
-func (t TestDeeplyNestedCall) GetMethodName() string {
-	return "testDeeplyNested"
+func (t TestNestedFixedArraysCall) GetMethodName() string {
+	return "testNestedFixedArrays"
 }
 
 // GetMethodID returns the function id
-func (t TestDeeplyNestedCall) GetMethodID() uint32 {
-	return TestDeeplyNestedID
+func (t TestNestedFixedArraysCall) GetMethodID() uint32 {
+	return TestNestedFixedArraysID
 }
 
 // GetMethodSelector returns the function selector
-func (t TestDeeplyNestedCall) GetMethodSelector() [4]byte {
-	return TestDeeplyNestedSelector
+func (t TestNestedFixedArraysCall) GetMethodSelector() [4]byte {
+	return TestNestedFixedArraysSelector
 }
 
-// EncodeWithSelector encodes testDeeplyNested arguments to ABI bytes including function selector
-func (t TestDeeplyNestedCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestDeeplyNestedSelector[:])
+// EncodedSizeWithSelector returns the encoded size of testNestedFixedArrays arguments including function selector
+func (t TestNestedFixedArraysCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testNestedFixedArrays arguments to ABI bytes including function selector
+func (t TestNestedFixedArraysCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestNestedFixedArraysSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewTestDeeplyNestedCall constructs a new TestDeeplyNestedCall
-func NewTestDeeplyNestedCall(
-	data Level1,
-) *TestDeeplyNestedCall {
-	return &TestDeeplyNestedCall{
-		Data: data,
+// EncodeHex encodes testNestedFixedArrays arguments to 0x prefixed hex string
+func (t TestNestedFixedArraysCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const TestDeeplyNestedReturnStaticSize = 32
+// EncodeHexWithSelector encodes testNestedFixedArrays arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestNestedFixedArraysCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*TestDeeplyNestedReturn)(nil)
-var _ abi.PackedTuple = (*TestDeeplyNestedReturn)(nil)
+// CalldataCost returns the gas cost of the testNestedFixedArrays calldata, returns 0 if encoding fails
+func (t TestNestedFixedArraysCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-// TestDeeplyNestedReturn represents an ABI tuple
-type TestDeeplyNestedReturn struct {
-	Field1 bool
+// DecodeWithSelector decodes testNestedFixedArrays arguments from ABI bytes including function selector
+func (t *TestNestedFixedArraysCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNestedFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
 }
 
-// EncodedSize returns the total encoded size of TestDeeplyNestedReturn
-func (t TestDeeplyNestedReturn) EncodedSize() int {
+// DecodeHexWithSelector decodes testNestedFixedArrays arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestNestedFixedArraysCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedFixedArraysCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes testNestedFixedArrays arguments to packed ABI bytes including function selector
+func (t TestNestedFixedArraysCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestNestedFixedArraysSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes testNestedFixedArrays arguments from packed ABI bytes including function selector
+func (t *TestNestedFixedArraysCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNestedFixedArraysSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewTestNestedFixedArraysCall constructs a new TestNestedFixedArraysCall
+func NewTestNestedFixedArraysCall(
+	matrix [3][2]*uint256.Int,
+	owners [2][3]common.Address,
+) *TestNestedFixedArraysCall {
+	return &TestNestedFixedArraysCall{
+		Matrix: matrix,
+		Owners: owners,
+	}
+}
+
+const TestNestedFixedArraysReturnStaticSize = 192
+
+// TestNestedFixedArraysReturn represents an ABI tuple
+type TestNestedFixedArraysReturn struct {
+	Field1 [3][2]*uint256.Int
+}
+
+// EncodedSize returns the total encoded size of TestNestedFixedArraysReturn
+func (t TestNestedFixedArraysReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestDeeplyNestedReturnStaticSize + dynamicSize
+	return TestNestedFixedArraysReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestDeeplyNestedReturn to ABI bytes in the provided buffer
-func (value TestDeeplyNestedReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedFixedArraysReturn to ABI bytes in the provided buffer
+func (value TestNestedFixedArraysReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestDeeplyNestedReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+	dynamicOffset := TestNestedFixedArraysReturnStaticSize // Start dynamic data after static section
+	// Field Field1: uint256[2][3]
+	if _, err := EncodeUint256Array2Array3U256(value.Field1, buf[0:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestDeeplyNestedReturn to ABI bytes
-func (value TestDeeplyNestedReturn) Encode() ([]byte, error) {
+// Encode encodes TestNestedFixedArraysReturn to ABI bytes
+func (value TestNestedFixedArraysReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -1980,38 +9646,93 @@ func (value TestDeeplyNestedReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestDeeplyNestedReturn from ABI bytes in the provided buffer
-func (t *TestDeeplyNestedReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// Decode decodes TestNestedFixedArraysReturn from ABI bytes in the provided buffer
+func (t *TestNestedFixedArraysReturn) Decode(data []byte) (int, error) {
+	if len(data) < 192 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+	dynamicOffset := 192
+	// Decode static field Field1: uint256[2][3]
+	t.Field1, _, err = DecodeUint256Array2Array3U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestDeeplyNestedReturn
-func (t TestDeeplyNestedReturn) PackedEncodedSize() int {
-	return 1
+// DecodeStrict decodes TestNestedFixedArraysReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedFixedArraysReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
 }
 
-// PackedEncodeTo encodes TestDeeplyNestedReturn to packed ABI bytes in the provided buffer
-func (value TestDeeplyNestedReturn) PackedEncodeTo(buf []byte) (int, error) {
+// DecodeHex decodes TestNestedFixedArraysReturn from the hex string with an optional 0x prefix
+func (t *TestNestedFixedArraysReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedFixedArraysReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedFixedArraysReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedFixedArraysReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 192 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 192
+	// Decode static field Field1: uint256[2][3]
+	t.Field1, _, err = DecodeUint256Array2Array3U256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestNestedFixedArraysReturn to the zero value, to reuse it from a pool
+func (t *TestNestedFixedArraysReturn) Reset() {
+	t.Field1 = [3][2]*uint256.Int{}
+}
+
+// Clone returns a deep copy of TestNestedFixedArraysReturn
+func (t TestNestedFixedArraysReturn) Clone() TestNestedFixedArraysReturn {
+	c := t
+	for i0 := range t.Field1 {
+		for i1 := range t.Field1[i0] {
+			if t.Field1[i0][i1] != nil {
+				c.Field1[i0][i1] = new(uint256.Int).Set(t.Field1[i0][i1])
+			}
+		}
+	}
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestNestedFixedArraysReturn
+func (t TestNestedFixedArraysReturn) PackedEncodedSize() int {
+	return 192
+}
+
+// PackedEncodeTo encodes TestNestedFixedArraysReturn to packed ABI bytes in the provided buffer
+func (value TestNestedFixedArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field Field1: uint256[2][3]
+	n, err = PackedEncodeUint256Array2Array3U256(value.Field1, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -2020,8 +9741,8 @@ func (value TestDeeplyNestedReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestDeeplyNestedReturn to packed ABI bytes
-func (value TestDeeplyNestedReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestNestedFixedArraysReturn to packed ABI bytes
+func (value TestNestedFixedArraysReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -2029,52 +9750,97 @@ func (value TestDeeplyNestedReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestDeeplyNestedReturn from packed ABI bytes
-func (t *TestDeeplyNestedReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
+// PackedDecode decodes TestNestedFixedArraysReturn from packed ABI bytes
+func (t *TestNestedFixedArraysReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 192 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	// Decode field Field1: uint256[2][3]
+	t.Field1, _, err = PackedDecodeUint256Array2Array3U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
+	return 192, nil
 }
 
-var _ abi.Method = (*TestExternalTupleCall)(nil)
+var _ abi.Tuple = (*TestNestedFixedArraysReturn)(nil)
+var _ abi.Decoder = (*TestNestedFixedArraysReturn)(nil)
+var _ abi.PackedTuple = (*TestNestedFixedArraysReturn)(nil)
 
-const TestExternalTupleCallStaticSize = 32
+// RandomTestNestedFixedArraysReturn returns a TestNestedFixedArraysReturn filled with random values, for property based tests
+func RandomTestNestedFixedArraysReturn(r *rand.Rand, maxDepth, maxLen int) TestNestedFixedArraysReturn {
+	var t TestNestedFixedArraysReturn
+	for i0 := range t.Field1 {
+		for i1 := range t.Field1[i0] {
+			t.Field1[i0][i1] = abi.RandomUint256(r, 256)
+		}
+	}
+	return t
+}
 
-var _ abi.Tuple = (*TestExternalTupleCall)(nil)
+// DecodeTestNestedFixedArraysReturn decodes the return data of testNestedFixedArrays into its values
+func DecodeTestNestedFixedArraysReturn(data []byte) (r1 [3][2]*uint256.Int, err error) {
+	var result TestNestedFixedArraysReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-// TestExternalTupleCall represents an ABI tuple
-type TestExternalTupleCall struct {
-	User User
+// DecodeTestNestedFixedArrays decodes the single return value of testNestedFixedArrays
+func DecodeTestNestedFixedArrays(data []byte) ([3][2]*uint256.Int, error) {
+	return DecodeTestNestedFixedArraysReturn(data)
 }
 
-// EncodedSize returns the total encoded size of TestExternalTupleCall
-func (t TestExternalTupleCall) EncodedSize() int {
+// DecodeTestNestedFixedArraysHex decodes the single return value of testNestedFixedArrays from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestNestedFixedArraysHex(s string) ([3][2]*uint256.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero [3][2]*uint256.Int
+		return zero, fmt.Errorf("decode TestNestedFixedArraysReturn: %w", err)
+	}
+	return DecodeTestNestedFixedArraysReturn(data)
+}
+
+// EncodeTestNestedFixedArraysResult encodes the single return value of testNestedFixedArrays, e.g. for the return data of precompiles
+func EncodeTestNestedFixedArraysResult(v [3][2]*uint256.Int) ([]byte, error) {
+	result := TestNestedFixedArraysReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestNestedStructCall)(nil)
+
+const TestNestedStructCallStaticSize = 32
+
+// TestNestedStructCall represents an ABI tuple
+type TestNestedStructCall struct {
+	Group Group
+}
+
+// EncodedSize returns the total encoded size of TestNestedStructCall
+func (t TestNestedStructCall) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += t.User.EncodedSize()
+	dynamicSize += t.Group.EncodedSize()
 
-	return TestExternalTupleCallStaticSize + dynamicSize
+	return TestNestedStructCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestExternalTupleCall to ABI bytes in the provided buffer
-func (value TestExternalTupleCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedStructCall to ABI bytes in the provided buffer
+func (value TestNestedStructCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestExternalTupleCallStaticSize // Start dynamic data after static section
+	dynamicOffset := TestNestedStructCallStaticSize // Start dynamic data after static section
 	var (
 		err error
 		n   int
 	)
-	// Field User: (address,string,uint256)
+	// Field Group: ((address,string,uint256)[])
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
-	n, err = value.User.EncodeTo(buf[dynamicOffset:])
+	n, err = value.Group.EncodeTo(buf[dynamicOffset:])
 	if err != nil {
 		return 0, err
 	}
@@ -2083,8 +9849,8 @@ func (value TestExternalTupleCall) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestExternalTupleCall to ABI bytes
-func (value TestExternalTupleCall) Encode() ([]byte, error) {
+// Encode encodes TestNestedStructCall to ABI bytes
+func (value TestNestedStructCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -2092,8 +9858,8 @@ func (value TestExternalTupleCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestExternalTupleCall from ABI bytes in the provided buffer
-func (t *TestExternalTupleCall) Decode(data []byte) (int, error) {
+// Decode decodes TestNestedStructCall from ABI bytes in the provided buffer
+func (t *TestNestedStructCall) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -2103,7 +9869,7 @@ func (t *TestExternalTupleCall) Decode(data []byte) (int, error) {
 		offset int
 	)
 	dynamicOffset := 32
-	// Decode dynamic field User
+	// Decode dynamic field Group
 	{
 		offset, err = abi.DecodeSize(data[0:])
 		if err != nil {
@@ -2112,7 +9878,55 @@ func (t *TestExternalTupleCall) Decode(data []byte) (int, error) {
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		n, err = t.User.Decode(data[dynamicOffset:])
+		n, err = t.Group.Decode(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestNestedStructCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedStructCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestNestedStructCall from the hex string with an optional 0x prefix
+func (t *TestNestedStructCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedStructCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedStructCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedStructCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field Group
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.Group.DecodeInto(data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -2121,61 +9935,138 @@ func (t *TestExternalTupleCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// Reset sets every field of TestNestedStructCall to the zero value, to reuse it from a pool
+func (t *TestNestedStructCall) Reset() {
+	t.Group.Reset()
+}
+
+// Clone returns a deep copy of TestNestedStructCall
+func (t TestNestedStructCall) Clone() TestNestedStructCall {
+	c := t
+	c.Group = t.Group.Clone()
+	return c
+}
+
+var _ abi.Tuple = (*TestNestedStructCall)(nil)
+var _ abi.Decoder = (*TestNestedStructCall)(nil)
+
+// RandomTestNestedStructCall returns a TestNestedStructCall filled with random values, for property based tests
+func RandomTestNestedStructCall(r *rand.Rand, maxDepth, maxLen int) TestNestedStructCall {
+	var t TestNestedStructCall
+	t.Group = RandomGroup(r, maxDepth-1, maxLen)
+	return t
+}
+
 // GetMethodName returns the function name
-func (t TestExternalTupleCall) GetMethodName() string {
-	return "testExternalTuple"
+func (t TestNestedStructCall) GetMethodName() string {
+	return "testNestedStruct"
 }
 
 // GetMethodID returns the function id
-func (t TestExternalTupleCall) GetMethodID() uint32 {
-	return TestExternalTupleID
+func (t TestNestedStructCall) GetMethodID() uint32 {
+	return TestNestedStructID
 }
 
 // GetMethodSelector returns the function selector
-func (t TestExternalTupleCall) GetMethodSelector() [4]byte {
-	return TestExternalTupleSelector
+func (t TestNestedStructCall) GetMethodSelector() [4]byte {
+	return TestNestedStructSelector
 }
 
-// EncodeWithSelector encodes testExternalTuple arguments to ABI bytes including function selector
-func (t TestExternalTupleCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestExternalTupleSelector[:])
+// EncodedSizeWithSelector returns the encoded size of testNestedStruct arguments including function selector
+func (t TestNestedStructCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testNestedStruct arguments to ABI bytes including function selector
+func (t TestNestedStructCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestNestedStructSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return result, nil
+}
+
+// EncodeHex encodes testNestedStruct arguments to 0x prefixed hex string
+func (t TestNestedStructCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testNestedStruct arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestNestedStructCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testNestedStruct calldata, returns 0 if encoding fails
+func (t TestNestedStructCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testNestedStruct arguments from ABI bytes including function selector
+func (t *TestNestedStructCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNestedStructSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
 }
 
-// NewTestExternalTupleCall constructs a new TestExternalTupleCall
-func NewTestExternalTupleCall(
-	user User,
-) *TestExternalTupleCall {
-	return &TestExternalTupleCall{
-		User: user,
+// DecodeHexWithSelector decodes testNestedStruct arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestNestedStructCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedStructCall: %w", err)
 	}
+	return t.DecodeWithSelector(data)
 }
 
-const TestExternalTupleReturnStaticSize = 32
+// NewTestNestedStructCall constructs a new TestNestedStructCall
+func NewTestNestedStructCall(
+	group Group,
+) *TestNestedStructCall {
+	return &TestNestedStructCall{
+		Group: group,
+	}
+}
 
-var _ abi.Tuple = (*TestExternalTupleReturn)(nil)
-var _ abi.PackedTuple = (*TestExternalTupleReturn)(nil)
+const TestNestedStructReturnStaticSize = 32
 
-// TestExternalTupleReturn represents an ABI tuple
-type TestExternalTupleReturn struct {
+// TestNestedStructReturn represents an ABI tuple
+type TestNestedStructReturn struct {
 	Field1 bool
 }
 
-// EncodedSize returns the total encoded size of TestExternalTupleReturn
-func (t TestExternalTupleReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestNestedStructReturn
+func (t TestNestedStructReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestExternalTupleReturnStaticSize + dynamicSize
+	return TestNestedStructReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestExternalTupleReturn to ABI bytes in the provided buffer
-func (value TestExternalTupleReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNestedStructReturn to ABI bytes in the provided buffer
+func (value TestNestedStructReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestExternalTupleReturnStaticSize // Start dynamic data after static section
+	dynamicOffset := TestNestedStructReturnStaticSize // Start dynamic data after static section
 	// Field Field1: bool
 	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
@@ -2184,8 +10075,8 @@ func (value TestExternalTupleReturn) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestExternalTupleReturn to ABI bytes
-func (value TestExternalTupleReturn) Encode() ([]byte, error) {
+// Encode encodes TestNestedStructReturn to ABI bytes
+func (value TestNestedStructReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -2193,8 +10084,8 @@ func (value TestExternalTupleReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestExternalTupleReturn from ABI bytes in the provided buffer
-func (t *TestExternalTupleReturn) Decode(data []byte) (int, error) {
+// Decode decodes TestNestedStructReturn from ABI bytes in the provided buffer
+func (t *TestNestedStructReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -2210,13 +10101,61 @@ func (t *TestExternalTupleReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestExternalTupleReturn
-func (t TestExternalTupleReturn) PackedEncodedSize() int {
+// DecodeStrict decodes TestNestedStructReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNestedStructReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestNestedStructReturn from the hex string with an optional 0x prefix
+func (t *TestNestedStructReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNestedStructReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNestedStructReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNestedStructReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestNestedStructReturn to the zero value, to reuse it from a pool
+func (t *TestNestedStructReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestNestedStructReturn
+func (t TestNestedStructReturn) Clone() TestNestedStructReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestNestedStructReturn
+func (t TestNestedStructReturn) PackedEncodedSize() int {
 	return 1
 }
 
-// PackedEncodeTo encodes TestExternalTupleReturn to packed ABI bytes in the provided buffer
-func (value TestExternalTupleReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes TestNestedStructReturn to packed ABI bytes in the provided buffer
+func (value TestNestedStructReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
@@ -2233,8 +10172,8 @@ func (value TestExternalTupleReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestExternalTupleReturn to packed ABI bytes
-func (value TestExternalTupleReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestNestedStructReturn to packed ABI bytes
+func (value TestNestedStructReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -2242,8 +10181,8 @@ func (value TestExternalTupleReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestExternalTupleReturn from packed ABI bytes
-func (t *TestExternalTupleReturn) PackedDecode(data []byte) (int, error) {
+// PackedDecode decodes TestNestedStructReturn from packed ABI bytes
+func (t *TestNestedStructReturn) PackedDecode(data []byte) (int, error) {
 	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -2256,51 +10195,132 @@ func (t *TestExternalTupleReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
-var _ abi.Method = (*TestFixedArraysCall)(nil)
+var _ abi.Tuple = (*TestNestedStructReturn)(nil)
+var _ abi.Decoder = (*TestNestedStructReturn)(nil)
+var _ abi.PackedTuple = (*TestNestedStructReturn)(nil)
 
-const TestFixedArraysCallStaticSize = 320
+// RandomTestNestedStructReturn returns a TestNestedStructReturn filled with random values, for property based tests
+func RandomTestNestedStructReturn(r *rand.Rand, maxDepth, maxLen int) TestNestedStructReturn {
+	var t TestNestedStructReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
 
-var _ abi.Tuple = (*TestFixedArraysCall)(nil)
-var _ abi.PackedTuple = (*TestFixedArraysCall)(nil)
+// DecodeTestNestedStructReturn decodes the return data of testNestedStruct into its values
+func DecodeTestNestedStructReturn(data []byte) (r1 bool, err error) {
+	var result TestNestedStructReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-// TestFixedArraysCall represents an ABI tuple
-type TestFixedArraysCall struct {
-	Addresses [5]common.Address
-	Uints     [3]*uint256.Int
-	Bytes32s  [2][32]byte
+// DecodeTestNestedStruct decodes the single return value of testNestedStruct
+func DecodeTestNestedStruct(data []byte) (bool, error) {
+	return DecodeTestNestedStructReturn(data)
 }
 
-// EncodedSize returns the total encoded size of TestFixedArraysCall
-func (t TestFixedArraysCall) EncodedSize() int {
+// DecodeTestNestedStructHex decodes the single return value of testNestedStruct from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestNestedStructHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestNestedStructReturn: %w", err)
+	}
+	return DecodeTestNestedStructReturn(data)
+}
+
+// EncodeTestNestedStructResult encodes the single return value of testNestedStruct, e.g. for the return data of precompiles
+func EncodeTestNestedStructResult(v bool) ([]byte, error) {
+	result := TestNestedStructReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestNonStandardIntegersCall)(nil)
+
+const TestNonStandardIntegersCallStaticSize = 320
+
+// TestNonStandardIntegersCall represents an ABI tuple
+type TestNonStandardIntegersCall struct {
+	U24  uint32
+	U48  uint64
+	U72  *uint256.Int
+	U96  *uint256.Int
+	U120 *uint256.Int
+	I24  int32
+	I48  int64
+	I72  *big.Int
+	I96  *big.Int
+	I120 *big.Int
+}
+
+// EncodedSize returns the total encoded size of TestNonStandardIntegersCall
+func (t TestNonStandardIntegersCall) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestFixedArraysCallStaticSize + dynamicSize
+	return TestNonStandardIntegersCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestFixedArraysCall to ABI bytes in the provided buffer
-func (value TestFixedArraysCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNonStandardIntegersCall to ABI bytes in the provided buffer
+func (value TestNonStandardIntegersCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestFixedArraysCallStaticSize // Start dynamic data after static section
-	// Field Addresses: address[5]
-	if _, err := EncodeAddressArray5(value.Addresses, buf[0:]); err != nil {
+	dynamicOffset := TestNonStandardIntegersCallStaticSize // Start dynamic data after static section
+	// Field U24: uint24
+	if _, err := abi.EncodeUint24(value.U24, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field Uints: uint256[3]
-	if _, err := EncodeUint256Array3(value.Uints, buf[160:]); err != nil {
+	// Field U48: uint48
+	if _, err := abi.EncodeUint48(value.U48, buf[32:]); err != nil {
 		return 0, err
 	}
 
-	// Field Bytes32s: bytes32[2]
-	if _, err := EncodeBytes32Array2(value.Bytes32s, buf[256:]); err != nil {
+	// Field U72: uint72
+	if _, err := abi.EncodeUint72U256(value.U72, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	// Field U96: uint96
+	if _, err := abi.EncodeUint96U256(value.U96, buf[96:]); err != nil {
+		return 0, err
+	}
+
+	// Field U120: uint120
+	if _, err := abi.EncodeUint120U256(value.U120, buf[128:]); err != nil {
+		return 0, err
+	}
+
+	// Field I24: int24
+	if _, err := abi.EncodeInt24(value.I24, buf[160:]); err != nil {
+		return 0, err
+	}
+
+	// Field I48: int48
+	if _, err := abi.EncodeInt48(value.I48, buf[192:]); err != nil {
+		return 0, err
+	}
+
+	// Field I72: int72
+	if _, err := abi.EncodeInt72(value.I72, buf[224:]); err != nil {
+		return 0, err
+	}
+
+	// Field I96: int96
+	if _, err := abi.EncodeInt96(value.I96, buf[256:]); err != nil {
+		return 0, err
+	}
+
+	// Field I120: int120
+	if _, err := abi.EncodeInt120(value.I120, buf[288:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestFixedArraysCall to ABI bytes
-func (value TestFixedArraysCall) Encode() ([]byte, error) {
+// Encode encodes TestNonStandardIntegersCall to ABI bytes
+func (value TestNonStandardIntegersCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -2308,8 +10328,8 @@ func (value TestFixedArraysCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestFixedArraysCall from ABI bytes in the provided buffer
-func (t *TestFixedArraysCall) Decode(data []byte) (int, error) {
+// Decode decodes TestNonStandardIntegersCall from ABI bytes in the provided buffer
+func (t *TestNonStandardIntegersCall) Decode(data []byte) (int, error) {
 	if len(data) < 320 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -2317,341 +10337,256 @@ func (t *TestFixedArraysCall) Decode(data []byte) (int, error) {
 		err error
 	)
 	dynamicOffset := 320
-	// Decode static field Addresses: address[5]
-	t.Addresses, _, err = DecodeAddressArray5(data[0:])
+	// Decode static field U24: uint24
+	t.U24, _, err = abi.DecodeUint24(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U48: uint48
+	t.U48, _, err = abi.DecodeUint48(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U72: uint72
+	t.U72, _, err = abi.DecodeIntoUint72U256(t.U72, data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U96: uint96
+	t.U96, _, err = abi.DecodeIntoUint96U256(t.U96, data[96:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U120: uint120
+	t.U120, _, err = abi.DecodeIntoUint120U256(t.U120, data[128:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I24: int24
+	t.I24, _, err = abi.DecodeInt24(data[160:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I48: int48
+	t.I48, _, err = abi.DecodeInt48(data[192:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I72: int72
+	t.I72, _, err = abi.DecodeIntoInt72(t.I72, data[224:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I96: int96
+	t.I96, _, err = abi.DecodeIntoInt96(t.I96, data[256:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I120: int120
+	t.I120, _, err = abi.DecodeIntoInt120(t.I120, data[288:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestNonStandardIntegersCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNonStandardIntegersCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestNonStandardIntegersCall from the hex string with an optional 0x prefix
+func (t *TestNonStandardIntegersCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNonStandardIntegersCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNonStandardIntegersCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNonStandardIntegersCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field U24: uint24
+	t.U24, _, err = abi.DecodeUint24(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Uints: uint256[3]
-	t.Uints, _, err = DecodeUint256Array3(data[160:])
+	// Decode static field U48: uint48
+	t.U48, _, err = abi.DecodeUint48(data[32:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Bytes32s: bytes32[2]
-	t.Bytes32s, _, err = DecodeBytes32Array2(data[256:])
+	// Decode static field U72: uint72
+	t.U72, _, err = abi.DecodeIntoUint72U256(t.U72, data[64:])
 	if err != nil {
 		return 0, err
 	}
-	return dynamicOffset, nil
-}
-
-// PackedEncodedSize returns the packed encoded size of TestFixedArraysCall
-func (t TestFixedArraysCall) PackedEncodedSize() int {
-	return 260
-}
-
-// PackedEncodeTo encodes TestFixedArraysCall to packed ABI bytes in the provided buffer
-func (value TestFixedArraysCall) PackedEncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields sequentially (packed, no dynamic section)
-	var (
-		offset int
-		n      int
-		err    error
-	)
-	// Field Addresses: address[5]
-	n, err = PackedEncodeAddressArray5(value.Addresses, buf[offset:])
+	// Decode static field U96: uint96
+	t.U96, _, err = abi.DecodeIntoUint96U256(t.U96, data[96:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
-
-	// Field Uints: uint256[3]
-	n, err = PackedEncodeUint256Array3(value.Uints, buf[offset:])
+	// Decode static field U120: uint120
+	t.U120, _, err = abi.DecodeIntoUint120U256(t.U120, data[128:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
-
-	// Field Bytes32s: bytes32[2]
-	n, err = PackedEncodeBytes32Array2(value.Bytes32s, buf[offset:])
+	// Decode static field I24: int24
+	t.I24, _, err = abi.DecodeInt24(data[160:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
-
-	return offset, nil
-}
-
-// PackedEncode encodes TestFixedArraysCall to packed ABI bytes
-func (value TestFixedArraysCall) PackedEncode() ([]byte, error) {
-	buf := make([]byte, value.PackedEncodedSize())
-	if _, err := value.PackedEncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// PackedDecode decodes TestFixedArraysCall from packed ABI bytes
-func (t *TestFixedArraysCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 260 {
-		return 0, io.ErrUnexpectedEOF
+	// Decode static field I48: int48
+	t.I48, _, err = abi.DecodeInt48(data[192:])
+	if err != nil {
+		return 0, err
 	}
-	var err error
-	// Decode field Addresses: address[5]
-	t.Addresses, _, err = PackedDecodeAddressArray5(data[0:])
+	// Decode static field I72: int72
+	t.I72, _, err = abi.DecodeIntoInt72(t.I72, data[224:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field Uints: uint256[3]
-	t.Uints, _, err = PackedDecodeUint256Array3(data[100:])
+	// Decode static field I96: int96
+	t.I96, _, err = abi.DecodeIntoInt96(t.I96, data[256:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field Bytes32s: bytes32[2]
-	t.Bytes32s, _, err = PackedDecodeBytes32Array2(data[196:])
+	// Decode static field I120: int120
+	t.I120, _, err = abi.DecodeIntoInt120(t.I120, data[288:])
 	if err != nil {
 		return 0, err
 	}
-	return 260, nil
-}
-
-// GetMethodName returns the function name
-func (t TestFixedArraysCall) GetMethodName() string {
-	return "testFixedArrays"
-}
-
-// GetMethodID returns the function id
-func (t TestFixedArraysCall) GetMethodID() uint32 {
-	return TestFixedArraysID
+	return dynamicOffset, nil
 }
 
-// GetMethodSelector returns the function selector
-func (t TestFixedArraysCall) GetMethodSelector() [4]byte {
-	return TestFixedArraysSelector
+// Reset sets every field of TestNonStandardIntegersCall to the zero value, to reuse it from a pool
+func (t *TestNonStandardIntegersCall) Reset() {
+	t.U24 = 0
+	t.U48 = 0
+	t.U72 = nil
+	t.U96 = nil
+	t.U120 = nil
+	t.I24 = 0
+	t.I48 = 0
+	t.I72 = nil
+	t.I96 = nil
+	t.I120 = nil
 }
 
-// EncodeWithSelector encodes testFixedArrays arguments to ABI bytes including function selector
-func (t TestFixedArraysCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestFixedArraysSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
+// Clone returns a deep copy of TestNonStandardIntegersCall
+func (t TestNonStandardIntegersCall) Clone() TestNonStandardIntegersCall {
+	c := t
+	if t.U72 != nil {
+		c.U72 = new(uint256.Int).Set(t.U72)
 	}
-	return result, nil
-}
-
-// NewTestFixedArraysCall constructs a new TestFixedArraysCall
-func NewTestFixedArraysCall(
-	addresses [5]common.Address,
-	uints [3]*uint256.Int,
-	bytes32s [2][32]byte,
-) *TestFixedArraysCall {
-	return &TestFixedArraysCall{
-		Addresses: addresses,
-		Uints:     uints,
-		Bytes32s:  bytes32s,
+	if t.U96 != nil {
+		c.U96 = new(uint256.Int).Set(t.U96)
 	}
-}
-
-const TestFixedArraysReturnStaticSize = 32
-
-var _ abi.Tuple = (*TestFixedArraysReturn)(nil)
-var _ abi.PackedTuple = (*TestFixedArraysReturn)(nil)
-
-// TestFixedArraysReturn represents an ABI tuple
-type TestFixedArraysReturn struct {
-	Field1 bool
-}
-
-// EncodedSize returns the total encoded size of TestFixedArraysReturn
-func (t TestFixedArraysReturn) EncodedSize() int {
-	dynamicSize := 0
-
-	return TestFixedArraysReturnStaticSize + dynamicSize
-}
-
-// EncodeTo encodes TestFixedArraysReturn to ABI bytes in the provided buffer
-func (value TestFixedArraysReturn) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestFixedArraysReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
-		return 0, err
+	if t.U120 != nil {
+		c.U120 = new(uint256.Int).Set(t.U120)
 	}
-
-	return dynamicOffset, nil
-}
-
-// Encode encodes TestFixedArraysReturn to ABI bytes
-func (value TestFixedArraysReturn) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+	if t.I72 != nil {
+		c.I72 = new(big.Int).Set(t.I72)
 	}
-	return buf, nil
-}
-
-// Decode decodes TestFixedArraysReturn from ABI bytes in the provided buffer
-func (t *TestFixedArraysReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
-		return 0, io.ErrUnexpectedEOF
+	if t.I96 != nil {
+		c.I96 = new(big.Int).Set(t.I96)
 	}
-	var (
-		err error
-	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
-	if err != nil {
-		return 0, err
+	if t.I120 != nil {
+		c.I120 = new(big.Int).Set(t.I120)
 	}
-	return dynamicOffset, nil
+	return c
 }
 
-// PackedEncodedSize returns the packed encoded size of TestFixedArraysReturn
-func (t TestFixedArraysReturn) PackedEncodedSize() int {
-	return 1
+// PackedEncodedSize returns the packed encoded size of TestNonStandardIntegersCall
+func (t TestNonStandardIntegersCall) PackedEncodedSize() int {
+	return 90
 }
 
-// PackedEncodeTo encodes TestFixedArraysReturn to packed ABI bytes in the provided buffer
-func (value TestFixedArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes TestNonStandardIntegersCall to packed ABI bytes in the provided buffer
+func (value TestNonStandardIntegersCall) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field U24: uint24
+	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	return offset, nil
-}
-
-// PackedEncode encodes TestFixedArraysReturn to packed ABI bytes
-func (value TestFixedArraysReturn) PackedEncode() ([]byte, error) {
-	buf := make([]byte, value.PackedEncodedSize())
-	if _, err := value.PackedEncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// PackedDecode decodes TestFixedArraysReturn from packed ABI bytes
-func (t *TestFixedArraysReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	// Field U48: uint48
+	n, err = abi.PackedEncodeUint48(value.U48, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
-}
-
-var _ abi.Method = (*TestFixedBytesCall)(nil)
-
-const TestFixedBytesCallStaticSize = 96
-
-var _ abi.Tuple = (*TestFixedBytesCall)(nil)
-var _ abi.PackedTuple = (*TestFixedBytesCall)(nil)
-
-// TestFixedBytesCall represents an ABI tuple
-type TestFixedBytesCall struct {
-	Data3  [3]byte
-	Data7  [7]byte
-	Data15 [15]byte
-}
-
-// EncodedSize returns the total encoded size of TestFixedBytesCall
-func (t TestFixedBytesCall) EncodedSize() int {
-	dynamicSize := 0
-
-	return TestFixedBytesCallStaticSize + dynamicSize
-}
-
-// EncodeTo encodes TestFixedBytesCall to ABI bytes in the provided buffer
-func (value TestFixedBytesCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestFixedBytesCallStaticSize // Start dynamic data after static section
-	// Field Data3: bytes3
-	if _, err := abi.EncodeBytes3(value.Data3, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Data7: bytes7
-	if _, err := abi.EncodeBytes7(value.Data7, buf[32:]); err != nil {
-		return 0, err
-	}
+	offset += n
 
-	// Field Data15: bytes15
-	if _, err := abi.EncodeBytes15(value.Data15, buf[64:]); err != nil {
+	// Field U72: uint72
+	n, err = abi.PackedEncodeUint72U256(value.U72, buf[offset:])
+	if err != nil {
 		return 0, err
 	}
+	offset += n
 
-	return dynamicOffset, nil
-}
-
-// Encode encodes TestFixedBytesCall to ABI bytes
-func (value TestFixedBytesCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
-	}
-	return buf, nil
-}
-
-// Decode decodes TestFixedBytesCall from ABI bytes in the provided buffer
-func (t *TestFixedBytesCall) Decode(data []byte) (int, error) {
-	if len(data) < 96 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var (
-		err error
-	)
-	dynamicOffset := 96
-	// Decode static field Data3: bytes3
-	t.Data3, _, err = abi.DecodeBytes3(data[0:])
+	// Field U96: uint96
+	n, err = abi.PackedEncodeUint96U256(value.U96, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Data7: bytes7
-	t.Data7, _, err = abi.DecodeBytes7(data[32:])
+	offset += n
+
+	// Field U120: uint120
+	n, err = abi.PackedEncodeUint120U256(value.U120, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Data15: bytes15
-	t.Data15, _, err = abi.DecodeBytes15(data[64:])
+	offset += n
+
+	// Field I24: int24
+	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	return dynamicOffset, nil
-}
+	offset += n
 
-// PackedEncodedSize returns the packed encoded size of TestFixedBytesCall
-func (t TestFixedBytesCall) PackedEncodedSize() int {
-	return 25
-}
+	// Field I48: int48
+	n, err = abi.PackedEncodeInt48(value.I48, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
 
-// PackedEncodeTo encodes TestFixedBytesCall to packed ABI bytes in the provided buffer
-func (value TestFixedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields sequentially (packed, no dynamic section)
-	var (
-		offset int
-		n      int
-		err    error
-	)
-	// Field Data3: bytes3
-	n, err = abi.PackedEncodeBytes3(value.Data3, buf[offset:])
+	// Field I72: int72
+	n, err = abi.PackedEncodeInt72(value.I72, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field Data7: bytes7
-	n, err = abi.PackedEncodeBytes7(value.Data7, buf[offset:])
+	// Field I96: int96
+	n, err = abi.PackedEncodeInt96(value.I96, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field Data15: bytes15
-	n, err = abi.PackedEncodeBytes15(value.Data15, buf[offset:])
+	// Field I120: int120
+	n, err = abi.PackedEncodeInt120(value.I120, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -2660,8 +10595,8 @@ func (value TestFixedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestFixedBytesCall to packed ABI bytes
-func (value TestFixedBytesCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestNonStandardIntegersCall to packed ABI bytes
+func (value TestNonStandardIntegersCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -2669,99 +10604,248 @@ func (value TestFixedBytesCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestFixedBytesCall from packed ABI bytes
-func (t *TestFixedBytesCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 25 {
+// PackedDecode decodes TestNonStandardIntegersCall from packed ABI bytes
+func (t *TestNonStandardIntegersCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 90 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Data3: bytes3
-	t.Data3, _, err = abi.PackedDecodeBytes3(data[0:])
+	// Decode field U24: uint24
+	t.U24, _, err = abi.PackedDecodeUint24(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field Data7: bytes7
-	t.Data7, _, err = abi.PackedDecodeBytes7(data[3:])
+	// Decode field U48: uint48
+	t.U48, _, err = abi.PackedDecodeUint48(data[3:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field Data15: bytes15
-	t.Data15, _, err = abi.PackedDecodeBytes15(data[10:])
+	// Decode field U72: uint72
+	t.U72, _, err = abi.PackedDecodeUint72U256(data[9:])
 	if err != nil {
 		return 0, err
 	}
-	return 25, nil
+	// Decode field U96: uint96
+	t.U96, _, err = abi.PackedDecodeUint96U256(data[18:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field U120: uint120
+	t.U120, _, err = abi.PackedDecodeUint120U256(data[30:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I24: int24
+	t.I24, _, err = abi.PackedDecodeInt24(data[45:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I48: int48
+	t.I48, _, err = abi.PackedDecodeInt48(data[48:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I72: int72
+	t.I72, _, err = abi.PackedDecodeInt72(data[54:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I96: int96
+	t.I96, _, err = abi.PackedDecodeInt96(data[63:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I120: int120
+	t.I120, _, err = abi.PackedDecodeInt120(data[75:])
+	if err != nil {
+		return 0, err
+	}
+	return 90, nil
+}
+
+var _ abi.Tuple = (*TestNonStandardIntegersCall)(nil)
+var _ abi.Decoder = (*TestNonStandardIntegersCall)(nil)
+var _ abi.PackedTuple = (*TestNonStandardIntegersCall)(nil)
+
+// RandomTestNonStandardIntegersCall returns a TestNonStandardIntegersCall filled with random values, for property based tests
+func RandomTestNonStandardIntegersCall(r *rand.Rand, maxDepth, maxLen int) TestNonStandardIntegersCall {
+	var t TestNonStandardIntegersCall
+	t.U24 = uint32(r.Uint64() >> 40)
+	t.U48 = uint64(r.Uint64() >> 16)
+	t.U72 = abi.RandomUint256(r, 72)
+	t.U96 = abi.RandomUint256(r, 96)
+	t.U120 = abi.RandomUint256(r, 120)
+	t.I24 = int32(int64(r.Uint64()) >> 40)
+	t.I48 = int64(int64(r.Uint64()) >> 16)
+	t.I72 = abi.RandomBigInt(r, 72, true)
+	t.I96 = abi.RandomBigInt(r, 96, true)
+	t.I120 = abi.RandomBigInt(r, 120, true)
+	return t
 }
 
 // GetMethodName returns the function name
-func (t TestFixedBytesCall) GetMethodName() string {
-	return "testFixedBytes"
+func (t TestNonStandardIntegersCall) GetMethodName() string {
+	return "testNonStandardIntegers"
 }
 
 // GetMethodID returns the function id
-func (t TestFixedBytesCall) GetMethodID() uint32 {
-	return TestFixedBytesID
+func (t TestNonStandardIntegersCall) GetMethodID() uint32 {
+	return TestNonStandardIntegersID
 }
 
 // GetMethodSelector returns the function selector
-func (t TestFixedBytesCall) GetMethodSelector() [4]byte {
-	return TestFixedBytesSelector
+func (t TestNonStandardIntegersCall) GetMethodSelector() [4]byte {
+	return TestNonStandardIntegersSelector
 }
 
-// EncodeWithSelector encodes testFixedBytes arguments to ABI bytes including function selector
-func (t TestFixedBytesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestFixedBytesSelector[:])
+// EncodedSizeWithSelector returns the encoded size of testNonStandardIntegers arguments including function selector
+func (t TestNonStandardIntegersCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testNonStandardIntegers arguments to ABI bytes including function selector
+func (t TestNonStandardIntegersCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestNonStandardIntegersSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewTestFixedBytesCall constructs a new TestFixedBytesCall
-func NewTestFixedBytesCall(
-	data3 [3]byte,
-	data7 [7]byte,
-	data15 [15]byte,
-) *TestFixedBytesCall {
-	return &TestFixedBytesCall{
-		Data3:  data3,
-		Data7:  data7,
-		Data15: data15,
+// EncodeHex encodes testNonStandardIntegers arguments to 0x prefixed hex string
+func (t TestNonStandardIntegersCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const TestFixedBytesReturnStaticSize = 32
+// EncodeHexWithSelector encodes testNonStandardIntegers arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestNonStandardIntegersCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*TestFixedBytesReturn)(nil)
-var _ abi.PackedTuple = (*TestFixedBytesReturn)(nil)
+// CalldataCost returns the gas cost of the testNonStandardIntegers calldata, returns 0 if encoding fails
+func (t TestNonStandardIntegersCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-// TestFixedBytesReturn represents an ABI tuple
-type TestFixedBytesReturn struct {
-	Field1 [32]byte
+// DecodeWithSelector decodes testNonStandardIntegers arguments from ABI bytes including function selector
+func (t *TestNonStandardIntegersCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNonStandardIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
 }
 
-// EncodedSize returns the total encoded size of TestFixedBytesReturn
-func (t TestFixedBytesReturn) EncodedSize() int {
+// DecodeHexWithSelector decodes testNonStandardIntegers arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestNonStandardIntegersCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNonStandardIntegersCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes testNonStandardIntegers arguments to packed ABI bytes including function selector
+func (t TestNonStandardIntegersCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestNonStandardIntegersSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes testNonStandardIntegers arguments from packed ABI bytes including function selector
+func (t *TestNonStandardIntegersCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestNonStandardIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewTestNonStandardIntegersCall constructs a new TestNonStandardIntegersCall
+func NewTestNonStandardIntegersCall(
+	u24 uint32,
+	u48 uint64,
+	u72 *uint256.Int,
+	u96 *uint256.Int,
+	u120 *uint256.Int,
+	i24 int32,
+	i48 int64,
+	i72 *big.Int,
+	i96 *big.Int,
+	i120 *big.Int,
+) *TestNonStandardIntegersCall {
+	return &TestNonStandardIntegersCall{
+		U24:  u24,
+		U48:  u48,
+		U72:  u72,
+		U96:  u96,
+		U120: u120,
+		I24:  i24,
+		I48:  i48,
+		I72:  i72,
+		I96:  i96,
+		I120: i120,
+	}
+}
+
+const TestNonStandardIntegersReturnStaticSize = 32
+
+// TestNonStandardIntegersReturn represents an ABI tuple
+type TestNonStandardIntegersReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of TestNonStandardIntegersReturn
+func (t TestNonStandardIntegersReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestFixedBytesReturnStaticSize + dynamicSize
+	return TestNonStandardIntegersReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestFixedBytesReturn to ABI bytes in the provided buffer
-func (value TestFixedBytesReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestNonStandardIntegersReturn to ABI bytes in the provided buffer
+func (value TestNonStandardIntegersReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestFixedBytesReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bytes32
-	if _, err := abi.EncodeBytes32(value.Field1, buf[0:]); err != nil {
+	dynamicOffset := TestNonStandardIntegersReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestFixedBytesReturn to ABI bytes
-func (value TestFixedBytesReturn) Encode() ([]byte, error) {
+// Encode encodes TestNonStandardIntegersReturn to ABI bytes
+func (value TestNonStandardIntegersReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -2769,8 +10853,8 @@ func (value TestFixedBytesReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestFixedBytesReturn from ABI bytes in the provided buffer
-func (t *TestFixedBytesReturn) Decode(data []byte) (int, error) {
+// Decode decodes TestNonStandardIntegersReturn from ABI bytes in the provided buffer
+func (t *TestNonStandardIntegersReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -2778,29 +10862,77 @@ func (t *TestFixedBytesReturn) Decode(data []byte) (int, error) {
 		err error
 	)
 	dynamicOffset := 32
-	// Decode static field Field1: bytes32
-	t.Field1, _, err = abi.DecodeBytes32(data[0:])
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestNonStandardIntegersReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestNonStandardIntegersReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestNonStandardIntegersReturn from the hex string with an optional 0x prefix
+func (t *TestNonStandardIntegersReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestNonStandardIntegersReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestNonStandardIntegersReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestNonStandardIntegersReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestFixedBytesReturn
-func (t TestFixedBytesReturn) PackedEncodedSize() int {
-	return 32
+// Reset sets every field of TestNonStandardIntegersReturn to the zero value, to reuse it from a pool
+func (t *TestNonStandardIntegersReturn) Reset() {
+	t.Field1 = false
 }
 
-// PackedEncodeTo encodes TestFixedBytesReturn to packed ABI bytes in the provided buffer
-func (value TestFixedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
+// Clone returns a deep copy of TestNonStandardIntegersReturn
+func (t TestNonStandardIntegersReturn) Clone() TestNonStandardIntegersReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestNonStandardIntegersReturn
+func (t TestNonStandardIntegersReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes TestNonStandardIntegersReturn to packed ABI bytes in the provided buffer
+func (value TestNonStandardIntegersReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bytes32
-	n, err = abi.PackedEncodeBytes32(value.Field1, buf[offset:])
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -2809,8 +10941,8 @@ func (value TestFixedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestFixedBytesReturn to packed ABI bytes
-func (value TestFixedBytesReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestNonStandardIntegersReturn to packed ABI bytes
+func (value TestNonStandardIntegersReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -2818,92 +10950,146 @@ func (value TestFixedBytesReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestFixedBytesReturn from packed ABI bytes
-func (t *TestFixedBytesReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 32 {
+// PackedDecode decodes TestNonStandardIntegersReturn from packed ABI bytes
+func (t *TestNonStandardIntegersReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Field1: bytes32
-	t.Field1, _, err = abi.PackedDecodeBytes32(data[0:])
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	return 32, nil
+	return 1, nil
 }
 
-var _ abi.Method = (*TestMixedTypesCall)(nil)
+var _ abi.Tuple = (*TestNonStandardIntegersReturn)(nil)
+var _ abi.Decoder = (*TestNonStandardIntegersReturn)(nil)
+var _ abi.PackedTuple = (*TestNonStandardIntegersReturn)(nil)
 
-const TestMixedTypesCallStaticSize = 160
+// RandomTestNonStandardIntegersReturn returns a TestNonStandardIntegersReturn filled with random values, for property based tests
+func RandomTestNonStandardIntegersReturn(r *rand.Rand, maxDepth, maxLen int) TestNonStandardIntegersReturn {
+	var t TestNonStandardIntegersReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
 
-var _ abi.Tuple = (*TestMixedTypesCall)(nil)
+// DecodeTestNonStandardIntegersReturn decodes the return data of testNonStandardIntegers into its values
+func DecodeTestNonStandardIntegersReturn(data []byte) (r1 bool, err error) {
+	var result TestNonStandardIntegersReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-// TestMixedTypesCall represents an ABI tuple
-type TestMixedTypesCall struct {
-	FixedData   [32]byte
-	DynamicData []byte
-	Flag        bool
-	Count       uint8
-	Items       []Item
+// DecodeTestNonStandardIntegers decodes the single return value of testNonStandardIntegers
+func DecodeTestNonStandardIntegers(data []byte) (bool, error) {
+	return DecodeTestNonStandardIntegersReturn(data)
 }
 
-// EncodedSize returns the total encoded size of TestMixedTypesCall
-func (t TestMixedTypesCall) EncodedSize() int {
+// DecodeTestNonStandardIntegersHex decodes the single return value of testNonStandardIntegers from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestNonStandardIntegersHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestNonStandardIntegersReturn: %w", err)
+	}
+	return DecodeTestNonStandardIntegersReturn(data)
+}
+
+// EncodeTestNonStandardIntegersResult encodes the single return value of testNonStandardIntegers, e.g. for the return data of precompiles
+func EncodeTestNonStandardIntegersResult(v bool) ([]byte, error) {
+	result := TestNonStandardIntegersReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestSmallIntegersCall)(nil)
+
+const TestSmallIntegersCallStaticSize = 320
+
+// TestSmallIntegersCall represents an ABI tuple
+type TestSmallIntegersCall struct {
+	U8  uint8
+	U16 uint16
+	U24 uint32
+	U32 uint32
+	U64 uint64
+	I8  int8
+	I16 int16
+	I24 int32
+	I32 int32
+	I64 int64
+}
+
+// EncodedSize returns the total encoded size of TestSmallIntegersCall
+func (t TestSmallIntegersCall) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += abi.SizeBytes(t.DynamicData)
-	dynamicSize += SizeItemSlice(t.Items)
 
-	return TestMixedTypesCallStaticSize + dynamicSize
+	return TestSmallIntegersCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestMixedTypesCall to ABI bytes in the provided buffer
-func (value TestMixedTypesCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestSmallIntegersCall to ABI bytes in the provided buffer
+func (value TestSmallIntegersCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestMixedTypesCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field FixedData: bytes32
-	if _, err := abi.EncodeBytes32(value.FixedData, buf[0:]); err != nil {
+	dynamicOffset := TestSmallIntegersCallStaticSize // Start dynamic data after static section
+	// Field U8: uint8
+	if _, err := abi.EncodeUint8(value.U8, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field DynamicData: bytes
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = abi.EncodeBytes(value.DynamicData, buf[dynamicOffset:])
-	if err != nil {
+	// Field U16: uint16
+	if _, err := abi.EncodeUint16(value.U16, buf[32:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
-	// Field Flag: bool
-	if _, err := abi.EncodeBool(value.Flag, buf[64:]); err != nil {
+	// Field U24: uint24
+	if _, err := abi.EncodeUint24(value.U24, buf[64:]); err != nil {
 		return 0, err
 	}
 
-	// Field Count: uint8
-	if _, err := abi.EncodeUint8(value.Count, buf[96:]); err != nil {
+	// Field U32: uint32
+	if _, err := abi.EncodeUint32(value.U32, buf[96:]); err != nil {
 		return 0, err
 	}
 
-	// Field Items: (uint32,bytes,bool)[]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[128+24:128+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeItemSlice(value.Items, buf[dynamicOffset:])
-	if err != nil {
+	// Field U64: uint64
+	if _, err := abi.EncodeUint64(value.U64, buf[128:]); err != nil {
+		return 0, err
+	}
+
+	// Field I8: int8
+	if _, err := abi.EncodeInt8(value.I8, buf[160:]); err != nil {
+		return 0, err
+	}
+
+	// Field I16: int16
+	if _, err := abi.EncodeInt16(value.I16, buf[192:]); err != nil {
+		return 0, err
+	}
+
+	// Field I24: int24
+	if _, err := abi.EncodeInt24(value.I24, buf[224:]); err != nil {
+		return 0, err
+	}
+
+	// Field I32: int32
+	if _, err := abi.EncodeInt32(value.I32, buf[256:]); err != nil {
+		return 0, err
+	}
+
+	// Field I64: int64
+	if _, err := abi.EncodeInt64(value.I64, buf[288:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestMixedTypesCall to ABI bytes
-func (value TestMixedTypesCall) Encode() ([]byte, error) {
+// Encode encodes TestSmallIntegersCall to ABI bytes
+func (value TestSmallIntegersCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -2911,177 +11097,247 @@ func (value TestMixedTypesCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestMixedTypesCall from ABI bytes in the provided buffer
-func (t *TestMixedTypesCall) Decode(data []byte) (int, error) {
-	if len(data) < 160 {
+// Decode decodes TestSmallIntegersCall from ABI bytes in the provided buffer
+func (t *TestSmallIntegersCall) Decode(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field U8: uint8
+	t.U8, _, err = abi.DecodeUint8(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U16: uint16
+	t.U16, _, err = abi.DecodeUint16(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U24: uint24
+	t.U24, _, err = abi.DecodeUint24(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U32: uint32
+	t.U32, _, err = abi.DecodeUint32(data[96:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U64: uint64
+	t.U64, _, err = abi.DecodeUint64(data[128:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I8: int8
+	t.I8, _, err = abi.DecodeInt8(data[160:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I16: int16
+	t.I16, _, err = abi.DecodeInt16(data[192:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I24: int24
+	t.I24, _, err = abi.DecodeInt24(data[224:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I32: int32
+	t.I32, _, err = abi.DecodeInt32(data[256:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I64: int64
+	t.I64, _, err = abi.DecodeInt64(data[288:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestSmallIntegersCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestSmallIntegersCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TestSmallIntegersCall from the hex string with an optional 0x prefix
+func (t *TestSmallIntegersCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestSmallIntegersCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestSmallIntegersCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestSmallIntegersCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 320 {
 		return 0, io.ErrUnexpectedEOF
 	}
-	var (
-		err    error
-		n      int
-		offset int
-	)
-	dynamicOffset := 160
-	// Decode static field FixedData: bytes32
-	t.FixedData, _, err = abi.DecodeBytes32(data[0:])
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field U8: uint8
+	t.U8, _, err = abi.DecodeUint8(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U16: uint16
+	t.U16, _, err = abi.DecodeUint16(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U24: uint24
+	t.U24, _, err = abi.DecodeUint24(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U32: uint32
+	t.U32, _, err = abi.DecodeUint32(data[96:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field U64: uint64
+	t.U64, _, err = abi.DecodeUint64(data[128:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I8: int8
+	t.I8, _, err = abi.DecodeInt8(data[160:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode dynamic field DynamicData
-	{
-		offset, err = abi.DecodeSize(data[32:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.DynamicData, n, err = abi.DecodeBytes(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field I16: int16
+	t.I16, _, err = abi.DecodeInt16(data[192:])
+	if err != nil {
+		return 0, err
 	}
-	// Decode static field Flag: bool
-	t.Flag, _, err = abi.DecodeBool(data[64:])
+	// Decode static field I24: int24
+	t.I24, _, err = abi.DecodeInt24(data[224:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Count: uint8
-	t.Count, _, err = abi.DecodeUint8(data[96:])
+	// Decode static field I32: int32
+	t.I32, _, err = abi.DecodeInt32(data[256:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode dynamic field Items
-	{
-		offset, err = abi.DecodeSize(data[128:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.Items, n, err = DecodeItemSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	// Decode static field I64: int64
+	t.I64, _, err = abi.DecodeInt64(data[288:])
+	if err != nil {
+		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// GetMethodName returns the function name
-func (t TestMixedTypesCall) GetMethodName() string {
-	return "testMixedTypes"
+// Reset sets every field of TestSmallIntegersCall to the zero value, to reuse it from a pool
+func (t *TestSmallIntegersCall) Reset() {
+	t.U8 = 0
+	t.U16 = 0
+	t.U24 = 0
+	t.U32 = 0
+	t.U64 = 0
+	t.I8 = 0
+	t.I16 = 0
+	t.I24 = 0
+	t.I32 = 0
+	t.I64 = 0
 }
 
-// GetMethodID returns the function id
-func (t TestMixedTypesCall) GetMethodID() uint32 {
-	return TestMixedTypesID
+// Clone returns a deep copy of TestSmallIntegersCall
+func (t TestSmallIntegersCall) Clone() TestSmallIntegersCall {
+	c := t
+	return c
 }
 
-// GetMethodSelector returns the function selector
-func (t TestMixedTypesCall) GetMethodSelector() [4]byte {
-	return TestMixedTypesSelector
+// PackedEncodedSize returns the packed encoded size of TestSmallIntegersCall
+func (t TestSmallIntegersCall) PackedEncodedSize() int {
+	return 36
 }
 
-// EncodeWithSelector encodes testMixedTypes arguments to ABI bytes including function selector
-func (t TestMixedTypesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestMixedTypesSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
+// PackedEncodeTo encodes TestSmallIntegersCall to packed ABI bytes in the provided buffer
+func (value TestSmallIntegersCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field U8: uint8
+	n, err = abi.PackedEncodeUint8(value.U8, buf[offset:])
+	if err != nil {
+		return 0, err
 	}
-	return result, nil
-}
+	offset += n
 
-// NewTestMixedTypesCall constructs a new TestMixedTypesCall
-func NewTestMixedTypesCall(
-	fixedData [32]byte,
-	dynamicData []byte,
-	flag bool,
-	count uint8,
-	items []Item,
-) *TestMixedTypesCall {
-	return &TestMixedTypesCall{
-		FixedData:   fixedData,
-		DynamicData: dynamicData,
-		Flag:        flag,
-		Count:       count,
-		Items:       items,
+	// Field U16: uint16
+	n, err = abi.PackedEncodeUint16(value.U16, buf[offset:])
+	if err != nil {
+		return 0, err
 	}
-}
-
-const TestMixedTypesReturnStaticSize = 32
-
-var _ abi.Tuple = (*TestMixedTypesReturn)(nil)
-var _ abi.PackedTuple = (*TestMixedTypesReturn)(nil)
-
-// TestMixedTypesReturn represents an ABI tuple
-type TestMixedTypesReturn struct {
-	Field1 bool
-}
-
-// EncodedSize returns the total encoded size of TestMixedTypesReturn
-func (t TestMixedTypesReturn) EncodedSize() int {
-	dynamicSize := 0
+	offset += n
 
-	return TestMixedTypesReturnStaticSize + dynamicSize
-}
+	// Field U24: uint24
+	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
 
-// EncodeTo encodes TestMixedTypesReturn to ABI bytes in the provided buffer
-func (value TestMixedTypesReturn) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestMixedTypesReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+	// Field U32: uint32
+	n, err = abi.PackedEncodeUint32(value.U32, buf[offset:])
+	if err != nil {
 		return 0, err
 	}
+	offset += n
 
-	return dynamicOffset, nil
-}
+	// Field U64: uint64
+	n, err = abi.PackedEncodeUint64(value.U64, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
 
-// Encode encodes TestMixedTypesReturn to ABI bytes
-func (value TestMixedTypesReturn) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+	// Field I8: int8
+	n, err = abi.PackedEncodeInt8(value.I8, buf[offset:])
+	if err != nil {
+		return 0, err
 	}
-	return buf, nil
-}
+	offset += n
 
-// Decode decodes TestMixedTypesReturn from ABI bytes in the provided buffer
-func (t *TestMixedTypesReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
-		return 0, io.ErrUnexpectedEOF
+	// Field I16: int16
+	n, err = abi.PackedEncodeInt16(value.I16, buf[offset:])
+	if err != nil {
+		return 0, err
 	}
-	var (
-		err error
-	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+	offset += n
+
+	// Field I24: int24
+	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	return dynamicOffset, nil
-}
+	offset += n
 
-// PackedEncodedSize returns the packed encoded size of TestMixedTypesReturn
-func (t TestMixedTypesReturn) PackedEncodedSize() int {
-	return 1
-}
+	// Field I32: int32
+	n, err = abi.PackedEncodeInt32(value.I32, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
 
-// PackedEncodeTo encodes TestMixedTypesReturn to packed ABI bytes in the provided buffer
-func (value TestMixedTypesReturn) PackedEncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields sequentially (packed, no dynamic section)
-	var (
-		offset int
-		n      int
-		err    error
-	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field I64: int64
+	n, err = abi.PackedEncodeInt64(value.I64, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -3090,8 +11346,8 @@ func (value TestMixedTypesReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestMixedTypesReturn to packed ABI bytes
-func (value TestMixedTypesReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestSmallIntegersCall to packed ABI bytes
+func (value TestSmallIntegersCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -3099,211 +11355,238 @@ func (value TestMixedTypesReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestMixedTypesReturn from packed ABI bytes
-func (t *TestMixedTypesReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
+// PackedDecode decodes TestSmallIntegersCall from packed ABI bytes
+func (t *TestSmallIntegersCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 36 {
 		return 0, io.ErrUnexpectedEOF
 	}
-	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	var err error
+	// Decode field U8: uint8
+	t.U8, _, err = abi.PackedDecodeUint8(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field U16: uint16
+	t.U16, _, err = abi.PackedDecodeUint16(data[1:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field U24: uint24
+	t.U24, _, err = abi.PackedDecodeUint24(data[3:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field U32: uint32
+	t.U32, _, err = abi.PackedDecodeUint32(data[6:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field U64: uint64
+	t.U64, _, err = abi.PackedDecodeUint64(data[10:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I8: int8
+	t.I8, _, err = abi.PackedDecodeInt8(data[18:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I16: int16
+	t.I16, _, err = abi.PackedDecodeInt16(data[19:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I24: int24
+	t.I24, _, err = abi.PackedDecodeInt24(data[21:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I32: int32
+	t.I32, _, err = abi.PackedDecodeInt32(data[24:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I64: int64
+	t.I64, _, err = abi.PackedDecodeInt64(data[28:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
+	return 36, nil
 }
 
-var _ abi.Method = (*TestNestedDynamicArraysCall)(nil)
+var _ abi.Tuple = (*TestSmallIntegersCall)(nil)
+var _ abi.Decoder = (*TestSmallIntegersCall)(nil)
+var _ abi.PackedTuple = (*TestSmallIntegersCall)(nil)
 
-const TestNestedDynamicArraysCallStaticSize = 96
+// RandomTestSmallIntegersCall returns a TestSmallIntegersCall filled with random values, for property based tests
+func RandomTestSmallIntegersCall(r *rand.Rand, maxDepth, maxLen int) TestSmallIntegersCall {
+	var t TestSmallIntegersCall
+	t.U8 = uint8(r.Uint64() >> 56)
+	t.U16 = uint16(r.Uint64() >> 48)
+	t.U24 = uint32(r.Uint64() >> 40)
+	t.U32 = uint32(r.Uint64() >> 32)
+	t.U64 = uint64(r.Uint64() >> 0)
+	t.I8 = int8(int64(r.Uint64()) >> 56)
+	t.I16 = int16(int64(r.Uint64()) >> 48)
+	t.I24 = int32(int64(r.Uint64()) >> 40)
+	t.I32 = int32(int64(r.Uint64()) >> 32)
+	t.I64 = int64(int64(r.Uint64()) >> 0)
+	return t
+}
 
-var _ abi.Tuple = (*TestNestedDynamicArraysCall)(nil)
+// GetMethodName returns the function name
+func (t TestSmallIntegersCall) GetMethodName() string {
+	return "testSmallIntegers"
+}
 
-// TestNestedDynamicArraysCall represents an ABI tuple
-type TestNestedDynamicArraysCall struct {
-	Matrix        [][]*uint256.Int
-	AddressMatrix [][3][]common.Address
-	DymMatrix     [][]string
+// GetMethodID returns the function id
+func (t TestSmallIntegersCall) GetMethodID() uint32 {
+	return TestSmallIntegersID
 }
 
-// EncodedSize returns the total encoded size of TestNestedDynamicArraysCall
-func (t TestNestedDynamicArraysCall) EncodedSize() int {
-	dynamicSize := 0
-	dynamicSize += SizeUint256SliceSlice(t.Matrix)
-	dynamicSize += SizeAddressSliceArray3Slice(t.AddressMatrix)
-	dynamicSize += SizeStringSliceSlice(t.DymMatrix)
+// GetMethodSelector returns the function selector
+func (t TestSmallIntegersCall) GetMethodSelector() [4]byte {
+	return TestSmallIntegersSelector
+}
 
-	return TestNestedDynamicArraysCallStaticSize + dynamicSize
+// EncodedSizeWithSelector returns the encoded size of testSmallIntegers arguments including function selector
+func (t TestSmallIntegersCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
 }
 
-// EncodeTo encodes TestNestedDynamicArraysCall to ABI bytes in the provided buffer
-func (value TestNestedDynamicArraysCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestNestedDynamicArraysCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Matrix: uint256[][]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeUint256SliceSlice(value.Matrix, buf[dynamicOffset:])
-	if err != nil {
-		return 0, err
+// EncodeWithSelector encodes testSmallIntegers arguments to ABI bytes including function selector
+func (t TestSmallIntegersCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestSmallIntegersSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
 	}
-	dynamicOffset += n
+	return result, nil
+}
 
-	// Field AddressMatrix: address[][3][]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeAddressSliceArray3Slice(value.AddressMatrix, buf[dynamicOffset:])
+// EncodeHex encodes testSmallIntegers arguments to 0x prefixed hex string
+func (t TestSmallIntegersCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
 	if err != nil {
-		return 0, err
+		return "", err
 	}
-	dynamicOffset += n
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-	// Field DymMatrix: string[][]
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = EncodeStringSliceSlice(value.DymMatrix, buf[dynamicOffset:])
+// EncodeHexWithSelector encodes testSmallIntegers arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestSmallIntegersCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
 	if err != nil {
-		return 0, err
+		return "", err
 	}
-	dynamicOffset += n
-
-	return dynamicOffset, nil
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-// Encode encodes TestNestedDynamicArraysCall to ABI bytes
-func (value TestNestedDynamicArraysCall) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+// CalldataCost returns the gas cost of the testSmallIntegers calldata, returns 0 if encoding fails
+func (t TestSmallIntegersCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
 	}
-	return buf, nil
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
 }
 
-// Decode decodes TestNestedDynamicArraysCall from ABI bytes in the provided buffer
-func (t *TestNestedDynamicArraysCall) Decode(data []byte) (int, error) {
-	if len(data) < 96 {
+// DecodeWithSelector decodes testSmallIntegers arguments from ABI bytes including function selector
+func (t *TestSmallIntegersCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
 		return 0, io.ErrUnexpectedEOF
 	}
-	var (
-		err    error
-		n      int
-		offset int
-	)
-	dynamicOffset := 96
-	// Decode dynamic field Matrix
-	{
-		offset, err = abi.DecodeSize(data[0:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.Matrix, n, err = DecodeUint256SliceSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
-	}
-	// Decode dynamic field AddressMatrix
-	{
-		offset, err = abi.DecodeSize(data[32:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.AddressMatrix, n, err = DecodeAddressSliceArray3Slice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	if [4]byte(data[:4]) != TestSmallIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
 	}
-	// Decode dynamic field DymMatrix
-	{
-		offset, err = abi.DecodeSize(data[64:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		t.DymMatrix, n, err = DecodeStringSliceSlice(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
 	}
-	return dynamicOffset, nil
-}
-
-// GetMethodName returns the function name
-func (t TestNestedDynamicArraysCall) GetMethodName() string {
-	return "testNestedDynamicArrays"
-}
-
-// GetMethodID returns the function id
-func (t TestNestedDynamicArraysCall) GetMethodID() uint32 {
-	return TestNestedDynamicArraysID
+	return 4 + n, nil
 }
 
-// GetMethodSelector returns the function selector
-func (t TestNestedDynamicArraysCall) GetMethodSelector() [4]byte {
-	return TestNestedDynamicArraysSelector
+// DecodeHexWithSelector decodes testSmallIntegers arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestSmallIntegersCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestSmallIntegersCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
 }
 
-// EncodeWithSelector encodes testNestedDynamicArrays arguments to ABI bytes including function selector
-func (t TestNestedDynamicArraysCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestNestedDynamicArraysSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
+// PackedEncodeWithSelector encodes testSmallIntegers arguments to packed ABI bytes including function selector
+func (t TestSmallIntegersCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestSmallIntegersSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewTestNestedDynamicArraysCall constructs a new TestNestedDynamicArraysCall
-func NewTestNestedDynamicArraysCall(
-	matrix [][]*uint256.Int,
-	addressMatrix [][3][]common.Address,
-	dymMatrix [][]string,
-) *TestNestedDynamicArraysCall {
-	return &TestNestedDynamicArraysCall{
-		Matrix:        matrix,
-		AddressMatrix: addressMatrix,
-		DymMatrix:     dymMatrix,
+// PackedDecodeWithSelector decodes testSmallIntegers arguments from packed ABI bytes including function selector
+func (t *TestSmallIntegersCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestSmallIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
 	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
 }
 
-const TestNestedDynamicArraysReturnStaticSize = 32
+// NewTestSmallIntegersCall constructs a new TestSmallIntegersCall
+func NewTestSmallIntegersCall(
+	u8 uint8,
+	u16 uint16,
+	u24 uint32,
+	u32 uint32,
+	u64 uint64,
+	i8 int8,
+	i16 int16,
+	i24 int32,
+	i32 int32,
+	i64 int64,
+) *TestSmallIntegersCall {
+	return &TestSmallIntegersCall{
+		U8:  u8,
+		U16: u16,
+		U24: u24,
+		U32: u32,
+		U64: u64,
+		I8:  i8,
+		I16: i16,
+		I24: i24,
+		I32: i32,
+		I64: i64,
+	}
+}
 
-var _ abi.Tuple = (*TestNestedDynamicArraysReturn)(nil)
-var _ abi.PackedTuple = (*TestNestedDynamicArraysReturn)(nil)
+const TestSmallIntegersReturnStaticSize = 32
 
-// TestNestedDynamicArraysReturn represents an ABI tuple
-type TestNestedDynamicArraysReturn struct {
+// TestSmallIntegersReturn represents an ABI tuple
+type TestSmallIntegersReturn struct {
 	Field1 bool
 }
 
-// EncodedSize returns the total encoded size of TestNestedDynamicArraysReturn
-func (t TestNestedDynamicArraysReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestSmallIntegersReturn
+func (t TestSmallIntegersReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestNestedDynamicArraysReturnStaticSize + dynamicSize
+	return TestSmallIntegersReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestNestedDynamicArraysReturn to ABI bytes in the provided buffer
-func (value TestNestedDynamicArraysReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestSmallIntegersReturn to ABI bytes in the provided buffer
+func (value TestSmallIntegersReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestNestedDynamicArraysReturnStaticSize // Start dynamic data after static section
+	dynamicOffset := TestSmallIntegersReturnStaticSize // Start dynamic data after static section
 	// Field Field1: bool
 	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
@@ -3312,8 +11595,8 @@ func (value TestNestedDynamicArraysReturn) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestNestedDynamicArraysReturn to ABI bytes
-func (value TestNestedDynamicArraysReturn) Encode() ([]byte, error) {
+// Encode encodes TestSmallIntegersReturn to ABI bytes
+func (value TestSmallIntegersReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -3321,8 +11604,8 @@ func (value TestNestedDynamicArraysReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestNestedDynamicArraysReturn from ABI bytes in the provided buffer
-func (t *TestNestedDynamicArraysReturn) Decode(data []byte) (int, error) {
+// Decode decodes TestSmallIntegersReturn from ABI bytes in the provided buffer
+func (t *TestSmallIntegersReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -3338,13 +11621,61 @@ func (t *TestNestedDynamicArraysReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestNestedDynamicArraysReturn
-func (t TestNestedDynamicArraysReturn) PackedEncodedSize() int {
+// DecodeStrict decodes TestSmallIntegersReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestSmallIntegersReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestSmallIntegersReturn from the hex string with an optional 0x prefix
+func (t *TestSmallIntegersReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestSmallIntegersReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestSmallIntegersReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestSmallIntegersReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestSmallIntegersReturn to the zero value, to reuse it from a pool
+func (t *TestSmallIntegersReturn) Reset() {
+	t.Field1 = false
+}
+
+// Clone returns a deep copy of TestSmallIntegersReturn
+func (t TestSmallIntegersReturn) Clone() TestSmallIntegersReturn {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestSmallIntegersReturn
+func (t TestSmallIntegersReturn) PackedEncodedSize() int {
 	return 1
 }
 
-// PackedEncodeTo encodes TestNestedDynamicArraysReturn to packed ABI bytes in the provided buffer
-func (value TestNestedDynamicArraysReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes TestSmallIntegersReturn to packed ABI bytes in the provided buffer
+func (value TestSmallIntegersReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
@@ -3361,8 +11692,8 @@ func (value TestNestedDynamicArraysReturn) PackedEncodeTo(buf []byte) (int, erro
 	return offset, nil
 }
 
-// PackedEncode encodes TestNestedDynamicArraysReturn to packed ABI bytes
-func (value TestNestedDynamicArraysReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestSmallIntegersReturn to packed ABI bytes
+func (value TestSmallIntegersReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -3370,8 +11701,8 @@ func (value TestNestedDynamicArraysReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestNestedDynamicArraysReturn from packed ABI bytes
-func (t *TestNestedDynamicArraysReturn) PackedDecode(data []byte) (int, error) {
+// PackedDecode decodes TestSmallIntegersReturn from packed ABI bytes
+func (t *TestSmallIntegersReturn) PackedDecode(data []byte) (int, error) {
 	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -3384,48 +11715,78 @@ func (t *TestNestedDynamicArraysReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
-var _ abi.Method = (*TestNestedStructCall)(nil)
+var _ abi.Tuple = (*TestSmallIntegersReturn)(nil)
+var _ abi.Decoder = (*TestSmallIntegersReturn)(nil)
+var _ abi.PackedTuple = (*TestSmallIntegersReturn)(nil)
 
-const TestNestedStructCallStaticSize = 32
+// RandomTestSmallIntegersReturn returns a TestSmallIntegersReturn filled with random values, for property based tests
+func RandomTestSmallIntegersReturn(r *rand.Rand, maxDepth, maxLen int) TestSmallIntegersReturn {
+	var t TestSmallIntegersReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
 
-var _ abi.Tuple = (*TestNestedStructCall)(nil)
+// DecodeTestSmallIntegersReturn decodes the return data of testSmallIntegers into its values
+func DecodeTestSmallIntegersReturn(data []byte) (r1 bool, err error) {
+	var result TestSmallIntegersReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-// TestNestedStructCall represents an ABI tuple
-type TestNestedStructCall struct {
-	Group Group
+// DecodeTestSmallIntegers decodes the single return value of testSmallIntegers
+func DecodeTestSmallIntegers(data []byte) (bool, error) {
+	return DecodeTestSmallIntegersReturn(data)
 }
 
-// EncodedSize returns the total encoded size of TestNestedStructCall
-func (t TestNestedStructCall) EncodedSize() int {
+// DecodeTestSmallIntegersHex decodes the single return value of testSmallIntegers from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestSmallIntegersHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TestSmallIntegersReturn: %w", err)
+	}
+	return DecodeTestSmallIntegersReturn(data)
+}
+
+// EncodeTestSmallIntegersResult encodes the single return value of testSmallIntegers, e.g. for the return data of precompiles
+func EncodeTestSmallIntegersResult(v bool) ([]byte, error) {
+	result := TestSmallIntegersReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestStaticOutputsCall)(nil)
+
+const TestStaticOutputsCallStaticSize = 96
+
+// TestStaticOutputsCall represents an ABI tuple
+type TestStaticOutputsCall struct {
+	Owners [3]common.Address
+}
+
+// EncodedSize returns the total encoded size of TestStaticOutputsCall
+func (t TestStaticOutputsCall) EncodedSize() int {
 	dynamicSize := 0
-	dynamicSize += t.Group.EncodedSize()
 
-	return TestNestedStructCallStaticSize + dynamicSize
+	return TestStaticOutputsCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestNestedStructCall to ABI bytes in the provided buffer
-func (value TestNestedStructCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestStaticOutputsCall to ABI bytes in the provided buffer
+func (value TestStaticOutputsCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestNestedStructCallStaticSize // Start dynamic data after static section
-	var (
-		err error
-		n   int
-	)
-	// Field Group: ((address,string,uint256)[])
-	// Encode offset pointer
-	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
-	// Encode dynamic data
-	n, err = value.Group.EncodeTo(buf[dynamicOffset:])
-	if err != nil {
+	dynamicOffset := TestStaticOutputsCallStaticSize // Start dynamic data after static section
+	// Field Owners: address[3]
+	if _, err := EncodeAddressArray3(value.Owners, buf[0:]); err != nil {
 		return 0, err
 	}
-	dynamicOffset += n
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestNestedStructCall to ABI bytes
-func (value TestNestedStructCall) Encode() ([]byte, error) {
+// Encode encodes TestStaticOutputsCall to ABI bytes
+func (value TestStaticOutputsCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -3433,139 +11794,85 @@ func (value TestNestedStructCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestNestedStructCall from ABI bytes in the provided buffer
-func (t *TestNestedStructCall) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// Decode decodes TestStaticOutputsCall from ABI bytes in the provided buffer
+func (t *TestStaticOutputsCall) Decode(data []byte) (int, error) {
+	if len(data) < 96 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
-		err    error
-		n      int
-		offset int
+		err error
 	)
-	dynamicOffset := 32
-	// Decode dynamic field Group
-	{
-		offset, err = abi.DecodeSize(data[0:])
-		if err != nil {
-			return 0, err
-		}
-		if offset != dynamicOffset {
-			return 0, abi.ErrInvalidOffsetForDynamicField
-		}
-		n, err = t.Group.Decode(data[dynamicOffset:])
-		if err != nil {
-			return 0, err
-		}
-		dynamicOffset += n
+	dynamicOffset := 96
+	// Decode static field Owners: address[3]
+	t.Owners, _, err = DecodeAddressArray3(data[0:])
+	if err != nil {
+		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// GetMethodName returns the function name
-func (t TestNestedStructCall) GetMethodName() string {
-	return "testNestedStruct"
-}
-
-// GetMethodID returns the function id
-func (t TestNestedStructCall) GetMethodID() uint32 {
-	return TestNestedStructID
-}
-
-// GetMethodSelector returns the function selector
-func (t TestNestedStructCall) GetMethodSelector() [4]byte {
-	return TestNestedStructSelector
-}
-
-// EncodeWithSelector encodes testNestedStruct arguments to ABI bytes including function selector
-func (t TestNestedStructCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestNestedStructSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// NewTestNestedStructCall constructs a new TestNestedStructCall
-func NewTestNestedStructCall(
-	group Group,
-) *TestNestedStructCall {
-	return &TestNestedStructCall{
-		Group: group,
-	}
-}
-
-const TestNestedStructReturnStaticSize = 32
-
-var _ abi.Tuple = (*TestNestedStructReturn)(nil)
-var _ abi.PackedTuple = (*TestNestedStructReturn)(nil)
-
-// TestNestedStructReturn represents an ABI tuple
-type TestNestedStructReturn struct {
-	Field1 bool
-}
-
-// EncodedSize returns the total encoded size of TestNestedStructReturn
-func (t TestNestedStructReturn) EncodedSize() int {
-	dynamicSize := 0
-
-	return TestNestedStructReturnStaticSize + dynamicSize
-}
-
-// EncodeTo encodes TestNestedStructReturn to ABI bytes in the provided buffer
-func (value TestNestedStructReturn) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestNestedStructReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
-		return 0, err
+// DecodeStrict decodes TestStaticOutputsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticOutputsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
 	}
-
-	return dynamicOffset, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// Encode encodes TestNestedStructReturn to ABI bytes
-func (value TestNestedStructReturn) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+// DecodeHex decodes TestStaticOutputsCall from the hex string with an optional 0x prefix
+func (t *TestStaticOutputsCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticOutputsCall: %w", err)
 	}
-	return buf, nil
+	return t.Decode(data)
 }
 
-// Decode decodes TestNestedStructReturn from ABI bytes in the provided buffer
-func (t *TestNestedStructReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// DecodeInto decodes TestStaticOutputsCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticOutputsCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 96 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+	dynamicOffset := 96
+	// Decode static field Owners: address[3]
+	t.Owners, _, err = DecodeAddressArray3(data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestNestedStructReturn
-func (t TestNestedStructReturn) PackedEncodedSize() int {
-	return 1
+// Reset sets every field of TestStaticOutputsCall to the zero value, to reuse it from a pool
+func (t *TestStaticOutputsCall) Reset() {
+	t.Owners = [3]common.Address{}
 }
 
-// PackedEncodeTo encodes TestNestedStructReturn to packed ABI bytes in the provided buffer
-func (value TestNestedStructReturn) PackedEncodeTo(buf []byte) (int, error) {
+// Clone returns a deep copy of TestStaticOutputsCall
+func (t TestStaticOutputsCall) Clone() TestStaticOutputsCall {
+	c := t
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestStaticOutputsCall
+func (t TestStaticOutputsCall) PackedEncodedSize() int {
+	return 60
+}
+
+// PackedEncodeTo encodes TestStaticOutputsCall to packed ABI bytes in the provided buffer
+func (value TestStaticOutputsCall) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field Owners: address[3]
+	n, err = PackedEncodeAddressArray3(value.Owners, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -3574,8 +11881,8 @@ func (value TestNestedStructReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestNestedStructReturn to packed ABI bytes
-func (value TestNestedStructReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestStaticOutputsCall to packed ABI bytes
+func (value TestStaticOutputsCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -3583,107 +11890,184 @@ func (value TestNestedStructReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestNestedStructReturn from packed ABI bytes
-func (t *TestNestedStructReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
+// PackedDecode decodes TestStaticOutputsCall from packed ABI bytes
+func (t *TestStaticOutputsCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 60 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	// Decode field Owners: address[3]
+	t.Owners, _, err = PackedDecodeAddressArray3(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
+	return 60, nil
 }
 
-var _ abi.Method = (*TestNonStandardIntegersCall)(nil)
+var _ abi.Tuple = (*TestStaticOutputsCall)(nil)
+var _ abi.Decoder = (*TestStaticOutputsCall)(nil)
+var _ abi.PackedTuple = (*TestStaticOutputsCall)(nil)
 
-const TestNonStandardIntegersCallStaticSize = 320
+// RandomTestStaticOutputsCall returns a TestStaticOutputsCall filled with random values, for property based tests
+func RandomTestStaticOutputsCall(r *rand.Rand, maxDepth, maxLen int) TestStaticOutputsCall {
+	var t TestStaticOutputsCall
+	for i0 := range t.Owners {
+		r.Read(t.Owners[i0][:])
+	}
+	return t
+}
 
-var _ abi.Tuple = (*TestNonStandardIntegersCall)(nil)
-var _ abi.PackedTuple = (*TestNonStandardIntegersCall)(nil)
+// GetMethodName returns the function name
+func (t TestStaticOutputsCall) GetMethodName() string {
+	return "testStaticOutputs"
+}
 
-// TestNonStandardIntegersCall represents an ABI tuple
-type TestNonStandardIntegersCall struct {
-	U24  uint32
-	U48  uint64
-	U72  *uint256.Int
-	U96  *uint256.Int
-	U120 *uint256.Int
-	I24  int32
-	I48  int64
-	I72  *big.Int
-	I96  *big.Int
-	I120 *big.Int
+// GetMethodID returns the function id
+func (t TestStaticOutputsCall) GetMethodID() uint32 {
+	return TestStaticOutputsID
 }
 
-// EncodedSize returns the total encoded size of TestNonStandardIntegersCall
-func (t TestNonStandardIntegersCall) EncodedSize() int {
-	dynamicSize := 0
+// GetMethodSelector returns the function selector
+func (t TestStaticOutputsCall) GetMethodSelector() [4]byte {
+	return TestStaticOutputsSelector
+}
 
-	return TestNonStandardIntegersCallStaticSize + dynamicSize
+// EncodedSizeWithSelector returns the encoded size of testStaticOutputs arguments including function selector
+func (t TestStaticOutputsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
 }
 
-// EncodeTo encodes TestNonStandardIntegersCall to ABI bytes in the provided buffer
-func (value TestNonStandardIntegersCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestNonStandardIntegersCallStaticSize // Start dynamic data after static section
-	// Field U24: uint24
-	if _, err := abi.EncodeUint24(value.U24, buf[0:]); err != nil {
-		return 0, err
+// EncodeWithSelector encodes testStaticOutputs arguments to ABI bytes including function selector
+func (t TestStaticOutputsCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestStaticOutputsSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	// Field U48: uint48
-	if _, err := abi.EncodeUint48(value.U48, buf[32:]); err != nil {
-		return 0, err
+// EncodeHex encodes testStaticOutputs arguments to 0x prefixed hex string
+func (t TestStaticOutputsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-	// Field U72: uint72
-	if _, err := abi.EncodeUint72(value.U72, buf[64:]); err != nil {
-		return 0, err
+// EncodeHexWithSelector encodes testStaticOutputs arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestStaticOutputsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-	// Field U96: uint96
-	if _, err := abi.EncodeUint96(value.U96, buf[96:]); err != nil {
-		return 0, err
+// CalldataCost returns the gas cost of the testStaticOutputs calldata, returns 0 if encoding fails
+func (t TestStaticOutputsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
 	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-	// Field U120: uint120
-	if _, err := abi.EncodeUint120(value.U120, buf[128:]); err != nil {
+// DecodeWithSelector decodes testStaticOutputs arguments from ABI bytes including function selector
+func (t *TestStaticOutputsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestStaticOutputsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
 		return 0, err
 	}
+	return 4 + n, nil
+}
 
-	// Field I24: int24
-	if _, err := abi.EncodeInt24(value.I24, buf[160:]); err != nil {
-		return 0, err
+// DecodeHexWithSelector decodes testStaticOutputs arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestStaticOutputsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticOutputsCall: %w", err)
 	}
+	return t.DecodeWithSelector(data)
+}
 
-	// Field I48: int48
-	if _, err := abi.EncodeInt48(value.I48, buf[192:]); err != nil {
+// PackedEncodeWithSelector encodes testStaticOutputs arguments to packed ABI bytes including function selector
+func (t TestStaticOutputsCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestStaticOutputsSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes testStaticOutputs arguments from packed ABI bytes including function selector
+func (t *TestStaticOutputsCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestStaticOutputsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
 		return 0, err
 	}
+	return 4 + n, nil
+}
+
+// NewTestStaticOutputsCall constructs a new TestStaticOutputsCall
+func NewTestStaticOutputsCall(
+	owners [3]common.Address,
+) *TestStaticOutputsCall {
+	return &TestStaticOutputsCall{
+		Owners: owners,
+	}
+}
+
+const TestStaticOutputsReturnStaticSize = 128
+
+// TestStaticOutputsReturn represents an ABI tuple
+type TestStaticOutputsReturn struct {
+	Balances [3]*uint256.Int
+	Ok       bool
+}
+
+// EncodedSize returns the total encoded size of TestStaticOutputsReturn
+func (t TestStaticOutputsReturn) EncodedSize() int {
+	dynamicSize := 0
 
-	// Field I72: int72
-	if _, err := abi.EncodeInt72(value.I72, buf[224:]); err != nil {
-		return 0, err
-	}
+	return TestStaticOutputsReturnStaticSize + dynamicSize
+}
 
-	// Field I96: int96
-	if _, err := abi.EncodeInt96(value.I96, buf[256:]); err != nil {
+// EncodeTo encodes TestStaticOutputsReturn to ABI bytes in the provided buffer
+func (value TestStaticOutputsReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestStaticOutputsReturnStaticSize // Start dynamic data after static section
+	// Field Balances: uint256[3]
+	if _, err := EncodeUint256Array3U256(value.Balances, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field I120: int120
-	if _, err := abi.EncodeInt120(value.I120, buf[288:]); err != nil {
+	// Field Ok: bool
+	if _, err := abi.EncodeBool(value.Ok, buf[96:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestNonStandardIntegersCall to ABI bytes
-func (value TestNonStandardIntegersCall) Encode() ([]byte, error) {
+// Encode encodes TestStaticOutputsReturn to ABI bytes
+func (value TestStaticOutputsReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -3691,146 +12075,109 @@ func (value TestNonStandardIntegersCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestNonStandardIntegersCall from ABI bytes in the provided buffer
-func (t *TestNonStandardIntegersCall) Decode(data []byte) (int, error) {
-	if len(data) < 320 {
+// Decode decodes TestStaticOutputsReturn from ABI bytes in the provided buffer
+func (t *TestStaticOutputsReturn) Decode(data []byte) (int, error) {
+	if len(data) < 128 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 320
-	// Decode static field U24: uint24
-	t.U24, _, err = abi.DecodeUint24(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field U48: uint48
-	t.U48, _, err = abi.DecodeUint48(data[32:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field U72: uint72
-	t.U72, _, err = abi.DecodeUint72(data[64:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode static field U96: uint96
-	t.U96, _, err = abi.DecodeUint96(data[96:])
+	dynamicOffset := 128
+	// Decode static field Balances: uint256[3]
+	t.Balances, _, err = DecodeUint256Array3U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field U120: uint120
-	t.U120, _, err = abi.DecodeUint120(data[128:])
+	// Decode static field Ok: bool
+	t.Ok, _, err = abi.DecodeBool(data[96:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I24: int24
-	t.I24, _, err = abi.DecodeInt24(data[160:])
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestStaticOutputsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticOutputsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	// Decode static field I48: int48
-	t.I48, _, err = abi.DecodeInt48(data[192:])
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestStaticOutputsReturn from the hex string with an optional 0x prefix
+func (t *TestStaticOutputsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("decode TestStaticOutputsReturn: %w", err)
 	}
-	// Decode static field I72: int72
-	t.I72, _, err = abi.DecodeInt72(data[224:])
-	if err != nil {
-		return 0, err
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestStaticOutputsReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticOutputsReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 128 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	// Decode static field I96: int96
-	t.I96, _, err = abi.DecodeInt96(data[256:])
+	var (
+		err error
+	)
+	dynamicOffset := 128
+	// Decode static field Balances: uint256[3]
+	t.Balances, _, err = DecodeUint256Array3U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I120: int120
-	t.I120, _, err = abi.DecodeInt120(data[288:])
+	// Decode static field Ok: bool
+	t.Ok, _, err = abi.DecodeBool(data[96:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestNonStandardIntegersCall
-func (t TestNonStandardIntegersCall) PackedEncodedSize() int {
-	return 90
+// Reset sets every field of TestStaticOutputsReturn to the zero value, to reuse it from a pool
+func (t *TestStaticOutputsReturn) Reset() {
+	t.Balances = [3]*uint256.Int{}
+	t.Ok = false
 }
 
-// PackedEncodeTo encodes TestNonStandardIntegersCall to packed ABI bytes in the provided buffer
-func (value TestNonStandardIntegersCall) PackedEncodeTo(buf []byte) (int, error) {
+// Clone returns a deep copy of TestStaticOutputsReturn
+func (t TestStaticOutputsReturn) Clone() TestStaticOutputsReturn {
+	c := t
+	for i0 := range t.Balances {
+		if t.Balances[i0] != nil {
+			c.Balances[i0] = new(uint256.Int).Set(t.Balances[i0])
+		}
+	}
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestStaticOutputsReturn
+func (t TestStaticOutputsReturn) PackedEncodedSize() int {
+	return 97
+}
+
+// PackedEncodeTo encodes TestStaticOutputsReturn to packed ABI bytes in the provided buffer
+func (value TestStaticOutputsReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field U24: uint24
-	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field U48: uint48
-	n, err = abi.PackedEncodeUint48(value.U48, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field U72: uint72
-	n, err = abi.PackedEncodeUint72(value.U72, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field U96: uint96
-	n, err = abi.PackedEncodeUint96(value.U96, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field U120: uint120
-	n, err = abi.PackedEncodeUint120(value.U120, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field I24: int24
-	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field I48: int48
-	n, err = abi.PackedEncodeInt48(value.I48, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field I72: int72
-	n, err = abi.PackedEncodeInt72(value.I72, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field I96: int96
-	n, err = abi.PackedEncodeInt96(value.I96, buf[offset:])
+	// Field Balances: uint256[3]
+	n, err = PackedEncodeUint256Array3U256(value.Balances, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field I120: int120
-	n, err = abi.PackedEncodeInt120(value.I120, buf[offset:])
+	// Field Ok: bool
+	n, err = abi.PackedEncodeBool(value.Ok, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -3839,8 +12186,8 @@ func (value TestNonStandardIntegersCall) PackedEncodeTo(buf []byte) (int, error)
 	return offset, nil
 }
 
-// PackedEncode encodes TestNonStandardIntegersCall to packed ABI bytes
-func (value TestNonStandardIntegersCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestStaticOutputsReturn to packed ABI bytes
+func (value TestStaticOutputsReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -3848,187 +12195,191 @@ func (value TestNonStandardIntegersCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestNonStandardIntegersCall from packed ABI bytes
-func (t *TestNonStandardIntegersCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 90 {
+// PackedDecode decodes TestStaticOutputsReturn from packed ABI bytes
+func (t *TestStaticOutputsReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 97 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field U24: uint24
-	t.U24, _, err = abi.PackedDecodeUint24(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field U48: uint48
-	t.U48, _, err = abi.PackedDecodeUint48(data[3:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field U72: uint72
-	t.U72, _, err = abi.PackedDecodeUint72(data[9:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field U96: uint96
-	t.U96, _, err = abi.PackedDecodeUint96(data[18:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field U120: uint120
-	t.U120, _, err = abi.PackedDecodeUint120(data[30:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field I24: int24
-	t.I24, _, err = abi.PackedDecodeInt24(data[45:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field I48: int48
-	t.I48, _, err = abi.PackedDecodeInt48(data[48:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field I72: int72
-	t.I72, _, err = abi.PackedDecodeInt72(data[54:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field I96: int96
-	t.I96, _, err = abi.PackedDecodeInt96(data[63:])
+	// Decode field Balances: uint256[3]
+	t.Balances, _, err = PackedDecodeUint256Array3U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field I120: int120
-	t.I120, _, err = abi.PackedDecodeInt120(data[75:])
+	// Decode field Ok: bool
+	t.Ok, _, err = abi.PackedDecodeBool(data[96:])
 	if err != nil {
 		return 0, err
 	}
-	return 90, nil
-}
-
-// GetMethodName returns the function name
-func (t TestNonStandardIntegersCall) GetMethodName() string {
-	return "testNonStandardIntegers"
+	return 97, nil
 }
 
-// GetMethodID returns the function id
-func (t TestNonStandardIntegersCall) GetMethodID() uint32 {
-	return TestNonStandardIntegersID
-}
-
-// GetMethodSelector returns the function selector
-func (t TestNonStandardIntegersCall) GetMethodSelector() [4]byte {
-	return TestNonStandardIntegersSelector
-}
+var _ abi.Tuple = (*TestStaticOutputsReturn)(nil)
+var _ abi.Decoder = (*TestStaticOutputsReturn)(nil)
+var _ abi.PackedTuple = (*TestStaticOutputsReturn)(nil)
 
-// EncodeWithSelector encodes testNonStandardIntegers arguments to ABI bytes including function selector
-func (t TestNonStandardIntegersCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestNonStandardIntegersSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
+// RandomTestStaticOutputsReturn returns a TestStaticOutputsReturn filled with random values, for property based tests
+func RandomTestStaticOutputsReturn(r *rand.Rand, maxDepth, maxLen int) TestStaticOutputsReturn {
+	var t TestStaticOutputsReturn
+	for i0 := range t.Balances {
+		t.Balances[i0] = abi.RandomUint256(r, 256)
 	}
-	return result, nil
+	t.Ok = r.Intn(2) == 1
+	return t
 }
 
-// NewTestNonStandardIntegersCall constructs a new TestNonStandardIntegersCall
-func NewTestNonStandardIntegersCall(
-	u24 uint32,
-	u48 uint64,
-	u72 *uint256.Int,
-	u96 *uint256.Int,
-	u120 *uint256.Int,
-	i24 int32,
-	i48 int64,
-	i72 *big.Int,
-	i96 *big.Int,
-	i120 *big.Int,
-) *TestNonStandardIntegersCall {
-	return &TestNonStandardIntegersCall{
-		U24:  u24,
-		U48:  u48,
-		U72:  u72,
-		U96:  u96,
-		U120: u120,
-		I24:  i24,
-		I48:  i48,
-		I72:  i72,
-		I96:  i96,
-		I120: i120,
+// DecodeTestStaticOutputsReturn decodes the return data of testStaticOutputs into its values
+func DecodeTestStaticOutputsReturn(data []byte) (r1 [3]*uint256.Int, r2 bool, err error) {
+	var result TestStaticOutputsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
 	}
+	return result.Balances, result.Ok, nil
 }
 
-const TestNonStandardIntegersReturnStaticSize = 32
+var _ abi.Method = (*TestStaticTupleArrayCall)(nil)
 
-var _ abi.Tuple = (*TestNonStandardIntegersReturn)(nil)
-var _ abi.PackedTuple = (*TestNonStandardIntegersReturn)(nil)
+const TestStaticTupleArrayCallStaticSize = 320
 
-// TestNonStandardIntegersReturn represents an ABI tuple
-type TestNonStandardIntegersReturn struct {
-	Field1 bool
+// TestStaticTupleArrayCall represents an ABI tuple
+type TestStaticTupleArrayCall struct {
+	Points [3]Point
+	Owners [4]common.Address
 }
 
-// EncodedSize returns the total encoded size of TestNonStandardIntegersReturn
-func (t TestNonStandardIntegersReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestStaticTupleArrayCall
+func (t TestStaticTupleArrayCall) EncodedSize() int {
 	dynamicSize := 0
 
-	return TestNonStandardIntegersReturnStaticSize + dynamicSize
+	return TestStaticTupleArrayCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestNonStandardIntegersReturn to ABI bytes in the provided buffer
-func (value TestNonStandardIntegersReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestStaticTupleArrayCall to ABI bytes in the provided buffer
+func (value TestStaticTupleArrayCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestNonStandardIntegersReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+	dynamicOffset := TestStaticTupleArrayCallStaticSize // Start dynamic data after static section
+	// Field Points: (uint256,address)[3]
+	if _, err := EncodePointArray3(value.Points, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Owners: address[4]
+	if _, err := EncodeAddressArray4(value.Owners, buf[192:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TestStaticTupleArrayCall to ABI bytes
+func (value TestStaticTupleArrayCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TestStaticTupleArrayCall from ABI bytes in the provided buffer
+func (t *TestStaticTupleArrayCall) Decode(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 320
+	// Decode static field Points: (uint256,address)[3]
+	t.Points, _, err = DecodePointArray3(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Owners: address[4]
+	t.Owners, _, err = DecodeAddressArray4(data[192:])
+	if err != nil {
 		return 0, err
 	}
-
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestNonStandardIntegersReturn to ABI bytes
-func (value TestNonStandardIntegersReturn) Encode() ([]byte, error) {
-	buf := make([]byte, value.EncodedSize())
-	if _, err := value.EncodeTo(buf); err != nil {
-		return nil, err
+// DecodeStrict decodes TestStaticTupleArrayCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticTupleArrayCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
 	}
-	return buf, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// Decode decodes TestNonStandardIntegersReturn from ABI bytes in the provided buffer
-func (t *TestNonStandardIntegersReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// DecodeHex decodes TestStaticTupleArrayCall from the hex string with an optional 0x prefix
+func (t *TestStaticTupleArrayCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticTupleArrayCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestStaticTupleArrayCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticTupleArrayCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 320 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+	dynamicOffset := 320
+	// Decode static field Points: (uint256,address)[3]
+	t.Points, _, err = DecodePointArray3(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Owners: address[4]
+	t.Owners, _, err = DecodeAddressArray4(data[192:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestNonStandardIntegersReturn
-func (t TestNonStandardIntegersReturn) PackedEncodedSize() int {
-	return 1
+// Reset sets every field of TestStaticTupleArrayCall to the zero value, to reuse it from a pool
+func (t *TestStaticTupleArrayCall) Reset() {
+	t.Points = [3]Point{}
+	t.Owners = [4]common.Address{}
 }
 
-// PackedEncodeTo encodes TestNonStandardIntegersReturn to packed ABI bytes in the provided buffer
-func (value TestNonStandardIntegersReturn) PackedEncodeTo(buf []byte) (int, error) {
+// Clone returns a deep copy of TestStaticTupleArrayCall
+func (t TestStaticTupleArrayCall) Clone() TestStaticTupleArrayCall {
+	c := t
+	for i0 := range t.Points {
+		c.Points[i0] = t.Points[i0].Clone()
+	}
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestStaticTupleArrayCall
+func (t TestStaticTupleArrayCall) PackedEncodedSize() int {
+	return 236
+}
+
+// PackedEncodeTo encodes TestStaticTupleArrayCall to packed ABI bytes in the provided buffer
+func (value TestStaticTupleArrayCall) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field Points: (uint256,address)[3]
+	n, err = PackedEncodePointArray3(value.Points, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Owners: address[4]
+	n, err = PackedEncodeAddressArray4(value.Owners, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -4037,8 +12388,8 @@ func (value TestNonStandardIntegersReturn) PackedEncodeTo(buf []byte) (int, erro
 	return offset, nil
 }
 
-// PackedEncode encodes TestNonStandardIntegersReturn to packed ABI bytes
-func (value TestNonStandardIntegersReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestStaticTupleArrayCall to packed ABI bytes
+func (value TestStaticTupleArrayCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -4046,107 +12397,188 @@ func (value TestNonStandardIntegersReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestNonStandardIntegersReturn from packed ABI bytes
-func (t *TestNonStandardIntegersReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
+// PackedDecode decodes TestStaticTupleArrayCall from packed ABI bytes
+func (t *TestStaticTupleArrayCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 236 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	// Decode field Points: (uint256,address)[3]
+	t.Points, _, err = PackedDecodePointArray3(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
+	// Decode field Owners: address[4]
+	t.Owners, _, err = PackedDecodeAddressArray4(data[156:])
+	if err != nil {
+		return 0, err
+	}
+	return 236, nil
 }
 
-var _ abi.Method = (*TestSmallIntegersCall)(nil)
+var _ abi.Tuple = (*TestStaticTupleArrayCall)(nil)
+var _ abi.Decoder = (*TestStaticTupleArrayCall)(nil)
+var _ abi.PackedTuple = (*TestStaticTupleArrayCall)(nil)
 
-const TestSmallIntegersCallStaticSize = 320
+// RandomTestStaticTupleArrayCall returns a TestStaticTupleArrayCall filled with random values, for property based tests
+func RandomTestStaticTupleArrayCall(r *rand.Rand, maxDepth, maxLen int) TestStaticTupleArrayCall {
+	var t TestStaticTupleArrayCall
+	for i0 := range t.Points {
+		t.Points[i0] = RandomPoint(r, maxDepth-1, maxLen)
+	}
+	for i0 := range t.Owners {
+		r.Read(t.Owners[i0][:])
+	}
+	return t
+}
 
-var _ abi.Tuple = (*TestSmallIntegersCall)(nil)
-var _ abi.PackedTuple = (*TestSmallIntegersCall)(nil)
+// GetMethodName returns the function name
+func (t TestStaticTupleArrayCall) GetMethodName() string {
+	return "testStaticTupleArray"
+}
 
-// TestSmallIntegersCall represents an ABI tuple
-type TestSmallIntegersCall struct {
-	U8  uint8
-	U16 uint16
-	U24 uint32
-	U32 uint32
-	U64 uint64
-	I8  int8
-	I16 int16
-	I24 int32
-	I32 int32
-	I64 int64
+// GetMethodID returns the function id
+func (t TestStaticTupleArrayCall) GetMethodID() uint32 {
+	return TestStaticTupleArrayID
 }
 
-// EncodedSize returns the total encoded size of TestSmallIntegersCall
-func (t TestSmallIntegersCall) EncodedSize() int {
-	dynamicSize := 0
+// GetMethodSelector returns the function selector
+func (t TestStaticTupleArrayCall) GetMethodSelector() [4]byte {
+	return TestStaticTupleArraySelector
+}
 
-	return TestSmallIntegersCallStaticSize + dynamicSize
+// EncodedSizeWithSelector returns the encoded size of testStaticTupleArray arguments including function selector
+func (t TestStaticTupleArrayCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
 }
 
-// EncodeTo encodes TestSmallIntegersCall to ABI bytes in the provided buffer
-func (value TestSmallIntegersCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := TestSmallIntegersCallStaticSize // Start dynamic data after static section
-	// Field U8: uint8
-	if _, err := abi.EncodeUint8(value.U8, buf[0:]); err != nil {
-		return 0, err
+// EncodeWithSelector encodes testStaticTupleArray arguments to ABI bytes including function selector
+func (t TestStaticTupleArrayCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestStaticTupleArraySelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	// Field U16: uint16
-	if _, err := abi.EncodeUint16(value.U16, buf[32:]); err != nil {
-		return 0, err
+// EncodeHex encodes testStaticTupleArray arguments to 0x prefixed hex string
+func (t TestStaticTupleArrayCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-	// Field U24: uint24
-	if _, err := abi.EncodeUint24(value.U24, buf[64:]); err != nil {
-		return 0, err
+// EncodeHexWithSelector encodes testStaticTupleArray arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestStaticTupleArrayCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-	// Field U32: uint32
-	if _, err := abi.EncodeUint32(value.U32, buf[96:]); err != nil {
-		return 0, err
+// CalldataCost returns the gas cost of the testStaticTupleArray calldata, returns 0 if encoding fails
+func (t TestStaticTupleArrayCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
 	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-	// Field U64: uint64
-	if _, err := abi.EncodeUint64(value.U64, buf[128:]); err != nil {
+// DecodeWithSelector decodes testStaticTupleArray arguments from ABI bytes including function selector
+func (t *TestStaticTupleArrayCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestStaticTupleArraySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
 		return 0, err
 	}
+	return 4 + n, nil
+}
 
-	// Field I8: int8
-	if _, err := abi.EncodeInt8(value.I8, buf[160:]); err != nil {
-		return 0, err
+// DecodeHexWithSelector decodes testStaticTupleArray arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestStaticTupleArrayCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticTupleArrayCall: %w", err)
 	}
+	return t.DecodeWithSelector(data)
+}
 
-	// Field I16: int16
-	if _, err := abi.EncodeInt16(value.I16, buf[192:]); err != nil {
-		return 0, err
+// PackedEncodeWithSelector encodes testStaticTupleArray arguments to packed ABI bytes including function selector
+func (t TestStaticTupleArrayCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestStaticTupleArraySelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	// Field I24: int24
-	if _, err := abi.EncodeInt24(value.I24, buf[224:]); err != nil {
+// PackedDecodeWithSelector decodes testStaticTupleArray arguments from packed ABI bytes including function selector
+func (t *TestStaticTupleArrayCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TestStaticTupleArraySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
 		return 0, err
 	}
+	return 4 + n, nil
+}
 
-	// Field I32: int32
-	if _, err := abi.EncodeInt32(value.I32, buf[256:]); err != nil {
-		return 0, err
+// NewTestStaticTupleArrayCall constructs a new TestStaticTupleArrayCall
+func NewTestStaticTupleArrayCall(
+	points [3]Point,
+	owners [4]common.Address,
+) *TestStaticTupleArrayCall {
+	return &TestStaticTupleArrayCall{
+		Points: points,
+		Owners: owners,
 	}
+}
 
-	// Field I64: int64
-	if _, err := abi.EncodeInt64(value.I64, buf[288:]); err != nil {
+const TestStaticTupleArrayReturnStaticSize = 128
+
+// TestStaticTupleArrayReturn represents an ABI tuple
+type TestStaticTupleArrayReturn struct {
+	Field1 [2]Point
+}
+
+// EncodedSize returns the total encoded size of TestStaticTupleArrayReturn
+func (t TestStaticTupleArrayReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestStaticTupleArrayReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestStaticTupleArrayReturn to ABI bytes in the provided buffer
+func (value TestStaticTupleArrayReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestStaticTupleArrayReturnStaticSize // Start dynamic data after static section
+	// Field Field1: (uint256,address)[2]
+	if _, err := EncodePointArray2(value.Field1, buf[0:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestSmallIntegersCall to ABI bytes
-func (value TestSmallIntegersCall) Encode() ([]byte, error) {
+// Encode encodes TestStaticTupleArrayReturn to ABI bytes
+func (value TestStaticTupleArrayReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -4154,146 +12586,307 @@ func (value TestSmallIntegersCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestSmallIntegersCall from ABI bytes in the provided buffer
-func (t *TestSmallIntegersCall) Decode(data []byte) (int, error) {
-	if len(data) < 320 {
+// Decode decodes TestStaticTupleArrayReturn from ABI bytes in the provided buffer
+func (t *TestStaticTupleArrayReturn) Decode(data []byte) (int, error) {
+	if len(data) < 128 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 320
-	// Decode static field U8: uint8
-	t.U8, _, err = abi.DecodeUint8(data[0:])
+	dynamicOffset := 128
+	// Decode static field Field1: (uint256,address)[2]
+	t.Field1, _, err = DecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field U16: uint16
-	t.U16, _, err = abi.DecodeUint16(data[32:])
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestStaticTupleArrayReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticTupleArrayReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	// Decode static field U24: uint24
-	t.U24, _, err = abi.DecodeUint24(data[64:])
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TestStaticTupleArrayReturn from the hex string with an optional 0x prefix
+func (t *TestStaticTupleArrayReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("decode TestStaticTupleArrayReturn: %w", err)
 	}
-	// Decode static field U32: uint32
-	t.U32, _, err = abi.DecodeUint32(data[96:])
-	if err != nil {
-		return 0, err
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TestStaticTupleArrayReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticTupleArrayReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 128 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	// Decode static field U64: uint64
-	t.U64, _, err = abi.DecodeUint64(data[128:])
+	var (
+		err error
+	)
+	dynamicOffset := 128
+	// Decode static field Field1: (uint256,address)[2]
+	t.Field1, _, err = DecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I8: int8
-	t.I8, _, err = abi.DecodeInt8(data[160:])
-	if err != nil {
-		return 0, err
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of TestStaticTupleArrayReturn to the zero value, to reuse it from a pool
+func (t *TestStaticTupleArrayReturn) Reset() {
+	t.Field1 = [2]Point{}
+}
+
+// Clone returns a deep copy of TestStaticTupleArrayReturn
+func (t TestStaticTupleArrayReturn) Clone() TestStaticTupleArrayReturn {
+	c := t
+	for i0 := range t.Field1 {
+		c.Field1[i0] = t.Field1[i0].Clone()
 	}
-	// Decode static field I16: int16
-	t.I16, _, err = abi.DecodeInt16(data[192:])
+	return c
+}
+
+// PackedEncodedSize returns the packed encoded size of TestStaticTupleArrayReturn
+func (t TestStaticTupleArrayReturn) PackedEncodedSize() int {
+	return 104
+}
+
+// PackedEncodeTo encodes TestStaticTupleArrayReturn to packed ABI bytes in the provided buffer
+func (value TestStaticTupleArrayReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: (uint256,address)[2]
+	n, err = PackedEncodePointArray2(value.Field1, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I24: int24
-	t.I24, _, err = abi.DecodeInt24(data[224:])
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TestStaticTupleArrayReturn to packed ABI bytes
+func (value TestStaticTupleArrayReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TestStaticTupleArrayReturn from packed ABI bytes
+func (t *TestStaticTupleArrayReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 104 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: (uint256,address)[2]
+	t.Field1, _, err = PackedDecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I32: int32
-	t.I32, _, err = abi.DecodeInt32(data[256:])
+	return 104, nil
+}
+
+var _ abi.Tuple = (*TestStaticTupleArrayReturn)(nil)
+var _ abi.Decoder = (*TestStaticTupleArrayReturn)(nil)
+var _ abi.PackedTuple = (*TestStaticTupleArrayReturn)(nil)
+
+// RandomTestStaticTupleArrayReturn returns a TestStaticTupleArrayReturn filled with random values, for property based tests
+func RandomTestStaticTupleArrayReturn(r *rand.Rand, maxDepth, maxLen int) TestStaticTupleArrayReturn {
+	var t TestStaticTupleArrayReturn
+	for i0 := range t.Field1 {
+		t.Field1[i0] = RandomPoint(r, maxDepth-1, maxLen)
+	}
+	return t
+}
+
+// DecodeTestStaticTupleArrayReturn decodes the return data of testStaticTupleArray into its values
+func DecodeTestStaticTupleArrayReturn(data []byte) (r1 [2]Point, err error) {
+	var result TestStaticTupleArrayReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTestStaticTupleArray decodes the single return value of testStaticTupleArray
+func DecodeTestStaticTupleArray(data []byte) ([2]Point, error) {
+	return DecodeTestStaticTupleArrayReturn(data)
+}
+
+// DecodeTestStaticTupleArrayHex decodes the single return value of testStaticTupleArray from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTestStaticTupleArrayHex(s string) ([2]Point, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
+		var zero [2]Point
+		return zero, fmt.Errorf("decode TestStaticTupleArrayReturn: %w", err)
+	}
+	return DecodeTestStaticTupleArrayReturn(data)
+}
+
+// EncodeTestStaticTupleArrayResult encodes the single return value of testStaticTupleArray, e.g. for the return data of precompiles
+func EncodeTestStaticTupleArrayResult(v [2]Point) ([]byte, error) {
+	result := TestStaticTupleArrayReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TestStaticTupleOutputsCall)(nil)
+
+const TestStaticTupleOutputsCallStaticSize = 160
+
+// TestStaticTupleOutputsCall represents an ABI tuple
+type TestStaticTupleOutputsCall struct {
+	Pair [2]Point
+	Flag bool
+}
+
+// EncodedSize returns the total encoded size of TestStaticTupleOutputsCall
+func (t TestStaticTupleOutputsCall) EncodedSize() int {
+	dynamicSize := 0
+
+	return TestStaticTupleOutputsCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TestStaticTupleOutputsCall to ABI bytes in the provided buffer
+func (value TestStaticTupleOutputsCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TestStaticTupleOutputsCallStaticSize // Start dynamic data after static section
+	// Field Pair: (uint256,address)[2]
+	if _, err := EncodePointArray2(value.Pair, buf[0:]); err != nil {
 		return 0, err
 	}
-	// Decode static field I64: int64
-	t.I64, _, err = abi.DecodeInt64(data[288:])
-	if err != nil {
+
+	// Field Flag: bool
+	if _, err := abi.EncodeBool(value.Flag, buf[128:]); err != nil {
 		return 0, err
 	}
+
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of TestSmallIntegersCall
-func (t TestSmallIntegersCall) PackedEncodedSize() int {
-	return 36
+// Encode encodes TestStaticTupleOutputsCall to ABI bytes
+func (value TestStaticTupleOutputsCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
-// PackedEncodeTo encodes TestSmallIntegersCall to packed ABI bytes in the provided buffer
-func (value TestSmallIntegersCall) PackedEncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields sequentially (packed, no dynamic section)
+// Decode decodes TestStaticTupleOutputsCall from ABI bytes in the provided buffer
+func (t *TestStaticTupleOutputsCall) Decode(data []byte) (int, error) {
+	if len(data) < 160 {
+		return 0, io.ErrUnexpectedEOF
+	}
 	var (
-		offset int
-		n      int
-		err    error
+		err error
 	)
-	// Field U8: uint8
-	n, err = abi.PackedEncodeUint8(value.U8, buf[offset:])
+	dynamicOffset := 160
+	// Decode static field Pair: (uint256,address)[2]
+	t.Pair, _, err = DecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
-
-	// Field U16: uint16
-	n, err = abi.PackedEncodeUint16(value.U16, buf[offset:])
+	// Decode static field Flag: bool
+	t.Flag, _, err = abi.DecodeBool(data[128:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
+	return dynamicOffset, nil
+}
 
-	// Field U24: uint24
-	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
+// DecodeStrict decodes TestStaticTupleOutputsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticTupleOutputsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	offset += n
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-	// Field U32: uint32
-	n, err = abi.PackedEncodeUint32(value.U32, buf[offset:])
+// DecodeHex decodes TestStaticTupleOutputsCall from the hex string with an optional 0x prefix
+func (t *TestStaticTupleOutputsCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("decode TestStaticTupleOutputsCall: %w", err)
 	}
-	offset += n
+	return t.Decode(data)
+}
 
-	// Field U64: uint64
-	n, err = abi.PackedEncodeUint64(value.U64, buf[offset:])
-	if err != nil {
-		return 0, err
+// DecodeInto decodes TestStaticTupleOutputsCall from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticTupleOutputsCall) DecodeInto(data []byte) (int, error) {
+	if len(data) < 160 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	offset += n
-
-	// Field I8: int8
-	n, err = abi.PackedEncodeInt8(value.I8, buf[offset:])
+	var (
+		err error
+	)
+	dynamicOffset := 160
+	// Decode static field Pair: (uint256,address)[2]
+	t.Pair, _, err = DecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
-
-	// Field I16: int16
-	n, err = abi.PackedEncodeInt16(value.I16, buf[offset:])
+	// Decode static field Flag: bool
+	t.Flag, _, err = abi.DecodeBool(data[128:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
+	return dynamicOffset, nil
+}
 
-	// Field I24: int24
-	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
-	if err != nil {
-		return 0, err
+// Reset sets every field of TestStaticTupleOutputsCall to the zero value, to reuse it from a pool
+func (t *TestStaticTupleOutputsCall) Reset() {
+	t.Pair = [2]Point{}
+	t.Flag = false
+}
+
+// Clone returns a deep copy of TestStaticTupleOutputsCall
+func (t TestStaticTupleOutputsCall) Clone() TestStaticTupleOutputsCall {
+	c := t
+	for i0 := range t.Pair {
+		c.Pair[i0] = t.Pair[i0].Clone()
 	}
-	offset += n
+	return c
+}
 
-	// Field I32: int32
-	n, err = abi.PackedEncodeInt32(value.I32, buf[offset:])
+// PackedEncodedSize returns the packed encoded size of TestStaticTupleOutputsCall
+func (t TestStaticTupleOutputsCall) PackedEncodedSize() int {
+	return 105
+}
+
+// PackedEncodeTo encodes TestStaticTupleOutputsCall to packed ABI bytes in the provided buffer
+func (value TestStaticTupleOutputsCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Pair: (uint256,address)[2]
+	n, err = PackedEncodePointArray2(value.Pair, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field I64: int64
-	n, err = abi.PackedEncodeInt64(value.I64, buf[offset:])
+	// Field Flag: bool
+	n, err = abi.PackedEncodeBool(value.Flag, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -4302,8 +12895,8 @@ func (value TestSmallIntegersCall) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes TestSmallIntegersCall to packed ABI bytes
-func (value TestSmallIntegersCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes TestStaticTupleOutputsCall to packed ABI bytes
+func (value TestStaticTupleOutputsCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -4311,148 +12904,215 @@ func (value TestSmallIntegersCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TestSmallIntegersCall from packed ABI bytes
-func (t *TestSmallIntegersCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 36 {
+// PackedDecode decodes TestStaticTupleOutputsCall from packed ABI bytes
+func (t *TestStaticTupleOutputsCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 105 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field U8: uint8
-	t.U8, _, err = abi.PackedDecodeUint8(data[0:])
+	// Decode field Pair: (uint256,address)[2]
+	t.Pair, _, err = PackedDecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field U16: uint16
-	t.U16, _, err = abi.PackedDecodeUint16(data[1:])
+	// Decode field Flag: bool
+	t.Flag, _, err = abi.PackedDecodeBool(data[104:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field U24: uint24
-	t.U24, _, err = abi.PackedDecodeUint24(data[3:])
-	if err != nil {
-		return 0, err
+	return 105, nil
+}
+
+var _ abi.Tuple = (*TestStaticTupleOutputsCall)(nil)
+var _ abi.Decoder = (*TestStaticTupleOutputsCall)(nil)
+var _ abi.PackedTuple = (*TestStaticTupleOutputsCall)(nil)
+
+// RandomTestStaticTupleOutputsCall returns a TestStaticTupleOutputsCall filled with random values, for property based tests
+func RandomTestStaticTupleOutputsCall(r *rand.Rand, maxDepth, maxLen int) TestStaticTupleOutputsCall {
+	var t TestStaticTupleOutputsCall
+	for i0 := range t.Pair {
+		t.Pair[i0] = RandomPoint(r, maxDepth-1, maxLen)
 	}
-	// Decode field U32: uint32
-	t.U32, _, err = abi.PackedDecodeUint32(data[6:])
-	if err != nil {
-		return 0, err
+	t.Flag = r.Intn(2) == 1
+	return t
+}
+
+// GetMethodName returns the function name
+func (t TestStaticTupleOutputsCall) GetMethodName() string {
+	return "testStaticTupleOutputs"
+}
+
+// GetMethodID returns the function id
+func (t TestStaticTupleOutputsCall) GetMethodID() uint32 {
+	return TestStaticTupleOutputsID
+}
+
+// GetMethodSelector returns the function selector
+func (t TestStaticTupleOutputsCall) GetMethodSelector() [4]byte {
+	return TestStaticTupleOutputsSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of testStaticTupleOutputs arguments including function selector
+func (t TestStaticTupleOutputsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes testStaticTupleOutputs arguments to ABI bytes including function selector
+func (t TestStaticTupleOutputsCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TestStaticTupleOutputsSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
 	}
-	// Decode field U64: uint64
-	t.U64, _, err = abi.PackedDecodeUint64(data[10:])
+	return result, nil
+}
+
+// EncodeHex encodes testStaticTupleOutputs arguments to 0x prefixed hex string
+func (t TestStaticTupleOutputsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
 	if err != nil {
-		return 0, err
+		return "", err
 	}
-	// Decode field I8: int8
-	t.I8, _, err = abi.PackedDecodeInt8(data[18:])
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes testStaticTupleOutputs arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TestStaticTupleOutputsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
 	if err != nil {
-		return 0, err
+		return "", err
 	}
-	// Decode field I16: int16
-	t.I16, _, err = abi.PackedDecodeInt16(data[19:])
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the testStaticTupleOutputs calldata, returns 0 if encoding fails
+func (t TestStaticTupleOutputsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
 	if err != nil {
-		return 0, err
+		return 0
 	}
-	// Decode field I24: int24
-	t.I24, _, err = abi.PackedDecodeInt24(data[21:])
-	if err != nil {
-		return 0, err
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes testStaticTupleOutputs arguments from ABI bytes including function selector
+func (t *TestStaticTupleOutputsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
 	}
-	// Decode field I32: int32
-	t.I32, _, err = abi.PackedDecodeInt32(data[24:])
-	if err != nil {
-		return 0, err
+	if [4]byte(data[:4]) != TestStaticTupleOutputsSelector {
+		return 0, abi.ErrSelectorMismatch
 	}
-	// Decode field I64: int64
-	t.I64, _, err = abi.PackedDecodeInt64(data[28:])
+	n, err := t.Decode(data[4:])
 	if err != nil {
 		return 0, err
 	}
-	return 36, nil
-}
-
-// GetMethodName returns the function name
-func (t TestSmallIntegersCall) GetMethodName() string {
-	return "testSmallIntegers"
-}
-
-// GetMethodID returns the function id
-func (t TestSmallIntegersCall) GetMethodID() uint32 {
-	return TestSmallIntegersID
+	return 4 + n, nil
 }
 
-// GetMethodSelector returns the function selector
-func (t TestSmallIntegersCall) GetMethodSelector() [4]byte {
-	return TestSmallIntegersSelector
+// DecodeHexWithSelector decodes testStaticTupleOutputs arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TestStaticTupleOutputsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticTupleOutputsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
 }
 
-// EncodeWithSelector encodes testSmallIntegers arguments to ABI bytes including function selector
-func (t TestSmallIntegersCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], TestSmallIntegersSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
+// PackedEncodeWithSelector encodes testStaticTupleOutputs arguments to packed ABI bytes including function selector
+func (t TestStaticTupleOutputsCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TestStaticTupleOutputsSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewTestSmallIntegersCall constructs a new TestSmallIntegersCall
-func NewTestSmallIntegersCall(
-	u8 uint8,
-	u16 uint16,
-	u24 uint32,
-	u32 uint32,
-	u64 uint64,
-	i8 int8,
-	i16 int16,
-	i24 int32,
-	i32 int32,
-	i64 int64,
-) *TestSmallIntegersCall {
-	return &TestSmallIntegersCall{
-		U8:  u8,
-		U16: u16,
-		U24: u24,
-		U32: u32,
-		U64: u64,
-		I8:  i8,
-		I16: i16,
-		I24: i24,
-		I32: i32,
-		I64: i64,
+// PackedDecodeWithSelector decodes testStaticTupleOutputs arguments from packed ABI bytes including function selector
+func (t *TestStaticTupleOutputsCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
 	}
+	if [4]byte(data[:4]) != TestStaticTupleOutputsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
 }
 
-const TestSmallIntegersReturnStaticSize = 32
+// NewTestStaticTupleOutputsCall constructs a new TestStaticTupleOutputsCall
+func NewTestStaticTupleOutputsCall(
+	pair [2]Point,
+	flag bool,
+) *TestStaticTupleOutputsCall {
+	return &TestStaticTupleOutputsCall{
+		Pair: pair,
+		Flag: flag,
+	}
+}
 
-var _ abi.Tuple = (*TestSmallIntegersReturn)(nil)
-var _ abi.PackedTuple = (*TestSmallIntegersReturn)(nil)
+const TestStaticTupleOutputsReturnStaticSize = 320
 
-// TestSmallIntegersReturn represents an ABI tuple
-type TestSmallIntegersReturn struct {
-	Field1 bool
+// TestStaticTupleOutputsReturn represents an ABI tuple
+type TestStaticTupleOutputsReturn struct {
+	Pair [2]Point
+	Grid [2][2]*uint256.Int
+	Ok   bool
+	Note string
 }
 
-// EncodedSize returns the total encoded size of TestSmallIntegersReturn
-func (t TestSmallIntegersReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of TestStaticTupleOutputsReturn
+func (t TestStaticTupleOutputsReturn) EncodedSize() int {
 	dynamicSize := 0
+	dynamicSize += abi.SizeString(t.Note)
 
-	return TestSmallIntegersReturnStaticSize + dynamicSize
+	return TestStaticTupleOutputsReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes TestSmallIntegersReturn to ABI bytes in the provided buffer
-func (value TestSmallIntegersReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes TestStaticTupleOutputsReturn to ABI bytes in the provided buffer
+func (value TestStaticTupleOutputsReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := TestSmallIntegersReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+	dynamicOffset := TestStaticTupleOutputsReturnStaticSize // Start dynamic data after static section
+	var (
+		err error
+		n   int
+	)
+	// Field Pair: (uint256,address)[2]
+	if _, err := EncodePointArray2(value.Pair, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Grid: uint256[2][2]
+	if _, err := EncodeUint256Array2Array2U256(value.Grid, buf[128:]); err != nil {
+		return 0, err
+	}
+
+	// Field Ok: bool
+	if _, err := abi.EncodeBool(value.Ok, buf[256:]); err != nil {
+		return 0, err
+	}
+
+	// Field Note: string
+	// Encode offset pointer
+	abi.ClearWord(buf[288:])
+	binary.BigEndian.PutUint64(buf[288+24:288+32], uint64(dynamicOffset))
+	// Encode dynamic data
+	n, err = abi.EncodeString(value.Note, buf[dynamicOffset:])
+	if err != nil {
 		return 0, err
 	}
+	dynamicOffset += n
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes TestSmallIntegersReturn to ABI bytes
-func (value TestSmallIntegersReturn) Encode() ([]byte, error) {
+// Encode encodes TestStaticTupleOutputsReturn to ABI bytes
+func (value TestStaticTupleOutputsReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -4460,67 +13120,217 @@ func (value TestSmallIntegersReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes TestSmallIntegersReturn from ABI bytes in the provided buffer
-func (t *TestSmallIntegersReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var (
-		err error
-	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+// Decode decodes TestStaticTupleOutputsReturn from ABI bytes in the provided buffer
+func (t *TestStaticTupleOutputsReturn) Decode(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 320
+	// Decode static field Pair: (uint256,address)[2]
+	t.Pair, _, err = DecodePointArray2(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Grid: uint256[2][2]
+	t.Grid, _, err = DecodeUint256Array2Array2U256(data[128:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Ok: bool
+	t.Ok, _, err = abi.DecodeBool(data[256:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Note
+	{
+		offset, err = abi.DecodeSize(data[288:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Note, n, err = abi.DecodeString(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TestStaticTupleOutputsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TestStaticTupleOutputsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return dynamicOffset, nil
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
 }
 
-// PackedEncodedSize returns the packed encoded size of TestSmallIntegersReturn
-func (t TestSmallIntegersReturn) PackedEncodedSize() int {
-	return 1
+// DecodeHex decodes TestStaticTupleOutputsReturn from the hex string with an optional 0x prefix
+func (t *TestStaticTupleOutputsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TestStaticTupleOutputsReturn: %w", err)
+	}
+	return t.Decode(data)
 }
 
-// PackedEncodeTo encodes TestSmallIntegersReturn to packed ABI bytes in the provided buffer
-func (value TestSmallIntegersReturn) PackedEncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields sequentially (packed, no dynamic section)
+// DecodeInto decodes TestStaticTupleOutputsReturn from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TestStaticTupleOutputsReturn) DecodeInto(data []byte) (int, error) {
+	if len(data) < 320 {
+		return 0, io.ErrUnexpectedEOF
+	}
 	var (
-		offset int
-		n      int
 		err    error
+		n      int
+		offset int
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	dynamicOffset := 320
+	// Decode static field Pair: (uint256,address)[2]
+	t.Pair, _, err = DecodePointArray2(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	offset += n
+	// Decode static field Grid: uint256[2][2]
+	t.Grid, _, err = DecodeUint256Array2Array2U256(data[128:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Ok: bool
+	t.Ok, _, err = abi.DecodeBool(data[256:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode dynamic field Note
+	{
+		offset, err = abi.DecodeSize(data[288:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Note, n, err = abi.DecodeString(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
 
-	return offset, nil
+// Reset sets every field of TestStaticTupleOutputsReturn to the zero value, to reuse it from a pool
+func (t *TestStaticTupleOutputsReturn) Reset() {
+	t.Pair = [2]Point{}
+	t.Grid = [2][2]*uint256.Int{}
+	t.Ok = false
+	t.Note = ""
 }
 
-// PackedEncode encodes TestSmallIntegersReturn to packed ABI bytes
-func (value TestSmallIntegersReturn) PackedEncode() ([]byte, error) {
-	buf := make([]byte, value.PackedEncodedSize())
-	if _, err := value.PackedEncodeTo(buf); err != nil {
-		return nil, err
+// Clone returns a deep copy of TestStaticTupleOutputsReturn
+func (t TestStaticTupleOutputsReturn) Clone() TestStaticTupleOutputsReturn {
+	c := t
+	for i0 := range t.Pair {
+		c.Pair[i0] = t.Pair[i0].Clone()
 	}
-	return buf, nil
+	for i0 := range t.Grid {
+		for i1 := range t.Grid[i0] {
+			if t.Grid[i0][i1] != nil {
+				c.Grid[i0][i1] = new(uint256.Int).Set(t.Grid[i0][i1])
+			}
+		}
+	}
+	return c
 }
 
-// PackedDecode decodes TestSmallIntegersReturn from packed ABI bytes
-func (t *TestSmallIntegersReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
-		return 0, io.ErrUnexpectedEOF
+var _ abi.Tuple = (*TestStaticTupleOutputsReturn)(nil)
+var _ abi.Decoder = (*TestStaticTupleOutputsReturn)(nil)
+
+// RandomTestStaticTupleOutputsReturn returns a TestStaticTupleOutputsReturn filled with random values, for property based tests
+func RandomTestStaticTupleOutputsReturn(r *rand.Rand, maxDepth, maxLen int) TestStaticTupleOutputsReturn {
+	var t TestStaticTupleOutputsReturn
+	for i0 := range t.Pair {
+		t.Pair[i0] = RandomPoint(r, maxDepth-1, maxLen)
 	}
-	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
-	if err != nil {
-		return 0, err
+	for i0 := range t.Grid {
+		for i1 := range t.Grid[i0] {
+			t.Grid[i0][i1] = abi.RandomUint256(r, 256)
+		}
 	}
-	return 1, nil
+	t.Ok = r.Intn(2) == 1
+	t.Note = abi.RandomString(r, maxLen)
+	return t
+}
+
+// DecodeTestStaticTupleOutputsReturn decodes the return data of testStaticTupleOutputs into its values
+func DecodeTestStaticTupleOutputsReturn(data []byte) (r1 [2]Point, r2 [2][2]*uint256.Int, r3 bool, r4 string, err error) {
+	var result TestStaticTupleOutputsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Pair, result.Grid, result.Ok, result.Note, nil
+}
+
+// DecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func DecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case LogsSelector:
+		call = new(LogsCall)
+	case TagsSelector:
+		call = new(TagsCall)
+	case TestComplexDynamicTuplesSelector:
+		call = new(TestComplexDynamicTuplesCall)
+	case TestDeeplyNestedSelector:
+		call = new(TestDeeplyNestedCall)
+	case TestDynamicFixedArraysSelector:
+		call = new(TestDynamicFixedArraysCall)
+	case TestExternalTupleSelector:
+		call = new(TestExternalTupleCall)
+	case TestFixedArraysSelector:
+		call = new(TestFixedArraysCall)
+	case TestFixedBytesSelector:
+		call = new(TestFixedBytesCall)
+	case TestMixedTypesSelector:
+		call = new(TestMixedTypesCall)
+	case TestNestedDynamicArraysSelector:
+		call = new(TestNestedDynamicArraysCall)
+	case TestNestedDynamicFixedArraysSelector:
+		call = new(TestNestedDynamicFixedArraysCall)
+	case TestNestedFixedArraysSelector:
+		call = new(TestNestedFixedArraysCall)
+	case TestNestedStructSelector:
+		call = new(TestNestedStructCall)
+	case TestNonStandardIntegersSelector:
+		call = new(TestNonStandardIntegersCall)
+	case TestSmallIntegersSelector:
+		call = new(TestSmallIntegersCall)
+	case TestStaticOutputsSelector:
+		call = new(TestStaticOutputsCall)
+	case TestStaticTupleArraySelector:
+		call = new(TestStaticTupleArrayCall)
+	case TestStaticTupleOutputsSelector:
+		call = new(TestStaticTupleOutputsCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
 }
 
 // Event signatures
@@ -4535,6 +13345,38 @@ var (
 	UserCreatedEventTopic = common.Hash{0x34, 0xd6, 0x8f, 0x2d, 0xec, 0x91, 0xef, 0x13, 0x0d, 0xe9, 0x21, 0x4e, 0x8e, 0xa8, 0x6e, 0x02, 0x29, 0xf7, 0x22, 0xee, 0x89, 0x41, 0xc9, 0x9f, 0x75, 0xbf, 0xa5, 0x38, 0x17, 0xd9, 0x97, 0x82}
 )
 
+// Event IDs, the same hashes as the topics, named like go-ethereum's abi.Event.ID
+var (
+	ComplexEventID     = ComplexEventTopic
+	IndexOnlyEventID   = IndexOnlyEventTopic
+	TransferEventID    = TransferEventTopic
+	UserCreatedEventID = UserCreatedEventTopic
+)
+
+// Canonical event signatures
+const (
+	ComplexEventSignature     = "Complex(string,uint256[],address)"
+	IndexOnlyEventSignature   = "IndexOnly(address)"
+	TransferEventSignature    = "Transfer(address,address,uint256)"
+	UserCreatedEventSignature = "UserCreated((address,string,uint256),address)"
+)
+
+// Events maps event topics to event names
+var Events = map[common.Hash]string{
+	ComplexEventTopic:     "Complex",
+	IndexOnlyEventTopic:   "IndexOnly",
+	TransferEventTopic:    "Transfer",
+	UserCreatedEventTopic: "UserCreated",
+}
+
+// EventTopics maps event topics to the canonical event signatures
+var EventTopics = map[common.Hash]string{
+	ComplexEventTopic:     ComplexEventSignature,
+	IndexOnlyEventTopic:   IndexOnlyEventSignature,
+	TransferEventTopic:    TransferEventSignature,
+	UserCreatedEventTopic: UserCreatedEventSignature,
+}
+
 // ComplexEvent represents the Complex event
 var _ abi.Event = (*ComplexEvent)(nil)
 
@@ -4590,13 +13432,13 @@ func (e ComplexEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of Complex event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of Complex event from topics, hash topics are stored as is
 func (e *ComplexEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 2 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(2, len(topics))
 	}
 	if topics[0] != ComplexEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.Sender, _, err = abi.DecodeAddress(topics[1][:])
@@ -4608,8 +13450,6 @@ func (e *ComplexEventIndexed) DecodeTopics(topics []common.Hash) error {
 
 const ComplexEventDataStaticSize = 64
 
-var _ abi.Tuple = (*ComplexEventData)(nil)
-
 // ComplexEventData represents an ABI tuple
 type ComplexEventData struct {
 	Message string
@@ -4620,7 +13460,7 @@ type ComplexEventData struct {
 func (t ComplexEventData) EncodedSize() int {
 	dynamicSize := 0
 	dynamicSize += abi.SizeString(t.Message)
-	dynamicSize += abi.SizeUint256Slice(t.Numbers)
+	dynamicSize += abi.SizeUint256SliceU256(t.Numbers)
 
 	return ComplexEventDataStaticSize + dynamicSize
 }
@@ -4635,6 +13475,7 @@ func (value ComplexEventData) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Message: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Message, buf[dynamicOffset:])
@@ -4645,9 +13486,10 @@ func (value ComplexEventData) EncodeTo(buf []byte) (int, error) {
 
 	// Field Numbers: uint256[]
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
-	n, err = abi.EncodeUint256Slice(value.Numbers, buf[dynamicOffset:])
+	n, err = abi.EncodeUint256SliceU256(value.Numbers, buf[dynamicOffset:])
 	if err != nil {
 		return 0, err
 	}
@@ -4700,7 +13542,70 @@ func (t *ComplexEventData) Decode(data []byte) (int, error) {
 		if offset != dynamicOffset {
 			return 0, abi.ErrInvalidOffsetForDynamicField
 		}
-		t.Numbers, n, err = abi.DecodeUint256Slice(data[dynamicOffset:])
+		t.Numbers, n, err = abi.DecodeUint256SliceU256(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes ComplexEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *ComplexEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes ComplexEventData from the hex string with an optional 0x prefix
+func (t *ComplexEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ComplexEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes ComplexEventData from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *ComplexEventData) DecodeInto(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 64
+	// Decode dynamic field Message
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Message, n, err = abi.DecodeString(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	// Decode dynamic field Numbers
+	{
+		offset, err = abi.DecodeSize(data[32:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		t.Numbers, n, err = abi.DecodeIntoUint256SliceU256(t.Numbers, data[dynamicOffset:])
 		if err != nil {
 			return 0, err
 		}
@@ -4709,6 +13614,40 @@ func (t *ComplexEventData) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// Reset sets every field of ComplexEventData to the zero value, to reuse it from a pool
+func (t *ComplexEventData) Reset() {
+	t.Message = ""
+	t.Numbers = nil
+}
+
+// Clone returns a deep copy of ComplexEventData
+func (t ComplexEventData) Clone() ComplexEventData {
+	c := t
+	if t.Numbers != nil {
+		c.Numbers = make([]*uint256.Int, len(t.Numbers))
+		for i0 := range t.Numbers {
+			if t.Numbers[i0] != nil {
+				c.Numbers[i0] = new(uint256.Int).Set(t.Numbers[i0])
+			}
+		}
+	}
+	return c
+}
+
+var _ abi.Tuple = (*ComplexEventData)(nil)
+var _ abi.Decoder = (*ComplexEventData)(nil)
+
+// RandomComplexEventData returns a ComplexEventData filled with random values, for property based tests
+func RandomComplexEventData(r *rand.Rand, maxDepth, maxLen int) ComplexEventData {
+	var t ComplexEventData
+	t.Message = abi.RandomString(r, maxLen)
+	t.Numbers = make([]*uint256.Int, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Numbers {
+		t.Numbers[i0] = abi.RandomUint256(r, 256)
+	}
+	return t
+}
+
 // IndexOnlyEvent represents the IndexOnly event
 var _ abi.Event = (*IndexOnlyEvent)(nil)
 
@@ -4759,13 +13698,13 @@ func (e IndexOnlyEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of IndexOnly event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of IndexOnly event from topics, hash topics are stored as is
 func (e *IndexOnlyEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 2 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(2, len(topics))
 	}
 	if topics[0] != IndexOnlyEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.Sender, _, err = abi.DecodeAddress(topics[1][:])
@@ -4843,13 +13782,13 @@ func (e TransferEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of Transfer event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of Transfer event from topics, hash topics are stored as is
 func (e *TransferEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 3 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(3, len(topics))
 	}
 	if topics[0] != TransferEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.From, _, err = abi.DecodeAddress(topics[1][:])
@@ -4865,9 +13804,6 @@ func (e *TransferEventIndexed) DecodeTopics(topics []common.Hash) error {
 
 const TransferEventDataStaticSize = 32
 
-var _ abi.Tuple = (*TransferEventData)(nil)
-var _ abi.PackedTuple = (*TransferEventData)(nil)
-
 // TransferEventData represents an ABI tuple
 type TransferEventData struct {
 	Value *uint256.Int
@@ -4885,7 +13821,7 @@ func (value TransferEventData) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
 	dynamicOffset := TransferEventDataStaticSize // Start dynamic data after static section
 	// Field Value: uint256
-	if _, err := abi.EncodeUint256(value.Value, buf[0:]); err != nil {
+	if _, err := abi.EncodeUint256U256(value.Value, buf[0:]); err != nil {
 		return 0, err
 	}
 
@@ -4911,13 +13847,63 @@ func (t *TransferEventData) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Value: uint256
-	t.Value, _, err = abi.DecodeUint256(data[0:])
+	t.Value, _, err = abi.DecodeIntoUint256U256(t.Value, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TransferEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferEventData from the hex string with an optional 0x prefix
+func (t *TransferEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes TransferEventData from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *TransferEventData) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Value: uint256
+	t.Value, _, err = abi.DecodeIntoUint256U256(t.Value, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// Reset sets every field of TransferEventData to the zero value, to reuse it from a pool
+func (t *TransferEventData) Reset() {
+	t.Value = nil
+}
+
+// Clone returns a deep copy of TransferEventData
+func (t TransferEventData) Clone() TransferEventData {
+	c := t
+	if t.Value != nil {
+		c.Value = new(uint256.Int).Set(t.Value)
+	}
+	return c
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferEventData
 func (t TransferEventData) PackedEncodedSize() int {
 	return 32
@@ -4932,7 +13918,7 @@ func (value TransferEventData) PackedEncodeTo(buf []byte) (int, error) {
 		err    error
 	)
 	// Field Value: uint256
-	n, err = abi.PackedEncodeUint256(value.Value, buf[offset:])
+	n, err = abi.PackedEncodeUint256U256(value.Value, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -4957,13 +13943,24 @@ func (t *TransferEventData) PackedDecode(data []byte) (int, error) {
 	}
 	var err error
 	// Decode field Value: uint256
-	t.Value, _, err = abi.PackedDecodeUint256(data[0:])
+	t.Value, _, err = abi.PackedDecodeUint256U256(data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return 32, nil
 }
 
+var _ abi.Tuple = (*TransferEventData)(nil)
+var _ abi.Decoder = (*TransferEventData)(nil)
+var _ abi.PackedTuple = (*TransferEventData)(nil)
+
+// RandomTransferEventData returns a TransferEventData filled with random values, for property based tests
+func RandomTransferEventData(r *rand.Rand, maxDepth, maxLen int) TransferEventData {
+	var t TransferEventData
+	t.Value = abi.RandomUint256(r, 256)
+	return t
+}
+
 // UserCreatedEvent represents the UserCreated event
 var _ abi.Event = (*UserCreatedEvent)(nil)
 
@@ -5017,13 +14014,13 @@ func (e UserCreatedEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of UserCreated event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of UserCreated event from topics, hash topics are stored as is
 func (e *UserCreatedEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 2 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(2, len(topics))
 	}
 	if topics[0] != UserCreatedEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
 	var err error
 	e.Creator, _, err = abi.DecodeAddress(topics[1][:])
@@ -5035,8 +14032,6 @@ func (e *UserCreatedEventIndexed) DecodeTopics(topics []common.Hash) error {
 
 const UserCreatedEventDataStaticSize = 32
 
-var _ abi.Tuple = (*UserCreatedEventData)(nil)
-
 // UserCreatedEventData represents an ABI tuple
 type UserCreatedEventData struct {
 	User User
@@ -5060,6 +14055,7 @@ func (value UserCreatedEventData) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field User: (address,string,uint256)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.User.EncodeTo(buf[dynamicOffset:])
@@ -5108,3 +14104,153 @@ func (t *UserCreatedEventData) Decode(data []byte) (int, error) {
 	}
 	return dynamicOffset, nil
 }
+
+// DecodeStrict decodes UserCreatedEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserCreatedEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UserCreatedEventData from the hex string with an optional 0x prefix
+func (t *UserCreatedEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UserCreatedEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// DecodeInto decodes UserCreatedEventData from ABI bytes in the provided buffer,
+// reusing the allocations of the slices and nested tuples of t
+func (t *UserCreatedEventData) DecodeInto(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err    error
+		n      int
+		offset int
+	)
+	dynamicOffset := 32
+	// Decode dynamic field User
+	{
+		offset, err = abi.DecodeSize(data[0:])
+		if err != nil {
+			return 0, err
+		}
+		if offset != dynamicOffset {
+			return 0, abi.ErrInvalidOffsetForDynamicField
+		}
+		n, err = t.User.Decode(data[dynamicOffset:])
+		if err != nil {
+			return 0, err
+		}
+		dynamicOffset += n
+	}
+	return dynamicOffset, nil
+}
+
+// Reset sets every field of UserCreatedEventData to the zero value, to reuse it from a pool
+func (t *UserCreatedEventData) Reset() {
+	t.User = User{}
+}
+
+// Clone returns a deep copy of UserCreatedEventData
+func (t UserCreatedEventData) Clone() UserCreatedEventData {
+	c := t
+	return c
+}
+
+var _ abi.Tuple = (*UserCreatedEventData)(nil)
+var _ abi.Decoder = (*UserCreatedEventData)(nil)
+
+// RandomUserCreatedEventData returns a UserCreatedEventData filled with random values, for property based tests
+func RandomUserCreatedEventData(r *rand.Rand, maxDepth, maxLen int) UserCreatedEventData {
+	var t UserCreatedEventData
+	t.User = RandomUser(r, maxDepth-1, maxLen)
+	return t
+}
+
+// Error selectors
+var (
+	// InsufficientBalance(uint256,uint256)
+	InsufficientBalanceErrorSelector = [4]byte{0xcf, 0x47, 0x91, 0x81}
+	// Unauthorized()
+	UnauthorizedErrorSelector = [4]byte{0x82, 0xb4, 0x29, 0x00}
+)
+
+// Big endian integer versions of error selectors
+const (
+	InsufficientBalanceErrorID = 3477574017
+	UnauthorizedErrorID        = 2192845056
+)
+
+// Selectors maps the function and error selectors to the canonical signatures
+var Selectors = map[[4]byte]string{
+	LogsSelector:                         LogsSignature,
+	TagsSelector:                         TagsSignature,
+	TestComplexDynamicTuplesSelector:     TestComplexDynamicTuplesSignature,
+	TestDeeplyNestedSelector:             TestDeeplyNestedSignature,
+	TestDynamicFixedArraysSelector:       TestDynamicFixedArraysSignature,
+	TestExternalTupleSelector:            TestExternalTupleSignature,
+	TestFixedArraysSelector:              TestFixedArraysSignature,
+	TestFixedBytesSelector:               TestFixedBytesSignature,
+	TestMixedTypesSelector:               TestMixedTypesSignature,
+	TestNestedDynamicArraysSelector:      TestNestedDynamicArraysSignature,
+	TestNestedDynamicFixedArraysSelector: TestNestedDynamicFixedArraysSignature,
+	TestNestedFixedArraysSelector:        TestNestedFixedArraysSignature,
+	TestNestedStructSelector:             TestNestedStructSignature,
+	TestNonStandardIntegersSelector:      TestNonStandardIntegersSignature,
+	TestSmallIntegersSelector:            TestSmallIntegersSignature,
+	TestStaticOutputsSelector:            TestStaticOutputsSignature,
+	TestStaticTupleArraySelector:         TestStaticTupleArraySignature,
+	TestStaticTupleOutputsSelector:       TestStaticTupleOutputsSignature,
+	InsufficientBalanceErrorSelector:     "InsufficientBalance(uint256,uint256)",
+	UnauthorizedErrorSelector:            "Unauthorized()",
+}
+
+// FallbackStateMutability is the state mutability of the fallback function
+const FallbackStateMutability = "payable"
+
+// FallbackCall represents the raw calldata passed to the fallback function
+type FallbackCall struct {
+	Data []byte
+}
+
+// EncodeWithSelector returns the raw calldata, fallback function has no selector
+func (t FallbackCall) EncodeWithSelector() ([]byte, error) {
+	return t.Data, nil
+}
+
+// DecodeWithSelector takes the whole calldata as is
+func (t *FallbackCall) DecodeWithSelector(data []byte) (int, error) {
+	t.Data = data
+	return len(data), nil
+}
+
+// Clone returns a deep copy of FallbackCall
+func (t FallbackCall) Clone() FallbackCall {
+	return FallbackCall{Data: bytes.Clone(t.Data)}
+}
+
+// ReceiveStateMutability is the state mutability of the receive function
+const ReceiveStateMutability = "payable"
+
+// ReceiveCall represents a plain value transfer to the receive function, which has empty calldata
+type ReceiveCall struct{}
+
+// EncodeWithSelector returns the empty calldata
+func (t ReceiveCall) EncodeWithSelector() ([]byte, error) {
+	return []byte{}, nil
+}
+
+// DecodeWithSelector accepts only empty calldata
+func (t *ReceiveCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) != 0 {
+		return 0, abi.ErrTrailingBytes
+	}
+	return 0, nil
+}
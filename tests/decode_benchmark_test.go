@@ -3,7 +3,11 @@
 package tests
 
 import (
+	"math/big"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/yihuang/go-abi"
 )
 
 // Benchmark functions for go-abi generated code
@@ -58,6 +62,60 @@ func BenchmarkGoABI_Decode_MixedTypes(b *testing.B) {
 	}
 }
 
+func BenchmarkGoABI_Decode_AddressSlice(b *testing.B) {
+	addresses := make([]common.Address, 256)
+	for i := range addresses {
+		addresses[i] = common.BigToAddress(big.NewInt(int64(i + 1)))
+	}
+	encoded := make([]byte, 32+32*len(addresses))
+	if _, err := abi.EncodeAddressSlice(addresses, encoded); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := abi.DecodeAddressSlice(encoded); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGoABI_DecodeInto_MixedTypes(b *testing.B) {
+	args := createMixedTypesData()
+	encoded, err := args.Encode()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var decoded TestMixedTypesCall
+	for i := 0; i < b.N; i++ {
+		_, err := decoded.DecodeInto(encoded)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGoABI_DecodeInto_ComplexDynamicTuples(b *testing.B) {
+	args := createComplexDynamicTuplesData()
+	encoded, err := args.Encode()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var decoded TestComplexDynamicTuplesCall
+	for i := 0; i < b.N; i++ {
+		_, err := decoded.DecodeInto(encoded)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // Benchmark functions for go-ethereum ABI decoding
 func BenchmarkGoEthereum_Decode_ComplexDynamicTuples(b *testing.B) {
 	args := createComplexDynamicTuplesData()
@@ -0,0 +1,111 @@
+package decodectx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var DecodeCtxTestABI -output decodectx.abi.go -package decodectx -decode-ctx -decode-ctx-interval 100
+
+var DecodeCtxTestABI = []string{
+	"struct Item { uint256 id; bytes data }",
+	"struct Batch { string name; Item[] items }",
+	"function submit(Batch batch, uint64[][] matrix, Item[2] pair)",
+	"function ids(uint256[] ids) returns (uint256)",
+	"function item(Item item) returns (uint256)",
+}
+
+// countingContext is canceled after its Err is called limit times, so the decoding is canceled midway deterministically
+type countingContext struct {
+	context.Context
+	calls atomic.Int64
+	limit int64
+}
+
+func (c *countingContext) Err() error {
+	if c.calls.Add(1) > c.limit {
+		return context.Canceled
+	}
+	return nil
+}
+
+func testItems(n int) []Item {
+	items := make([]Item, n)
+	for i := range items {
+		items[i] = Item{Id: big.NewInt(int64(i + 1)), Data: []byte{byte(i)}}
+	}
+	return items
+}
+
+func TestDecodeCtx(t *testing.T) {
+	call := SubmitCall{
+		Batch:  Batch{Name: "batch", Items: testItems(1000)},
+		Matrix: [][]uint64{{1, 2}, {}, {3}},
+		Pair:   [2]Item{{Id: big.NewInt(1), Data: []byte{}}, {Id: big.NewInt(2), Data: []byte{1}}},
+	}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	var decoded SubmitCall
+	n, err := decoded.DecodeCtx(context.Background(), encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, call, decoded)
+
+	// the structs without dynamic arrays have no DecodeCtx
+	_, ok := interface{}(&ItemCall{}).(interface {
+		DecodeCtx(context.Context, []byte) (int, error)
+	})
+	require.False(t, ok)
+}
+
+func TestDecodeCtxCanceled(t *testing.T) {
+	call := IdsCall{Ids: make([]*big.Int, 100_000)}
+	for i := range call.Ids {
+		call.Ids[i] = big.NewInt(int64(i + 1))
+	}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var decoded IdsCall
+	_, err = decoded.DecodeCtx(ctx, encoded)
+	require.True(t, errors.Is(err, abi.ErrDecodeCanceled))
+	require.True(t, errors.Is(err, context.Canceled))
+
+	// canceled midway, after 10 checks of every 100 elements
+	counting := &countingContext{Context: context.Background(), limit: 10}
+	_, err = decoded.DecodeCtx(counting, encoded)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.EqualValues(t, 11, counting.calls.Load(), "stops at the first check after the cancellation")
+
+	// the plain Decode doesn't check any context
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, decoded)
+}
+
+func TestDecodeCtxNested(t *testing.T) {
+	call := SubmitCall{
+		Batch:  Batch{Name: "batch", Items: testItems(10)},
+		Matrix: [][]uint64{make([]uint64, 50_000)},
+		Pair:   [2]Item{{Id: big.NewInt(1), Data: []byte{}}, {Id: big.NewInt(2), Data: []byte{}}},
+	}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	// the checks of the nested arrays, 1 of the items, 1 of the matrix and 5 of its row
+	counting := &countingContext{Context: context.Background(), limit: 5}
+	var decoded SubmitCall
+	_, err = decoded.DecodeCtx(counting, encoded)
+	require.True(t, errors.Is(err, abi.ErrDecodeCanceled))
+	require.EqualValues(t, 6, counting.calls.Load())
+}
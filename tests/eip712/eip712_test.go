@@ -0,0 +1,115 @@
+package eip712
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var EIP712TestABI -output eip712.abi.go -eip712
+
+// EIP712TestABI mirrors the EIP-712 types of the Mail example in the spec
+var EIP712TestABI = []string{
+	"struct EIP712Domain { string name; string version; uint256 chainId; address verifyingContract }",
+	"struct Person { string name; address wallet }",
+	"struct Mail { Person from; Person to; string contents }",
+	"struct Group { string name; Person[] members; uint64[2] ids; bytes data; bytes32[] tags }",
+	"function mail(EIP712Domain domain, Mail mail)",
+	"function group(Group group)",
+}
+
+var EIP712TestABIDef ethabi.ABI
+
+func init() {
+	var err error
+	abiJSON, err := abi.ParseHumanReadableABI(EIP712TestABI)
+	if err != nil {
+		panic(err)
+	}
+	EIP712TestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestMailExample(t *testing.T) {
+	domain := EIP712Domain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"),
+	}
+	mail := Mail{
+		From:     Person{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+		To:       Person{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+		Contents: "Hello, Bob!",
+	}
+
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", MailEIP712Type)
+	require.Equal(t, common.HexToHash("0xa0cedeb2dc280ba39b857546d74f5549c3a1d7bdc2dd96bf881f76108e23dac2"), common.Hash(mail.TypeHash()))
+
+	domainSeparator, err := domain.HashStruct()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"), common.Hash(domainSeparator))
+
+	mailHash, err := mail.HashStruct()
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"), common.Hash(mailHash))
+
+	digest := abi.TypedDataDigest(domainSeparator, mailHash)
+	require.Equal(t, common.HexToHash("0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"), common.Hash(digest))
+}
+
+func TestArraysAgainstGoEthereum(t *testing.T) {
+	group := Group{
+		Name: "friends",
+		Members: []Person{
+			{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+			{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+		},
+		Ids:  [2]uint64{1, 2},
+		Data: []byte{0xde, 0xad},
+		Tags: [][32]byte{crypto.Keccak256Hash([]byte("a")), crypto.Keccak256Hash([]byte("b"))},
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Person":       {{Name: "name", Type: "string"}, {Name: "wallet", Type: "address"}},
+			"Group": {
+				{Name: "name", Type: "string"},
+				{Name: "members", Type: "Person[]"},
+				{Name: "ids", Type: "uint64[2]"},
+				{Name: "data", Type: "bytes"},
+				{Name: "tags", Type: "bytes32[]"},
+			},
+		},
+		Domain: apitypes.TypedDataDomain{Name: "groups"},
+	}
+	require.Equal(t, string(typedData.EncodeType("Group")), GroupEIP712Type)
+
+	members := make([]interface{}, len(group.Members))
+	for i, m := range group.Members {
+		members[i] = map[string]interface{}{"name": m.Name, "wallet": m.Wallet.Hex()}
+	}
+	expected, err := typedData.HashStruct("Group", apitypes.TypedDataMessage{
+		"name":    group.Name,
+		"members": members,
+		"ids":     []interface{}{"1", "2"},
+		"data":    hexutil.Encode(group.Data),
+		"tags":    []interface{}{hexutil.Encode(group.Tags[0][:]), hexutil.Encode(group.Tags[1][:])},
+	})
+	require.NoError(t, err)
+
+	actual, err := group.HashStruct()
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(expected), common.Hash(actual))
+}
@@ -220,6 +220,20 @@ func BenchmarkGoABI_EncodeTo_ComplexDynamicTuples(b *testing.B) {
 	}
 }
 
+func BenchmarkGoABI_EncodeTo_MixedTypes(b *testing.B) {
+	args := createMixedTypesData()
+	size := args.EncodedSize()
+	buf := make([]byte, size)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := args.EncodeTo(buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkGoABI_Encode_SmallIntegers(b *testing.B) {
 	args := createSmallIntegersData()
 	b.ResetTimer()
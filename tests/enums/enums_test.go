@@ -0,0 +1,96 @@
+package enums
+
+import (
+	"os"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -input enums.abi.json -output enums.abi.go -package enums -enums
+
+func loadABI(t *testing.T) ethabi.ABI {
+	f, err := os.Open("enums.abi.json")
+	require.NoError(t, err)
+	defer f.Close()
+	abiDef, err := ethabi.JSON(f)
+	require.NoError(t, err)
+	return abiDef
+}
+
+func TestEnums(t *testing.T) {
+	abiDef := loadABI(t)
+
+	call := NewSetStatusCall(Status(2), 7)
+	var _ Status = call.Status
+	var _ uint8 = call.Count
+	require.Equal(t, "2", call.Status.String())
+
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+	expected, err := abiDef.Pack("setStatus", uint8(2), uint8(7))
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	var decoded SetStatusCall
+	_, err = decoded.DecodeWithSelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, &decoded)
+
+	result, err := EncodeSetStatusResult(Status(1))
+	require.NoError(t, err)
+	status, err := DecodeSetStatus(result)
+	require.NoError(t, err)
+	require.Equal(t, Status(1), status)
+
+	// out of range values are rejected like uint8
+	result[30] = 1
+	_, err = DecodeSetStatus(result)
+	require.Error(t, err)
+
+	// the ABI without internalType falls back to uint8
+	var _ uint8 = NewLegacyCall(1).Status
+}
+
+func TestEnumsNested(t *testing.T) {
+	abiDef := loadABI(t)
+
+	call := NewGetOrdersCall([2]Side{1, 0})
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+	expected, err := abiDef.Pack("getOrders", [2]uint8{1, 0})
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	ret := GetOrdersReturn{Orders: []MarketOrder{
+		{Maker: common.HexToAddress("0x01"), Side: 1, History: []Status{0, 1, 2}},
+		{Maker: common.HexToAddress("0x02"), Side: 0, History: []Status{}},
+	}}
+	data, err := ret.Encode()
+	require.NoError(t, err)
+
+	var decoded GetOrdersReturn
+	_, err = decoded.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, ret, decoded)
+}
+
+func TestEnumsEvent(t *testing.T) {
+	abiDef := loadABI(t)
+
+	event := NewStatusChangedEvent(Status(2), Status(1))
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(common.Big2), topics[1])
+
+	expected, err := abiDef.Events["StatusChanged"].Inputs.NonIndexed().Pack(uint8(1))
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	var decoded StatusChangedEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, *event, decoded)
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"testing"
 
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/test-go/testify/require"
@@ -102,3 +103,115 @@ func TestEventDataEncodingComparison(t *testing.T) {
 		}
 	})
 }
+
+func TestEventRegistryComparison(t *testing.T) {
+	signatures := map[string]string{
+		"Transfer":    TransferEventSignature,
+		"UserCreated": UserCreatedEventSignature,
+		"Complex":     ComplexEventSignature,
+		"IndexOnly":   IndexOnlyEventSignature,
+	}
+
+	require.Equal(t, len(ComprehensiveTestABIDef.Events), len(Events))
+	for name, event := range ComprehensiveTestABIDef.Events {
+		require.Equal(t, name, Events[event.ID])
+		require.Equal(t, event.Sig, signatures[name])
+		require.Equal(t, event.ID, crypto.Keccak256Hash([]byte(signatures[name])))
+	}
+}
+
+func TestErrorSelectorComparison(t *testing.T) {
+	selectors := map[string][4]byte{
+		"InsufficientBalance": InsufficientBalanceErrorSelector,
+		"Unauthorized":        UnauthorizedErrorSelector,
+	}
+
+	require.Equal(t, len(ComprehensiveTestABIDef.Errors), len(selectors))
+	for name, e := range ComprehensiveTestABIDef.Errors {
+		require.Equal(t, [4]byte(e.ID[:4]), selectors[name])
+	}
+	require.Equal(t, uint32(0x82b42900), uint32(UnauthorizedErrorID))
+}
+
+func TestSelectorRegistry(t *testing.T) {
+	require.Equal(t, len(ComprehensiveTestABIDef.Methods)+len(ComprehensiveTestABIDef.Errors), len(Selectors))
+	for _, method := range ComprehensiveTestABIDef.Methods {
+		require.Equal(t, method.Sig, Selectors[[4]byte(method.ID)])
+	}
+	for _, e := range ComprehensiveTestABIDef.Errors {
+		require.Equal(t, e.Sig, Selectors[[4]byte(e.ID[:4])])
+	}
+
+	require.Equal(t, len(ComprehensiveTestABIDef.Events), len(EventTopics))
+	for _, event := range ComprehensiveTestABIDef.Events {
+		require.Equal(t, event.Sig, EventTopics[event.ID])
+	}
+}
+
+func TestEventIDComparison(t *testing.T) {
+	ids := map[string]common.Hash{
+		"Complex":     ComplexEventID,
+		"IndexOnly":   IndexOnlyEventID,
+		"Transfer":    TransferEventID,
+		"UserCreated": UserCreatedEventID,
+	}
+
+	require.Equal(t, len(ComprehensiveTestABIDef.Events), len(ids))
+	for name, event := range ComprehensiveTestABIDef.Events {
+		id, ok := ids[name]
+		require.True(t, ok, "missing event ID of %s", name)
+		require.Equal(t, event.ID, id, name)
+	}
+}
+
+func TestIndexedHashTopics(t *testing.T) {
+	t.Run("Indexed string", func(t *testing.T) {
+		event := NewDynamicIndexedEvent("uatom")
+		topics, err := event.EncodeTopics()
+		require.NoError(t, err)
+
+		expected, err := ethabi.MakeTopics([]any{"uatom"})
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{DynamicIndexedEventTopic, expected[0][0]}, topics)
+
+		// the hash round-trips, the preimage is lost
+		var decoded DynamicIndexedEventIndexed
+		require.NoError(t, decoded.DecodeTopics(topics))
+		require.Equal(t, expected[0][0], decoded.Denom)
+		require.Nil(t, decoded.DenomPreimage)
+
+		// re-encoding without the preimage uses the hash
+		reencoded, err := decoded.EncodeTopics()
+		require.NoError(t, err)
+		require.Equal(t, topics, reencoded)
+	})
+
+	t.Run("Indexed bytes and static array", func(t *testing.T) {
+		owner := common.HexToAddress("0x1234567890123456789012345678901234567890")
+		data := []byte{1, 2, 3}
+		var balances [10]*big.Int
+		var balancesEncoded [320]byte
+		for i := range balances {
+			balances[i] = big.NewInt(int64(i))
+			balances[i].FillBytes(balancesEncoded[i*32 : (i+1)*32])
+		}
+		event := NewHashedIndexedEvent(data, balances, owner)
+		topics, err := event.EncodeTopics()
+		require.NoError(t, err)
+
+		expected, err := ethabi.MakeTopics([]any{data}, []any{owner})
+		require.NoError(t, err)
+		require.Equal(t, []common.Hash{
+			HashedIndexedEventTopic,
+			expected[0][0],
+			crypto.Keccak256Hash(balancesEncoded[:]),
+			expected[1][0],
+		}, topics)
+
+		var decoded HashedIndexedEventIndexed
+		require.NoError(t, decoded.DecodeTopics(topics))
+		require.Equal(t, expected[0][0], decoded.Data)
+		require.Equal(t, crypto.Keccak256Hash(balancesEncoded[:]), decoded.Balances)
+		require.Equal(t, owner, decoded.Owner)
+	})
+}
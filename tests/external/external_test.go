@@ -0,0 +1,51 @@
+package external
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi/tests/external/types"
+)
+
+// Coin is generated into the types fixture package and referenced by its import path from this package
+//go:generate go run ../../cmd -var CoinABI -output types/coin.abi.go -package types
+//go:generate go run ../../cmd -var ExternalTestABI -output external.abi.go -package external -external-tuples Coin=github.com/yihuang/go-abi/tests/external/types.Coin
+
+var CoinABI = []string{
+	"struct Coin { string denom; uint256 amount }",
+	"function coin(Coin coin)",
+}
+
+var ExternalTestABI = []string{
+	"struct Coin { string denom; uint256 amount }",
+	"function send(address to, Coin amount, Coin[] fees, Coin[2] pair) returns (Coin[])",
+}
+
+func TestQualifiedExternalTuple(t *testing.T) {
+	call := NewSendCall(
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		types.Coin{Denom: "atom", Amount: big.NewInt(100)},
+		[]types.Coin{{Denom: "fee", Amount: big.NewInt(1)}},
+		[2]types.Coin{{Denom: "a", Amount: big.NewInt(2)}, {Denom: "b", Amount: big.NewInt(3)}},
+	)
+
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	var decoded SendCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, &decoded)
+
+	ret := SendReturn{Field1: []types.Coin{{Denom: "atom", Amount: big.NewInt(99)}}}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+
+	var decodedRet SendReturn
+	_, err = decodedRet.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, ret, decodedRet)
+}
@@ -0,0 +1,51 @@
+package fragments
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+// the structs are declared once and used by the functions of the following variable, the JSON ABI
+// is merged into the same package
+//go:generate go run ../../cmd -input fragments_test.go -input ownable.abi.json -var CoinStructs -var BankABI -output fragments.abi.go -package fragments
+
+var CoinStructs = []string{
+	"struct Coin { string denom; uint256 amount; }",
+}
+
+var BankABI = []string{
+	"struct Balance { address owner; Coin[] coins; }",
+	"function balance(address owner) view returns (Balance)",
+	"function send(address to, Coin[] coins) returns (bool)",
+	"event Sent(address indexed to, Coin coin)",
+}
+
+func TestFragments(t *testing.T) {
+	coins := []Coin{{Denom: "atom", Amount: big.NewInt(100)}}
+
+	for _, call := range []interface {
+		EncodeWithSelector() ([]byte, error)
+	}{
+		NewSendCall(common.HexToAddress("0x01"), coins),
+		NewBalanceCall(common.HexToAddress("0x02")),
+		NewTransferOwnershipCall(common.HexToAddress("0x03")),
+		NewOwnerCall(),
+	} {
+		data, err := call.EncodeWithSelector()
+		require.NoError(t, err)
+		decoded, err := DecodeBySelector(data)
+		require.NoError(t, err)
+		require.Equal(t, call, decoded)
+	}
+
+	ret := BalanceReturn{Field1: Balance{Owner: common.HexToAddress("0x02"), Coins: coins}}
+	data, err := ret.Encode()
+	require.NoError(t, err)
+	var decoded BalanceReturn
+	_, err = decoded.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, ret, decoded)
+}
@@ -0,0 +1,93 @@
+package iface
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+// the interface lists all the functions with the arguments and return values expanded
+//go:generate go run ../../cmd -var IfaceTestABI -output iface.abi.go -package iface -caller Vault -iface
+
+var IfaceTestABI = []string{
+	"function balanceOf(address owner) view returns (uint256 balance)",
+	"function reserves() view returns (uint112 reserve0, uint112 reserve1, uint32 timestamp)",
+	"function price(uint256 amount) view returns (uint256 amount, bool)",
+	"function deposit(address to, uint256 amount) returns (uint256 shares)",
+	"function pause()",
+	"function lookup(bytes32 type) view returns (string)",
+}
+
+func TestInterfaceMethodSet(t *testing.T) {
+	expected := map[string]string{
+		"BalanceOf": "func(context.Context, common.Address) (*big.Int, error)",
+		"Reserves":  "func(context.Context) (*big.Int, *big.Int, uint32, error)",
+		"Price":     "func(context.Context, *big.Int) (*big.Int, bool, error)",
+		"Deposit":   "func(context.Context, common.Address, *big.Int) (*big.Int, error)",
+		"Pause":     "func(context.Context) error",
+		"Lookup":    "func(context.Context, [32]uint8) (string, error)",
+	}
+
+	iface := reflect.TypeOf((*VaultInterface)(nil)).Elem()
+	methods := make(map[string]string, iface.NumMethod())
+	for i := 0; i < iface.NumMethod(); i++ {
+		method := iface.Method(i)
+		methods[method.Name] = method.Type.String()
+	}
+	require.Equal(t, expected, methods)
+
+	require.True(t, reflect.TypeOf(&VaultCaller{}).Implements(iface))
+}
+
+// mockBackend returns canned output for the calldata
+type mockBackend struct {
+	outputs map[string][]byte
+}
+
+func (m *mockBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return m.outputs[string(call.Data)], nil
+}
+
+func TestCallerExpanded(t *testing.T) {
+	ctx := context.Background()
+	addr := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	owner := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	reserves := ReservesReturn{Reserve0: big.NewInt(1), Reserve1: big.NewInt(2), Timestamp: 3}
+	reservesOutput, err := reserves.Encode()
+	require.NoError(t, err)
+	shares := DepositReturn{Shares: big.NewInt(4)}
+	sharesOutput, err := shares.Encode()
+	require.NoError(t, err)
+
+	reservesCall, err := NewReservesCall().EncodeWithSelector()
+	require.NoError(t, err)
+	depositCall, err := NewDepositCall(owner, big.NewInt(5)).EncodeWithSelector()
+	require.NoError(t, err)
+
+	var vault VaultInterface = NewVaultCaller(&mockBackend{outputs: map[string][]byte{
+		string(reservesCall): reservesOutput,
+		string(depositCall):  sharesOutput,
+	}}, addr)
+
+	reserve0, reserve1, timestamp, err := vault.Reserves(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), reserve0)
+	require.Equal(t, big.NewInt(2), reserve1)
+	require.Equal(t, uint32(3), timestamp)
+
+	// the state changing function is simulated
+	deposited, err := vault.Deposit(ctx, owner, big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(4), deposited)
+
+	// the empty output of an unknown call fails to decode
+	_, err = vault.BalanceOf(ctx, owner)
+	require.Error(t, err)
+	require.NoError(t, vault.Pause(ctx))
+}
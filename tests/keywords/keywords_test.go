@@ -0,0 +1,72 @@
+package keywords
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var KeywordsTestABI -output keywords.abi.go -client Keywords -caller Keywords -name-tuples-by-function
+
+// KeywordsTestABI uses Go keywords and empty strings as parameter names, the generated code must compile
+var KeywordsTestABI = []string{
+	"function set(uint8 type, address func, uint256, (uint8 map, bool go) range) returns (bool, string chan)",
+	"event Updated(uint8 indexed type, address indexed, uint256 func, bool)",
+}
+
+var KeywordsTestABIDef ethabi.ABI
+
+func init() {
+	var err error
+	abiJSON, err := abi.ParseHumanReadableABI(KeywordsTestABI)
+	if err != nil {
+		panic(err)
+	}
+	KeywordsTestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestKeywordArguments(t *testing.T) {
+	to := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	call := NewSetCall(1, to, big.NewInt(2), SetParam3{})
+	call.Range.Map = 3
+	call.Range.Go = true
+
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	expected, err := KeywordsTestABIDef.Pack("set", uint8(1), to, big.NewInt(2), struct {
+		Map uint8
+		Go  bool
+	}{3, true})
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	var decoded SetCall
+	_, err = decoded.DecodeWithSelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, *call, decoded)
+}
+
+func TestKeywordEvent(t *testing.T) {
+	from := common.HexToAddress("0x2000000000000000000000000000000000000000")
+	event := NewUpdatedEvent(1, from, big.NewInt(2), true)
+
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	data, err := event.Encode()
+	require.NoError(t, err)
+
+	var decoded UpdatedEvent
+	require.NoError(t, decoded.DecodeTopics(topics))
+	_, err = decoded.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, *event, decoded)
+}
@@ -0,0 +1,84 @@
+package layout
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+//go:generate go run ../../cmd -var LayoutTestABI -output layout.abi.go -package layout -layout
+
+var LayoutTestABI = []string{
+	"struct Point { uint256 x; uint256 y }",
+	"function transfer(address to, uint256 amount)",
+	"function transferWithMemo(address to, string memo, uint256 amount, bytes data, uint64 deadline, Point point)",
+}
+
+var receiver = common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+func TestLayoutPatchStaticField(t *testing.T) {
+	call := NewTransferCall(receiver, big.NewInt(100))
+	buf := make([]byte, call.EncodedSize())
+	layout, err := call.EncodeToDetailed(buf)
+	require.NoError(t, err)
+	require.Equal(t, 64, layout.StaticLen)
+	require.Equal(t, 0, layout.DynamicLen)
+	require.Len(t, layout.Fields, 2)
+
+	// same bytes as EncodeTo
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+	require.Equal(t, encoded, buf)
+
+	// patch the amount word in place
+	amount := layout.Fields[1]
+	require.Equal(t, 32, amount.Offset)
+	require.Equal(t, 32, amount.Length)
+	big.NewInt(200).FillBytes(buf[amount.Offset : amount.Offset+amount.Length])
+
+	var decoded TransferCall
+	_, err = decoded.Decode(buf)
+	require.NoError(t, err)
+	require.Equal(t, receiver, decoded.To)
+	require.Equal(t, big.NewInt(200), decoded.Amount)
+}
+
+func TestLayoutDynamicFields(t *testing.T) {
+	call := NewTransferWithMemoCall(receiver, "hello", big.NewInt(100), []byte{1, 2, 3}, 1000, Point{X: big.NewInt(1), Y: big.NewInt(2)})
+	buf := make([]byte, call.EncodedSize())
+	layout, err := call.EncodeToDetailed(buf)
+	require.NoError(t, err)
+	require.Equal(t, TransferWithMemoCallStaticSize, layout.StaticLen)
+	require.Equal(t, len(buf), layout.Len())
+
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+	require.Equal(t, encoded, buf)
+
+	// the static fields are in the head, the dynamic ones in the tail, one after another
+	fields := layout.Fields
+	require.Len(t, fields, 6)
+	require.Equal(t, 0, fields[0].Offset)
+	require.Equal(t, 64, fields[2].Offset)
+	require.Equal(t, 128, fields[4].Offset)
+	require.Equal(t, 160, fields[5].Offset)
+	require.Equal(t, 64, fields[5].Length)
+	require.Equal(t, layout.StaticLen, fields[1].Offset)
+	require.Equal(t, 64, fields[1].Length) // length word and the padded string
+	require.Equal(t, fields[1].Offset+fields[1].Length, fields[3].Offset)
+	require.Equal(t, len(buf), fields[3].Offset+fields[3].Length)
+	require.Equal(t, []byte{1, 2, 3}, buf[fields[3].Offset+32:fields[3].Offset+35])
+
+	// patch the deadline word in place
+	deadline := fields[4]
+	new(big.Int).SetUint64(2000).FillBytes(buf[deadline.Offset : deadline.Offset+deadline.Length])
+
+	var decoded TransferWithMemoCall
+	_, err = decoded.Decode(buf)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), decoded.Deadline)
+	require.Equal(t, "hello", decoded.Memo)
+	require.Equal(t, []byte{1, 2, 3}, decoded.Data)
+}
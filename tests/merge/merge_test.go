@@ -0,0 +1,41 @@
+package merge
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+// the ABIs of both inputs are merged into one package, the identical items are generated once
+//go:generate go run ../../cmd -input token.abi.json -input vault.abi.json -output merge.abi.go -package merge
+
+func TestMergeSharedTuple(t *testing.T) {
+	coin := Coin{Denom: "atom", Amount: big.NewInt(100)}
+
+	for _, call := range []interface {
+		EncodeWithSelector() ([]byte, error)
+	}{
+		NewTransferCall(common.HexToAddress("0x01"), coin),
+		NewDepositCall([]Coin{coin, coin}),
+		NewOwnerCall(),
+	} {
+		data, err := call.EncodeWithSelector()
+		require.NoError(t, err)
+		decoded, err := DecodeBySelector(data)
+		require.NoError(t, err)
+		require.Equal(t, call, decoded)
+	}
+}
+
+func TestMergeSharedEvent(t *testing.T) {
+	event := NewOwnershipTransferredEvent(common.HexToAddress("0x01"), common.HexToAddress("0x02"))
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	require.Equal(t, OwnershipTransferredEventTopic, topics[0])
+
+	var decoded OwnershipTransferredEvent
+	require.NoError(t, decoded.DecodeTopics(topics))
+	require.Equal(t, event.OwnershipTransferredEventIndexed, decoded.OwnershipTransferredEventIndexed)
+}
@@ -0,0 +1,79 @@
+package mixed
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/holiman/uint256"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+	"github.com/yihuang/go-abi/tests/mixed/bigint"
+	"github.com/yihuang/go-abi/tests/mixed/u256"
+)
+
+// the same ABI generated with both integer representations, compiled together without build tags
+//go:generate go run ../../cmd -var MixedTestABI -output bigint/mixed.abi.go -package bigint
+//go:generate go run ../../cmd -var MixedTestABI -output u256/mixed.abi.go -package u256 -uint256
+
+var MixedTestABI = []string{
+	"struct Order { address maker; uint256 amount; uint128[] fees }",
+	"function place(Order order, uint256[] amounts, uint72 small, uint256[2] pair) returns (uint256)",
+}
+
+func TestMixedRepresentations(t *testing.T) {
+	maker := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	bigCall := bigint.NewPlaceCall(
+		bigint.Order{Maker: maker, Amount: big.NewInt(1000), Fees: []*big.Int{big.NewInt(1), big.NewInt(2)}},
+		[]*big.Int{big.NewInt(3), new(big.Int).Lsh(big.NewInt(1), 255)},
+		big.NewInt(4),
+		[2]*big.Int{big.NewInt(5), big.NewInt(6)},
+	)
+	u256Call := u256.NewPlaceCall(
+		u256.Order{Maker: maker, Amount: uint256.NewInt(1000), Fees: []*uint256.Int{uint256.NewInt(1), uint256.NewInt(2)}},
+		[]*uint256.Int{uint256.NewInt(3), new(uint256.Int).Lsh(uint256.NewInt(1), 255)},
+		uint256.NewInt(4),
+		[2]*uint256.Int{uint256.NewInt(5), uint256.NewInt(6)},
+	)
+
+	bigEncoded, err := bigCall.EncodeWithSelector()
+	require.NoError(t, err)
+	u256Encoded, err := u256Call.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, bigEncoded, u256Encoded)
+
+	// decode across representations
+	var bigDecoded bigint.PlaceCall
+	_, err = bigDecoded.DecodeWithSelector(u256Encoded)
+	require.NoError(t, err)
+	require.Equal(t, bigCall, &bigDecoded)
+
+	var u256Decoded u256.PlaceCall
+	_, err = u256Decoded.DecodeWithSelector(bigEncoded)
+	require.NoError(t, err)
+	require.Equal(t, u256Call, &u256Decoded)
+
+	bigRet := bigint.PlaceReturn{Field1: big.NewInt(7)}
+	u256Ret := u256.PlaceReturn{Field1: uint256.NewInt(7)}
+	bigEncoded, err = bigRet.Encode()
+	require.NoError(t, err)
+	u256Encoded, err = u256Ret.Encode()
+	require.NoError(t, err)
+	require.Equal(t, bigEncoded, u256Encoded)
+}
+
+func TestMixedNilInteger(t *testing.T) {
+	// a forgotten amount is an error in both representations instead of a panic
+	bigCall := bigint.PlaceCall{Order: bigint.Order{Fees: []*big.Int{}}, Small: big.NewInt(1)}
+	_, err := bigCall.Encode()
+	require.Equal(t, abi.ErrNilInteger, err)
+
+	u256Call := u256.PlaceCall{Order: u256.Order{Fees: []*uint256.Int{}}, Small: uint256.NewInt(1)}
+	_, err = u256Call.Encode()
+	require.Equal(t, abi.ErrNilInteger, err)
+
+	_, err = abi.PackedEncodeUint128U256(nil, make([]byte, 16))
+	require.Equal(t, abi.ErrNilInteger, err)
+}
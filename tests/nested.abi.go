@@ -1,11 +1,12 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: 24cfb9f072eb3c1b8f633c9c858e4ea1c1e9982f45b236c94273ea1d2c103cfd
 
 package tests
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -48,9 +49,20 @@ const (
 	GetUsersArrayID        = 2583589359
 )
 
-const AddressStringPairStaticSize = 64
+// Canonical function signatures
+const (
+	GetAddressStringPairSignature = "getAddressStringPair()"
+	GetComplexNestedSignature     = "getComplexNested()"
+	GetDeeplyNestedSignature      = "getDeeplyNested()"
+	GetMultipleReturnsSignature   = "getMultipleReturns()"
+	GetNestedTupleArraySignature  = "getNestedTupleArray()"
+	GetSimplePairSignature        = "getSimplePair()"
+	GetTupleArraySignature        = "getTupleArray()"
+	GetUserWithMetadataSignature  = "getUserWithMetadata()"
+	GetUsersArraySignature        = "getUsersArray()"
+)
 
-var _ abi.Tuple = (*AddressStringPair)(nil)
+const AddressStringPairStaticSize = 64
 
 // AddressStringPair represents an ABI tuple
 type AddressStringPair struct {
@@ -81,6 +93,7 @@ func (value AddressStringPair) EncodeTo(buf []byte) (int, error) {
 
 	// Field Str: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Str, buf[dynamicOffset:])
@@ -135,9 +148,38 @@ func (t *AddressStringPair) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const ComplexNestedStaticSize = 128
+// DecodeStrict decodes AddressStringPair from ABI bytes, rejecting unexpected trailing bytes
+func (t *AddressStringPair) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*ComplexNested)(nil)
+// DecodeHex decodes AddressStringPair from the hex string with an optional 0x prefix
+func (t *AddressStringPair) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode AddressStringPair: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of AddressStringPair
+func (value AddressStringPair) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *AddressStringPair) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*AddressStringPair)(nil)
+var _ abi.Decoder = (*AddressStringPair)(nil)
+
+const ComplexNestedStaticSize = 128
 
 // ComplexNested represents an ABI tuple
 type ComplexNested struct {
@@ -176,6 +218,7 @@ func (value ComplexNested) EncodeTo(buf []byte) (int, error) {
 
 	// Field Str: string
 	// Encode offset pointer
+	abi.ClearWord(buf[64:])
 	binary.BigEndian.PutUint64(buf[64+24:64+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Str, buf[dynamicOffset:])
@@ -186,6 +229,7 @@ func (value ComplexNested) EncodeTo(buf []byte) (int, error) {
 
 	// Field Data: bytes
 	// Encode offset pointer
+	abi.ClearWord(buf[96:])
 	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeBytes(value.Data, buf[dynamicOffset:])
@@ -218,7 +262,7 @@ func (t *ComplexNested) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 128
 	// Decode static field Num: uint256
-	t.Num, _, err = abi.DecodeUint256(data[0:])
+	t.Num, _, err = abi.DecodeIntoUint256(t.Num, data[0:])
 	if err != nil {
 		return 0, err
 	}
@@ -260,9 +304,38 @@ func (t *ComplexNested) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const DeeplyNestedStaticSize = 160
+// DecodeStrict decodes ComplexNested from ABI bytes, rejecting unexpected trailing bytes
+func (t *ComplexNested) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*DeeplyNested)(nil)
+// DecodeHex decodes ComplexNested from the hex string with an optional 0x prefix
+func (t *ComplexNested) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ComplexNested: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of ComplexNested
+func (value ComplexNested) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *ComplexNested) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*ComplexNested)(nil)
+var _ abi.Decoder = (*ComplexNested)(nil)
+
+const DeeplyNestedStaticSize = 160
 
 // DeeplyNested represents an ABI tuple
 type DeeplyNested struct {
@@ -296,6 +369,7 @@ func (value DeeplyNested) EncodeTo(buf []byte) (int, error) {
 
 	// Field Str: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Str, buf[dynamicOffset:])
@@ -343,7 +417,7 @@ func (t *DeeplyNested) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 160
 	// Decode static field Num: uint256
-	t.Num, _, err = abi.DecodeUint256(data[0:])
+	t.Num, _, err = abi.DecodeIntoUint256(t.Num, data[0:])
 	if err != nil {
 		return 0, err
 	}
@@ -380,10 +454,38 @@ func (t *DeeplyNested) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const SimplePairStaticSize = 64
+// DecodeStrict decodes DeeplyNested from ABI bytes, rejecting unexpected trailing bytes
+func (t *DeeplyNested) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*SimplePair)(nil)
-var _ abi.PackedTuple = (*SimplePair)(nil)
+// DecodeHex decodes DeeplyNested from the hex string with an optional 0x prefix
+func (t *DeeplyNested) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode DeeplyNested: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of DeeplyNested
+func (value DeeplyNested) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *DeeplyNested) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*DeeplyNested)(nil)
+var _ abi.Decoder = (*DeeplyNested)(nil)
+
+const SimplePairStaticSize = 64
 
 // SimplePair represents an ABI tuple
 type SimplePair struct {
@@ -434,18 +536,46 @@ func (t *SimplePair) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 64
 	// Decode static field First: uint256
-	t.First, _, err = abi.DecodeUint256(data[0:])
+	t.First, _, err = abi.DecodeIntoUint256(t.First, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Second: uint256
-	t.Second, _, err = abi.DecodeUint256(data[32:])
+	t.Second, _, err = abi.DecodeIntoUint256(t.Second, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SimplePair from ABI bytes, rejecting unexpected trailing bytes
+func (t *SimplePair) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes SimplePair from the hex string with an optional 0x prefix
+func (t *SimplePair) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SimplePair: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of SimplePair
+func (value SimplePair) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *SimplePair) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of SimplePair
 func (t SimplePair) PackedEncodedSize() int {
 	return 64
@@ -504,9 +634,11 @@ func (t *SimplePair) PackedDecode(data []byte) (int, error) {
 	return 64, nil
 }
 
-const UserWithMetadataStaticSize = 128
+var _ abi.Tuple = (*SimplePair)(nil)
+var _ abi.Decoder = (*SimplePair)(nil)
+var _ abi.PackedTuple = (*SimplePair)(nil)
 
-var _ abi.Tuple = (*UserWithMetadata)(nil)
+const UserWithMetadataStaticSize = 128
 
 // UserWithMetadata represents an ABI tuple
 type UserWithMetadata struct {
@@ -535,6 +667,7 @@ func (value UserWithMetadata) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Name: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
@@ -555,6 +688,7 @@ func (value UserWithMetadata) EncodeTo(buf []byte) (int, error) {
 
 	// Field Metadata: string
 	// Encode offset pointer
+	abi.ClearWord(buf[96:])
 	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Metadata, buf[dynamicOffset:])
@@ -602,12 +736,12 @@ func (t *UserWithMetadata) Decode(data []byte) (int, error) {
 		dynamicOffset += n
 	}
 	// Decode static field Id: uint256
-	t.Id, _, err = abi.DecodeUint256(data[32:])
+	t.Id, _, err = abi.DecodeIntoUint256(t.Id, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	// Decode static field Age: uint256
-	t.Age, _, err = abi.DecodeUint256(data[64:])
+	t.Age, _, err = abi.DecodeIntoUint256(t.Age, data[64:])
 	if err != nil {
 		return 0, err
 	}
@@ -629,9 +763,41 @@ func (t *UserWithMetadata) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UserWithMetadata from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserWithMetadata) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UserWithMetadata from the hex string with an optional 0x prefix
+func (t *UserWithMetadata) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UserWithMetadata: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of UserWithMetadata
+func (value UserWithMetadata) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *UserWithMetadata) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*UserWithMetadata)(nil)
+var _ abi.Decoder = (*UserWithMetadata)(nil)
+
 // NestedEncodeAddressStringPairSlice encodes (address,string)[] to ABI bytes
 func NestedEncodeAddressStringPairSlice(value []AddressStringPair, buf []byte) (int, error) {
 	// Encode length
+	abi.ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -640,6 +806,7 @@ func NestedEncodeAddressStringPairSlice(value []AddressStringPair, buf []byte) (
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		abi.ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -657,6 +824,7 @@ func NestedEncodeAddressStringPairSlice(value []AddressStringPair, buf []byte) (
 // NestedEncodeComplexNestedSlice encodes (uint256,address,string,bytes)[] to ABI bytes
 func NestedEncodeComplexNestedSlice(value []ComplexNested, buf []byte) (int, error) {
 	// Encode length
+	abi.ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -665,6 +833,7 @@ func NestedEncodeComplexNestedSlice(value []ComplexNested, buf []byte) (int, err
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		abi.ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -682,6 +851,7 @@ func NestedEncodeComplexNestedSlice(value []ComplexNested, buf []byte) (int, err
 // NestedEncodeSimplePairSlice encodes (uint256,uint256)[] to ABI bytes
 func NestedEncodeSimplePairSlice(value []SimplePair, buf []byte) (int, error) {
 	// Encode length
+	abi.ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -724,6 +894,11 @@ func NestedSizeSimplePairSlice(value []SimplePair) int {
 
 // NestedDecodeAddressStringPairSlice decodes (address,string)[] from ABI bytes
 func NestedDecodeAddressStringPairSlice(data []byte) ([]AddressStringPair, int, error) {
+	return NestedDecodeIntoAddressStringPairSlice(nil, data)
+}
+
+// NestedDecodeIntoAddressStringPairSlice decodes (address,string)[] from ABI bytes, reusing the backing array of dst
+func NestedDecodeIntoAddressStringPairSlice(dst []AddressStringPair, data []byte) ([]AddressStringPair, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -733,7 +908,7 @@ func NestedDecodeAddressStringPairSlice(data []byte) ([]AddressStringPair, int,
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -741,7 +916,7 @@ func NestedDecodeAddressStringPairSlice(data []byte) ([]AddressStringPair, int,
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([]AddressStringPair, length)
+	result := abi.ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := abi.DecodeSize(data[offset:])
@@ -764,6 +939,11 @@ func NestedDecodeAddressStringPairSlice(data []byte) ([]AddressStringPair, int,
 
 // NestedDecodeComplexNestedSlice decodes (uint256,address,string,bytes)[] from ABI bytes
 func NestedDecodeComplexNestedSlice(data []byte) ([]ComplexNested, int, error) {
+	return NestedDecodeIntoComplexNestedSlice(nil, data)
+}
+
+// NestedDecodeIntoComplexNestedSlice decodes (uint256,address,string,bytes)[] from ABI bytes, reusing the backing array of dst
+func NestedDecodeIntoComplexNestedSlice(dst []ComplexNested, data []byte) ([]ComplexNested, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -773,7 +953,7 @@ func NestedDecodeComplexNestedSlice(data []byte) ([]ComplexNested, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -781,7 +961,7 @@ func NestedDecodeComplexNestedSlice(data []byte) ([]ComplexNested, int, error) {
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([]ComplexNested, length)
+	result := abi.ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := abi.DecodeSize(data[offset:])
@@ -804,6 +984,11 @@ func NestedDecodeComplexNestedSlice(data []byte) ([]ComplexNested, int, error) {
 
 // NestedDecodeSimplePairSlice decodes (uint256,uint256)[] from ABI bytes
 func NestedDecodeSimplePairSlice(data []byte) ([]SimplePair, int, error) {
+	return NestedDecodeIntoSimplePairSlice(nil, data)
+}
+
+// NestedDecodeIntoSimplePairSlice decodes (uint256,uint256)[] from ABI bytes, reusing the backing array of dst
+func NestedDecodeIntoSimplePairSlice(dst []SimplePair, data []byte) ([]SimplePair, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -813,7 +998,7 @@ func NestedDecodeSimplePairSlice(data []byte) ([]SimplePair, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*64 > len(data) {
+	if length > len(data)/64 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -821,7 +1006,7 @@ func NestedDecodeSimplePairSlice(data []byte) ([]SimplePair, int, error) {
 		offset int
 	)
 	// Decode elements with static types
-	result := make([]SimplePair, length)
+	result := abi.ResizeSlice(dst, length)
 	for i := 0; i < length; i++ {
 		n, err = result[i].Decode(data[offset:])
 		if err != nil {
@@ -832,6 +1017,93 @@ func NestedDecodeSimplePairSlice(data []byte) ([]SimplePair, int, error) {
 	return result, offset + 32, nil
 }
 
+// NestedEncodeTopLevelAddressStringPairSlice encodes (address,string)[] to ABI bytes as a single top-level value, including the leading offset word
+func NestedEncodeTopLevelAddressStringPairSlice(value []AddressStringPair) ([]byte, error) {
+	buf := make([]byte, 32+NestedSizeAddressStringPairSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := NestedEncodeAddressStringPairSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// NestedDecodeTopLevelAddressStringPairSlice decodes (address,string)[] from ABI bytes of a single top-level value, including the leading offset word
+func NestedDecodeTopLevelAddressStringPairSlice(data []byte) ([]AddressStringPair, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := NestedDecodeAddressStringPairSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// NestedEncodeTopLevelComplexNestedSlice encodes (uint256,address,string,bytes)[] to ABI bytes as a single top-level value, including the leading offset word
+func NestedEncodeTopLevelComplexNestedSlice(value []ComplexNested) ([]byte, error) {
+	buf := make([]byte, 32+NestedSizeComplexNestedSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := NestedEncodeComplexNestedSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// NestedDecodeTopLevelComplexNestedSlice decodes (uint256,address,string,bytes)[] from ABI bytes of a single top-level value, including the leading offset word
+func NestedDecodeTopLevelComplexNestedSlice(data []byte) ([]ComplexNested, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := NestedDecodeComplexNestedSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// NestedEncodeTopLevelSimplePairSlice encodes (uint256,uint256)[] to ABI bytes as a single top-level value, including the leading offset word
+func NestedEncodeTopLevelSimplePairSlice(value []SimplePair) ([]byte, error) {
+	buf := make([]byte, 32+NestedSizeSimplePairSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := NestedEncodeSimplePairSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// NestedDecodeTopLevelSimplePairSlice decodes (uint256,uint256)[] from ABI bytes of a single top-level value, including the leading offset word
+func NestedDecodeTopLevelSimplePairSlice(data []byte) ([]SimplePair, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := NestedDecodeSimplePairSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
 var _ abi.Method = (*GetAddressStringPairCall)(nil)
 
 // GetAddressStringPairCall represents the input arguments for getAddressStringPair function
@@ -854,9 +1126,14 @@ func (t GetAddressStringPairCall) GetMethodSelector() [4]byte {
 	return GetAddressStringPairSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getAddressStringPair arguments including function selector
+func (t GetAddressStringPairCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getAddressStringPair arguments to ABI bytes including function selector
 func (t GetAddressStringPairCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetAddressStringPairSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -864,6 +1141,59 @@ func (t GetAddressStringPairCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getAddressStringPair arguments to 0x prefixed hex string
+func (t GetAddressStringPairCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getAddressStringPair arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetAddressStringPairCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getAddressStringPair calldata, returns 0 if encoding fails
+func (t GetAddressStringPairCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getAddressStringPair arguments from ABI bytes including function selector
+func (t *GetAddressStringPairCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetAddressStringPairSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getAddressStringPair arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetAddressStringPairCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetAddressStringPairCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetAddressStringPairCall constructs a new GetAddressStringPairCall
 func NewGetAddressStringPairCall() *GetAddressStringPairCall {
 	return &GetAddressStringPairCall{}
@@ -871,8 +1201,6 @@ func NewGetAddressStringPairCall() *GetAddressStringPairCall {
 
 const GetAddressStringPairReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetAddressStringPairReturn)(nil)
-
 // GetAddressStringPairReturn represents an ABI tuple
 type GetAddressStringPairReturn struct {
 	Field1 AddressStringPair
@@ -896,6 +1224,7 @@ func (value GetAddressStringPairReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (address,string)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Field1.EncodeTo(buf[dynamicOffset:])
@@ -945,6 +1274,69 @@ func (t *GetAddressStringPairReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetAddressStringPairReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetAddressStringPairReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetAddressStringPairReturn from the hex string with an optional 0x prefix
+func (t *GetAddressStringPairReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetAddressStringPairReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetAddressStringPairReturn
+func (value GetAddressStringPairReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetAddressStringPairReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetAddressStringPairReturn)(nil)
+var _ abi.Decoder = (*GetAddressStringPairReturn)(nil)
+
+// DecodeGetAddressStringPairReturn decodes the return data of getAddressStringPair into its values
+func DecodeGetAddressStringPairReturn(data []byte) (r1 AddressStringPair, err error) {
+	var result GetAddressStringPairReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetAddressStringPair decodes the single return value of getAddressStringPair
+func DecodeGetAddressStringPair(data []byte) (AddressStringPair, error) {
+	return DecodeGetAddressStringPairReturn(data)
+}
+
+// DecodeGetAddressStringPairHex decodes the single return value of getAddressStringPair from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetAddressStringPairHex(s string) (AddressStringPair, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero AddressStringPair
+		return zero, fmt.Errorf("decode GetAddressStringPairReturn: %w", err)
+	}
+	return DecodeGetAddressStringPairReturn(data)
+}
+
+// EncodeGetAddressStringPairResult encodes the single return value of getAddressStringPair, e.g. for the return data of precompiles
+func EncodeGetAddressStringPairResult(v AddressStringPair) ([]byte, error) {
+	result := GetAddressStringPairReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetComplexNestedCall)(nil)
 
 // GetComplexNestedCall represents the input arguments for getComplexNested function
@@ -967,9 +1359,14 @@ func (t GetComplexNestedCall) GetMethodSelector() [4]byte {
 	return GetComplexNestedSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getComplexNested arguments including function selector
+func (t GetComplexNestedCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getComplexNested arguments to ABI bytes including function selector
 func (t GetComplexNestedCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetComplexNestedSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -977,6 +1374,59 @@ func (t GetComplexNestedCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getComplexNested arguments to 0x prefixed hex string
+func (t GetComplexNestedCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getComplexNested arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetComplexNestedCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getComplexNested calldata, returns 0 if encoding fails
+func (t GetComplexNestedCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getComplexNested arguments from ABI bytes including function selector
+func (t *GetComplexNestedCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetComplexNestedSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getComplexNested arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetComplexNestedCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetComplexNestedCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetComplexNestedCall constructs a new GetComplexNestedCall
 func NewGetComplexNestedCall() *GetComplexNestedCall {
 	return &GetComplexNestedCall{}
@@ -984,8 +1434,6 @@ func NewGetComplexNestedCall() *GetComplexNestedCall {
 
 const GetComplexNestedReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetComplexNestedReturn)(nil)
-
 // GetComplexNestedReturn represents an ABI tuple
 type GetComplexNestedReturn struct {
 	Field1 ComplexNested
@@ -1009,6 +1457,7 @@ func (value GetComplexNestedReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (uint256,address,string,bytes)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Field1.EncodeTo(buf[dynamicOffset:])
@@ -1058,6 +1507,69 @@ func (t *GetComplexNestedReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetComplexNestedReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetComplexNestedReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetComplexNestedReturn from the hex string with an optional 0x prefix
+func (t *GetComplexNestedReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetComplexNestedReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetComplexNestedReturn
+func (value GetComplexNestedReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetComplexNestedReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetComplexNestedReturn)(nil)
+var _ abi.Decoder = (*GetComplexNestedReturn)(nil)
+
+// DecodeGetComplexNestedReturn decodes the return data of getComplexNested into its values
+func DecodeGetComplexNestedReturn(data []byte) (r1 ComplexNested, err error) {
+	var result GetComplexNestedReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetComplexNested decodes the single return value of getComplexNested
+func DecodeGetComplexNested(data []byte) (ComplexNested, error) {
+	return DecodeGetComplexNestedReturn(data)
+}
+
+// DecodeGetComplexNestedHex decodes the single return value of getComplexNested from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetComplexNestedHex(s string) (ComplexNested, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero ComplexNested
+		return zero, fmt.Errorf("decode GetComplexNestedReturn: %w", err)
+	}
+	return DecodeGetComplexNestedReturn(data)
+}
+
+// EncodeGetComplexNestedResult encodes the single return value of getComplexNested, e.g. for the return data of precompiles
+func EncodeGetComplexNestedResult(v ComplexNested) ([]byte, error) {
+	result := GetComplexNestedReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetDeeplyNestedCall)(nil)
 
 // GetDeeplyNestedCall represents the input arguments for getDeeplyNested function
@@ -1080,9 +1592,14 @@ func (t GetDeeplyNestedCall) GetMethodSelector() [4]byte {
 	return GetDeeplyNestedSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getDeeplyNested arguments including function selector
+func (t GetDeeplyNestedCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getDeeplyNested arguments to ABI bytes including function selector
 func (t GetDeeplyNestedCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetDeeplyNestedSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1090,14 +1607,65 @@ func (t GetDeeplyNestedCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
-// NewGetDeeplyNestedCall constructs a new GetDeeplyNestedCall
-func NewGetDeeplyNestedCall() *GetDeeplyNestedCall {
-	return &GetDeeplyNestedCall{}
+// EncodeHex encodes getDeeplyNested arguments to 0x prefixed hex string
+func (t GetDeeplyNestedCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const GetDeeplyNestedReturnStaticSize = 32
+// EncodeHexWithSelector encodes getDeeplyNested arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetDeeplyNestedCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*GetDeeplyNestedReturn)(nil)
+// CalldataCost returns the gas cost of the getDeeplyNested calldata, returns 0 if encoding fails
+func (t GetDeeplyNestedCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getDeeplyNested arguments from ABI bytes including function selector
+func (t *GetDeeplyNestedCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetDeeplyNestedSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getDeeplyNested arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetDeeplyNestedCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetDeeplyNestedCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewGetDeeplyNestedCall constructs a new GetDeeplyNestedCall
+func NewGetDeeplyNestedCall() *GetDeeplyNestedCall {
+	return &GetDeeplyNestedCall{}
+}
+
+const GetDeeplyNestedReturnStaticSize = 32
 
 // GetDeeplyNestedReturn represents an ABI tuple
 type GetDeeplyNestedReturn struct {
@@ -1122,6 +1690,7 @@ func (value GetDeeplyNestedReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (uint256,string,bool,address,bytes32)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Field1.EncodeTo(buf[dynamicOffset:])
@@ -1171,6 +1740,69 @@ func (t *GetDeeplyNestedReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetDeeplyNestedReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetDeeplyNestedReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetDeeplyNestedReturn from the hex string with an optional 0x prefix
+func (t *GetDeeplyNestedReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetDeeplyNestedReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetDeeplyNestedReturn
+func (value GetDeeplyNestedReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetDeeplyNestedReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetDeeplyNestedReturn)(nil)
+var _ abi.Decoder = (*GetDeeplyNestedReturn)(nil)
+
+// DecodeGetDeeplyNestedReturn decodes the return data of getDeeplyNested into its values
+func DecodeGetDeeplyNestedReturn(data []byte) (r1 DeeplyNested, err error) {
+	var result GetDeeplyNestedReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetDeeplyNested decodes the single return value of getDeeplyNested
+func DecodeGetDeeplyNested(data []byte) (DeeplyNested, error) {
+	return DecodeGetDeeplyNestedReturn(data)
+}
+
+// DecodeGetDeeplyNestedHex decodes the single return value of getDeeplyNested from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetDeeplyNestedHex(s string) (DeeplyNested, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero DeeplyNested
+		return zero, fmt.Errorf("decode GetDeeplyNestedReturn: %w", err)
+	}
+	return DecodeGetDeeplyNestedReturn(data)
+}
+
+// EncodeGetDeeplyNestedResult encodes the single return value of getDeeplyNested, e.g. for the return data of precompiles
+func EncodeGetDeeplyNestedResult(v DeeplyNested) ([]byte, error) {
+	result := GetDeeplyNestedReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetMultipleReturnsCall)(nil)
 
 // GetMultipleReturnsCall represents the input arguments for getMultipleReturns function
@@ -1193,9 +1825,14 @@ func (t GetMultipleReturnsCall) GetMethodSelector() [4]byte {
 	return GetMultipleReturnsSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getMultipleReturns arguments including function selector
+func (t GetMultipleReturnsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getMultipleReturns arguments to ABI bytes including function selector
 func (t GetMultipleReturnsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetMultipleReturnsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1203,6 +1840,59 @@ func (t GetMultipleReturnsCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getMultipleReturns arguments to 0x prefixed hex string
+func (t GetMultipleReturnsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getMultipleReturns arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetMultipleReturnsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getMultipleReturns calldata, returns 0 if encoding fails
+func (t GetMultipleReturnsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getMultipleReturns arguments from ABI bytes including function selector
+func (t *GetMultipleReturnsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetMultipleReturnsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getMultipleReturns arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetMultipleReturnsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetMultipleReturnsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetMultipleReturnsCall constructs a new GetMultipleReturnsCall
 func NewGetMultipleReturnsCall() *GetMultipleReturnsCall {
 	return &GetMultipleReturnsCall{}
@@ -1210,8 +1900,6 @@ func NewGetMultipleReturnsCall() *GetMultipleReturnsCall {
 
 const GetMultipleReturnsReturnStaticSize = 96
 
-var _ abi.Tuple = (*GetMultipleReturnsReturn)(nil)
-
 // GetMultipleReturnsReturn represents an ABI tuple
 type GetMultipleReturnsReturn struct {
 	Field1 *big.Int
@@ -1242,6 +1930,7 @@ func (value GetMultipleReturnsReturn) EncodeTo(buf []byte) (int, error) {
 
 	// Field Field2: (address,string)
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Field2.EncodeTo(buf[dynamicOffset:])
@@ -1279,7 +1968,7 @@ func (t *GetMultipleReturnsReturn) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 96
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
@@ -1306,6 +1995,47 @@ func (t *GetMultipleReturnsReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetMultipleReturnsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetMultipleReturnsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetMultipleReturnsReturn from the hex string with an optional 0x prefix
+func (t *GetMultipleReturnsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetMultipleReturnsReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetMultipleReturnsReturn
+func (value GetMultipleReturnsReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetMultipleReturnsReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetMultipleReturnsReturn)(nil)
+var _ abi.Decoder = (*GetMultipleReturnsReturn)(nil)
+
+// DecodeGetMultipleReturnsReturn decodes the return data of getMultipleReturns into its values
+func DecodeGetMultipleReturnsReturn(data []byte) (r1 *big.Int, r2 AddressStringPair, r3 bool, err error) {
+	var result GetMultipleReturnsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, result.Field2, result.Field3, nil
+}
+
 var _ abi.Method = (*GetNestedTupleArrayCall)(nil)
 
 // GetNestedTupleArrayCall represents the input arguments for getNestedTupleArray function
@@ -1328,9 +2058,14 @@ func (t GetNestedTupleArrayCall) GetMethodSelector() [4]byte {
 	return GetNestedTupleArraySelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getNestedTupleArray arguments including function selector
+func (t GetNestedTupleArrayCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getNestedTupleArray arguments to ABI bytes including function selector
 func (t GetNestedTupleArrayCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetNestedTupleArraySelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1338,6 +2073,59 @@ func (t GetNestedTupleArrayCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getNestedTupleArray arguments to 0x prefixed hex string
+func (t GetNestedTupleArrayCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getNestedTupleArray arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetNestedTupleArrayCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getNestedTupleArray calldata, returns 0 if encoding fails
+func (t GetNestedTupleArrayCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getNestedTupleArray arguments from ABI bytes including function selector
+func (t *GetNestedTupleArrayCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetNestedTupleArraySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getNestedTupleArray arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetNestedTupleArrayCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetNestedTupleArrayCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetNestedTupleArrayCall constructs a new GetNestedTupleArrayCall
 func NewGetNestedTupleArrayCall() *GetNestedTupleArrayCall {
 	return &GetNestedTupleArrayCall{}
@@ -1345,8 +2133,6 @@ func NewGetNestedTupleArrayCall() *GetNestedTupleArrayCall {
 
 const GetNestedTupleArrayReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetNestedTupleArrayReturn)(nil)
-
 // GetNestedTupleArrayReturn represents an ABI tuple
 type GetNestedTupleArrayReturn struct {
 	Field1 []ComplexNested
@@ -1370,6 +2156,7 @@ func (value GetNestedTupleArrayReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (uint256,address,string,bytes)[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = NestedEncodeComplexNestedSlice(value.Field1, buf[dynamicOffset:])
@@ -1419,6 +2206,69 @@ func (t *GetNestedTupleArrayReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetNestedTupleArrayReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetNestedTupleArrayReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetNestedTupleArrayReturn from the hex string with an optional 0x prefix
+func (t *GetNestedTupleArrayReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetNestedTupleArrayReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetNestedTupleArrayReturn
+func (value GetNestedTupleArrayReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetNestedTupleArrayReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetNestedTupleArrayReturn)(nil)
+var _ abi.Decoder = (*GetNestedTupleArrayReturn)(nil)
+
+// DecodeGetNestedTupleArrayReturn decodes the return data of getNestedTupleArray into its values
+func DecodeGetNestedTupleArrayReturn(data []byte) (r1 []ComplexNested, err error) {
+	var result GetNestedTupleArrayReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetNestedTupleArray decodes the single return value of getNestedTupleArray
+func DecodeGetNestedTupleArray(data []byte) ([]ComplexNested, error) {
+	return DecodeGetNestedTupleArrayReturn(data)
+}
+
+// DecodeGetNestedTupleArrayHex decodes the single return value of getNestedTupleArray from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetNestedTupleArrayHex(s string) ([]ComplexNested, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero []ComplexNested
+		return zero, fmt.Errorf("decode GetNestedTupleArrayReturn: %w", err)
+	}
+	return DecodeGetNestedTupleArrayReturn(data)
+}
+
+// EncodeGetNestedTupleArrayResult encodes the single return value of getNestedTupleArray, e.g. for the return data of precompiles
+func EncodeGetNestedTupleArrayResult(v []ComplexNested) ([]byte, error) {
+	result := GetNestedTupleArrayReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetSimplePairCall)(nil)
 
 // GetSimplePairCall represents the input arguments for getSimplePair function
@@ -1441,9 +2291,14 @@ func (t GetSimplePairCall) GetMethodSelector() [4]byte {
 	return GetSimplePairSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getSimplePair arguments including function selector
+func (t GetSimplePairCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getSimplePair arguments to ABI bytes including function selector
 func (t GetSimplePairCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetSimplePairSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1451,6 +2306,59 @@ func (t GetSimplePairCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getSimplePair arguments to 0x prefixed hex string
+func (t GetSimplePairCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getSimplePair arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetSimplePairCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getSimplePair calldata, returns 0 if encoding fails
+func (t GetSimplePairCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getSimplePair arguments from ABI bytes including function selector
+func (t *GetSimplePairCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetSimplePairSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getSimplePair arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetSimplePairCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetSimplePairCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetSimplePairCall constructs a new GetSimplePairCall
 func NewGetSimplePairCall() *GetSimplePairCall {
 	return &GetSimplePairCall{}
@@ -1458,9 +2366,6 @@ func NewGetSimplePairCall() *GetSimplePairCall {
 
 const GetSimplePairReturnStaticSize = 64
 
-var _ abi.Tuple = (*GetSimplePairReturn)(nil)
-var _ abi.PackedTuple = (*GetSimplePairReturn)(nil)
-
 // GetSimplePairReturn represents an ABI tuple
 type GetSimplePairReturn struct {
 	Field1 SimplePair
@@ -1511,6 +2416,35 @@ func (t *GetSimplePairReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetSimplePairReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetSimplePairReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetSimplePairReturn from the hex string with an optional 0x prefix
+func (t *GetSimplePairReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetSimplePairReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetSimplePairReturn
+func (value GetSimplePairReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetSimplePairReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of GetSimplePairReturn
 func (t GetSimplePairReturn) PackedEncodedSize() int {
 	return 64
@@ -1557,6 +2491,41 @@ func (t *GetSimplePairReturn) PackedDecode(data []byte) (int, error) {
 	return 64, nil
 }
 
+var _ abi.Tuple = (*GetSimplePairReturn)(nil)
+var _ abi.Decoder = (*GetSimplePairReturn)(nil)
+var _ abi.PackedTuple = (*GetSimplePairReturn)(nil)
+
+// DecodeGetSimplePairReturn decodes the return data of getSimplePair into its values
+func DecodeGetSimplePairReturn(data []byte) (r1 SimplePair, err error) {
+	var result GetSimplePairReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetSimplePair decodes the single return value of getSimplePair
+func DecodeGetSimplePair(data []byte) (SimplePair, error) {
+	return DecodeGetSimplePairReturn(data)
+}
+
+// DecodeGetSimplePairHex decodes the single return value of getSimplePair from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetSimplePairHex(s string) (SimplePair, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero SimplePair
+		return zero, fmt.Errorf("decode GetSimplePairReturn: %w", err)
+	}
+	return DecodeGetSimplePairReturn(data)
+}
+
+// EncodeGetSimplePairResult encodes the single return value of getSimplePair, e.g. for the return data of precompiles
+func EncodeGetSimplePairResult(v SimplePair) ([]byte, error) {
+	result := GetSimplePairReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetTupleArrayCall)(nil)
 
 // GetTupleArrayCall represents the input arguments for getTupleArray function
@@ -1579,9 +2548,14 @@ func (t GetTupleArrayCall) GetMethodSelector() [4]byte {
 	return GetTupleArraySelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getTupleArray arguments including function selector
+func (t GetTupleArrayCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getTupleArray arguments to ABI bytes including function selector
 func (t GetTupleArrayCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetTupleArraySelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1589,6 +2563,59 @@ func (t GetTupleArrayCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getTupleArray arguments to 0x prefixed hex string
+func (t GetTupleArrayCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getTupleArray arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetTupleArrayCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getTupleArray calldata, returns 0 if encoding fails
+func (t GetTupleArrayCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getTupleArray arguments from ABI bytes including function selector
+func (t *GetTupleArrayCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetTupleArraySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getTupleArray arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetTupleArrayCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetTupleArrayCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetTupleArrayCall constructs a new GetTupleArrayCall
 func NewGetTupleArrayCall() *GetTupleArrayCall {
 	return &GetTupleArrayCall{}
@@ -1596,8 +2623,6 @@ func NewGetTupleArrayCall() *GetTupleArrayCall {
 
 const GetTupleArrayReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetTupleArrayReturn)(nil)
-
 // GetTupleArrayReturn represents an ABI tuple
 type GetTupleArrayReturn struct {
 	Field1 []SimplePair
@@ -1621,6 +2646,7 @@ func (value GetTupleArrayReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (uint256,uint256)[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = NestedEncodeSimplePairSlice(value.Field1, buf[dynamicOffset:])
@@ -1670,6 +2696,69 @@ func (t *GetTupleArrayReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetTupleArrayReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetTupleArrayReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetTupleArrayReturn from the hex string with an optional 0x prefix
+func (t *GetTupleArrayReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetTupleArrayReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetTupleArrayReturn
+func (value GetTupleArrayReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetTupleArrayReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetTupleArrayReturn)(nil)
+var _ abi.Decoder = (*GetTupleArrayReturn)(nil)
+
+// DecodeGetTupleArrayReturn decodes the return data of getTupleArray into its values
+func DecodeGetTupleArrayReturn(data []byte) (r1 []SimplePair, err error) {
+	var result GetTupleArrayReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetTupleArray decodes the single return value of getTupleArray
+func DecodeGetTupleArray(data []byte) ([]SimplePair, error) {
+	return DecodeGetTupleArrayReturn(data)
+}
+
+// DecodeGetTupleArrayHex decodes the single return value of getTupleArray from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetTupleArrayHex(s string) ([]SimplePair, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero []SimplePair
+		return zero, fmt.Errorf("decode GetTupleArrayReturn: %w", err)
+	}
+	return DecodeGetTupleArrayReturn(data)
+}
+
+// EncodeGetTupleArrayResult encodes the single return value of getTupleArray, e.g. for the return data of precompiles
+func EncodeGetTupleArrayResult(v []SimplePair) ([]byte, error) {
+	result := GetTupleArrayReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetUserWithMetadataCall)(nil)
 
 // GetUserWithMetadataCall represents the input arguments for getUserWithMetadata function
@@ -1692,9 +2781,14 @@ func (t GetUserWithMetadataCall) GetMethodSelector() [4]byte {
 	return GetUserWithMetadataSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getUserWithMetadata arguments including function selector
+func (t GetUserWithMetadataCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getUserWithMetadata arguments to ABI bytes including function selector
 func (t GetUserWithMetadataCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetUserWithMetadataSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1702,6 +2796,59 @@ func (t GetUserWithMetadataCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getUserWithMetadata arguments to 0x prefixed hex string
+func (t GetUserWithMetadataCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getUserWithMetadata arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetUserWithMetadataCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getUserWithMetadata calldata, returns 0 if encoding fails
+func (t GetUserWithMetadataCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getUserWithMetadata arguments from ABI bytes including function selector
+func (t *GetUserWithMetadataCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetUserWithMetadataSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getUserWithMetadata arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetUserWithMetadataCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetUserWithMetadataCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetUserWithMetadataCall constructs a new GetUserWithMetadataCall
 func NewGetUserWithMetadataCall() *GetUserWithMetadataCall {
 	return &GetUserWithMetadataCall{}
@@ -1709,8 +2856,6 @@ func NewGetUserWithMetadataCall() *GetUserWithMetadataCall {
 
 const GetUserWithMetadataReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetUserWithMetadataReturn)(nil)
-
 // GetUserWithMetadataReturn represents an ABI tuple
 type GetUserWithMetadataReturn struct {
 	Field1 UserWithMetadata
@@ -1734,6 +2879,7 @@ func (value GetUserWithMetadataReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (string,uint256,uint256,string)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Field1.EncodeTo(buf[dynamicOffset:])
@@ -1783,6 +2929,69 @@ func (t *GetUserWithMetadataReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetUserWithMetadataReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetUserWithMetadataReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetUserWithMetadataReturn from the hex string with an optional 0x prefix
+func (t *GetUserWithMetadataReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetUserWithMetadataReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetUserWithMetadataReturn
+func (value GetUserWithMetadataReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetUserWithMetadataReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetUserWithMetadataReturn)(nil)
+var _ abi.Decoder = (*GetUserWithMetadataReturn)(nil)
+
+// DecodeGetUserWithMetadataReturn decodes the return data of getUserWithMetadata into its values
+func DecodeGetUserWithMetadataReturn(data []byte) (r1 UserWithMetadata, err error) {
+	var result GetUserWithMetadataReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetUserWithMetadata decodes the single return value of getUserWithMetadata
+func DecodeGetUserWithMetadata(data []byte) (UserWithMetadata, error) {
+	return DecodeGetUserWithMetadataReturn(data)
+}
+
+// DecodeGetUserWithMetadataHex decodes the single return value of getUserWithMetadata from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetUserWithMetadataHex(s string) (UserWithMetadata, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero UserWithMetadata
+		return zero, fmt.Errorf("decode GetUserWithMetadataReturn: %w", err)
+	}
+	return DecodeGetUserWithMetadataReturn(data)
+}
+
+// EncodeGetUserWithMetadataResult encodes the single return value of getUserWithMetadata, e.g. for the return data of precompiles
+func EncodeGetUserWithMetadataResult(v UserWithMetadata) ([]byte, error) {
+	result := GetUserWithMetadataReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*GetUsersArrayCall)(nil)
 
 // GetUsersArrayCall represents the input arguments for getUsersArray function
@@ -1805,9 +3014,14 @@ func (t GetUsersArrayCall) GetMethodSelector() [4]byte {
 	return GetUsersArraySelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getUsersArray arguments including function selector
+func (t GetUsersArrayCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getUsersArray arguments to ABI bytes including function selector
 func (t GetUsersArrayCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetUsersArraySelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1815,6 +3029,59 @@ func (t GetUsersArrayCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getUsersArray arguments to 0x prefixed hex string
+func (t GetUsersArrayCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getUsersArray arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetUsersArrayCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getUsersArray calldata, returns 0 if encoding fails
+func (t GetUsersArrayCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getUsersArray arguments from ABI bytes including function selector
+func (t *GetUsersArrayCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetUsersArraySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getUsersArray arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetUsersArrayCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetUsersArrayCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewGetUsersArrayCall constructs a new GetUsersArrayCall
 func NewGetUsersArrayCall() *GetUsersArrayCall {
 	return &GetUsersArrayCall{}
@@ -1822,8 +3089,6 @@ func NewGetUsersArrayCall() *GetUsersArrayCall {
 
 const GetUsersArrayReturnStaticSize = 32
 
-var _ abi.Tuple = (*GetUsersArrayReturn)(nil)
-
 // GetUsersArrayReturn represents an ABI tuple
 type GetUsersArrayReturn struct {
 	Field1 []AddressStringPair
@@ -1847,6 +3112,7 @@ func (value GetUsersArrayReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Field1: (address,string)[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = NestedEncodeAddressStringPairSlice(value.Field1, buf[dynamicOffset:])
@@ -1895,3 +3161,114 @@ func (t *GetUsersArrayReturn) Decode(data []byte) (int, error) {
 	}
 	return dynamicOffset, nil
 }
+
+// DecodeStrict decodes GetUsersArrayReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetUsersArrayReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetUsersArrayReturn from the hex string with an optional 0x prefix
+func (t *GetUsersArrayReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetUsersArrayReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the ABI encoding of GetUsersArrayReturn
+func (value GetUsersArrayReturn) MarshalBinary() ([]byte, error) {
+	return value.Encode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, rejecting unexpected trailing bytes
+func (t *GetUsersArrayReturn) UnmarshalBinary(data []byte) error {
+	return t.DecodeStrict(data)
+}
+
+var _ abi.Tuple = (*GetUsersArrayReturn)(nil)
+var _ abi.Decoder = (*GetUsersArrayReturn)(nil)
+
+// DecodeGetUsersArrayReturn decodes the return data of getUsersArray into its values
+func DecodeGetUsersArrayReturn(data []byte) (r1 []AddressStringPair, err error) {
+	var result GetUsersArrayReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetUsersArray decodes the single return value of getUsersArray
+func DecodeGetUsersArray(data []byte) ([]AddressStringPair, error) {
+	return DecodeGetUsersArrayReturn(data)
+}
+
+// DecodeGetUsersArrayHex decodes the single return value of getUsersArray from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetUsersArrayHex(s string) ([]AddressStringPair, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero []AddressStringPair
+		return zero, fmt.Errorf("decode GetUsersArrayReturn: %w", err)
+	}
+	return DecodeGetUsersArrayReturn(data)
+}
+
+// EncodeGetUsersArrayResult encodes the single return value of getUsersArray, e.g. for the return data of precompiles
+func EncodeGetUsersArrayResult(v []AddressStringPair) ([]byte, error) {
+	result := GetUsersArrayReturn{Field1: v}
+	return result.Encode()
+}
+
+// NestedDecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func NestedDecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case GetAddressStringPairSelector:
+		call = new(GetAddressStringPairCall)
+	case GetComplexNestedSelector:
+		call = new(GetComplexNestedCall)
+	case GetDeeplyNestedSelector:
+		call = new(GetDeeplyNestedCall)
+	case GetMultipleReturnsSelector:
+		call = new(GetMultipleReturnsCall)
+	case GetNestedTupleArraySelector:
+		call = new(GetNestedTupleArrayCall)
+	case GetSimplePairSelector:
+		call = new(GetSimplePairCall)
+	case GetTupleArraySelector:
+		call = new(GetTupleArrayCall)
+	case GetUserWithMetadataSelector:
+		call = new(GetUserWithMetadataCall)
+	case GetUsersArraySelector:
+		call = new(GetUsersArrayCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// NestedSelectors maps the function and error selectors to the canonical signatures
+var NestedSelectors = map[[4]byte]string{
+	GetAddressStringPairSelector: GetAddressStringPairSignature,
+	GetComplexNestedSelector:     GetComplexNestedSignature,
+	GetDeeplyNestedSelector:      GetDeeplyNestedSignature,
+	GetMultipleReturnsSelector:   GetMultipleReturnsSignature,
+	GetNestedTupleArraySelector:  GetNestedTupleArraySignature,
+	GetSimplePairSelector:        GetSimplePairSignature,
+	GetTupleArraySelector:        GetTupleArraySignature,
+	GetUserWithMetadataSelector:  GetUserWithMetadataSignature,
+	GetUsersArraySelector:        GetUsersArraySignature,
+}
@@ -4,6 +4,12 @@ package tests
 
 import (
 	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"math"
 	"math/big"
 	"testing"
 
@@ -14,7 +20,7 @@ import (
 	"github.com/yihuang/go-abi"
 )
 
-//go:generate go run ../cmd -var NestedTupleReturnsABI -output nested.abi.go -prefix nested
+//go:generate go run ../cmd -var NestedTupleReturnsABI -output nested.abi.go -prefix nested -binary-marshaler
 
 // NestedTupleReturnsABI contains human-readable ABI definitions for testing nested tuples in function returns
 var NestedTupleReturnsABI = []string{
@@ -171,6 +177,24 @@ func TestNestedTupleReturnsTupleArray(t *testing.T) {
 	require.Equal(t, args, &decoded)
 }
 
+func TestNestedTupleSliceLengthOverflow(t *testing.T) {
+	// the length checks must hold without the size limit
+	defer func(max int) { abi.MaxDecodeSize = max }(abi.MaxDecodeSize)
+	abi.MaxDecodeSize = math.MaxInt
+
+	// the lengths whose size in bytes overflows int, or wraps around to a small value
+	for _, length := range []uint64{math.MaxInt / 64, math.MaxInt/64 + 1, math.MaxInt/32 + 1, math.MaxInt} {
+		data := make([]byte, 32*4)
+		binary.BigEndian.PutUint64(data[24:32], length)
+
+		_, _, err := NestedDecodeSimplePairSlice(data)
+		require.Equal(t, io.ErrUnexpectedEOF, err)
+
+		_, _, err = abi.DecodeStaticSlice[SimplePair](nil, data, 64)
+		require.Equal(t, io.ErrUnexpectedEOF, err)
+	}
+}
+
 func TestNestedTupleReturnsUserWithMetadata(t *testing.T) {
 	args := &GetUserWithMetadataReturn{
 		Field1: UserWithMetadata{
@@ -234,4 +258,47 @@ func TestNestedTupleReturnsMultipleReturns(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, args, &decoded)
+
+	// decode directly into multiple values
+	value, pair, flag, err := DecodeGetMultipleReturnsReturn(encoded)
+	require.NoError(t, err)
+	require.Equal(t, args.Field1, value)
+	require.Equal(t, args.Field2, pair)
+	require.Equal(t, args.Field3, flag)
+
+	value, pair, flag, err = DecodeGetMultipleReturnsReturn(encoded[:64])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	require.Nil(t, value)
+	require.Equal(t, AddressStringPair{}, pair)
+	require.False(t, flag)
+}
+
+func TestNestedTupleBinaryMarshaler(t *testing.T) {
+	value := ComplexNested{
+		Num:  big.NewInt(42),
+		Addr: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Str:  "binary",
+		Data: []byte{0x01, 0x02},
+	}
+
+	var _ encoding.BinaryMarshaler = value
+	var _ encoding.BinaryUnmarshaler = &value
+
+	data, err := value.MarshalBinary()
+	require.NoError(t, err)
+	encoded, err := value.Encode()
+	require.NoError(t, err)
+	require.Equal(t, encoded, data)
+
+	var decoded ComplexNested
+	require.NoError(t, decoded.UnmarshalBinary(data))
+	require.Equal(t, value, decoded)
+	require.True(t, errors.Is(decoded.UnmarshalBinary(append(data, 0)), abi.ErrTrailingBytes))
+
+	// gob uses the BinaryMarshaler implementation
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(value))
+	var gobDecoded ComplexNested
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&gobDecoded))
+	require.Equal(t, value, gobDecoded)
 }
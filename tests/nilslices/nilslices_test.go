@@ -0,0 +1,82 @@
+package nilslices
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+	"github.com/yihuang/go-abi/tests/nilslices/compact"
+)
+
+// the zero-length dynamic arrays decode to nil, with the inlined loops and the compact helpers
+//go:generate go run ../../cmd -var NilSlicesTestABI -output nilslices.abi.go -package nilslices -nil-slices -decode-into
+//go:generate go run ../../cmd -var NilSlicesTestABI -output compact/nilslices.abi.go -package compact -nil-slices -compact
+
+var NilSlicesTestABI = []string{
+	"struct Point { uint256 x; address[] owners }",
+	"function update(address[] owners, string[][] names, Point[] points, bytes data, uint256[2][] pairs) returns (Point)",
+}
+
+func TestNilSlices(t *testing.T) {
+	call := UpdateCall{
+		Points: []Point{{X: big.NewInt(1)}},
+	}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	var decoded UpdateCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded.Owners)
+	require.Nil(t, decoded.Names)
+	require.Nil(t, decoded.Points[0].Owners)
+	require.Nil(t, decoded.Pairs)
+	// bytes are not affected
+	require.Equal(t, []byte{}, decoded.Data)
+
+	decoded.Data = nil
+	require.Equal(t, call, decoded)
+
+	// the reused slices are dropped too
+	decoded.Owners = []common.Address{{1}}
+	_, err = decoded.DecodeInto(encoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded.Owners)
+
+	// the empty inner slices
+	call.Names = [][]string{nil, {"a"}}
+	encoded, err = call.Encode()
+	require.NoError(t, err)
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call.Names, decoded.Names)
+
+	owners, _, err := DecodeAddressSlice(make([]byte, 32))
+	require.NoError(t, err)
+	require.Nil(t, owners)
+	// the stdlib keeps decoding to empty slices
+	owners, _, err = abi.DecodeAddressSlice(make([]byte, 32))
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{}, owners)
+}
+
+func TestNilSlicesCompact(t *testing.T) {
+	call := compact.UpdateCall{}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	var decoded compact.UpdateCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Nil(t, decoded.Points)
+
+	call.Points = []compact.Point{{X: big.NewInt(1), Owners: []common.Address{{2}}}}
+	encoded, err = call.Encode()
+	require.NoError(t, err)
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call.Points, decoded.Points)
+}
@@ -0,0 +1,80 @@
+package outputs
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+)
+
+// the tuples are used only by the outputs of the zero-input functions and by the events
+//go:generate go run ../../cmd -var OutputsTestABI -output outputs.abi.go -package outputs
+
+var OutputsTestABI = []string{
+	"function position() view returns ((address owner, (uint256 amount, uint64 expiry)[] locks) position)",
+	"function positions() view returns ((address owner, (uint256 amount, uint64 expiry)[] locks)[2] positions, uint256 total)",
+	"event Settled((uint256 id, (address to, uint256 amount)[] legs) indexed settlement, (string memo, bytes data)[] extras)",
+}
+
+var owner = common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+func testPosition() Tuple03d6bc67 {
+	return Tuple03d6bc67{
+		Owner: owner,
+		Locks: []Tuplec1dd5942{
+			{Amount: big.NewInt(100), Expiry: 1000},
+			{Amount: big.NewInt(200), Expiry: 2000},
+		},
+	}
+}
+
+func TestOutputOnlyTuples(t *testing.T) {
+	ret := PositionReturn{Position: testPosition()}
+	encoded, err := ret.Encode()
+	require.NoError(t, err)
+
+	var decoded PositionReturn
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, ret, decoded)
+
+	position, err := DecodePosition(encoded)
+	require.NoError(t, err)
+	require.Equal(t, ret.Position, position)
+
+	rets := PositionsReturn{Positions: [2]Tuple03d6bc67{testPosition(), {Owner: owner, Locks: []Tuplec1dd5942{}}}, Total: big.NewInt(300)}
+	encoded, err = rets.Encode()
+	require.NoError(t, err)
+
+	positions, total, err := DecodePositionsReturn(encoded)
+	require.NoError(t, err)
+	require.Equal(t, rets.Positions, positions)
+	require.Equal(t, rets.Total, total)
+}
+
+func TestEventOnlyTuples(t *testing.T) {
+	settlement := Tuple61d87eaf{
+		Id:   big.NewInt(1),
+		Legs: []Tuple4c821694{{To: owner, Amount: big.NewInt(100)}},
+	}
+	extras := []Tuplee79edf07{{Memo: "fee", Data: []byte{1, 2, 3}}}
+	event := NewSettledEvent(settlement, extras)
+
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	require.Len(t, topics, 2)
+	// the in-place encoding of the tuple, without the offset and the length of the legs
+	preimage := append(common.BigToHash(settlement.Id).Bytes(), common.LeftPadBytes(owner.Bytes(), 32)...)
+	preimage = append(preimage, common.BigToHash(big.NewInt(100)).Bytes()...)
+	require.Equal(t, crypto.Keccak256Hash(preimage), topics[1])
+
+	data, err := event.SettledEventData.Encode()
+	require.NoError(t, err)
+
+	var decoded SettledEventData
+	_, err = decoded.Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, extras, decoded.Extras)
+}
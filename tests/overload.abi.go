@@ -1,11 +1,12 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: eb3865f457fad9b2a616211587f079f139fd240a4cfe6e76a300bf384192f0f8
 
 package tests
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -36,13 +37,19 @@ const (
 	Overloaded20ID = 822703915
 )
 
+// Canonical function signatures
+const (
+	Overloaded1Signature  = "overloaded1(address,uint256)"
+	Overloaded10Signature = "overloaded1(address,address,uint256)"
+	Overloaded11Signature = "overloaded1(address,address,uint256,bytes)"
+	Overloaded2Signature  = "overloaded2(address)"
+	Overloaded20Signature = "overloaded2()"
+)
+
 var _ abi.Method = (*Overloaded1Call)(nil)
 
 const Overloaded1CallStaticSize = 64
 
-var _ abi.Tuple = (*Overloaded1Call)(nil)
-var _ abi.PackedTuple = (*Overloaded1Call)(nil)
-
 // Overloaded1Call represents an ABI tuple
 type Overloaded1Call struct {
 	To     common.Address
@@ -97,13 +104,31 @@ func (t *Overloaded1Call) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded1Call from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded1Call) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes Overloaded1Call from the hex string with an optional 0x prefix
+func (t *Overloaded1Call) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded1Call: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded1Call
 func (t Overloaded1Call) PackedEncodedSize() int {
 	return 52
@@ -162,6 +187,10 @@ func (t *Overloaded1Call) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*Overloaded1Call)(nil)
+var _ abi.Decoder = (*Overloaded1Call)(nil)
+var _ abi.PackedTuple = (*Overloaded1Call)(nil)
+
 // GetMethodName returns the function name
 func (t Overloaded1Call) GetMethodName() string {
 	return "overloaded1"
@@ -177,9 +206,14 @@ func (t Overloaded1Call) GetMethodSelector() [4]byte {
 	return Overloaded1Selector
 }
 
+// EncodedSizeWithSelector returns the encoded size of overloaded1 arguments including function selector
+func (t Overloaded1Call) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes overloaded1 arguments to ABI bytes including function selector
 func (t Overloaded1Call) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], Overloaded1Selector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -187,6 +221,84 @@ func (t Overloaded1Call) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes overloaded1 arguments to 0x prefixed hex string
+func (t Overloaded1Call) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes overloaded1 arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t Overloaded1Call) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the overloaded1 calldata, returns 0 if encoding fails
+func (t Overloaded1Call) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes overloaded1 arguments from ABI bytes including function selector
+func (t *Overloaded1Call) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded1Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes overloaded1 arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *Overloaded1Call) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded1Call: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes overloaded1 arguments to packed ABI bytes including function selector
+func (t Overloaded1Call) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], Overloaded1Selector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes overloaded1 arguments from packed ABI bytes including function selector
+func (t *Overloaded1Call) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded1Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewOverloaded1Call constructs a new Overloaded1Call
 func NewOverloaded1Call(
 	to common.Address,
@@ -200,9 +312,6 @@ func NewOverloaded1Call(
 
 const Overloaded1ReturnStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded1Return)(nil)
-var _ abi.PackedTuple = (*Overloaded1Return)(nil)
-
 // Overloaded1Return represents an ABI tuple
 type Overloaded1Return struct {
 	Field1 bool
@@ -253,6 +362,25 @@ func (t *Overloaded1Return) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded1Return from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded1Return) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes Overloaded1Return from the hex string with an optional 0x prefix
+func (t *Overloaded1Return) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded1Return: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded1Return
 func (t Overloaded1Return) PackedEncodedSize() int {
 	return 1
@@ -299,13 +427,45 @@ func (t *Overloaded1Return) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*Overloaded1Return)(nil)
+var _ abi.Decoder = (*Overloaded1Return)(nil)
+var _ abi.PackedTuple = (*Overloaded1Return)(nil)
+
+// DecodeOverloaded1Return decodes the return data of overloaded1 into its values
+func DecodeOverloaded1Return(data []byte) (r1 bool, err error) {
+	var result Overloaded1Return
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeOverloaded1 decodes the single return value of overloaded1
+func DecodeOverloaded1(data []byte) (bool, error) {
+	return DecodeOverloaded1Return(data)
+}
+
+// DecodeOverloaded1Hex decodes the single return value of overloaded1 from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeOverloaded1Hex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode Overloaded1Return: %w", err)
+	}
+	return DecodeOverloaded1Return(data)
+}
+
+// EncodeOverloaded1Result encodes the single return value of overloaded1, e.g. for the return data of precompiles
+func EncodeOverloaded1Result(v bool) ([]byte, error) {
+	result := Overloaded1Return{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*Overloaded10Call)(nil)
 
 const Overloaded10CallStaticSize = 96
 
-var _ abi.Tuple = (*Overloaded10Call)(nil)
-var _ abi.PackedTuple = (*Overloaded10Call)(nil)
-
 // Overloaded10Call represents an ABI tuple
 type Overloaded10Call struct {
 	From   common.Address
@@ -371,13 +531,31 @@ func (t *Overloaded10Call) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[64:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[64:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded10Call from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded10Call) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes Overloaded10Call from the hex string with an optional 0x prefix
+func (t *Overloaded10Call) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded10Call: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded10Call
 func (t Overloaded10Call) PackedEncodedSize() int {
 	return 72
@@ -448,6 +626,10 @@ func (t *Overloaded10Call) PackedDecode(data []byte) (int, error) {
 	return 72, nil
 }
 
+var _ abi.Tuple = (*Overloaded10Call)(nil)
+var _ abi.Decoder = (*Overloaded10Call)(nil)
+var _ abi.PackedTuple = (*Overloaded10Call)(nil)
+
 // GetMethodName returns the function name
 func (t Overloaded10Call) GetMethodName() string {
 	return "overloaded10"
@@ -463,9 +645,14 @@ func (t Overloaded10Call) GetMethodSelector() [4]byte {
 	return Overloaded10Selector
 }
 
+// EncodedSizeWithSelector returns the encoded size of overloaded10 arguments including function selector
+func (t Overloaded10Call) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes overloaded10 arguments to ABI bytes including function selector
 func (t Overloaded10Call) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], Overloaded10Selector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -473,6 +660,84 @@ func (t Overloaded10Call) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes overloaded10 arguments to 0x prefixed hex string
+func (t Overloaded10Call) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes overloaded10 arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t Overloaded10Call) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the overloaded10 calldata, returns 0 if encoding fails
+func (t Overloaded10Call) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes overloaded10 arguments from ABI bytes including function selector
+func (t *Overloaded10Call) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded10Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes overloaded10 arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *Overloaded10Call) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded10Call: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes overloaded10 arguments to packed ABI bytes including function selector
+func (t Overloaded10Call) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], Overloaded10Selector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes overloaded10 arguments from packed ABI bytes including function selector
+func (t *Overloaded10Call) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded10Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewOverloaded10Call constructs a new Overloaded10Call
 func NewOverloaded10Call(
 	from common.Address,
@@ -488,9 +753,6 @@ func NewOverloaded10Call(
 
 const Overloaded10ReturnStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded10Return)(nil)
-var _ abi.PackedTuple = (*Overloaded10Return)(nil)
-
 // Overloaded10Return represents an ABI tuple
 type Overloaded10Return struct {
 	Field1 bool
@@ -541,6 +803,25 @@ func (t *Overloaded10Return) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded10Return from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded10Return) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes Overloaded10Return from the hex string with an optional 0x prefix
+func (t *Overloaded10Return) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded10Return: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded10Return
 func (t Overloaded10Return) PackedEncodedSize() int {
 	return 1
@@ -587,12 +868,45 @@ func (t *Overloaded10Return) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*Overloaded10Return)(nil)
+var _ abi.Decoder = (*Overloaded10Return)(nil)
+var _ abi.PackedTuple = (*Overloaded10Return)(nil)
+
+// DecodeOverloaded10Return decodes the return data of overloaded10 into its values
+func DecodeOverloaded10Return(data []byte) (r1 bool, err error) {
+	var result Overloaded10Return
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeOverloaded10 decodes the single return value of overloaded10
+func DecodeOverloaded10(data []byte) (bool, error) {
+	return DecodeOverloaded10Return(data)
+}
+
+// DecodeOverloaded10Hex decodes the single return value of overloaded10 from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeOverloaded10Hex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode Overloaded10Return: %w", err)
+	}
+	return DecodeOverloaded10Return(data)
+}
+
+// EncodeOverloaded10Result encodes the single return value of overloaded10, e.g. for the return data of precompiles
+func EncodeOverloaded10Result(v bool) ([]byte, error) {
+	result := Overloaded10Return{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*Overloaded11Call)(nil)
 
 const Overloaded11CallStaticSize = 128
 
-var _ abi.Tuple = (*Overloaded11Call)(nil)
-
 // Overloaded11Call represents an ABI tuple
 type Overloaded11Call struct {
 	From   common.Address
@@ -634,6 +948,7 @@ func (value Overloaded11Call) EncodeTo(buf []byte) (int, error) {
 
 	// Field Data: bytes
 	// Encode offset pointer
+	abi.ClearWord(buf[96:])
 	binary.BigEndian.PutUint64(buf[96+24:96+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeBytes(value.Data, buf[dynamicOffset:])
@@ -676,7 +991,7 @@ func (t *Overloaded11Call) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[64:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[64:])
 	if err != nil {
 		return 0, err
 	}
@@ -698,6 +1013,27 @@ func (t *Overloaded11Call) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded11Call from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded11Call) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes Overloaded11Call from the hex string with an optional 0x prefix
+func (t *Overloaded11Call) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded11Call: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*Overloaded11Call)(nil)
+var _ abi.Decoder = (*Overloaded11Call)(nil)
+
 // GetMethodName returns the function name
 func (t Overloaded11Call) GetMethodName() string {
 	return "overloaded11"
@@ -713,9 +1049,14 @@ func (t Overloaded11Call) GetMethodSelector() [4]byte {
 	return Overloaded11Selector
 }
 
+// EncodedSizeWithSelector returns the encoded size of overloaded11 arguments including function selector
+func (t Overloaded11Call) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes overloaded11 arguments to ABI bytes including function selector
 func (t Overloaded11Call) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], Overloaded11Selector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -723,6 +1064,59 @@ func (t Overloaded11Call) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes overloaded11 arguments to 0x prefixed hex string
+func (t Overloaded11Call) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes overloaded11 arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t Overloaded11Call) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the overloaded11 calldata, returns 0 if encoding fails
+func (t Overloaded11Call) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes overloaded11 arguments from ABI bytes including function selector
+func (t *Overloaded11Call) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded11Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes overloaded11 arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *Overloaded11Call) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded11Call: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewOverloaded11Call constructs a new Overloaded11Call
 func NewOverloaded11Call(
 	from common.Address,
@@ -740,9 +1134,6 @@ func NewOverloaded11Call(
 
 const Overloaded11ReturnStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded11Return)(nil)
-var _ abi.PackedTuple = (*Overloaded11Return)(nil)
-
 // Overloaded11Return represents an ABI tuple
 type Overloaded11Return struct {
 	Field1 bool
@@ -793,6 +1184,25 @@ func (t *Overloaded11Return) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded11Return from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded11Return) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes Overloaded11Return from the hex string with an optional 0x prefix
+func (t *Overloaded11Return) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded11Return: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded11Return
 func (t Overloaded11Return) PackedEncodedSize() int {
 	return 1
@@ -839,13 +1249,45 @@ func (t *Overloaded11Return) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*Overloaded11Return)(nil)
+var _ abi.Decoder = (*Overloaded11Return)(nil)
+var _ abi.PackedTuple = (*Overloaded11Return)(nil)
+
+// DecodeOverloaded11Return decodes the return data of overloaded11 into its values
+func DecodeOverloaded11Return(data []byte) (r1 bool, err error) {
+	var result Overloaded11Return
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeOverloaded11 decodes the single return value of overloaded11
+func DecodeOverloaded11(data []byte) (bool, error) {
+	return DecodeOverloaded11Return(data)
+}
+
+// DecodeOverloaded11Hex decodes the single return value of overloaded11 from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeOverloaded11Hex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode Overloaded11Return: %w", err)
+	}
+	return DecodeOverloaded11Return(data)
+}
+
+// EncodeOverloaded11Result encodes the single return value of overloaded11, e.g. for the return data of precompiles
+func EncodeOverloaded11Result(v bool) ([]byte, error) {
+	result := Overloaded11Return{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*Overloaded2Call)(nil)
 
 const Overloaded2CallStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded2Call)(nil)
-var _ abi.PackedTuple = (*Overloaded2Call)(nil)
-
 // Overloaded2Call represents an ABI tuple
 type Overloaded2Call struct {
 	Account common.Address
@@ -896,6 +1338,24 @@ func (t *Overloaded2Call) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded2Call from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded2Call) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes Overloaded2Call from the hex string with an optional 0x prefix
+func (t *Overloaded2Call) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded2Call: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded2Call
 func (t Overloaded2Call) PackedEncodedSize() int {
 	return 20
@@ -942,6 +1402,10 @@ func (t *Overloaded2Call) PackedDecode(data []byte) (int, error) {
 	return 20, nil
 }
 
+var _ abi.Tuple = (*Overloaded2Call)(nil)
+var _ abi.Decoder = (*Overloaded2Call)(nil)
+var _ abi.PackedTuple = (*Overloaded2Call)(nil)
+
 // GetMethodName returns the function name
 func (t Overloaded2Call) GetMethodName() string {
 	return "overloaded2"
@@ -957,9 +1421,14 @@ func (t Overloaded2Call) GetMethodSelector() [4]byte {
 	return Overloaded2Selector
 }
 
+// EncodedSizeWithSelector returns the encoded size of overloaded2 arguments including function selector
+func (t Overloaded2Call) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes overloaded2 arguments to ABI bytes including function selector
 func (t Overloaded2Call) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], Overloaded2Selector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -967,6 +1436,84 @@ func (t Overloaded2Call) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes overloaded2 arguments to 0x prefixed hex string
+func (t Overloaded2Call) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes overloaded2 arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t Overloaded2Call) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the overloaded2 calldata, returns 0 if encoding fails
+func (t Overloaded2Call) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes overloaded2 arguments from ABI bytes including function selector
+func (t *Overloaded2Call) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded2Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes overloaded2 arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *Overloaded2Call) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded2Call: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes overloaded2 arguments to packed ABI bytes including function selector
+func (t Overloaded2Call) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], Overloaded2Selector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes overloaded2 arguments from packed ABI bytes including function selector
+func (t *Overloaded2Call) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded2Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewOverloaded2Call constructs a new Overloaded2Call
 func NewOverloaded2Call(
 	account common.Address,
@@ -978,9 +1525,6 @@ func NewOverloaded2Call(
 
 const Overloaded2ReturnStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded2Return)(nil)
-var _ abi.PackedTuple = (*Overloaded2Return)(nil)
-
 // Overloaded2Return represents an ABI tuple
 type Overloaded2Return struct {
 	Field1 *big.Int
@@ -1024,13 +1568,32 @@ func (t *Overloaded2Return) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded2Return from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded2Return) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes Overloaded2Return from the hex string with an optional 0x prefix
+func (t *Overloaded2Return) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded2Return: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded2Return
 func (t Overloaded2Return) PackedEncodedSize() int {
 	return 32
@@ -1077,6 +1640,41 @@ func (t *Overloaded2Return) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*Overloaded2Return)(nil)
+var _ abi.Decoder = (*Overloaded2Return)(nil)
+var _ abi.PackedTuple = (*Overloaded2Return)(nil)
+
+// DecodeOverloaded2Return decodes the return data of overloaded2 into its values
+func DecodeOverloaded2Return(data []byte) (r1 *big.Int, err error) {
+	var result Overloaded2Return
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeOverloaded2 decodes the single return value of overloaded2
+func DecodeOverloaded2(data []byte) (*big.Int, error) {
+	return DecodeOverloaded2Return(data)
+}
+
+// DecodeOverloaded2Hex decodes the single return value of overloaded2 from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeOverloaded2Hex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode Overloaded2Return: %w", err)
+	}
+	return DecodeOverloaded2Return(data)
+}
+
+// EncodeOverloaded2Result encodes the single return value of overloaded2, e.g. for the return data of precompiles
+func EncodeOverloaded2Result(v *big.Int) ([]byte, error) {
+	result := Overloaded2Return{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*Overloaded20Call)(nil)
 
 // Overloaded20Call represents the input arguments for overloaded20 function
@@ -1099,9 +1697,14 @@ func (t Overloaded20Call) GetMethodSelector() [4]byte {
 	return Overloaded20Selector
 }
 
+// EncodedSizeWithSelector returns the encoded size of overloaded20 arguments including function selector
+func (t Overloaded20Call) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes overloaded20 arguments to ABI bytes including function selector
 func (t Overloaded20Call) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], Overloaded20Selector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1109,6 +1712,59 @@ func (t Overloaded20Call) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes overloaded20 arguments to 0x prefixed hex string
+func (t Overloaded20Call) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes overloaded20 arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t Overloaded20Call) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the overloaded20 calldata, returns 0 if encoding fails
+func (t Overloaded20Call) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes overloaded20 arguments from ABI bytes including function selector
+func (t *Overloaded20Call) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != Overloaded20Selector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes overloaded20 arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *Overloaded20Call) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded20Call: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewOverloaded20Call constructs a new Overloaded20Call
 func NewOverloaded20Call() *Overloaded20Call {
 	return &Overloaded20Call{}
@@ -1116,9 +1772,6 @@ func NewOverloaded20Call() *Overloaded20Call {
 
 const Overloaded20ReturnStaticSize = 32
 
-var _ abi.Tuple = (*Overloaded20Return)(nil)
-var _ abi.PackedTuple = (*Overloaded20Return)(nil)
-
 // Overloaded20Return represents an ABI tuple
 type Overloaded20Return struct {
 	Field1 *big.Int
@@ -1162,13 +1815,32 @@ func (t *Overloaded20Return) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes Overloaded20Return from ABI bytes, rejecting unexpected trailing bytes
+func (t *Overloaded20Return) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes Overloaded20Return from the hex string with an optional 0x prefix
+func (t *Overloaded20Return) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Overloaded20Return: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of Overloaded20Return
 func (t Overloaded20Return) PackedEncodedSize() int {
 	return 32
@@ -1214,3 +1886,74 @@ func (t *Overloaded20Return) PackedDecode(data []byte) (int, error) {
 	}
 	return 32, nil
 }
+
+var _ abi.Tuple = (*Overloaded20Return)(nil)
+var _ abi.Decoder = (*Overloaded20Return)(nil)
+var _ abi.PackedTuple = (*Overloaded20Return)(nil)
+
+// DecodeOverloaded20Return decodes the return data of overloaded20 into its values
+func DecodeOverloaded20Return(data []byte) (r1 *big.Int, err error) {
+	var result Overloaded20Return
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeOverloaded20 decodes the single return value of overloaded20
+func DecodeOverloaded20(data []byte) (*big.Int, error) {
+	return DecodeOverloaded20Return(data)
+}
+
+// DecodeOverloaded20Hex decodes the single return value of overloaded20 from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeOverloaded20Hex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode Overloaded20Return: %w", err)
+	}
+	return DecodeOverloaded20Return(data)
+}
+
+// EncodeOverloaded20Result encodes the single return value of overloaded20, e.g. for the return data of precompiles
+func EncodeOverloaded20Result(v *big.Int) ([]byte, error) {
+	result := Overloaded20Return{Field1: v}
+	return result.Encode()
+}
+
+// OverloadDecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func OverloadDecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case Overloaded1Selector:
+		call = new(Overloaded1Call)
+	case Overloaded10Selector:
+		call = new(Overloaded10Call)
+	case Overloaded11Selector:
+		call = new(Overloaded11Call)
+	case Overloaded2Selector:
+		call = new(Overloaded2Call)
+	case Overloaded20Selector:
+		call = new(Overloaded20Call)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// OverloadSelectors maps the function and error selectors to the canonical signatures
+var OverloadSelectors = map[[4]byte]string{
+	Overloaded1Selector:  Overloaded1Signature,
+	Overloaded10Selector: Overloaded10Signature,
+	Overloaded11Selector: Overloaded11Signature,
+	Overloaded2Selector:  Overloaded2Signature,
+	Overloaded20Selector: Overloaded20Signature,
+}
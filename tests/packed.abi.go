@@ -1,10 +1,11 @@
-//go:build !uint256
-
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: 18f6201ff5c24d321dd77202b4bc9ec5947817c47184590af4a7702f11834e82
 
 package tests
 
 import (
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -20,6 +21,8 @@ var (
 	PackedBytesSelector = [4]byte{0xfb, 0x04, 0x69, 0xe0}
 	// packedIntermediate(uint24,uint40,int24,int40)
 	PackedIntermediateSelector = [4]byte{0x11, 0xfe, 0xe1, 0x68}
+	// packedNested((((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2]),uint8)
+	PackedNestedSelector = [4]byte{0x04, 0x4a, 0x23, 0x01}
 	// packedSmallInts(uint8,uint16,uint32,uint64,int8,int16,int32,int64)
 	PackedSmallIntsSelector = [4]byte{0xe3, 0xfb, 0x85, 0xd2}
 	// packedStruct((address,uint256,bytes32))
@@ -33,54 +36,57 @@ const (
 	PackedBoolID         = 2086941324
 	PackedBytesID        = 4211370464
 	PackedIntermediateID = 301916520
+	PackedNestedID       = 71967489
 	PackedSmallIntsID    = 3824911826
 	PackedStructID       = 2515243548
 	PackedTransferID     = 1500839442
 )
 
-const PackedStructStaticSize = 96
+// Canonical function signatures
+const (
+	PackedBoolSignature         = "packedBool(bool,bool)"
+	PackedBytesSignature        = "packedBytes(bytes32,bytes4)"
+	PackedIntermediateSignature = "packedIntermediate(uint24,uint40,int24,int40)"
+	PackedNestedSignature       = "packedNested((((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2]),uint8)"
+	PackedSmallIntsSignature    = "packedSmallInts(uint8,uint16,uint32,uint64,int8,int16,int32,int64)"
+	PackedStructSignature       = "packedStruct((address,uint256,bytes32))"
+	PackedTransferSignature     = "packedTransfer(address,uint256)"
+)
 
-var _ abi.Tuple = (*PackedStruct)(nil)
-var _ abi.PackedTuple = (*PackedStruct)(nil)
+const PackedInnerStaticSize = 64
 
-// PackedStruct represents an ABI tuple
-type PackedStruct struct {
-	Addr  common.Address
-	Value *big.Int
-	Data  [32]byte
+// PackedInner represents an ABI tuple
+type PackedInner struct {
+	A uint64
+	B [4]byte
 }
 
-// EncodedSize returns the total encoded size of PackedStruct
-func (t PackedStruct) EncodedSize() int {
+// EncodedSize returns the total encoded size of PackedInner
+func (t PackedInner) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedStructStaticSize + dynamicSize
+	return PackedInnerStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedStruct to ABI bytes in the provided buffer
-func (value PackedStruct) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedInner to ABI bytes in the provided buffer
+func (value PackedInner) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedStructStaticSize // Start dynamic data after static section
-	// Field Addr: address
-	if _, err := abi.EncodeAddress(value.Addr, buf[0:]); err != nil {
-		return 0, err
-	}
-
-	// Field Value: uint256
-	if _, err := abi.EncodeUint256(value.Value, buf[32:]); err != nil {
+	dynamicOffset := PackedInnerStaticSize // Start dynamic data after static section
+	// Field A: uint64
+	if _, err := abi.EncodeUint64(value.A, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field Data: bytes32
-	if _, err := abi.EncodeBytes32(value.Data, buf[64:]); err != nil {
+	// Field B: bytes4
+	if _, err := abi.EncodeBytes4(value.B, buf[32:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedStruct to ABI bytes
-func (value PackedStruct) Encode() ([]byte, error) {
+// Encode encodes PackedInner to ABI bytes
+func (value PackedInner) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -88,62 +94,68 @@ func (value PackedStruct) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedStruct from ABI bytes in the provided buffer
-func (t *PackedStruct) Decode(data []byte) (int, error) {
-	if len(data) < 96 {
+// Decode decodes PackedInner from ABI bytes in the provided buffer
+func (t *PackedInner) Decode(data []byte) (int, error) {
+	if len(data) < 64 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 96
-	// Decode static field Addr: address
-	t.Addr, _, err = abi.DecodeAddress(data[0:])
+	dynamicOffset := 64
+	// Decode static field A: uint64
+	t.A, _, err = abi.DecodeUint64(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Value: uint256
-	t.Value, _, err = abi.DecodeUint256(data[32:])
+	// Decode static field B: bytes4
+	t.B, _, err = abi.DecodeBytes4(data[32:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field Data: bytes32
-	t.Data, _, err = abi.DecodeBytes32(data[64:])
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedInner from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedInner) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return dynamicOffset, nil
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedStruct
-func (t PackedStruct) PackedEncodedSize() int {
-	return 84
+// DecodeHex decodes PackedInner from the hex string with an optional 0x prefix
+func (t *PackedInner) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedInner: %w", err)
+	}
+	return t.Decode(data)
 }
 
-// PackedEncodeTo encodes PackedStruct to packed ABI bytes in the provided buffer
-func (value PackedStruct) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodedSize returns the packed encoded size of PackedInner
+func (t PackedInner) PackedEncodedSize() int {
+	return 12
+}
+
+// PackedEncodeTo encodes PackedInner to packed ABI bytes in the provided buffer
+func (value PackedInner) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Addr: address
-	n, err = abi.PackedEncodeAddress(value.Addr, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field Value: uint256
-	n, err = abi.PackedEncodeUint256(value.Value, buf[offset:])
+	// Field A: uint64
+	n, err = abi.PackedEncodeUint64(value.A, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field Data: bytes32
-	n, err = abi.PackedEncodeBytes32(value.Data, buf[offset:])
+	// Field B: bytes4
+	n, err = abi.PackedEncodeBytes4(value.B, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -152,8 +164,8 @@ func (value PackedStruct) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedStruct to packed ABI bytes
-func (value PackedStruct) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedInner to packed ABI bytes
+func (value PackedInner) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -161,69 +173,69 @@ func (value PackedStruct) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedStruct from packed ABI bytes
-func (t *PackedStruct) PackedDecode(data []byte) (int, error) {
-	if len(data) < 84 {
+// PackedDecode decodes PackedInner from packed ABI bytes
+func (t *PackedInner) PackedDecode(data []byte) (int, error) {
+	if len(data) < 12 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Addr: address
-	t.Addr, _, err = abi.PackedDecodeAddress(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field Value: uint256
-	t.Value, _, err = abi.PackedDecodeUint256(data[20:])
+	// Decode field A: uint64
+	t.A, _, err = abi.PackedDecodeUint64(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field Data: bytes32
-	t.Data, _, err = abi.PackedDecodeBytes32(data[52:])
+	// Decode field B: bytes4
+	t.B, _, err = abi.PackedDecodeBytes4(data[8:])
 	if err != nil {
 		return 0, err
 	}
-	return 84, nil
+	return 12, nil
 }
 
-var _ abi.Method = (*PackedBoolCall)(nil)
-
-const PackedBoolCallStaticSize = 64
+var _ abi.Tuple = (*PackedInner)(nil)
+var _ abi.Decoder = (*PackedInner)(nil)
+var _ abi.PackedTuple = (*PackedInner)(nil)
 
-var _ abi.Tuple = (*PackedBoolCall)(nil)
-var _ abi.PackedTuple = (*PackedBoolCall)(nil)
+const PackedMiddleStaticSize = 160
 
-// PackedBoolCall represents an ABI tuple
-type PackedBoolCall struct {
-	A bool
-	B bool
+// PackedMiddle represents an ABI tuple
+type PackedMiddle struct {
+	Inner PackedInner
+	Flag  bool
+	Pair  [2]uint16
 }
 
-// EncodedSize returns the total encoded size of PackedBoolCall
-func (t PackedBoolCall) EncodedSize() int {
+// EncodedSize returns the total encoded size of PackedMiddle
+func (t PackedMiddle) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedBoolCallStaticSize + dynamicSize
+	return PackedMiddleStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedBoolCall to ABI bytes in the provided buffer
-func (value PackedBoolCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedMiddle to ABI bytes in the provided buffer
+func (value PackedMiddle) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedBoolCallStaticSize // Start dynamic data after static section
-	// Field A: bool
-	if _, err := abi.EncodeBool(value.A, buf[0:]); err != nil {
+	dynamicOffset := PackedMiddleStaticSize // Start dynamic data after static section
+	// Field Inner: (uint64,bytes4)
+	if _, err := value.Inner.EncodeTo(buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field B: bool
-	if _, err := abi.EncodeBool(value.B, buf[32:]); err != nil {
+	// Field Flag: bool
+	if _, err := abi.EncodeBool(value.Flag, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	// Field Pair: uint16[2]
+	if _, err := PackedEncodeUint16Array2(value.Pair, buf[96:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedBoolCall to ABI bytes
-func (value PackedBoolCall) Encode() ([]byte, error) {
+// Encode encodes PackedMiddle to ABI bytes
+func (value PackedMiddle) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -231,50 +243,80 @@ func (value PackedBoolCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedBoolCall from ABI bytes in the provided buffer
-func (t *PackedBoolCall) Decode(data []byte) (int, error) {
-	if len(data) < 64 {
+// Decode decodes PackedMiddle from ABI bytes in the provided buffer
+func (t *PackedMiddle) Decode(data []byte) (int, error) {
+	if len(data) < 160 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 64
-	// Decode static field A: bool
-	t.A, _, err = abi.DecodeBool(data[0:])
+	dynamicOffset := 160
+	// Decode static field Inner: (uint64,bytes4)
+	_, err = t.Inner.Decode(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field B: bool
-	t.B, _, err = abi.DecodeBool(data[32:])
+	// Decode static field Flag: bool
+	t.Flag, _, err = abi.DecodeBool(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Pair: uint16[2]
+	t.Pair, _, err = PackedDecodeUint16Array2(data[96:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedBoolCall
-func (t PackedBoolCall) PackedEncodedSize() int {
-	return 2
+// DecodeStrict decodes PackedMiddle from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedMiddle) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// PackedEncodeTo encodes PackedBoolCall to packed ABI bytes in the provided buffer
-func (value PackedBoolCall) PackedEncodeTo(buf []byte) (int, error) {
+// DecodeHex decodes PackedMiddle from the hex string with an optional 0x prefix
+func (t *PackedMiddle) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedMiddle: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedMiddle
+func (t PackedMiddle) PackedEncodedSize() int {
+	return 17
+}
+
+// PackedEncodeTo encodes PackedMiddle to packed ABI bytes in the provided buffer
+func (value PackedMiddle) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field A: bool
-	n, err = abi.PackedEncodeBool(value.A, buf[offset:])
+	// Field Inner: (uint64,bytes4)
+	n, err = value.Inner.PackedEncodeTo(buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field B: bool
-	n, err = abi.PackedEncodeBool(value.B, buf[offset:])
+	// Field Flag: bool
+	n, err = abi.PackedEncodeBool(value.Flag, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Pair: uint16[2]
+	n, err = PackedPackedEncodeUint16Array2(value.Pair, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -283,8 +325,8 @@ func (value PackedBoolCall) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedBoolCall to packed ABI bytes
-func (value PackedBoolCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedMiddle to packed ABI bytes
+func (value PackedMiddle) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -292,92 +334,74 @@ func (value PackedBoolCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedBoolCall from packed ABI bytes
-func (t *PackedBoolCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 2 {
+// PackedDecode decodes PackedMiddle from packed ABI bytes
+func (t *PackedMiddle) PackedDecode(data []byte) (int, error) {
+	if len(data) < 17 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field A: bool
-	t.A, _, err = abi.PackedDecodeBool(data[0:])
+	// Decode field Inner: (uint64,bytes4)
+	_, err = t.Inner.PackedDecode(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field B: bool
-	t.B, _, err = abi.PackedDecodeBool(data[1:])
+	// Decode field Flag: bool
+	t.Flag, _, err = abi.PackedDecodeBool(data[12:])
 	if err != nil {
 		return 0, err
 	}
-	return 2, nil
-}
-
-// GetMethodName returns the function name
-func (t PackedBoolCall) GetMethodName() string {
-	return "packedBool"
-}
-
-// GetMethodID returns the function id
-func (t PackedBoolCall) GetMethodID() uint32 {
-	return PackedBoolID
-}
-
-// GetMethodSelector returns the function selector
-func (t PackedBoolCall) GetMethodSelector() [4]byte {
-	return PackedBoolSelector
-}
-
-// EncodeWithSelector encodes packedBool arguments to ABI bytes including function selector
-func (t PackedBoolCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], PackedBoolSelector[:])
-	if _, err := t.EncodeTo(result[4:]); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
-// NewPackedBoolCall constructs a new PackedBoolCall
-func NewPackedBoolCall(
-	a bool,
-	b bool,
-) *PackedBoolCall {
-	return &PackedBoolCall{
-		A: a,
-		B: b,
+	// Decode field Pair: uint16[2]
+	t.Pair, _, err = PackedPackedDecodeUint16Array2(data[13:])
+	if err != nil {
+		return 0, err
 	}
+	return 17, nil
 }
 
-const PackedBoolReturnStaticSize = 32
+var _ abi.Tuple = (*PackedMiddle)(nil)
+var _ abi.Decoder = (*PackedMiddle)(nil)
+var _ abi.PackedTuple = (*PackedMiddle)(nil)
 
-var _ abi.Tuple = (*PackedBoolReturn)(nil)
-var _ abi.PackedTuple = (*PackedBoolReturn)(nil)
+const PackedOuterStaticSize = 320
 
-// PackedBoolReturn represents an ABI tuple
-type PackedBoolReturn struct {
-	Field1 bool
+// PackedOuter represents an ABI tuple
+type PackedOuter struct {
+	Middle PackedMiddle
+	Who    common.Address
+	Inners [2]PackedInner
 }
 
-// EncodedSize returns the total encoded size of PackedBoolReturn
-func (t PackedBoolReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of PackedOuter
+func (t PackedOuter) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedBoolReturnStaticSize + dynamicSize
+	return PackedOuterStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedBoolReturn to ABI bytes in the provided buffer
-func (value PackedBoolReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedOuter to ABI bytes in the provided buffer
+func (value PackedOuter) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedBoolReturnStaticSize // Start dynamic data after static section
-	// Field Field1: bool
-	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+	dynamicOffset := PackedOuterStaticSize // Start dynamic data after static section
+	// Field Middle: ((uint64,bytes4),bool,uint16[2])
+	if _, err := value.Middle.EncodeTo(buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Who: address
+	if _, err := abi.EncodeAddress(value.Who, buf[160:]); err != nil {
+		return 0, err
+	}
+
+	// Field Inners: (uint64,bytes4)[2]
+	if _, err := PackedEncodePackedInnerArray2(value.Inners, buf[192:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedBoolReturn to ABI bytes
-func (value PackedBoolReturn) Encode() ([]byte, error) {
+// Encode encodes PackedOuter to ABI bytes
+func (value PackedOuter) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -385,38 +409,80 @@ func (value PackedBoolReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedBoolReturn from ABI bytes in the provided buffer
-func (t *PackedBoolReturn) Decode(data []byte) (int, error) {
-	if len(data) < 32 {
+// Decode decodes PackedOuter from ABI bytes in the provided buffer
+func (t *PackedOuter) Decode(data []byte) (int, error) {
+	if len(data) < 320 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 32
-	// Decode static field Field1: bool
-	t.Field1, _, err = abi.DecodeBool(data[0:])
+	dynamicOffset := 320
+	// Decode static field Middle: ((uint64,bytes4),bool,uint16[2])
+	_, err = t.Middle.Decode(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Who: address
+	t.Who, _, err = abi.DecodeAddress(data[160:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Inners: (uint64,bytes4)[2]
+	t.Inners, _, err = PackedDecodePackedInnerArray2(data[192:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedBoolReturn
-func (t PackedBoolReturn) PackedEncodedSize() int {
-	return 1
+// DecodeStrict decodes PackedOuter from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedOuter) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// PackedEncodeTo encodes PackedBoolReturn to packed ABI bytes in the provided buffer
-func (value PackedBoolReturn) PackedEncodeTo(buf []byte) (int, error) {
+// DecodeHex decodes PackedOuter from the hex string with an optional 0x prefix
+func (t *PackedOuter) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedOuter: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedOuter
+func (t PackedOuter) PackedEncodedSize() int {
+	return 61
+}
+
+// PackedEncodeTo encodes PackedOuter to packed ABI bytes in the provided buffer
+func (value PackedOuter) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field Field1: bool
-	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	// Field Middle: ((uint64,bytes4),bool,uint16[2])
+	n, err = value.Middle.PackedEncodeTo(buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Who: address
+	n, err = abi.PackedEncodeAddress(value.Who, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Inners: (uint64,bytes4)[2]
+	n, err = PackedPackedEncodePackedInnerArray2(value.Inners, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -425,8 +491,8 @@ func (value PackedBoolReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedBoolReturn to packed ABI bytes
-func (value PackedBoolReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedOuter to packed ABI bytes
+func (value PackedOuter) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -434,59 +500,1228 @@ func (value PackedBoolReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedBoolReturn from packed ABI bytes
-func (t *PackedBoolReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
+// PackedDecode decodes PackedOuter from packed ABI bytes
+func (t *PackedOuter) PackedDecode(data []byte) (int, error) {
+	if len(data) < 61 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	// Decode field Middle: ((uint64,bytes4),bool,uint16[2])
+	_, err = t.Middle.PackedDecode(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	return 1, nil
+	// Decode field Who: address
+	t.Who, _, err = abi.PackedDecodeAddress(data[17:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Inners: (uint64,bytes4)[2]
+	t.Inners, _, err = PackedPackedDecodePackedInnerArray2(data[37:])
+	if err != nil {
+		return 0, err
+	}
+	return 61, nil
 }
 
-var _ abi.Method = (*PackedBytesCall)(nil)
+var _ abi.Tuple = (*PackedOuter)(nil)
+var _ abi.Decoder = (*PackedOuter)(nil)
+var _ abi.PackedTuple = (*PackedOuter)(nil)
+
+const PackedStructStaticSize = 96
+
+// PackedStruct represents an ABI tuple
+type PackedStruct struct {
+	Addr  common.Address
+	Value *big.Int
+	Data  [32]byte
+}
+
+// EncodedSize returns the total encoded size of PackedStruct
+func (t PackedStruct) EncodedSize() int {
+	dynamicSize := 0
+
+	return PackedStructStaticSize + dynamicSize
+}
+
+// EncodeTo encodes PackedStruct to ABI bytes in the provided buffer
+func (value PackedStruct) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedStructStaticSize // Start dynamic data after static section
+	// Field Addr: address
+	if _, err := abi.EncodeAddress(value.Addr, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field Value: uint256
+	if _, err := abi.EncodeUint256(value.Value, buf[32:]); err != nil {
+		return 0, err
+	}
+
+	// Field Data: bytes32
+	if _, err := abi.EncodeBytes32(value.Data, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes PackedStruct to ABI bytes
+func (value PackedStruct) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes PackedStruct from ABI bytes in the provided buffer
+func (t *PackedStruct) Decode(data []byte) (int, error) {
+	if len(data) < 96 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 96
+	// Decode static field Addr: address
+	t.Addr, _, err = abi.DecodeAddress(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Value: uint256
+	t.Value, _, err = abi.DecodeIntoUint256(t.Value, data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field Data: bytes32
+	t.Data, _, err = abi.DecodeBytes32(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedStruct from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedStruct) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedStruct from the hex string with an optional 0x prefix
+func (t *PackedStruct) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedStruct: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedStruct
+func (t PackedStruct) PackedEncodedSize() int {
+	return 84
+}
+
+// PackedEncodeTo encodes PackedStruct to packed ABI bytes in the provided buffer
+func (value PackedStruct) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Addr: address
+	n, err = abi.PackedEncodeAddress(value.Addr, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Value: uint256
+	n, err = abi.PackedEncodeUint256(value.Value, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field Data: bytes32
+	n, err = abi.PackedEncodeBytes32(value.Data, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes PackedStruct to packed ABI bytes
+func (value PackedStruct) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes PackedStruct from packed ABI bytes
+func (t *PackedStruct) PackedDecode(data []byte) (int, error) {
+	if len(data) < 84 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Addr: address
+	t.Addr, _, err = abi.PackedDecodeAddress(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Value: uint256
+	t.Value, _, err = abi.PackedDecodeUint256(data[20:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field Data: bytes32
+	t.Data, _, err = abi.PackedDecodeBytes32(data[52:])
+	if err != nil {
+		return 0, err
+	}
+	return 84, nil
+}
+
+var _ abi.Tuple = (*PackedStruct)(nil)
+var _ abi.Decoder = (*PackedStruct)(nil)
+var _ abi.PackedTuple = (*PackedStruct)(nil)
+
+// PackedEncodePackedInnerArray2 encodes (uint64,bytes4)[2] to ABI bytes
+func PackedEncodePackedInnerArray2(value [2]PackedInner, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := value[0].EncodeTo(buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := value[1].EncodeTo(buf[64:]); err != nil {
+		return 0, err
+	}
+
+	return 128, nil
+}
+
+// PackedEncodeUint16Array2 encodes uint16[2] to ABI bytes
+func PackedEncodeUint16Array2(value [2]uint16, buf []byte) (int, error) {
+	// Encode fixed-size array with static elements
+	if _, err := abi.EncodeUint16(value[0], buf[0:]); err != nil {
+		return 0, err
+	}
+	if _, err := abi.EncodeUint16(value[1], buf[32:]); err != nil {
+		return 0, err
+	}
+
+	return 64, nil
+}
+
+// PackedDecodePackedInnerArray2 decodes (uint64,bytes4)[2] from ABI bytes
+func PackedDecodePackedInnerArray2(data []byte) ([2]PackedInner, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2]PackedInner
+		err    error
+	)
+	if len(data) < 128 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	_, err = result[0].Decode(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	_, err = result[1].Decode(data[64:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 128, nil
+}
+
+// PackedDecodeUint16Array2 decodes uint16[2] from ABI bytes
+func PackedDecodeUint16Array2(data []byte) ([2]uint16, int, error) {
+	// Decode fixed-size array with static elements
+	var (
+		result [2]uint16
+		err    error
+	)
+	if len(data) < 64 {
+		return result, 0, io.ErrUnexpectedEOF
+	}
+	// Element 0
+	result[0], _, err = abi.DecodeUint16(data[0:])
+	if err != nil {
+		return result, 0, err
+	}
+	// Element 1
+	result[1], _, err = abi.DecodeUint16(data[32:])
+	if err != nil {
+		return result, 0, err
+	}
+	return result, 64, nil
+}
+
+// PackedPackedEncodePackedInnerArray2 encodes (uint64,bytes4)[2] to packed ABI bytes (no padding)
+func PackedPackedEncodePackedInnerArray2(value [2]PackedInner, buf []byte) (int, error) {
+	if len(buf) < 24 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := value[i].PackedEncodeTo(buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 24, nil
+}
+
+// PackedPackedEncodeUint16Array2 encodes uint16[2] to packed ABI bytes (no padding)
+func PackedPackedEncodeUint16Array2(value [2]uint16, buf []byte) (int, error) {
+	if len(buf) < 4 {
+		return 0, io.ErrShortBuffer
+	}
+	// Encode fixed-size array elements sequentially (no padding)
+	var offset int
+	for i := 0; i < 2; i++ {
+		n, err := abi.PackedEncodeUint16(value[i], buf[offset:])
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+	return 4, nil
+}
+
+// PackedPackedDecodePackedInnerArray2 decodes (uint64,bytes4)[2] from packed ABI bytes (no padding)
+func PackedPackedDecodePackedInnerArray2(data []byte) ([2]PackedInner, int, error) {
+	if len(data) < 24 {
+		return [2]PackedInner{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2]PackedInner
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		n, err = result[i].PackedDecode(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 24, nil
+}
+
+// PackedPackedDecodeUint16Array2 decodes uint16[2] from packed ABI bytes (no padding)
+func PackedPackedDecodeUint16Array2(data []byte) ([2]uint16, int, error) {
+	if len(data) < 4 {
+		return [2]uint16{}, 0, io.ErrUnexpectedEOF
+	}
+	var (
+		result [2]uint16
+		offset int
+		n      int
+		err    error
+	)
+	for i := 0; i < 2; i++ {
+		result[i], n, err = abi.PackedDecodeUint16(data[offset:])
+		if err != nil {
+			return result, 0, err
+		}
+		offset += n
+	}
+	return result, 4, nil
+}
+
+var _ abi.Method = (*PackedBoolCall)(nil)
+
+const PackedBoolCallStaticSize = 64
+
+// PackedBoolCall represents an ABI tuple
+type PackedBoolCall struct {
+	A bool
+	B bool
+}
+
+// EncodedSize returns the total encoded size of PackedBoolCall
+func (t PackedBoolCall) EncodedSize() int {
+	dynamicSize := 0
+
+	return PackedBoolCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes PackedBoolCall to ABI bytes in the provided buffer
+func (value PackedBoolCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedBoolCallStaticSize // Start dynamic data after static section
+	// Field A: bool
+	if _, err := abi.EncodeBool(value.A, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field B: bool
+	if _, err := abi.EncodeBool(value.B, buf[32:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes PackedBoolCall to ABI bytes
+func (value PackedBoolCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes PackedBoolCall from ABI bytes in the provided buffer
+func (t *PackedBoolCall) Decode(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 64
+	// Decode static field A: bool
+	t.A, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field B: bool
+	t.B, _, err = abi.DecodeBool(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedBoolCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedBoolCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedBoolCall from the hex string with an optional 0x prefix
+func (t *PackedBoolCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBoolCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedBoolCall
+func (t PackedBoolCall) PackedEncodedSize() int {
+	return 2
+}
+
+// PackedEncodeTo encodes PackedBoolCall to packed ABI bytes in the provided buffer
+func (value PackedBoolCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field A: bool
+	n, err = abi.PackedEncodeBool(value.A, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field B: bool
+	n, err = abi.PackedEncodeBool(value.B, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes PackedBoolCall to packed ABI bytes
+func (value PackedBoolCall) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes PackedBoolCall from packed ABI bytes
+func (t *PackedBoolCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field A: bool
+	t.A, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field B: bool
+	t.B, _, err = abi.PackedDecodeBool(data[1:])
+	if err != nil {
+		return 0, err
+	}
+	return 2, nil
+}
+
+var _ abi.Tuple = (*PackedBoolCall)(nil)
+var _ abi.Decoder = (*PackedBoolCall)(nil)
+var _ abi.PackedTuple = (*PackedBoolCall)(nil)
+
+// GetMethodName returns the function name
+func (t PackedBoolCall) GetMethodName() string {
+	return "packedBool"
+}
+
+// GetMethodID returns the function id
+func (t PackedBoolCall) GetMethodID() uint32 {
+	return PackedBoolID
+}
+
+// GetMethodSelector returns the function selector
+func (t PackedBoolCall) GetMethodSelector() [4]byte {
+	return PackedBoolSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of packedBool arguments including function selector
+func (t PackedBoolCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes packedBool arguments to ABI bytes including function selector
+func (t PackedBoolCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], PackedBoolSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes packedBool arguments to 0x prefixed hex string
+func (t PackedBoolCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes packedBool arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedBoolCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the packedBool calldata, returns 0 if encoding fails
+func (t PackedBoolCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes packedBool arguments from ABI bytes including function selector
+func (t *PackedBoolCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedBoolSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedBool arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedBoolCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBoolCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedBool arguments to packed ABI bytes including function selector
+func (t PackedBoolCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedBoolSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedBool arguments from packed ABI bytes including function selector
+func (t *PackedBoolCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedBoolSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewPackedBoolCall constructs a new PackedBoolCall
+func NewPackedBoolCall(
+	a bool,
+	b bool,
+) *PackedBoolCall {
+	return &PackedBoolCall{
+		A: a,
+		B: b,
+	}
+}
+
+const PackedBoolReturnStaticSize = 32
+
+// PackedBoolReturn represents an ABI tuple
+type PackedBoolReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of PackedBoolReturn
+func (t PackedBoolReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return PackedBoolReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes PackedBoolReturn to ABI bytes in the provided buffer
+func (value PackedBoolReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedBoolReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes PackedBoolReturn to ABI bytes
+func (value PackedBoolReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes PackedBoolReturn from ABI bytes in the provided buffer
+func (t *PackedBoolReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedBoolReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedBoolReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedBoolReturn from the hex string with an optional 0x prefix
+func (t *PackedBoolReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBoolReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedBoolReturn
+func (t PackedBoolReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes PackedBoolReturn to packed ABI bytes in the provided buffer
+func (value PackedBoolReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes PackedBoolReturn to packed ABI bytes
+func (value PackedBoolReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes PackedBoolReturn from packed ABI bytes
+func (t *PackedBoolReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*PackedBoolReturn)(nil)
+var _ abi.Decoder = (*PackedBoolReturn)(nil)
+var _ abi.PackedTuple = (*PackedBoolReturn)(nil)
+
+// DecodePackedBoolReturn decodes the return data of packedBool into its values
+func DecodePackedBoolReturn(data []byte) (r1 bool, err error) {
+	var result PackedBoolReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodePackedBool decodes the single return value of packedBool
+func DecodePackedBool(data []byte) (bool, error) {
+	return DecodePackedBoolReturn(data)
+}
+
+// DecodePackedBoolHex decodes the single return value of packedBool from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedBoolHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedBoolReturn: %w", err)
+	}
+	return DecodePackedBoolReturn(data)
+}
+
+// EncodePackedBoolResult encodes the single return value of packedBool, e.g. for the return data of precompiles
+func EncodePackedBoolResult(v bool) ([]byte, error) {
+	result := PackedBoolReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*PackedBytesCall)(nil)
 
 const PackedBytesCallStaticSize = 64
 
-var _ abi.Tuple = (*PackedBytesCall)(nil)
-var _ abi.PackedTuple = (*PackedBytesCall)(nil)
+// PackedBytesCall represents an ABI tuple
+type PackedBytesCall struct {
+	B32 [32]byte
+	B4  [4]byte
+}
+
+// EncodedSize returns the total encoded size of PackedBytesCall
+func (t PackedBytesCall) EncodedSize() int {
+	dynamicSize := 0
+
+	return PackedBytesCallStaticSize + dynamicSize
+}
+
+// EncodeTo encodes PackedBytesCall to ABI bytes in the provided buffer
+func (value PackedBytesCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedBytesCallStaticSize // Start dynamic data after static section
+	// Field B32: bytes32
+	if _, err := abi.EncodeBytes32(value.B32, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	// Field B4: bytes4
+	if _, err := abi.EncodeBytes4(value.B4, buf[32:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes PackedBytesCall to ABI bytes
+func (value PackedBytesCall) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes PackedBytesCall from ABI bytes in the provided buffer
+func (t *PackedBytesCall) Decode(data []byte) (int, error) {
+	if len(data) < 64 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 64
+	// Decode static field B32: bytes32
+	t.B32, _, err = abi.DecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field B4: bytes4
+	t.B4, _, err = abi.DecodeBytes4(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedBytesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedBytesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedBytesCall from the hex string with an optional 0x prefix
+func (t *PackedBytesCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBytesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedBytesCall
+func (t PackedBytesCall) PackedEncodedSize() int {
+	return 36
+}
+
+// PackedEncodeTo encodes PackedBytesCall to packed ABI bytes in the provided buffer
+func (value PackedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field B32: bytes32
+	n, err = abi.PackedEncodeBytes32(value.B32, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field B4: bytes4
+	n, err = abi.PackedEncodeBytes4(value.B4, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes PackedBytesCall to packed ABI bytes
+func (value PackedBytesCall) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes PackedBytesCall from packed ABI bytes
+func (t *PackedBytesCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 36 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field B32: bytes32
+	t.B32, _, err = abi.PackedDecodeBytes32(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field B4: bytes4
+	t.B4, _, err = abi.PackedDecodeBytes4(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	return 36, nil
+}
+
+var _ abi.Tuple = (*PackedBytesCall)(nil)
+var _ abi.Decoder = (*PackedBytesCall)(nil)
+var _ abi.PackedTuple = (*PackedBytesCall)(nil)
+
+// GetMethodName returns the function name
+func (t PackedBytesCall) GetMethodName() string {
+	return "packedBytes"
+}
+
+// GetMethodID returns the function id
+func (t PackedBytesCall) GetMethodID() uint32 {
+	return PackedBytesID
+}
+
+// GetMethodSelector returns the function selector
+func (t PackedBytesCall) GetMethodSelector() [4]byte {
+	return PackedBytesSelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of packedBytes arguments including function selector
+func (t PackedBytesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes packedBytes arguments to ABI bytes including function selector
+func (t PackedBytesCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], PackedBytesSelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes packedBytes arguments to 0x prefixed hex string
+func (t PackedBytesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes packedBytes arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedBytesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the packedBytes calldata, returns 0 if encoding fails
+func (t PackedBytesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes packedBytes arguments from ABI bytes including function selector
+func (t *PackedBytesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedBytesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedBytes arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedBytesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBytesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedBytes arguments to packed ABI bytes including function selector
+func (t PackedBytesCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedBytesSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedBytes arguments from packed ABI bytes including function selector
+func (t *PackedBytesCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedBytesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewPackedBytesCall constructs a new PackedBytesCall
+func NewPackedBytesCall(
+	b32 [32]byte,
+	b4 [4]byte,
+) *PackedBytesCall {
+	return &PackedBytesCall{
+		B32: b32,
+		B4:  b4,
+	}
+}
+
+const PackedBytesReturnStaticSize = 32
+
+// PackedBytesReturn represents an ABI tuple
+type PackedBytesReturn struct {
+	Field1 bool
+}
+
+// EncodedSize returns the total encoded size of PackedBytesReturn
+func (t PackedBytesReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return PackedBytesReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes PackedBytesReturn to ABI bytes in the provided buffer
+func (value PackedBytesReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedBytesReturnStaticSize // Start dynamic data after static section
+	// Field Field1: bool
+	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes PackedBytesReturn to ABI bytes
+func (value PackedBytesReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes PackedBytesReturn from ABI bytes in the provided buffer
+func (t *PackedBytesReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: bool
+	t.Field1, _, err = abi.DecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedBytesReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedBytesReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedBytesReturn from the hex string with an optional 0x prefix
+func (t *PackedBytesReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedBytesReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedBytesReturn
+func (t PackedBytesReturn) PackedEncodedSize() int {
+	return 1
+}
+
+// PackedEncodeTo encodes PackedBytesReturn to packed ABI bytes in the provided buffer
+func (value PackedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: bool
+	n, err = abi.PackedEncodeBool(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes PackedBytesReturn to packed ABI bytes
+func (value PackedBytesReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes PackedBytesReturn from packed ABI bytes
+func (t *PackedBytesReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*PackedBytesReturn)(nil)
+var _ abi.Decoder = (*PackedBytesReturn)(nil)
+var _ abi.PackedTuple = (*PackedBytesReturn)(nil)
 
-// PackedBytesCall represents an ABI tuple
-type PackedBytesCall struct {
-	B32 [32]byte
-	B4  [4]byte
+// DecodePackedBytesReturn decodes the return data of packedBytes into its values
+func DecodePackedBytesReturn(data []byte) (r1 bool, err error) {
+	var result PackedBytesReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
 }
 
-// EncodedSize returns the total encoded size of PackedBytesCall
-func (t PackedBytesCall) EncodedSize() int {
+// DecodePackedBytes decodes the single return value of packedBytes
+func DecodePackedBytes(data []byte) (bool, error) {
+	return DecodePackedBytesReturn(data)
+}
+
+// DecodePackedBytesHex decodes the single return value of packedBytes from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedBytesHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedBytesReturn: %w", err)
+	}
+	return DecodePackedBytesReturn(data)
+}
+
+// EncodePackedBytesResult encodes the single return value of packedBytes, e.g. for the return data of precompiles
+func EncodePackedBytesResult(v bool) ([]byte, error) {
+	result := PackedBytesReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*PackedIntermediateCall)(nil)
+
+const PackedIntermediateCallStaticSize = 128
+
+// PackedIntermediateCall represents an ABI tuple
+type PackedIntermediateCall struct {
+	U24 uint32
+	U40 uint64
+	I24 int32
+	I40 int64
+}
+
+// EncodedSize returns the total encoded size of PackedIntermediateCall
+func (t PackedIntermediateCall) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedBytesCallStaticSize + dynamicSize
+	return PackedIntermediateCallStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedBytesCall to ABI bytes in the provided buffer
-func (value PackedBytesCall) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedIntermediateCall to ABI bytes in the provided buffer
+func (value PackedIntermediateCall) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedBytesCallStaticSize // Start dynamic data after static section
-	// Field B32: bytes32
-	if _, err := abi.EncodeBytes32(value.B32, buf[0:]); err != nil {
+	dynamicOffset := PackedIntermediateCallStaticSize // Start dynamic data after static section
+	// Field U24: uint24
+	if _, err := abi.EncodeUint24(value.U24, buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field B4: bytes4
-	if _, err := abi.EncodeBytes4(value.B4, buf[32:]); err != nil {
+	// Field U40: uint40
+	if _, err := abi.EncodeUint40(value.U40, buf[32:]); err != nil {
+		return 0, err
+	}
+
+	// Field I24: int24
+	if _, err := abi.EncodeInt24(value.I24, buf[64:]); err != nil {
+		return 0, err
+	}
+
+	// Field I40: int40
+	if _, err := abi.EncodeInt40(value.I40, buf[96:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedBytesCall to ABI bytes
-func (value PackedBytesCall) Encode() ([]byte, error) {
+// Encode encodes PackedIntermediateCall to ABI bytes
+func (value PackedIntermediateCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -494,50 +1729,92 @@ func (value PackedBytesCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedBytesCall from ABI bytes in the provided buffer
-func (t *PackedBytesCall) Decode(data []byte) (int, error) {
-	if len(data) < 64 {
+// Decode decodes PackedIntermediateCall from ABI bytes in the provided buffer
+func (t *PackedIntermediateCall) Decode(data []byte) (int, error) {
+	if len(data) < 128 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 64
-	// Decode static field B32: bytes32
-	t.B32, _, err = abi.DecodeBytes32(data[0:])
+	dynamicOffset := 128
+	// Decode static field U24: uint24
+	t.U24, _, err = abi.DecodeUint24(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field B4: bytes4
-	t.B4, _, err = abi.DecodeBytes4(data[32:])
+	// Decode static field U40: uint40
+	t.U40, _, err = abi.DecodeUint40(data[32:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I24: int24
+	t.I24, _, err = abi.DecodeInt24(data[64:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode static field I40: int40
+	t.I40, _, err = abi.DecodeInt40(data[96:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedBytesCall
-func (t PackedBytesCall) PackedEncodedSize() int {
-	return 36
+// DecodeStrict decodes PackedIntermediateCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedIntermediateCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
 }
 
-// PackedEncodeTo encodes PackedBytesCall to packed ABI bytes in the provided buffer
-func (value PackedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
+// DecodeHex decodes PackedIntermediateCall from the hex string with an optional 0x prefix
+func (t *PackedIntermediateCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedIntermediateCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedIntermediateCall
+func (t PackedIntermediateCall) PackedEncodedSize() int {
+	return 16
+}
+
+// PackedEncodeTo encodes PackedIntermediateCall to packed ABI bytes in the provided buffer
+func (value PackedIntermediateCall) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field B32: bytes32
-	n, err = abi.PackedEncodeBytes32(value.B32, buf[offset:])
+	// Field U24: uint24
+	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field B4: bytes4
-	n, err = abi.PackedEncodeBytes4(value.B4, buf[offset:])
+	// Field U40: uint40
+	n, err = abi.PackedEncodeUint40(value.U40, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field I24: int24
+	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	// Field I40: int40
+	n, err = abi.PackedEncodeInt40(value.I40, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -546,8 +1823,8 @@ func (value PackedBytesCall) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedBytesCall to packed ABI bytes
-func (value PackedBytesCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedIntermediateCall to packed ABI bytes
+func (value PackedIntermediateCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -555,82 +1832,180 @@ func (value PackedBytesCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedBytesCall from packed ABI bytes
-func (t *PackedBytesCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 36 {
+// PackedDecode decodes PackedIntermediateCall from packed ABI bytes
+func (t *PackedIntermediateCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 16 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field B32: bytes32
-	t.B32, _, err = abi.PackedDecodeBytes32(data[0:])
+	// Decode field U24: uint24
+	t.U24, _, err = abi.PackedDecodeUint24(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field B4: bytes4
-	t.B4, _, err = abi.PackedDecodeBytes4(data[32:])
+	// Decode field U40: uint40
+	t.U40, _, err = abi.PackedDecodeUint40(data[3:])
 	if err != nil {
 		return 0, err
 	}
-	return 36, nil
+	// Decode field I24: int24
+	t.I24, _, err = abi.PackedDecodeInt24(data[8:])
+	if err != nil {
+		return 0, err
+	}
+	// Decode field I40: int40
+	t.I40, _, err = abi.PackedDecodeInt40(data[11:])
+	if err != nil {
+		return 0, err
+	}
+	return 16, nil
 }
 
+var _ abi.Tuple = (*PackedIntermediateCall)(nil)
+var _ abi.Decoder = (*PackedIntermediateCall)(nil)
+var _ abi.PackedTuple = (*PackedIntermediateCall)(nil)
+
 // GetMethodName returns the function name
-func (t PackedBytesCall) GetMethodName() string {
-	return "packedBytes"
+func (t PackedIntermediateCall) GetMethodName() string {
+	return "packedIntermediate"
 }
 
 // GetMethodID returns the function id
-func (t PackedBytesCall) GetMethodID() uint32 {
-	return PackedBytesID
+func (t PackedIntermediateCall) GetMethodID() uint32 {
+	return PackedIntermediateID
 }
 
 // GetMethodSelector returns the function selector
-func (t PackedBytesCall) GetMethodSelector() [4]byte {
-	return PackedBytesSelector
+func (t PackedIntermediateCall) GetMethodSelector() [4]byte {
+	return PackedIntermediateSelector
 }
 
-// EncodeWithSelector encodes packedBytes arguments to ABI bytes including function selector
-func (t PackedBytesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], PackedBytesSelector[:])
+// EncodedSizeWithSelector returns the encoded size of packedIntermediate arguments including function selector
+func (t PackedIntermediateCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes packedIntermediate arguments to ABI bytes including function selector
+func (t PackedIntermediateCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], PackedIntermediateSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewPackedBytesCall constructs a new PackedBytesCall
-func NewPackedBytesCall(
-	b32 [32]byte,
-	b4 [4]byte,
-) *PackedBytesCall {
-	return &PackedBytesCall{
-		B32: b32,
-		B4:  b4,
+// EncodeHex encodes packedIntermediate arguments to 0x prefixed hex string
+func (t PackedIntermediateCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const PackedBytesReturnStaticSize = 32
+// EncodeHexWithSelector encodes packedIntermediate arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedIntermediateCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*PackedBytesReturn)(nil)
-var _ abi.PackedTuple = (*PackedBytesReturn)(nil)
+// CalldataCost returns the gas cost of the packedIntermediate calldata, returns 0 if encoding fails
+func (t PackedIntermediateCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-// PackedBytesReturn represents an ABI tuple
-type PackedBytesReturn struct {
+// DecodeWithSelector decodes packedIntermediate arguments from ABI bytes including function selector
+func (t *PackedIntermediateCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedIntermediateSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedIntermediate arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedIntermediateCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedIntermediateCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedIntermediate arguments to packed ABI bytes including function selector
+func (t PackedIntermediateCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedIntermediateSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedIntermediate arguments from packed ABI bytes including function selector
+func (t *PackedIntermediateCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedIntermediateSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewPackedIntermediateCall constructs a new PackedIntermediateCall
+func NewPackedIntermediateCall(
+	u24 uint32,
+	u40 uint64,
+	i24 int32,
+	i40 int64,
+) *PackedIntermediateCall {
+	return &PackedIntermediateCall{
+		U24: u24,
+		U40: u40,
+		I24: i24,
+		I40: i40,
+	}
+}
+
+const PackedIntermediateReturnStaticSize = 32
+
+// PackedIntermediateReturn represents an ABI tuple
+type PackedIntermediateReturn struct {
 	Field1 bool
 }
 
-// EncodedSize returns the total encoded size of PackedBytesReturn
-func (t PackedBytesReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of PackedIntermediateReturn
+func (t PackedIntermediateReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedBytesReturnStaticSize + dynamicSize
+	return PackedIntermediateReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedBytesReturn to ABI bytes in the provided buffer
-func (value PackedBytesReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedIntermediateReturn to ABI bytes in the provided buffer
+func (value PackedIntermediateReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedBytesReturnStaticSize // Start dynamic data after static section
+	dynamicOffset := PackedIntermediateReturnStaticSize // Start dynamic data after static section
 	// Field Field1: bool
 	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
@@ -639,8 +2014,8 @@ func (value PackedBytesReturn) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedBytesReturn to ABI bytes
-func (value PackedBytesReturn) Encode() ([]byte, error) {
+// Encode encodes PackedIntermediateReturn to ABI bytes
+func (value PackedIntermediateReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -648,8 +2023,8 @@ func (value PackedBytesReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedBytesReturn from ABI bytes in the provided buffer
-func (t *PackedBytesReturn) Decode(data []byte) (int, error) {
+// Decode decodes PackedIntermediateReturn from ABI bytes in the provided buffer
+func (t *PackedIntermediateReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -665,13 +2040,32 @@ func (t *PackedBytesReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedBytesReturn
-func (t PackedBytesReturn) PackedEncodedSize() int {
+// DecodeStrict decodes PackedIntermediateReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedIntermediateReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedIntermediateReturn from the hex string with an optional 0x prefix
+func (t *PackedIntermediateReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedIntermediateReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedIntermediateReturn
+func (t PackedIntermediateReturn) PackedEncodedSize() int {
 	return 1
 }
 
-// PackedEncodeTo encodes PackedBytesReturn to packed ABI bytes in the provided buffer
-func (value PackedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes PackedIntermediateReturn to packed ABI bytes in the provided buffer
+func (value PackedIntermediateReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
@@ -688,8 +2082,8 @@ func (value PackedBytesReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedBytesReturn to packed ABI bytes
-func (value PackedBytesReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedIntermediateReturn to packed ABI bytes
+func (value PackedIntermediateReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -697,8 +2091,8 @@ func (value PackedBytesReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedBytesReturn from packed ABI bytes
-func (t *PackedBytesReturn) PackedDecode(data []byte) (int, error) {
+// PackedDecode decodes PackedIntermediateReturn from packed ABI bytes
+func (t *PackedIntermediateReturn) PackedDecode(data []byte) (int, error) {
 	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -711,57 +2105,77 @@ func (t *PackedBytesReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
-var _ abi.Method = (*PackedIntermediateCall)(nil)
+var _ abi.Tuple = (*PackedIntermediateReturn)(nil)
+var _ abi.Decoder = (*PackedIntermediateReturn)(nil)
+var _ abi.PackedTuple = (*PackedIntermediateReturn)(nil)
 
-const PackedIntermediateCallStaticSize = 128
+// DecodePackedIntermediateReturn decodes the return data of packedIntermediate into its values
+func DecodePackedIntermediateReturn(data []byte) (r1 bool, err error) {
+	var result PackedIntermediateReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
 
-var _ abi.Tuple = (*PackedIntermediateCall)(nil)
-var _ abi.PackedTuple = (*PackedIntermediateCall)(nil)
+// DecodePackedIntermediate decodes the single return value of packedIntermediate
+func DecodePackedIntermediate(data []byte) (bool, error) {
+	return DecodePackedIntermediateReturn(data)
+}
 
-// PackedIntermediateCall represents an ABI tuple
-type PackedIntermediateCall struct {
-	U24 uint32
-	U40 uint64
-	I24 int32
-	I40 int64
+// DecodePackedIntermediateHex decodes the single return value of packedIntermediate from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedIntermediateHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedIntermediateReturn: %w", err)
+	}
+	return DecodePackedIntermediateReturn(data)
 }
 
-// EncodedSize returns the total encoded size of PackedIntermediateCall
-func (t PackedIntermediateCall) EncodedSize() int {
-	dynamicSize := 0
+// EncodePackedIntermediateResult encodes the single return value of packedIntermediate, e.g. for the return data of precompiles
+func EncodePackedIntermediateResult(v bool) ([]byte, error) {
+	result := PackedIntermediateReturn{Field1: v}
+	return result.Encode()
+}
 
-	return PackedIntermediateCallStaticSize + dynamicSize
+var _ abi.Method = (*PackedNestedCall)(nil)
+
+const PackedNestedCallStaticSize = 352
+
+// PackedNestedCall represents an ABI tuple
+type PackedNestedCall struct {
+	Outer PackedOuter
+	Tail  uint8
 }
 
-// EncodeTo encodes PackedIntermediateCall to ABI bytes in the provided buffer
-func (value PackedIntermediateCall) EncodeTo(buf []byte) (int, error) {
-	// Encode tuple fields
-	dynamicOffset := PackedIntermediateCallStaticSize // Start dynamic data after static section
-	// Field U24: uint24
-	if _, err := abi.EncodeUint24(value.U24, buf[0:]); err != nil {
-		return 0, err
-	}
+// EncodedSize returns the total encoded size of PackedNestedCall
+func (t PackedNestedCall) EncodedSize() int {
+	dynamicSize := 0
 
-	// Field U40: uint40
-	if _, err := abi.EncodeUint40(value.U40, buf[32:]); err != nil {
-		return 0, err
-	}
+	return PackedNestedCallStaticSize + dynamicSize
+}
 
-	// Field I24: int24
-	if _, err := abi.EncodeInt24(value.I24, buf[64:]); err != nil {
+// EncodeTo encodes PackedNestedCall to ABI bytes in the provided buffer
+func (value PackedNestedCall) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := PackedNestedCallStaticSize // Start dynamic data after static section
+	// Field Outer: (((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2])
+	if _, err := value.Outer.EncodeTo(buf[0:]); err != nil {
 		return 0, err
 	}
 
-	// Field I40: int40
-	if _, err := abi.EncodeInt40(value.I40, buf[96:]); err != nil {
+	// Field Tail: uint8
+	if _, err := abi.EncodeUint8(value.Tail, buf[320:]); err != nil {
 		return 0, err
 	}
 
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedIntermediateCall to ABI bytes
-func (value PackedIntermediateCall) Encode() ([]byte, error) {
+// Encode encodes PackedNestedCall to ABI bytes
+func (value PackedNestedCall) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -769,74 +2183,68 @@ func (value PackedIntermediateCall) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedIntermediateCall from ABI bytes in the provided buffer
-func (t *PackedIntermediateCall) Decode(data []byte) (int, error) {
-	if len(data) < 128 {
+// Decode decodes PackedNestedCall from ABI bytes in the provided buffer
+func (t *PackedNestedCall) Decode(data []byte) (int, error) {
+	if len(data) < 352 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var (
 		err error
 	)
-	dynamicOffset := 128
-	// Decode static field U24: uint24
-	t.U24, _, err = abi.DecodeUint24(data[0:])
+	dynamicOffset := 352
+	// Decode static field Outer: (((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2])
+	_, err = t.Outer.Decode(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field U40: uint40
-	t.U40, _, err = abi.DecodeUint40(data[32:])
+	// Decode static field Tail: uint8
+	t.Tail, _, err = abi.DecodeUint8(data[320:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode static field I24: int24
-	t.I24, _, err = abi.DecodeInt24(data[64:])
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes PackedNestedCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedNestedCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	// Decode static field I40: int40
-	t.I40, _, err = abi.DecodeInt40(data[96:])
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedNestedCall from the hex string with an optional 0x prefix
+func (t *PackedNestedCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("decode PackedNestedCall: %w", err)
 	}
-	return dynamicOffset, nil
+	return t.Decode(data)
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedIntermediateCall
-func (t PackedIntermediateCall) PackedEncodedSize() int {
-	return 16
+// PackedEncodedSize returns the packed encoded size of PackedNestedCall
+func (t PackedNestedCall) PackedEncodedSize() int {
+	return 62
 }
 
-// PackedEncodeTo encodes PackedIntermediateCall to packed ABI bytes in the provided buffer
-func (value PackedIntermediateCall) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes PackedNestedCall to packed ABI bytes in the provided buffer
+func (value PackedNestedCall) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
 		n      int
 		err    error
 	)
-	// Field U24: uint24
-	n, err = abi.PackedEncodeUint24(value.U24, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field U40: uint40
-	n, err = abi.PackedEncodeUint40(value.U40, buf[offset:])
-	if err != nil {
-		return 0, err
-	}
-	offset += n
-
-	// Field I24: int24
-	n, err = abi.PackedEncodeInt24(value.I24, buf[offset:])
+	// Field Outer: (((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2])
+	n, err = value.Outer.PackedEncodeTo(buf[offset:])
 	if err != nil {
 		return 0, err
 	}
 	offset += n
 
-	// Field I40: int40
-	n, err = abi.PackedEncodeInt40(value.I40, buf[offset:])
+	// Field Tail: uint8
+	n, err = abi.PackedEncodeUint8(value.Tail, buf[offset:])
 	if err != nil {
 		return 0, err
 	}
@@ -845,8 +2253,8 @@ func (value PackedIntermediateCall) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedIntermediateCall to packed ABI bytes
-func (value PackedIntermediateCall) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedNestedCall to packed ABI bytes
+func (value PackedNestedCall) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -854,96 +2262,166 @@ func (value PackedIntermediateCall) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedIntermediateCall from packed ABI bytes
-func (t *PackedIntermediateCall) PackedDecode(data []byte) (int, error) {
-	if len(data) < 16 {
+// PackedDecode decodes PackedNestedCall from packed ABI bytes
+func (t *PackedNestedCall) PackedDecode(data []byte) (int, error) {
+	if len(data) < 62 {
 		return 0, io.ErrUnexpectedEOF
 	}
 	var err error
-	// Decode field U24: uint24
-	t.U24, _, err = abi.PackedDecodeUint24(data[0:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field U40: uint40
-	t.U40, _, err = abi.PackedDecodeUint40(data[3:])
-	if err != nil {
-		return 0, err
-	}
-	// Decode field I24: int24
-	t.I24, _, err = abi.PackedDecodeInt24(data[8:])
+	// Decode field Outer: (((uint64,bytes4),bool,uint16[2]),address,(uint64,bytes4)[2])
+	_, err = t.Outer.PackedDecode(data[0:])
 	if err != nil {
 		return 0, err
 	}
-	// Decode field I40: int40
-	t.I40, _, err = abi.PackedDecodeInt40(data[11:])
+	// Decode field Tail: uint8
+	t.Tail, _, err = abi.PackedDecodeUint8(data[61:])
 	if err != nil {
 		return 0, err
 	}
-	return 16, nil
+	return 62, nil
 }
 
+var _ abi.Tuple = (*PackedNestedCall)(nil)
+var _ abi.Decoder = (*PackedNestedCall)(nil)
+var _ abi.PackedTuple = (*PackedNestedCall)(nil)
+
 // GetMethodName returns the function name
-func (t PackedIntermediateCall) GetMethodName() string {
-	return "packedIntermediate"
+func (t PackedNestedCall) GetMethodName() string {
+	return "packedNested"
 }
 
 // GetMethodID returns the function id
-func (t PackedIntermediateCall) GetMethodID() uint32 {
-	return PackedIntermediateID
+func (t PackedNestedCall) GetMethodID() uint32 {
+	return PackedNestedID
 }
 
 // GetMethodSelector returns the function selector
-func (t PackedIntermediateCall) GetMethodSelector() [4]byte {
-	return PackedIntermediateSelector
+func (t PackedNestedCall) GetMethodSelector() [4]byte {
+	return PackedNestedSelector
 }
 
-// EncodeWithSelector encodes packedIntermediate arguments to ABI bytes including function selector
-func (t PackedIntermediateCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
-	copy(result[:4], PackedIntermediateSelector[:])
+// EncodedSizeWithSelector returns the encoded size of packedNested arguments including function selector
+func (t PackedNestedCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes packedNested arguments to ABI bytes including function selector
+func (t PackedNestedCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], PackedNestedSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
-// NewPackedIntermediateCall constructs a new PackedIntermediateCall
-func NewPackedIntermediateCall(
-	u24 uint32,
-	u40 uint64,
-	i24 int32,
-	i40 int64,
-) *PackedIntermediateCall {
-	return &PackedIntermediateCall{
-		U24: u24,
-		U40: u40,
-		I24: i24,
-		I40: i40,
+// EncodeHex encodes packedNested arguments to 0x prefixed hex string
+func (t PackedNestedCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
 	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const PackedIntermediateReturnStaticSize = 32
+// EncodeHexWithSelector encodes packedNested arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedNestedCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*PackedIntermediateReturn)(nil)
-var _ abi.PackedTuple = (*PackedIntermediateReturn)(nil)
+// CalldataCost returns the gas cost of the packedNested calldata, returns 0 if encoding fails
+func (t PackedNestedCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
 
-// PackedIntermediateReturn represents an ABI tuple
-type PackedIntermediateReturn struct {
+// DecodeWithSelector decodes packedNested arguments from ABI bytes including function selector
+func (t *PackedNestedCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedNestedSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedNested arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedNestedCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedNestedCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedNested arguments to packed ABI bytes including function selector
+func (t PackedNestedCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedNestedSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedNested arguments from packed ABI bytes including function selector
+func (t *PackedNestedCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedNestedSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// NewPackedNestedCall constructs a new PackedNestedCall
+func NewPackedNestedCall(
+	outer PackedOuter,
+	tail uint8,
+) *PackedNestedCall {
+	return &PackedNestedCall{
+		Outer: outer,
+		Tail:  tail,
+	}
+}
+
+const PackedNestedReturnStaticSize = 32
+
+// PackedNestedReturn represents an ABI tuple
+type PackedNestedReturn struct {
 	Field1 bool
 }
 
-// EncodedSize returns the total encoded size of PackedIntermediateReturn
-func (t PackedIntermediateReturn) EncodedSize() int {
+// EncodedSize returns the total encoded size of PackedNestedReturn
+func (t PackedNestedReturn) EncodedSize() int {
 	dynamicSize := 0
 
-	return PackedIntermediateReturnStaticSize + dynamicSize
+	return PackedNestedReturnStaticSize + dynamicSize
 }
 
-// EncodeTo encodes PackedIntermediateReturn to ABI bytes in the provided buffer
-func (value PackedIntermediateReturn) EncodeTo(buf []byte) (int, error) {
+// EncodeTo encodes PackedNestedReturn to ABI bytes in the provided buffer
+func (value PackedNestedReturn) EncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields
-	dynamicOffset := PackedIntermediateReturnStaticSize // Start dynamic data after static section
+	dynamicOffset := PackedNestedReturnStaticSize // Start dynamic data after static section
 	// Field Field1: bool
 	if _, err := abi.EncodeBool(value.Field1, buf[0:]); err != nil {
 		return 0, err
@@ -952,8 +2430,8 @@ func (value PackedIntermediateReturn) EncodeTo(buf []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// Encode encodes PackedIntermediateReturn to ABI bytes
-func (value PackedIntermediateReturn) Encode() ([]byte, error) {
+// Encode encodes PackedNestedReturn to ABI bytes
+func (value PackedNestedReturn) Encode() ([]byte, error) {
 	buf := make([]byte, value.EncodedSize())
 	if _, err := value.EncodeTo(buf); err != nil {
 		return nil, err
@@ -961,8 +2439,8 @@ func (value PackedIntermediateReturn) Encode() ([]byte, error) {
 	return buf, nil
 }
 
-// Decode decodes PackedIntermediateReturn from ABI bytes in the provided buffer
-func (t *PackedIntermediateReturn) Decode(data []byte) (int, error) {
+// Decode decodes PackedNestedReturn from ABI bytes in the provided buffer
+func (t *PackedNestedReturn) Decode(data []byte) (int, error) {
 	if len(data) < 32 {
 		return 0, io.ErrUnexpectedEOF
 	}
@@ -978,13 +2456,32 @@ func (t *PackedIntermediateReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-// PackedEncodedSize returns the packed encoded size of PackedIntermediateReturn
-func (t PackedIntermediateReturn) PackedEncodedSize() int {
+// DecodeStrict decodes PackedNestedReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedNestedReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedNestedReturn from the hex string with an optional 0x prefix
+func (t *PackedNestedReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedNestedReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of PackedNestedReturn
+func (t PackedNestedReturn) PackedEncodedSize() int {
 	return 1
 }
 
-// PackedEncodeTo encodes PackedIntermediateReturn to packed ABI bytes in the provided buffer
-func (value PackedIntermediateReturn) PackedEncodeTo(buf []byte) (int, error) {
+// PackedEncodeTo encodes PackedNestedReturn to packed ABI bytes in the provided buffer
+func (value PackedNestedReturn) PackedEncodeTo(buf []byte) (int, error) {
 	// Encode tuple fields sequentially (packed, no dynamic section)
 	var (
 		offset int
@@ -1001,8 +2498,8 @@ func (value PackedIntermediateReturn) PackedEncodeTo(buf []byte) (int, error) {
 	return offset, nil
 }
 
-// PackedEncode encodes PackedIntermediateReturn to packed ABI bytes
-func (value PackedIntermediateReturn) PackedEncode() ([]byte, error) {
+// PackedEncode encodes PackedNestedReturn to packed ABI bytes
+func (value PackedNestedReturn) PackedEncode() ([]byte, error) {
 	buf := make([]byte, value.PackedEncodedSize())
 	if _, err := value.PackedEncodeTo(buf); err != nil {
 		return nil, err
@@ -1010,27 +2507,59 @@ func (value PackedIntermediateReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes PackedIntermediateReturn from packed ABI bytes
-func (t *PackedIntermediateReturn) PackedDecode(data []byte) (int, error) {
+// PackedDecode decodes PackedNestedReturn from packed ABI bytes
+func (t *PackedNestedReturn) PackedDecode(data []byte) (int, error) {
 	if len(data) < 1 {
 		return 0, io.ErrUnexpectedEOF
 	}
-	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*PackedNestedReturn)(nil)
+var _ abi.Decoder = (*PackedNestedReturn)(nil)
+var _ abi.PackedTuple = (*PackedNestedReturn)(nil)
+
+// DecodePackedNestedReturn decodes the return data of packedNested into its values
+func DecodePackedNestedReturn(data []byte) (r1 bool, err error) {
+	var result PackedNestedReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodePackedNested decodes the single return value of packedNested
+func DecodePackedNested(data []byte) (bool, error) {
+	return DecodePackedNestedReturn(data)
+}
+
+// DecodePackedNestedHex decodes the single return value of packedNested from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedNestedHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		var zero bool
+		return zero, fmt.Errorf("decode PackedNestedReturn: %w", err)
 	}
-	return 1, nil
+	return DecodePackedNestedReturn(data)
+}
+
+// EncodePackedNestedResult encodes the single return value of packedNested, e.g. for the return data of precompiles
+func EncodePackedNestedResult(v bool) ([]byte, error) {
+	result := PackedNestedReturn{Field1: v}
+	return result.Encode()
 }
 
 var _ abi.Method = (*PackedSmallIntsCall)(nil)
 
 const PackedSmallIntsCallStaticSize = 256
 
-var _ abi.Tuple = (*PackedSmallIntsCall)(nil)
-var _ abi.PackedTuple = (*PackedSmallIntsCall)(nil)
-
 // PackedSmallIntsCall represents an ABI tuple
 type PackedSmallIntsCall struct {
 	U8  uint8
@@ -1158,6 +2687,24 @@ func (t *PackedSmallIntsCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedSmallIntsCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedSmallIntsCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedSmallIntsCall from the hex string with an optional 0x prefix
+func (t *PackedSmallIntsCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedSmallIntsCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedSmallIntsCall
 func (t PackedSmallIntsCall) PackedEncodedSize() int {
 	return 30
@@ -1288,6 +2835,10 @@ func (t *PackedSmallIntsCall) PackedDecode(data []byte) (int, error) {
 	return 30, nil
 }
 
+var _ abi.Tuple = (*PackedSmallIntsCall)(nil)
+var _ abi.Decoder = (*PackedSmallIntsCall)(nil)
+var _ abi.PackedTuple = (*PackedSmallIntsCall)(nil)
+
 // GetMethodName returns the function name
 func (t PackedSmallIntsCall) GetMethodName() string {
 	return "packedSmallInts"
@@ -1303,9 +2854,14 @@ func (t PackedSmallIntsCall) GetMethodSelector() [4]byte {
 	return PackedSmallIntsSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of packedSmallInts arguments including function selector
+func (t PackedSmallIntsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes packedSmallInts arguments to ABI bytes including function selector
 func (t PackedSmallIntsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], PackedSmallIntsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1313,6 +2869,84 @@ func (t PackedSmallIntsCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes packedSmallInts arguments to 0x prefixed hex string
+func (t PackedSmallIntsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes packedSmallInts arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedSmallIntsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the packedSmallInts calldata, returns 0 if encoding fails
+func (t PackedSmallIntsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes packedSmallInts arguments from ABI bytes including function selector
+func (t *PackedSmallIntsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedSmallIntsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedSmallInts arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedSmallIntsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedSmallIntsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedSmallInts arguments to packed ABI bytes including function selector
+func (t PackedSmallIntsCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedSmallIntsSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedSmallInts arguments from packed ABI bytes including function selector
+func (t *PackedSmallIntsCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedSmallIntsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewPackedSmallIntsCall constructs a new PackedSmallIntsCall
 func NewPackedSmallIntsCall(
 	u8 uint8,
@@ -1338,9 +2972,6 @@ func NewPackedSmallIntsCall(
 
 const PackedSmallIntsReturnStaticSize = 32
 
-var _ abi.Tuple = (*PackedSmallIntsReturn)(nil)
-var _ abi.PackedTuple = (*PackedSmallIntsReturn)(nil)
-
 // PackedSmallIntsReturn represents an ABI tuple
 type PackedSmallIntsReturn struct {
 	Field1 bool
@@ -1391,6 +3022,25 @@ func (t *PackedSmallIntsReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedSmallIntsReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedSmallIntsReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedSmallIntsReturn from the hex string with an optional 0x prefix
+func (t *PackedSmallIntsReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedSmallIntsReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedSmallIntsReturn
 func (t PackedSmallIntsReturn) PackedEncodedSize() int {
 	return 1
@@ -1437,13 +3087,45 @@ func (t *PackedSmallIntsReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*PackedSmallIntsReturn)(nil)
+var _ abi.Decoder = (*PackedSmallIntsReturn)(nil)
+var _ abi.PackedTuple = (*PackedSmallIntsReturn)(nil)
+
+// DecodePackedSmallIntsReturn decodes the return data of packedSmallInts into its values
+func DecodePackedSmallIntsReturn(data []byte) (r1 bool, err error) {
+	var result PackedSmallIntsReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodePackedSmallInts decodes the single return value of packedSmallInts
+func DecodePackedSmallInts(data []byte) (bool, error) {
+	return DecodePackedSmallIntsReturn(data)
+}
+
+// DecodePackedSmallIntsHex decodes the single return value of packedSmallInts from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedSmallIntsHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedSmallIntsReturn: %w", err)
+	}
+	return DecodePackedSmallIntsReturn(data)
+}
+
+// EncodePackedSmallIntsResult encodes the single return value of packedSmallInts, e.g. for the return data of precompiles
+func EncodePackedSmallIntsResult(v bool) ([]byte, error) {
+	result := PackedSmallIntsReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*PackedStructCall)(nil)
 
 const PackedStructCallStaticSize = 96
 
-var _ abi.Tuple = (*PackedStructCall)(nil)
-var _ abi.PackedTuple = (*PackedStructCall)(nil)
-
 // PackedStructCall represents an ABI tuple
 type PackedStructCall struct {
 	S PackedStruct
@@ -1494,6 +3176,24 @@ func (t *PackedStructCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedStructCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedStructCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedStructCall from the hex string with an optional 0x prefix
+func (t *PackedStructCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedStructCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedStructCall
 func (t PackedStructCall) PackedEncodedSize() int {
 	return 84
@@ -1540,6 +3240,10 @@ func (t *PackedStructCall) PackedDecode(data []byte) (int, error) {
 	return 84, nil
 }
 
+var _ abi.Tuple = (*PackedStructCall)(nil)
+var _ abi.Decoder = (*PackedStructCall)(nil)
+var _ abi.PackedTuple = (*PackedStructCall)(nil)
+
 // GetMethodName returns the function name
 func (t PackedStructCall) GetMethodName() string {
 	return "packedStruct"
@@ -1555,9 +3259,14 @@ func (t PackedStructCall) GetMethodSelector() [4]byte {
 	return PackedStructSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of packedStruct arguments including function selector
+func (t PackedStructCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes packedStruct arguments to ABI bytes including function selector
 func (t PackedStructCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], PackedStructSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1565,6 +3274,84 @@ func (t PackedStructCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes packedStruct arguments to 0x prefixed hex string
+func (t PackedStructCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes packedStruct arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedStructCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the packedStruct calldata, returns 0 if encoding fails
+func (t PackedStructCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes packedStruct arguments from ABI bytes including function selector
+func (t *PackedStructCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedStructSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedStruct arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedStructCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedStructCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedStruct arguments to packed ABI bytes including function selector
+func (t PackedStructCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedStructSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedStruct arguments from packed ABI bytes including function selector
+func (t *PackedStructCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedStructSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewPackedStructCall constructs a new PackedStructCall
 func NewPackedStructCall(
 	s PackedStruct,
@@ -1576,9 +3363,6 @@ func NewPackedStructCall(
 
 const PackedStructReturnStaticSize = 32
 
-var _ abi.Tuple = (*PackedStructReturn)(nil)
-var _ abi.PackedTuple = (*PackedStructReturn)(nil)
-
 // PackedStructReturn represents an ABI tuple
 type PackedStructReturn struct {
 	Field1 bool
@@ -1629,6 +3413,25 @@ func (t *PackedStructReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedStructReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedStructReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedStructReturn from the hex string with an optional 0x prefix
+func (t *PackedStructReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedStructReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedStructReturn
 func (t PackedStructReturn) PackedEncodedSize() int {
 	return 1
@@ -1675,13 +3478,45 @@ func (t *PackedStructReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*PackedStructReturn)(nil)
+var _ abi.Decoder = (*PackedStructReturn)(nil)
+var _ abi.PackedTuple = (*PackedStructReturn)(nil)
+
+// DecodePackedStructReturn decodes the return data of packedStruct into its values
+func DecodePackedStructReturn(data []byte) (r1 bool, err error) {
+	var result PackedStructReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodePackedStruct decodes the single return value of packedStruct
+func DecodePackedStruct(data []byte) (bool, error) {
+	return DecodePackedStructReturn(data)
+}
+
+// DecodePackedStructHex decodes the single return value of packedStruct from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedStructHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedStructReturn: %w", err)
+	}
+	return DecodePackedStructReturn(data)
+}
+
+// EncodePackedStructResult encodes the single return value of packedStruct, e.g. for the return data of precompiles
+func EncodePackedStructResult(v bool) ([]byte, error) {
+	result := PackedStructReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*PackedTransferCall)(nil)
 
 const PackedTransferCallStaticSize = 64
 
-var _ abi.Tuple = (*PackedTransferCall)(nil)
-var _ abi.PackedTuple = (*PackedTransferCall)(nil)
-
 // PackedTransferCall represents an ABI tuple
 type PackedTransferCall struct {
 	To     common.Address
@@ -1736,13 +3571,31 @@ func (t *PackedTransferCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedTransferCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedTransferCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes PackedTransferCall from the hex string with an optional 0x prefix
+func (t *PackedTransferCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedTransferCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedTransferCall
 func (t PackedTransferCall) PackedEncodedSize() int {
 	return 52
@@ -1801,6 +3654,10 @@ func (t *PackedTransferCall) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*PackedTransferCall)(nil)
+var _ abi.Decoder = (*PackedTransferCall)(nil)
+var _ abi.PackedTuple = (*PackedTransferCall)(nil)
+
 // GetMethodName returns the function name
 func (t PackedTransferCall) GetMethodName() string {
 	return "packedTransfer"
@@ -1816,9 +3673,14 @@ func (t PackedTransferCall) GetMethodSelector() [4]byte {
 	return PackedTransferSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of packedTransfer arguments including function selector
+func (t PackedTransferCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes packedTransfer arguments to ABI bytes including function selector
 func (t PackedTransferCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], PackedTransferSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1826,6 +3688,84 @@ func (t PackedTransferCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes packedTransfer arguments to 0x prefixed hex string
+func (t PackedTransferCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes packedTransfer arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t PackedTransferCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the packedTransfer calldata, returns 0 if encoding fails
+func (t PackedTransferCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes packedTransfer arguments from ABI bytes including function selector
+func (t *PackedTransferCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedTransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes packedTransfer arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *PackedTransferCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedTransferCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes packedTransfer arguments to packed ABI bytes including function selector
+func (t PackedTransferCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], PackedTransferSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes packedTransfer arguments from packed ABI bytes including function selector
+func (t *PackedTransferCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != PackedTransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewPackedTransferCall constructs a new PackedTransferCall
 func NewPackedTransferCall(
 	to common.Address,
@@ -1839,9 +3779,6 @@ func NewPackedTransferCall(
 
 const PackedTransferReturnStaticSize = 32
 
-var _ abi.Tuple = (*PackedTransferReturn)(nil)
-var _ abi.PackedTuple = (*PackedTransferReturn)(nil)
-
 // PackedTransferReturn represents an ABI tuple
 type PackedTransferReturn struct {
 	Field1 bool
@@ -1892,6 +3829,25 @@ func (t *PackedTransferReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes PackedTransferReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *PackedTransferReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes PackedTransferReturn from the hex string with an optional 0x prefix
+func (t *PackedTransferReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode PackedTransferReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of PackedTransferReturn
 func (t PackedTransferReturn) PackedEncodedSize() int {
 	return 1
@@ -1937,3 +3893,80 @@ func (t *PackedTransferReturn) PackedDecode(data []byte) (int, error) {
 	}
 	return 1, nil
 }
+
+var _ abi.Tuple = (*PackedTransferReturn)(nil)
+var _ abi.Decoder = (*PackedTransferReturn)(nil)
+var _ abi.PackedTuple = (*PackedTransferReturn)(nil)
+
+// DecodePackedTransferReturn decodes the return data of packedTransfer into its values
+func DecodePackedTransferReturn(data []byte) (r1 bool, err error) {
+	var result PackedTransferReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodePackedTransfer decodes the single return value of packedTransfer
+func DecodePackedTransfer(data []byte) (bool, error) {
+	return DecodePackedTransferReturn(data)
+}
+
+// DecodePackedTransferHex decodes the single return value of packedTransfer from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodePackedTransferHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode PackedTransferReturn: %w", err)
+	}
+	return DecodePackedTransferReturn(data)
+}
+
+// EncodePackedTransferResult encodes the single return value of packedTransfer, e.g. for the return data of precompiles
+func EncodePackedTransferResult(v bool) ([]byte, error) {
+	result := PackedTransferReturn{Field1: v}
+	return result.Encode()
+}
+
+// PackedDecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func PackedDecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case PackedBoolSelector:
+		call = new(PackedBoolCall)
+	case PackedBytesSelector:
+		call = new(PackedBytesCall)
+	case PackedIntermediateSelector:
+		call = new(PackedIntermediateCall)
+	case PackedNestedSelector:
+		call = new(PackedNestedCall)
+	case PackedSmallIntsSelector:
+		call = new(PackedSmallIntsCall)
+	case PackedStructSelector:
+		call = new(PackedStructCall)
+	case PackedTransferSelector:
+		call = new(PackedTransferCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// PackedSelectors maps the function and error selectors to the canonical signatures
+var PackedSelectors = map[[4]byte]string{
+	PackedBoolSelector:         PackedBoolSignature,
+	PackedBytesSelector:        PackedBytesSignature,
+	PackedIntermediateSelector: PackedIntermediateSignature,
+	PackedNestedSelector:       PackedNestedSignature,
+	PackedSmallIntsSelector:    PackedSmallIntsSignature,
+	PackedStructSelector:       PackedStructSignature,
+	PackedTransferSelector:     PackedTransferSignature,
+}
@@ -5,6 +5,7 @@ package tests
 import (
 	"bytes"
 	"encoding/hex"
+	"io"
 	"math/big"
 	"testing"
 
@@ -25,6 +26,10 @@ var PackedTestABI = []string{
 	"function packedIntermediate(uint24 u24, uint40 u40, int24 i24, int40 i40) returns (bool)",
 	"struct PackedStruct { address addr; uint256 value; bytes32 data }",
 	"function packedStruct(PackedStruct s) returns (bool)",
+	"struct PackedInner { uint64 a; bytes4 b }",
+	"struct PackedMiddle { PackedInner inner; bool flag; uint16[2] pair }",
+	"struct PackedOuter { PackedMiddle middle; address who; PackedInner[2] inners }",
+	"function packedNested(PackedOuter outer, uint8 tail) returns (bool)",
 }
 
 var PackedTestABIDef ethabi.ABI
@@ -197,6 +202,81 @@ func TestPackedStruct(t *testing.T) {
 	DecodePackedRoundTrip(t, call)
 }
 
+// TestPackedWithSelector tests the packed encoding prefixed with the function selector
+// TestPackedNestedStruct tests the packed encoding of two levels of nested tuples, laid out sequentially
+func TestPackedNestedStruct(t *testing.T) {
+	inner := func(a uint64, b byte) PackedInner {
+		return PackedInner{A: a, B: [4]byte{b, b, b, b}}
+	}
+	call := &PackedNestedCall{
+		Outer: PackedOuter{
+			Middle: PackedMiddle{Inner: inner(1, 0xaa), Flag: true, Pair: [2]uint16{0x0102, 0x0304}},
+			Who:    common.HexToAddress("0x1234567890123456789012345678901234567890"),
+			Inners: [2]PackedInner{inner(2, 0xbb), inner(3, 0xcc)},
+		},
+		Tail: 0xff,
+	}
+
+	var expected []byte
+	expected = append(expected, "\x00\x00\x00\x00\x00\x00\x00\x01\xaa\xaa\xaa\xaa"...) // middle.inner
+	expected = append(expected, 0x01, 0x01, 0x02, 0x03, 0x04)                          // middle.flag, middle.pair
+	expected = append(expected, call.Outer.Who.Bytes()...)                             // who
+	expected = append(expected, "\x00\x00\x00\x00\x00\x00\x00\x02\xbb\xbb\xbb\xbb"...) // inners[0]
+	expected = append(expected, "\x00\x00\x00\x00\x00\x00\x00\x03\xcc\xcc\xcc\xcc"...) // inners[1]
+	expected = append(expected, 0xff)                                                  // tail
+
+	require.Equal(t, len(expected), call.PackedEncodedSize())
+	encoded, err := call.PackedEncode()
+	require.NoError(t, err)
+	require.Equal(t, expected, encoded)
+
+	// the nested tuples encode the same on their own
+	middle, err := call.Outer.Middle.PackedEncode()
+	require.NoError(t, err)
+	require.Equal(t, expected[:17], middle)
+
+	var decoded PackedNestedCall
+	n, err := decoded.PackedDecode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), n)
+	require.Equal(t, *call, decoded)
+
+	_, err = decoded.PackedDecode(encoded[:len(encoded)-1])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestPackedWithSelector(t *testing.T) {
+	call := &PackedTransferCall{
+		To:     common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Amount: big.NewInt(100),
+	}
+
+	encoded, err := call.PackedEncodeWithSelector()
+	require.NoError(t, err)
+	require.Len(t, encoded, 4+call.PackedEncodedSize())
+	require.Equal(t, PackedTransferSelector[:], encoded[:4])
+
+	packed, err := call.PackedEncode()
+	require.NoError(t, err)
+	require.Equal(t, packed, encoded[4:])
+
+	var decoded PackedTransferCall
+	n, err := decoded.PackedDecodeWithSelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, call, &decoded)
+
+	// wrong selector
+	_, err = new(PackedBoolCall).PackedDecodeWithSelector(encoded)
+	require.Equal(t, abi.ErrSelectorMismatch, err)
+
+	// truncated
+	_, err = decoded.PackedDecodeWithSelector(encoded[:3])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	_, err = decoded.PackedDecodeWithSelector(encoded[:len(encoded)-1])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
 // TestPackedCompareWithSolidityEncodePacked verifies our encoding matches Solidity's abi.encodePacked
 func TestPackedCompareWithSolidityEncodePacked(t *testing.T) {
 	// This test verifies known encodings from Solidity
@@ -0,0 +1,116 @@
+package packunpack
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var PackUnpackTestABI -output packunpack.abi.go -package packunpack -pack-unpack
+
+var PackUnpackTestABI = []string{
+	"struct Order { address maker; uint256 amount; bytes data }",
+	"function transfer(address to, uint256 amount) returns (bool)",
+	"function transfer(address to, uint256 amount, bytes data) returns (bool)",
+	"function submit(Order order, uint64[] ids) returns (Order order, uint64 count)",
+	"function totalSupply() view returns (uint256)",
+	"function pause()",
+}
+
+var (
+	PackUnpackTestABIDef ethabi.ABI
+
+	to = common.HexToAddress("0x1111111111111111111111111111111111111111")
+)
+
+func init() {
+	abiJSON, err := abi.ParseHumanReadableABI(PackUnpackTestABI)
+	if err != nil {
+		panic(err)
+	}
+	PackUnpackTestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestPack(t *testing.T) {
+	order := Order{Maker: to, Amount: big.NewInt(100), Data: []byte{1, 2, 3}}
+	tests := []struct {
+		name string
+		args []interface{}
+	}{
+		{"transfer", []interface{}{to, big.NewInt(1)}},
+		{"transfer0", []interface{}{to, big.NewInt(1), []byte{0xff}}},
+		// go-ethereum converts the structs by the field names
+		{"submit", []interface{}{order, []uint64{1, 2}}},
+		{"totalSupply", nil},
+		{"pause", nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded, err := Pack(tc.name, tc.args...)
+			require.NoError(t, err)
+			expected, err := PackUnpackTestABIDef.Pack(tc.name, tc.args...)
+			require.NoError(t, err)
+			require.Equal(t, expected, encoded)
+		})
+	}
+}
+
+func TestPackErrors(t *testing.T) {
+	_, err := Pack("approve", to, big.NewInt(1))
+	require.True(t, errors.Is(err, abi.ErrUnknownMethod))
+
+	_, err = Pack("transfer", to)
+	require.True(t, errors.Is(err, abi.ErrArgumentCountMismatch))
+	require.EqualError(t, err, "argument count mismatch, expected 2, got 1")
+
+	_, err = Pack("transfer", to, 1)
+	require.True(t, errors.Is(err, abi.ErrArgumentTypeMismatch))
+	require.EqualError(t, err, "argument type mismatch, argument 1 expected *big.Int, got int")
+
+	_, err = Pack("pause", to)
+	require.True(t, errors.Is(err, abi.ErrArgumentCountMismatch))
+}
+
+func TestUnpack(t *testing.T) {
+	order := Order{Maker: to, Amount: big.NewInt(100), Data: []byte{1, 2, 3}}
+	ret := SubmitReturn{Order: order, Count: 2}
+	encoded, err := ret.Encode()
+	require.NoError(t, err)
+
+	values, err := Unpack("submit", encoded)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{order, uint64(2)}, values)
+
+	// the same values as go-ethereum, which decodes the tuples into anonymous structs
+	expected, err := PackUnpackTestABIDef.Unpack("submit", encoded)
+	require.NoError(t, err)
+	require.Len(t, expected, 2)
+	require.Equal(t, expected[1], values[1])
+
+	encoded, err = (&TotalSupplyReturn{Field1: big.NewInt(42)}).Encode()
+	require.NoError(t, err)
+	values, err = Unpack("totalSupply", encoded)
+	require.NoError(t, err)
+	expected, err = PackUnpackTestABIDef.Unpack("totalSupply", encoded)
+	require.NoError(t, err)
+	require.Equal(t, expected, values)
+
+	values, err = Unpack("pause", nil)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	_, err = Unpack("submit", encoded[:16])
+	require.Error(t, err)
+	_, err = Unpack("approve", encoded)
+	require.True(t, errors.Is(err, abi.ErrUnknownMethod))
+}
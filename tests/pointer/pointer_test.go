@@ -0,0 +1,124 @@
+//go:build !uint256
+
+package pointer
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var PointerTestABI -output pointer.abi.go -pointer-receivers
+
+// PointerTestABI is generated with pointer receivers for all methods
+var PointerTestABI = []string{
+	"struct UserMetadata2 { uint256 createdAt; string[] tags }",
+	"struct UserProfile { string name; string[] emails; UserMetadata2 metadata }",
+	"struct User2 { uint256 id; UserProfile profile }",
+	"function testComplexDynamicTuples(User2[] users) returns (bool)",
+	"function packedSmall(uint64 a, address b) returns (bool)",
+	"event UserCreated(address indexed owner, uint256 id)",
+}
+
+var PointerTestABIDef ethabi.ABI
+
+func init() {
+	var err error
+	abiJSON, err := abi.ParseHumanReadableABI(PointerTestABI)
+	if err != nil {
+		panic(err)
+	}
+	PointerTestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func createComplexDynamicTuplesData() *TestComplexDynamicTuplesCall {
+	return &TestComplexDynamicTuplesCall{
+		Users: []User2{
+			{
+				Id: big.NewInt(1),
+				Profile: UserProfile{
+					Name:   "User 1",
+					Emails: []string{"user1@example.com", "user1@gmail.com", "user1@test.org"},
+					Metadata: UserMetadata2{
+						CreatedAt: big.NewInt(1234567890),
+						Tags:      []string{"tag1", "tag2", "tag3", "tag4", "tag5"},
+					},
+				},
+			},
+			{
+				Id: big.NewInt(2),
+				Profile: UserProfile{
+					Name:   "User 2 with a longer name for testing",
+					Emails: []string{"user2@example.com", "user2@work.com"},
+					Metadata: UserMetadata2{
+						CreatedAt: big.NewInt(9876543210),
+						Tags:      []string{"tag6", "tag7"},
+					},
+				},
+			},
+			{
+				Id: big.NewInt(3),
+				Profile: UserProfile{
+					Name:   "User 3",
+					Emails: []string{"user3@example.com"},
+					Metadata: UserMetadata2{
+						CreatedAt: big.NewInt(5555555555),
+						Tags:      []string{"tag8", "tag9", "tag10", "tag11"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPointerReceivers(t *testing.T) {
+	args := createComplexDynamicTuplesData()
+
+	encoded, err := args.EncodeWithSelector()
+	require.NoError(t, err)
+
+	goEthEncoded, err := PointerTestABIDef.Pack("testComplexDynamicTuples", args.Users)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	var decoded TestComplexDynamicTuplesCall
+	n, err := decoded.DecodeWithSelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), n)
+	require.Equal(t, *args, decoded)
+
+	packed := &PackedSmallCall{A: 1}
+	packedEncoded, err := packed.PackedEncode()
+	require.NoError(t, err)
+	require.Equal(t, packed.PackedEncodedSize(), len(packedEncoded))
+
+	event := NewUserCreatedEvent(common.HexToAddress("0x1111111111111111111111111111111111111111"), big.NewInt(1))
+	require.Equal(t, UserCreatedEventTopic, event.GetEventID())
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	require.Len(t, topics, 2)
+}
+
+// BenchmarkPointerReceivers_EncodeTo_ComplexDynamicTuples is comparable to
+// tests.BenchmarkGoABI_EncodeTo_ComplexDynamicTuples which uses value receivers
+func BenchmarkPointerReceivers_EncodeTo_ComplexDynamicTuples(b *testing.B) {
+	args := createComplexDynamicTuplesData()
+	size := args.EncodedSize()
+	buf := make([]byte, size)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := args.EncodeTo(buf)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
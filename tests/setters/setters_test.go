@@ -0,0 +1,47 @@
+package setters
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+// the native integer fields get setters from *big.Int checking the range of the ABI type
+//go:generate go run ../../cmd -var SettersTestABI -output setters.abi.go -package setters -big-setters
+
+var SettersTestABI = []string{
+	"struct Position { uint64 nonce; uint24 fee; int8 tick; uint256 liquidity; }",
+	"function open(Position position, uint32 deadline, int64 offset)",
+}
+
+func TestSetFromBig(t *testing.T) {
+	var position Position
+	require.NoError(t, position.SetNonceFromBig(new(big.Int).SetUint64(math.MaxUint64)))
+	require.Equal(t, uint64(math.MaxUint64), position.Nonce)
+	require.Equal(t, abi.ErrIntegerOutOfRange, position.SetNonceFromBig(new(big.Int).Lsh(big.NewInt(1), 64)))
+	require.Equal(t, abi.ErrIntegerOutOfRange, position.SetNonceFromBig(big.NewInt(-1)))
+	require.Equal(t, abi.ErrNilInteger, position.SetNonceFromBig(nil))
+	// the failed setters leave the field untouched
+	require.Equal(t, uint64(math.MaxUint64), position.Nonce)
+
+	// the range is the one of the ABI type, not of the Go type
+	require.NoError(t, position.SetFeeFromBig(big.NewInt(1<<24-1)))
+	require.Equal(t, uint32(1<<24-1), position.Fee)
+	require.Equal(t, abi.ErrIntegerOutOfRange, position.SetFeeFromBig(big.NewInt(1<<24)))
+
+	require.NoError(t, position.SetTickFromBig(big.NewInt(-128)))
+	require.Equal(t, int8(-128), position.Tick)
+	require.Equal(t, abi.ErrIntegerOutOfRange, position.SetTickFromBig(big.NewInt(128)))
+	require.Equal(t, abi.ErrIntegerOutOfRange, position.SetTickFromBig(big.NewInt(-129)))
+
+	var call OpenCall
+	require.NoError(t, call.SetDeadlineFromBig(big.NewInt(math.MaxUint32)))
+	require.Equal(t, abi.ErrIntegerOutOfRange, call.SetDeadlineFromBig(big.NewInt(math.MaxUint32+1)))
+	require.NoError(t, call.SetOffsetFromBig(big.NewInt(math.MinInt64)))
+	require.Equal(t, int64(math.MinInt64), call.Offset)
+	require.Equal(t, abi.ErrIntegerOutOfRange, call.SetOffsetFromBig(new(big.Int).Lsh(big.NewInt(1), 63)))
+}
@@ -0,0 +1,68 @@
+//go:build !uint256
+
+package split
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var SplitTestABI -output . -prefix split -split
+
+// SplitTestABI is generated into one file per category
+var SplitTestABI = []string{
+	"struct Coin { string denom; uint256 amount }",
+	"function send(address to, Coin[] amount) returns (bool)",
+	"function balances(address owner) view returns (Coin[] balances, uint64 height)",
+	"event Sent(address indexed from, Coin[] amount)",
+	"error InsufficientFunds(Coin[] required)",
+}
+
+var SplitTestABIDef ethabi.ABI
+
+func init() {
+	var err error
+	abiJSON, err := abi.ParseHumanReadableABI(SplitTestABI)
+	if err != nil {
+		panic(err)
+	}
+	SplitTestABIDef, err = ethabi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestSplitFiles(t *testing.T) {
+	to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	coins := []Coin{{Denom: "atom", Amount: big.NewInt(100)}}
+
+	call := NewSendCall(to, coins)
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	goEthEncoded, err := SplitTestABIDef.Pack("send", to, coins)
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	ret := &BalancesReturn{Balances: coins, Height: 10}
+	encoded, err = ret.Encode()
+	require.NoError(t, err)
+
+	goEthEncoded, err = SplitTestABIDef.Methods["balances"].Outputs.Pack(coins, uint64(10))
+	require.NoError(t, err)
+	require.Equal(t, goEthEncoded, encoded)
+
+	event := NewSentEvent(to, coins)
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	require.Equal(t, SplitTestABIDef.Events["Sent"].ID, topics[0])
+
+	errorID := SplitTestABIDef.Errors["InsufficientFunds"].ID
+	require.Equal(t, [4]byte(errorID[:4]), InsufficientFundsErrorSelector)
+}
@@ -0,0 +1,34 @@
+package stdprefix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+)
+
+// abi is taken by the local variable below, the generated code refers to the runtime as goabi
+//go:generate go run ../../cmd -var StdPrefixTestABI -output stdprefix.abi.go -package stdprefix -std-prefix goabi.
+
+var StdPrefixTestABI = []string{
+	"function transfer(address to, uint256[] amounts, string memo) returns (bool)",
+}
+
+var abi = StdPrefixTestABI
+
+func TestStdPrefix(t *testing.T) {
+	require.NotEmpty(t, abi)
+
+	call := TransferCall{
+		To:      common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)},
+		Memo:    "memo",
+	}
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	decoded, err := DecodeBySelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, &call, decoded)
+}
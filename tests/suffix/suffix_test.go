@@ -0,0 +1,48 @@
+package suffix
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+// the struct names of an earlier go-abi version, e.g. TransferArgs
+//go:generate go run ../../cmd -var SuffixTestABI -output suffix.abi.go -package suffix -call-suffix Args -return-suffix Result -event-suffix Log -client TokenClient -caller Token -testhelpers -tomap
+
+var SuffixTestABI = []string{
+	"function transfer(address to, uint256 amount) returns (bool)",
+	"function balanceOf(address owner) view returns (uint256)",
+	"event Transfer(address indexed from, address indexed to, uint256 value)",
+}
+
+func TestSuffixes(t *testing.T) {
+	to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	var call abi.Method = NewTransferArgs(to, big.NewInt(100))
+	encoded, err := call.EncodeWithSelector()
+	require.NoError(t, err)
+
+	decoded, err := DecodeBySelector(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, decoded)
+
+	result, err := EncodeBalanceOfResult(big.NewInt(7))
+	require.NoError(t, err)
+	var ret BalanceOfResult
+	_, err = ret.Decode(result)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), ret.Field1)
+
+	event := NewTransferLog(to, to, big.NewInt(1))
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, TransferLogTopic, topics[0])
+
+	var decodedEvent TransferLog
+	require.NoError(t, abi.DecodeEvent(&decodedEvent, topics, data))
+	require.Equal(t, *event, decodedEvent)
+	require.Equal(t, "Transfer", Events[TransferLogTopic])
+}
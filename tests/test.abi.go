@@ -1,16 +1,19 @@
 //go:build !uint256
 
 // Code generated by go-abi. DO NOT EDIT.
+// Input hash: c4286a37e792f43299dbc02209ee368a68d5bdf26a392af83279ee547894ce5c
 
 package tests
 
 import (
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"io"
 	"math/big"
+	"math/rand"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/yihuang/go-abi"
 )
 
@@ -36,6 +39,8 @@ var (
 	SetMessageSelector = [4]byte{0x36, 0x8b, 0x87, 0x72}
 	// smallIntegers(uint8,uint16,uint32,uint64,int8,int16,int32,int64)
 	SmallIntegersSelector = [4]byte{0x98, 0x83, 0xfe, 0x4a}
+	// totalSupply()
+	TotalSupplySelector = [4]byte{0x18, 0x16, 0x0d, 0xdd}
 	// transfer(address,uint256)
 	TransferSelector = [4]byte{0xa9, 0x05, 0x9c, 0xbb}
 	// transferBatch(address[],uint256[])
@@ -58,15 +63,33 @@ const (
 	SetDataID         = 2133027084
 	SetMessageID      = 915113842
 	SmallIntegersID   = 2558787146
+	TotalSupplyID     = 404098525
 	TransferID        = 2835717307
 	TransferBatchID   = 993945391
 	UnderstoreID      = 1482041909
 	UpdateProfileID   = 1844007425
 )
 
-const Tuple45c89796StaticSize = 64
+// Canonical function signatures
+const (
+	BalanceOfSignature       = "balanceOf(address)"
+	BatchProcessSignature    = "batchProcess((uint256,(bytes32,string))[])"
+	CommunityPoolSignature   = "communityPool()"
+	EmptyArgsSignature       = "emptyArgs()"
+	GetBalancesSignature     = "getBalances(address[10])"
+	MultiTransferSignature   = "multiTransfer(address[],uint256[])"
+	ProcessUserDataSignature = "processUserData((address,string,int256),(address,string,int256))"
+	SetDataSignature         = "setData(bytes32,bytes)"
+	SetMessageSignature      = "setMessage(string)"
+	SmallIntegersSignature   = "smallIntegers(uint8,uint16,uint32,uint64,int8,int16,int32,int64)"
+	TotalSupplySignature     = "totalSupply()"
+	TransferSignature        = "transfer(address,uint256)"
+	TransferBatchSignature   = "transferBatch(address[],uint256[])"
+	UnderstoreSignature      = "understore(string)"
+	UpdateProfileSignature   = "updateProfile(address,string,uint256)"
+)
 
-var _ abi.Tuple = (*Tuple45c89796)(nil)
+const Tuple45c89796StaticSize = 64
 
 // Tuple45c89796 represents an ABI tuple
 type Tuple45c89796 struct {
@@ -92,6 +115,7 @@ func (value Tuple45c89796) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Denom: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Denom, buf[dynamicOffset:])
@@ -144,16 +168,43 @@ func (t *Tuple45c89796) Decode(data []byte) (int, error) {
 		dynamicOffset += n
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-const UserStaticSize = 96
+// DecodeStrict decodes Tuple45c89796 from ABI bytes, rejecting unexpected trailing bytes
+func (t *Tuple45c89796) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*User)(nil)
+// DecodeHex decodes Tuple45c89796 from the hex string with an optional 0x prefix
+func (t *Tuple45c89796) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode Tuple45c89796: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*Tuple45c89796)(nil)
+var _ abi.Decoder = (*Tuple45c89796)(nil)
+
+// RandomTuple45c89796 returns a Tuple45c89796 filled with random values, for property based tests
+func RandomTuple45c89796(r *rand.Rand, maxDepth, maxLen int) Tuple45c89796 {
+	var t Tuple45c89796
+	t.Denom = abi.RandomString(r, maxLen)
+	t.Amount = abi.RandomBigInt(r, 256, false)
+	return t
+}
+
+const UserStaticSize = 96
 
 // User represents an ABI tuple
 type User struct {
@@ -185,6 +236,7 @@ func (value User) EncodeTo(buf []byte) (int, error) {
 
 	// Field Name: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
@@ -242,16 +294,44 @@ func (t *User) Decode(data []byte) (int, error) {
 		dynamicOffset += n
 	}
 	// Decode static field Age: int256
-	t.Age, _, err = abi.DecodeInt256(data[64:])
+	t.Age, _, err = abi.DecodeIntoInt256(t.Age, data[64:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
-const UserDataStaticSize = 64
+// DecodeStrict decodes User from ABI bytes, rejecting unexpected trailing bytes
+func (t *User) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*UserData)(nil)
+// DecodeHex decodes User from the hex string with an optional 0x prefix
+func (t *User) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode User: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*User)(nil)
+var _ abi.Decoder = (*User)(nil)
+
+// RandomUser returns a User filled with random values, for property based tests
+func RandomUser(r *rand.Rand, maxDepth, maxLen int) User {
+	var t User
+	r.Read(t.Address[:])
+	t.Name = abi.RandomString(r, maxLen)
+	t.Age = abi.RandomBigInt(r, 256, true)
+	return t
+}
+
+const UserDataStaticSize = 64
 
 // UserData represents an ABI tuple
 type UserData struct {
@@ -282,6 +362,7 @@ func (value UserData) EncodeTo(buf []byte) (int, error) {
 
 	// Field Data: (bytes32,string)
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.Data.EncodeTo(buf[dynamicOffset:])
@@ -314,7 +395,7 @@ func (t *UserData) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 64
 	// Decode static field Id: uint256
-	t.Id, _, err = abi.DecodeUint256(data[0:])
+	t.Id, _, err = abi.DecodeIntoUint256(t.Id, data[0:])
 	if err != nil {
 		return 0, err
 	}
@@ -336,9 +417,36 @@ func (t *UserData) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
-const UserMetadataStaticSize = 64
+// DecodeStrict decodes UserData from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
 
-var _ abi.Tuple = (*UserMetadata)(nil)
+// DecodeHex decodes UserData from the hex string with an optional 0x prefix
+func (t *UserData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UserData: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*UserData)(nil)
+var _ abi.Decoder = (*UserData)(nil)
+
+// RandomUserData returns a UserData filled with random values, for property based tests
+func RandomUserData(r *rand.Rand, maxDepth, maxLen int) UserData {
+	var t UserData
+	t.Id = abi.RandomBigInt(r, 256, false)
+	t.Data = RandomUserMetadata(r, maxDepth-1, maxLen)
+	return t
+}
+
+const UserMetadataStaticSize = 64
 
 // UserMetadata represents an ABI tuple
 type UserMetadata struct {
@@ -369,6 +477,7 @@ func (value UserMetadata) EncodeTo(buf []byte) (int, error) {
 
 	// Field Value: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Value, buf[dynamicOffset:])
@@ -423,6 +532,35 @@ func (t *UserMetadata) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UserMetadata from ABI bytes, rejecting unexpected trailing bytes
+func (t *UserMetadata) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UserMetadata from the hex string with an optional 0x prefix
+func (t *UserMetadata) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UserMetadata: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*UserMetadata)(nil)
+var _ abi.Decoder = (*UserMetadata)(nil)
+
+// RandomUserMetadata returns a UserMetadata filled with random values, for property based tests
+func RandomUserMetadata(r *rand.Rand, maxDepth, maxLen int) UserMetadata {
+	var t UserMetadata
+	r.Read(t.Key[:])
+	t.Value = abi.RandomString(r, maxLen)
+	return t
+}
+
 // TestEncodeAddressArray10 encodes address[10] to ABI bytes
 func TestEncodeAddressArray10(value [10]common.Address, buf []byte) (int, error) {
 	// Encode fixed-size array with static elements
@@ -463,6 +601,7 @@ func TestEncodeAddressArray10(value [10]common.Address, buf []byte) (int, error)
 // TestEncodeTuple45c89796Slice encodes (string,uint256)[] to ABI bytes
 func TestEncodeTuple45c89796Slice(value []Tuple45c89796, buf []byte) (int, error) {
 	// Encode length
+	abi.ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -471,6 +610,7 @@ func TestEncodeTuple45c89796Slice(value []Tuple45c89796, buf []byte) (int, error
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		abi.ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -525,6 +665,7 @@ func TestEncodeUint256Array10(value [10]*big.Int, buf []byte) (int, error) {
 // TestEncodeUserDataSlice encodes (uint256,(bytes32,string))[] to ABI bytes
 func TestEncodeUserDataSlice(value []UserData, buf []byte) (int, error) {
 	// Encode length
+	abi.ClearWord(buf)
 	binary.BigEndian.PutUint64(buf[24:32], uint64(len(value)))
 	buf = buf[32:]
 
@@ -533,6 +674,7 @@ func TestEncodeUserDataSlice(value []UserData, buf []byte) (int, error) {
 	dynamicOffset := len(value) * 32
 	for _, elem := range value {
 		// Write offset for element
+		abi.ClearWord(buf[offset:])
 		offset += 32
 		binary.BigEndian.PutUint64(buf[offset-8:offset], uint64(dynamicOffset))
 
@@ -630,6 +772,11 @@ func TestDecodeAddressArray10(data []byte) ([10]common.Address, int, error) {
 
 // TestDecodeTuple45c89796Slice decodes (string,uint256)[] from ABI bytes
 func TestDecodeTuple45c89796Slice(data []byte) ([]Tuple45c89796, int, error) {
+	return TestDecodeIntoTuple45c89796Slice(nil, data)
+}
+
+// TestDecodeIntoTuple45c89796Slice decodes (string,uint256)[] from ABI bytes, reusing the backing array of dst
+func TestDecodeIntoTuple45c89796Slice(dst []Tuple45c89796, data []byte) ([]Tuple45c89796, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -639,7 +786,7 @@ func TestDecodeTuple45c89796Slice(data []byte) ([]Tuple45c89796, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -647,7 +794,7 @@ func TestDecodeTuple45c89796Slice(data []byte) ([]Tuple45c89796, int, error) {
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([]Tuple45c89796, length)
+	result := abi.ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := abi.DecodeSize(data[offset:])
@@ -733,6 +880,11 @@ func TestDecodeUint256Array10(data []byte) ([10]*big.Int, int, error) {
 
 // TestDecodeUserDataSlice decodes (uint256,(bytes32,string))[] from ABI bytes
 func TestDecodeUserDataSlice(data []byte) ([]UserData, int, error) {
+	return TestDecodeIntoUserDataSlice(nil, data)
+}
+
+// TestDecodeIntoUserDataSlice decodes (uint256,(bytes32,string))[] from ABI bytes, reusing the backing array of dst
+func TestDecodeIntoUserDataSlice(dst []UserData, data []byte) ([]UserData, int, error) {
 	// Decode length
 	if len(data) < 32 {
 		return nil, 0, io.ErrUnexpectedEOF
@@ -742,7 +894,7 @@ func TestDecodeUserDataSlice(data []byte) ([]UserData, int, error) {
 		return nil, 0, err
 	}
 	data = data[32:]
-	if length > len(data) || length*32 > len(data) {
+	if length > len(data)/32 {
 		return nil, 0, io.ErrUnexpectedEOF
 	}
 	var (
@@ -750,7 +902,7 @@ func TestDecodeUserDataSlice(data []byte) ([]UserData, int, error) {
 		offset int
 	)
 	// Decode elements with dynamic types
-	result := make([]UserData, length)
+	result := abi.ResizeSlice(dst, length)
 	dynamicOffset := length * 32
 	for i := 0; i < length; i++ {
 		tmp, err := abi.DecodeSize(data[offset:])
@@ -847,13 +999,68 @@ func TestPackedDecodeUint256Array10(data []byte) ([10]*big.Int, int, error) {
 	return result, 320, nil
 }
 
+// TestEncodeTopLevelTuple45c89796Slice encodes (string,uint256)[] to ABI bytes as a single top-level value, including the leading offset word
+func TestEncodeTopLevelTuple45c89796Slice(value []Tuple45c89796) ([]byte, error) {
+	buf := make([]byte, 32+TestSizeTuple45c89796Slice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := TestEncodeTuple45c89796Slice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// TestDecodeTopLevelTuple45c89796Slice decodes (string,uint256)[] from ABI bytes of a single top-level value, including the leading offset word
+func TestDecodeTopLevelTuple45c89796Slice(data []byte) ([]Tuple45c89796, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := TestDecodeTuple45c89796Slice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
+// TestEncodeTopLevelUserDataSlice encodes (uint256,(bytes32,string))[] to ABI bytes as a single top-level value, including the leading offset word
+func TestEncodeTopLevelUserDataSlice(value []UserData) ([]byte, error) {
+	buf := make([]byte, 32+TestSizeUserDataSlice(value))
+	binary.BigEndian.PutUint64(buf[24:32], 32)
+	if _, err := TestEncodeUserDataSlice(value, buf[32:]); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// TestDecodeTopLevelUserDataSlice decodes (uint256,(bytes32,string))[] from ABI bytes of a single top-level value, including the leading offset word
+func TestDecodeTopLevelUserDataSlice(data []byte) ([]UserData, int, error) {
+	if len(data) < 32 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	offset, err := abi.DecodeSize(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset != 32 {
+		return nil, 0, abi.ErrInvalidOffsetForDynamicField
+	}
+	result, n, err := TestDecodeUserDataSlice(data[32:])
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, 32 + n, nil
+}
+
 var _ abi.Method = (*BalanceOfCall)(nil)
 
 const BalanceOfCallStaticSize = 32
 
-var _ abi.Tuple = (*BalanceOfCall)(nil)
-var _ abi.PackedTuple = (*BalanceOfCall)(nil)
-
 // BalanceOfCall represents an ABI tuple
 type BalanceOfCall struct {
 	Account common.Address
@@ -904,6 +1111,24 @@ func (t *BalanceOfCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BalanceOfCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *BalanceOfCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes BalanceOfCall from the hex string with an optional 0x prefix
+func (t *BalanceOfCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of BalanceOfCall
 func (t BalanceOfCall) PackedEncodedSize() int {
 	return 20
@@ -950,6 +1175,17 @@ func (t *BalanceOfCall) PackedDecode(data []byte) (int, error) {
 	return 20, nil
 }
 
+var _ abi.Tuple = (*BalanceOfCall)(nil)
+var _ abi.Decoder = (*BalanceOfCall)(nil)
+var _ abi.PackedTuple = (*BalanceOfCall)(nil)
+
+// RandomBalanceOfCall returns a BalanceOfCall filled with random values, for property based tests
+func RandomBalanceOfCall(r *rand.Rand, maxDepth, maxLen int) BalanceOfCall {
+	var t BalanceOfCall
+	r.Read(t.Account[:])
+	return t
+}
+
 // GetMethodName returns the function name
 func (t BalanceOfCall) GetMethodName() string {
 	return "balanceOf"
@@ -965,9 +1201,14 @@ func (t BalanceOfCall) GetMethodSelector() [4]byte {
 	return BalanceOfSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of balanceOf arguments including function selector
+func (t BalanceOfCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes balanceOf arguments to ABI bytes including function selector
 func (t BalanceOfCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], BalanceOfSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -975,6 +1216,84 @@ func (t BalanceOfCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes balanceOf arguments to 0x prefixed hex string
+func (t BalanceOfCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes balanceOf arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t BalanceOfCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the balanceOf calldata, returns 0 if encoding fails
+func (t BalanceOfCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes balanceOf arguments from ABI bytes including function selector
+func (t *BalanceOfCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BalanceOfSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes balanceOf arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *BalanceOfCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes balanceOf arguments to packed ABI bytes including function selector
+func (t BalanceOfCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], BalanceOfSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes balanceOf arguments from packed ABI bytes including function selector
+func (t *BalanceOfCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BalanceOfSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewBalanceOfCall constructs a new BalanceOfCall
 func NewBalanceOfCall(
 	account common.Address,
@@ -986,9 +1305,6 @@ func NewBalanceOfCall(
 
 const BalanceOfReturnStaticSize = 32
 
-var _ abi.Tuple = (*BalanceOfReturn)(nil)
-var _ abi.PackedTuple = (*BalanceOfReturn)(nil)
-
 // BalanceOfReturn represents an ABI tuple
 type BalanceOfReturn struct {
 	Field1 *big.Int
@@ -1032,13 +1348,32 @@ func (t *BalanceOfReturn) Decode(data []byte) (int, error) {
 	)
 	dynamicOffset := 32
 	// Decode static field Field1: uint256
-	t.Field1, _, err = abi.DecodeUint256(data[0:])
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BalanceOfReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *BalanceOfReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes BalanceOfReturn from the hex string with an optional 0x prefix
+func (t *BalanceOfReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BalanceOfReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of BalanceOfReturn
 func (t BalanceOfReturn) PackedEncodedSize() int {
 	return 32
@@ -1085,12 +1420,52 @@ func (t *BalanceOfReturn) PackedDecode(data []byte) (int, error) {
 	return 32, nil
 }
 
+var _ abi.Tuple = (*BalanceOfReturn)(nil)
+var _ abi.Decoder = (*BalanceOfReturn)(nil)
+var _ abi.PackedTuple = (*BalanceOfReturn)(nil)
+
+// RandomBalanceOfReturn returns a BalanceOfReturn filled with random values, for property based tests
+func RandomBalanceOfReturn(r *rand.Rand, maxDepth, maxLen int) BalanceOfReturn {
+	var t BalanceOfReturn
+	t.Field1 = abi.RandomBigInt(r, 256, false)
+	return t
+}
+
+// DecodeBalanceOfReturn decodes the return data of balanceOf into its values
+func DecodeBalanceOfReturn(data []byte) (r1 *big.Int, err error) {
+	var result BalanceOfReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeBalanceOf decodes the single return value of balanceOf
+func DecodeBalanceOf(data []byte) (*big.Int, error) {
+	return DecodeBalanceOfReturn(data)
+}
+
+// DecodeBalanceOfHex decodes the single return value of balanceOf from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeBalanceOfHex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode BalanceOfReturn: %w", err)
+	}
+	return DecodeBalanceOfReturn(data)
+}
+
+// EncodeBalanceOfResult encodes the single return value of balanceOf, e.g. for the return data of precompiles
+func EncodeBalanceOfResult(v *big.Int) ([]byte, error) {
+	result := BalanceOfReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*BatchProcessCall)(nil)
 
 const BatchProcessCallStaticSize = 32
 
-var _ abi.Tuple = (*BatchProcessCall)(nil)
-
 // BatchProcessCall represents an ABI tuple
 type BatchProcessCall struct {
 	Users []UserData
@@ -1114,6 +1489,7 @@ func (value BatchProcessCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Users: (uint256,(bytes32,string))[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = TestEncodeUserDataSlice(value.Users, buf[dynamicOffset:])
@@ -1163,6 +1539,37 @@ func (t *BatchProcessCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BatchProcessCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *BatchProcessCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes BatchProcessCall from the hex string with an optional 0x prefix
+func (t *BatchProcessCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BatchProcessCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*BatchProcessCall)(nil)
+var _ abi.Decoder = (*BatchProcessCall)(nil)
+
+// RandomBatchProcessCall returns a BatchProcessCall filled with random values, for property based tests
+func RandomBatchProcessCall(r *rand.Rand, maxDepth, maxLen int) BatchProcessCall {
+	var t BatchProcessCall
+	t.Users = make([]UserData, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Users {
+		t.Users[i0] = RandomUserData(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
 // GetMethodName returns the function name
 func (t BatchProcessCall) GetMethodName() string {
 	return "batchProcess"
@@ -1178,9 +1585,14 @@ func (t BatchProcessCall) GetMethodSelector() [4]byte {
 	return BatchProcessSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of batchProcess arguments including function selector
+func (t BatchProcessCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes batchProcess arguments to ABI bytes including function selector
 func (t BatchProcessCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], BatchProcessSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1188,6 +1600,59 @@ func (t BatchProcessCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes batchProcess arguments to 0x prefixed hex string
+func (t BatchProcessCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes batchProcess arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t BatchProcessCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the batchProcess calldata, returns 0 if encoding fails
+func (t BatchProcessCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes batchProcess arguments from ABI bytes including function selector
+func (t *BatchProcessCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != BatchProcessSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes batchProcess arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *BatchProcessCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BatchProcessCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewBatchProcessCall constructs a new BatchProcessCall
 func NewBatchProcessCall(
 	users []UserData,
@@ -1199,9 +1664,6 @@ func NewBatchProcessCall(
 
 const BatchProcessReturnStaticSize = 32
 
-var _ abi.Tuple = (*BatchProcessReturn)(nil)
-var _ abi.PackedTuple = (*BatchProcessReturn)(nil)
-
 // BatchProcessReturn represents an ABI tuple
 type BatchProcessReturn struct {
 	Field1 bool
@@ -1252,6 +1714,25 @@ func (t *BatchProcessReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes BatchProcessReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *BatchProcessReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes BatchProcessReturn from the hex string with an optional 0x prefix
+func (t *BatchProcessReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode BatchProcessReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of BatchProcessReturn
 func (t BatchProcessReturn) PackedEncodedSize() int {
 	return 1
@@ -1298,6 +1779,48 @@ func (t *BatchProcessReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*BatchProcessReturn)(nil)
+var _ abi.Decoder = (*BatchProcessReturn)(nil)
+var _ abi.PackedTuple = (*BatchProcessReturn)(nil)
+
+// RandomBatchProcessReturn returns a BatchProcessReturn filled with random values, for property based tests
+func RandomBatchProcessReturn(r *rand.Rand, maxDepth, maxLen int) BatchProcessReturn {
+	var t BatchProcessReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeBatchProcessReturn decodes the return data of batchProcess into its values
+func DecodeBatchProcessReturn(data []byte) (r1 bool, err error) {
+	var result BatchProcessReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeBatchProcess decodes the single return value of batchProcess
+func DecodeBatchProcess(data []byte) (bool, error) {
+	return DecodeBatchProcessReturn(data)
+}
+
+// DecodeBatchProcessHex decodes the single return value of batchProcess from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeBatchProcessHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode BatchProcessReturn: %w", err)
+	}
+	return DecodeBatchProcessReturn(data)
+}
+
+// EncodeBatchProcessResult encodes the single return value of batchProcess, e.g. for the return data of precompiles
+func EncodeBatchProcessResult(v bool) ([]byte, error) {
+	result := BatchProcessReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*CommunityPoolCall)(nil)
 
 // CommunityPoolCall represents the input arguments for communityPool function
@@ -1320,9 +1843,14 @@ func (t CommunityPoolCall) GetMethodSelector() [4]byte {
 	return CommunityPoolSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of communityPool arguments including function selector
+func (t CommunityPoolCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes communityPool arguments to ABI bytes including function selector
 func (t CommunityPoolCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], CommunityPoolSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1330,14 +1858,65 @@ func (t CommunityPoolCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
-// NewCommunityPoolCall constructs a new CommunityPoolCall
-func NewCommunityPoolCall() *CommunityPoolCall {
-	return &CommunityPoolCall{}
+// EncodeHex encodes communityPool arguments to 0x prefixed hex string
+func (t CommunityPoolCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
 }
 
-const CommunityPoolReturnStaticSize = 32
+// EncodeHexWithSelector encodes communityPool arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t CommunityPoolCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
 
-var _ abi.Tuple = (*CommunityPoolReturn)(nil)
+// CalldataCost returns the gas cost of the communityPool calldata, returns 0 if encoding fails
+func (t CommunityPoolCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes communityPool arguments from ABI bytes including function selector
+func (t *CommunityPoolCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != CommunityPoolSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes communityPool arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *CommunityPoolCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode CommunityPoolCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewCommunityPoolCall constructs a new CommunityPoolCall
+func NewCommunityPoolCall() *CommunityPoolCall {
+	return &CommunityPoolCall{}
+}
+
+const CommunityPoolReturnStaticSize = 32
 
 // CommunityPoolReturn represents an ABI tuple
 type CommunityPoolReturn struct {
@@ -1362,6 +1941,7 @@ func (value CommunityPoolReturn) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Coins: (string,uint256)[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = TestEncodeTuple45c89796Slice(value.Coins, buf[dynamicOffset:])
@@ -1411,6 +1991,69 @@ func (t *CommunityPoolReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes CommunityPoolReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *CommunityPoolReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes CommunityPoolReturn from the hex string with an optional 0x prefix
+func (t *CommunityPoolReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode CommunityPoolReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*CommunityPoolReturn)(nil)
+var _ abi.Decoder = (*CommunityPoolReturn)(nil)
+
+// RandomCommunityPoolReturn returns a CommunityPoolReturn filled with random values, for property based tests
+func RandomCommunityPoolReturn(r *rand.Rand, maxDepth, maxLen int) CommunityPoolReturn {
+	var t CommunityPoolReturn
+	t.Coins = make([]Tuple45c89796, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Coins {
+		t.Coins[i0] = RandomTuple45c89796(r, maxDepth-2, maxLen)
+	}
+	return t
+}
+
+// DecodeCommunityPoolReturn decodes the return data of communityPool into its values
+func DecodeCommunityPoolReturn(data []byte) (r1 []Tuple45c89796, err error) {
+	var result CommunityPoolReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Coins, nil
+}
+
+// DecodeCommunityPool decodes the single return value of communityPool
+func DecodeCommunityPool(data []byte) ([]Tuple45c89796, error) {
+	return DecodeCommunityPoolReturn(data)
+}
+
+// DecodeCommunityPoolHex decodes the single return value of communityPool from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeCommunityPoolHex(s string) ([]Tuple45c89796, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero []Tuple45c89796
+		return zero, fmt.Errorf("decode CommunityPoolReturn: %w", err)
+	}
+	return DecodeCommunityPoolReturn(data)
+}
+
+// EncodeCommunityPoolResult encodes the single return value of communityPool, e.g. for the return data of precompiles
+func EncodeCommunityPoolResult(v []Tuple45c89796) ([]byte, error) {
+	result := CommunityPoolReturn{Coins: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*EmptyArgsCall)(nil)
 
 // EmptyArgsCall represents the input arguments for emptyArgs function
@@ -1433,9 +2076,14 @@ func (t EmptyArgsCall) GetMethodSelector() [4]byte {
 	return EmptyArgsSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of emptyArgs arguments including function selector
+func (t EmptyArgsCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes emptyArgs arguments to ABI bytes including function selector
 func (t EmptyArgsCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], EmptyArgsSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1443,6 +2091,59 @@ func (t EmptyArgsCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes emptyArgs arguments to 0x prefixed hex string
+func (t EmptyArgsCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes emptyArgs arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t EmptyArgsCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the emptyArgs calldata, returns 0 if encoding fails
+func (t EmptyArgsCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes emptyArgs arguments from ABI bytes including function selector
+func (t *EmptyArgsCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != EmptyArgsSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes emptyArgs arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *EmptyArgsCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode EmptyArgsCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewEmptyArgsCall constructs a new EmptyArgsCall
 func NewEmptyArgsCall() *EmptyArgsCall {
 	return &EmptyArgsCall{}
@@ -1457,9 +2158,6 @@ var _ abi.Method = (*GetBalancesCall)(nil)
 
 const GetBalancesCallStaticSize = 320
 
-var _ abi.Tuple = (*GetBalancesCall)(nil)
-var _ abi.PackedTuple = (*GetBalancesCall)(nil)
-
 // GetBalancesCall represents an ABI tuple
 type GetBalancesCall struct {
 	Accounts [10]common.Address
@@ -1510,6 +2208,24 @@ func (t *GetBalancesCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetBalancesCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetBalancesCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes GetBalancesCall from the hex string with an optional 0x prefix
+func (t *GetBalancesCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetBalancesCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of GetBalancesCall
 func (t GetBalancesCall) PackedEncodedSize() int {
 	return 200
@@ -1556,6 +2272,19 @@ func (t *GetBalancesCall) PackedDecode(data []byte) (int, error) {
 	return 200, nil
 }
 
+var _ abi.Tuple = (*GetBalancesCall)(nil)
+var _ abi.Decoder = (*GetBalancesCall)(nil)
+var _ abi.PackedTuple = (*GetBalancesCall)(nil)
+
+// RandomGetBalancesCall returns a GetBalancesCall filled with random values, for property based tests
+func RandomGetBalancesCall(r *rand.Rand, maxDepth, maxLen int) GetBalancesCall {
+	var t GetBalancesCall
+	for i0 := range t.Accounts {
+		r.Read(t.Accounts[i0][:])
+	}
+	return t
+}
+
 // GetMethodName returns the function name
 func (t GetBalancesCall) GetMethodName() string {
 	return "getBalances"
@@ -1571,9 +2300,14 @@ func (t GetBalancesCall) GetMethodSelector() [4]byte {
 	return GetBalancesSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of getBalances arguments including function selector
+func (t GetBalancesCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes getBalances arguments to ABI bytes including function selector
 func (t GetBalancesCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], GetBalancesSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1581,6 +2315,84 @@ func (t GetBalancesCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes getBalances arguments to 0x prefixed hex string
+func (t GetBalancesCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes getBalances arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t GetBalancesCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the getBalances calldata, returns 0 if encoding fails
+func (t GetBalancesCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes getBalances arguments from ABI bytes including function selector
+func (t *GetBalancesCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetBalancesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes getBalances arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *GetBalancesCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetBalancesCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes getBalances arguments to packed ABI bytes including function selector
+func (t GetBalancesCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], GetBalancesSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes getBalances arguments from packed ABI bytes including function selector
+func (t *GetBalancesCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != GetBalancesSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewGetBalancesCall constructs a new GetBalancesCall
 func NewGetBalancesCall(
 	accounts [10]common.Address,
@@ -1592,9 +2404,6 @@ func NewGetBalancesCall(
 
 const GetBalancesReturnStaticSize = 320
 
-var _ abi.Tuple = (*GetBalancesReturn)(nil)
-var _ abi.PackedTuple = (*GetBalancesReturn)(nil)
-
 // GetBalancesReturn represents an ABI tuple
 type GetBalancesReturn struct {
 	Field1 [10]*big.Int
@@ -1645,6 +2454,25 @@ func (t *GetBalancesReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes GetBalancesReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *GetBalancesReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes GetBalancesReturn from the hex string with an optional 0x prefix
+func (t *GetBalancesReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode GetBalancesReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of GetBalancesReturn
 func (t GetBalancesReturn) PackedEncodedSize() int {
 	return 320
@@ -1691,12 +2519,54 @@ func (t *GetBalancesReturn) PackedDecode(data []byte) (int, error) {
 	return 320, nil
 }
 
+var _ abi.Tuple = (*GetBalancesReturn)(nil)
+var _ abi.Decoder = (*GetBalancesReturn)(nil)
+var _ abi.PackedTuple = (*GetBalancesReturn)(nil)
+
+// RandomGetBalancesReturn returns a GetBalancesReturn filled with random values, for property based tests
+func RandomGetBalancesReturn(r *rand.Rand, maxDepth, maxLen int) GetBalancesReturn {
+	var t GetBalancesReturn
+	for i0 := range t.Field1 {
+		t.Field1[i0] = abi.RandomBigInt(r, 256, false)
+	}
+	return t
+}
+
+// DecodeGetBalancesReturn decodes the return data of getBalances into its values
+func DecodeGetBalancesReturn(data []byte) (r1 [10]*big.Int, err error) {
+	var result GetBalancesReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeGetBalances decodes the single return value of getBalances
+func DecodeGetBalances(data []byte) ([10]*big.Int, error) {
+	return DecodeGetBalancesReturn(data)
+}
+
+// DecodeGetBalancesHex decodes the single return value of getBalances from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeGetBalancesHex(s string) ([10]*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero [10]*big.Int
+		return zero, fmt.Errorf("decode GetBalancesReturn: %w", err)
+	}
+	return DecodeGetBalancesReturn(data)
+}
+
+// EncodeGetBalancesResult encodes the single return value of getBalances, e.g. for the return data of precompiles
+func EncodeGetBalancesResult(v [10]*big.Int) ([]byte, error) {
+	result := GetBalancesReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*MultiTransferCall)(nil)
 
 const MultiTransferCallStaticSize = 64
 
-var _ abi.Tuple = (*MultiTransferCall)(nil)
-
 // MultiTransferCall represents an ABI tuple
 type MultiTransferCall struct {
 	Recipients []common.Address
@@ -1722,6 +2592,7 @@ func (value MultiTransferCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Recipients: address[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeAddressSlice(value.Recipients, buf[dynamicOffset:])
@@ -1732,6 +2603,7 @@ func (value MultiTransferCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Amounts: uint256[]
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeUint256Slice(value.Amounts, buf[dynamicOffset:])
@@ -1796,6 +2668,41 @@ func (t *MultiTransferCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes MultiTransferCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *MultiTransferCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes MultiTransferCall from the hex string with an optional 0x prefix
+func (t *MultiTransferCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode MultiTransferCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*MultiTransferCall)(nil)
+var _ abi.Decoder = (*MultiTransferCall)(nil)
+
+// RandomMultiTransferCall returns a MultiTransferCall filled with random values, for property based tests
+func RandomMultiTransferCall(r *rand.Rand, maxDepth, maxLen int) MultiTransferCall {
+	var t MultiTransferCall
+	t.Recipients = make([]common.Address, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Recipients {
+		r.Read(t.Recipients[i0][:])
+	}
+	t.Amounts = make([]*big.Int, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Amounts {
+		t.Amounts[i0] = abi.RandomBigInt(r, 256, false)
+	}
+	return t
+}
+
 // GetMethodName returns the function name
 func (t MultiTransferCall) GetMethodName() string {
 	return "multiTransfer"
@@ -1811,9 +2718,14 @@ func (t MultiTransferCall) GetMethodSelector() [4]byte {
 	return MultiTransferSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of multiTransfer arguments including function selector
+func (t MultiTransferCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes multiTransfer arguments to ABI bytes including function selector
 func (t MultiTransferCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], MultiTransferSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1821,6 +2733,59 @@ func (t MultiTransferCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes multiTransfer arguments to 0x prefixed hex string
+func (t MultiTransferCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes multiTransfer arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t MultiTransferCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the multiTransfer calldata, returns 0 if encoding fails
+func (t MultiTransferCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes multiTransfer arguments from ABI bytes including function selector
+func (t *MultiTransferCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != MultiTransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes multiTransfer arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *MultiTransferCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode MultiTransferCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewMultiTransferCall constructs a new MultiTransferCall
 func NewMultiTransferCall(
 	recipients []common.Address,
@@ -1841,8 +2806,6 @@ var _ abi.Method = (*ProcessUserDataCall)(nil)
 
 const ProcessUserDataCallStaticSize = 64
 
-var _ abi.Tuple = (*ProcessUserDataCall)(nil)
-
 // ProcessUserDataCall represents an ABI tuple
 type ProcessUserDataCall struct {
 	User1 User
@@ -1868,6 +2831,7 @@ func (value ProcessUserDataCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field User1: (address,string,int256)
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.User1.EncodeTo(buf[dynamicOffset:])
@@ -1878,6 +2842,7 @@ func (value ProcessUserDataCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field User2: (address,string,int256)
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = value.User2.EncodeTo(buf[dynamicOffset:])
@@ -1942,6 +2907,35 @@ func (t *ProcessUserDataCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes ProcessUserDataCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *ProcessUserDataCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes ProcessUserDataCall from the hex string with an optional 0x prefix
+func (t *ProcessUserDataCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ProcessUserDataCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*ProcessUserDataCall)(nil)
+var _ abi.Decoder = (*ProcessUserDataCall)(nil)
+
+// RandomProcessUserDataCall returns a ProcessUserDataCall filled with random values, for property based tests
+func RandomProcessUserDataCall(r *rand.Rand, maxDepth, maxLen int) ProcessUserDataCall {
+	var t ProcessUserDataCall
+	t.User1 = RandomUser(r, maxDepth-1, maxLen)
+	t.User2 = RandomUser(r, maxDepth-1, maxLen)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t ProcessUserDataCall) GetMethodName() string {
 	return "processUserData"
@@ -1957,9 +2951,14 @@ func (t ProcessUserDataCall) GetMethodSelector() [4]byte {
 	return ProcessUserDataSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of processUserData arguments including function selector
+func (t ProcessUserDataCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes processUserData arguments to ABI bytes including function selector
 func (t ProcessUserDataCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], ProcessUserDataSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -1967,6 +2966,59 @@ func (t ProcessUserDataCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes processUserData arguments to 0x prefixed hex string
+func (t ProcessUserDataCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes processUserData arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t ProcessUserDataCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the processUserData calldata, returns 0 if encoding fails
+func (t ProcessUserDataCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes processUserData arguments from ABI bytes including function selector
+func (t *ProcessUserDataCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != ProcessUserDataSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes processUserData arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *ProcessUserDataCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ProcessUserDataCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewProcessUserDataCall constructs a new ProcessUserDataCall
 func NewProcessUserDataCall(
 	user1 User,
@@ -1980,9 +3032,6 @@ func NewProcessUserDataCall(
 
 const ProcessUserDataReturnStaticSize = 32
 
-var _ abi.Tuple = (*ProcessUserDataReturn)(nil)
-var _ abi.PackedTuple = (*ProcessUserDataReturn)(nil)
-
 // ProcessUserDataReturn represents an ABI tuple
 type ProcessUserDataReturn struct {
 	Field1 bool
@@ -2033,6 +3082,25 @@ func (t *ProcessUserDataReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes ProcessUserDataReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *ProcessUserDataReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes ProcessUserDataReturn from the hex string with an optional 0x prefix
+func (t *ProcessUserDataReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode ProcessUserDataReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of ProcessUserDataReturn
 func (t ProcessUserDataReturn) PackedEncodedSize() int {
 	return 1
@@ -2079,11 +3147,51 @@ func (t *ProcessUserDataReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
-var _ abi.Method = (*SetDataCall)(nil)
-
-const SetDataCallStaticSize = 64
+var _ abi.Tuple = (*ProcessUserDataReturn)(nil)
+var _ abi.Decoder = (*ProcessUserDataReturn)(nil)
+var _ abi.PackedTuple = (*ProcessUserDataReturn)(nil)
 
-var _ abi.Tuple = (*SetDataCall)(nil)
+// RandomProcessUserDataReturn returns a ProcessUserDataReturn filled with random values, for property based tests
+func RandomProcessUserDataReturn(r *rand.Rand, maxDepth, maxLen int) ProcessUserDataReturn {
+	var t ProcessUserDataReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeProcessUserDataReturn decodes the return data of processUserData into its values
+func DecodeProcessUserDataReturn(data []byte) (r1 bool, err error) {
+	var result ProcessUserDataReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeProcessUserData decodes the single return value of processUserData
+func DecodeProcessUserData(data []byte) (bool, error) {
+	return DecodeProcessUserDataReturn(data)
+}
+
+// DecodeProcessUserDataHex decodes the single return value of processUserData from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeProcessUserDataHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode ProcessUserDataReturn: %w", err)
+	}
+	return DecodeProcessUserDataReturn(data)
+}
+
+// EncodeProcessUserDataResult encodes the single return value of processUserData, e.g. for the return data of precompiles
+func EncodeProcessUserDataResult(v bool) ([]byte, error) {
+	result := ProcessUserDataReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*SetDataCall)(nil)
+
+const SetDataCallStaticSize = 64
 
 // SetDataCall represents an ABI tuple
 type SetDataCall struct {
@@ -2114,6 +3222,7 @@ func (value SetDataCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Value: bytes
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeBytes(value.Value, buf[dynamicOffset:])
@@ -2168,6 +3277,35 @@ func (t *SetDataCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SetDataCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *SetDataCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes SetDataCall from the hex string with an optional 0x prefix
+func (t *SetDataCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SetDataCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*SetDataCall)(nil)
+var _ abi.Decoder = (*SetDataCall)(nil)
+
+// RandomSetDataCall returns a SetDataCall filled with random values, for property based tests
+func RandomSetDataCall(r *rand.Rand, maxDepth, maxLen int) SetDataCall {
+	var t SetDataCall
+	r.Read(t.Key[:])
+	t.Value = abi.RandomBytes(r, maxLen)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t SetDataCall) GetMethodName() string {
 	return "setData"
@@ -2183,9 +3321,14 @@ func (t SetDataCall) GetMethodSelector() [4]byte {
 	return SetDataSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of setData arguments including function selector
+func (t SetDataCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes setData arguments to ABI bytes including function selector
 func (t SetDataCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], SetDataSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -2193,6 +3336,59 @@ func (t SetDataCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes setData arguments to 0x prefixed hex string
+func (t SetDataCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes setData arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t SetDataCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the setData calldata, returns 0 if encoding fails
+func (t SetDataCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes setData arguments from ABI bytes including function selector
+func (t *SetDataCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SetDataSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes setData arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *SetDataCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SetDataCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewSetDataCall constructs a new SetDataCall
 func NewSetDataCall(
 	key [32]byte,
@@ -2213,8 +3409,6 @@ var _ abi.Method = (*SetMessageCall)(nil)
 
 const SetMessageCallStaticSize = 32
 
-var _ abi.Tuple = (*SetMessageCall)(nil)
-
 // SetMessageCall represents an ABI tuple
 type SetMessageCall struct {
 	Message string
@@ -2238,6 +3432,7 @@ func (value SetMessageCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Message: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Message, buf[dynamicOffset:])
@@ -2287,6 +3482,34 @@ func (t *SetMessageCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SetMessageCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *SetMessageCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes SetMessageCall from the hex string with an optional 0x prefix
+func (t *SetMessageCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SetMessageCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*SetMessageCall)(nil)
+var _ abi.Decoder = (*SetMessageCall)(nil)
+
+// RandomSetMessageCall returns a SetMessageCall filled with random values, for property based tests
+func RandomSetMessageCall(r *rand.Rand, maxDepth, maxLen int) SetMessageCall {
+	var t SetMessageCall
+	t.Message = abi.RandomString(r, maxLen)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t SetMessageCall) GetMethodName() string {
 	return "setMessage"
@@ -2302,9 +3525,14 @@ func (t SetMessageCall) GetMethodSelector() [4]byte {
 	return SetMessageSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of setMessage arguments including function selector
+func (t SetMessageCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes setMessage arguments to ABI bytes including function selector
 func (t SetMessageCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], SetMessageSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -2312,6 +3540,59 @@ func (t SetMessageCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes setMessage arguments to 0x prefixed hex string
+func (t SetMessageCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes setMessage arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t SetMessageCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the setMessage calldata, returns 0 if encoding fails
+func (t SetMessageCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes setMessage arguments from ABI bytes including function selector
+func (t *SetMessageCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SetMessageSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes setMessage arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *SetMessageCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SetMessageCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewSetMessageCall constructs a new SetMessageCall
 func NewSetMessageCall(
 	message string,
@@ -2323,9 +3604,6 @@ func NewSetMessageCall(
 
 const SetMessageReturnStaticSize = 32
 
-var _ abi.Tuple = (*SetMessageReturn)(nil)
-var _ abi.PackedTuple = (*SetMessageReturn)(nil)
-
 // SetMessageReturn represents an ABI tuple
 type SetMessageReturn struct {
 	Field1 bool
@@ -2376,6 +3654,25 @@ func (t *SetMessageReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SetMessageReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *SetMessageReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes SetMessageReturn from the hex string with an optional 0x prefix
+func (t *SetMessageReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SetMessageReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of SetMessageReturn
 func (t SetMessageReturn) PackedEncodedSize() int {
 	return 1
@@ -2422,13 +3719,52 @@ func (t *SetMessageReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*SetMessageReturn)(nil)
+var _ abi.Decoder = (*SetMessageReturn)(nil)
+var _ abi.PackedTuple = (*SetMessageReturn)(nil)
+
+// RandomSetMessageReturn returns a SetMessageReturn filled with random values, for property based tests
+func RandomSetMessageReturn(r *rand.Rand, maxDepth, maxLen int) SetMessageReturn {
+	var t SetMessageReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeSetMessageReturn decodes the return data of setMessage into its values
+func DecodeSetMessageReturn(data []byte) (r1 bool, err error) {
+	var result SetMessageReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeSetMessage decodes the single return value of setMessage
+func DecodeSetMessage(data []byte) (bool, error) {
+	return DecodeSetMessageReturn(data)
+}
+
+// DecodeSetMessageHex decodes the single return value of setMessage from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeSetMessageHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode SetMessageReturn: %w", err)
+	}
+	return DecodeSetMessageReturn(data)
+}
+
+// EncodeSetMessageResult encodes the single return value of setMessage, e.g. for the return data of precompiles
+func EncodeSetMessageResult(v bool) ([]byte, error) {
+	result := SetMessageReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*SmallIntegersCall)(nil)
 
 const SmallIntegersCallStaticSize = 256
 
-var _ abi.Tuple = (*SmallIntegersCall)(nil)
-var _ abi.PackedTuple = (*SmallIntegersCall)(nil)
-
 // SmallIntegersCall represents an ABI tuple
 type SmallIntegersCall struct {
 	U8  uint8
@@ -2556,6 +3892,24 @@ func (t *SmallIntegersCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SmallIntegersCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *SmallIntegersCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes SmallIntegersCall from the hex string with an optional 0x prefix
+func (t *SmallIntegersCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SmallIntegersCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of SmallIntegersCall
 func (t SmallIntegersCall) PackedEncodedSize() int {
 	return 30
@@ -2686,6 +4040,24 @@ func (t *SmallIntegersCall) PackedDecode(data []byte) (int, error) {
 	return 30, nil
 }
 
+var _ abi.Tuple = (*SmallIntegersCall)(nil)
+var _ abi.Decoder = (*SmallIntegersCall)(nil)
+var _ abi.PackedTuple = (*SmallIntegersCall)(nil)
+
+// RandomSmallIntegersCall returns a SmallIntegersCall filled with random values, for property based tests
+func RandomSmallIntegersCall(r *rand.Rand, maxDepth, maxLen int) SmallIntegersCall {
+	var t SmallIntegersCall
+	t.U8 = uint8(r.Uint64() >> 56)
+	t.U16 = uint16(r.Uint64() >> 48)
+	t.U32 = uint32(r.Uint64() >> 32)
+	t.U64 = uint64(r.Uint64() >> 0)
+	t.I8 = int8(int64(r.Uint64()) >> 56)
+	t.I16 = int16(int64(r.Uint64()) >> 48)
+	t.I32 = int32(int64(r.Uint64()) >> 32)
+	t.I64 = int64(int64(r.Uint64()) >> 0)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t SmallIntegersCall) GetMethodName() string {
 	return "smallIntegers"
@@ -2701,9 +4073,14 @@ func (t SmallIntegersCall) GetMethodSelector() [4]byte {
 	return SmallIntegersSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of smallIntegers arguments including function selector
+func (t SmallIntegersCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes smallIntegers arguments to ABI bytes including function selector
 func (t SmallIntegersCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], SmallIntegersSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -2711,6 +4088,84 @@ func (t SmallIntegersCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes smallIntegers arguments to 0x prefixed hex string
+func (t SmallIntegersCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes smallIntegers arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t SmallIntegersCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the smallIntegers calldata, returns 0 if encoding fails
+func (t SmallIntegersCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes smallIntegers arguments from ABI bytes including function selector
+func (t *SmallIntegersCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SmallIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes smallIntegers arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *SmallIntegersCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SmallIntegersCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes smallIntegers arguments to packed ABI bytes including function selector
+func (t SmallIntegersCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], SmallIntegersSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes smallIntegers arguments from packed ABI bytes including function selector
+func (t *SmallIntegersCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != SmallIntegersSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewSmallIntegersCall constructs a new SmallIntegersCall
 func NewSmallIntegersCall(
 	u8 uint8,
@@ -2736,9 +4191,6 @@ func NewSmallIntegersCall(
 
 const SmallIntegersReturnStaticSize = 32
 
-var _ abi.Tuple = (*SmallIntegersReturn)(nil)
-var _ abi.PackedTuple = (*SmallIntegersReturn)(nil)
-
 // SmallIntegersReturn represents an ABI tuple
 type SmallIntegersReturn struct {
 	Field1 bool
@@ -2789,6 +4241,25 @@ func (t *SmallIntegersReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes SmallIntegersReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *SmallIntegersReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes SmallIntegersReturn from the hex string with an optional 0x prefix
+func (t *SmallIntegersReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode SmallIntegersReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of SmallIntegersReturn
 func (t SmallIntegersReturn) PackedEncodedSize() int {
 	return 1
@@ -2835,13 +4306,306 @@ func (t *SmallIntegersReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*SmallIntegersReturn)(nil)
+var _ abi.Decoder = (*SmallIntegersReturn)(nil)
+var _ abi.PackedTuple = (*SmallIntegersReturn)(nil)
+
+// RandomSmallIntegersReturn returns a SmallIntegersReturn filled with random values, for property based tests
+func RandomSmallIntegersReturn(r *rand.Rand, maxDepth, maxLen int) SmallIntegersReturn {
+	var t SmallIntegersReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeSmallIntegersReturn decodes the return data of smallIntegers into its values
+func DecodeSmallIntegersReturn(data []byte) (r1 bool, err error) {
+	var result SmallIntegersReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeSmallIntegers decodes the single return value of smallIntegers
+func DecodeSmallIntegers(data []byte) (bool, error) {
+	return DecodeSmallIntegersReturn(data)
+}
+
+// DecodeSmallIntegersHex decodes the single return value of smallIntegers from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeSmallIntegersHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode SmallIntegersReturn: %w", err)
+	}
+	return DecodeSmallIntegersReturn(data)
+}
+
+// EncodeSmallIntegersResult encodes the single return value of smallIntegers, e.g. for the return data of precompiles
+func EncodeSmallIntegersResult(v bool) ([]byte, error) {
+	result := SmallIntegersReturn{Field1: v}
+	return result.Encode()
+}
+
+var _ abi.Method = (*TotalSupplyCall)(nil)
+
+// TotalSupplyCall represents the input arguments for totalSupply function
+type TotalSupplyCall struct {
+	abi.EmptyTuple
+}
+
+// GetMethodName returns the function name
+func (t TotalSupplyCall) GetMethodName() string {
+	return "totalSupply"
+}
+
+// GetMethodID returns the function id
+func (t TotalSupplyCall) GetMethodID() uint32 {
+	return TotalSupplyID
+}
+
+// GetMethodSelector returns the function selector
+func (t TotalSupplyCall) GetMethodSelector() [4]byte {
+	return TotalSupplySelector
+}
+
+// EncodedSizeWithSelector returns the encoded size of totalSupply arguments including function selector
+func (t TotalSupplyCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
+// EncodeWithSelector encodes totalSupply arguments to ABI bytes including function selector
+func (t TotalSupplyCall) EncodeWithSelector() ([]byte, error) {
+	result := make([]byte, t.EncodedSizeWithSelector())
+	copy(result[:4], TotalSupplySelector[:])
+	if _, err := t.EncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// EncodeHex encodes totalSupply arguments to 0x prefixed hex string
+func (t TotalSupplyCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes totalSupply arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TotalSupplyCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the totalSupply calldata, returns 0 if encoding fails
+func (t TotalSupplyCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes totalSupply arguments from ABI bytes including function selector
+func (t *TotalSupplyCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TotalSupplySelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes totalSupply arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TotalSupplyCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TotalSupplyCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// NewTotalSupplyCall constructs a new TotalSupplyCall
+func NewTotalSupplyCall() *TotalSupplyCall {
+	return &TotalSupplyCall{}
+}
+
+const TotalSupplyReturnStaticSize = 32
+
+// TotalSupplyReturn represents an ABI tuple
+type TotalSupplyReturn struct {
+	Field1 *big.Int
+}
+
+// EncodedSize returns the total encoded size of TotalSupplyReturn
+func (t TotalSupplyReturn) EncodedSize() int {
+	dynamicSize := 0
+
+	return TotalSupplyReturnStaticSize + dynamicSize
+}
+
+// EncodeTo encodes TotalSupplyReturn to ABI bytes in the provided buffer
+func (value TotalSupplyReturn) EncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields
+	dynamicOffset := TotalSupplyReturnStaticSize // Start dynamic data after static section
+	// Field Field1: uint256
+	if _, err := abi.EncodeUint256(value.Field1, buf[0:]); err != nil {
+		return 0, err
+	}
+
+	return dynamicOffset, nil
+}
+
+// Encode encodes TotalSupplyReturn to ABI bytes
+func (value TotalSupplyReturn) Encode() ([]byte, error) {
+	buf := make([]byte, value.EncodedSize())
+	if _, err := value.EncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Decode decodes TotalSupplyReturn from ABI bytes in the provided buffer
+func (t *TotalSupplyReturn) Decode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var (
+		err error
+	)
+	dynamicOffset := 32
+	// Decode static field Field1: uint256
+	t.Field1, _, err = abi.DecodeIntoUint256(t.Field1, data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return dynamicOffset, nil
+}
+
+// DecodeStrict decodes TotalSupplyReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TotalSupplyReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TotalSupplyReturn from the hex string with an optional 0x prefix
+func (t *TotalSupplyReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TotalSupplyReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
+// PackedEncodedSize returns the packed encoded size of TotalSupplyReturn
+func (t TotalSupplyReturn) PackedEncodedSize() int {
+	return 32
+}
+
+// PackedEncodeTo encodes TotalSupplyReturn to packed ABI bytes in the provided buffer
+func (value TotalSupplyReturn) PackedEncodeTo(buf []byte) (int, error) {
+	// Encode tuple fields sequentially (packed, no dynamic section)
+	var (
+		offset int
+		n      int
+		err    error
+	)
+	// Field Field1: uint256
+	n, err = abi.PackedEncodeUint256(value.Field1, buf[offset:])
+	if err != nil {
+		return 0, err
+	}
+	offset += n
+
+	return offset, nil
+}
+
+// PackedEncode encodes TotalSupplyReturn to packed ABI bytes
+func (value TotalSupplyReturn) PackedEncode() ([]byte, error) {
+	buf := make([]byte, value.PackedEncodedSize())
+	if _, err := value.PackedEncodeTo(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// PackedDecode decodes TotalSupplyReturn from packed ABI bytes
+func (t *TotalSupplyReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 32 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: uint256
+	t.Field1, _, err = abi.PackedDecodeUint256(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 32, nil
+}
+
+var _ abi.Tuple = (*TotalSupplyReturn)(nil)
+var _ abi.Decoder = (*TotalSupplyReturn)(nil)
+var _ abi.PackedTuple = (*TotalSupplyReturn)(nil)
+
+// RandomTotalSupplyReturn returns a TotalSupplyReturn filled with random values, for property based tests
+func RandomTotalSupplyReturn(r *rand.Rand, maxDepth, maxLen int) TotalSupplyReturn {
+	var t TotalSupplyReturn
+	t.Field1 = abi.RandomBigInt(r, 256, false)
+	return t
+}
+
+// DecodeTotalSupplyReturn decodes the return data of totalSupply into its values
+func DecodeTotalSupplyReturn(data []byte) (r1 *big.Int, err error) {
+	var result TotalSupplyReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTotalSupply decodes the single return value of totalSupply
+func DecodeTotalSupply(data []byte) (*big.Int, error) {
+	return DecodeTotalSupplyReturn(data)
+}
+
+// DecodeTotalSupplyHex decodes the single return value of totalSupply from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTotalSupplyHex(s string) (*big.Int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero *big.Int
+		return zero, fmt.Errorf("decode TotalSupplyReturn: %w", err)
+	}
+	return DecodeTotalSupplyReturn(data)
+}
+
+// EncodeTotalSupplyResult encodes the single return value of totalSupply, e.g. for the return data of precompiles
+func EncodeTotalSupplyResult(v *big.Int) ([]byte, error) {
+	result := TotalSupplyReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*TransferCall)(nil)
 
 const TransferCallStaticSize = 64
 
-var _ abi.Tuple = (*TransferCall)(nil)
-var _ abi.PackedTuple = (*TransferCall)(nil)
-
 // TransferCall represents an ABI tuple
 type TransferCall struct {
 	To     common.Address
@@ -2896,13 +4660,31 @@ func (t *TransferCall) Decode(data []byte) (int, error) {
 		return 0, err
 	}
 	// Decode static field Amount: uint256
-	t.Amount, _, err = abi.DecodeUint256(data[32:])
+	t.Amount, _, err = abi.DecodeIntoUint256(t.Amount, data[32:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferCall from the hex string with an optional 0x prefix
+func (t *TransferCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferCall
 func (t TransferCall) PackedEncodedSize() int {
 	return 52
@@ -2961,6 +4743,18 @@ func (t *TransferCall) PackedDecode(data []byte) (int, error) {
 	return 52, nil
 }
 
+var _ abi.Tuple = (*TransferCall)(nil)
+var _ abi.Decoder = (*TransferCall)(nil)
+var _ abi.PackedTuple = (*TransferCall)(nil)
+
+// RandomTransferCall returns a TransferCall filled with random values, for property based tests
+func RandomTransferCall(r *rand.Rand, maxDepth, maxLen int) TransferCall {
+	var t TransferCall
+	r.Read(t.To[:])
+	t.Amount = abi.RandomBigInt(r, 256, false)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t TransferCall) GetMethodName() string {
 	return "transfer"
@@ -2976,9 +4770,14 @@ func (t TransferCall) GetMethodSelector() [4]byte {
 	return TransferSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of transfer arguments including function selector
+func (t TransferCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes transfer arguments to ABI bytes including function selector
 func (t TransferCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], TransferSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -2986,6 +4785,84 @@ func (t TransferCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes transfer arguments to 0x prefixed hex string
+func (t TransferCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes transfer arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TransferCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the transfer calldata, returns 0 if encoding fails
+func (t TransferCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes transfer arguments from ABI bytes including function selector
+func (t *TransferCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes transfer arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TransferCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
+// PackedEncodeWithSelector encodes transfer arguments to packed ABI bytes including function selector
+func (t TransferCall) PackedEncodeWithSelector() ([]byte, error) {
+	result := make([]byte, 4+t.PackedEncodedSize())
+	copy(result[:4], TransferSelector[:])
+	if _, err := t.PackedEncodeTo(result[4:]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PackedDecodeWithSelector decodes transfer arguments from packed ABI bytes including function selector
+func (t *TransferCall) PackedDecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.PackedDecode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
 // NewTransferCall constructs a new TransferCall
 func NewTransferCall(
 	to common.Address,
@@ -2999,9 +4876,6 @@ func NewTransferCall(
 
 const TransferReturnStaticSize = 32
 
-var _ abi.Tuple = (*TransferReturn)(nil)
-var _ abi.PackedTuple = (*TransferReturn)(nil)
-
 // TransferReturn represents an ABI tuple
 type TransferReturn struct {
 	Field1 bool
@@ -3052,6 +4926,25 @@ func (t *TransferReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TransferReturn from the hex string with an optional 0x prefix
+func (t *TransferReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferReturn
 func (t TransferReturn) PackedEncodedSize() int {
 	return 1
@@ -3084,26 +4977,66 @@ func (value TransferReturn) PackedEncode() ([]byte, error) {
 	return buf, nil
 }
 
-// PackedDecode decodes TransferReturn from packed ABI bytes
-func (t *TransferReturn) PackedDecode(data []byte) (int, error) {
-	if len(data) < 1 {
-		return 0, io.ErrUnexpectedEOF
-	}
-	var err error
-	// Decode field Field1: bool
-	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+// PackedDecode decodes TransferReturn from packed ABI bytes
+func (t *TransferReturn) PackedDecode(data []byte) (int, error) {
+	if len(data) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	var err error
+	// Decode field Field1: bool
+	t.Field1, _, err = abi.PackedDecodeBool(data[0:])
+	if err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+var _ abi.Tuple = (*TransferReturn)(nil)
+var _ abi.Decoder = (*TransferReturn)(nil)
+var _ abi.PackedTuple = (*TransferReturn)(nil)
+
+// RandomTransferReturn returns a TransferReturn filled with random values, for property based tests
+func RandomTransferReturn(r *rand.Rand, maxDepth, maxLen int) TransferReturn {
+	var t TransferReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTransferReturn decodes the return data of transfer into its values
+func DecodeTransferReturn(data []byte) (r1 bool, err error) {
+	var result TransferReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTransfer decodes the single return value of transfer
+func DecodeTransfer(data []byte) (bool, error) {
+	return DecodeTransferReturn(data)
+}
+
+// DecodeTransferHex decodes the single return value of transfer from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTransferHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
 	if err != nil {
-		return 0, err
+		var zero bool
+		return zero, fmt.Errorf("decode TransferReturn: %w", err)
 	}
-	return 1, nil
+	return DecodeTransferReturn(data)
+}
+
+// EncodeTransferResult encodes the single return value of transfer, e.g. for the return data of precompiles
+func EncodeTransferResult(v bool) ([]byte, error) {
+	result := TransferReturn{Field1: v}
+	return result.Encode()
 }
 
 var _ abi.Method = (*TransferBatchCall)(nil)
 
 const TransferBatchCallStaticSize = 64
 
-var _ abi.Tuple = (*TransferBatchCall)(nil)
-
 // TransferBatchCall represents an ABI tuple
 type TransferBatchCall struct {
 	Recipients []common.Address
@@ -3129,6 +5062,7 @@ func (value TransferBatchCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Recipients: address[]
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeAddressSlice(value.Recipients, buf[dynamicOffset:])
@@ -3139,6 +5073,7 @@ func (value TransferBatchCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Amounts: uint256[]
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeUint256Slice(value.Amounts, buf[dynamicOffset:])
@@ -3203,6 +5138,41 @@ func (t *TransferBatchCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferBatchCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferBatchCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes TransferBatchCall from the hex string with an optional 0x prefix
+func (t *TransferBatchCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferBatchCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*TransferBatchCall)(nil)
+var _ abi.Decoder = (*TransferBatchCall)(nil)
+
+// RandomTransferBatchCall returns a TransferBatchCall filled with random values, for property based tests
+func RandomTransferBatchCall(r *rand.Rand, maxDepth, maxLen int) TransferBatchCall {
+	var t TransferBatchCall
+	t.Recipients = make([]common.Address, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Recipients {
+		r.Read(t.Recipients[i0][:])
+	}
+	t.Amounts = make([]*big.Int, abi.RandomLen(r, maxDepth, maxLen))
+	for i0 := range t.Amounts {
+		t.Amounts[i0] = abi.RandomBigInt(r, 256, false)
+	}
+	return t
+}
+
 // GetMethodName returns the function name
 func (t TransferBatchCall) GetMethodName() string {
 	return "transferBatch"
@@ -3218,9 +5188,14 @@ func (t TransferBatchCall) GetMethodSelector() [4]byte {
 	return TransferBatchSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of transferBatch arguments including function selector
+func (t TransferBatchCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes transferBatch arguments to ABI bytes including function selector
 func (t TransferBatchCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], TransferBatchSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -3228,6 +5203,59 @@ func (t TransferBatchCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes transferBatch arguments to 0x prefixed hex string
+func (t TransferBatchCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes transferBatch arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t TransferBatchCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the transferBatch calldata, returns 0 if encoding fails
+func (t TransferBatchCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes transferBatch arguments from ABI bytes including function selector
+func (t *TransferBatchCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != TransferBatchSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes transferBatch arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *TransferBatchCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferBatchCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewTransferBatchCall constructs a new TransferBatchCall
 func NewTransferBatchCall(
 	recipients []common.Address,
@@ -3241,9 +5269,6 @@ func NewTransferBatchCall(
 
 const TransferBatchReturnStaticSize = 32
 
-var _ abi.Tuple = (*TransferBatchReturn)(nil)
-var _ abi.PackedTuple = (*TransferBatchReturn)(nil)
-
 // TransferBatchReturn represents an ABI tuple
 type TransferBatchReturn struct {
 	Field1 bool
@@ -3294,6 +5319,25 @@ func (t *TransferBatchReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes TransferBatchReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *TransferBatchReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes TransferBatchReturn from the hex string with an optional 0x prefix
+func (t *TransferBatchReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode TransferBatchReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of TransferBatchReturn
 func (t TransferBatchReturn) PackedEncodedSize() int {
 	return 1
@@ -3340,12 +5384,52 @@ func (t *TransferBatchReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*TransferBatchReturn)(nil)
+var _ abi.Decoder = (*TransferBatchReturn)(nil)
+var _ abi.PackedTuple = (*TransferBatchReturn)(nil)
+
+// RandomTransferBatchReturn returns a TransferBatchReturn filled with random values, for property based tests
+func RandomTransferBatchReturn(r *rand.Rand, maxDepth, maxLen int) TransferBatchReturn {
+	var t TransferBatchReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeTransferBatchReturn decodes the return data of transferBatch into its values
+func DecodeTransferBatchReturn(data []byte) (r1 bool, err error) {
+	var result TransferBatchReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeTransferBatch decodes the single return value of transferBatch
+func DecodeTransferBatch(data []byte) (bool, error) {
+	return DecodeTransferBatchReturn(data)
+}
+
+// DecodeTransferBatchHex decodes the single return value of transferBatch from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeTransferBatchHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode TransferBatchReturn: %w", err)
+	}
+	return DecodeTransferBatchReturn(data)
+}
+
+// EncodeTransferBatchResult encodes the single return value of transferBatch, e.g. for the return data of precompiles
+func EncodeTransferBatchResult(v bool) ([]byte, error) {
+	result := TransferBatchReturn{Field1: v}
+	return result.Encode()
+}
+
 var _ abi.Method = (*UnderstoreCall)(nil)
 
 const UnderstoreCallStaticSize = 32
 
-var _ abi.Tuple = (*UnderstoreCall)(nil)
-
 // UnderstoreCall represents an ABI tuple
 type UnderstoreCall struct {
 	Name string
@@ -3369,6 +5453,7 @@ func (value UnderstoreCall) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Name: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
@@ -3418,6 +5503,34 @@ func (t *UnderstoreCall) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UnderstoreCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *UnderstoreCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UnderstoreCall from the hex string with an optional 0x prefix
+func (t *UnderstoreCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UnderstoreCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*UnderstoreCall)(nil)
+var _ abi.Decoder = (*UnderstoreCall)(nil)
+
+// RandomUnderstoreCall returns a UnderstoreCall filled with random values, for property based tests
+func RandomUnderstoreCall(r *rand.Rand, maxDepth, maxLen int) UnderstoreCall {
+	var t UnderstoreCall
+	t.Name = abi.RandomString(r, maxLen)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t UnderstoreCall) GetMethodName() string {
 	return "understore"
@@ -3433,9 +5546,14 @@ func (t UnderstoreCall) GetMethodSelector() [4]byte {
 	return UnderstoreSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of understore arguments including function selector
+func (t UnderstoreCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes understore arguments to ABI bytes including function selector
 func (t UnderstoreCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], UnderstoreSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -3443,6 +5561,59 @@ func (t UnderstoreCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes understore arguments to 0x prefixed hex string
+func (t UnderstoreCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes understore arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t UnderstoreCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the understore calldata, returns 0 if encoding fails
+func (t UnderstoreCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes understore arguments from ABI bytes including function selector
+func (t *UnderstoreCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != UnderstoreSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes understore arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *UnderstoreCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UnderstoreCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewUnderstoreCall constructs a new UnderstoreCall
 func NewUnderstoreCall(
 	name string,
@@ -3461,8 +5632,6 @@ var _ abi.Method = (*UpdateProfileCall)(nil)
 
 const UpdateProfileCallStaticSize = 96
 
-var _ abi.Tuple = (*UpdateProfileCall)(nil)
-
 // UpdateProfileCall represents an ABI tuple
 type UpdateProfileCall struct {
 	User common.Address
@@ -3493,6 +5662,7 @@ func (value UpdateProfileCall) EncodeTo(buf []byte) (int, error) {
 
 	// Field Name: string
 	// Encode offset pointer
+	abi.ClearWord(buf[32:])
 	binary.BigEndian.PutUint64(buf[32+24:32+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Name, buf[dynamicOffset:])
@@ -3550,13 +5720,43 @@ func (t *UpdateProfileCall) Decode(data []byte) (int, error) {
 		dynamicOffset += n
 	}
 	// Decode static field Age: uint256
-	t.Age, _, err = abi.DecodeUint256(data[64:])
+	t.Age, _, err = abi.DecodeIntoUint256(t.Age, data[64:])
 	if err != nil {
 		return 0, err
 	}
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UpdateProfileCall from ABI bytes, rejecting unexpected trailing bytes
+func (t *UpdateProfileCall) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes UpdateProfileCall from the hex string with an optional 0x prefix
+func (t *UpdateProfileCall) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UpdateProfileCall: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*UpdateProfileCall)(nil)
+var _ abi.Decoder = (*UpdateProfileCall)(nil)
+
+// RandomUpdateProfileCall returns a UpdateProfileCall filled with random values, for property based tests
+func RandomUpdateProfileCall(r *rand.Rand, maxDepth, maxLen int) UpdateProfileCall {
+	var t UpdateProfileCall
+	r.Read(t.User[:])
+	t.Name = abi.RandomString(r, maxLen)
+	t.Age = abi.RandomBigInt(r, 256, false)
+	return t
+}
+
 // GetMethodName returns the function name
 func (t UpdateProfileCall) GetMethodName() string {
 	return "updateProfile"
@@ -3572,9 +5772,14 @@ func (t UpdateProfileCall) GetMethodSelector() [4]byte {
 	return UpdateProfileSelector
 }
 
+// EncodedSizeWithSelector returns the encoded size of updateProfile arguments including function selector
+func (t UpdateProfileCall) EncodedSizeWithSelector() int {
+	return 4 + t.EncodedSize()
+}
+
 // EncodeWithSelector encodes updateProfile arguments to ABI bytes including function selector
 func (t UpdateProfileCall) EncodeWithSelector() ([]byte, error) {
-	result := make([]byte, 4+t.EncodedSize())
+	result := make([]byte, t.EncodedSizeWithSelector())
 	copy(result[:4], UpdateProfileSelector[:])
 	if _, err := t.EncodeTo(result[4:]); err != nil {
 		return nil, err
@@ -3582,6 +5787,59 @@ func (t UpdateProfileCall) EncodeWithSelector() ([]byte, error) {
 	return result, nil
 }
 
+// EncodeHex encodes updateProfile arguments to 0x prefixed hex string
+func (t UpdateProfileCall) EncodeHex() (string, error) {
+	data, err := t.Encode()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// EncodeHexWithSelector encodes updateProfile arguments to 0x prefixed hex calldata including function selector,
+// ready for the data field of eth_call and eth_sendTransaction
+func (t UpdateProfileCall) EncodeHexWithSelector() (string, error) {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return "", err
+	}
+	return "0x" + hex.EncodeToString(data), nil
+}
+
+// CalldataCost returns the gas cost of the updateProfile calldata, returns 0 if encoding fails
+func (t UpdateProfileCall) CalldataCost(zeroByteGas, nonZeroByteGas uint64) uint64 {
+	data, err := t.EncodeWithSelector()
+	if err != nil {
+		return 0
+	}
+	return abi.CalldataCost(data, zeroByteGas, nonZeroByteGas)
+}
+
+// DecodeWithSelector decodes updateProfile arguments from ABI bytes including function selector
+func (t *UpdateProfileCall) DecodeWithSelector(data []byte) (int, error) {
+	if len(data) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if [4]byte(data[:4]) != UpdateProfileSelector {
+		return 0, abi.ErrSelectorMismatch
+	}
+	n, err := t.Decode(data[4:])
+	if err != nil {
+		return 0, err
+	}
+	return 4 + n, nil
+}
+
+// DecodeHexWithSelector decodes updateProfile arguments from the hex calldata with an optional 0x prefix,
+// including function selector
+func (t *UpdateProfileCall) DecodeHexWithSelector(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UpdateProfileCall: %w", err)
+	}
+	return t.DecodeWithSelector(data)
+}
+
 // NewUpdateProfileCall constructs a new UpdateProfileCall
 func NewUpdateProfileCall(
 	user common.Address,
@@ -3597,9 +5855,6 @@ func NewUpdateProfileCall(
 
 const UpdateProfileReturnStaticSize = 32
 
-var _ abi.Tuple = (*UpdateProfileReturn)(nil)
-var _ abi.PackedTuple = (*UpdateProfileReturn)(nil)
-
 // UpdateProfileReturn represents an ABI tuple
 type UpdateProfileReturn struct {
 	Field1 bool
@@ -3650,6 +5905,25 @@ func (t *UpdateProfileReturn) Decode(data []byte) (int, error) {
 	return dynamicOffset, nil
 }
 
+// DecodeStrict decodes UpdateProfileReturn from ABI bytes, rejecting unexpected trailing bytes
+func (t *UpdateProfileReturn) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	// tolerate zero padding after return data
+	return abi.CheckTrailingBytes(data[n:], abi.MaxReturnPadding)
+}
+
+// DecodeHex decodes UpdateProfileReturn from the hex string with an optional 0x prefix
+func (t *UpdateProfileReturn) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode UpdateProfileReturn: %w", err)
+	}
+	return t.Decode(data)
+}
+
 // PackedEncodedSize returns the packed encoded size of UpdateProfileReturn
 func (t UpdateProfileReturn) PackedEncodedSize() int {
 	return 1
@@ -3696,14 +5970,133 @@ func (t *UpdateProfileReturn) PackedDecode(data []byte) (int, error) {
 	return 1, nil
 }
 
+var _ abi.Tuple = (*UpdateProfileReturn)(nil)
+var _ abi.Decoder = (*UpdateProfileReturn)(nil)
+var _ abi.PackedTuple = (*UpdateProfileReturn)(nil)
+
+// RandomUpdateProfileReturn returns a UpdateProfileReturn filled with random values, for property based tests
+func RandomUpdateProfileReturn(r *rand.Rand, maxDepth, maxLen int) UpdateProfileReturn {
+	var t UpdateProfileReturn
+	t.Field1 = r.Intn(2) == 1
+	return t
+}
+
+// DecodeUpdateProfileReturn decodes the return data of updateProfile into its values
+func DecodeUpdateProfileReturn(data []byte) (r1 bool, err error) {
+	var result UpdateProfileReturn
+	if _, err = result.Decode(data); err != nil {
+		return
+	}
+	return result.Field1, nil
+}
+
+// DecodeUpdateProfile decodes the single return value of updateProfile
+func DecodeUpdateProfile(data []byte) (bool, error) {
+	return DecodeUpdateProfileReturn(data)
+}
+
+// DecodeUpdateProfileHex decodes the single return value of updateProfile from the hex string with an optional 0x prefix,
+// e.g. the result of eth_call
+func DecodeUpdateProfileHex(s string) (bool, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		var zero bool
+		return zero, fmt.Errorf("decode UpdateProfileReturn: %w", err)
+	}
+	return DecodeUpdateProfileReturn(data)
+}
+
+// EncodeUpdateProfileResult encodes the single return value of updateProfile, e.g. for the return data of precompiles
+func EncodeUpdateProfileResult(v bool) ([]byte, error) {
+	result := UpdateProfileReturn{Field1: v}
+	return result.Encode()
+}
+
+// TestDecodeBySelector decodes the calldata into the call struct of the function matching the selector,
+// returns abi.ErrUnknownSelector if no function matches.
+func TestDecodeBySelector(data []byte) (abi.Method, error) {
+	if len(data) < 4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	var call abi.Method
+	switch [4]byte(data[:4]) {
+	case BalanceOfSelector:
+		call = new(BalanceOfCall)
+	case BatchProcessSelector:
+		call = new(BatchProcessCall)
+	case CommunityPoolSelector:
+		call = new(CommunityPoolCall)
+	case EmptyArgsSelector:
+		call = new(EmptyArgsCall)
+	case GetBalancesSelector:
+		call = new(GetBalancesCall)
+	case MultiTransferSelector:
+		call = new(MultiTransferCall)
+	case ProcessUserDataSelector:
+		call = new(ProcessUserDataCall)
+	case SetDataSelector:
+		call = new(SetDataCall)
+	case SetMessageSelector:
+		call = new(SetMessageCall)
+	case SmallIntegersSelector:
+		call = new(SmallIntegersCall)
+	case TotalSupplySelector:
+		call = new(TotalSupplyCall)
+	case TransferSelector:
+		call = new(TransferCall)
+	case TransferBatchSelector:
+		call = new(TransferBatchCall)
+	case UnderstoreSelector:
+		call = new(UnderstoreCall)
+	case UpdateProfileSelector:
+		call = new(UpdateProfileCall)
+	default:
+		return nil, abi.ErrUnknownSelector
+	}
+	if _, err := call.DecodeWithSelector(data); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
 // Event signatures
 var (
 	// DynamicIndexed(string)
 	DynamicIndexedEventTopic = common.Hash{0x3f, 0x9f, 0x17, 0xba, 0xc9, 0x56, 0x4d, 0x19, 0xb3, 0x0d, 0x61, 0xf0, 0xe5, 0x07, 0x81, 0x21, 0xfc, 0x40, 0xc7, 0x25, 0x4a, 0xa1, 0xba, 0xb6, 0x7e, 0xee, 0x77, 0x38, 0x8c, 0x00, 0x92, 0xbd}
 	// EmptyIndexed(string)
 	EmptyIndexedEventTopic = common.Hash{0xe5, 0x2f, 0xef, 0xc3, 0xd9, 0xf6, 0x59, 0xfe, 0x1f, 0x72, 0x8a, 0x74, 0xef, 0x9d, 0x2e, 0x7e, 0x23, 0xfe, 0x1f, 0x4c, 0xfc, 0x2b, 0x16, 0x7e, 0x1d, 0x71, 0xaf, 0xa9, 0xf7, 0x0b, 0x29, 0x13}
+	// HashedIndexed(bytes,uint256[10],address)
+	HashedIndexedEventTopic = common.Hash{0xad, 0x89, 0x22, 0x50, 0xfa, 0xcf, 0x2e, 0x0f, 0xe0, 0x7d, 0x9f, 0x46, 0x1e, 0xba, 0x94, 0x8b, 0xda, 0x0a, 0x13, 0xff, 0xaf, 0x1e, 0x54, 0xca, 0x8b, 0x47, 0x84, 0x70, 0xd1, 0xfc, 0x55, 0xf5}
+)
+
+// Event IDs, the same hashes as the topics, named like go-ethereum's abi.Event.ID
+var (
+	DynamicIndexedEventID = DynamicIndexedEventTopic
+	EmptyIndexedEventID   = EmptyIndexedEventTopic
+	HashedIndexedEventID  = HashedIndexedEventTopic
+)
+
+// Canonical event signatures
+const (
+	DynamicIndexedEventSignature = "DynamicIndexed(string)"
+	EmptyIndexedEventSignature   = "EmptyIndexed(string)"
+	HashedIndexedEventSignature  = "HashedIndexed(bytes,uint256[10],address)"
 )
 
+// TestEvents maps event topics to event names
+var TestEvents = map[common.Hash]string{
+	DynamicIndexedEventTopic: "DynamicIndexed",
+	EmptyIndexedEventTopic:   "EmptyIndexed",
+	HashedIndexedEventTopic:  "HashedIndexed",
+}
+
+// TestEventTopics maps event topics to the canonical event signatures
+var TestEventTopics = map[common.Hash]string{
+	DynamicIndexedEventTopic: DynamicIndexedEventSignature,
+	EmptyIndexedEventTopic:   EmptyIndexedEventSignature,
+	HashedIndexedEventTopic:  HashedIndexedEventSignature,
+}
+
 // DynamicIndexedEvent represents the DynamicIndexed event
 var _ abi.Event = (*DynamicIndexedEvent)(nil)
 
@@ -3718,7 +6111,7 @@ func NewDynamicIndexedEvent(
 ) *DynamicIndexedEvent {
 	return &DynamicIndexedEvent{
 		DynamicIndexedEventIndexed: DynamicIndexedEventIndexed{
-			Denom: denom,
+			DenomPreimage: &denom,
 		},
 		DynamicIndexedEventData: DynamicIndexedEventData{},
 	}
@@ -3735,8 +6128,12 @@ func (e DynamicIndexedEvent) GetEventID() common.Hash {
 }
 
 // DynamicIndexed represents an ABI event
+//
+// Indexed dynamic and non-word fields only appear as keccak hashes in the topics,
+// the original values are unrecoverable, set the XxxPreimage fields to hash them in EncodeTopics.
 type DynamicIndexedEventIndexed struct {
-	Denom string
+	Denom         common.Hash
+	DenomPreimage *string
 }
 
 // EncodeTopics encodes indexed fields of DynamicIndexed event to topics
@@ -3745,25 +6142,25 @@ func (e DynamicIndexedEventIndexed) EncodeTopics() ([]common.Hash, error) {
 	topics = append(topics, DynamicIndexedEventTopic)
 	{
 		// Denom
-		encodedSize := abi.SizeString(e.Denom)
-		buf := make([]byte, encodedSize)
-		if _, err := abi.EncodeString(e.Denom, buf); err != nil {
-			return nil, err
+		hash := e.Denom
+		if e.DenomPreimage != nil {
+			hash = abi.Keccak256Hash([]byte(*e.DenomPreimage))
 		}
-		hash := crypto.Keccak256Hash(buf)
 		topics = append(topics, hash)
 	}
 	return topics, nil
 }
 
-// DecodeTopics decodes indexed fields of DynamicIndexed event from topics, ignore hash topics
+// DecodeTopics decodes indexed fields of DynamicIndexed event from topics, hash topics are stored as is
 func (e *DynamicIndexedEventIndexed) DecodeTopics(topics []common.Hash) error {
 	if len(topics) != 2 {
-		return abi.ErrInvalidNumberOfTopics
+		return abi.TopicCountMismatch(2, len(topics))
 	}
 	if topics[0] != DynamicIndexedEventTopic {
-		return abi.ErrInvalidEventTopic
+		return abi.ErrEventSignatureMismatch
 	}
+	e.Denom = topics[1]
+	e.DenomPreimage = nil
 	return nil
 }
 
@@ -3801,13 +6198,29 @@ func (e EmptyIndexedEvent) GetEventID() common.Hash {
 	return EmptyIndexedEventTopic
 }
 
+// EmptyIndexed represents an ABI event
 type EmptyIndexedEventIndexed struct {
-	abi.EmptyIndexed
 }
 
-const EmptyIndexedEventDataStaticSize = 32
+// EncodeTopics encodes indexed fields of EmptyIndexed event to topics
+func (e EmptyIndexedEventIndexed) EncodeTopics() ([]common.Hash, error) {
+	topics := make([]common.Hash, 0, 1)
+	topics = append(topics, EmptyIndexedEventTopic)
+	return topics, nil
+}
 
-var _ abi.Tuple = (*EmptyIndexedEventData)(nil)
+// DecodeTopics decodes indexed fields of EmptyIndexed event from topics, hash topics are stored as is
+func (e *EmptyIndexedEventIndexed) DecodeTopics(topics []common.Hash) error {
+	if len(topics) != 1 {
+		return abi.TopicCountMismatch(1, len(topics))
+	}
+	if topics[0] != EmptyIndexedEventTopic {
+		return abi.ErrEventSignatureMismatch
+	}
+	return nil
+}
+
+const EmptyIndexedEventDataStaticSize = 32
 
 // EmptyIndexedEventData represents an ABI tuple
 type EmptyIndexedEventData struct {
@@ -3832,6 +6245,7 @@ func (value EmptyIndexedEventData) EncodeTo(buf []byte) (int, error) {
 	)
 	// Field Denom: string
 	// Encode offset pointer
+	abi.ClearWord(buf[0:])
 	binary.BigEndian.PutUint64(buf[0+24:0+32], uint64(dynamicOffset))
 	// Encode dynamic data
 	n, err = abi.EncodeString(value.Denom, buf[dynamicOffset:])
@@ -3880,3 +6294,155 @@ func (t *EmptyIndexedEventData) Decode(data []byte) (int, error) {
 	}
 	return dynamicOffset, nil
 }
+
+// DecodeStrict decodes EmptyIndexedEventData from ABI bytes, rejecting unexpected trailing bytes
+func (t *EmptyIndexedEventData) DecodeStrict(data []byte) error {
+	n, err := t.Decode(data)
+	if err != nil {
+		return err
+	}
+	return abi.CheckTrailingBytes(data[n:], 0)
+}
+
+// DecodeHex decodes EmptyIndexedEventData from the hex string with an optional 0x prefix
+func (t *EmptyIndexedEventData) DecodeHex(s string) (int, error) {
+	data, err := abi.FromHex(s)
+	if err != nil {
+		return 0, fmt.Errorf("decode EmptyIndexedEventData: %w", err)
+	}
+	return t.Decode(data)
+}
+
+var _ abi.Tuple = (*EmptyIndexedEventData)(nil)
+var _ abi.Decoder = (*EmptyIndexedEventData)(nil)
+
+// RandomEmptyIndexedEventData returns a EmptyIndexedEventData filled with random values, for property based tests
+func RandomEmptyIndexedEventData(r *rand.Rand, maxDepth, maxLen int) EmptyIndexedEventData {
+	var t EmptyIndexedEventData
+	t.Denom = abi.RandomString(r, maxLen)
+	return t
+}
+
+// HashedIndexedEvent represents the HashedIndexed event
+var _ abi.Event = (*HashedIndexedEvent)(nil)
+
+type HashedIndexedEvent struct {
+	HashedIndexedEventIndexed
+	HashedIndexedEventData
+}
+
+// NewHashedIndexedEvent constructs a new HashedIndexed event
+func NewHashedIndexedEvent(
+	data []byte,
+	balances [10]*big.Int,
+	owner common.Address,
+) *HashedIndexedEvent {
+	return &HashedIndexedEvent{
+		HashedIndexedEventIndexed: HashedIndexedEventIndexed{
+			DataPreimage:     &data,
+			BalancesPreimage: &balances,
+			Owner:            owner,
+		},
+		HashedIndexedEventData: HashedIndexedEventData{},
+	}
+}
+
+// GetEventName returns the event name
+func (e HashedIndexedEvent) GetEventName() string {
+	return "HashedIndexed"
+}
+
+// GetEventID returns the event ID (topic)
+func (e HashedIndexedEvent) GetEventID() common.Hash {
+	return HashedIndexedEventTopic
+}
+
+// HashedIndexed represents an ABI event
+//
+// Indexed dynamic and non-word fields only appear as keccak hashes in the topics,
+// the original values are unrecoverable, set the XxxPreimage fields to hash them in EncodeTopics.
+type HashedIndexedEventIndexed struct {
+	Data             common.Hash
+	DataPreimage     *[]byte
+	Balances         common.Hash
+	BalancesPreimage *[10]*big.Int
+	Owner            common.Address
+}
+
+// EncodeTopics encodes indexed fields of HashedIndexed event to topics
+func (e HashedIndexedEventIndexed) EncodeTopics() ([]common.Hash, error) {
+	topics := make([]common.Hash, 0, 4)
+	topics = append(topics, HashedIndexedEventTopic)
+	{
+		// Data
+		hash := e.Data
+		if e.DataPreimage != nil {
+			hash = abi.Keccak256Hash(*e.DataPreimage)
+		}
+		topics = append(topics, hash)
+	}
+	{
+		// Balances
+		hash := e.Balances
+		if e.BalancesPreimage != nil {
+			buf := make([]byte, 320)
+			if _, err := TestEncodeUint256Array10((*e.BalancesPreimage), buf); err != nil {
+				return nil, err
+			}
+			hash = abi.Keccak256Hash(buf)
+		}
+		topics = append(topics, hash)
+	}
+	{
+		// Owner
+		var hash common.Hash
+		if _, err := abi.EncodeAddress(e.Owner, hash[:]); err != nil {
+			return nil, err
+		}
+		topics = append(topics, hash)
+	}
+	return topics, nil
+}
+
+// DecodeTopics decodes indexed fields of HashedIndexed event from topics, hash topics are stored as is
+func (e *HashedIndexedEventIndexed) DecodeTopics(topics []common.Hash) error {
+	if len(topics) != 4 {
+		return abi.TopicCountMismatch(4, len(topics))
+	}
+	if topics[0] != HashedIndexedEventTopic {
+		return abi.ErrEventSignatureMismatch
+	}
+	var err error
+	e.Data = topics[1]
+	e.DataPreimage = nil
+	e.Balances = topics[2]
+	e.BalancesPreimage = nil
+	e.Owner, _, err = abi.DecodeAddress(topics[3][:])
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type HashedIndexedEventData struct {
+	abi.EmptyTuple
+}
+
+// TestSelectors maps the function and error selectors to the canonical signatures
+var TestSelectors = map[[4]byte]string{
+	BalanceOfSelector:       BalanceOfSignature,
+	BatchProcessSelector:    BatchProcessSignature,
+	CommunityPoolSelector:   CommunityPoolSignature,
+	EmptyArgsSelector:       EmptyArgsSignature,
+	GetBalancesSelector:     GetBalancesSignature,
+	MultiTransferSelector:   MultiTransferSignature,
+	ProcessUserDataSelector: ProcessUserDataSignature,
+	SetDataSelector:         SetDataSignature,
+	SetMessageSelector:      SetMessageSignature,
+	SmallIntegersSelector:   SmallIntegersSignature,
+	TotalSupplySelector:     TotalSupplySignature,
+	TransferSelector:        TransferSignature,
+	TransferBatchSelector:   TransferBatchSignature,
+	UnderstoreSelector:      UnderstoreSignature,
+	UpdateProfileSelector:   UpdateProfileSignature,
+}
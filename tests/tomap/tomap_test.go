@@ -0,0 +1,85 @@
+package tomap
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+)
+
+//go:generate go run ../../cmd -var ToMapTestABI -output tomap.abi.go -package tomap -tomap
+
+var ToMapTestABI = []string{
+	"struct Point { uint256 x; address owner }",
+	"struct Shape { string name; Point[] points; bytes32[2] hashes; bytes data }",
+	"function draw(Shape shape, address[] viewers, uint64 id, bool visible) returns (Point, uint256)",
+	"function clear()",
+	"event Drawn(address indexed sender, string indexed name, Shape shape)",
+	"event Cleared(address indexed sender)",
+}
+
+var (
+	owner  = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	viewer = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func testShape() Shape {
+	return Shape{
+		Name:   "square",
+		Points: []Point{{X: big.NewInt(1), Owner: owner}},
+		Hashes: [2][32]byte{{1}, {2}},
+		Data:   []byte{0xde, 0xad},
+	}
+}
+
+func expectedShape() map[string]interface{} {
+	return map[string]interface{}{
+		"name": "square",
+		"points": []interface{}{
+			map[string]interface{}{"x": big.NewInt(1), "owner": owner.Hex()},
+		},
+		"hashes": []interface{}{
+			"0x0100000000000000000000000000000000000000000000000000000000000000",
+			"0x0200000000000000000000000000000000000000000000000000000000000000",
+		},
+		"data": "0xdead",
+	}
+}
+
+func TestToMap(t *testing.T) {
+	call := NewDrawCall(testShape(), []common.Address{viewer}, 7, true)
+	require.Equal(t, map[string]interface{}{
+		"shape":   expectedShape(),
+		"viewers": []interface{}{viewer.Hex()},
+		"id":      uint64(7),
+		"visible": true,
+	}, call.ToMap())
+
+	ret := DrawReturn{Field1: Point{X: big.NewInt(2), Owner: owner}, Field2: big.NewInt(3)}
+	require.Equal(t, map[string]interface{}{
+		"field1": map[string]interface{}{"x": big.NewInt(2), "owner": owner.Hex()},
+		"field2": big.NewInt(3),
+	}, ret.ToMap())
+
+	require.Equal(t, map[string]interface{}{}, ClearCall{}.ToMap())
+}
+
+func TestToMapEvent(t *testing.T) {
+	event := NewDrawnEvent(owner, "square", testShape())
+	require.Equal(t, map[string]interface{}{
+		"sender": owner.Hex(),
+		"name":   common.Hash{}.Hex(),
+		"shape":  expectedShape(),
+	}, event.ToMap())
+
+	// the decoded event only has the hash of the indexed string
+	topics, err := event.EncodeTopics()
+	require.NoError(t, err)
+	var decoded DrawnEvent
+	require.NoError(t, decoded.DecodeTopics(topics))
+	require.Equal(t, crypto.Keccak256Hash([]byte("square")).Hex(), decoded.ToMap()["name"])
+
+	require.Equal(t, map[string]interface{}{"sender": owner.Hex()}, NewClearedEvent(owner).ToMap())
+}
@@ -0,0 +1,183 @@
+package topics
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+	"github.com/yihuang/go-abi/tests/topics/lenient"
+)
+
+// the topics must match the indexed fields exactly, or tolerate the extra trailing ones with -lenient-topics
+//go:generate go run ../../cmd -var TopicsTestABI -output topics.abi.go -package topics
+//go:generate go run ../../cmd -var TopicsTestABI -output lenient/topics.abi.go -package lenient -lenient-topics
+
+var TopicsTestABI = []string{
+	"event Transfer(address indexed from, address indexed to, uint256 value)",
+	"event Sync(uint256 reserve)",
+	"event Raw(address indexed sender, bytes32 indexed key, uint256 value) anonymous",
+	"struct Fill { uint256 id; uint256 amount }",
+	"struct Ticket { uint256 id }",
+	"function settle(Fill fill, Ticket ticket)",
+	"event Settled(Fill indexed fill, Ticket indexed ticket, uint256 price)",
+	"struct Order { address maker; string memo; uint256[] amounts; }",
+	"event Order(Order indexed o, uint256 amount)",
+}
+
+var (
+	from  = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to    = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	extra = common.HexToHash("0xdeadbeef")
+)
+
+func TestTopicsCount(t *testing.T) {
+	topics, err := NewTransferEvent(from, to, big.NewInt(1)).EncodeTopics()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(topics))
+
+	// exact
+	var exact TransferEventIndexed
+	require.NoError(t, exact.DecodeTopics(topics))
+	require.Equal(t, from, exact.From)
+	require.Equal(t, to, exact.To)
+
+	// short
+	var short TransferEventIndexed
+	err = short.DecodeTopics(topics[:2])
+	require.True(t, errors.Is(err, abi.ErrTopicCountMismatch))
+	require.True(t, errors.Is(err, abi.ErrInvalidNumberOfTopics))
+	require.Equal(t, "topic count mismatch: invalid number of topics, expected 3, got 2", err.Error())
+
+	// long
+	var long TransferEventIndexed
+	err = long.DecodeTopics(append(topics, extra))
+	require.True(t, errors.Is(err, abi.ErrTopicCountMismatch))
+	require.Equal(t, "topic count mismatch: invalid number of topics, expected 3, got 4", err.Error())
+
+	// wrong topic0
+	wrong := append([]common.Hash{SyncEventTopic}, topics[1:]...)
+	var mismatch TransferEventIndexed
+	err = mismatch.DecodeTopics(wrong)
+	require.Equal(t, abi.ErrEventSignatureMismatch, err)
+	require.True(t, errors.Is(err, abi.ErrInvalidEventTopic))
+}
+
+func TestTopicsNoIndexed(t *testing.T) {
+	topics, err := NewSyncEvent(big.NewInt(1)).EncodeTopics()
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{SyncEventTopic}, topics)
+
+	var indexed SyncEventIndexed
+	require.NoError(t, indexed.DecodeTopics(topics))
+	require.True(t, errors.Is(indexed.DecodeTopics(nil), abi.ErrTopicCountMismatch))
+	require.True(t, errors.Is(indexed.DecodeTopics(append(topics, extra)), abi.ErrTopicCountMismatch))
+	require.Equal(t, abi.ErrEventSignatureMismatch, indexed.DecodeTopics([]common.Hash{TransferEventTopic}))
+}
+
+func TestTopicsAnonymous(t *testing.T) {
+	event := NewRawEvent(from, extra, big.NewInt(1))
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+	// no signature topic
+	require.Equal(t, []common.Hash{common.BytesToHash(from[:]), extra}, topics)
+
+	var decoded RawEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, *event, decoded)
+
+	err = decoded.DecodeTopics(append([]common.Hash{RawEventTopic}, topics...))
+	require.True(t, errors.Is(err, abi.ErrTopicCountMismatch))
+	require.Equal(t, "topic count mismatch: invalid number of topics, expected 2, got 3", err.Error())
+}
+
+func TestTopicsLenient(t *testing.T) {
+	topics, err := lenient.NewTransferEvent(from, to, big.NewInt(1)).EncodeTopics()
+	require.NoError(t, err)
+
+	// the extra trailing topics are ignored
+	var long lenient.TransferEventIndexed
+	require.NoError(t, long.DecodeTopics(append(topics, extra)))
+	require.Equal(t, from, long.From)
+	require.Equal(t, to, long.To)
+
+	var short lenient.TransferEventIndexed
+	err = short.DecodeTopics(topics[:2])
+	require.True(t, errors.Is(err, abi.ErrTopicCountMismatch))
+
+	wrong := append([]common.Hash{lenient.SyncEventTopic}, topics[1:]...)
+	require.Equal(t, abi.ErrEventSignatureMismatch, long.DecodeTopics(wrong))
+}
+
+func TestTopicsIndexedTuple(t *testing.T) {
+	fill := Fill{Id: big.NewInt(1), Amount: big.NewInt(2)}
+	ticket := Ticket{Id: big.NewInt(3)}
+	event := NewSettledEvent(fill, ticket, big.NewInt(4))
+
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+
+	// the tuples are hashed, even the one fitting in a word
+	fillData, err := fill.Encode()
+	require.NoError(t, err)
+	ticketData, err := ticket.Encode()
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{SettledEventTopic, crypto.Keccak256Hash(fillData), crypto.Keccak256Hash(ticketData)}, topics)
+	require.Equal(t, 32, len(data))
+
+	var decoded SettledEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, topics[1], decoded.Fill)
+	require.Equal(t, topics[2], decoded.Ticket)
+	require.Equal(t, big.NewInt(4), decoded.Price)
+}
+
+func TestTopicsIndexedDynamicTuple(t *testing.T) {
+	order := Order{
+		Maker:   common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Memo:    "a memo longer than thirty-two bytes",
+		Amounts: []*big.Int{big.NewInt(1), big.NewInt(2)},
+	}
+	event := NewOrderEvent(order, big.NewInt(3))
+
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+
+	abiJSON, err := abi.ParseHumanReadableABI(TopicsTestABI)
+	require.NoError(t, err)
+	parsed, err := ethabi.JSON(bytes.NewReader(abiJSON))
+	require.NoError(t, err)
+	ethEvent := parsed.Events["Order"]
+
+	// the tuple is a single topic, the data only holds the amount
+	require.Len(t, topics, 2)
+	require.Equal(t, ethEvent.ID, topics[0])
+	expectedData, err := ethEvent.Inputs.NonIndexed().Pack(big.NewInt(3))
+	require.NoError(t, err)
+	require.Equal(t, expectedData, data)
+
+	// the topic hashes the in-place encoding, without offsets and lengths, the string padded to 32 bytes
+	var preimage []byte
+	preimage = append(preimage, common.LeftPadBytes(order.Maker.Bytes(), 32)...)
+	preimage = append(preimage, common.RightPadBytes([]byte(order.Memo), 64)...)
+	for _, amount := range order.Amounts {
+		preimage = append(preimage, math.U256Bytes(new(big.Int).Set(amount))...)
+	}
+	require.Equal(t, crypto.Keccak256Hash(preimage), topics[1])
+
+	var decoded OrderEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, topics[1], decoded.O)
+	require.Equal(t, big.NewInt(3), decoded.Amount)
+
+	values, err := ethEvent.Inputs.Unpack(data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(3)}, values)
+}
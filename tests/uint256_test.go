@@ -5,6 +5,8 @@ package tests
 import (
 	"testing"
 
+	"github.com/test-go/testify/require"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 )
@@ -36,6 +38,26 @@ func TestUint256Transfer(t *testing.T) {
 	}
 }
 
+func TestUint256TransferDecodeReuse(t *testing.T) {
+	args := &TransferCall{
+		To:     common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Amount: uint256.NewInt(1000000000000000000),
+	}
+	encoded, err := args.Encode()
+	require.NoError(t, err)
+
+	decoded := TransferCall{Amount: new(uint256.Int)}
+	amount := decoded.Amount
+	allocs := testing.AllocsPerRun(10, func() {
+		if _, err := decoded.Decode(encoded); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.True(t, amount == decoded.Amount)
+	require.Equal(t, *args, decoded)
+}
+
 func TestUint256BalanceOfReturn(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -0,0 +1,82 @@
+package uniform
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+	"github.com/yihuang/go-abi/tests/uniform/bigint"
+	"github.com/yihuang/go-abi/tests/uniform/native"
+)
+
+// the same ABI generated with the native integer mapping and with *big.Int for all integers
+//go:generate go run ../../cmd -var UniformTestABI -output native/uniform.abi.go -package native
+//go:generate go run ../../cmd -var UniformTestABI -output bigint/uniform.abi.go -package bigint -uniform-bigint
+
+var UniformTestABI = []string{
+	"struct Point { int32 x; int32 y }",
+	"function move(uint8 step, int64 delta, uint256 total, uint16[] ids, int8[2] signs, Point point) returns (uint32)",
+	"function pack(uint8 a, int24 b, int128 c, int64 d)",
+	"event Moved(uint8 indexed step, int64 delta)",
+}
+
+func TestUniformBigInt(t *testing.T) {
+	nativeCall := native.NewMoveCall(
+		200, -5, big.NewInt(1000), []uint16{1, 65535}, [2]int8{-128, 127}, native.Point{X: -1, Y: 2},
+	)
+	bigCall := bigint.NewMoveCall(
+		big.NewInt(200), big.NewInt(-5), big.NewInt(1000),
+		[]*big.Int{big.NewInt(1), big.NewInt(65535)},
+		[2]*big.Int{big.NewInt(-128), big.NewInt(127)},
+		bigint.Point{X: big.NewInt(-1), Y: big.NewInt(2)},
+	)
+
+	nativeEncoded, err := nativeCall.EncodeWithSelector()
+	require.NoError(t, err)
+	bigEncoded, err := bigCall.EncodeWithSelector()
+	require.NoError(t, err)
+	require.Equal(t, nativeEncoded, bigEncoded)
+
+	var decoded bigint.MoveCall
+	_, err = decoded.DecodeWithSelector(nativeEncoded)
+	require.NoError(t, err)
+	require.Equal(t, bigCall, &decoded)
+
+	nativeRet, err := native.MoveReturn{Field1: 42}.Encode()
+	require.NoError(t, err)
+	bigRet, err := bigint.MoveReturn{Field1: big.NewInt(42)}.Encode()
+	require.NoError(t, err)
+	require.Equal(t, nativeRet, bigRet)
+
+	nativeTopics, nativeData, err := abi.EncodeEvent(native.NewMovedEvent(7, -9))
+	require.NoError(t, err)
+	bigTopics, bigData, err := abi.EncodeEvent(bigint.NewMovedEvent(big.NewInt(7), big.NewInt(-9)))
+	require.NoError(t, err)
+	require.Equal(t, nativeTopics, bigTopics)
+	require.Equal(t, nativeData, bigData)
+}
+
+func TestUniformBigIntPacked(t *testing.T) {
+	c := new(big.Int).Lsh(big.NewInt(-1), 100)
+	nativeCall := native.NewPackCall(255, -3, c, -4)
+	bigCall := bigint.NewPackCall(big.NewInt(255), big.NewInt(-3), c, big.NewInt(-4))
+
+	nativeEncoded, err := nativeCall.PackedEncode()
+	require.NoError(t, err)
+	bigEncoded, err := bigCall.PackedEncode()
+	require.NoError(t, err)
+	require.Equal(t, nativeEncoded, bigEncoded)
+	require.Len(t, bigEncoded, 1+3+16+8)
+
+	var decoded bigint.PackCall
+	_, err = decoded.PackedDecode(bigEncoded)
+	require.NoError(t, err)
+	require.Equal(t, bigCall, &decoded)
+
+	var nativeDecoded native.PackCall
+	_, err = nativeDecoded.PackedDecode(bigEncoded)
+	require.NoError(t, err)
+	require.Equal(t, nativeCall, &nativeDecoded)
+}
@@ -0,0 +1,74 @@
+package views
+
+import (
+	"math/big"
+	"testing"
+	"unsafe"
+
+	"github.com/test-go/testify/require"
+
+	"github.com/yihuang/go-abi"
+)
+
+//go:generate go run ../../cmd -var ViewsTestABI -output views.abi.go -package views -string-views
+
+var ViewsTestABI = []string{
+	"struct Entry { string key; string[] values; uint256 id }",
+	"function put(string name, Entry entry, string[2] pair) returns (string)",
+	"event Logged(string indexed topic, string message)",
+}
+
+// within returns if the data of s is in buf
+func within(s string, buf []byte) bool {
+	p, start := uintptr(unsafe.Pointer(unsafe.StringData(s))), uintptr(unsafe.Pointer(unsafe.SliceData(buf)))
+	return p >= start && p < start+uintptr(len(buf))
+}
+
+func TestStringViews(t *testing.T) {
+	call := PutCall{
+		Name:  "alice",
+		Entry: Entry{Key: "color", Values: []string{"red", "green"}, Id: big.NewInt(1)},
+		Pair:  [2]string{"left", "right"},
+	}
+	encoded, err := call.Encode()
+	require.NoError(t, err)
+
+	var decoded PutCall
+	_, err = decoded.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, call, decoded)
+
+	for _, s := range []string{decoded.Name, decoded.Entry.Key, decoded.Entry.Values[0], decoded.Entry.Values[1], decoded.Pair[0], decoded.Pair[1]} {
+		require.True(t, within(s, encoded), "%q is not a view of the input", s)
+	}
+
+	// the views change with the input buffer
+	copy(encoded, make([]byte, len(encoded)))
+	require.Equal(t, "\x00\x00\x00\x00\x00", decoded.Name)
+}
+
+func TestStringViewsAllocs(t *testing.T) {
+	ret := PutReturn{Field1: "a long enough string to be allocated on the heap when copied"}
+	encoded, err := ret.Encode()
+	require.NoError(t, err)
+
+	var decoded PutReturn
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := decoded.Decode(encoded); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+	require.Equal(t, ret, decoded)
+}
+
+func TestEventStringViews(t *testing.T) {
+	event := NewLoggedEvent("topic", "message")
+	topics, data, err := abi.EncodeEvent(event)
+	require.NoError(t, err)
+
+	var decoded LoggedEvent
+	require.NoError(t, abi.DecodeEvent(&decoded, topics, data))
+	require.Equal(t, "message", decoded.Message)
+	require.True(t, within(decoded.Message, data))
+}
@@ -1,25 +1,48 @@
 package abi
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// Encode is implemented by all the generated structs and the call, return and event data structs.
 type Encode interface {
 	EncodedSize() int
 	Encode() ([]byte, error)
+	// EncodeTo writes every byte of the encoding, the buffer doesn't need to be zeroed.
 	EncodeTo([]byte) (int, error)
 }
 
+// Decode is implemented by the pointers to all the generated structs.
 type Decode interface {
 	// Decode returns io.UnexpectedEOF if data is too short.
 	Decode([]byte) (int, error)
 }
 
+// Decoder is implemented by all the generated structs, to hold heterogeneous decodable types
+type Decoder = Decode
+
+// Tuple is implemented by the pointers to all the generated structs, it combines the encoding and decoding
+// methods to handle them generically, e.g. in a pipeline re-encoding the decoded values.
 type Tuple interface {
 	Encode
 	Decode
 }
 
+// Codec constrains a type parameter to the pointer of a generated struct, to encode and decode the
+// values of T in generic code, e.g. a new value of T is decoded with:
+//
+//	func decode[T any, PT abi.Codec[T]](data []byte) (T, error) {
+//		var v T
+//		_, err := PT(&v).Decode(data)
+//		return v, err
+//	}
+type Codec[T any] interface {
+	*T
+	Tuple
+}
+
 type PackedEncode interface {
 	PackedEncodedSize() int
 	PackedEncode() ([]byte, error)
@@ -38,7 +61,9 @@ type PackedTuple interface {
 type Method interface {
 	Tuple
 
+	EncodedSizeWithSelector() int
 	EncodeWithSelector() ([]byte, error)
+	DecodeWithSelector([]byte) (int, error)
 
 	GetMethodName() string
 	GetMethodID() uint32
@@ -58,6 +83,32 @@ type Event interface {
 	GetEventID() common.Hash
 }
 
+// ContractCaller is the minimal interface used by the generated clients to call contracts,
+// easy to adapt from ethclient or a mock.
+type ContractCaller interface {
+	CallContract(ctx context.Context, to common.Address, data []byte) ([]byte, error)
+}
+
+// FieldLayout is the byte range of a top-level field of an encoded tuple, relative to the start of the buffer,
+// the head word of a static field or the tail data of a dynamic field.
+type FieldLayout struct {
+	Offset int
+	Length int
+}
+
+// EncodeLayout describes the byte ranges of an encoded tuple, returned by the generated EncodeToDetailed
+// methods, e.g. to patch a static field in place without encoding the whole tuple again.
+type EncodeLayout struct {
+	StaticLen  int           // Length of the static head, including the offsets of the dynamic fields
+	DynamicLen int           // Length of the dynamic tail
+	Fields     []FieldLayout // Byte ranges of the top-level fields in the order of the tuple
+}
+
+// Len returns the total encoded length of the tuple
+func (l EncodeLayout) Len() int {
+	return l.StaticLen + l.DynamicLen
+}
+
 type EmptyTuple struct{}
 
 func (e EmptyTuple) EncodedSize() int {
@@ -76,6 +127,10 @@ func (e *EmptyTuple) Decode(data []byte) (int, error) {
 	return 0, nil
 }
 
+func (e *EmptyTuple) DecodeStrict(data []byte) error {
+	return CheckTrailingBytes(data, 0)
+}
+
 func (e EmptyTuple) PackedEncodedSize() int {
 	return 0
 }
@@ -2,16 +2,14 @@ package abi
 
 import (
 	"bytes"
-	"encoding/hex"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"math"
 	"math/big"
-	"strings"
+	"unsafe"
 
-	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 )
 
@@ -60,10 +58,87 @@ var (
 	MaxUint256 = new(big.Int).Sub(tt256, common.Big1)
 )
 
+// MaxReturnPadding is the number of trailing zero bytes tolerated when strictly
+// decoding function return data, some RPC responses pad it to a word boundary.
+const MaxReturnPadding = 31
+
+// MaxArraySize is the largest fixed array size accepted by the human-readable ABI parser and the
+// generator, larger arrays are almost certainly a mistake and would generate huge static sizes.
+const MaxArraySize = 1 << 16
+
 func Pad32(n int) int {
 	return (n + 31) / 32 * 32
 }
 
+// ClearWord zeroes the first 32 bytes of buf, encoders call it before writing
+// a value that doesn't cover the whole word, so a reused buffer can't leak
+// stale bytes into the padding.
+func ClearWord(buf []byte) {
+	*(*[32]byte)(buf) = [32]byte{}
+}
+
+// ResizeSlice returns s resized to length n, reusing its backing array if the capacity allows,
+// the elements within the old capacity are kept so their own allocations can be reused.
+func ResizeSlice[T any](s []T, n int) []T {
+	if s != nil && cap(s) >= n {
+		return s[:n]
+	}
+	// never returns nil, decoding an empty slice gives an empty slice
+	return make([]T, n)
+}
+
+// FromHex decodes the hex string s with an optional 0x prefix, e.g. the calldata or the return data of
+// a JSON-RPC response, returns ErrOddLengthHex, or ErrInvalidHex with the position of the bad character
+// after the prefix.
+func FromHex(s string) ([]byte, error) {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+	if len(s)%2 != 0 {
+		return nil, ErrOddLengthHex
+	}
+
+	data := make([]byte, len(s)/2)
+	for i := range data {
+		hi, ok1 := fromHexChar(s[2*i])
+		lo, ok2 := fromHexChar(s[2*i+1])
+		if !ok1 || !ok2 {
+			pos := 2 * i
+			if ok1 {
+				pos++
+			}
+			return nil, fmt.Errorf("%w: bad character %q at %d", ErrInvalidHex, s[pos], pos)
+		}
+		data[i] = hi<<4 | lo
+	}
+	return data, nil
+}
+
+// fromHexChar converts a hex character into its value
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// CalldataCost returns the intrinsic gas cost of calldata, charging
+// zeroByteGas for each zero byte and nonZeroByteGas for each non-zero byte.
+func CalldataCost(data []byte, zeroByteGas, nonZeroByteGas uint64) uint64 {
+	var zeros uint64
+	for _, b := range data {
+		if b == 0 {
+			zeros++
+		}
+	}
+	return zeros*zeroByteGas + (uint64(len(data))-zeros)*nonZeroByteGas
+}
+
 // DecodeUint is common utility to decode a small unsigned integer value from 32 bytes
 // the caller must pass correct maxValue for the target type T
 func DecodeUint[T int | uint8 | uint16 | uint32 | uint64](data []byte, maxValue uint64) (T, error) {
@@ -78,6 +153,31 @@ func DecodeUint[T int | uint8 | uint16 | uint32 | uint64](data []byte, maxValue
 	return T(result), nil
 }
 
+// UintFromBig converts v to the native Go type of an ABI uint of the given bits, returns ErrNilInteger
+// if v is nil and ErrIntegerOutOfRange if it's negative or doesn't fit in the bits.
+func UintFromBig[T ~uint8 | ~uint16 | ~uint32 | ~uint64](v *big.Int, bits int) (T, error) {
+	if v == nil {
+		return 0, ErrNilInteger
+	}
+	if v.Sign() < 0 || v.BitLen() > bits {
+		return 0, ErrIntegerOutOfRange
+	}
+	return T(v.Uint64()), nil
+}
+
+// IntFromBig converts v to the native Go type of an ABI int of the given bits, returns ErrNilInteger
+// if v is nil and ErrIntegerOutOfRange if it doesn't fit in the bits.
+func IntFromBig[T ~int8 | ~int16 | ~int32 | ~int64](v *big.Int, bits int) (T, error) {
+	if v == nil {
+		return 0, ErrNilInteger
+	}
+	minValue := int64(-1) << (bits - 1)
+	if !v.IsInt64() || v.Int64() < minValue || v.Int64() > ^minValue {
+		return 0, ErrIntegerOutOfRange
+	}
+	return T(v.Int64()), nil
+}
+
 func DecodeInt[T int8 | int16 | int32 | int64](data []byte, minValue, maxValue int64) (T, error) {
 	var n uint256.Int
 	n.SetBytes32(data)
@@ -104,16 +204,81 @@ func DecodeInt[T int8 | int16 | int32 | int64](data []byte, minValue, maxValue i
 	return T(i64), nil
 }
 
+// MaxDecodeSize is the largest length or offset accepted by DecodeSize, the decoders check the
+// lengths against the remaining data before allocating, the limit rejects the absurd ones early
+// with ErrSizeTooLarge. It must not be negative.
+var MaxDecodeSize = math.MaxInt32
+
+// DecodeSize decodes a length or offset word, only the canonical encoding is accepted,
+// the upper 24 bytes must be zero and the value must fit in an int, otherwise it
+// returns ErrNonCanonicalSize, a value above MaxDecodeSize returns ErrSizeTooLarge.
 func DecodeSize(data []byte) (int, error) {
-	v, err := DecodeUint[int](data, math.MaxInt)
+	_ = data[31] // bounds check hint
+	if binary.BigEndian.Uint64(data[0:8])|binary.BigEndian.Uint64(data[8:16])|binary.BigEndian.Uint64(data[16:24]) != 0 {
+		return 0, ErrNonCanonicalSize
+	}
+
+	v := binary.BigEndian.Uint64(data[24:32])
+	if v > math.MaxInt {
+		return 0, ErrNonCanonicalSize
+	}
+	if v > uint64(MaxDecodeSize) {
+		return 0, ErrSizeTooLarge
+	}
+
+	return int(v), nil
+}
+
+// DecodeStringView decodes string from ABI bytes like DecodeString, but the result references
+// data instead of a copy, so it doesn't allocate.
+//
+// It's unsafe: the string is only valid as long as data is not modified, e.g. a reused read
+// buffer changes the strings decoded from it, copy them with strings.Clone to keep them.
+func DecodeStringView(data []byte) (string, int, error) {
+	if len(data) < 32 {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+	length, err := DecodeSize(data)
 	if err != nil {
-		return 0, err
+		return "", 0, err
+	}
+	data = data[32:]
+	// check the length first, Pad32 overflows near MaxInt
+	if length > len(data) {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+	paddedLength := Pad32(length)
+	if len(data) < paddedLength {
+		return "", 0, io.ErrUnexpectedEOF
 	}
+	for i := length; i < paddedLength; i++ {
+		if data[i] != 0x00 {
+			return "", 0, ErrDirtyPadding
+		}
+	}
+	return unsafe.String(unsafe.SliceData(data), length), 32 + paddedLength, nil
+}
 
-	return v, nil
+// CheckTrailingBytes validates the bytes left over after decoding, it returns
+// ErrTrailingBytes if there are more than maxPadding of them or any of them is non-zero.
+func CheckTrailingBytes(trailing []byte, maxPadding int) error {
+	if len(trailing) > maxPadding {
+		return ErrTrailingBytes
+	}
+	for _, b := range trailing {
+		if b != 0 {
+			return ErrTrailingBytes
+		}
+	}
+	return nil
 }
 
+// EncodeBigInt encodes n to the 32 bytes word buf, the leading zero bytes are not written,
+// a nil n returns ErrNilInteger.
 func EncodeBigInt(n *big.Int, buf []byte, signed bool) error {
+	if n == nil {
+		return ErrNilInteger
+	}
 	if n.Sign() < 0 {
 		if !signed {
 			return ErrNegativeValue
@@ -132,16 +297,25 @@ func EncodeBigInt(n *big.Int, buf []byte, signed bool) error {
 }
 
 func DecodeBigInt(data []byte, signed bool) (*big.Int, error) {
+	return DecodeIntoBigInt(nil, data, signed)
+}
+
+// DecodeIntoBigInt decodes a 32 bytes word into dst, allocates a new big.Int if dst is nil,
+// decoding into a reused dst doesn't allocate.
+func DecodeIntoBigInt(dst *big.Int, data []byte, signed bool) (*big.Int, error) {
 	if len(data) < 32 {
 		return nil, io.ErrUnexpectedEOF
 	}
+	if dst == nil {
+		dst = new(big.Int)
+	}
 
-	ret := new(big.Int).SetBytes(data[:32])
+	dst.SetBytes(data[:32])
 	if signed && data[0]&0x80 != 0 {
-		ret.Sub(ret, tt256)
+		dst.Sub(dst, tt256)
 	}
 
-	return ret, nil
+	return dst, nil
 }
 
 func EncodeEvent(event Event) ([]common.Hash, []byte, error) {
@@ -166,56 +340,3 @@ func DecodeEvent(event Event, topics []common.Hash, data []byte) error {
 	_, err := event.Decode(data)
 	return err
 }
-
-// GenTypeIdentifier generates a unique identifier for any ABI type
-// This is used to create unique function names for encoding/decoding
-func GenTypeIdentifier(t ethabi.Type) string {
-	switch t.T {
-	case ethabi.UintTy:
-		return fmt.Sprintf("Uint%d", t.Size)
-	case ethabi.IntTy:
-		return fmt.Sprintf("Int%d", t.Size)
-	case ethabi.AddressTy:
-		return "Address"
-	case ethabi.BoolTy:
-		return "Bool"
-	case ethabi.StringTy:
-		return "String"
-	case ethabi.BytesTy:
-		return "Bytes"
-	case ethabi.FixedBytesTy:
-		return fmt.Sprintf("Bytes%d", t.Size)
-	case ethabi.SliceTy:
-		return fmt.Sprintf("%sSlice", GenTypeIdentifier(*t.Elem))
-	case ethabi.ArrayTy:
-		return fmt.Sprintf("%sArray%d", GenTypeIdentifier(*t.Elem), t.Size)
-	case ethabi.TupleTy:
-		return TupleStructName(t) // Reuse existing tuple identifier logic
-	default:
-		panic("unsupported ABI type for identifier generation: " + t.String())
-	}
-}
-
-// GenTupleIdentifier generates a unique identifier for a tuple type
-func GenTupleIdentifier(t ethabi.Type) string {
-	// Create a signature based on tuple element types
-	types := make([]string, len(t.TupleElems))
-	for i, elem := range t.TupleElems {
-		types[i] = elem.String()
-	}
-
-	sig := fmt.Sprintf("(%v)", strings.Join(types, ","))
-	id := crypto.Keccak256([]byte(sig))
-	return "Tuple" + hex.EncodeToString(id)[:8] // Use first 8 chars for readability
-}
-
-// TupleStructName generates a unique struct name for a tuple type
-func TupleStructName(t ethabi.Type) string {
-	if t.TupleRawName != "" {
-		return t.TupleRawName
-	}
-
-	// Use the tuple's string representation as the basis for the struct name
-	// This creates a deterministic name based on the tuple structure
-	return GenTupleIdentifier(t)
-}
@@ -1,8 +1,12 @@
 package abi
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"io"
+	"math"
 	"math/big"
 	"testing"
 
@@ -40,6 +44,12 @@ func TestEncodeBigInt(t *testing.T) {
 	}
 }
 
+func TestEncodeBigIntNil(t *testing.T) {
+	buf := make([]byte, 32)
+	require.Equal(t, ErrNilInteger, EncodeBigInt(nil, buf, false))
+	require.Equal(t, ErrNilInteger, EncodeBigInt(nil, buf, true))
+}
+
 func TestDecodeBigInt(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -140,7 +150,238 @@ func TestDecodeBigInt(t *testing.T) {
 				err = EncodeBigInt(result, buf, tt.signed)
 				require.NoError(t, err)
 				require.Equal(t, tt.data, hex.EncodeToString(buf))
+
+				// decode into a reused big.Int holding another value
+				dst := big.NewInt(-12345)
+				reused, err := DecodeIntoBigInt(dst, data, tt.signed)
+				require.NoError(t, err)
+				require.True(t, reused == dst)
+				require.Zero(t, tt.expected.Cmp(reused))
+			}
+		})
+	}
+}
+
+func TestCheckTrailingBytes(t *testing.T) {
+	tests := []struct {
+		name       string
+		trailing   []byte
+		maxPadding int
+		err        error
+	}{
+		{"empty", nil, 0, nil},
+		{"zero padding not allowed", []byte{0}, 0, ErrTrailingBytes},
+		{"zero padding allowed", make([]byte, 31), MaxReturnPadding, nil},
+		{"zero padding too long", make([]byte, 32), MaxReturnPadding, ErrTrailingBytes},
+		{"non-zero padding", []byte{0, 0, 1}, MaxReturnPadding, ErrTrailingBytes},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.err, CheckTrailingBytes(tt.trailing, tt.maxPadding))
+		})
+	}
+}
+
+func TestDecodeSize(t *testing.T) {
+	// the canonical encoding checks, regardless of the size limit
+	defer func(max int) { MaxDecodeSize = max }(MaxDecodeSize)
+	MaxDecodeSize = math.MaxInt
+
+	word := func(hexStr string) []byte {
+		bz, err := hex.DecodeString(hexStr)
+		require.NoError(t, err)
+		return append(make([]byte, 32-len(bz)), bz...)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		size int
+		err  error
+	}{
+		{"zero", word(""), 0, nil},
+		{"small", word("20"), 32, nil},
+		{"max int", word("7fffffffffffffff"), math.MaxInt, nil},
+		{"exceeds max int", word("8000000000000000"), 0, ErrNonCanonicalSize},
+		{"max uint64", word("ffffffffffffffff"), 0, ErrNonCanonicalSize},
+		{"lowest upper byte set", word("010000000000000020"), 0, ErrNonCanonicalSize},
+		{"highest byte set", append([]byte{0x80}, word("20")[1:]...), 0, ErrNonCanonicalSize},
+		{"middle byte set", append(append(word("")[:12:12], 0x01), word("20")[13:]...), 0, ErrNonCanonicalSize},
+		{"all ones", bytes.Repeat([]byte{0xff}, 32), 0, ErrNonCanonicalSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, err := DecodeSize(tt.data)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.size, size)
+			if err != nil {
+				// compatible with the error returned before
+				require.True(t, errors.Is(err, ErrDirtyPadding))
+			}
+		})
+	}
+}
+
+func TestDecodeSizeTooLarge(t *testing.T) {
+	word := func(v uint64) []byte {
+		var data [32]byte
+		binary.BigEndian.PutUint64(data[24:], v)
+		return data[:]
+	}
+
+	size, err := DecodeSize(word(math.MaxInt32))
+	require.NoError(t, err)
+	require.Equal(t, math.MaxInt32, size)
+
+	for _, v := range []uint64{math.MaxInt32 + 1, 1 << 40} {
+		_, err = DecodeSize(word(v))
+		require.Equal(t, ErrSizeTooLarge, err)
+	}
+
+	defer func(max int) { MaxDecodeSize = max }(MaxDecodeSize)
+	MaxDecodeSize = 1024
+	_, err = DecodeSize(word(1024))
+	require.NoError(t, err)
+	_, err = DecodeSize(word(1025))
+	require.Equal(t, ErrSizeTooLarge, err)
+
+	// every decoding path fails gracefully on a length word of 2^40, before allocating
+	data := append(word(1<<40), make([]byte, 64)...)
+	_, _, err = DecodeString(data)
+	require.Equal(t, ErrSizeTooLarge, err)
+	_, _, err = DecodeBytes(data)
+	require.Equal(t, ErrSizeTooLarge, err)
+	_, _, err = DecodeUint256Slice(data)
+	require.Equal(t, ErrSizeTooLarge, err)
+	_, _, err = DecodeStringSlice(data)
+	require.Equal(t, ErrSizeTooLarge, err)
+	_, err = DecodeMulticall3Result(append(word(32), data...))
+	require.Equal(t, ErrSizeTooLarge, err)
+	_, err = UnpackRevertError(append(RevertSelector[:], data...))
+	require.Equal(t, ErrSizeTooLarge, err)
+}
+
+func TestDecodeStringView(t *testing.T) {
+	for _, s := range []string{"", "hello", string(bytes.Repeat([]byte{'a'}, 33))} {
+		data := make([]byte, SizeString(s))
+		_, err := EncodeString(s, data)
+		require.NoError(t, err)
+
+		expected, expectedN, err := DecodeString(data)
+		require.NoError(t, err)
+		view, n, err := DecodeStringView(data)
+		require.NoError(t, err)
+		require.Equal(t, expected, view)
+		require.Equal(t, expectedN, n)
+	}
+
+	data := make([]byte, SizeString("hello"))
+	_, err := EncodeString("hello", data)
+	require.NoError(t, err)
+	view, _, err := DecodeStringView(data)
+	require.NoError(t, err)
+	data[32] = 'j'
+	require.Equal(t, "jello", view, "the view references the input")
+
+	// the same validation as DecodeString
+	_, _, err = DecodeStringView(data[:40])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	data[40] = 1
+	_, _, err = DecodeStringView(data)
+	require.Equal(t, ErrDirtyPadding, err)
+	_, _, err = DecodeStringView(data[:31])
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestDecodeBool(t *testing.T) {
+	var word [32]byte
+	v, n, err := DecodeBool(word[:])
+	require.NoError(t, err)
+	require.False(t, v)
+	require.Equal(t, 32, n)
+
+	word[31] = 0x01
+	v, _, err = DecodeBool(word[:])
+	require.NoError(t, err)
+	require.True(t, v)
+
+	word[31] = 0x02
+	_, _, err = DecodeBool(word[:])
+	require.Equal(t, ErrDirtyPadding, err)
+
+	// any non-zero byte before the last one is dirty padding
+	for i := 0; i < 31; i++ {
+		for _, last := range []byte{0x00, 0x01} {
+			var dirty [32]byte
+			dirty[i] = 0x80
+			dirty[31] = last
+			_, _, err = DecodeBool(dirty[:])
+			require.Equal(t, ErrDirtyPadding, err, "byte %d", i)
+		}
+	}
+}
+
+func TestFromHex(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		data []byte
+		err  string
+	}{
+		{"empty", "", []byte{}, ""},
+		{"prefix only", "0x", []byte{}, ""},
+		{"prefixed", "0xa9059cbb", []byte{0xa9, 0x05, 0x9c, 0xbb}, ""},
+		{"upper case", "0XA9059CBB", []byte{0xa9, 0x05, 0x9c, 0xbb}, ""},
+		{"unprefixed", "00ff", []byte{0x00, 0xff}, ""},
+		{"odd length", "0xabc", nil, "odd length: invalid hex string"},
+		{"bad high digit", "0x0g", nil, "invalid hex string: bad character 'g' at 1"},
+		{"bad low digit", "000z", nil, "invalid hex string: bad character 'z' at 3"},
+		{"double prefix", "0x0x00", nil, "invalid hex string: bad character 'x' at 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := FromHex(tt.s)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				require.True(t, errors.Is(err, ErrInvalidHex))
+				require.Nil(t, data)
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tt.data, data)
 		})
 	}
+
+	_, err := FromHex("0x123")
+	require.Equal(t, ErrOddLengthHex, err)
+}
+
+func TestIntFromBig(t *testing.T) {
+	u24, err := UintFromBig[uint32](big.NewInt(1<<24-1), 24)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1<<24-1), u24)
+	_, err = UintFromBig[uint32](big.NewInt(1<<24), 24)
+	require.Equal(t, ErrIntegerOutOfRange, err)
+	_, err = UintFromBig[uint8](big.NewInt(-1), 8)
+	require.Equal(t, ErrIntegerOutOfRange, err)
+	_, err = UintFromBig[uint64](nil, 64)
+	require.Equal(t, ErrNilInteger, err)
+
+	i24, err := IntFromBig[int32](big.NewInt(-1<<23), 24)
+	require.NoError(t, err)
+	require.Equal(t, int32(-1<<23), i24)
+	_, err = IntFromBig[int32](big.NewInt(1<<23), 24)
+	require.Equal(t, ErrIntegerOutOfRange, err)
+	_, err = IntFromBig[int32](big.NewInt(-1<<23-1), 24)
+	require.Equal(t, ErrIntegerOutOfRange, err)
+	i64, err := IntFromBig[int64](big.NewInt(math.MaxInt64), 64)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), i64)
+	_, err = IntFromBig[int64](new(big.Int).Lsh(big.NewInt(1), 63), 64)
+	require.Equal(t, ErrIntegerOutOfRange, err)
+	_, err = IntFromBig[int16](nil, 16)
+	require.Equal(t, ErrNilInteger, err)
 }